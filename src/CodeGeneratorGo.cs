@@ -89,6 +89,7 @@ namespace AutoRest.Go
                 "client",
                 "version",
                 "interfaces",
+                "recording",
             };
 
             foreach (var methodGroup in codeModel.MethodGroups.Where(mg => !string.IsNullOrEmpty(mg.Name)))
@@ -113,6 +114,13 @@ namespace AutoRest.Go
             var versionTemplate = new VersionTemplate { Model = codeModel };
             await Write(versionTemplate, FormatFileName("version"));
 
+            // Recorder used by tests to record and play back HTTP interactions, opt-in by specifying the generate-recorder arg
+            if (codeModel.GenerateRecorder)
+            {
+                var recordingTemplate = new RecordingTemplate { Model = codeModel };
+                await Write(recordingTemplate, FormatFileName("recording"));
+            }
+
             // go.mod file, opt-in by specifying the gomod-root arg
             var modRoot = Settings.Instance.Host.GetValue<string>("gomod-root").Result;
             if (!string.IsNullOrWhiteSpace(modRoot))
@@ -11,6 +11,7 @@ using System.Collections.Generic;
 using System.Linq;
 using System.Net;
 using System.Text;
+using System.Text.RegularExpressions;
 
 namespace AutoRest.Go
 {
@@ -33,7 +34,6 @@ namespace AutoRest.Go
         {
             PrimaryTypeGo.GetImportLine(package: "net/http"),
             PrimaryTypeGo.GetImportLine(package: "context"),
-            PrimaryTypeGo.GetImportLine(package: "github.com/Azure/go-autorest/tracing"),
             PrimaryTypeGo.GetImportLine(package: "github.com/Azure/go-autorest/autorest/to")
         };
 
@@ -465,6 +465,229 @@ namespace AutoRest.Go
             return $"{pageType.ContentType.Name}Iterator";
         }
 
+        /// <summary>
+        /// Returns the result type name for the specified method, which is the type to be
+        /// returned from the method (this is applicable to operations that only return headers).
+        /// </summary>
+        /// <param name="method">The operation that returns response headers.</param>
+        /// <returns>The name of the type to be returned from the specified method.</returns>
+        internal string GetHeaderResponseTypeName(MethodGo method)
+        {
+            // operation group + method name is guaranteed to be unique
+            return $"{method.Group}{method.Name}Result";
+        }
+
+        /// <summary>
+        /// Returns the name of the type containing the optional parameters for the specified method.
+        /// </summary>
+        /// <param name="method">The operation that has optional parameters.</param>
+        /// <returns>The name of the options type for the specified method.</returns>
+        internal string GetOptionsTypeName(MethodGo method)
+        {
+            // operation group + method name is guaranteed to be unique
+            return $"{method.Group}{method.Name}Options";
+        }
+
+        /// <summary>
+        /// Returns the result type name for the specified method, which is the type to be
+        /// returned from the method (this is applicable to operations whose responses have different schemas).
+        /// </summary>
+        /// <param name="method">The operation that returns multiple response schemas.</param>
+        /// <returns>The name of the type to be returned from the specified method.</returns>
+        internal string GetMultiResponseTypeName(MethodGo method)
+        {
+            // operation group + method name is guaranteed to be unique
+            return $"{method.Group}{method.Name}Result";
+        }
+
+        /// <summary>
+        /// Returns the name of the error type returned from operations that
+        /// fail with a response containing the specified error model.
+        /// </summary>
+        /// <param name="errorModelName">The name of the error model, or null for the package's most common error model.</param>
+        /// <returns>The name of the error response type.</returns>
+        internal string GetErrorResponseTypeName(string errorModelName = null)
+        {
+            return $"{errorModelName}ErrorResponse";
+        }
+
+        /// <summary>
+        /// Returns the name of the type containing the options for polling futures until their operations complete.
+        /// </summary>
+        /// <returns>The name of the poll options type.</returns>
+        internal string GetPollOptionsTypeName()
+        {
+            return "PollUntilDoneOptions";
+        }
+
+        /// <summary>
+        /// Returns the name of the type containing the state serialized into the resume tokens of futures.
+        /// </summary>
+        /// <returns>The name of the resume token type.</returns>
+        internal string GetResumeTokenTypeName()
+        {
+            return "futureResumeToken";
+        }
+
+        /// <summary>
+        /// Returns the name of the function type of the steps of the pipeline requests go through.
+        /// </summary>
+        /// <returns>The name of the pipeline policy type.</returns>
+        internal string GetPipelinePolicyTypeName()
+        {
+            return "PipelinePolicy";
+        }
+
+        /// <summary>
+        /// Returns the name of the interface starting the spans tracing operations.
+        /// </summary>
+        /// <returns>The name of the tracer interface.</returns>
+        internal string GetTracerTypeName()
+        {
+            return "Tracer";
+        }
+
+        /// <summary>
+        /// Returns the name of the interface of the spans started by a tracer.
+        /// </summary>
+        /// <returns>The name of the span interface.</returns>
+        internal string GetSpanTypeName()
+        {
+            return "Span";
+        }
+
+        /// <summary>
+        /// Returns the name of the type of the structured events logged by operations.
+        /// </summary>
+        /// <returns>The name of the log event type.</returns>
+        internal string GetLogEventTypeName()
+        {
+            return "LogEvent";
+        }
+
+        /// <summary>
+        /// Returns the name of the interface receiving the events logged by operations.
+        /// </summary>
+        /// <returns>The name of the logger interface.</returns>
+        internal string GetLoggerTypeName()
+        {
+            return "Logger";
+        }
+
+        /// <summary>
+        /// Returns the name of the variant of a polymorphic type used for unknown discriminator values.
+        /// </summary>
+        /// <param name="name">The name of the polymorphic type.</param>
+        /// <returns>The name of the unknown variant.</returns>
+        internal string GetUnknownPolymorphicTypeName(string name)
+        {
+            return $"Unknown{name}";
+        }
+
+        /// <summary>
+        /// Returns the name of an enum constant prefixed with the name of its enum type, e.g. CMYKColorsBlack for the value blacK.
+        /// The words of the value are capitalized and the rest of their letters lower cased; a word starts after a
+        /// non-alphanumeric character or at an upper case letter followed by a lower case one, e.g. smart_salmon or DotSalmon.
+        /// </summary>
+        /// <param name="enumName">The name of the enum type.</param>
+        /// <param name="value">The value of the constant on the wire.</param>
+        /// <returns>The prefixed name of the constant.</returns>
+        internal string GetPrefixedEnumMemberName(string enumName, string value)
+        {
+            var builder = new StringBuilder(enumName);
+            foreach (var part in Regex.Split(value ?? string.Empty, "[^A-Za-z0-9]+").Where(p => p.Length > 0))
+            {
+                var start = 0;
+                for (var i = 1; i <= part.Length; ++i)
+                {
+                    if (i < part.Length && !(char.IsUpper(part[i]) && i + 1 < part.Length && char.IsLower(part[i + 1])))
+                    {
+                        continue;
+                    }
+                    builder.Append(char.ToUpperInvariant(part[start]));
+                    builder.Append(part.Substring(start + 1, i - start - 1).ToLowerInvariant());
+                    start = i;
+                }
+            }
+            if (builder.Length == enumName.Length)
+            {
+                builder.Append("Empty");
+            }
+            return GetEnumMemberName(builder.ToString());
+        }
+
+        /// <summary>
+        /// Returns the name of the interface deciding if and when failed requests are retried.
+        /// </summary>
+        /// <returns>The name of the retry policy interface.</returns>
+        internal string GetRetryPolicyTypeName()
+        {
+            return "RetryPolicy";
+        }
+
+        /// <summary>
+        /// Returns the name of the default retry policy type, retrying with exponentially increasing delays.
+        /// </summary>
+        /// <returns>The name of the default retry policy type.</returns>
+        internal string GetExponentialRetryTypeName()
+        {
+            return "ExponentialRetry";
+        }
+
+        /// <summary>
+        /// Returns the name of the type containing the options used to create a client.
+        /// </summary>
+        /// <returns>The name of the client options type.</returns>
+        internal string GetClientOptionsTypeName()
+        {
+            return "ClientOptions";
+        }
+
+        /// <summary>
+        /// Returns the name of the type containing the per-call options applied to the requests of operations.
+        /// </summary>
+        /// <returns>The name of the request options type.</returns>
+        internal string GetRequestOptionsTypeName()
+        {
+            return "RequestOptions";
+        }
+
+        /// <summary>
+        /// Returns the name of the type containing the per-call options applied to streaming multipart/form-data request bodies.
+        /// </summary>
+        /// <returns>The name of the multipart options type.</returns>
+        internal string GetMultipartOptionsTypeName()
+        {
+            return "MultipartOptions";
+        }
+
+        /// <summary>
+        /// Returns the name of the type returned by operations returning binary content.
+        /// </summary>
+        /// <returns>The name of the download result type.</returns>
+        internal string GetDownloadResultTypeName()
+        {
+            return "DownloadResult";
+        }
+
+        /// <summary>
+        /// Returns the name of the type describing a violation of a constraint found during validation.
+        /// </summary>
+        /// <returns>The name of the validation error type.</returns>
+        internal string GetValidationErrorTypeName()
+        {
+            return "ValidationError";
+        }
+
+        /// <summary>
+        /// Returns the name of the type controlling how violations of constraints found in responses are reported.
+        /// </summary>
+        /// <returns>The name of the response validation type.</returns>
+        internal string GetResponseValidationTypeName()
+        {
+            return "ResponseValidation";
+        }
+
         /// <summary>
         /// Converts names the conflict with Go reserved terms by appending the passed appendValue.
         /// </summary>
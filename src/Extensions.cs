@@ -17,6 +17,8 @@ namespace AutoRest.Go
 
         public const string ReadOnlyConstraint = "ReadOnly";
 
+        public const string EnumConstraint = "Enum";
+
         private static readonly Regex IsApiVersionPattern = new Regex(@"^api[^a-zA-Z0-9_]?version", RegexOptions.IgnoreCase);
 
         private static readonly Regex UnwrapAnchorTagsPattern = new Regex("([^<>]*)<a\\s*.*\\shref\\s*=\\s*[\'\"]([^\'\"]*)[\'\"][^>]*>(.*)</a>");
@@ -305,7 +307,7 @@ namespace AutoRest.Go
 
         public static bool IsStreamType(this IModelType body)
         {
-            return body is CompositeTypeGo r && (r.BaseType.PrimaryType(KnownPrimaryType.Stream));
+            return body is DownloadResultTypeGo || body is CompositeTypeGo r && (r.BaseType.PrimaryType(KnownPrimaryType.Stream));
         }
 
         public static bool PrimaryType(this IModelType type, KnownPrimaryType typeToMatch)
@@ -503,224 +505,734 @@ namespace AutoRest.Go
         /////////////////////////////////////////////////////////////////////////////////////////
         // Validate code
         //
-        // This code generates a validation object which is defined in
-        // go-autorest/autorest/validation package and is used to validate
-        // constraints.
-        // See PR: https://github.com/Azure/go-autorest/tree/master/autorest/validation
+        // This code generates straight-line Go statements that check the constraints of
+        // parameters and model properties.  Instead of stopping at the first failure the
+        // statements append every violation to a local variable of the package's
+        // ValidationErrors type, see ValidationErrorTypeGo.
         //
         /////////////////////////////////////////////////////////////////////////////////////////
 
+        /// <summary>
+        /// The name of the local variable the validation statements append violations to.
+        /// </summary>
+        public const string ValidationErrorsVariable = "errs";
 
+        /// <summary>
+        /// Return list of validation statements for primary, map, sequence and rest of the types.
+        /// </summary>
+        /// <param name="p">The parameter or property to validate.</param>
+        /// <param name="name">The expression used to access the value.</param>
+        /// <param name="path">The JSON path reported for violations.</param>
+        /// <param name="checkConstraints">Pass false to only check that a required value is present.</param>
+        /// <returns></returns>
+        public static List<string> ValidateType(this IVariable p, string name, string path, bool checkConstraints = true)
+        {
+            List<string> x = new List<string>();
+            if (checkConstraints)
+            {
+                var value = p.IsPointer() ? $"*{name}" : name;
+                foreach (var c in p.Constraints.Where(c => c.IsValidConstraint()))
+                {
+                    x.AddRange(GetConstraintCheck(p.ModelType, value, path, c.Key, c.Value));
+                }
+            }
+            return p.AddNullValidation(name, path, x);
+        }
 
         /// <summary>
-        /// Return list of validations for primary, map, sequence and rest of the types.
+        /// Return list of validation statements for composite type.  The constraints of
+        /// the type's properties are checked by calling its Validate method.
         /// </summary>
-        /// <param name="p"></param>
-        /// <param name="name"></param>
-        /// <param name="method"></param>
-        /// <param name="isCompositeProperties"></param>
+        /// <param name="p">The parameter or property to validate.</param>
+        /// <param name="name">The expression used to access the value.</param>
+        /// <param name="path">The JSON path reported for violations.</param>
+        /// <param name="checkConstraints">Pass false to only check that a required value is present.</param>
+        /// <param name="forResponse">Pass true to only check the constraints not checked by the type's Validate method,
+        /// by calling its responseViolations method.</param>
         /// <returns></returns>
-        public static List<string> ValidateType(this IVariable p, string name, HttpMethod method,
-            bool isCompositeProperties = false)
+        public static List<string> ValidateCompositeType(this IVariable p, string name, string path, bool checkConstraints = true, bool forResponse = false)
         {
             List<string> x = new List<string>();
-            if (method != HttpMethod.Patch || !p.IsBodyParameter() || isCompositeProperties)
+            if (checkConstraints && p.ModelType is CompositeTypeGo ctg && (forResponse ? ctg.HasResponseOnlyValidation : ctg.HasValidation))
             {
-                x.AddRange(p.Constraints
-                    .Where(c => c.IsValidConstraint())
-                    .Select(c => GetConstraint(name, c.Key.ToString(), c.Value)).ToList());
+                // a pointer to an interface must be dereferenced before calling its methods
+                var target = p.IsPointer() && p.ModelType.HasInterface() ? $"(*{name})" : name;
+                var method = forResponse ? CompositeTypeGo.ResponseViolationsMethodName : "Validate";
+                x.Add($"{ValidationErrorsVariable} = {ValidationErrorsVariable}.addNested(\"{path}\", {target}.{method}())");
+            }
+            return p.AddNullValidation(name, path, x, forResponse: forResponse);
+        }
+
+        /// <summary>
+        /// Return list of validation statements checking that a required read-only value returned by
+        /// the service is present.  The presence of other values is checked by the Validate method.
+        /// </summary>
+        /// <param name="p">The property to validate.</param>
+        /// <param name="name">The expression used to access the value.</param>
+        /// <param name="path">The JSON path reported for violations.</param>
+        /// <returns></returns>
+        public static List<string> ValidatePresence(this IVariable p, string name, string path)
+        {
+            return p.AddNullValidation(name, path, new List<string>(), forResponse: true);
+        }
+
+        /// <summary>
+        /// Return list of validation statements checking that the value of an enum is one of its known values.
+        /// </summary>
+        /// <param name="p">The parameter or property to validate.</param>
+        /// <param name="name">The expression used to access the value.</param>
+        /// <param name="path">The JSON path reported for violations.</param>
+        /// <returns></returns>
+        public static List<string> ValidateEnum(this IVariable p, string name, string path)
+        {
+            var etg = (EnumTypeGo)p.ModelType;
+            var known = etg.Values.Select(v => CodeNamerGo.Instance.GetEnumMemberName(v.Name)).ToList();
+            // an empty value means the service didn't return one
+            if (!etg.Values.Any(v => string.IsNullOrEmpty(v.SerializedName)))
+            {
+                known.Insert(0, "\"\"");
+            }
+            List<string> x = new List<string>
+            {
+                $"switch {(p.IsPointer() ? $"*{name}" : name)} {{",
+                $"case {string.Join(", ", known)}:",
+                "default:",
+                GetViolation(path, EnumConstraint, $"value must be one of {string.Join(", ", etg.Values.Select(v => $"'{v.SerializedName}'"))}"),
+                "}"
+            };
+            // the Validate method doesn't check enums so check that required ones are present
+            return p.AddNilCheck(name, path, x, p.IsRequired);
+        }
+
+        /// <summary>
+        /// Guards the specified checks with a nil check of the value, reporting
+        /// a violation if the value is required and nil.  The presence of read-only
+        /// properties is only checked on values returned by the service.
+        /// </summary>
+        /// <param name="p">The parameter or property to validate.</param>
+        /// <param name="name">The expression used to access the value.</param>
+        /// <param name="path">The JSON path reported for violations.</param>
+        /// <param name="checks">The statements checking the value's constraints.</param>
+        /// <param name="forResponse">Pass true to only check the presence of required read-only properties.</param>
+        /// <returns></returns>
+        public static List<string> AddNullValidation(this IVariable p, string name, string path, List<string> checks, bool forResponse = false)
+        {
+            var isReadOnly = p is Property property && property.IsReadOnly;
+            return p.AddNilCheck(name, path, checks, p.IsRequired && isReadOnly == forResponse);
+        }
+
+        /// <summary>
+        /// Guards the specified checks with a nil check of the value, reporting
+        /// a violation if required is true and the value is nil.
+        /// </summary>
+        /// <param name="p">The parameter or property to validate.</param>
+        /// <param name="name">The expression used to access the value.</param>
+        /// <param name="path">The JSON path reported for violations.</param>
+        /// <param name="checks">The statements checking the value's constraints.</param>
+        /// <param name="required">Pass true to report a violation if the value is nil.</param>
+        /// <returns></returns>
+        private static List<string> AddNilCheck(this IVariable p, string name, string path, List<string> checks, bool required)
+        {
+            if (!p.CanBeNil())
+            {
+                return checks;
             }
 
             List<string> y = new List<string>();
-            if (x.Count > 0)
+            if (required)
             {
-                if (p.CheckNull() || isCompositeProperties)
-                    y.AddRange(x.AddChain(name, NullConstraint, p.IsRequired));
-                else
-                    y.AddRange(x);
+                y.Add($"if {name} == nil {{");
+                y.Add(GetViolation(path, NullConstraint, "value can not be null; required parameter"));
+                if (checks.Count > 0)
+                {
+                    y.Add("} else {");
+                    y.AddRange(checks);
+                }
+                y.Add("}");
             }
-            else
+            else if (checks.Count > 0)
             {
-                if (p.IsRequired && (p.CheckNull() || isCompositeProperties))
-                    y.AddNullValidation(name, p.IsRequired);
+                y.Add($"if {name} != nil {{");
+                y.AddRange(checks);
+                y.Add("}");
             }
             return y;
         }
 
         /// <summary>
-        /// Return list of validations for composite type.
+        /// Returns true if the Go type of the specified parameter or property is a pointer.
         /// </summary>
         /// <param name="p"></param>
-        /// <param name="name"></param>
-        /// <param name="method"></param>
-        /// <param name="ancestors"></param>
-        /// <param name="isCompositeProperties"></param>
         /// <returns></returns>
-        public static List<string> ValidateCompositeType(this IVariable p, string name, HttpMethod method, HashSet<string> ancestors,
-            bool isCompositeProperties = false)
+        private static bool IsPointer(this IVariable p)
         {
-            List<string> x = new List<string>();
-            if (method != HttpMethod.Patch || !p.IsBodyParameter() || isCompositeProperties)
-            {
-                foreach (var prop in ((CompositeType)p.ModelType).Properties.Cast<PropertyGo>())
-                {
-                    var primary = prop.ModelType as PrimaryType;
-                    var sequence = prop.ModelType as SequenceType;
-                    var map = prop.ModelType as DictionaryTypeGo;
-                    var composite = prop.ModelType as CompositeType;
+            return p is PropertyGo property
+                ? property.IsPointer
+                : !(p.IsRequired || p.ModelType.CanBeEmpty());
+        }
+
+        /// <summary>
+        /// Returns true if the value of the specified parameter or property can be nil.
+        /// </summary>
+        /// <param name="p"></param>
+        /// <returns></returns>
+        private static bool CanBeNil(this IVariable p)
+        {
+            return p.IsPointer() || p.ModelType.CanBeNull() || p.ModelType.HasInterface();
+        }
+
+        /// <summary>
+        /// Check if parameter is a body parameter.
+        /// </summary>
+        /// <param name="p"></param>
+        /// <returns></returns>
+        public static bool IsBodyParameter(this IVariable p)
+        {
+            return p is Parameter parameter && parameter.Location == ParameterLocation.Body;
+        }
+
+        /// <summary>
+        /// Returns the statements checking the specified constraint.  Constraints that
+        /// don't apply to the type of the value don't generate any statements.
+        /// </summary>
+        /// <param name="type">The type of the value.</param>
+        /// <param name="value">The expression used to access the value.</param>
+        /// <param name="path">The JSON path reported for violations.</param>
+        /// <param name="constraint">The constraint to check.</param>
+        /// <param name="rule">The value of the constraint.</param>
+        /// <returns></returns>
+        private static IEnumerable<string> GetConstraintCheck(IModelType type, string value, string path, Constraint constraint, string rule)
+        {
+            var isInteger = type.IsInteger();
+            var isNumber = type.IsNumber();
+            var isString = type.IsPrimaryType(KnownPrimaryType.String);
+            var hasLength = isString || type.IsPrimaryType(KnownPrimaryType.ByteArray) || type is SequenceType || type is DictionaryType;
 
-                    var propName = prop.FieldName;
+            // integer values compared to a fractional rule must be converted first
+            var number = isInteger && rule.IndexOf('.') > -1 ? $"float64({value})" : value;
 
-                    if (primary != null || sequence != null || map != null)
+            string condition = null;
+            string details = null;
+            switch (constraint)
+            {
+                case Constraint.MaxLength when hasLength:
+                    condition = $"len({value}) > {rule}";
+                    details = $"value length must be less than or equal to {rule}";
+                    break;
+                case Constraint.MinLength when hasLength:
+                    condition = $"len({value}) < {rule}";
+                    details = $"value length must be greater than or equal to {rule}";
+                    break;
+                case Constraint.MaxItems when hasLength:
+                    condition = $"len({value}) > {rule}";
+                    details = $"maximum item limit is {rule}";
+                    break;
+                case Constraint.MinItems when hasLength:
+                    condition = $"len({value}) < {rule}";
+                    details = $"minimum item limit is {rule}";
+                    break;
+                case Constraint.UniqueItems when type is SequenceType st && st.ElementType.IsComparable() && rule.EqualsIgnoreCase("true"):
+                    var items = value.StartsWith("*") ? $"({value})" : value;
+                    condition = $"{ValidationErrorTypeGo.HasDuplicateItemsFuncName}(len({items}), func(i, j int) bool {{ return {items}[i] == {items}[j] }})";
+                    details = "all items must be unique";
+                    break;
+                case Constraint.Pattern when isString:
+                    return new[]
                     {
-                        x.AddRange(prop.ValidateType($"{name}.{propName}", method, true));
-                    }
-                    else if (composite != null)
+                        $"if matched, err := regexp.MatchString({rule.ToGoRawStringLiteral()}, {value}); err != nil || !matched {{",
+                        GetViolation(path, constraint.ToString(), $"value doesn't match pattern {rule}"),
+                        "}"
+                    };
+                case Constraint.Pattern when type is DictionaryType:
+                    return new[]
                     {
-                        if (ancestors.Contains(composite.Name))
-                        {
-                            x.AddNullValidation($"{name}.{propName}", p.IsRequired);
-                        }
-                        else
-                        {
-                            ancestors.Add(composite.Name);
-                            x.AddRange(prop.ValidateCompositeType($"{name}.{propName}", method, ancestors, true));
-                            ancestors.Remove(composite.Name);
-                        }
-                    }
-                }
+                        $"for k := range {value} {{",
+                        $"if matched, err := regexp.MatchString({rule.ToGoRawStringLiteral()}, k); err != nil || !matched {{",
+                        GetViolation(path, constraint.ToString(), $"map key doesn't match pattern {rule}"),
+                        "break",
+                        "}",
+                        "}"
+                    };
+                case Constraint.MultipleOf when isInteger && rule.IndexOf('.') < 0:
+                    condition = $"{value}%{rule} != 0";
+                    details = $"value must be a multiple of {rule}";
+                    break;
+                case Constraint.MultipleOf when isNumber:
+                    condition = isInteger ? $"math.Mod(float64({value}), {rule}) != 0" : $"math.Mod({value}, {rule}) != 0";
+                    details = $"value must be a multiple of {rule}";
+                    break;
+                case Constraint.ExclusiveMinimum when isNumber:
+                    condition = $"{number} <= {rule}";
+                    details = $"value must be greater than {rule}";
+                    break;
+                case Constraint.ExclusiveMaximum when isNumber:
+                    condition = $"{number} >= {rule}";
+                    details = $"value must be less than {rule}";
+                    break;
+                case Constraint.InclusiveMinimum when isNumber:
+                    condition = $"{number} < {rule}";
+                    details = $"value must be greater than or equal to {rule}";
+                    break;
+                case Constraint.InclusiveMaximum when isNumber:
+                    condition = $"{number} > {rule}";
+                    details = $"value must be less than or equal to {rule}";
+                    break;
             }
 
-            List<string> y = new List<string>();
-            if (x.Count > 0)
+            if (condition == null)
             {
-                if (p.CheckNull() || isCompositeProperties)
-                    y.AddRange(x.AddChain(name, NullConstraint, p.IsRequired));
-                else
-                    y.AddRange(x);
+                return Enumerable.Empty<string>();
             }
-            else
+            return new[]
             {
-                if (p.IsRequired && (p.CheckNull() || isCompositeProperties))
-                    y.AddNullValidation(name, p.IsRequired);
-            }
-            return y;
+                $"if {condition} {{",
+                GetViolation(path, constraint.ToString(), details),
+                "}"
+            };
         }
 
         /// <summary>
-        /// Add null validation in validation object.
+        /// Returns the statement appending a violation of the specified constraint to the validation errors.
         /// </summary>
-        /// <param name="v"></param>
-        /// <param name="name"></param>
-        /// <param name="isRequired"></param>
-        public static void AddNullValidation(this List<string> v, string name, bool isRequired = false)
+        /// <param name="path">The JSON path of the value violating the constraint.</param>
+        /// <param name="constraintName">The name of the violated constraint.</param>
+        /// <param name="details">Describes the violation.</param>
+        /// <returns></returns>
+        private static string GetViolation(string path, string constraintName, string details)
         {
-            v.Add(GetConstraint(name, NullConstraint, $"{isRequired}".ToLower()));
+            return $"{ValidationErrorsVariable} = append({ValidationErrorsVariable}, {CodeNamerGo.Instance.GetValidationErrorTypeName()}{{Path: \"{path}\", Constraint: \"{constraintName}\", Details: {details.ToGoStringLiteral()}}})";
         }
 
         /// <summary>
-        /// Add chain of validation for composite type.
+        /// Adds the imports required to check the constraints of the specified parameter or property.
         /// </summary>
-        /// <param name="x"></param>
-        /// <param name="name"></param>
-        /// <param name="constraint"></param>
-        /// <param name="isRequired"></param>
+        /// <param name="p"></param>
+        /// <param name="imports"></param>
+        public static void AddValidationImports(this IVariable p, HashSet<string> imports)
+        {
+            foreach (var c in p.Constraints.Where(c => c.IsValidConstraint()))
+            {
+                var package = GetConstraintImport(p.ModelType, c.Key, c.Value);
+                if (package != null)
+                {
+                    imports.Add(PrimaryTypeGo.GetImportLine(package: package));
+                }
+            }
+        }
+
+        /// <summary>
+        /// Returns the package used by the statements checking the specified constraint, or null
+        /// if they don't use any.  Must be kept in sync with GetConstraintCheck.
+        /// </summary>
+        /// <param name="type">The type of the value.</param>
+        /// <param name="constraint">The constraint to check.</param>
+        /// <param name="rule">The value of the constraint.</param>
         /// <returns></returns>
-        public static List<string> AddChain(this List<string> x, string name, string constraint, bool isRequired)
+        private static string GetConstraintImport(IModelType type, Constraint constraint, string rule)
         {
-            List<string> a = new List<string>
+            switch (constraint)
             {
-                GetConstraint(name, constraint, $"{isRequired}".ToLower(), true),
-                $"Chain: []validation.Constraint{{{x[0]}"
-            };
-            a.AddRange(x.GetRange(1, x.Count - 1));
-            a.Add("}}");
-            return a;
+                case Constraint.Pattern when type.IsPrimaryType(KnownPrimaryType.String) || type is DictionaryType:
+                    return "regexp";
+                case Constraint.MultipleOf when type.IsNumber() && !(type.IsInteger() && rule.IndexOf('.') < 0):
+                    return "math";
+            }
+            return null;
         }
+
         /// <summary>
-        /// CheckNull
+        /// Returns true if the specified type is represented by a Go integer type.
         /// </summary>
-        /// <param name="p"></param>
+        /// <param name="type"></param>
         /// <returns></returns>
-        // Check if type is not a null or pointer type.
-        public static bool CheckNull(this IVariable p)
+        private static bool IsInteger(this IModelType type)
         {
-            return p is Parameter && (p.ModelType.IsNullValueType() || !(p.IsRequired || p.ModelType.CanBeEmpty()));
+            return type.IsPrimaryType(KnownPrimaryType.Int) || type.IsPrimaryType(KnownPrimaryType.Long);
         }
 
         /// <summary>
-        /// Check whether a type is nullable type.
+        /// Returns true if the specified type is represented by a Go integer or floating-point type.
         /// </summary>
-        /// <param name="t"></param>
+        /// <param name="type"></param>
         /// <returns></returns>
-        public static bool IsNullValueType(this IModelType t)
+        private static bool IsNumber(this IModelType type)
         {
-            return t is DictionaryType
-                || (t is PrimaryType primaryType
-                   && primaryType.KnownPrimaryType == KnownPrimaryType.ByteArray)
-                || t is SequenceType;
+            return type.IsInteger() || type.IsPrimaryType(KnownPrimaryType.Double) || type.IsPrimaryType(KnownPrimaryType.Decimal);
         }
 
         /// <summary>
-        /// Check if parameter is a body parameter.
+        /// Returns true if values of the specified type can be compared with the == operator.
         /// </summary>
-        /// <param name="p"></param>
+        /// <param name="type"></param>
         /// <returns></returns>
-        public static bool IsBodyParameter(this IVariable p)
+        private static bool IsComparable(this IModelType type)
         {
-            return p is Parameter parameter && parameter.Location == ParameterLocation.Body;
+            return type is EnumType ||
+                (type is PrimaryType primaryType &&
+                 (primaryType.KnownPrimaryType == KnownPrimaryType.String ||
+                  primaryType.KnownPrimaryType == KnownPrimaryType.Int ||
+                  primaryType.KnownPrimaryType == KnownPrimaryType.Long ||
+                  primaryType.KnownPrimaryType == KnownPrimaryType.Double ||
+                  primaryType.KnownPrimaryType == KnownPrimaryType.Decimal ||
+                  primaryType.KnownPrimaryType == KnownPrimaryType.Boolean));
+        }
+
+        /// <summary>
+        /// Returns the specified string as an interpreted Go string literal.
+        /// </summary>
+        /// <param name="s"></param>
+        /// <returns></returns>
+        private static string ToGoStringLiteral(this string s)
+        {
+            return $"\"{s.Replace("\\", "\\\\").Replace("\"", "\\\"")}\"";
+        }
+
+        /// <summary>
+        /// Returns the specified string as a raw Go string literal if possible.
+        /// </summary>
+        /// <param name="s"></param>
+        /// <returns></returns>
+        private static string ToGoRawStringLiteral(this string s)
+        {
+            return s.Contains("`") ? s.ToGoStringLiteral() : $"`{s}`";
+        }
+
+        /// <summary>
+        /// Returns true if the specified constraint can be expressed in Go.
+        /// </summary>
+        internal static bool IsValidConstraint(this KeyValuePair<Constraint, string> constraint)
+        {
+            // Go's regex engine doesn't support positive or negative lookaheads or
+            // lookbehinds, so if the constraint contain any of them we will omit it.
+            if (constraint.Key == Constraint.Pattern &&
+                (constraint.Value.Contains("?=") || constraint.Value.Contains("?!") ||
+                 constraint.Value.Contains("?<=") || constraint.Value.Contains("?<!")))
+            {
+                return false;
+            }
+            return true;
         }
 
+        /////////////////////////////////////////////////////////////////////////////////////////
+        // Copy code
+        //
+        // This code generates the statements of the DeepCopy, equal and MergeFrom methods of
+        // the models.  Pointers, slices and maps are copied and compared element by element,
+        // nested models by calling their own methods and polymorphic values by calling the
+        // deepCopy and equal functions generated with their interface.
+        //
+        /////////////////////////////////////////////////////////////////////////////////////////
+
         /// <summary>
-        /// Construct validation string for validation object for the passed constraint.
+        /// The name of the parameter telling the equal methods to skip the read-only fields.
         /// </summary>
-        /// <param name="name"></param>
-        /// <param name="constraintName"></param>
-        /// <param name="constraintValue"></param>
-        /// <param name="chain"></param>
+        public const string IgnoreReadOnlyVariable = "ignoreReadOnly";
+
+        /// <summary>
+        /// The name of the function returning a deep copy of the value of a field of type interface{}.
+        /// </summary>
+        public const string DeepCopyObjectFuncName = "deepCopyObject";
+
+        /// <summary>
+        /// The name of the function returning the value of the discriminator of a polymorphic type from its JSON.
+        /// </summary>
+        public const string UnmarshalDiscriminatorFuncName = "unmarshalDiscriminator";
+
+        /// <summary>
+        /// Returns the statements assigning a deep copy of a value to the target.  Nothing is assigned
+        /// when the value is nil so the target must already be nil or equal to the value.
+        /// </summary>
+        /// <param name="type">The type of the value.</param>
+        /// <param name="isPointer">Pass true if the value is a pointer to the type.</param>
+        /// <param name="target">The expression the copy is assigned to.</param>
+        /// <param name="source">The expression used to access the value.</param>
+        /// <param name="scope">Provides the names of the local variables.</param>
         /// <returns></returns>
-        public static string GetConstraint(string name, string constraintName, string constraintValue, bool chain = false)
+        public static List<string> DeepCopyValue(this IModelType type, bool isPointer, string target, string source, VariableScopeProvider scope)
         {
-            var value = constraintValue;
-            if (constraintName == Constraint.Pattern.ToString())
+            List<string> x = new List<string>();
+            if (isPointer)
             {
-                value = $"`{constraintValue}`";
+                var v = scope.GetVariableName("v");
+                x.Add($"if {source} != nil {{");
+                if (type.IsCopiedByValue())
+                {
+                    x.Add($"{v} := *{source}");
+                }
+                else if (type is CompositeType)
+                {
+                    x.Add($"{v} := {source}.DeepCopy()");
+                }
+                else
+                {
+                    x.Add($"var {v} {type.Name}");
+                    x.AddRange(type.DeepCopyValue(false, v, $"*{source}", scope));
+                }
+                x.Add($"{target} = &{v}");
+                x.Add("}");
             }
-            else if (constraintName == Constraint.InclusiveMaximum.ToString())
+            else if (type.IsCopiedByValue())
             {
-                // swagger spec states that InclusiveMaximum should be an integer
-                // however the validation code supports int64 and float64.  to be
-                // on the safe side handle both cases here.
-                if (constraintValue.IndexOf('.') > -1)
+                x.Add($"{target} = {source}");
+            }
+            else if (type.HasInterface())
+            {
+                x.Add($"{target} = deepCopy{type.GetInterfaceName()}({source})");
+            }
+            else if (type is CompositeType)
+            {
+                x.Add($"{target} = {source}.DeepCopy()");
+            }
+            else if (type.PrimaryType(KnownPrimaryType.Object))
+            {
+                x.Add($"{target} = {DeepCopyObjectFuncName}({source})");
+            }
+            else if (type is DictionaryType dictionaryType)
+            {
+                x.Add($"if {source} != nil {{");
+                x.Add($"{target} = make({type.Name}, len({source}))");
+                x.AddRange(dictionaryType.DeepCopyEntries(target, source, scope));
+                x.Add("}");
+            }
+            else
+            {
+                // a slice, byte slices included
+                var elementType = (type as SequenceType)?.ElementType;
+                x.Add($"if {source} != nil {{");
+                x.Add($"{target} = make({type.Name}, len({source}))");
+                if (elementType == null || elementType.IsCopiedByValue())
                 {
-                    value = $"float64({constraintValue})";
+                    x.Add($"copy({target}, {source})");
                 }
                 else
                 {
-                    value = $"int64({constraintValue})";
+                    var i = scope.GetVariableName("i");
+                    x.Add($"for {i} := range {source} {{");
+                    x.AddRange(elementType.DeepCopyValue(false, target.Indexed(i), source.Indexed(i), scope));
+                    x.Add("}");
                 }
+                x.Add("}");
             }
+            return x;
+        }
 
-            return string.Format(chain
-                                    ? "\t{{Target: \"{0}\", Name: validation.{1}, Rule: {2} "
-                                    : "\t{{Target: \"{0}\", Name: validation.{1}, Rule: {2}, Chain: nil }}",
-                                    name, constraintName, value);
+        /// <summary>
+        /// Returns the statements merging a map into the target, which is created if it's nil.
+        /// The entries of the map replace the entries of the target with the same keys.
+        /// </summary>
+        /// <param name="type">The type of the map.</param>
+        /// <param name="target">The expression used to access the map merged into.</param>
+        /// <param name="source">The expression used to access the map merged.</param>
+        /// <param name="scope">Provides the names of the local variables.</param>
+        /// <returns></returns>
+        public static List<string> MergeDictionary(this DictionaryType type, string target, string source, VariableScopeProvider scope)
+        {
+            List<string> x = new List<string>
+            {
+                $"if {source} != nil {{",
+                $"if {target} == nil {{",
+                $"{target} = make({type.Name}, len({source}))",
+                "}"
+            };
+            x.AddRange(type.DeepCopyEntries(target, source, scope));
+            x.Add("}");
+            return x;
         }
 
         /// <summary>
-        /// Returns true if the specified constraint can be expressed in Go.
+        /// Returns the loop assigning deep copies of the entries of a map to the entries of the target map.
         /// </summary>
-        private static bool IsValidConstraint(this KeyValuePair<Constraint, string> constraint)
+        private static List<string> DeepCopyEntries(this DictionaryType type, string target, string source, VariableScopeProvider scope)
         {
-            // Go's regex engine doesn't support positive or negative lookaheads or
-            // lookbehinds, so if the constraint contain any of them we will omit it.
-            if (constraint.Key == Constraint.Pattern &&
-                (constraint.Value.Contains("?=") || constraint.Value.Contains("?!") ||
-                 constraint.Value.Contains("?<=") || constraint.Value.Contains("?<!")))
+            var k = scope.GetVariableName("k");
+            var e = scope.GetVariableName("e");
+            List<string> x = new List<string> { $"for {k}, {e} := range {source} {{" };
+            // the values that can't be nil are stored as pointers, see DictionaryTypeGo
+            var isPointer = !type.ValueType.CanBeNull();
+            if (isPointer)
             {
-                return false;
+                // the copy replaces the loop variable, which is only read before being assigned
+                x.AddRange(type.ValueType.DeepCopyValue(true, e, e, scope));
+                x.Add($"{target}[{k}] = {e}");
+            }
+            else if (type.ValueType.PrimaryType(KnownPrimaryType.Object))
+            {
+                x.Add($"{target}[{k}] = {DeepCopyObjectFuncName}({e})");
+            }
+            else
+            {
+                var v = scope.GetVariableName("v");
+                x.Add($"var {v} {type.ValueType.Name}");
+                x.AddRange(type.ValueType.DeepCopyValue(false, v, e, scope));
+                x.Add($"{target}[{k}] = {v}");
+            }
+            x.Add("}");
+            return x;
+        }
+
+        /// <summary>
+        /// Returns the statements returning false if two values aren't equal.  Times are equal if they
+        /// represent the same instant and the read-only fields of nested models are skipped if the
+        /// variable named IgnoreReadOnlyVariable is true.
+        /// </summary>
+        /// <param name="type">The type of the values.</param>
+        /// <param name="isPointer">Pass true if the values are pointers to the type.</param>
+        /// <param name="a">The expression used to access the first value.</param>
+        /// <param name="b">The expression used to access the second value.</param>
+        /// <param name="scope">Provides the names of the local variables.</param>
+        /// <returns></returns>
+        public static List<string> EqualValue(this IModelType type, bool isPointer, string a, string b, VariableScopeProvider scope)
+        {
+            var differs = type.DiffersCondition(isPointer, a, b);
+            if (differs != null)
+            {
+                return new List<string> { $"if {differs} {{", "return false", "}" };
+            }
+
+            List<string> x = new List<string>
+            {
+                isPointer ? $"if ({a} == nil) != ({b} == nil) {{" : $"if ({a} == nil) != ({b} == nil) || len({a}) != len({b}) {{",
+                "return false",
+                "}"
+            };
+            if (isPointer)
+            {
+                x.Add($"if {a} != nil {{");
+                x.AddRange(type.EqualValue(false, $"*{a}", $"*{b}", scope));
+                x.Add("}");
+            }
+            else if (type is DictionaryType dictionaryType)
+            {
+                var k = scope.GetVariableName("k");
+                var e = scope.GetVariableName("e");
+                var f = scope.GetVariableName("f");
+                var ok = scope.GetVariableName("ok");
+                var valueIsPointer = !dictionaryType.ValueType.CanBeNull();
+                var valueDiffers = dictionaryType.ValueType.DiffersCondition(valueIsPointer, e, f);
+                x.Add($"for {k}, {e} := range {a} {{");
+                x.Add($"{f}, {ok} := {b}[{k}]");
+                if (valueDiffers != null)
+                {
+                    x.Add($"if !{ok} || {valueDiffers} {{");
+                    x.Add("return false");
+                    x.Add("}");
+                }
+                else
+                {
+                    x.Add($"if !{ok} {{");
+                    x.Add("return false");
+                    x.Add("}");
+                    x.AddRange(dictionaryType.ValueType.EqualValue(valueIsPointer, e, f, scope));
+                }
+                x.Add("}");
+            }
+            else
+            {
+                var i = scope.GetVariableName("i");
+                x.Add($"for {i} := range {a} {{");
+                x.AddRange(((SequenceType)type).ElementType.EqualValue(false, a.Indexed(i), b.Indexed(i), scope));
+                x.Add("}");
+            }
+            return x;
+        }
+
+        /// <summary>
+        /// Returns the condition true if two values aren't equal, or null if they must be compared with
+        /// several statements.
+        /// </summary>
+        private static string DiffersCondition(this IModelType type, bool isPointer, string a, string b)
+        {
+            string differs;
+            var valueA = isPointer ? $"*{a}" : a;
+            var valueB = isPointer ? $"*{b}" : b;
+            if (type is CompositeType)
+            {
+                differs = type.HasInterface() && !isPointer
+                    ? $"!equal{type.GetInterfaceName()}({a}, {b}, {IgnoreReadOnlyVariable})"
+                    : $"!{a}.equal({valueB}, {IgnoreReadOnlyVariable})";
+            }
+            else if (type is PrimaryType primaryType)
+            {
+                switch (primaryType.KnownPrimaryType)
+                {
+                    case KnownPrimaryType.Date:
+                    case KnownPrimaryType.DateTime:
+                    case KnownPrimaryType.DateTimeRfc1123:
+                        differs = $"!{a}.Equal({b}.Time)";
+                        break;
+                    case KnownPrimaryType.UnixTime:
+                        differs = $"!time.Time({valueA}).Equal(time.Time({valueB}))";
+                        break;
+                    case KnownPrimaryType.Decimal:
+                        differs = $"!{a}.Equal({valueB})";
+                        break;
+                    case KnownPrimaryType.Object:
+                        differs = $"!reflect.DeepEqual({a}, {b})";
+                        break;
+                    case KnownPrimaryType.ByteArray:
+                        if (isPointer)
+                        {
+                            return null;
+                        }
+                        differs = $"({a} == nil) != ({b} == nil) || !bytes.Equal({a}, {b})";
+                        break;
+                    default:
+                        differs = $"{valueA} != {valueB}";
+                        break;
+                }
+            }
+            else if (type is EnumType)
+            {
+                differs = $"{valueA} != {valueB}";
+            }
+            else
+            {
+                return null;
+            }
+            return isPointer ? $"({a} == nil) != ({b} == nil) || ({a} != nil && {differs})" : differs;
+        }
+
+        /// <summary>
+        /// Returns true if the values of the specified type don't share memory once assigned.
+        /// </summary>
+        /// <param name="type"></param>
+        /// <returns></returns>
+        public static bool IsCopiedByValue(this IModelType type)
+        {
+            return type is EnumType ||
+                (type is PrimaryType primaryType &&
+                 primaryType.KnownPrimaryType != KnownPrimaryType.ByteArray &&
+                 primaryType.KnownPrimaryType != KnownPrimaryType.Object);
+        }
+
+        /// <summary>
+        /// Returns the expression indexing the specified slice or map expression.
+        /// </summary>
+        /// <param name="value"></param>
+        /// <param name="index"></param>
+        /// <returns></returns>
+        private static string Indexed(this string value, string index)
+        {
+            return value.StartsWith("*") ? $"({value})[{index}]" : $"{value}[{index}]";
+        }
+
+        /// <summary>
+        /// Adds the imports required by the specified copy statements.
+        /// </summary>
+        /// <param name="statements"></param>
+        /// <param name="imports"></param>
+        public static void AddCopyImports(this IEnumerable<string> statements, HashSet<string> imports)
+        {
+            foreach (var statement in statements)
+            {
+                foreach (var package in new[] { "bytes", "reflect", "time" })
+                {
+                    if (Regex.IsMatch(statement, $@"\b{package}\."))
+                    {
+                        imports.Add(PrimaryTypeGo.GetImportLine(package: package));
+                    }
+                }
             }
-            return true;
         }
     }
 }
@@ -31,6 +31,11 @@ namespace AutoRest.Go.Model
             Tag = Settings.Instance.Host?.GetValue<string>("tag").Result ?? null;
             APIType = Settings.Instance.Host?.GetValue<string>("openapi-type").Result;
             ShouldValidate = (bool)Settings.Instance.Host?.GetValue<bool?>("client-side-validation").Result;
+            UseOptionsStructs = Settings.Instance.Host?.GetValue<bool?>("options-structs").Result ?? false;
+            ShouldValidateResponses = Settings.Instance.Host?.GetValue<bool?>("response-validation").Result ?? false;
+            UseStrictEnums = Settings.Instance.Host?.GetValue<bool?>("strict-enums").Result ?? false;
+            UsePrefixedEnumConstants = Settings.Instance.Host?.GetValue<bool?>("prefix-enum-constants").Result ?? false;
+            GenerateRecorder = Settings.Instance.Host?.GetValue<bool?>("generate-recorder").Result ?? false;
             _pkgName = Settings.Instance.Host?.GetValue<string>("package-name").Result?.ToLowerInvariant();
             _outDir = Settings.Instance.Host?.GetValue<string>("output-folder").Result.ToLowerInvariant().Replace("\\", "/");
             _sdkPath = Settings.Instance.Host?.GetValue<string>("go-sdk-folder").Result?.ToLowerInvariant().Replace("\\", "/").Trim();
@@ -140,6 +145,22 @@ namespace AutoRest.Go.Model
             }
         }
 
+        /// <summary>
+        /// Gets the imports of the file containing the Recorder used to record and play back HTTP interactions in tests.
+        /// </summary>
+        public IEnumerable<string> RecordingImports
+        {
+            get
+            {
+                var imports = new HashSet<string>(CodeNamerGo.Instance.AutorestImports);
+                foreach (var package in new[] { "bytes", "crypto/sha256", "encoding/base64", "encoding/hex", "encoding/json", "fmt", "io", "io/ioutil", "net/http", "net/url", "os", "path/filepath", "sync", "unicode/utf8" })
+                {
+                    imports.Add(PrimaryTypeGo.GetImportLine(package: package));
+                }
+                return imports.OrderBy(i => i);
+            }
+        }
+
         public IEnumerable<string> ClientImports
         {
             get
@@ -155,12 +176,114 @@ namespace AutoRest.Go.Model
                 {
                     p.ModelType.AddImports(imports);
                 }
+                // used by NewWithOptions
+                if (!IsCustomBaseUri)
+                {
+                    imports.Add(PrimaryTypeGo.GetImportLine(package: "net/url"));
+                }
+                imports.Add(PrimaryTypeGo.GetImportLine(package: "time"));
+                // used by the pipeline policies
+                imports.Add(PrimaryTypeGo.GetImportLine(package: "crypto/rand"));
+                imports.Add(PrimaryTypeGo.GetImportLine(package: "fmt"));
+                imports.Add(PrimaryTypeGo.GetImportLine(package: "net/http"));
+                // used by the tracing helpers
+                imports.Add(PrimaryTypeGo.GetImportLine(package: "context"));
+                imports.Add(PrimaryTypeGo.GetImportLine(package: "sync"));
+                imports.UnionWith(CodeNamerGo.Instance.TracingImports);
+                imports.Add(PrimaryTypeGo.GetImportLine(package: "github.com/Azure/go-autorest/autorest/validation"));
                 return imports.OrderBy(i => i);
             }
         }
 
         public string ClientDocumentation => string.Format("{0} is the base client for {1}.", BaseClient, ServiceName);
 
+        /// <summary>
+        /// Gets the name of the function type of the steps of the pipeline requests go through.
+        /// </summary>
+        public string PipelinePolicyTypeName => CodeNamerGo.Instance.GetPipelinePolicyTypeName();
+
+        /// <summary>
+        /// Gets the name of the client field containing the ordered list of pipeline policies.
+        /// </summary>
+        public string PipelineFieldName => "Pipeline";
+
+        /// <summary>
+        /// Gets the name of the client method returning a sender that runs requests through the pipeline.
+        /// </summary>
+        public string PipelineMethodName => "pipeline";
+
+        /// <summary>
+        /// Gets the name of the interface starting the spans tracing operations.
+        /// </summary>
+        public string TracerTypeName => CodeNamerGo.Instance.GetTracerTypeName();
+
+        /// <summary>
+        /// Gets the name of the interface of the spans started by a tracer.
+        /// </summary>
+        public string SpanTypeName => CodeNamerGo.Instance.GetSpanTypeName();
+
+        /// <summary>
+        /// Gets the name of the client field containing the tracer of the client.
+        /// </summary>
+        public string TracerFieldName => "Tracer";
+
+        /// <summary>
+        /// Gets the name of the client method returning the tracer of the client, or the registered one.
+        /// </summary>
+        public string TracerMethodName => "tracer";
+
+        /// <summary>
+        /// Gets the name of the function starting a span and attaching it to a context.
+        /// </summary>
+        public string StartSpanFuncName => "startSpan";
+
+        /// <summary>
+        /// Gets the name of the function starting a child of the span attached to a context.
+        /// </summary>
+        public string StartChildSpanFuncName => "startChildSpan";
+
+        /// <summary>
+        /// Gets the name of the function setting an attribute of the span attached to a context.
+        /// </summary>
+        public string SetSpanAttributeFuncName => "setSpanAttribute";
+
+        /// <summary>
+        /// Gets the name of the function ending the span attached to a context.
+        /// </summary>
+        public string EndSpanFuncName => "endSpan";
+
+        /// <summary>
+        /// Gets the name of the type containing the options passed to NewWithOptions.
+        /// </summary>
+        public string ClientOptionsTypeName => CodeNamerGo.Instance.GetClientOptionsTypeName();
+
+        /// <summary>
+        /// Gets the global parameters that are specified in the client options.
+        /// </summary>
+        public IEnumerable<Property> ClientOptionsParameters => Properties.Where(p => !p.SerializedName.IsApiVersion());
+
+        /// <summary>
+        /// Returns the zero value of the client options field for the specified global parameter, used to
+        /// check if it has been set.  Returns null if an unset field can't be told apart from a set one.
+        /// </summary>
+        /// <param name="p">The global parameter.</param>
+        public static string ClientOptionsZeroValue(Property p)
+        {
+            if (p.ModelType is PrimaryType pt && (p.IsRequired || p.ModelType.CanBeEmpty()))
+            {
+                switch (pt.KnownPrimaryType)
+                {
+                    case KnownPrimaryType.String:
+                        return "\"\"";
+                    case KnownPrimaryType.Double:
+                    case KnownPrimaryType.Int:
+                    case KnownPrimaryType.Long:
+                        return "0";
+                }
+            }
+            return null;
+        }
+
         public IEnumerable<string> ModelImports
         {
             get
@@ -187,6 +310,11 @@ namespace AutoRest.Go.Model
                     {
                         mt.AddImports(imports);
                     });
+                EnumTypes.Cast<EnumTypeGo>()
+                    .ForEach(et =>
+                    {
+                        et.AddImports(imports);
+                    });
                 // if any paged types need a preparer created add the pageable imports
                 if (ModelTypes.Any(mt => mt is PageTypeGo && mt.Cast<PageTypeGo>().PreparerNeeded))
                 {
@@ -200,6 +328,31 @@ namespace AutoRest.Go.Model
 
         public bool ShouldValidate { get; }
 
+        /// <summary>
+        /// Gets true if the optional parameters of operations are passed in per-operation options structs.
+        /// </summary>
+        public bool UseOptionsStructs { get; }
+
+        /// <summary>
+        /// Gets true if the responders check that responses satisfy the constraints defined by the service.
+        /// </summary>
+        public bool ShouldValidateResponses { get; }
+
+        /// <summary>
+        /// Gets true if unmarshaling enums not modeled as strings fails for values other than their possible ones.
+        /// </summary>
+        public bool UseStrictEnums { get; }
+
+        /// <summary>
+        /// Gets true if the Recorder used to record and play back HTTP interactions in tests is generated.
+        /// </summary>
+        public bool GenerateRecorder { get; }
+
+        /// <summary>
+        /// Gets true if enum constants are prefixed with the names of their types, with deprecated aliases for their previous names.
+        /// </summary>
+        public bool UsePrefixedEnumConstants { get; }
+
         public string GlobalParameters
         {
             get
@@ -368,6 +521,116 @@ namespace AutoRest.Go.Model
             return builder.ToString();
         }
 
+        /// <summary>
+        /// Gets the error response types for this code model, one per error model declared as a default response.
+        /// </summary>
+        internal IEnumerable<ErrorResponseTypeGo> ErrorResponseTypes => ModelTypes.OfType<ErrorResponseTypeGo>();
+
+        /// <summary>
+        /// Gets the poll options type for this code model or null if there isn't one.
+        /// </summary>
+        internal PollOptionsTypeGo PollOptionsType => ModelTypes.OfType<PollOptionsTypeGo>().FirstOrDefault();
+
+        /// <summary>
+        /// Gets the resume token type for this code model or null if there isn't one.
+        /// </summary>
+        internal ResumeTokenTypeGo ResumeTokenType => ModelTypes.OfType<ResumeTokenTypeGo>().FirstOrDefault();
+
+        /// <summary>
+        /// Gets the request options type for this code model or null if there isn't one.
+        /// </summary>
+        internal RequestOptionsTypeGo RequestOptionsType => ModelTypes.OfType<RequestOptionsTypeGo>().FirstOrDefault();
+
+        /// <summary>
+        /// Gets the retry policy type for this code model or null if there isn't one.
+        /// </summary>
+        internal RetryPolicyTypeGo RetryPolicyType => ModelTypes.OfType<RetryPolicyTypeGo>().FirstOrDefault();
+
+        /// <summary>
+        /// Gets the log event type for this code model or null if there isn't one.
+        /// </summary>
+        internal LogEventTypeGo LogEventType => ModelTypes.OfType<LogEventTypeGo>().FirstOrDefault();
+
+        /// <summary>
+        /// Gets the multipart options type for this code model or null if there isn't one.
+        /// </summary>
+        internal MultipartOptionsTypeGo MultipartOptionsType => ModelTypes.OfType<MultipartOptionsTypeGo>().FirstOrDefault();
+
+        /// <summary>
+        /// Gets the download result type for this code model or null if there isn't one.
+        /// </summary>
+        internal DownloadResultTypeGo DownloadResultType => ModelTypes.OfType<DownloadResultTypeGo>().FirstOrDefault();
+
+        /// <summary>
+        /// Gets the validation error type for this code model or null if there isn't one.
+        /// </summary>
+        internal ValidationErrorTypeGo ValidationErrorType => ModelTypes.OfType<ValidationErrorTypeGo>().FirstOrDefault();
+
+        /// <summary>
+        /// Gets the response validation type for this code model or null if there isn't one.
+        /// </summary>
+        internal ResponseValidationTypeGo ResponseValidationType => ModelTypes.OfType<ResponseValidationTypeGo>().FirstOrDefault();
+
+        /// <summary>
+        /// Gets true if the DeepCopy method of a model copies values of type interface{}.
+        /// </summary>
+        public bool NeedsDeepCopyObject => ModelTypes.Cast<CompositeTypeGo>()
+            .Any(mt => mt.HasCopyHelpers && mt.DeepCopyStatements(mt.Name.FixedValue.ToVariableName(), "result").Any(s => s.Contains(Extensions.DeepCopyObjectFuncName)));
+
+        /// <summary>
+        /// Gets true if the package has polymorphic types, whose unmarshalers scan the JSON for the discriminator.
+        /// </summary>
+        public bool NeedsUnmarshalDiscriminator => ModelTypes.OfType<UnknownPolymorphicTypeGo>().Any();
+
+        /// <summary>
+        /// Creates an error response type for each model declared as the default response of the operations.
+        /// The model most commonly declared gets the ErrorResponse type, the others are prefixed with their name.
+        /// </summary>
+        internal void CreateErrorResponseTypes()
+        {
+            var errorModels = Methods
+                .Select(m => m.DefaultResponse.Body)
+                .OfType<CompositeTypeGo>()
+                .GroupBy(mt => mt.Name.Value)
+                .OrderByDescending(g => g.Count())
+                .ThenBy(g => g.Key)
+                .Select(g => g.First())
+                .ToList();
+            for (var i = 0; i < errorModels.Count; ++i)
+            {
+                var name = CodeNamerGo.Instance.GetErrorResponseTypeName(i == 0 ? null : errorModels[i].Name.Value);
+                // operations whose error response type collides with a model keep returning an autorest.DetailedError
+                if (!ModelTypes.Any(mt => mt.Name.EqualsIgnoreCase(name)))
+                {
+                    Add(new ErrorResponseTypeGo(this, name, errorModels[i]));
+                }
+            }
+        }
+
+        /// <summary>
+        /// Creates the type describing constraint violations if any of the models
+        /// or operations check constraints, along with the type controlling how
+        /// violations found in responses are reported if any responses are checked.
+        /// </summary>
+        internal void CreateValidationErrorType()
+        {
+            if (ValidationErrorType != null)
+            {
+                return;
+            }
+            var validatesResponses = Methods.Cast<MethodGo>().Any(m => m.ValidatesResponse);
+            if (ModelTypes.Cast<CompositeTypeGo>().Any(mt => mt.HasValidation) ||
+                Methods.Cast<MethodGo>().Any(m => ShouldValidate && !m.ParameterValidations.IsNullOrEmpty()) ||
+                validatesResponses)
+            {
+                Add(new ValidationErrorTypeGo(this));
+            }
+            if (validatesResponses)
+            {
+                Add(new ResponseValidationTypeGo(this));
+            }
+        }
+
         /// <summary>
         /// Creates a pageable type for the specified method and updates its return type.
         /// </summary>
@@ -406,6 +669,18 @@ namespace AutoRest.Go.Model
                 throw new InvalidOperationException("CreateFutureTypeForMethod requires method to be a long-running operation");
             }
 
+            // all futures share the options type used when polling until done
+            if (PollOptionsType == null)
+            {
+                Add(new PollOptionsTypeGo(this));
+            }
+
+            // all futures share the type used to serialize their resume tokens
+            if (ResumeTokenType == null)
+            {
+                Add(new ResumeTokenTypeGo(this));
+            }
+
             // this is the future to return from the method
             var future = GetOrAddFuture(new FutureTypeGo(method));
 
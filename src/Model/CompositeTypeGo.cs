@@ -213,13 +213,140 @@ namespace AutoRest.Go.Model
         /// Add imports for composite types.
         /// </summary>
         /// <param name="imports"></param>
-        public void AddImports(HashSet<string> imports)
+        public virtual void AddImports(HashSet<string> imports)
         {
             Properties.ForEach(p => p.ModelType.AddImports(imports));
             if (IsPolymorphic || HasFlattenedFields || AllProperties.Any(p => p.ModelType is DictionaryTypeGo))
             {
                 imports.Add("\"encoding/json\"");
             }
+            if (HasValidation)
+            {
+                // the constraints of composite properties are checked by their own Validate methods
+                AllProperties.Where(p => !(p.ModelType is CompositeType)).ForEach(p => p.AddValidationImports(imports));
+            }
+            if (HasCopyHelpers)
+            {
+                var receiver = Name.FixedValue.ToVariableName();
+                DeepCopyStatements(receiver, "result").AddCopyImports(imports);
+                EqualStatements(receiver, "other").AddCopyImports(imports);
+                MergeStatements(receiver, "patch").AddCopyImports(imports);
+            }
+            if (this.HasInterface())
+            {
+                // the equal function of the interface compares the values of other types with reflect.DeepEqual
+                imports.Add(PrimaryTypeGo.GetImportLine(package: "reflect"));
+            }
+        }
+
+        /// <summary>
+        /// Gets if the type has a Validate method checking the constraints of its properties.
+        /// </summary>
+        public bool HasValidation => CodeModel is CodeModelGo cmg && (cmg.ShouldValidate || cmg.ShouldValidateResponses) && !IsWrapperType && NeedsValidation(new HashSet<CompositeTypeGo>());
+
+        /// <summary>
+        /// Gets if the type has a validateResponse method checking that the values returned by the service satisfy its constraints.
+        /// </summary>
+        public bool HasResponseValidation => HasResponseOnlyValidation || (CodeModel is CodeModelGo cmg && cmg.ShouldValidateResponses && HasValidation);
+
+        /// <summary>
+        /// Gets if the type has a responseViolations method checking the constraints only checked on values returned by the service.
+        /// </summary>
+        public bool HasResponseOnlyValidation => CodeModel is CodeModelGo cmg && cmg.ShouldValidateResponses && !IsWrapperType && NeedsResponseOnlyValidation(new HashSet<CompositeTypeGo>());
+
+        /// <summary>
+        /// Gets the name of the method checking that the values returned by the service satisfy the type's constraints.
+        /// </summary>
+        public const string ResponseValidationMethodName = "validateResponse";
+
+        /// <summary>
+        /// Gets the name of the method checking the constraints only checked on values returned by the service.
+        /// </summary>
+        public const string ResponseViolationsMethodName = "responseViolations";
+
+        /// <summary>
+        /// Returns true if any of the properties has a constraint to check.  The presence of
+        /// read-only properties is only checked on values returned by the service.
+        /// </summary>
+        /// <param name="visited">The types already inspected, used to break cycles.</param>
+        private bool NeedsValidation(ISet<CompositeTypeGo> visited)
+        {
+            if (!visited.Add(this))
+            {
+                return false;
+            }
+            return AllProperties.Any(p =>
+            {
+                if (p.ModelType is CompositeTypeGo ctg)
+                {
+                    return (p.IsRequired && !p.IsReadOnly) || ctg.NeedsValidation(visited);
+                }
+                if (p.ModelType is PrimaryType || p.ModelType is SequenceType || p.ModelType is DictionaryType)
+                {
+                    return (p.IsRequired && !p.IsReadOnly) || p.Constraints.Any(c => c.IsValidConstraint());
+                }
+                return false;
+            });
+        }
+
+        /// <summary>
+        /// Returns true if any of the properties has a constraint only checked on values returned
+        /// by the service, i.e. a required read-only property or a named enum.
+        /// </summary>
+        /// <param name="visited">The types already inspected, used to break cycles.</param>
+        private bool NeedsResponseOnlyValidation(ISet<CompositeTypeGo> visited)
+        {
+            if (!visited.Add(this))
+            {
+                return false;
+            }
+            return AllProperties.Any(p =>
+                (p.IsRequired && p.IsReadOnly) ||
+                (p.ModelType is EnumTypeGo etg && etg.IsNamed) ||
+                (p.ModelType is CompositeTypeGo ctg && ctg.NeedsResponseOnlyValidation(visited)));
+        }
+
+        /// <summary>
+        /// Returns the statements checking the constraints of the properties.
+        /// </summary>
+        /// <param name="receiver">The name of the method's receiver.</param>
+        /// <param name="forResponse">Pass true for the statements of the responseViolations method, which only
+        /// check the constraints not already checked by the Validate method: enum values and the presence of
+        /// read-only properties.</param>
+        public IEnumerable<string> ValidationStatements(string receiver, bool forResponse = false)
+        {
+            foreach (var p in AllProperties)
+            {
+                var name = $"{receiver}.{p.FieldName}";
+                if (p.ModelType is CompositeType)
+                {
+                    foreach (var statement in p.ValidateCompositeType(name, p.SerializedName, forResponse: forResponse))
+                    {
+                        yield return statement;
+                    }
+                }
+                else if (forResponse && p.ModelType is EnumTypeGo etg && etg.IsNamed)
+                {
+                    foreach (var statement in p.ValidateEnum(name, p.SerializedName))
+                    {
+                        yield return statement;
+                    }
+                }
+                else if (forResponse)
+                {
+                    foreach (var statement in p.ValidatePresence(name, p.SerializedName))
+                    {
+                        yield return statement;
+                    }
+                }
+                else if (p.ModelType is PrimaryType || p.ModelType is SequenceType || p.ModelType is DictionaryType)
+                {
+                    foreach (var statement in p.ValidateType(name, p.SerializedName))
+                    {
+                        yield return statement;
+                    }
+                }
+            }
         }
 
         public string AddHTTPResponse()
@@ -247,19 +374,10 @@ namespace AutoRest.Go.Model
         /// </summary>
         public virtual string Fields()
         {
-            AddPolymorphicPropertyIfNecessary();
-
             var indented = new IndentedStringBuilder("    ");
-            var properties = AllProperties.ToHashSet();
-
-            if (!IsPolymorphic && RootType.IsPolymorphic)
-            {
-                RootType.AddPolymorphicPropertyIfNecessary();
-                properties.Add((PropertyGo)RootType.PolymorphicDiscriminatorProperty);
-            }
 
             // Emit each property, except for named Enumerated types, as a pointer to the type
-            foreach (var property in properties)
+            foreach (var property in FieldProperties())
             {
                 if (property.Deprecated)
                 {
@@ -290,6 +408,143 @@ namespace AutoRest.Go.Model
             return indented.ToString();
         }
 
+        /// <summary>
+        /// Returns the properties emitted as fields, including the discriminator of the root type.
+        /// </summary>
+        private ISet<PropertyGo> FieldProperties()
+        {
+            AddPolymorphicPropertyIfNecessary();
+
+            var properties = AllProperties.ToHashSet();
+
+            if (!IsPolymorphic && RootType.IsPolymorphic)
+            {
+                RootType.AddPolymorphicPropertyIfNecessary();
+                properties.Add((PropertyGo)RootType.PolymorphicDiscriminatorProperty);
+            }
+
+            return properties;
+        }
+
+        /// <summary>
+        /// Gets if the type has the DeepCopy, Equal and MergeFrom methods.  They're only generated for the
+        /// types defined by the service, not for the types synthesized by the generator.
+        /// </summary>
+        public bool HasCopyHelpers => GetType() == typeof(CompositeTypeGo);
+
+        /// <summary>
+        /// Gets the types implementing the type's interface, the unknown variants included.
+        /// </summary>
+        public IEnumerable<CompositeTypeGo> InterfaceImplementations =>
+            DerivedTypes.Cast<CompositeTypeGo>()
+                .ConcatSingleItem(this)
+                .Concat(CodeModel.ModelTypes.OfType<UnknownPolymorphicTypeGo>().Where(u => u.Implements(this)).OrderBy(u => u.Name.Value));
+
+        /// <summary>
+        /// Returns the statements of the DeepCopy method replacing the pointers, slices and maps of a
+        /// shallow copy of the receiver with deep copies.
+        /// </summary>
+        /// <param name="receiver">The name of the DeepCopy method's receiver.</param>
+        /// <param name="result">The name of the variable containing the shallow copy.</param>
+        public IEnumerable<string> DeepCopyStatements(string receiver, string result)
+        {
+            foreach (var p in FieldProperties().Where(p => p.IsPointer || !p.ModelType.IsCopiedByValue()))
+            {
+                var scope = NewCopyScope(receiver, result);
+                foreach (var statement in p.ModelType.DeepCopyValue(p.IsPointer, $"{result}.{p.FieldName}", $"{receiver}.{p.FieldName}", scope))
+                {
+                    yield return statement;
+                }
+            }
+        }
+
+        /// <summary>
+        /// Returns the statements of the equal method returning false if a field of the receiver isn't
+        /// equal to the field of the other value.
+        /// </summary>
+        /// <param name="receiver">The name of the equal method's receiver.</param>
+        /// <param name="other">The name of the value the receiver is compared to.</param>
+        public IEnumerable<string> EqualStatements(string receiver, string other)
+        {
+            foreach (var p in FieldProperties())
+            {
+                var scope = NewCopyScope(receiver, other);
+                var statements = p.ModelType.EqualValue(p.IsPointer, $"{receiver}.{p.FieldName}", $"{other}.{p.FieldName}", scope);
+                if (p.IsReadOnly)
+                {
+                    statements.Insert(0, $"if !{Extensions.IgnoreReadOnlyVariable} {{");
+                    statements.Add("}");
+                }
+                foreach (var statement in statements)
+                {
+                    yield return statement;
+                }
+            }
+        }
+
+        /// <summary>
+        /// Returns the statements of the MergeFrom method.  The nested models and the maps of the patch are
+        /// merged into the receiver's, its other fields replace the receiver's unless they're nil.
+        /// The read-only fields aren't merged.
+        /// </summary>
+        /// <param name="receiver">The name of the MergeFrom method's receiver.</param>
+        /// <param name="patch">The name of the value merged into the receiver.</param>
+        public IEnumerable<string> MergeStatements(string receiver, string patch)
+        {
+            foreach (var p in FieldProperties().Where(p => !p.IsReadOnly))
+            {
+                var scope = NewCopyScope(receiver, patch);
+                var target = $"{receiver}.{p.FieldName}";
+                var source = $"{patch}.{p.FieldName}";
+                List<string> statements;
+                if (p.IsPointer && p.ModelType is CompositeType)
+                {
+                    statements = new List<string>
+                    {
+                        $"if {source} != nil {{",
+                        $"if {target} == nil {{",
+                        $"{target} = &{p.ModelType.Name}{{}}",
+                        "}",
+                        $"{target}.MergeFrom(*{source})",
+                        "}"
+                    };
+                }
+                else if (p.ModelType is DictionaryType dictionaryType)
+                {
+                    statements = dictionaryType.MergeDictionary(target, source, scope);
+                }
+                else if (p.IsPointer)
+                {
+                    statements = p.ModelType.DeepCopyValue(true, target, source, scope);
+                }
+                else
+                {
+                    // the named enums aren't pointers, their zero value is the empty string
+                    statements = p.ModelType.DeepCopyValue(false, target, source, scope);
+                    statements.Insert(0, p.ModelType is EnumType ? $"if {source} != \"\" {{" : $"if {source} != nil {{");
+                    statements.Add("}");
+                }
+                foreach (var statement in statements)
+                {
+                    yield return statement;
+                }
+            }
+        }
+
+        /// <summary>
+        /// Returns a scope for the local variables of the copy statements of a field, which mustn't
+        /// shadow the parameters of the method.
+        /// </summary>
+        private static VariableScopeProvider NewCopyScope(params string[] parameters)
+        {
+            var scope = new VariableScopeProvider();
+            foreach (var parameter in parameters.ConcatSingleItem(Extensions.IgnoreReadOnlyVariable))
+            {
+                scope.GetVariableName(parameter);
+            }
+            return scope;
+        }
+
         private IModelType GetElementType(IModelType type)
         {
             if (type is SequenceTypeGo sequenceType)
@@ -0,0 +1,69 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+using AutoRest.Core.Utilities;
+using System.Collections.Generic;
+
+namespace AutoRest.Go.Model
+{
+    /// <summary>
+    /// Represents the result of operations returning binary content.
+    /// It exposes the response body as a stream along with its content headers.
+    /// </summary>
+    internal class DownloadResultTypeGo : CompositeTypeGo
+    {
+        /// <summary>
+        /// Creates a new download result type for the specified code model.
+        /// </summary>
+        /// <param name="cmg">The code model that will contain the download result type.</param>
+        public DownloadResultTypeGo(CodeModelGo cmg) : base(CodeNamerGo.Instance.GetDownloadResultTypeName())
+        {
+            CodeModel = cmg;
+            Documentation = "Contains the body of a response returning binary content along with its content headers.";
+            IsResponseType = true;
+        }
+
+        /// <summary>
+        /// Gets the name of the method that populates the body and the content headers from the HTTP response.
+        /// </summary>
+        public string ReadMethodName => "readResponse";
+
+        /// <summary>
+        /// Gets the name of the field holding the function that sends a range request for the rest of the content.
+        /// </summary>
+        public string ResumeFieldName => "resume";
+
+        public override void AddImports(HashSet<string> imports)
+        {
+            base.AddImports(imports);
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "context"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "fmt"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "github.com/Azure/go-autorest/autorest"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "io"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "net/http"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "os"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "strconv"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "strings"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "time"));
+        }
+
+        public override string Fields()
+        {
+            var indented = new IndentedStringBuilder("    ");
+            indented.Append("Body - the content.  It must be closed unless it's consumed by WriteTo or DownloadToFile.".ToCommentBlock());
+            indented.AppendLine("Body io.ReadCloser `json:\"-\"`");
+            indented.Append("ContentLength - the length of the content in bytes, or -1 if it isn't known.".ToCommentBlock());
+            indented.AppendLine("ContentLength int64 `json:\"-\"`");
+            indented.Append("ContentType - the media type of the content.".ToCommentBlock());
+            indented.AppendLine("ContentType string `json:\"-\"`");
+            indented.Append("ETag - the entity tag of the content.".ToCommentBlock());
+            indented.AppendLine("ETag string `json:\"-\"`");
+            indented.Append("LastModified - the time the content was last modified, or the zero time if it isn't known.".ToCommentBlock());
+            indented.AppendLine("LastModified time.Time `json:\"-\"`");
+            indented.Append("Progress - if not nil, called by WriteTo and DownloadToFile as the content is written with the number of bytes written so far and the length of the content, or -1 if it isn't known.".ToCommentBlock());
+            indented.AppendLine("Progress func(written, total int64) `json:\"-\"`");
+            indented.AppendLine($"{ResumeFieldName} func(req *http.Request) ({Name}, error)");
+            return indented.ToString();
+        }
+    }
+}
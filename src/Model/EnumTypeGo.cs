@@ -38,5 +38,60 @@ namespace AutoRest.Go.Model
         /// Gets the expression for a zero-initialized enum.
         /// </summary>
         public string ZeroInitExpression => "\"\"";
+
+        /// <summary>
+        /// Gets or sets if this enum contains the values of the discriminator of a polymorphic type.
+        /// </summary>
+        public bool IsDiscriminator { get; set; }
+
+        /// <summary>
+        /// Returns true if unmarshaling this enum fails for values other than its possible ones.
+        /// This is the case for enums not modeled as strings when the --strict-enums flag was specified,
+        /// except for discriminators as the unmarshalers of polymorphic types handle their unknown values.
+        /// </summary>
+        public bool IsStrict => CodeModel is CodeModelGo cmg && cmg.UseStrictEnums && !ModelAsString && !IsDiscriminator && Values.Any();
+
+        /// <summary>
+        /// Gets the names the constants of this enum had before they were prefixed with its name, keyed by the old names.
+        /// They're emitted as deprecated aliases of the constants.
+        /// </summary>
+        public IDictionary<string, string> DeprecatedMemberNames { get; } = new Dictionary<string, string>();
+
+        /// <summary>
+        /// Gets the name of the function returning the possible values of this enum.
+        /// </summary>
+        public string PossibleValuesFuncName => $"Possible{Name}Values";
+
+        /// <summary>
+        /// Gets the name of the variable containing the set of the possible values of this enum.
+        /// </summary>
+        public string KnownValuesVarName => $"known{Name}Values";
+
+        /// <summary>
+        /// Gets the name of the function parsing a value of this enum.
+        /// </summary>
+        public string ParseFuncName => $"Parse{Name}";
+
+        /// <summary>
+        /// Gets the name of the function parsing a value of this enum without regard to case.
+        /// </summary>
+        public string ParseIgnoreCaseFuncName => $"Parse{Name}IgnoreCase";
+
+        /// <summary>
+        /// Adds the imports required by the functions and methods of this enum.
+        /// </summary>
+        public void AddImports(HashSet<string> imports)
+        {
+            if (!Values.Any())
+            {
+                return;
+            }
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "fmt"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "strings"));
+            if (IsStrict)
+            {
+                imports.Add(PrimaryTypeGo.GetImportLine(package: "encoding/json"));
+            }
+        }
     }
 }
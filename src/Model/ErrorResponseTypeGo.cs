@@ -0,0 +1,76 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+using AutoRest.Core.Model;
+using AutoRest.Core.Utilities;
+using System;
+using System.Collections.Generic;
+
+namespace AutoRest.Go.Model
+{
+    /// <summary>
+    /// Represents the error returned from operations that fail with a response containing one of the package's default error models.
+    /// </summary>
+    internal class ErrorResponseTypeGo : CompositeTypeGo
+    {
+        /// <summary>
+        /// Creates a new error response type wrapping the specified error model.
+        /// </summary>
+        /// <param name="cmg">The code model that will contain the error response type.</param>
+        /// <param name="name">The name of the error response type.</param>
+        /// <param name="errorModel">The model declared as the default response of one or more operations.</param>
+        public ErrorResponseTypeGo(CodeModelGo cmg, string name, CompositeTypeGo errorModel) : base(name)
+        {
+            if (errorModel == null)
+            {
+                throw new ArgumentNullException(nameof(errorModel));
+            }
+
+            CodeModel = cmg;
+            Documentation = $"Is returned when an operation fails with a response containing the {errorModel.Name} model.  " +
+                "Unlike the other errors of the operations it isn't wrapped in an autorest.DetailedError.";
+            ErrorModel = errorModel;
+        }
+
+        /// <summary>
+        /// Gets the default error model wrapped by this type.
+        /// </summary>
+        public CompositeTypeGo ErrorModel { get; }
+
+        /// <summary>
+        /// Gets the name of the field containing the error model.
+        /// The error model isn't embedded as that would promote its methods, and its name might collide with the Error() method.
+        /// </summary>
+        public string ModelFieldName => "Model";
+
+        /// <summary>
+        /// Gets the name of the respond decorator that returns this type for unexpected status codes.
+        /// </summary>
+        public string RespondDecoratorName => $"with{Name}UnlessStatusCode";
+
+        public override void AddImports(HashSet<string> imports)
+        {
+            base.AddImports(imports);
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "bytes"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "encoding/json"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "fmt"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "github.com/Azure/go-autorest/autorest"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "io/ioutil"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "net/http"));
+        }
+
+        public override string Fields()
+        {
+            var indented = new IndentedStringBuilder("    ");
+            indented.Append($"{ModelFieldName} - the error model unmarshalled from the response body.".ToCommentBlock());
+            indented.AppendLine($"{ModelFieldName} {ErrorModel.Name}");
+            indented.Append("StatusCode - the HTTP status code of the response.".ToCommentBlock());
+            indented.AppendLine("StatusCode int");
+            indented.Append("Body - the raw response body.".ToCommentBlock());
+            indented.AppendLine("Body []byte");
+            indented.Append("Err - the error encountered reading or unmarshalling the response body, if any.".ToCommentBlock());
+            indented.AppendLine("Err error");
+            return indented.ToString();
+        }
+    }
+}
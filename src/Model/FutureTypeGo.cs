@@ -25,8 +25,11 @@ namespace AutoRest.Go.Model
             CodeModel = method.CodeModel;
             Documentation = "An abstraction for monitoring and retrieving the results of a long-running operation.";
             ClientTypeName = method.Owner;
+            OperationName = method.QualifiedName;
             ResultType = method.ReturnValue().Body;
             ResponderMethodName = method.ResponderMethodName;
+            ErrorResponseType = method.ErrorResponseType;
+            ResumeMethodName = method.ResumeMethodName;
             if (method.Deprecated)
             {
                 DeprecationMessage = "The method for this type has been deprecated.";
@@ -47,8 +50,10 @@ namespace AutoRest.Go.Model
             CodeModel = method.CodeModel;
             Documentation = "An abstraction for monitoring and retrieving the results of a long-running operation.";
             ClientTypeName = method.Owner;
+            OperationName = method.QualifiedName;
             ResultType = method.ReturnValue().Body;
             ResponderMethodName = method.ResponderMethodName;
+            ErrorResponseType = method.ErrorResponseType;
         }
 
         public override string Fields()
@@ -61,6 +66,11 @@ namespace AutoRest.Go.Model
         /// </summary>
         public string ClientTypeName { get; }
 
+        /// <summary>
+        /// Gets the name of the operation returning this future, qualified by the name of its client.
+        /// </summary>
+        public string OperationName { get; }
+
         /// <summary>
         /// Gets the type of the object that's returned when the operation is complete.
         /// If the future doesn't have a response body this will be null.
@@ -93,6 +103,22 @@ namespace AutoRest.Go.Model
         /// </summary>
         public string ResponderMethodName { get; }
 
+        /// <summary>
+        /// Gets the error response type returned from the responder method associated with this future, if any.
+        /// </summary>
+        public ErrorResponseTypeGo ErrorResponseType { get; }
+
+        /// <summary>
+        /// Returns true if the responder method associated with this future returns an error response type.
+        /// </summary>
+        public bool ReturnsErrorResponse => ErrorResponseType != null;
+
+        /// <summary>
+        /// Gets the name of the client method that rebuilds this future from a resume token.
+        /// If the future can't be resumed this will be null.
+        /// </summary>
+        public string ResumeMethodName { get; }
+
         public override bool Equals(object other)
         {
             if (other == null)
@@ -0,0 +1,238 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+using AutoRest.Core.Model;
+using AutoRest.Core.Utilities;
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Text;
+
+namespace AutoRest.Go.Model
+{
+    /// <summary>
+    /// Represents the result of an operation that declares response headers.
+    /// The declared headers are exposed as typed fields, along with the response body if there is one.
+    /// </summary>
+    internal class HeaderResponseTypeGo : CompositeTypeGo
+    {
+        /// <summary>
+        /// Creates a new header response type for the specified method.
+        /// </summary>
+        /// <param name="method">The method that will return the header response type.</param>
+        /// <param name="headers">The type containing the headers declared for the method's responses.</param>
+        public HeaderResponseTypeGo(MethodGo method, CompositeType headers) : base(CodeNamerGo.Instance.GetHeaderResponseTypeName(method))
+        {
+            if (headers == null || !headers.Properties.Any())
+            {
+                throw new InvalidOperationException($"method {method.Owner}.{method.Name} doesn't declare any response headers");
+            }
+
+            CodeModel = method.CodeModel;
+            Documentation = $"Contains the response headers for the {method.Name} operation.";
+            IsResponseType = true;
+            if (method.HasReturnValue())
+            {
+                if (!CanContainBody(method.ReturnType.Body))
+                {
+                    throw new InvalidOperationException($"the response body of method {method.Owner}.{method.Name} can't be exposed along with its headers");
+                }
+
+                var body = (CompositeTypeGo)method.ReturnType.Body;
+                Documentation = $"Contains the response body and headers for the {method.Name} operation.";
+                // wrapper types are unwrapped so the body is accessed the same way as from the wrapper
+                BodyField = body.IsWrapperType
+                    ? body.Properties.Cast<PropertyGo>().Single(p => p.Name == "Value")
+                    : new PropertyGo { Name = "Value", SerializedName = "value", ModelType = body };
+            }
+            foreach (var header in headers.Properties)
+            {
+                base.Add(new PropertyGo
+                {
+                    Name = CodeNamerGo.Instance.GetPropertyName(header.SerializedName),
+                    SerializedName = header.SerializedName,
+                    ModelType = header.ModelType,
+                    Documentation = header.Documentation
+                });
+            }
+            if (method.Deprecated)
+            {
+                DeprecationMessage = "The method for this type has been deprecated.";
+            }
+        }
+
+        /// <summary>
+        /// Gets the name of the method that populates the fields from the HTTP response headers.
+        /// </summary>
+        public string UnmarshalMethodName => "unmarshalHeaders";
+
+        /// <summary>
+        /// Gets the headers contained in this type.
+        /// </summary>
+        public IEnumerable<PropertyGo> Headers => Properties.Cast<PropertyGo>();
+
+        /// <summary>
+        /// Gets the field containing the response body, or null if the operation doesn't return a body.
+        /// </summary>
+        public PropertyGo BodyField { get; }
+
+        /// <summary>
+        /// Returns true if the specified response body can be exposed along with the response headers.
+        /// Streams, polymorphic models and models whose responses are validated keep their own result types.
+        /// </summary>
+        /// <param name="body">The type of the response body.</param>
+        public static bool CanContainBody(IModelType body)
+        {
+            return body is CompositeTypeGo ctg && !ctg.IsStreamType() && !(ctg is MultiResponseTypeGo) &&
+                !ctg.IsPolymorphic && !ctg.HasPolymorphicFields && !ctg.HasResponseValidation;
+        }
+
+        public override void AddImports(HashSet<string> imports)
+        {
+            base.AddImports(imports);
+            BodyField?.ModelType.AddImports(imports);
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "net/http"));
+            foreach (var header in Headers)
+            {
+                if (RequiresParsing(header))
+                {
+                    imports.Add(PrimaryTypeGo.GetImportLine(package: "fmt"));
+                }
+                if (header.ModelType is PrimaryTypeGo ptg)
+                {
+                    switch (ptg.KnownPrimaryType)
+                    {
+                        case KnownPrimaryType.Boolean:
+                        case KnownPrimaryType.Double:
+                        case KnownPrimaryType.Int:
+                        case KnownPrimaryType.Long:
+                            imports.Add(PrimaryTypeGo.GetImportLine(package: "strconv"));
+                            break;
+                        case KnownPrimaryType.ByteArray:
+                            imports.Add(PrimaryTypeGo.GetImportLine(package: "encoding/base64"));
+                            break;
+                    }
+                }
+            }
+        }
+
+        /// <summary>
+        /// Returns true if the raw header value must be parsed into the field's type.
+        /// </summary>
+        private static bool RequiresParsing(PropertyGo header)
+        {
+            if (header.ModelType is PrimaryTypeGo ptg)
+            {
+                switch (ptg.KnownPrimaryType)
+                {
+                    case KnownPrimaryType.Boolean:
+                    case KnownPrimaryType.Double:
+                    case KnownPrimaryType.Int:
+                    case KnownPrimaryType.Long:
+                    case KnownPrimaryType.ByteArray:
+                    case KnownPrimaryType.Date:
+                    case KnownPrimaryType.DateTime:
+                    case KnownPrimaryType.DateTimeRfc1123:
+                    case KnownPrimaryType.UnixTime:
+                        return true;
+                }
+            }
+            return false;
+        }
+
+        public override string Fields()
+        {
+            var indented = new IndentedStringBuilder("    ");
+            if (BodyField != null)
+            {
+                indented.Append($"{BodyField.Name} - the response body.".ToCommentBlock());
+                var bodyTypeName = BodyField.ModelType.Name.Value;
+                indented.AppendLine(BodyField.IsPointer ? $"{BodyField.Name} *{bodyTypeName}" : $"{BodyField.Name} {bodyTypeName}");
+            }
+            foreach (var header in Headers)
+            {
+                if (!string.IsNullOrWhiteSpace(header.Documentation))
+                {
+                    indented.Append($"{header.Name} - {header.Documentation}".ToCommentBlock());
+                }
+                var typeName = header.ModelType.Name.Value;
+                indented.AppendLine(header.IsPointer ? $"{header.Name} *{typeName}" : $"{header.Name} {typeName}");
+            }
+            return indented.ToString();
+        }
+
+        /// <summary>
+        /// Returns the code that parses the specified header value and assigns it to its field.
+        /// </summary>
+        /// <param name="header">The header to unmarshal.</param>
+        /// <param name="receiver">The name of the receiver variable.</param>
+        /// <param name="rawValue">The name of the variable containing the raw header value.</param>
+        public string UnmarshalHeader(PropertyGo header, string receiver, string rawValue)
+        {
+            var target = $"{receiver}.{header.Name}";
+            var sb = new StringBuilder();
+            if (header.ModelType is EnumTypeGo etg)
+            {
+                if (etg.IsNamed)
+                {
+                    sb.AppendLine($"{target} = {etg.Name}({rawValue})");
+                }
+                else
+                {
+                    sb.AppendLine($"{target} = &{rawValue}");
+                }
+                return sb.ToString();
+            }
+
+            var ptg = header.ModelType as PrimaryTypeGo;
+            if (ptg == null)
+            {
+                throw new NotImplementedException($"unmarshalling headers of type {header.ModelType.Name} NYI");
+            }
+
+            if (!RequiresParsing(header))
+            {
+                // strings, durations etc are passed through as-is
+                sb.AppendLine($"{target} = &{rawValue}");
+                return sb.ToString();
+            }
+
+            string parse;
+            var value = "value";
+            switch (ptg.KnownPrimaryType)
+            {
+                case KnownPrimaryType.Boolean:
+                    parse = $"{value}, err := strconv.ParseBool({rawValue})";
+                    break;
+                case KnownPrimaryType.Double:
+                    parse = $"{value}, err := strconv.ParseFloat({rawValue}, 64)";
+                    break;
+                case KnownPrimaryType.Int:
+                    parse = $"{value}, err := strconv.ParseInt({rawValue}, 10, 32)";
+                    break;
+                case KnownPrimaryType.Long:
+                    parse = $"{value}, err := strconv.ParseInt({rawValue}, 10, 64)";
+                    break;
+                case KnownPrimaryType.ByteArray:
+                    parse = $"{value}, err := base64.StdEncoding.DecodeString({rawValue})";
+                    break;
+                default:
+                    // all of the date/time types implement encoding.TextUnmarshaler
+                    parse = $"var {value} {ptg.Name}\nerr := {value}.UnmarshalText([]byte({rawValue}))";
+                    break;
+            }
+            sb.AppendLine(parse);
+            sb.AppendLine("if err != nil {");
+            sb.AppendLine($"return fmt.Errorf(\"failed to parse header {header.SerializedName}: %v\", err)");
+            sb.AppendLine("}");
+            if (ptg.KnownPrimaryType == KnownPrimaryType.Int)
+            {
+                // ParseInt always returns an int64
+                sb.AppendLine($"i32 := int32({value})");
+                value = "i32";
+            }
+            sb.AppendLine(header.IsPointer ? $"{target} = &{value}" : $"{target} = {value}");
+            return sb.ToString();
+        }
+    }
+}
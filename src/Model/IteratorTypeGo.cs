@@ -6,6 +6,7 @@ using AutoRest.Core.Utilities;
 using AutoRest.Extensions.Azure;
 using AutoRest.Go;
 using System;
+using System.Collections.Generic;
 using System.Linq;
 
 namespace AutoRest.Go.Model
@@ -46,6 +47,12 @@ namespace AutoRest.Go.Model
         /// </summary>
         public string PageField => "page";
 
+        public override void AddImports(HashSet<string> imports)
+        {
+            base.AddImports(imports);
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "errors"));
+        }
+
         public override string Fields()
         {
             return $"    {IndexField} int\n    {PageField} {PageType.Name}";
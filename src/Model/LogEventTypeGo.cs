@@ -0,0 +1,91 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+using AutoRest.Core.Utilities;
+using System.Collections.Generic;
+
+namespace AutoRest.Go.Model
+{
+    /// <summary>
+    /// Represents the structured events logged by the operations of a client.
+    /// The Logger interface receiving them and the helpers used to log them are emitted along with it.
+    /// </summary>
+    internal class LogEventTypeGo : CompositeTypeGo
+    {
+        /// <summary>
+        /// Creates a new log event type for the specified code model.
+        /// </summary>
+        /// <param name="cmg">The code model that will contain the log event type.</param>
+        public LogEventTypeGo(CodeModelGo cmg) : base(CodeNamerGo.Instance.GetLogEventTypeName())
+        {
+            CodeModel = cmg;
+            Documentation = $"Is an event logged by the operations of a client when its {InterfaceName} isn't nil.  The URLs and headers of the requests aren't included as they can contain secrets.";
+        }
+
+        /// <summary>
+        /// Gets the name of the interface receiving the events.
+        /// </summary>
+        public string InterfaceName => CodeNamerGo.Instance.GetLoggerTypeName();
+
+        /// <summary>
+        /// Gets the name of the constant replacing the values of secret parameters.
+        /// </summary>
+        public string RedactedValueName => "redactedLogValue";
+
+        /// <summary>
+        /// Gets the name of the function logging the start of an operation.
+        /// </summary>
+        public string StartFuncName => "startOperationLog";
+
+        /// <summary>
+        /// Gets the name of the function attaching the logging state of an operation to a context.
+        /// </summary>
+        public string WithLogFuncName => "withOperationLog";
+
+        /// <summary>
+        /// Gets the name of the function logging an event along with the status code of a response.
+        /// </summary>
+        public string LogResponseFuncName => "logResponse";
+
+        /// <summary>
+        /// Gets the name of the swagger extension marking a parameter as a secret.
+        /// </summary>
+        public static string SecretExtension => "x-ms-secret";
+
+        public override void AddImports(HashSet<string> imports)
+        {
+            base.AddImports(imports);
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "context"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "fmt"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "net/http"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "reflect"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "sort"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "strings"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "time"));
+        }
+
+        public override string Fields()
+        {
+            var indented = new IndentedStringBuilder("    ");
+            indented.Append("Kind - the kind of the event.".ToCommentBlock());
+            indented.AppendLine("Kind LogEventKind");
+            indented.Append("Operation - the name of the operation, qualified by the name of its client.".ToCommentBlock());
+            indented.AppendLine("Operation string");
+            indented.Append("Fields - the parameters of the operation keyed by their names in the request, for LogOperationStart events.  Body parameters aren't included and the values of secret parameters are replaced with REDACTED.".ToCommentBlock());
+            indented.AppendLine("Fields map[string]interface{}");
+            indented.Append("Attempt - the number of the failed attempt, starting at one, for LogRetry events.".ToCommentBlock());
+            indented.AppendLine("Attempt int");
+            indented.Append("Delay - the delay before the request is sent again, for LogRetry events.".ToCommentBlock());
+            indented.AppendLine("Delay time.Duration");
+            indented.Append("State - the status of the long-running operation, for LogPoll events.".ToCommentBlock());
+            indented.AppendLine("State string");
+            indented.Append("StatusCode - the status code of the response, or zero if none was received.".ToCommentBlock());
+            indented.AppendLine("StatusCode int");
+            indented.Append("Elapsed - the time elapsed since the operation, the page fetch or the polling started.".ToCommentBlock());
+            indented.AppendLine("Elapsed time.Duration");
+            indented.Append("Err - the error the operation, the attempt or the poll failed with, if any.".ToCommentBlock());
+            indented.AppendLine("Err error");
+            return indented.ToString();
+        }
+    }
+}
@@ -22,6 +22,11 @@ namespace AutoRest.Go.Model
     {
         internal const string DefaultReturnType = "autorest.Response";
 
+        /// <summary>
+        /// The name of the parameter containing the optional parameters when options structs are used.
+        /// </summary>
+        internal const string OptionsParameterName = "options";
+
         public string Owner { get; private set; }
 
         public string PackageName { get; private set; }
@@ -30,6 +35,12 @@ namespace AutoRest.Go.Model
 
         public bool NextAlreadyDefined { get; private set; }
 
+        /// <summary>
+        /// Gets the type containing the optional parameters for this method.
+        /// If the optional parameters are passed positionally this will be null.
+        /// </summary>
+        internal OptionsTypeGo OptionsType { get; set; }
+
         /// <summary>
         /// The method name qualified with the client name
         /// </summary>
@@ -114,12 +125,17 @@ namespace AutoRest.Go.Model
         {
             var declarations = new List<string> { "ctx context.Context" };
             LocalParameters
+                .Where(p => !p.IsOptionsField)
                 .ForEach(p => declarations.Add(string.Format(
                                                     p.IsRequired || p.ModelType.CanBeEmpty()
                                                         ? "{0} {1}"
                                                         : "{0} *{1}", p.Name, p.ModelType.HasInterface()
                                                             ? p.ModelType.GetInterfaceName(includePkgName)
                                                             : ParameterTypeSig(p.ModelType, includePkgName))));
+            if (OptionsType != null)
+            {
+                declarations.Add($"{OptionsParameterName} *{(includePkgName ? $"{CodeModel.Namespace}." : "")}{OptionsType.Name}");
+            }
             return string.Join(", ", declarations);
         }
 
@@ -198,18 +214,35 @@ namespace AutoRest.Go.Model
         /// <returns>The "next results" method parameter type name.</returns>
         public string LastResultsTypeName()
         {
-            var type = ReturnValue().Body;
-            if (IsLongRunningOperation())
-            {
-                type = type.Cast<FutureTypeGo>().ResultType;
-            }
-            if (IsPageable && !IsNextMethod)
+            return ResponderResultType.Name;
+        }
+
+        /// <summary>
+        /// Gets the type of the result returned by the responder method.
+        /// </summary>
+        private IModelType ResponderResultType
+        {
+            get
             {
-                type = type.Cast<PageTypeGo>().ContentType;
+                var type = ReturnValue().Body;
+                if (IsLongRunningOperation())
+                {
+                    type = type.Cast<FutureTypeGo>().ResultType;
+                }
+                if (IsPageable && !IsNextMethod)
+                {
+                    type = type.Cast<PageTypeGo>().ContentType;
+                }
+                return type;
             }
-            return type.Name;
         }
 
+        /// <summary>
+        /// Gets true if the responder method checks that the response satisfies the constraints defined by the service.
+        /// </summary>
+        public bool ValidatesResponse => HasReturnValue() && !(IsLongRunningOperation() && IsPageable) &&
+            ResponderResultType is CompositeTypeGo ctg && ctg.HasResponseValidation;
+
         public string NextMethodName => $"{Name.ToCamelCase()}NextResults";
 
         public string PreparerMethodName => $"{Name}Preparer";
@@ -220,14 +253,22 @@ namespace AutoRest.Go.Model
 
         public string ListCompleteMethodName => $"{Name}Complete";
 
+        public string ResumeMethodName => $"Resume{Name}";
+
+        public string FromTokenMethodName => $"{Name}FromToken";
+
         public string HelperInvocationParameters()
         {
             var invocationParams = new List<string> { "ctx" };
 
-            foreach (ParameterGo p in LocalParameters)
+            foreach (ParameterGo p in LocalParameters.Where(p => !p.IsOptionsField))
             {
                 invocationParams.Add(p.Name);
             }
+            if (OptionsType != null)
+            {
+                invocationParams.Add(OptionsParameterName);
+            }
             return string.Join(", ", invocationParams);
         }
 
@@ -238,6 +279,11 @@ namespace AutoRest.Go.Model
         /// <returns>The params string, e.g. "ctx, foo, nextLink".</returns>
         public string NextMethodInvocationParameters(string nextLink)
         {
+            if (((CodeModelGo)CodeModel).UseOptionsStructs)
+            {
+                return NextMethodInvocationParametersWithOptions(nextLink);
+            }
+
             // some next methods take the same params as the "list initial" method plus
             // the next link param.  so if the param counts match assume this is the case.
             // to date, the only place where this appears is in the autorest tests.
@@ -293,6 +339,51 @@ namespace AutoRest.Go.Model
             return string.Join(", ", invocationParams);
         }
 
+        /// <summary>
+        /// Calculates the args to be passed to the "next method" when optional parameters are passed in options structs.
+        /// </summary>
+        /// <param name="nextLink">The arg to be passed in the "next link" param.</param>
+        /// <returns>The params string, e.g. "ctx, foo, nextLink, &amp;NextOptions{Bar: options.Bar}".</returns>
+        private string NextMethodInvocationParametersWithOptions(string nextLink)
+        {
+            var invocationParams = new List<string> { "ctx" };
+            var optionsFields = new List<string>();
+            foreach (var nextParam in NextMethod.LocalParameters)
+            {
+                var value = nextLink;
+                var isPointer = false;
+                if (!nextParam.Name.EqualsIgnoreCase("nextlink"))
+                {
+                    var param = LocalParameters.FirstOrDefault(p => ParameterGo.Match(p, nextParam));
+                    if (param == null)
+                    {
+                        throw new Exception("failed to find a matching local parameter");
+                    }
+                    value = param.ValueReference;
+                    isPointer = !param.IsRequired && !param.ModelType.CanBeEmpty();
+                }
+
+                if (nextParam.IsOptionsField)
+                {
+                    if (!isPointer && !nextParam.ModelType.CanBeEmpty())
+                    {
+                        value = $"&{value}";
+                    }
+                    optionsFields.Add($"{nextParam.OptionsFieldName}: {value}");
+                }
+                else
+                {
+                    invocationParams.Add(value);
+                }
+            }
+
+            if (NextMethod.OptionsType != null)
+            {
+                invocationParams.Add($"&{NextMethod.OptionsType.Name}{{{string.Join(", ", optionsFields)}}}");
+            }
+            return string.Join(", ", invocationParams);
+        }
+
         /// <summary>
         /// Return the parameters as they appear in the method signature excluding global parameters.
         /// </summary>
@@ -315,10 +406,21 @@ namespace AutoRest.Go.Model
 
         public IEnumerable<ParameterGo> FormDataParameters => ParametersGo.FormDataParameters();
 
+        /// <summary>
+        /// Gets true if the form data parameters include files, requiring a multipart/form-data request body.
+        /// </summary>
+        public bool IsMultipartFormData => FormDataParameters.Any(p => p.ModelType.PrimaryType(KnownPrimaryType.Stream));
+
         public IEnumerable<ParameterGo> HeaderParameters => ParametersGo.HeaderParameters();
 
         public IEnumerable<ParameterGo> OptionalHeaderParameters => ParametersGo.HeaderParameters(false);
 
+        /// <summary>
+        /// Gets true if the method returns binary content and declares a Range header, allowing interrupted downloads to be resumed.
+        /// </summary>
+        public bool SupportsRangeRequests => HasReturnValue() && ReturnValue().Body is DownloadResultTypeGo &&
+            HeaderParameters.Any(p => p.SerializedName.EqualsIgnoreCase("Range"));
+
         public IEnumerable<ParameterGo> URLParameters => ParametersGo.URLParameters();
 
         public string URLMap => URLParameters.BuildParameterMap("urlParameters");
@@ -404,8 +506,8 @@ namespace AutoRest.Go.Model
                 if (FormDataParameters.Any())
                 {
                     decorators.Add(
-                        FormDataParameters.Any(p => p.ModelType.PrimaryType(KnownPrimaryType.Stream))
-                            ? "autorest.WithMultiPartFormData(formDataParameters)"
+                        IsMultipartFormData
+                            ? $"{((CodeModelGo)CodeModel).MultipartOptionsType.PrepareDecoratorName}(formDataParameters)"
                             : "autorest.WithFormData(autorest.MapToValues(formDataParameters))"
                         );
                 }
@@ -435,6 +537,11 @@ namespace AutoRest.Go.Model
             }
         }
 
+        /// <summary>
+        /// Gets the HTTP method of the operation in upper case, e.g. GET.
+        /// </summary>
+        public string HttpMethodName => HttpMethod.ToString().ToUpperInvariant();
+
         public string HTTPMethodDecorator
         {
             get
@@ -454,6 +561,26 @@ namespace AutoRest.Go.Model
             }
         }
 
+        /// <summary>
+        /// Gets true if the service marks the operation as safe to retry regardless of its HTTP method.
+        /// </summary>
+        public bool IsMarkedIdempotent => Extensions.ContainsKey(RetryPolicyTypeGo.IdempotentExtension) && (bool)Extensions[RetryPolicyTypeGo.IdempotentExtension];
+
+        /// <summary>
+        /// Gets the entries of the map of parameters logged when the operation starts, keyed by their names in the request.
+        /// Body and form data parameters aren't logged and the values of secret parameters are redacted.
+        /// </summary>
+        public IEnumerable<string> LogFields => LocalParameters
+            .Where(p => p.Location != ParameterLocation.Body && p.Location != ParameterLocation.FormData)
+            .Select(p => $"\"{p.SerializedName}\": {(p.IsSecret ? ((CodeModelGo)CodeModel).LogEventType.RedactedValueName : p.ValueReference)},");
+
+        /// <summary>
+        /// Gets the expression evaluating to the HTTP response of the operation, which can be nil.
+        /// </summary>
+        public string RawResponseExpression => IsLongRunningOperation()
+            ? "result.Response()"
+            : HasReturnValue() ? $"{ResponseAssignTarget}.Response.Response" : $"{ResponseAssignTarget}.Response";
+
         public IEnumerable<string> SendDecorators
         {
             get
@@ -462,7 +589,7 @@ namespace AutoRest.Go.Model
                 {
                     RegisterRP
                         ? "azure.DoRetryWithRegistration(client.Client)"
-                        : "autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...)"
+                        : ((CodeModelGo)CodeModel).RetryPolicyType.SendDecorator(IsMarkedIdempotent)
                 };
                 return decorators;
             }
@@ -476,10 +603,24 @@ namespace AutoRest.Go.Model
                 {
                     "resp",
                     "client.ByInspecting()",
-                    string.Format("azure.WithErrorUnlessStatusCode({0})", string.Join(",", ResponseCodes.ToArray()))
+                    ReturnsErrorResponse
+                        ? string.Format("{0}({1})", ErrorResponseType.RespondDecoratorName, string.Join(",", ResponseCodes.ToArray()))
+                        : string.Format("azure.WithErrorUnlessStatusCode({0})", string.Join(",", ResponseCodes.ToArray()))
                 };
 
-                if (HasReturnValue() && !ReturnValue().Body.IsStreamType() && !LroWrapsDefaultResp())
+                if (ReturnsMultiResponse)
+                {
+                    decorators.Add($"result.{ReturnValue().Body.Cast<MultiResponseTypeGo>().ByUnmarshallingBodyMethodName}(resp.StatusCode)");
+                }
+                else if (ReturnsHeaderResponse)
+                {
+                    var bodyField = ReturnValue().Body.Cast<HeaderResponseTypeGo>().BodyField;
+                    if (bodyField != null)
+                    {
+                        decorators.Add($"autorest.ByUnmarshallingJSON(&result.{bodyField.Name})");
+                    }
+                }
+                else if (HasReturnValue() && !ReturnValue().Body.IsStreamType() && !LroWrapsDefaultResp())
                 {
                     if (((CompositeTypeGo)ReturnValue().Body).IsWrapperType && !((CompositeTypeGo)ReturnValue().Body).HasPolymorphicFields)
                     {
@@ -499,6 +640,29 @@ namespace AutoRest.Go.Model
             }
         }
 
+        /// <summary>
+        /// Returns true if the method's result type only contains response headers.
+        /// </summary>
+        public bool ReturnsHeaderResponse => HasReturnValue() && ReturnValue().Body is HeaderResponseTypeGo;
+
+        /// <summary>
+        /// Returns true if the method's result type contains a field per response schema.
+        /// </summary>
+        public bool ReturnsMultiResponse => HasReturnValue() && ReturnValue().Body is MultiResponseTypeGo;
+
+        /// <summary>
+        /// Gets the error response type returned when the method fails with a response containing
+        /// its default error model, or null if the method returns an autorest.DetailedError instead.
+        /// </summary>
+        public ErrorResponseTypeGo ErrorResponseType => DefaultResponse.Body is CompositeTypeGo errorModel
+            ? ((CodeModelGo)CodeModel).ErrorResponseTypes.FirstOrDefault(ert => ert.ErrorModel.Name.Value == errorModel.Name.Value)
+            : null;
+
+        /// <summary>
+        /// Returns true if the method returns an error response type when it fails with a response containing its default error model.
+        /// </summary>
+        public bool ReturnsErrorResponse => ErrorResponseType != null;
+
         /// <summary>
         /// Returns true if the future response wraps a default response type.
         /// We need to make this distinction because the default response doesn't
@@ -570,7 +734,7 @@ namespace AutoRest.Go.Model
                                  : string.Format("autorest.NewErrorWithError(err, \"{0}.{1}\", \"{2}\", {3}, \"{4}\")", PackageName, Owner, methodName, response, phase);
         }
 
-        public string ValidationError => $"validation.NewError(\"{PackageName}.{Owner}\", \"{Name}\", err.Error())";
+        public string ValidationError => $"{((CodeModelGo)CodeModel).ValidationErrorType.ParameterErrorTypeName}{{Err: validation.NewError(\"{PackageName}.{Owner}\", \"{Name}\", {Extensions.ValidationErrorsVariable}.Error()), Errors: {Extensions.ValidationErrorsVariable}}}";
 
         /// <summary>
         /// Check if method has a return response.
@@ -73,7 +73,6 @@ namespace AutoRest.Go.Model
             var imports = new HashSet<string>();
             imports.UnionWith(CodeNamerGo.Instance.AutorestImports);
             imports.UnionWith(CodeNamerGo.Instance.StandardImports);
-            imports.UnionWith(CodeNamerGo.Instance.TracingImports);
 
 
             cmg.Methods.Where(m => m.Group.Value == Name)
@@ -83,8 +82,10 @@ namespace AutoRest.Go.Model
                     if ((CodeModel as CodeModelGo).ShouldValidate && !mg.ParameterValidations.IsNullOrEmpty())
                     {
                         imports.UnionWith(CodeNamerGo.Instance.ValidationImports);
+                        mg.ParametersGo.AddValidationImports(mg.HttpMethod, imports);
                     }
-                    mg.ParametersGo.ForEach(p => p.AddImports(imports));
+                    // the types of options fields are only referenced by the options structs
+                    mg.ParametersGo.Where(p => !p.IsOptionsField).ForEach(p => p.AddImports(imports));
                     if (mg.HasReturnValue() && !mg.ReturnValue().Body.PrimaryType(KnownPrimaryType.Stream))
                     {
                         mg.ReturnType.Body.AddImports(imports);
@@ -100,6 +101,12 @@ namespace AutoRest.Go.Model
                 p.ModelType.AddImports(imports);
             }
 
+            // the azure package is only required for LROs and responders that don't return an ErrorResponse
+            if (cmg.Methods.Cast<MethodGo>().Where(m => m.Group.Value == Name).All(m => m.ReturnsErrorResponse && !m.IsLongRunningOperation()))
+            {
+                imports.Remove(PrimaryTypeGo.GetImportLine(package: "github.com/Azure/go-autorest/autorest/azure"));
+            }
+
             imports.OrderBy(i => i);
             Imports = imports;
         }
@@ -0,0 +1,98 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+using AutoRest.Core.Model;
+using AutoRest.Core.Utilities;
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Net;
+
+namespace AutoRest.Go.Model
+{
+    /// <summary>
+    /// Represents the result of an operation whose successful responses have different schemas.
+    /// Each schema is exposed as a field that's populated based on the response's status code.
+    /// </summary>
+    internal class MultiResponseTypeGo : CompositeTypeGo
+    {
+        private readonly Dictionary<HttpStatusCode, PropertyGo> _fieldsByStatusCode = new Dictionary<HttpStatusCode, PropertyGo>();
+
+        /// <summary>
+        /// Creates a new multi-response type for the specified method.
+        /// </summary>
+        /// <param name="method">The method that will return the multi-response type.</param>
+        public MultiResponseTypeGo(MethodGo method) : base(CodeNamerGo.Instance.GetMultiResponseTypeName(method))
+        {
+            if (!HasMultipleResponseSchemas(method))
+            {
+                throw new InvalidOperationException($"method {method.Owner}.{method.Name} doesn't have multiple response schemas");
+            }
+
+            CodeModel = method.CodeModel;
+            Documentation = $"Contains the result of the {method.Name} operation.";
+            IsResponseType = true;
+            foreach (var sc in method.Responses.Keys.OrderBy(k => (int)k))
+            {
+                var body = method.Responses[sc].Body;
+                if (body == null)
+                {
+                    continue;
+                }
+                // status codes that share a schema also share a field
+                var field = _fieldsByStatusCode.Values.FirstOrDefault(p => p.ModelType.Equals(body));
+                if (field == null)
+                {
+                    field = new PropertyGo
+                    {
+                        Name = body is CompositeType ? body.Name.Value : CodeNamerGo.Instance.GetPropertyName($"{sc}Value"),
+                        ModelType = body
+                    };
+                    base.Add(field);
+                }
+                _fieldsByStatusCode.Add(sc, field);
+            }
+            if (method.Deprecated)
+            {
+                DeprecationMessage = "The method for this type has been deprecated.";
+            }
+        }
+
+        /// <summary>
+        /// Returns true if the successful responses for the specified method have more than one schema.
+        /// </summary>
+        public static bool HasMultipleResponseSchemas(MethodGo method)
+        {
+            return method.Responses.Values.Where(r => r.Body != null).Select(r => r.Body).Distinct().Count() > 1;
+        }
+
+        /// <summary>
+        /// Gets the name of the method that returns the RespondDecorator unmarshalling the response body.
+        /// </summary>
+        public string ByUnmarshallingBodyMethodName => "byUnmarshallingBody";
+
+        /// <summary>
+        /// Gets the fields keyed by the status code for which they're populated.
+        /// </summary>
+        public IReadOnlyDictionary<HttpStatusCode, PropertyGo> FieldsByStatusCode => _fieldsByStatusCode;
+
+        public override void AddImports(HashSet<string> imports)
+        {
+            base.AddImports(imports);
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "net/http"));
+        }
+
+        public override string Fields()
+        {
+            var indented = new IndentedStringBuilder("    ");
+            foreach (PropertyGo field in Properties)
+            {
+                var codes = _fieldsByStatusCode.Where(kv => kv.Value == field).Select(kv => ((int)kv.Key).ToString());
+                indented.Append($"{field.Name} - populated when the status code is {string.Join(" or ", codes)}.".ToCommentBlock());
+                var typeName = field.ModelType.Name.Value;
+                indented.AppendLine(field.IsPointer ? $"{field.Name} *{typeName}" : $"{field.Name} {typeName}");
+            }
+            return indented.ToString();
+        }
+    }
+}
@@ -0,0 +1,62 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+using AutoRest.Core.Utilities;
+using System.Collections.Generic;
+
+namespace AutoRest.Go.Model
+{
+    /// <summary>
+    /// Represents the per-call options applied to the streaming multipart/form-data request bodies of operations.
+    /// </summary>
+    internal class MultipartOptionsTypeGo : CompositeTypeGo
+    {
+        /// <summary>
+        /// Creates a new multipart options type for the specified code model.
+        /// </summary>
+        /// <param name="cmg">The code model that will contain the multipart options type.</param>
+        public MultipartOptionsTypeGo(CodeModelGo cmg) : base(CodeNamerGo.Instance.GetMultipartOptionsTypeName())
+        {
+            CodeModel = cmg;
+            Documentation = $"Contains the options applied to the multipart/form-data request bodies of operations whose context was created with {WithFuncName}.";
+        }
+
+        /// <summary>
+        /// Gets the name of the function that attaches multipart options to a context.
+        /// </summary>
+        public string WithFuncName => $"With{Name}";
+
+        /// <summary>
+        /// Gets the name of the prepare decorator that streams the form data parameters as the request body.
+        /// </summary>
+        public string PrepareDecoratorName => "withMultipartFormData";
+
+        public override void AddImports(HashSet<string> imports)
+        {
+            base.AddImports(imports);
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "context"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "fmt"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "github.com/Azure/go-autorest/autorest"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "io"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "io/ioutil"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "mime/multipart"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "net/http"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "net/textproto"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "sort"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "strings"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "sync"));
+        }
+
+        public override string Fields()
+        {
+            var indented = new IndentedStringBuilder("    ");
+            indented.Append("ContentLength - the lengths in bytes of the file parts, keyed by form field name.  The Content-Length of the request is only set if the lengths of all its file parts are known.".ToCommentBlock());
+            indented.AppendLine("ContentLength map[string]int64");
+            indented.Append("ContentType - the content types of the parts, keyed by form field name.  File parts default to application/octet-stream.".ToCommentBlock());
+            indented.AppendLine("ContentType map[string]string");
+            indented.Append("Progress - if not nil, called as the request body is sent with the number of bytes sent so far and the length of the body, or -1 if it isn't known.".ToCommentBlock());
+            indented.AppendLine("Progress func(sent, total int64)");
+            return indented.ToString();
+        }
+    }
+}
@@ -0,0 +1,65 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+using AutoRest.Core.Utilities;
+using System;
+using System.Collections.Generic;
+using System.Linq;
+
+namespace AutoRest.Go.Model
+{
+    /// <summary>
+    /// Represents the optional parameters of an operation, passed as a single argument instead of positionally.
+    /// </summary>
+    internal class OptionsTypeGo : CompositeTypeGo
+    {
+        /// <summary>
+        /// Creates a new options type for the specified method.
+        /// </summary>
+        /// <param name="method">The method whose optional parameters will be contained in the options type.</param>
+        public OptionsTypeGo(MethodGo method) : base(CodeNamerGo.Instance.GetOptionsTypeName(method))
+        {
+            Parameters = method.LocalParameters.Where(p => p.IsOptionsField).ToList();
+            if (!Parameters.Any())
+            {
+                throw new InvalidOperationException($"method {method.Owner}.{method.Name} doesn't have any optional parameters");
+            }
+
+            CodeModel = method.CodeModel;
+            Documentation = $"Contains the optional parameters for the {method.Owner}.{method.Name} method.";
+            if (method.Deprecated)
+            {
+                DeprecationMessage = "The method for this type has been deprecated.";
+            }
+        }
+
+        /// <summary>
+        /// Gets the optional parameters contained in this type.
+        /// </summary>
+        public IEnumerable<ParameterGo> Parameters { get; }
+
+        public override void AddImports(HashSet<string> imports)
+        {
+            base.AddImports(imports);
+            foreach (var p in Parameters)
+            {
+                p.AddImports(imports);
+            }
+        }
+
+        public override string Fields()
+        {
+            var indented = new IndentedStringBuilder("    ");
+            foreach (var p in Parameters)
+            {
+                if (!string.IsNullOrWhiteSpace(p.Documentation.FixedValue))
+                {
+                    indented.Append($"{p.OptionsFieldName} - {p.Documentation.FixedValue.ToSentence()}".ToCommentBlock());
+                }
+                var typeName = p.ModelType.HasInterface() ? p.ModelType.GetInterfaceName() : p.ModelType.Name.ToString();
+                indented.AppendLine(p.ModelType.CanBeEmpty() ? $"{p.OptionsFieldName} {typeName}" : $"{p.OptionsFieldName} *{typeName}");
+            }
+            return indented.ToString();
+        }
+    }
+}
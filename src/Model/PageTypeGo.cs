@@ -6,6 +6,7 @@ using AutoRest.Core.Utilities;
 using AutoRest.Extensions.Azure;
 using AutoRest.Go;
 using System;
+using System.Collections.Generic;
 using System.Linq;
 
 namespace AutoRest.Go.Model
@@ -114,6 +115,12 @@ namespace AutoRest.Go.Model
         /// </summary>
         public string NextPageFunctionSig => $"func(context.Context, {ContentType.Name}) ({ContentType.Name}, error)";
 
+        public override void AddImports(HashSet<string> imports)
+        {
+            base.AddImports(imports);
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "github.com/Azure/go-autorest/autorest/to"));
+        }
+
         public override string Fields()
         {
             return $"    {FnFieldName} {NextPageFunctionSig}\n    {ResultFieldName} {ContentType.Name}";
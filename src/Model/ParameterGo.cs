@@ -56,11 +56,26 @@ namespace AutoRest.Go.Model
             }
             else
             {
-                retval = Name.Value;
+                retval = ValueReference;
             }
             return retval;
         }
 
+        /// <summary>
+        /// Returns true if the parameter is passed as a field of its method's options struct.
+        /// </summary>
+        public bool IsOptionsField => IsMethodArgument && !IsRequired && ((Method?.CodeModel as CodeModelGo)?.UseOptionsStructs ?? false);
+
+        /// <summary>
+        /// Gets the name of the options struct field containing the parameter.
+        /// </summary>
+        public string OptionsFieldName => CodeNamerGo.Instance.GetPropertyName(Name.Value);
+
+        /// <summary>
+        /// Gets the expression used to reference the parameter's value within its method.
+        /// </summary>
+        public string ValueReference => IsOptionsField ? $"{MethodGo.OptionsParameterName}.{OptionsFieldName}" : Name.Value;
+
         public override bool IsClientProperty => base.IsClientProperty == true && !IsAPIVersion;
 
         public virtual bool IsAPIVersion => SerializedName.IsApiVersion();
@@ -131,6 +146,15 @@ namespace AutoRest.Go.Model
             return (Location == Core.Model.ParameterLocation.Query || Location == Core.Model.ParameterLocation.Path) && !Extensions.ContainsKey(SwaggerExtensions.SkipUrlEncodingExtension);
         }
 
+        /// <summary>
+        /// Returns true if the value of the parameter must never be logged, i.e. the parameter is marked
+        /// with x-ms-secret, has the password format or is an Authorization header.
+        /// </summary>
+        public bool IsSecret =>
+            (Extensions.ContainsKey(LogEventTypeGo.SecretExtension) && (bool)Extensions[LogEventTypeGo.SecretExtension]) ||
+            (ModelType is PrimaryTypeGo ptg && ptg.KnownFormat == KnownFormat.password) ||
+            (Location == Core.Model.ParameterLocation.Header && SerializedName.EqualsIgnoreCase("Authorization"));
+
         /// <summary>
         /// Return formatted value string for the parameter.
         /// </summary>
@@ -161,7 +185,7 @@ namespace AutoRest.Go.Model
             }
             else
             {
-                value = Name.Value;
+                value = ValueReference;
             }
 
             var format = IsRequired || ModelType.CanBeEmpty() || useDefault
@@ -397,7 +421,6 @@ namespace AutoRest.Go.Model
         public static string Validate(this IEnumerable<ParameterGo> parameters, HttpMethod method)
         {
             List<string> v = new List<string>();
-            HashSet<string> ancestors = new HashSet<string>();
 
             foreach (var p in parameters)
             {
@@ -407,23 +430,40 @@ namespace AutoRest.Go.Model
                 }
 
                 var name = !p.IsClientProperty
-                        ? p.Name.Value
+                        ? p.ValueReference
                         : p.GetClientPropertryName();
 
-                List<string> x = new List<string>();
                 if (p.ModelType is CompositeType)
-                {
-                    ancestors.Add(p.ModelType.Name);
-                    x.AddRange(p.ValidateCompositeType(name, method, ancestors));
-                    ancestors.Remove(p.ModelType.Name);
-                }
+                    v.AddRange(p.ValidateCompositeType(name, p.SerializedName, p.ChecksConstraints(method)));
                 else
-                    x.AddRange(p.ValidateType(name, method));
-
-                if (x.Count != 0)
-                    v.Add($"{{ TargetValue: {name},\n Constraints: []validation.Constraint{{{string.Join(",\n", x)}}}}}");
+                    v.AddRange(p.ValidateType(name, p.SerializedName, p.ChecksConstraints(method)));
             }
-            return string.Join(",\n", v);
+            return string.Join("\n", v);
+        }
+
+        /// <summary>
+        /// Adds the imports required to check the constraints of the specified parameters.
+        /// </summary>
+        /// <param name="parameters"></param>
+        /// <param name="method">The HTTP method of the operation the parameters belong to.</param>
+        /// <param name="imports"></param>
+        public static void AddValidationImports(this IEnumerable<ParameterGo> parameters, HttpMethod method, HashSet<string> imports)
+        {
+            // the constraints of composite parameters are checked by their own Validate methods
+            parameters.Where(p => !p.IsAPIVersion && !p.IsConstant && !(p.ModelType is CompositeType) && p.ChecksConstraints(method))
+                .ForEach(p => p.AddValidationImports(imports));
+        }
+
+        /// <summary>
+        /// Returns false if only the presence of the specified parameter is checked.  The body
+        /// of a PATCH request only contains the values to update so its constraints aren't checked.
+        /// </summary>
+        /// <param name="p"></param>
+        /// <param name="method">The HTTP method of the operation the parameter belongs to.</param>
+        /// <returns></returns>
+        private static bool ChecksConstraints(this ParameterGo p, HttpMethod method)
+        {
+            return method != HttpMethod.Patch || !p.IsBodyParameter();
         }
     }
 }
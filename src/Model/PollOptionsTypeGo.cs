@@ -0,0 +1,56 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+using AutoRest.Core.Utilities;
+using System.Collections.Generic;
+
+namespace AutoRest.Go.Model
+{
+    /// <summary>
+    /// Represents the options used when polling a future until its operation completes.
+    /// </summary>
+    internal class PollOptionsTypeGo : CompositeTypeGo
+    {
+        /// <summary>
+        /// Creates a new poll options type for the specified code model.
+        /// </summary>
+        /// <param name="cmg">The code model that will contain the poll options type.</param>
+        public PollOptionsTypeGo(CodeModelGo cmg) : base(CodeNamerGo.Instance.GetPollOptionsTypeName())
+        {
+            CodeModel = cmg;
+            Documentation = "Contains the optional parameters for the PollUntilDone methods of the futures.";
+        }
+
+        /// <summary>
+        /// Gets the name of the helper function that polls a future until its operation completes.
+        /// </summary>
+        public string PollUntilDoneFuncName => "pollUntilDone";
+
+        /// <summary>
+        /// Gets the name of the send decorator that associates a context with outgoing requests.
+        /// </summary>
+        public string WithRequestContextFuncName => "withRequestContext";
+
+        public override void AddImports(HashSet<string> imports)
+        {
+            base.AddImports(imports);
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "context"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "github.com/Azure/go-autorest/autorest"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "github.com/Azure/go-autorest/autorest/azure"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "net/http"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "time"));
+        }
+
+        public override string Fields()
+        {
+            var indented = new IndentedStringBuilder("    ");
+            indented.Append("Frequency - the delay between status checks.  If zero, the delay requested by the service or the client's PollingDelay is used.".ToCommentBlock());
+            indented.AppendLine("Frequency time.Duration");
+            indented.Append("MaxWait - the maximum amount of time to wait for the operation to complete.  If zero, polling continues until the operation completes or the context is cancelled.".ToCommentBlock());
+            indented.AppendLine("MaxWait time.Duration");
+            indented.Append("Progress - if not nil, it's called with the operation's status after each status check.".ToCommentBlock());
+            indented.AppendLine("Progress func(status string)");
+            return indented.ToString();
+        }
+    }
+}
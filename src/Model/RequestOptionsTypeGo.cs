@@ -0,0 +1,62 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+using AutoRest.Core.Utilities;
+using System.Collections.Generic;
+
+namespace AutoRest.Go.Model
+{
+    /// <summary>
+    /// Represents the per-call options that can be attached to the context passed to an operation.
+    /// </summary>
+    internal class RequestOptionsTypeGo : CompositeTypeGo
+    {
+        /// <summary>
+        /// Creates a new request options type for the specified code model.
+        /// </summary>
+        /// <param name="cmg">The code model that will contain the request options type.</param>
+        public RequestOptionsTypeGo(CodeModelGo cmg) : base(CodeNamerGo.Instance.GetRequestOptionsTypeName())
+        {
+            CodeModel = cmg;
+            Documentation = "Contains the per-call options applied to the requests of operations whose context was created with WithRequestOptions.";
+        }
+
+        /// <summary>
+        /// Gets the name of the function that attaches request options to a context.
+        /// </summary>
+        public string WithFuncName => $"With{Name}";
+
+        /// <summary>
+        /// Gets the name of the prepare decorator that applies the request options to outgoing requests.
+        /// </summary>
+        public string PrepareDecoratorName => $"with{Name}";
+
+        /// <summary>
+        /// Gets the name of the helper function that sends requests honoring the request options.
+        /// </summary>
+        public string SendFuncName => $"sendWith{Name}";
+
+        public override void AddImports(HashSet<string> imports)
+        {
+            base.AddImports(imports);
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "context"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "github.com/Azure/go-autorest/autorest"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "io"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "net/http"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "net/url"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "time"));
+        }
+
+        public override string Fields()
+        {
+            var indented = new IndentedStringBuilder("    ");
+            indented.Append("Header - additional headers to send.  They replace any values of the same headers set by the operation.".ToCommentBlock());
+            indented.AppendLine("Header http.Header");
+            indented.Append("Query - additional query parameters to send.  They replace any values of the same parameters set by the operation.".ToCommentBlock());
+            indented.AppendLine("Query url.Values");
+            indented.Append("Timeout - if greater than zero, the maximum amount of time allowed for sending the request, including retries, and reading its response.".ToCommentBlock());
+            indented.AppendLine("Timeout time.Duration");
+            return indented.ToString();
+        }
+    }
+}
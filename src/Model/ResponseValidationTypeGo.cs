@@ -0,0 +1,38 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+using AutoRest.Core.Utilities;
+
+namespace AutoRest.Go.Model
+{
+    /// <summary>
+    /// Represents the options controlling how the client reports responses that violate the constraints defined by the service.
+    /// </summary>
+    internal class ResponseValidationTypeGo : CompositeTypeGo
+    {
+        /// <summary>
+        /// Creates a new response validation type for the specified code model.
+        /// </summary>
+        /// <param name="cmg">The code model that will contain the response validation type.</param>
+        public ResponseValidationTypeGo(CodeModelGo cmg) : base(CodeNamerGo.Instance.GetResponseValidationTypeName())
+        {
+            CodeModel = cmg;
+            Documentation = "Controls how the client reports responses that violate the constraints defined by the service.  The zero value reports violations as errors.";
+        }
+
+        /// <summary>
+        /// Gets the name of the method that reports the violations found in a response.
+        /// </summary>
+        public string CheckMethodName => "check";
+
+        public override string Fields()
+        {
+            var indented = new IndentedStringBuilder("    ");
+            indented.Append("Warn - if true, violations are passed to OnWarning instead of being returned as errors.".ToCommentBlock());
+            indented.AppendLine("Warn bool");
+            indented.Append("OnWarning - called with the name of the operation and the violations found in its response when Warn is true.".ToCommentBlock());
+            indented.AppendLine("OnWarning func(operation string, err error)");
+            return indented.ToString();
+        }
+    }
+}
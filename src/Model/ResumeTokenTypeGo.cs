@@ -0,0 +1,51 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+using AutoRest.Core.Utilities;
+using System.Collections.Generic;
+
+namespace AutoRest.Go.Model
+{
+    /// <summary>
+    /// Represents the state serialized into the resume tokens of futures.
+    /// </summary>
+    internal class ResumeTokenTypeGo : CompositeTypeGo
+    {
+        /// <summary>
+        /// Creates a new resume token type for the specified code model.
+        /// </summary>
+        /// <param name="cmg">The code model that will contain the resume token type.</param>
+        public ResumeTokenTypeGo(CodeModelGo cmg) : base(CodeNamerGo.Instance.GetResumeTokenTypeName())
+        {
+            CodeModel = cmg;
+            Documentation = "Contains the state of a future and the operation that created it.";
+        }
+
+        /// <summary>
+        /// Gets the name of the helper function that creates a resume token for a future.
+        /// </summary>
+        public string NewFuncName => "newResumeToken";
+
+        /// <summary>
+        /// Gets the name of the helper function that extracts a future from a resume token.
+        /// </summary>
+        public string ParseFuncName => "parseResumeToken";
+
+        public override void AddImports(HashSet<string> imports)
+        {
+            base.AddImports(imports);
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "encoding/base64"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "encoding/json"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "fmt"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "github.com/Azure/go-autorest/autorest/azure"));
+        }
+
+        public override string Fields()
+        {
+            var indented = new IndentedStringBuilder("    ");
+            indented.AppendLine("Operation string `json:\"operation\"`");
+            indented.AppendLine("Future azure.Future `json:\"future\"`");
+            return indented.ToString();
+        }
+    }
+}
@@ -0,0 +1,77 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+using AutoRest.Core.Utilities;
+using System.Collections.Generic;
+
+namespace AutoRest.Go.Model
+{
+    /// <summary>
+    /// Represents the default retry policy, retrying failed requests with exponentially increasing delays.
+    /// The RetryPolicy interface consulted by the senders of operations is emitted along with it.
+    /// </summary>
+    internal class RetryPolicyTypeGo : CompositeTypeGo
+    {
+        /// <summary>
+        /// Creates a new retry policy type for the specified code model.
+        /// </summary>
+        /// <param name="cmg">The code model that will contain the retry policy type.</param>
+        public RetryPolicyTypeGo(CodeModelGo cmg) : base(CodeNamerGo.Instance.GetExponentialRetryTypeName())
+        {
+            CodeModel = cmg;
+            Documentation = $"Is a {InterfaceName} that retries failed requests with exponentially increasing delays.  When the {InterfaceName} of a client is nil one is created from its RetryAttempts and RetryDuration.  Unlike autorest.DoRetryForStatusCodes it only retries idempotent requests unless RetryNonIdempotent is true, so POST and PATCH requests, including the initial requests of long-running operations, aren't retried unless the service marks their operation x-ms-idempotent.  Responses with status code 429 count against MaxRetries.";
+        }
+
+        /// <summary>
+        /// Gets the name of the interface consulted by the senders of operations.
+        /// </summary>
+        public string InterfaceName => CodeNamerGo.Instance.GetRetryPolicyTypeName();
+
+        /// <summary>
+        /// Gets the name of the send decorator that retries requests as decided by a retry policy.
+        /// </summary>
+        public string SendDecoratorName => "withRetryPolicy";
+
+        /// <summary>
+        /// Gets the name of the swagger extension marking an operation as safe to retry regardless of its HTTP method.
+        /// </summary>
+        public static string IdempotentExtension => "x-ms-idempotent";
+
+        /// <summary>
+        /// Returns the send decorator applying the retry policy of the client to a request.
+        /// </summary>
+        /// <param name="idempotent">Pass true if the operation is marked as idempotent by the service.</param>
+        public string SendDecorator(bool idempotent) => $"{SendDecoratorName}(client.{InterfaceName}, client.Client, {(idempotent ? "true" : "false")})";
+
+        public override void AddImports(HashSet<string> imports)
+        {
+            base.AddImports(imports);
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "github.com/Azure/go-autorest/autorest"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "math"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "math/rand"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "net/http"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "strconv"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "time"));
+        }
+
+        public override string Fields()
+        {
+            var indented = new IndentedStringBuilder("    ");
+            indented.Append("MaxRetries - the maximum number of times a request is retried.".ToCommentBlock());
+            indented.AppendLine("MaxRetries int");
+            indented.Append("Delay - the delay before the first retry.  The delay is doubled for each following retry.".ToCommentBlock());
+            indented.AppendLine("Delay time.Duration");
+            indented.Append("MaxDelay - the maximum delay between retries.  Zero means no limit.".ToCommentBlock());
+            indented.AppendLine("MaxDelay time.Duration");
+            indented.Append("MaxElapsed - requests aren't retried once the delay would exceed this much time since the first attempt.  Zero means no limit.".ToCommentBlock());
+            indented.AppendLine("MaxElapsed time.Duration");
+            indented.Append("Jitter - the fraction, from 0 to 1, of each delay that's randomized to spread out the retries of concurrent requests.".ToCommentBlock());
+            indented.AppendLine("Jitter float64");
+            indented.Append("StatusCodes - the status codes of the responses that are retried.  Defaults to autorest.StatusCodesForRetry.".ToCommentBlock());
+            indented.AppendLine("StatusCodes []int");
+            indented.Append("RetryNonIdempotent - if true, requests that aren't idempotent, e.g. POST requests of operations the service doesn't mark as idempotent, are retried too.".ToCommentBlock());
+            indented.AppendLine("RetryNonIdempotent bool");
+            return indented.ToString();
+        }
+    }
+}
@@ -0,0 +1,77 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+using AutoRest.Core.Model;
+using AutoRest.Core.Utilities;
+using System.Collections.Generic;
+using System.Linq;
+
+namespace AutoRest.Go.Model
+{
+    /// <summary>
+    /// Represents the variant of a polymorphic type returned by its unmarshaler when the discriminator has a value it doesn't know.
+    /// It keeps the discriminator value and the JSON it was unmarshaled from so that it's marshaled back unchanged.
+    /// </summary>
+    internal class UnknownPolymorphicTypeGo : CompositeTypeGo
+    {
+        /// <summary>
+        /// Creates a new unknown variant of the specified polymorphic type.
+        /// </summary>
+        /// <param name="polymorphicType">The polymorphic type whose interface the unknown variant implements.</param>
+        public UnknownPolymorphicTypeGo(CompositeTypeGo polymorphicType) : base(CodeNamerGo.Instance.GetUnknownPolymorphicTypeName(polymorphicType.Name))
+        {
+            PolymorphicType = polymorphicType;
+            CodeModel = polymorphicType.CodeModel;
+            Documentation = $"Is a {polymorphicType.GetInterfaceName()} whose {polymorphicType.RootType.PolymorphicDiscriminator} isn't known to this version of the package, e.g. a type added to the service since.  It keeps the JSON it was unmarshaled from so that it's marshaled back unchanged.";
+        }
+
+        /// <summary>
+        /// Gets the polymorphic type whose interface the unknown variant implements.
+        /// </summary>
+        public CompositeTypeGo PolymorphicType { get; }
+
+        /// <summary>
+        /// Gets the name of the field containing the value of the discriminator.
+        /// </summary>
+        public string DiscriminatorFieldName => PolymorphicType.RootType.PolymorphicProperty;
+
+        /// <summary>
+        /// Gets the name of the field containing the JSON the unknown variant was unmarshaled from.
+        /// </summary>
+        public string RawFieldName => "Raw";
+
+        /// <summary>
+        /// Gets the types whose As methods are implemented by the unknown variant, i.e. all the types of the hierarchy.
+        /// </summary>
+        public IEnumerable<CompositeType> HierarchyTypes => PolymorphicType.SiblingTypes;
+
+        /// <summary>
+        /// Returns true if the unknown variant implements the interface of the specified type.
+        /// </summary>
+        /// <param name="type">A type of the hierarchy having an interface.</param>
+        public bool Implements(CompositeType type) => type.Equals(PolymorphicType) || PolymorphicType.DerivesFrom(type);
+
+        /// <summary>
+        /// Gets if any of the interfaces implemented by the unknown variant requires a Validate method.
+        /// </summary>
+        public bool ImplementsValidation => HierarchyTypes.Cast<CompositeTypeGo>().Any(st => st.HasInterface() && Implements(st) && st.HasValidation);
+
+        public override void AddImports(HashSet<string> imports)
+        {
+            base.AddImports(imports);
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "encoding/json"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "bytes"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "strings"));
+        }
+
+        public override string Fields()
+        {
+            var indented = new IndentedStringBuilder("    ");
+            indented.Append($"{DiscriminatorFieldName} - the value of the discriminator.".ToCommentBlock());
+            indented.AppendLine($"{DiscriminatorFieldName} {PolymorphicType.DiscriminatorEnum.Name}");
+            indented.Append($"{RawFieldName} - the JSON the {Name} was unmarshaled from.".ToCommentBlock());
+            indented.AppendLine($"{RawFieldName} json.RawMessage");
+            return indented.ToString();
+        }
+    }
+}
@@ -0,0 +1,91 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+using AutoRest.Core.Utilities;
+using System.Collections.Generic;
+using System.Linq;
+
+namespace AutoRest.Go.Model
+{
+    /// <summary>
+    /// Represents a violation of a constraint found when validating a parameter or model.
+    /// </summary>
+    internal class ValidationErrorTypeGo : CompositeTypeGo
+    {
+        /// <summary>
+        /// The name of the helper function used to check the UniqueItems constraint.
+        /// </summary>
+        public const string HasDuplicateItemsFuncName = "hasDuplicateItems";
+
+        /// <summary>
+        /// Creates a new validation error type for the specified code model.
+        /// </summary>
+        /// <param name="cmg">The code model that will contain the validation error type.</param>
+        public ValidationErrorTypeGo(CodeModelGo cmg) : base(CodeNamerGo.Instance.GetValidationErrorTypeName())
+        {
+            CodeModel = cmg;
+            Documentation = "Describes a value that violates one of the constraints defined by the service.";
+        }
+
+        /// <summary>
+        /// Gets the name of the type containing all the violations found when validating a value.
+        /// </summary>
+        public string ListTypeName => $"{Name}s";
+
+        /// <summary>
+        /// Gets the name of the error returned by operations whose parameters violate their constraints.
+        /// </summary>
+        public string ParameterErrorTypeName => $"Parameter{Name}";
+
+        /// <summary>
+        /// Returns true if any of the operations of the code model check the constraints of their parameters.
+        /// </summary>
+        public bool ValidatesParameters
+        {
+            get
+            {
+                var cmg = (CodeModelGo)CodeModel;
+                return cmg.ShouldValidate && cmg.Methods.Cast<MethodGo>().Any(m => !m.ParameterValidations.IsNullOrEmpty());
+            }
+        }
+
+        /// <summary>
+        /// Returns true if the validation statements of the code model check the UniqueItems constraint.
+        /// </summary>
+        public bool ChecksUniqueItems
+        {
+            get
+            {
+                var cmg = (CodeModelGo)CodeModel;
+                return cmg.ModelTypes.Cast<CompositeTypeGo>()
+                        .Where(mt => mt.HasValidation)
+                        .SelectMany(mt => mt.ValidationStatements(mt.Name.FixedValue.ToVariableName()))
+                        .Concat(cmg.Methods.Cast<MethodGo>().Select(m => m.ParameterValidations))
+                        .Any(s => s.Contains($"{HasDuplicateItemsFuncName}("));
+            }
+        }
+
+        public override void AddImports(HashSet<string> imports)
+        {
+            base.AddImports(imports);
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "fmt"));
+            imports.Add(PrimaryTypeGo.GetImportLine(package: "strings"));
+            if (ValidatesParameters)
+            {
+                imports.UnionWith(CodeNamerGo.Instance.ValidationImports);
+            }
+        }
+
+        public override string Fields()
+        {
+            var indented = new IndentedStringBuilder("    ");
+            indented.Append("Path - the JSON path of the value, relative to the value being validated.".ToCommentBlock());
+            indented.AppendLine("Path string");
+            indented.Append("Constraint - the name of the violated constraint, e.g. MaxLength.".ToCommentBlock());
+            indented.AppendLine("Constraint string");
+            indented.Append("Details - describes the violation.".ToCommentBlock());
+            indented.AppendLine("Details string");
+            return indented.ToString();
+        }
+    }
+}
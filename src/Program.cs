@@ -82,6 +82,11 @@ namespace AutoRest.Go
             Settings.Instance.CustomSettings.Add("SyncMethods", GetXmsCodeGenSetting<string>(codeModelT, "syncMethods") ?? await GetValue("sync-methods") ?? "essential");
             Settings.Instance.CustomSettings.Add("UseDateTimeOffset", GetXmsCodeGenSetting<bool?>(codeModelT, "useDateTimeOffset") ?? await GetValue<bool?>("use-datetimeoffset") ?? false);
             Settings.Instance.CustomSettings["ClientSideValidation"] = await GetValue<bool?>("client-side-validation") ?? false;
+            Settings.Instance.CustomSettings["OptionsStructs"] = await GetValue<bool?>("options-structs") ?? false;
+            Settings.Instance.CustomSettings["ResponseValidation"] = await GetValue<bool?>("response-validation") ?? false;
+            Settings.Instance.CustomSettings["StrictEnums"] = await GetValue<bool?>("strict-enums") ?? false;
+            Settings.Instance.CustomSettings["PrefixEnumConstants"] = await GetValue<bool?>("prefix-enum-constants") ?? false;
+            Settings.Instance.CustomSettings["GenerateRecorder"] = await GetValue<bool?>("generate-recorder") ?? false;
             Settings.Instance.CustomSettings["OpenAPIType"] = await GetValue<string>("openapi-type") ?? "default";
             Settings.Instance.MaximumCommentColumns = await GetValue<int?>("max-comment-columns") ?? 120;
             Settings.Instance.OutputFileName = await GetValue<string>("output-file");
@@ -26,8 +26,14 @@ namespace AutoRest.Go
             // name collisions call it after transforming enums and models
             FixUpPolymorphicTypes(cmg);
             TransformMethods(cmg);
+            // must be done after transforming the methods as
+            // it depends on the names of their parameters
+            cmg.CreateValidationErrorType();
             SwaggerExtensions.ProcessParameterizedHost(cmg);
             FixStutteringTypeNames(cmg);
+            // the unknown variants are named after their polymorphic
+            // types so they must be added once those are final
+            AddUnknownPolymorphicTypes(cmg);
             AssureUniqueNames(cmg);
             TransformPropertyTypes(cmg);
 
@@ -153,6 +159,7 @@ namespace AutoRest.Go
             {
                 if (mtm.IsPolymorphic)
                 {
+                    mtm.DiscriminatorEnum.IsDiscriminator = true;
                     foreach (var dt in mtm.DerivedTypes)
                     {
                         ((CompositeTypeGo)dt).DiscriminatorEnum = mtm.DiscriminatorEnum;
@@ -161,6 +168,17 @@ namespace AutoRest.Go
             }
         }
 
+        private static void AddUnknownPolymorphicTypes(CodeModelGo cmg)
+        {
+            // the unmarshalers of polymorphic types return these for the
+            // discriminator values they don't know, e.g. types added to the
+            // service since the package was generated
+            foreach (var mt in cmg.ModelTypes.Cast<CompositeTypeGo>().Where(mt => mt.HasInterface()).ToList())
+            {
+                cmg.Add(new UnknownPolymorphicTypeGo(mt));
+            }
+        }
+
         private static void AssureUniqueNames(CodeModelGo cmg)
         {
             // now normalize the names
@@ -218,6 +236,37 @@ namespace AutoRest.Go
                     modelList.Add(v);
                 }
             }
+
+            if (cmg.UsePrefixedEnumConstants)
+            {
+                PrefixEnumConstants(cmg);
+            }
+        }
+
+        private static void PrefixEnumConstants(CodeModelGo cmg)
+        {
+            // the names computed above are kept as deprecated aliases so that
+            // packages can switch to the prefixed names gradually
+            var oldNames = new Dictionary<EnumValue, string>();
+            var prefixedList = new List<EnumValue>();
+            foreach (var em in cmg.EnumTypes.Cast<EnumTypeGo>().OrderBy(etg => etg.Name.Value))
+            {
+                foreach (var v in em.Values)
+                {
+                    oldNames[v] = v.Name;
+                    v.Name = CodeNamerGo.Instance.GetUnique(CodeNamerGo.Instance.GetPrefixedEnumMemberName(em.Name, v.SerializedName), v, cmg.ModelTypes, prefixedList);
+                    prefixedList.Add(v);
+                }
+            }
+
+            var prefixedNames = new HashSet<string>(prefixedList.Select(v => v.Name.Value));
+            foreach (var em in cmg.EnumTypes.Cast<EnumTypeGo>())
+            {
+                foreach (var v in em.Values.Where(v => !prefixedNames.Contains(oldNames[v])))
+                {
+                    em.DeprecatedMemberNames[oldNames[v]] = v.Name;
+                }
+            }
         }
 
         private static void TransformModelTypes(CodeModelGo cmg)
@@ -259,6 +308,26 @@ namespace AutoRest.Go
 
         private static void TransformMethods(CodeModelGo cmg)
         {
+            // must be done before transforming the method groups as
+            // it affects the imports required by the operations
+            cmg.CreateErrorResponseTypes();
+
+            // the preparers and senders of all operations consult the
+            // request options attached to the context of the call
+            cmg.Add(new RequestOptionsTypeGo(cmg));
+
+            // the senders of all operations consult the retry policy of the client
+            cmg.Add(new RetryPolicyTypeGo(cmg));
+
+            // operations log their events through the logger of the client
+            cmg.Add(new LogEventTypeGo(cmg));
+
+            // operations uploading files stream their multipart/form-data bodies
+            if (cmg.Methods.Cast<MethodGo>().Any(m => m.IsMultipartFormData))
+            {
+                cmg.Add(new MultipartOptionsTypeGo(cmg));
+            }
+
             {
                 var allNames = new HashSet<string>();
                 foreach (var mg in cmg.MethodGroups)
@@ -286,8 +355,29 @@ namespace AutoRest.Go
                     }
                 }
 
+                if (!method.IsLongRunningOperation() && !method.IsPageable && MultiResponseTypeGo.HasMultipleResponseSchemas(method))
+                {
+                    // for methods whose responses have different schemas create a result
+                    // type with a field per schema, populated based on the status code
+                    var mrt = new MultiResponseTypeGo(method);
+                    cmg.Add(mrt);
+                    method.ReturnType = new Response(mrt, method.ReturnType.Headers);
+                }
+
                 // fix up method return types
-                if (method.ReturnType.Body.ShouldBeSyntheticType())
+                if (method.ReturnType.Body.PrimaryType(KnownPrimaryType.Stream))
+                {
+                    // operations returning binary content share a result type
+                    // exposing the body along with its content headers
+                    var drt = cmg.DownloadResultType;
+                    if (drt == null)
+                    {
+                        drt = new DownloadResultTypeGo(cmg);
+                        cmg.Add(drt);
+                    }
+                    method.ReturnType = new Response(drt, method.ReturnType.Headers);
+                }
+                else if (method.ReturnType.Body.ShouldBeSyntheticType())
                 {
                     var ctg = new CompositeTypeGo(method.ReturnType.Body);
                     if (wrapperTypes.ContainsKey(ctg.Name))
@@ -302,6 +392,25 @@ namespace AutoRest.Go
                     }
                 }
 
+                if (!method.IsLongRunningOperation() && !method.IsPageable &&
+                    (!method.HasReturnValue() || HeaderResponseTypeGo.CanContainBody(method.ReturnType.Body)) &&
+                    method.ReturnType.Headers is CompositeType headers && headers.Properties.Any())
+                {
+                    // for methods that return headers create a result type that exposes
+                    // the header values as strongly typed fields next to the body
+                    var hrt = new HeaderResponseTypeGo(method, headers);
+                    cmg.Add(hrt);
+                    method.ReturnType = new Response(hrt, method.ReturnType.Headers);
+                }
+
+                if (method.LocalParameters.Any(p => p.IsOptionsField))
+                {
+                    // pass the optional parameters in a struct so that
+                    // adding one to the spec doesn't break existing callers
+                    method.OptionsType = new OptionsTypeGo(method);
+                    cmg.Add(method.OptionsType);
+                }
+
                 if (method.IsPageable && !method.IsNextMethod)
                 {
                     // for pageable methods replace the return type with a page iterator.
@@ -17,10 +17,13 @@ type AdditionalPropertiesSuite struct{}
 
 var _ = chk.Suite(&AdditionalPropertiesSuite{})
 
+var recorder = utils.MustRecorder(additionalproperties.NewRecorder(utils.CassettePath("additionalproperties"), utils.RecordingSender(), additionalproperties.RecorderOptions{Mode: additionalproperties.RecordingMode(utils.RecordingMode())}))
+
 func getPetsClient() additionalproperties.PetsClient {
 	c := additionalproperties.NewPetsClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
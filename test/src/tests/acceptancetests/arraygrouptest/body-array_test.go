@@ -21,6 +21,8 @@ type ArrayGroupSuite struct{}
 
 var _ = chk.Suite(&ArrayGroupSuite{})
 
+var recorder = utils.MustRecorder(NewRecorder(utils.CassettePath("arraygroup"), utils.RecordingSender(), RecorderOptions{Mode: RecordingMode(utils.RecordingMode())}))
+
 var arrayClient = getArrayClient()
 
 var one, two, three = "one", "two", "three"
@@ -31,6 +33,7 @@ func getArrayClient() ArrayClient {
 	c := NewArrayClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -15,12 +15,15 @@ type BoolGroupSuite struct{}
 
 var _ = chk.Suite(&BoolGroupSuite{})
 
+var recorder = utils.MustRecorder(NewRecorder(utils.CassettePath("booleangroup"), utils.RecordingSender(), RecorderOptions{Mode: RecordingMode(utils.RecordingMode())}))
+
 var boolClient = getBooleanClient()
 
 func getBooleanClient() BoolClient {
 	c := NewBoolClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -16,12 +16,15 @@ type ByteGroupSuite struct{}
 
 var _ = chk.Suite(&ByteGroupSuite{})
 
+var recorder = utils.MustRecorder(NewRecorder(utils.CassettePath("bytegroup"), utils.RecordingSender(), RecorderOptions{Mode: RecordingMode(utils.RecordingMode())}))
+
 var byteClient = getByteClient()
 
 func getByteClient() ByteClient {
 	c := NewByteClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
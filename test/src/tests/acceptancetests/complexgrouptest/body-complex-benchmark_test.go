@@ -0,0 +1,202 @@
+package complexgrouptest
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	. "tests/generated/complexgroup"
+)
+
+// The map decoders below mirror the polymorphic unmarshalers generated before they were changed to decode only the
+// discriminator: the body is decoded into a map to read it, then again into the concrete type.  mapFishModel and
+// mapUnmarshalFish stand for the FishModel and Fish unmarshalers of the time so that both implementations are benchmarked
+// through json.Unmarshal with the same bodies, the siblings of a Fish included.  The siblings of the other fishes are
+// decoded by the generated code, so the benchmarks don't give them any.
+
+func mapUnmarshalBasicFish(body []byte) (BasicFish, error) {
+	var m map[string]interface{}
+	err := json.Unmarshal(body, &m)
+	if err != nil {
+		return nil, err
+	}
+
+	switch m["fishtype"] {
+	case string(FishtypeSalmon):
+		var s Salmon
+		err := json.Unmarshal(body, &s)
+		return s, err
+	case string(FishtypeSmartSalmon):
+		var s SmartSalmon
+		err := json.Unmarshal(body, &s)
+		return s, err
+	case string(FishtypeShark):
+		var s Shark
+		err := json.Unmarshal(body, &s)
+		return s, err
+	case string(FishtypeSawshark):
+		var s Sawshark
+		err := json.Unmarshal(body, &s)
+		return s, err
+	case string(FishtypeGoblin):
+		var g Goblinshark
+		err := json.Unmarshal(body, &g)
+		return g, err
+	case string(FishtypeCookiecuttershark):
+		var c Cookiecuttershark
+		err := json.Unmarshal(body, &c)
+		return c, err
+	default:
+		return mapUnmarshalFish(body)
+	}
+}
+
+func mapUnmarshalBasicFishArray(body []byte) ([]BasicFish, error) {
+	var rawMessages []*json.RawMessage
+	err := json.Unmarshal(body, &rawMessages)
+	if err != nil {
+		return nil, err
+	}
+
+	fArray := make([]BasicFish, len(rawMessages))
+
+	for index, rawMessage := range rawMessages {
+		f, err := mapUnmarshalBasicFish(*rawMessage)
+		if err != nil {
+			return nil, err
+		}
+		fArray[index] = f
+	}
+	return fArray, nil
+}
+
+func mapUnmarshalFish(body []byte) (Fish, error) {
+	var f Fish
+	var m map[string]*json.RawMessage
+	err := json.Unmarshal(body, &m)
+	if err != nil {
+		return f, err
+	}
+	for k, v := range m {
+		switch k {
+		case "species":
+			if v != nil {
+				var species string
+				err = json.Unmarshal(*v, &species)
+				if err != nil {
+					return f, err
+				}
+				f.Species = &species
+			}
+		case "length":
+			if v != nil {
+				var length float64
+				err = json.Unmarshal(*v, &length)
+				if err != nil {
+					return f, err
+				}
+				f.Length = &length
+			}
+		case "siblings":
+			if v != nil {
+				siblings, err := mapUnmarshalBasicFishArray(*v)
+				if err != nil {
+					return f, err
+				}
+				f.Siblings = &siblings
+			}
+		case "fishtype":
+			if v != nil {
+				var fishtype FishtypeBasicFish
+				err = json.Unmarshal(*v, &fishtype)
+				if err != nil {
+					return f, err
+				}
+				f.Fishtype = fishtype
+			}
+		}
+	}
+
+	return f, nil
+}
+
+type mapFishModel struct {
+	Value BasicFish
+}
+
+func (fm *mapFishModel) UnmarshalJSON(body []byte) error {
+	f, err := mapUnmarshalBasicFish(body)
+	if err != nil {
+		return err
+	}
+	fm.Value = f
+
+	return nil
+}
+
+// benchmarkFish returns the JSON of a fish of the specified type.  Fishes of type Fish get two siblings of the same
+// type, nested depth levels deep.
+func benchmarkFish(fishtype string, depth int) string {
+	siblings := ""
+	if fishtype == "Fish" && depth > 0 {
+		sibling := benchmarkFish(fishtype, depth-1)
+		siblings = fmt.Sprintf(`,"siblings":[%s,%s]`, sibling, sibling)
+	}
+	return fmt.Sprintf(`{"fishtype":%q,"species":"king","length":1.5,"age":6,"birthday":"2012-01-05T01:00:00Z","location":"alaska","iswild":true,"picture":"//////4=","jawsize":5,"color":"pinkish-gray"%s}`, fishtype, siblings)
+}
+
+// benchmarkFishArray returns the JSON of a Fish whose siblings are count fishes of all the other known types.
+func benchmarkFishArray(count int) string {
+	fishtypes := []string{"salmon", "smart_salmon", "shark", "sawshark", "goblin", "cookiecuttershark"}
+	fishes := make([]string, count)
+	for i := range fishes {
+		fishes[i] = benchmarkFish(fishtypes[i%len(fishtypes)], 0)
+	}
+	return `{"fishtype":"Fish","length":1,"siblings":[` + strings.Join(fishes, ",") + `]}`
+}
+
+// benchmarkUnmarshal benchmarks the generated unmarshaler against the map one, both called by json.Unmarshal on body.
+func benchmarkUnmarshal(b *testing.B, body []byte) {
+	var fm FishModel
+	var mfm mapFishModel
+	if err := json.Unmarshal(body, &fm); err != nil {
+		b.Fatal(err)
+	}
+	if err := json.Unmarshal(body, &mfm); err != nil {
+		b.Fatal(err)
+	}
+	if !reflect.DeepEqual(fm.Value, mfm.Value) {
+		b.Fatalf("the unmarshalers disagree: %+v != %+v", fm.Value, mfm.Value)
+	}
+	b.Run("Discriminator", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var fm FishModel
+			if err := json.Unmarshal(body, &fm); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Map", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var mfm mapFishModel
+			if err := json.Unmarshal(body, &mfm); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkUnmarshalBasicFish(b *testing.B) {
+	benchmarkUnmarshal(b, []byte(benchmarkFish("goblin", 0)))
+}
+
+func BenchmarkUnmarshalBasicFishArray(b *testing.B) {
+	benchmarkUnmarshal(b, []byte(benchmarkFishArray(120)))
+}
+
+func BenchmarkUnmarshalBasicFishRecursive(b *testing.B) {
+	benchmarkUnmarshal(b, []byte(benchmarkFish("Fish", 6)))
+}
@@ -20,6 +20,8 @@ type ComplexGroupSuite struct{}
 
 var _ = chk.Suite(&ComplexGroupSuite{})
 
+var recorder = utils.MustRecorder(NewRecorder(utils.CassettePath("complexgroup"), utils.RecordingSender(), RecorderOptions{Mode: RecordingMode(utils.RecordingMode())}))
+
 var complexPrimitiveClient = getPrimitiveClient()
 var complexArrayClient = getArrayComplexClient()
 var complexDictionaryClient = getDictionaryComplexClient()
@@ -33,6 +35,7 @@ func getArrayComplexClient() ArrayClient {
 	c := NewArrayClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -40,6 +43,7 @@ func getBasicOperationsClient() BasicClient {
 	c := NewBasicClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -47,6 +51,7 @@ func getDictionaryComplexClient() DictionaryClient {
 	c := NewDictionaryClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -54,6 +59,7 @@ func getPrimitiveClient() PrimitiveClient {
 	c := NewPrimitiveClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -61,6 +67,7 @@ func getInheritanceClient() InheritanceClient {
 	c := NewInheritanceClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -68,6 +75,7 @@ func getPolymorphismClient() PolymorphismClient {
 	c := NewPolymorphismClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -75,6 +83,7 @@ func getReadOnlyClient() ReadonlypropertyClient {
 	c := NewReadonlypropertyClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -82,6 +91,7 @@ func getPolymorphismRecursiveClient() PolymorphicrecursiveClient {
 	c := NewPolymorphicrecursiveClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -651,3 +661,229 @@ func (s *ComplexGroupSuite) TestPutComplexPolymorphicComplicated(c *chk.C) {
 	_, err := complexPolymorphicClient.PutComplicated(context.Background(), ss)
 	c.Assert(err, chk.IsNil)
 }
+
+func (s *ComplexGroupSuite) TestUnknownPolymorphicDiscriminator(c *chk.C) {
+	jsonBlob := `{"fishtype":"dolphin","species":"bottlenose","length":2.5,"flippers":{"count":2}}`
+	var fm FishModel
+	err := json.Unmarshal([]byte(jsonBlob), &fm)
+	c.Assert(err, chk.IsNil)
+	unknown, ok := fm.Value.(UnknownFish)
+	c.Assert(ok, chk.Equals, true)
+	c.Assert(unknown.Fishtype, chk.Equals, FishtypeBasicFish("dolphin"))
+	_, ok = unknown.AsShark()
+	c.Assert(ok, chk.Equals, false)
+	c.Assert(unknown.Validate(), chk.IsNil)
+	b, err := json.Marshal(fm.Value)
+	c.Assert(err, chk.IsNil)
+	c.Assert(string(b), chk.Equals, jsonBlob)
+}
+
+func (s *ComplexGroupSuite) TestUnknownPolymorphicDiscriminatorInArray(c *chk.C) {
+	sibling := `{"fishtype":"dolphin","length":2.5}`
+	var fm FishModel
+	err := json.Unmarshal([]byte(`{"fishtype":"salmon","length":1,"siblings":[`+sibling+`]}`), &fm)
+	c.Assert(err, chk.IsNil)
+	salmon, ok := fm.Value.AsSalmon()
+	c.Assert(ok, chk.Equals, true)
+	c.Assert(*salmon.Siblings, chk.HasLen, 1)
+	_, ok = (*salmon.Siblings)[0].(UnknownFish)
+	c.Assert(ok, chk.Equals, true)
+	b, err := json.Marshal((*salmon.Siblings)[0])
+	c.Assert(err, chk.IsNil)
+	c.Assert(string(b), chk.Equals, sibling)
+
+	var sm SalmonModel
+	err = json.Unmarshal([]byte(`{"fishtype":"shark","age":3}`), &sm)
+	c.Assert(err, chk.IsNil)
+	unknown, ok := sm.Value.(UnknownSalmon)
+	c.Assert(ok, chk.Equals, true)
+	c.Assert(unknown.Fishtype, chk.Equals, FishtypeBasicFishShark)
+	_, ok = unknown.AsBasicFish()
+	c.Assert(ok, chk.Equals, true)
+}
+
+func (s *ComplexGroupSuite) TestPolymorphicDiscriminatorScan(c *chk.C) {
+	var fm FishModel
+	err := json.Unmarshal([]byte(`{"length":1,"siblings":[{"fishtype":"dolphin"}],"FishType":"goblin","jawsize":5}`), &fm)
+	c.Assert(err, chk.IsNil)
+	goblin, ok := fm.Value.AsGoblinshark()
+	c.Assert(ok, chk.Equals, true)
+	c.Assert(*goblin.Jawsize, chk.Equals, int32(5))
+	c.Assert(*goblin.Siblings, chk.HasLen, 1)
+
+	fm = FishModel{}
+	err = json.Unmarshal([]byte(`{"length":1}`), &fm)
+	c.Assert(err, chk.IsNil)
+	_, ok = fm.Value.AsFish()
+	c.Assert(ok, chk.Equals, true)
+
+	err = json.Unmarshal([]byte(`{"fishtype":2,"length":1}`), &fm)
+	c.Assert(err, chk.ErrorMatches, "json: cannot unmarshal number into .*FishtypeBasicFish")
+	err = json.Unmarshal([]byte(`[{"fishtype":"goblin"}]`), &fm)
+	c.Assert(err, chk.ErrorMatches, "json: cannot unmarshal array into .*")
+
+	var unknown UnknownFish
+	err = json.Unmarshal([]byte(`{"length":1,"fishtype":"dolphin"}`), &unknown)
+	c.Assert(err, chk.IsNil)
+	c.Assert(unknown.Fishtype, chk.Equals, FishtypeBasicFish("dolphin"))
+	c.Assert(string(unknown.Raw), chk.Equals, `{"length":1,"fishtype":"dolphin"}`)
+}
+
+func (s *ComplexGroupSuite) TestEnumParse(c *chk.C) {
+	color, err := ParseCMYKColors("YELLOW")
+	c.Assert(err, chk.IsNil)
+	c.Assert(color, chk.Equals, CMYKColorsYellow)
+	_, err = ParseCMYKColors("yellow")
+	c.Assert(err, chk.NotNil)
+	color, err = ParseCMYKColorsIgnoreCase("yellow")
+	c.Assert(err, chk.IsNil)
+	c.Assert(color, chk.Equals, CMYKColorsYellow)
+	_, err = ParseCMYKColorsIgnoreCase("purple")
+	c.Assert(err, chk.ErrorMatches, `"purple" isn't a possible value for CMYKColors`)
+	c.Assert(CMYKColorsMagenta.IsKnown(), chk.Equals, true)
+	c.Assert(CMYKColors("purple").IsKnown(), chk.Equals, false)
+	c.Assert(CMYKColorsBlack.String(), chk.Equals, "blacK")
+}
+
+func (s *ComplexGroupSuite) TestDeprecatedEnumConstants(c *chk.C) {
+	c.Assert(BlacK, chk.Equals, CMYKColorsBlack)
+	c.Assert(YELLOW, chk.Equals, CMYKColorsYellow)
+	c.Assert(FishtypeSmartSalmon, chk.Equals, FishtypeBasicFishSmartSalmon)
+	c.Assert(Kind1, chk.Equals, MyKindKind1)
+	// the constants that were already prefixed have no aliases
+	c.Assert(string(FishTypeDotSalmon), chk.Equals, "DotSalmon")
+}
+
+func (s *ComplexGroupSuite) TestStrictEnumUnmarshal(c *chk.C) {
+	var b Basic
+	err := json.Unmarshal([]byte(`{"id":2,"color":"cyan"}`), &b)
+	c.Assert(err, chk.IsNil)
+	c.Assert(b.Color, chk.Equals, CMYKColorsCyan)
+	err = json.Unmarshal([]byte(`{"id":2,"color":"purple"}`), &b)
+	c.Assert(err, chk.ErrorMatches, `"purple" isn't a possible value for CMYKColors`)
+
+	// null and "" leave the enum unset
+	b = Basic{}
+	err = json.Unmarshal([]byte(`{"id":2,"name":"abc","color":null}`), &b)
+	c.Assert(err, chk.IsNil)
+	c.Assert(*b.Name, chk.Equals, "abc")
+	c.Assert(b.Color, chk.Equals, CMYKColors(""))
+	b.Color = CMYKColorsCyan
+	err = json.Unmarshal([]byte(`{"id":2,"color":""}`), &b)
+	c.Assert(err, chk.IsNil)
+	c.Assert(b.Color, chk.Equals, CMYKColors(""))
+
+	// extensible enums accept unknown values
+	var g Goblinshark
+	err = json.Unmarshal([]byte(`{"fishtype":"goblin","length":1,"color":"red"}`), &g)
+	c.Assert(err, chk.IsNil)
+	c.Assert(g.Color, chk.Equals, GoblinSharkColor("red"))
+	c.Assert(g.Color.IsKnown(), chk.Equals, false)
+}
+
+func (s *ComplexGroupSuite) TestDeepCopy(c *chk.C) {
+	copied := ss.DeepCopy()
+	c.Assert(copied.Equal(ss), chk.Equals, true)
+
+	*copied.Species = "queen"
+	picture := *(*copied.Siblings)[1].(Sawshark).Picture
+	picture[0] = 0
+	copied.AdditionalProperties["additionalProperty4"].(map[string]interface{})["a"] = float64(3)
+	copied.AdditionalProperties["additionalProperty5"].([]interface{})[0] = float64(2)
+	c.Assert(copied.Equal(ss), chk.Equals, false)
+
+	c.Assert(*ss.Species, chk.Equals, "king")
+	c.Assert((*(*ss.Siblings)[1].(Sawshark).Picture)[0], chk.Equals, byte(255))
+	c.Assert(ss.AdditionalProperties["additionalProperty4"].(map[string]interface{})["a"], chk.Equals, float64(1))
+	c.Assert(ss.AdditionalProperties["additionalProperty5"].([]interface{})[0], chk.Equals, float64(1))
+
+	// unknown types keep their own copy of the JSON
+	var fm FishModel
+	err := json.Unmarshal([]byte(`{"fishtype":"dolphin","length":2.5}`), &fm)
+	c.Assert(err, chk.IsNil)
+	copiedModel := fm.DeepCopy()
+	copiedModel.Value.(UnknownFish).Raw[0] = '['
+	c.Assert(string(fm.Value.(UnknownFish).Raw), chk.Equals, `{"fishtype":"dolphin","length":2.5}`)
+	c.Assert(copiedModel.Equal(fm), chk.Equals, false)
+}
+
+func (s *ComplexGroupSuite) TestEqual(c *chk.C) {
+	birthday := time.Date(2012, time.January, 5, 1, 0, 0, 0, time.UTC)
+	shark := Shark{Age: to.Int32Ptr(6), Birthday: &date.Time{birthday}}
+	other := Shark{Age: to.Int32Ptr(6), Birthday: &date.Time{birthday.In(time.FixedZone("CET", 3600))}}
+	c.Assert(shark.Equal(other), chk.Equals, true)
+	other.Birthday = &date.Time{birthday.Add(time.Second)}
+	c.Assert(shark.Equal(other), chk.Equals, false)
+
+	// polymorphic values must be of the same type
+	salmon := Salmon{Siblings: &[]BasicFish{Shark{Age: to.Int32Ptr(6)}}}
+	c.Assert(salmon.Equal(Salmon{Siblings: &[]BasicFish{Shark{Age: to.Int32Ptr(6)}}}), chk.Equals, true)
+	c.Assert(salmon.Equal(Salmon{Siblings: &[]BasicFish{Sawshark{Age: to.Int32Ptr(6)}}}), chk.Equals, false)
+	c.Assert(salmon.Equal(Salmon{Siblings: &[]BasicFish{}}), chk.Equals, false)
+	c.Assert(salmon.Equal(Salmon{}), chk.Equals, false)
+
+	c.Assert(ss.Equal(ss.DeepCopy()), chk.Equals, true)
+	c.Assert(ss.Equal(SmartSalmon{}), chk.Equals, false)
+}
+
+func (s *ComplexGroupSuite) TestPolymorphicPointers(c *chk.C) {
+	shark := &Shark{Age: to.Int32Ptr(6)}
+	salmon := Salmon{Siblings: &[]BasicFish{shark, (*Sawshark)(nil)}}
+	copied := salmon.DeepCopy()
+	c.Assert(copied.Equal(salmon), chk.Equals, true)
+	copiedShark := (*copied.Siblings)[0].(*Shark)
+	c.Assert(copiedShark, chk.Not(chk.Equals), shark)
+	*copiedShark.Age = 7
+	c.Assert(*shark.Age, chk.Equals, int32(6))
+	c.Assert(copied.Equal(salmon), chk.Equals, false)
+	c.Assert((*copied.Siblings)[1], chk.Equals, BasicFish((*Sawshark)(nil)))
+
+	// pointers are equal to pointers to equal values only
+	c.Assert(salmon.Equal(Salmon{Siblings: &[]BasicFish{&Shark{Age: to.Int32Ptr(6)}, (*Sawshark)(nil)}}), chk.Equals, true)
+	c.Assert(salmon.Equal(Salmon{Siblings: &[]BasicFish{Shark{Age: to.Int32Ptr(6)}, (*Sawshark)(nil)}}), chk.Equals, false)
+	c.Assert(salmon.Equal(Salmon{Siblings: &[]BasicFish{shark, &Sawshark{}}}), chk.Equals, false)
+}
+
+func (s *ComplexGroupSuite) TestEqualIgnoreReadOnly(c *chk.C) {
+	obj := ReadonlyObj{ID: to.StringPtr("1"), Size: to.Int32Ptr(2)}
+	other := ReadonlyObj{ID: to.StringPtr("2"), Size: to.Int32Ptr(2)}
+	c.Assert(obj.Equal(other), chk.Equals, false)
+	c.Assert(obj.EqualIgnoreReadOnly(other), chk.Equals, true)
+	other.Size = to.Int32Ptr(3)
+	c.Assert(obj.EqualIgnoreReadOnly(other), chk.Equals, false)
+}
+
+func (s *ComplexGroupSuite) TestMergeFrom(c *chk.C) {
+	b := Basic{ID: to.Int32Ptr(2), Name: to.StringPtr("abc"), Color: CMYKColorsCyan}
+	b.MergeFrom(Basic{Name: to.StringPtr("def")})
+	c.Assert(b.Equal(Basic{ID: to.Int32Ptr(2), Name: to.StringPtr("def"), Color: CMYKColorsCyan}), chk.Equals, true)
+
+	// nested models are merged, slices are replaced
+	market := DotFishMarket{
+		SampleSalmon: &DotSalmon{Location: to.StringPtr("sweden"), Iswild: to.BoolPtr(true)},
+		Salmons:      &[]DotSalmon{{Location: to.StringPtr("sweden")}, {Location: to.StringPtr("norway")}},
+	}
+	patch := DotFishMarket{
+		SampleSalmon: &DotSalmon{Iswild: to.BoolPtr(false)},
+		Salmons:      &[]DotSalmon{{Location: to.StringPtr("iceland")}},
+	}
+	market.MergeFrom(patch)
+	c.Assert(*market.SampleSalmon.Location, chk.Equals, "sweden")
+	c.Assert(*market.SampleSalmon.Iswild, chk.Equals, false)
+	c.Assert(*market.Salmons, chk.HasLen, 1)
+	*(*patch.Salmons)[0].Location = "greenland"
+	c.Assert(*(*market.Salmons)[0].Location, chk.Equals, "iceland")
+
+	// maps are merged key by key
+	salmon := ss.DeepCopy()
+	salmon.MergeFrom(SmartSalmon{AdditionalProperties: map[string]interface{}{"additionalProperty1": float64(2), "additionalProperty6": true}})
+	c.Assert(salmon.AdditionalProperties, chk.HasLen, 6)
+	c.Assert(salmon.AdditionalProperties["additionalProperty1"], chk.Equals, float64(2))
+	c.Assert(salmon.AdditionalProperties["additionalProperty3"], chk.Equals, "hello")
+
+	// read-only fields aren't changed
+	obj := ReadonlyObj{ID: to.StringPtr("1")}
+	obj.MergeFrom(ReadonlyObj{ID: to.StringPtr("2"), Size: to.Int32Ptr(3)})
+	c.Assert(*obj.ID, chk.Equals, "1")
+	c.Assert(*obj.Size, chk.Equals, int32(3))
+}
@@ -3,6 +3,7 @@ package custombaseurlgrouptest
 import (
 	"context"
 	"testing"
+	"tests/acceptancetests/utils"
 	. "tests/generated/custombaseurlgroup"
 
 	chk "gopkg.in/check.v1"
@@ -14,11 +15,14 @@ type CustomBaseURLGroupSuite struct{}
 
 var _ = chk.Suite(&CustomBaseURLGroupSuite{})
 
+var recorder = utils.MustRecorder(NewRecorder(utils.CassettePath("custombaseurlgroup"), utils.RecordingSender(), RecorderOptions{Mode: RecordingMode(utils.RecordingMode())}))
+
 var custombaseuriClient = getCustomBaseURIClient()
 
 func getCustomBaseURIClient() PathsClient {
 	c := NewWithoutDefaults("host:3000")
 	c.RetryDuration = 1
+	c.Sender = recorder
 	return PathsClient{BaseClient: c}
 }
 
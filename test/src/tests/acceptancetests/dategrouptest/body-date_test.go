@@ -17,12 +17,15 @@ type DateGroupSuite struct{}
 
 var _ = chk.Suite(&DateGroupSuite{})
 
+var recorder = utils.MustRecorder(NewRecorder(utils.CassettePath("dategroup"), utils.RecordingSender(), RecorderOptions{Mode: RecordingMode(utils.RecordingMode())}))
+
 var dateClient = getDateClient()
 
 func getDateClient() DateClient {
 	c := NewDateClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -15,12 +15,16 @@ func Test(t *testing.T) { chk.TestingT(t) }
 type DateTimeGroupSuite struct{}
 
 var _ = chk.Suite(&DateTimeGroupSuite{})
+
+var recorder = utils.MustRecorder(NewRecorder(utils.CassettePath("datetimegroup"), utils.RecordingSender(), RecorderOptions{Mode: RecordingMode(utils.RecordingMode())}))
+
 var datetimeClient = getDateTimeClient()
 
 func getDateTimeClient() DatetimeClient {
 	c := NewDatetimeClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
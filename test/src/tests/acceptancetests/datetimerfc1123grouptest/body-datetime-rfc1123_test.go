@@ -17,12 +17,16 @@ func Test(t *testing.T) { chk.TestingT(t) }
 type DateTimeRfc1123GroupSuite struct{}
 
 var _ = chk.Suite(&DateTimeRfc1123GroupSuite{})
+
+var recorder = utils.MustRecorder(NewRecorder(utils.CassettePath("datetimerfc1123group"), utils.RecordingSender(), RecorderOptions{Mode: RecordingMode(utils.RecordingMode())}))
+
 var datetimerfc1123Client = getDateTimeRFC1123Client()
 
 func getDateTimeRFC1123Client() Datetimerfc1123Client {
 	c := NewDatetimerfc1123Client()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
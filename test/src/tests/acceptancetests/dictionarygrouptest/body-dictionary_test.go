@@ -20,6 +20,8 @@ type DictionaryGroupSuite struct{}
 
 var _ = chk.Suite(&DictionaryGroupSuite{})
 
+var recorder = utils.MustRecorder(NewRecorder(utils.CassettePath("dictionarygroup"), utils.RecordingSender(), RecorderOptions{Mode: RecordingMode(utils.RecordingMode())}))
+
 var dictionaryClient = getDictionaryClient()
 
 var one, two, three = "one", "two", "three"
@@ -30,6 +32,7 @@ func getDictionaryClient() DictionaryClient {
 	c := NewDictionaryClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
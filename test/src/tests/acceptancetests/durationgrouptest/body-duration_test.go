@@ -16,12 +16,15 @@ type DurationSuite struct{}
 
 var _ = chk.Suite(&DurationSuite{})
 
+var recorder = utils.MustRecorder(NewRecorder(utils.CassettePath("durationgroup"), utils.RecordingSender(), RecorderOptions{Mode: RecordingMode(utils.RecordingMode())}))
+
 var durationClient = getDurationClient()
 
 func getDurationClient() DurationClient {
 	c := NewDurationClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
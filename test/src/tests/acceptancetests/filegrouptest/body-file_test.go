@@ -2,13 +2,23 @@ package filegrouptest
 
 import (
 	"bufio"
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 	"tests/acceptancetests/utils"
 	. "tests/generated/filegroup"
+	"time"
 
+	"github.com/Azure/go-autorest/autorest"
 	chk "gopkg.in/check.v1"
 )
 
@@ -18,20 +28,23 @@ type FileSuite struct{}
 
 var _ = chk.Suite(&FileSuite{})
 
+var recorder = utils.MustRecorder(NewRecorder(utils.CassettePath("filegroup"), utils.RecordingSender(), RecorderOptions{Mode: RecordingMode(utils.RecordingMode())}))
+
 var filesClient = getFileClient()
 
 func getFileClient() FilesClient {
 	c := NewFilesClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
 func (s *FileSuite) TestGetFile(c *chk.C) {
 	res, err := filesClient.GetFile(context.Background())
 	c.Assert(err, chk.IsNil)
-	b, err := ioutil.ReadAll(*res.Value)
-	defer func() { (res.Body).Close() }()
+	b, err := ioutil.ReadAll(res.Body)
+	defer func() { res.Body.Close() }()
 	c.Assert(err, chk.IsNil)
 	c.Assert(len(b), chk.Equals, 8725)
 }
@@ -39,16 +52,16 @@ func (s *FileSuite) TestGetFile(c *chk.C) {
 func (s *FileSuite) TestGetEmptyFile(c *chk.C) {
 	res, err := filesClient.GetEmptyFile(context.Background())
 	c.Assert(err, chk.IsNil)
-	b, err := ioutil.ReadAll(*res.Value)
-	defer func() { (res.Body).Close() }()
+	b, err := ioutil.ReadAll(res.Body)
+	defer func() { res.Body.Close() }()
 	c.Assert(err, chk.IsNil)
 	c.Assert(len(b), chk.Equals, 0)
 }
 
 func (s *FileSuite) TestGetFileLarge(c *chk.C) {
-	res, err := filesClient.GetFileLarge(context.Background())
+	res, err := filesClient.GetFileLarge(context.Background(), "")
 	c.Assert(err, chk.IsNil)
-	numberOfBytes, err := readLargeFile(*res.Value)
+	numberOfBytes, err := readLargeFile(res.Body)
 	c.Assert(err, chk.IsNil)
 	c.Assert(numberOfBytes, chk.Equals, 3000*1024*1024)
 }
@@ -69,3 +82,149 @@ func readLargeFile(b io.ReadCloser) (int, error) {
 	}
 	return length, nil
 }
+
+// fileSender responds with content and its content headers.  The body fails after failAfter bytes if it's not negative.
+func fileSender(content string, failAfter int) autorest.Sender {
+	return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		var body io.Reader = strings.NewReader(content)
+		if failAfter >= 0 {
+			body = io.MultiReader(strings.NewReader(content[:failAfter]), failingReader{})
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header: http.Header{
+				"Content-Type":  []string{"image/png"},
+				"Etag":          []string{`"0x8D5"`},
+				"Last-Modified": []string{"Wed, 21 Oct 2015 07:28:00 GMT"},
+			},
+			ContentLength: int64(len(content)),
+			Body:          ioutil.NopCloser(body),
+			Request:       r,
+		}, nil
+	})
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+func (s *FileSuite) TestGetFileContentHeaders(c *chk.C) {
+	client := getFileClient()
+	client.Sender = fileSender("hello world", -1)
+	res, err := client.GetFile(context.Background())
+	c.Assert(err, chk.IsNil)
+	c.Assert(res.ContentLength, chk.Equals, int64(11))
+	c.Assert(res.ContentType, chk.Equals, "image/png")
+	c.Assert(res.ETag, chk.Equals, `"0x8D5"`)
+	c.Assert(res.LastModified.Equal(time.Date(2015, time.October, 21, 7, 28, 0, 0, time.UTC)), chk.Equals, true)
+	var progress []int64
+	res.Progress = func(written, total int64) {
+		c.Assert(total, chk.Equals, int64(11))
+		progress = append(progress, written)
+	}
+	var buf bytes.Buffer
+	n, err := res.WriteTo(&buf)
+	c.Assert(err, chk.IsNil)
+	c.Assert(n, chk.Equals, int64(11))
+	c.Assert(buf.String(), chk.Equals, "hello world")
+	c.Assert(progress, chk.DeepEquals, []int64{11})
+}
+
+func (s *FileSuite) TestGetFileDownloadToFile(c *chk.C) {
+	client := getFileClient()
+	client.Sender = fileSender("hello world", -1)
+	res, err := client.GetFile(context.Background())
+	c.Assert(err, chk.IsNil)
+	path := filepath.Join(c.MkDir(), "file.png")
+	n, err := res.DownloadToFile(context.Background(), path)
+	c.Assert(err, chk.IsNil)
+	c.Assert(n, chk.Equals, int64(11))
+	b, err := ioutil.ReadFile(path)
+	c.Assert(err, chk.IsNil)
+	c.Assert(string(b), chk.Equals, "hello world")
+}
+
+func (s *FileSuite) TestGetFileDownloadToFileInterrupted(c *chk.C) {
+	client := getFileClient()
+	client.Sender = fileSender("hello world", 5)
+	res, err := client.GetFile(context.Background())
+	c.Assert(err, chk.IsNil)
+	path := filepath.Join(c.MkDir(), "file.png")
+	// GetFile doesn't declare a Range header so the download can't be resumed
+	n, err := res.DownloadToFile(context.Background(), path)
+	c.Assert(err, chk.ErrorMatches, "connection reset")
+	c.Assert(n, chk.Equals, int64(5))
+	_, err = os.Stat(path)
+	c.Assert(os.IsNotExist(err), chk.Equals, true)
+}
+
+// rangeSender serves ranges of content, failing the body of the first response after failAfter bytes.  It records the
+// Range header of each request.
+func rangeSender(content string, failAfter int, ranges *[]string) autorest.Sender {
+	failed := false
+	return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		h := r.Header.Get("Range")
+		*ranges = append(*ranges, h)
+		status, first, last := http.StatusOK, 0, len(content)-1
+		if h != "" {
+			spec := strings.Split(strings.TrimPrefix(h, "bytes="), "-")
+			status = http.StatusPartialContent
+			first, _ = strconv.Atoi(spec[0])
+			if spec[1] != "" {
+				last, _ = strconv.Atoi(spec[1])
+			}
+		}
+		part := content[first : last+1]
+		var body io.Reader = strings.NewReader(part)
+		if !failed && failAfter < len(part) {
+			failed = true
+			body = io.MultiReader(strings.NewReader(part[:failAfter]), failingReader{})
+		}
+		header := http.Header{"Etag": []string{`"0x8D5"`}}
+		if status == http.StatusPartialContent {
+			header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", first, last, len(content)))
+		}
+		return &http.Response{
+			StatusCode:    status,
+			Header:        header,
+			ContentLength: int64(len(part)),
+			Body:          ioutil.NopCloser(body),
+			Request:       r,
+		}, nil
+	})
+}
+
+func (s *FileSuite) TestGetFileLargeDownloadToFileResumed(c *chk.C) {
+	client := getFileClient()
+	var ranges []string
+	client.Sender = rangeSender("hello world", 5, &ranges)
+	res, err := client.GetFileLarge(context.Background(), "")
+	c.Assert(err, chk.IsNil)
+	path := filepath.Join(c.MkDir(), "file.bin")
+	n, err := res.DownloadToFile(context.Background(), path)
+	c.Assert(err, chk.IsNil)
+	c.Assert(n, chk.Equals, int64(11))
+	b, err := ioutil.ReadFile(path)
+	c.Assert(err, chk.IsNil)
+	c.Assert(string(b), chk.Equals, "hello world")
+	c.Assert(ranges, chk.DeepEquals, []string{"", "bytes=5-"})
+}
+
+func (s *FileSuite) TestGetFileLargeDownloadToFileResumedWithinRange(c *chk.C) {
+	client := getFileClient()
+	var ranges []string
+	client.Sender = rangeSender("hello world", 3, &ranges)
+	res, err := client.GetFileLarge(context.Background(), "bytes=2-8")
+	c.Assert(err, chk.IsNil)
+	path := filepath.Join(c.MkDir(), "file.bin")
+	n, err := res.DownloadToFile(context.Background(), path)
+	c.Assert(err, chk.IsNil)
+	c.Assert(n, chk.Equals, int64(7))
+	b, err := ioutil.ReadFile(path)
+	c.Assert(err, chk.IsNil)
+	c.Assert(string(b), chk.Equals, "llo wor")
+	// the resumed request starts after the bytes already written, relative to the requested range
+	c.Assert(ranges, chk.DeepEquals, []string{"bytes=2-8", "bytes=5-8"})
+}
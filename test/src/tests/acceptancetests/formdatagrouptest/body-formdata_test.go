@@ -3,11 +3,16 @@ package formdatagrouptest
 import (
 	"bytes"
 	"context"
+	"io"
 	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
 	"testing"
 	"tests/acceptancetests/utils"
 	. "tests/generated/formdatagroup"
 
+	"github.com/Azure/go-autorest/autorest"
 	chk "gopkg.in/check.v1"
 )
 
@@ -16,12 +21,16 @@ func Test(t *testing.T) { chk.TestingT(t) }
 type FormdataSuite struct{}
 
 var _ = chk.Suite(&FormdataSuite{})
+
+var recorder = utils.MustRecorder(NewRecorder(utils.CassettePath("formdatagroup"), utils.RecordingSender(), RecorderOptions{Mode: RecordingMode(utils.RecordingMode())}))
+
 var formdataClient = getFormdataClient()
 
 func getFormdataClient() FormdataClient {
 	c := NewFormdataClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -31,9 +40,9 @@ func (s *FormdataSuite) TestUploadFileViaBody(c *chk.C) {
 	res, err := formdataClient.UploadFileViaBody(context.Background(), ioutil.NopCloser(bytes.NewReader(f)))
 	c.Assert(err, chk.IsNil)
 	buf := new(bytes.Buffer)
-	buf.ReadFrom(*res.Value)
+	buf.ReadFrom(res.Body)
 	b := buf.Bytes()
-	defer (*res.Value).Close()
+	defer res.Body.Close()
 	c.Assert(len(b), chk.Equals, len(f))
 	c.Assert(string(b), chk.Equals, string(f))
 }
@@ -44,9 +53,95 @@ func (s *FormdataSuite) TestUploadFile(c *chk.C) {
 	res, err := formdataClient.UploadFile(context.Background(), ioutil.NopCloser(bytes.NewReader(f)), "samplefile")
 	c.Assert(err, chk.IsNil)
 	buf := new(bytes.Buffer)
-	buf.ReadFrom(*res.Value)
+	buf.ReadFrom(res.Body)
 	b := buf.Bytes()
-	defer (*res.Value).Close()
+	defer res.Body.Close()
 	c.Assert(len(b), chk.Equals, len(f))
 	c.Assert(string(b), chk.Equals, string(f))
 }
+
+type seekableFile struct {
+	*bytes.Reader
+}
+
+func (seekableFile) Close() error { return nil }
+
+// echoFileSender returns a Sender that parses the multipart/form-data body of the request, records the
+// content types of its parts, and responds with the contents of the fileContent part.  Its first failures
+// responses have a retriable status code.  Errors reading the body are returned like a transport would.
+func echoFileSender(c *chk.C, contentTypes map[string]string, failures int) autorest.Sender {
+	return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		if r.ContentLength >= 0 {
+			c.Assert(int64(len(body)), chk.Equals, r.ContentLength)
+		}
+		mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		c.Assert(err, chk.IsNil)
+		c.Assert(mediaType, chk.Equals, "multipart/form-data")
+		var file []byte
+		mr := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+		for {
+			part, err := mr.NextPart()
+			if err == io.EOF {
+				break
+			}
+			c.Assert(err, chk.IsNil)
+			contentTypes[part.FormName()] = part.Header.Get("Content-Type")
+			if part.FormName() == "fileContent" {
+				file, err = ioutil.ReadAll(part)
+				c.Assert(err, chk.IsNil)
+			}
+		}
+		resp := &http.Response{Request: r, StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewReader(file))}
+		if failures > 0 {
+			failures--
+			resp.StatusCode = http.StatusInternalServerError
+		}
+		return resp, nil
+	})
+}
+
+func (s *FormdataSuite) TestUploadFileStreaming(c *chk.C) {
+	f, err := ioutil.ReadFile("../sample.png")
+	c.Assert(err, chk.IsNil)
+	contentTypes := map[string]string{}
+	client := getFormdataClient()
+	client.Sender = echoFileSender(c, contentTypes, 0)
+	var sent, total int64
+	ctx := WithMultipartOptions(context.Background(), MultipartOptions{
+		ContentLength: map[string]int64{"fileContent": int64(len(f))},
+		ContentType:   map[string]string{"fileContent": "image/png"},
+		Progress: func(s, t int64) {
+			sent, total = s, t
+		},
+	})
+	res, err := client.UploadFile(ctx, ioutil.NopCloser(bytes.NewReader(f)), "samplefile")
+	c.Assert(err, chk.IsNil)
+	b, err := ioutil.ReadAll(res.Body)
+	c.Assert(err, chk.IsNil)
+	c.Assert(bytes.Equal(b, f), chk.Equals, true)
+	c.Assert(contentTypes, chk.DeepEquals, map[string]string{"fileContent": "image/png", "fileName": ""})
+	c.Assert(total > int64(len(f)), chk.Equals, true)
+	c.Assert(sent, chk.Equals, total)
+}
+
+func (s *FormdataSuite) TestUploadFileStreamingRetry(c *chk.C) {
+	f, err := ioutil.ReadFile("../sample.png")
+	c.Assert(err, chk.IsNil)
+	client := getFormdataClient()
+	// uploads are POST requests, which are only retried if the policy allows it
+	client.RetryPolicy = ExponentialRetry{MaxRetries: 1, Delay: 1, RetryNonIdempotent: true}
+	client.Sender = echoFileSender(c, map[string]string{}, 1)
+	res, err := client.UploadFile(context.Background(), seekableFile{bytes.NewReader(f)}, "samplefile")
+	c.Assert(err, chk.IsNil)
+	b, err := ioutil.ReadAll(res.Body)
+	c.Assert(err, chk.IsNil)
+	c.Assert(bytes.Equal(b, f), chk.Equals, true)
+
+	client.Sender = echoFileSender(c, map[string]string{}, 1)
+	_, err = client.UploadFile(context.Background(), ioutil.NopCloser(bytes.NewReader(f)), "samplefile")
+	c.Assert(err, chk.ErrorMatches, ".*the multipart/form-data body can't be resent as fileContent isn't an io.Seeker.*")
+}
@@ -3,6 +3,7 @@ package headergrouptest
 import (
 	"context"
 	"encoding/base64"
+	"net/http"
 	"strconv"
 	"strings"
 	"testing"
@@ -10,6 +11,7 @@ import (
 	. "tests/generated/headergroup"
 	"time"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/date"
 	chk "gopkg.in/check.v1"
 )
@@ -20,12 +22,15 @@ type HeaderSuite struct{}
 
 var _ = chk.Suite(&HeaderSuite{})
 
+var recorder = utils.MustRecorder(NewRecorder(utils.CassettePath("headergroup"), utils.RecordingSender(), RecorderOptions{Mode: RecordingMode(utils.RecordingMode())}))
+
 var headerClient = getHeaderClient()
 
 func getHeaderClient() HeaderClient {
 	c := NewHeaderClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -105,6 +110,7 @@ func (s *HeaderSuite) TestHeaderResponseBoolTrue(c *chk.C) {
 	f, err := strconv.ParseBool(res.Response.Header["Value"][0])
 	c.Assert(err, chk.IsNil)
 	c.Assert(f, chk.Equals, true)
+	c.Assert(*res.Value, chk.Equals, true)
 }
 
 func (s *HeaderSuite) TestHeaderParamIntPositive(c *chk.C) {
@@ -118,6 +124,7 @@ func (s *HeaderSuite) TestHeaderResponseIntPositive(c *chk.C) {
 	f, err := strconv.ParseInt(res.Response.Header["Value"][0], 10, 64)
 	c.Assert(err, chk.IsNil)
 	c.Assert(f, chk.Equals, int64(1))
+	c.Assert(*res.Value, chk.Equals, int32(1))
 }
 
 func (s *HeaderSuite) TestHeaderParamIntNegative(c *chk.C) {
@@ -179,6 +186,7 @@ func (s *HeaderSuite) TestHeaderResponseStringValid(c *chk.C) {
 	res, err := headerClient.ResponseString(context.Background(), "valid")
 	c.Assert(err, chk.IsNil)
 	c.Assert(res.Response.Header["Value"][0], chk.Equals, "The quick brown fox jumps over the lazy dog")
+	c.Assert(*res.Value, chk.Equals, "The quick brown fox jumps over the lazy dog")
 }
 
 func (s *HeaderSuite) TestHeaderResponseStringNull(c *chk.C) {
@@ -197,6 +205,7 @@ func (s *HeaderSuite) TestHeaderResponseEnumValid(c *chk.C) {
 	res, err := headerClient.ResponseEnum(context.Background(), "valid")
 	c.Assert(err, chk.IsNil)
 	c.Assert(res.Response.Header["Value"][0], chk.Equals, "GREY")
+	c.Assert(res.Value, chk.Equals, GREY)
 }
 
 func (s *HeaderSuite) TestHeaderParamEnumValid(c *chk.C) {
@@ -208,6 +217,7 @@ func (s *HeaderSuite) TestHeaderResponseEnumNull(c *chk.C) {
 	res, err := headerClient.ResponseEnum(context.Background(), "null")
 	c.Assert(err, chk.IsNil)
 	c.Assert(res.Response.Header["Value"][0], chk.Equals, "")
+	c.Assert(res.Value, chk.Equals, GreyscaleColors(""))
 }
 
 // String can't be null in Go
@@ -325,3 +335,51 @@ func (s *HeaderSuite) TestHeaderResponseProtectedKey(c *chk.C) {
 		c.Errorf("Expected to contain '%v', got %v\n", "text/html", res.Response.Header["Content-Type"][0])
 	}
 }
+
+func (s *HeaderSuite) TestLoggerRedactsSecretParameters(c *chk.C) {
+	// value is marked x-ms-secret and User-Agent has the password format in test/config/headergroup.md
+	client := NewHeaderClient()
+	headers := http.Header{}
+	client.Sender = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		for k, v := range r.Header {
+			headers[k] = v
+		}
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: http.NoBody, Request: r}, nil
+	})
+	var events []LogEvent
+	client.Logger = LoggerFunc(func(e LogEvent) { events = append(events, e) })
+	_, err := client.ParamString(context.Background(), "valid", "The quick brown fox jumps over the lazy dog")
+	c.Assert(err, chk.IsNil)
+	c.Assert(events[0].Kind, chk.Equals, LogOperationStart)
+	c.Assert(events[0].Fields, chk.DeepEquals, map[string]interface{}{"scenario": "valid", "value": "REDACTED"})
+	c.Assert(headers.Get("value"), chk.Equals, "The quick brown fox jumps over the lazy dog")
+
+	events = nil
+	_, err = client.ParamExistingKey(context.Background(), "overwrite")
+	c.Assert(err, chk.IsNil)
+	c.Assert(events[0].Kind, chk.Equals, LogOperationStart)
+	c.Assert(events[0].Fields, chk.DeepEquals, map[string]interface{}{"User-Agent": "REDACTED"})
+	c.Assert(headers.Get("User-Agent"), chk.Equals, "overwrite")
+	for _, e := range events {
+		c.Assert(strings.Contains(e.String(), "overwrite"), chk.Equals, false)
+	}
+}
+
+func (s *HeaderSuite) TestHeaderParamDateTimeRFC1123SendsValue(c *chk.C) {
+	// value is an optional date.TimeRFC1123 header, passed as a pointer
+	client := NewHeaderClient()
+	headers := http.Header{}
+	client.Sender = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		headers = r.Header
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: http.NoBody, Request: r}, nil
+	})
+	d := utils.ToDateTimeRFC1123("Fri, 01 Jan 2010 12:34:56 GMT")
+	_, err := client.ParamDatetimeRfc1123(context.Background(), "valid", &d)
+	c.Assert(err, chk.IsNil)
+	c.Assert(headers.Get("value"), chk.Equals, "Fri, 01 Jan 2010 12:34:56 GMT")
+
+	headers = http.Header{}
+	_, err = client.ParamDatetimeRfc1123(context.Background(), "null", nil)
+	c.Assert(err, chk.IsNil)
+	c.Assert(headers["Value"], chk.IsNil)
+}
@@ -18,12 +18,15 @@ type IntegerSuite struct{}
 
 var _ = chk.Suite(&IntegerSuite{})
 
+var recorder = utils.MustRecorder(NewRecorder(utils.CassettePath("integergroup"), utils.RecordingSender(), RecorderOptions{Mode: RecordingMode(utils.RecordingMode())}))
+
 var intClient = getIntegerClient()
 
 func getIntegerClient() IntClient {
 	c := NewIntClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
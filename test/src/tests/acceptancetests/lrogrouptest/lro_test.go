@@ -3,11 +3,13 @@ package lrogrouptest
 import (
 	"context"
 	"net/http"
+	"strings"
 	"testing"
 	"tests/acceptancetests/utils"
 	"tests/generated/lrogroup"
 	"time"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/to"
 	chk "gopkg.in/check.v1"
 )
@@ -18,6 +20,8 @@ type LROSuite struct{}
 
 var _ = chk.Suite(&LROSuite{})
 
+var recorder = utils.MustRecorder(lrogroup.NewRecorder(utils.CassettePath("lrogroup"), utils.RecordingSender(), lrogroup.RecorderOptions{Mode: lrogroup.RecordingMode(utils.RecordingMode())}))
+
 var lroRetryClient = getLRORetrysClient()
 var lrosClient = getLROsClient()
 var lroSADSClient = getLROSADsClient()
@@ -28,6 +32,7 @@ func getLRORetrysClient() lrogroup.LRORetrysClient {
 	c.RetryDuration = 1
 	c.PollingDelay = time.Second
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -36,6 +41,7 @@ func getLROsClient() lrogroup.LROsClient {
 	c.RetryDuration = 1
 	c.PollingDelay = time.Second
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -44,6 +50,7 @@ func getLROSADsClient() lrogroup.LROSADsClient {
 	c.RetryDuration = 1
 	c.PollingDelay = time.Second
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -52,6 +59,7 @@ func getLROsCustomHeaderClient() lrogroup.LROsCustomHeaderClient {
 	c.RetryDuration = 1
 	c.PollingDelay = time.Second
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -125,6 +133,173 @@ func (s *LROSuite) TestRetryPutAsyncRelativeRetrySucceeded(c *chk.C) {
 	c.Assert(r.Name, chk.NotNil)
 }
 
+func (s *LROSuite) TestRetryPutAsyncRelativeRetrySucceededPollUntilDone(c *chk.C) {
+	future, err := lroRetryClient.PutAsyncRelativeRetrySucceeded(context.Background(), &lrogroup.Product{})
+	c.Assert(err, chk.IsNil)
+	statuses := []string{}
+	r, err := future.PollUntilDone(context.Background(), lroRetryClient, lrogroup.PollUntilDoneOptions{
+		Frequency: time.Second,
+		Progress:  func(status string) { statuses = append(statuses, status) },
+	})
+	c.Assert(err, chk.IsNil)
+	c.Assert(r.StatusCode, chk.Equals, http.StatusOK)
+	c.Assert(r.Name, chk.NotNil)
+	c.Assert(statuses, chk.Not(chk.HasLen), 0)
+}
+
+func (s *LROSuite) TestPollUntilDoneCancelled(c *chk.C) {
+	future, err := lrosClient.Put201CreatingSucceeded200(context.Background(), &lrogroup.Product{})
+	c.Assert(err, chk.IsNil)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = future.PollUntilDone(ctx, lrosClient, lrogroup.PollUntilDoneOptions{})
+	c.Assert(err, chk.FitsTypeOf, autorest.DetailedError{})
+	c.Assert(err.(autorest.DetailedError).Original, chk.Equals, context.Canceled)
+}
+
+func (s *LROSuite) TestPollUntilDoneMaxWait(c *chk.C) {
+	future, err := lrosClient.Put201CreatingSucceeded200(context.Background(), &lrogroup.Product{})
+	c.Assert(err, chk.IsNil)
+	_, err = future.PollUntilDone(context.Background(), lrosClient, lrogroup.PollUntilDoneOptions{MaxWait: time.Nanosecond})
+	c.Assert(err, chk.FitsTypeOf, autorest.DetailedError{})
+	c.Assert(err.(autorest.DetailedError).Original, chk.Equals, context.DeadlineExceeded)
+}
+
+func (s *LROSuite) TestRetryPutAsyncRelativeRetrySucceededResume(c *chk.C) {
+	future, err := lroRetryClient.PutAsyncRelativeRetrySucceeded(context.Background(), &lrogroup.Product{})
+	c.Assert(err, chk.IsNil)
+	token, err := future.ResumeToken()
+	c.Assert(err, chk.IsNil)
+	_, err = lroRetryClient.ResumePut201CreatingSucceeded200(context.Background(), token)
+	c.Assert(err, chk.NotNil)
+	resumed, err := lroRetryClient.ResumePutAsyncRelativeRetrySucceeded(context.Background(), token)
+	c.Assert(err, chk.IsNil)
+	c.Assert(resumed.PollingURL(), chk.Equals, future.PollingURL())
+	err = resumed.WaitForCompletionRef(context.Background(), lroRetryClient.Client)
+	c.Assert(err, chk.IsNil)
+	r, err := resumed.Result(lroRetryClient)
+	c.Assert(err, chk.IsNil)
+	c.Assert(r.StatusCode, chk.Equals, http.StatusOK)
+	c.Assert(r.Name, chk.NotNil)
+}
+
+// initialSender responds to each request with the next of statuses, and with the last one once they run out.
+// Accepted responses point to a polling location.
+type initialSender struct {
+	statuses []int
+	requests int
+}
+
+func (is *initialSender) Do(r *http.Request) (*http.Response, error) {
+	status := is.statuses[len(is.statuses)-1]
+	if is.requests < len(is.statuses) {
+		status = is.statuses[is.requests]
+	}
+	is.requests++
+	resp := &http.Response{StatusCode: status, Header: http.Header{}, Body: http.NoBody, Request: r}
+	if status == http.StatusAccepted {
+		resp.Header.Set("Location", "http://localhost/lro/poll")
+	}
+	return resp, nil
+}
+
+func (s *LROSuite) TestRetryPolicyRetriesInitialRequestsMarkedIdempotent(c *chk.C) {
+	// Post202Retry200 of LRORetrysClient is marked x-ms-idempotent by test/config/lrogroup.md
+	client := lrogroup.NewLRORetrysClient()
+	client.RetryDuration = 1
+	sender := &initialSender{statuses: []int{http.StatusInternalServerError, http.StatusAccepted}}
+	client.Sender = sender
+	future, err := client.Post202Retry200(context.Background(), &lrogroup.Product{})
+	c.Assert(err, chk.IsNil)
+	c.Assert(future.PollingURL(), chk.Equals, "http://localhost/lro/poll")
+	c.Assert(sender.requests, chk.Equals, 2)
+}
+
+func (s *LROSuite) TestRetryPolicySkipsInitialPostRequests(c *chk.C) {
+	client := lrogroup.NewLROsClient()
+	client.RetryDuration = 1
+	sender := &initialSender{statuses: []int{http.StatusInternalServerError, http.StatusAccepted}}
+	client.Sender = sender
+	_, err := client.Post202Retry200(context.Background(), &lrogroup.Product{})
+	c.Assert(err, chk.NotNil)
+	c.Assert(sender.requests, chk.Equals, 1)
+}
+
+// pollSender accepts the initial request of a long-running operation and responds to the polls that follow with
+// the next of statuses, and with the last one once they run out.
+type pollSender struct {
+	statuses []int
+	polls    int
+}
+
+func (ps *pollSender) Do(r *http.Request) (*http.Response, error) {
+	status := http.StatusAccepted
+	if r.Method == http.MethodGet {
+		status = ps.statuses[len(ps.statuses)-1]
+		if ps.polls < len(ps.statuses) {
+			status = ps.statuses[ps.polls]
+		}
+		ps.polls++
+	}
+	resp := &http.Response{StatusCode: status, Header: http.Header{}, Body: http.NoBody, Request: r}
+	if status == http.StatusAccepted {
+		resp.Header.Set("Location", "http://localhost/lro/poll")
+	}
+	return resp, nil
+}
+
+// methodsPolicy returns a PipelinePolicy appending the method of each request it sees to methods.
+func methodsPolicy(methods *[]string) lrogroup.PipelinePolicy {
+	return func(req *http.Request, next autorest.Sender) (*http.Response, error) {
+		*methods = append(*methods, req.Method)
+		return next.Do(req)
+	}
+}
+
+func (s *LROSuite) TestPollUntilDoneSendsPollsThroughPipeline(c *chk.C) {
+	client := lrogroup.NewLROsClient()
+	client.RetryDuration = 1
+	methods := []string{}
+	client.Pipeline = []lrogroup.PipelinePolicy{methodsPolicy(&methods)}
+	sender := &pollSender{statuses: []int{http.StatusServiceUnavailable, http.StatusAccepted, http.StatusOK}}
+	client.Sender = sender
+	future, err := client.Post202Retry200(context.Background(), &lrogroup.Product{})
+	c.Assert(err, chk.IsNil)
+	r, err := future.PollUntilDone(context.Background(), client, lrogroup.PollUntilDoneOptions{Frequency: time.Nanosecond})
+	c.Assert(err, chk.IsNil)
+	c.Assert(r.StatusCode, chk.Equals, http.StatusOK)
+	// the failed poll is retried by the retry policy
+	c.Assert(methods, chk.DeepEquals, []string{http.MethodPost, http.MethodGet, http.MethodGet, http.MethodGet})
+}
+
+func (s *LROSuite) TestPollUntilDoneReturnsFailuresAtOnce(c *chk.C) {
+	client := lrogroup.NewLROsClient()
+	client.RetryDuration = time.Hour
+	sender := &pollSender{statuses: []int{http.StatusBadRequest}}
+	client.Sender = sender
+	future, err := client.Post202Retry200(context.Background(), &lrogroup.Product{})
+	c.Assert(err, chk.IsNil)
+	start := time.Now()
+	_, err = future.PollUntilDone(context.Background(), client, lrogroup.PollUntilDoneOptions{Frequency: time.Nanosecond})
+	c.Assert(err, chk.NotNil)
+	c.Assert(sender.polls, chk.Equals, 1)
+	c.Assert(time.Since(start) < time.Minute, chk.Equals, true)
+}
+
+func (s *LROSuite) TestResultSendsPollThroughPipeline(c *chk.C) {
+	client := lrogroup.NewLROsClient()
+	client.RetryDuration = 1
+	methods := []string{}
+	client.Pipeline = []lrogroup.PipelinePolicy{methodsPolicy(&methods)}
+	client.Sender = &pollSender{statuses: []int{http.StatusServiceUnavailable, http.StatusOK}}
+	future, err := client.Post202Retry200(context.Background(), &lrogroup.Product{})
+	c.Assert(err, chk.IsNil)
+	r, err := future.Result(client)
+	c.Assert(err, chk.IsNil)
+	c.Assert(r.StatusCode, chk.Equals, http.StatusOK)
+	c.Assert(methods, chk.DeepEquals, []string{http.MethodPost, http.MethodGet, http.MethodGet})
+}
+
 // vanilla client
 
 func (s *LROSuite) TestDelete202NoRetry204(c *chk.C) {
@@ -661,3 +836,86 @@ func (s *LROSuite) TestCustomHeaderPut201CreatingSucceeded200(c *chk.C) {
 func (s *LROSuite) TestCustomHeaderPutAsyncRetrySucceeded(c *chk.C) {
 	// TODO
 }
+
+func (s *LROSuite) TestDelete202Retry200Logging(c *chk.C) {
+	client := lrogroup.NewLROsClient()
+	client.BaseURI = "http://localhost"
+	polls := 0
+	client.Sender = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		resp := &http.Response{StatusCode: http.StatusAccepted, Header: http.Header{}, Body: http.NoBody, Request: r}
+		resp.Header.Set("Location", "http://localhost/lro/poll?sig=secret")
+		if r.Method == http.MethodGet {
+			polls++
+			if polls == 2 {
+				resp.StatusCode = http.StatusOK
+			}
+		}
+		return resp, nil
+	})
+	var events []lrogroup.LogEvent
+	client.Logger = lrogroup.LoggerFunc(func(e lrogroup.LogEvent) { events = append(events, e) })
+	future, err := client.Delete202Retry200(context.Background())
+	c.Assert(err, chk.IsNil)
+	_, err = future.PollUntilDone(context.Background(), client, lrogroup.PollUntilDoneOptions{Frequency: time.Millisecond})
+	c.Assert(err, chk.IsNil)
+	kinds := []lrogroup.LogEventKind{}
+	for _, e := range events {
+		c.Assert(e.Operation, chk.Equals, "LROsClient.Delete202Retry200")
+		c.Assert(strings.Contains(e.String(), "secret"), chk.Equals, false)
+		kinds = append(kinds, e.Kind)
+	}
+	c.Assert(kinds, chk.DeepEquals, []lrogroup.LogEventKind{lrogroup.LogOperationStart, lrogroup.LogOperationFinish, lrogroup.LogPoll, lrogroup.LogPoll})
+	c.Assert(events[1].StatusCode, chk.Equals, http.StatusAccepted)
+	c.Assert(events[2].State, chk.Equals, "InProgress")
+	c.Assert(events[3].State, chk.Equals, "Succeeded")
+	c.Assert(events[3].StatusCode, chk.Equals, http.StatusOK)
+}
+
+type parentKey struct{}
+
+// spanRecorder is a lrogroup.Tracer recording the spans it starts as child/parent name pairs.
+type spanRecorder struct {
+	spans [][2]string
+}
+
+type noopSpan struct{}
+
+func (sr *spanRecorder) StartSpan(ctx context.Context, name string) (context.Context, lrogroup.Span) {
+	parent, _ := ctx.Value(parentKey{}).(string)
+	sr.spans = append(sr.spans, [2]string{name, parent})
+	return context.WithValue(ctx, parentKey{}, name), noopSpan{}
+}
+
+func (noopSpan) SetAttribute(key string, value interface{}) {}
+
+func (noopSpan) End(err error) {}
+
+func (s *LROSuite) TestDelete202Retry200Tracing(c *chk.C) {
+	client := lrogroup.NewLROsClient()
+	client.BaseURI = "http://localhost"
+	polls := 0
+	client.Sender = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		resp := &http.Response{StatusCode: http.StatusAccepted, Header: http.Header{}, Body: http.NoBody, Request: r}
+		resp.Header.Set("Location", "http://localhost/lro/poll")
+		if r.Method == http.MethodGet {
+			polls++
+			if polls == 2 {
+				resp.StatusCode = http.StatusOK
+			}
+		}
+		return resp, nil
+	})
+	tracer := &spanRecorder{}
+	client.Tracer = tracer
+	future, err := client.Delete202Retry200(context.Background())
+	c.Assert(err, chk.IsNil)
+	_, err = future.PollUntilDone(context.Background(), client, lrogroup.PollUntilDoneOptions{Frequency: time.Millisecond})
+	c.Assert(err, chk.IsNil)
+	const poller = "tests/generated/lrogroup/LROsDelete202Retry200Future.PollUntilDone"
+	c.Assert(tracer.spans, chk.DeepEquals, [][2]string{
+		{"tests/generated/lrogroup/LROsClient.Delete202Retry200", ""},
+		{poller, ""},
+		{poller + "/poll", poller},
+		{poller + "/poll", poller},
+	})
+}
@@ -16,12 +16,15 @@ type ModelFlatteningSuite struct{}
 
 var _ = chk.Suite(&ModelFlatteningSuite{})
 
+var recorder = utils.MustRecorder(NewRecorder(utils.CassettePath("modelflatteninggroup"), utils.RecordingSender(), RecorderOptions{Mode: RecordingMode(utils.RecordingMode())}))
+
 var modelflatteningClient = getmodelflatteningClient()
 
 func getmodelflatteningClient() BaseClient {
 	c := New()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
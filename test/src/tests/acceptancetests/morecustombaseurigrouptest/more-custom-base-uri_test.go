@@ -2,9 +2,14 @@ package morecustombaseurigrouptest
 
 import (
 	"context"
+	"net/http"
+	"strings"
 	"testing"
+	"tests/acceptancetests/utils"
 	. "tests/generated/morecustombaseurigroup"
+	"time"
 
+	"github.com/Azure/go-autorest/autorest"
 	chk "gopkg.in/check.v1"
 )
 
@@ -14,11 +19,14 @@ type MoreCustomBaseURIGroupSuite struct{}
 
 var _ = chk.Suite(&MoreCustomBaseURIGroupSuite{})
 
+var recorder = utils.MustRecorder(NewRecorder(utils.CassettePath("morecustombaseurigroup"), utils.RecordingSender(), RecorderOptions{Mode: RecordingMode(utils.RecordingMode())}))
+
 var custombaseuriClient = getMoreCustomBaseURIClient()
 
 func getMoreCustomBaseURIClient() PathsClient {
 	c := NewWithoutDefaults("test12", "host:3000")
 	c.RetryDuration = 1
+	c.Sender = recorder
 	return PathsClient{BaseClient: c}
 }
 
@@ -26,3 +34,39 @@ func (s *MoreCustomBaseURIGroupSuite) TestCustomBaseUriMoreOptions(c *chk.C) {
 	_, err := custombaseuriClient.GetEmpty(context.Background(), "http://lo", "cal", "key1", "v1")
 	c.Assert(err, chk.IsNil)
 }
+
+func (s *MoreCustomBaseURIGroupSuite) TestNewWithOptions(c *chk.C) {
+	sender := &http.Client{}
+	client, err := NewPathsClientWithOptions(ClientOptions{
+		Authorizer:      autorest.NewBearerAuthorizer(nil),
+		RetryAttempts:   -1,
+		RetryDuration:   time.Second,
+		HTTPClient:      sender,
+		UserAgentSuffix: "myapp/1.0",
+		SubscriptionID:  "test12",
+	})
+	c.Assert(err, chk.IsNil)
+	c.Assert(client.SubscriptionID, chk.Equals, "test12")
+	c.Assert(client.DNSSuffix, chk.Equals, DefaultDNSSuffix)
+	c.Assert(client.Authorizer, chk.NotNil)
+	c.Assert(client.RetryAttempts, chk.Equals, 0)
+	c.Assert(client.RetryDuration, chk.Equals, time.Second)
+	c.Assert(client.Sender, chk.Equals, autorest.Sender(sender))
+	c.Assert(strings.HasSuffix(client.UserAgent, " myapp/1.0"), chk.Equals, true)
+}
+
+func (s *MoreCustomBaseURIGroupSuite) TestNewWithOptionsDefaults(c *chk.C) {
+	client, err := NewWithOptions(ClientOptions{SubscriptionID: "test12", DNSSuffix: "host:3000"})
+	c.Assert(err, chk.IsNil)
+	c.Assert(client.DNSSuffix, chk.Equals, "host:3000")
+	c.Assert(client.RetryAttempts, chk.Equals, autorest.DefaultRetryAttempts)
+	c.Assert(client.RetryDuration, chk.Equals, autorest.DefaultRetryDuration)
+	c.Assert(client.Sender, chk.NotNil)
+}
+
+func (s *MoreCustomBaseURIGroupSuite) TestNewWithOptionsValidation(c *chk.C) {
+	_, err := NewPathsClientWithOptions(ClientOptions{})
+	c.Assert(err, chk.ErrorMatches, ".*: Invalid input: SubscriptionID must be set")
+	_, err = NewWithOptions(ClientOptions{SubscriptionID: "test12", RetryDuration: -time.Second})
+	c.Assert(err, chk.ErrorMatches, ".*RetryDuration can't be negative")
+}
@@ -18,12 +18,15 @@ type NumberSuite struct{}
 
 var _ = chk.Suite(&NumberSuite{})
 
+var recorder = utils.MustRecorder(NewRecorder(utils.CassettePath("numbergroup"), utils.RecordingSender(), RecorderOptions{Mode: RecordingMode(utils.RecordingMode())}))
+
 var numberClient = getNumberClient()
 
 func getNumberClient() NumberClient {
 	c := NewNumberClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -2,10 +2,17 @@ package paginggrouptest
 
 import (
 	"context"
+	"fmt"
+	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strings"
+	"sync"
 	"testing"
 	"tests/acceptancetests/utils"
 	"tests/generated/paginggroup"
+	"tests/generated/paginggroup/paginggroupapi"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest"
 	chk "gopkg.in/check.v1"
@@ -17,6 +24,8 @@ type PagingGroupSuite struct{}
 
 var _ = chk.Suite(&PagingGroupSuite{})
 
+var recorder = utils.MustRecorder(paginggroup.NewRecorder(utils.CassettePath("paginggroup"), utils.RecordingSender(), paginggroup.RecorderOptions{Mode: paginggroup.RecordingMode(utils.RecordingMode())}))
+
 var pagingClient = getPagingClient()
 var clientID = "client-id"
 
@@ -24,13 +33,59 @@ func getPagingClient() paginggroup.PagingClient {
 	c := paginggroup.NewPagingClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
+// cannedPagesSender answers requests with the bodies of pages keyed by their URL path, optionally followed by their
+// query, so that enumerations run without the test server.  Requests for other URLs fail with status code 400.  The
+// URLs requested are recorded.
+type cannedPagesSender struct {
+	pages     map[string]string
+	mu        sync.Mutex
+	requested []string
+}
+
+func (cps *cannedPagesSender) Do(r *http.Request) (*http.Response, error) {
+	cps.mu.Lock()
+	cps.requested = append(cps.requested, r.URL.RequestURI())
+	cps.mu.Unlock()
+	resp := &http.Response{
+		Request:    r,
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+	}
+	body, ok := cps.pages[r.URL.RequestURI()]
+	if !ok {
+		body, ok = cps.pages[r.URL.Path]
+	}
+	if !ok {
+		resp.StatusCode = http.StatusBadRequest
+		body = `{"message":"no such page"}`
+	}
+	resp.Body = ioutil.NopCloser(strings.NewReader(body))
+	return resp, nil
+}
+
+func (cps *cannedPagesSender) urls() []string {
+	cps.mu.Lock()
+	defer cps.mu.Unlock()
+	return append([]string(nil), cps.requested...)
+}
+
+func getCannedPagingClient(pages map[string]string) (paginggroup.PagingClient, *cannedPagesSender) {
+	c := paginggroup.NewPagingClient()
+	c.RetryDuration = 1
+	c.BaseURI = "http://localhost"
+	sender := &cannedPagesSender{pages: pages}
+	c.Sender = sender
+	return c, sender
+}
+
 func (s *PagingGroupSuite) TestGetMultiplePages(c *chk.C) {
 	// Get pages one by one...
 	count := 0
-	for page, err := pagingClient.GetMultiplePages(context.Background(), clientID, nil, nil); page.NotDone(); err = page.Next() {
+	for page, err := pagingClient.GetMultiplePages(context.Background(), &paginggroup.PagingGetMultiplePagesOptions{ClientRequestID: clientID}); page.NotDone(); err = page.Next() {
 		c.Assert(err, chk.IsNil)
 		c.Assert(page.Values(), chk.NotNil)
 		count++
@@ -39,7 +94,7 @@ func (s *PagingGroupSuite) TestGetMultiplePages(c *chk.C) {
 
 	// Get all!
 	count = 0
-	for iter, err := pagingClient.GetMultiplePagesComplete(context.Background(), clientID, nil, nil); iter.NotDone(); err = iter.Next() {
+	for iter, err := pagingClient.GetMultiplePagesComplete(context.Background(), &paginggroup.PagingGetMultiplePagesOptions{ClientRequestID: clientID}); iter.NotDone(); err = iter.Next() {
 		c.Assert(err, chk.IsNil)
 		c.Assert(iter.Value().Properties, chk.NotNil)
 		count++
@@ -47,6 +102,98 @@ func (s *PagingGroupSuite) TestGetMultiplePages(c *chk.C) {
 	c.Assert(count, chk.Equals, 10)
 }
 
+func (s *PagingGroupSuite) TestGetMultiplePagesWithPrefetch(c *chk.C) {
+	first, err := pagingClient.GetMultiplePages(context.Background(), &paginggroup.PagingGetMultiplePagesOptions{ClientRequestID: clientID})
+	c.Assert(err, chk.IsNil)
+	page, cancel := paginggroup.NewProductResultPageWithPrefetch(context.Background(), first, 3)
+	defer cancel()
+	count := 0
+	for ; page.NotDone(); err = page.NextWithContext(context.Background()) {
+		c.Assert(err, chk.IsNil)
+		c.Assert(page.Values(), chk.NotNil)
+		count++
+	}
+	c.Assert(err, chk.IsNil)
+	c.Assert(count, chk.Equals, 10)
+}
+
+func (s *PagingGroupSuite) TestGetMultiplePagesWithPrefetchStopEarly(c *chk.C) {
+	first, err := pagingClient.GetMultiplePages(context.Background(), &paginggroup.PagingGetMultiplePagesOptions{ClientRequestID: clientID})
+	c.Assert(err, chk.IsNil)
+	page, cancel := paginggroup.NewProductResultPageWithPrefetch(context.Background(), first, 3)
+	err = page.NextWithContext(context.Background())
+	c.Assert(err, chk.IsNil)
+	cancel()
+	err = page.NextWithContext(context.Background())
+	c.Assert(err, chk.Equals, context.Canceled)
+}
+
+// productIDs returns the IDs of the products in page.
+func productIDs(page paginggroup.ProductResultPage) []int32 {
+	ids := []int32{}
+	for _, p := range page.Values() {
+		ids = append(ids, *p.Properties.ID)
+	}
+	return ids
+}
+
+func (s *PagingGroupSuite) TestGetMultiplePagesWithPrefetchOrder(c *chk.C) {
+	client, sender := getCannedPagingClient(map[string]string{
+		"/paging/multiple":   `{"values":[{"properties":{"id":1}}],"nextLink":"http://localhost/paging/multiple/2"}`,
+		"/paging/multiple/2": `{"values":[{"properties":{"id":2}}],"nextLink":"http://localhost/paging/multiple/3"}`,
+		"/paging/multiple/3": `{"values":[{"properties":{"id":3}}],"nextLink":"http://localhost/paging/multiple/4"}`,
+		"/paging/multiple/4": `{"values":[{"properties":{"id":4}}]}`,
+	})
+	first, err := client.GetMultiplePages(context.Background(), nil)
+	c.Assert(err, chk.IsNil)
+	page, cancel := paginggroup.NewProductResultPageWithPrefetch(context.Background(), first, 2)
+	defer cancel()
+	ids := []int32{}
+	for ; page.NotDone(); err = page.NextWithContext(context.Background()) {
+		c.Assert(err, chk.IsNil)
+		ids = append(ids, productIDs(page)...)
+	}
+	c.Assert(err, chk.IsNil)
+	c.Assert(ids, chk.DeepEquals, []int32{1, 2, 3, 4})
+	c.Assert(sender.urls(), chk.DeepEquals, []string{"/paging/multiple", "/paging/multiple/2", "/paging/multiple/3", "/paging/multiple/4"})
+}
+
+func (s *PagingGroupSuite) TestGetMultiplePagesWithPrefetchFailure(c *chk.C) {
+	// the third page fails: the pages prefetched before it are returned first, then its error
+	client, sender := getCannedPagingClient(map[string]string{
+		"/paging/multiple":   `{"values":[{"properties":{"id":1}}],"nextLink":"http://localhost/paging/multiple/2"}`,
+		"/paging/multiple/2": `{"values":[{"properties":{"id":2}}],"nextLink":"http://localhost/paging/multiple/missing"}`,
+	})
+	first, err := client.GetMultiplePages(context.Background(), nil)
+	c.Assert(err, chk.IsNil)
+	page, cancel := paginggroup.NewProductResultPageWithPrefetch(context.Background(), first, 3)
+	defer cancel()
+	c.Assert(page.NextWithContext(context.Background()), chk.IsNil)
+	c.Assert(productIDs(page), chk.DeepEquals, []int32{2})
+	err = page.NextWithContext(context.Background())
+	detErr, ok := err.(autorest.DetailedError)
+	c.Assert(ok, chk.Equals, true)
+	c.Assert(detErr.StatusCode, chk.Equals, http.StatusBadRequest)
+	c.Assert(productIDs(page), chk.DeepEquals, []int32{2})
+	// prefetching stopped at the error, which is returned again
+	c.Assert(page.NextWithContext(context.Background()), chk.DeepEquals, err)
+	c.Assert(sender.urls(), chk.DeepEquals, []string{"/paging/multiple", "/paging/multiple/2", "/paging/multiple/missing"})
+}
+
+func (s *PagingGroupSuite) TestGetMultiplePagesFromToken(c *chk.C) {
+	first, err := pagingClient.GetMultiplePages(context.Background(), &paginggroup.PagingGetMultiplePagesOptions{ClientRequestID: clientID})
+	c.Assert(err, chk.IsNil)
+	token := first.ContinuationToken()
+	c.Assert(token, chk.Not(chk.Equals), "")
+	count := 0
+	for page, err := pagingClient.GetMultiplePagesFromToken(context.Background(), token); page.NotDone(); err = page.Next() {
+		c.Assert(err, chk.IsNil)
+		c.Assert(page.Values(), chk.NotNil)
+		count++
+	}
+	c.Assert(count, chk.Equals, 9)
+}
+
 func (s *PagingGroupSuite) TestGetSinglePages(c *chk.C) {
 	page, err := pagingClient.GetSinglePages(context.Background())
 	c.Assert(err, chk.IsNil)
@@ -61,7 +208,7 @@ func (s *PagingGroupSuite) TestGetSinglePages(c *chk.C) {
 
 func (s *PagingGroupSuite) TestGetOdataMultiplePages(c *chk.C) {
 	count := 0
-	for page, err := pagingClient.GetOdataMultiplePages(context.Background(), clientID, nil, nil); page.NotDone(); err = page.Next() {
+	for page, err := pagingClient.GetOdataMultiplePages(context.Background(), &paginggroup.PagingGetOdataMultiplePagesOptions{ClientRequestID: clientID}); page.NotDone(); err = page.Next() {
 		c.Assert(err, chk.IsNil)
 		c.Assert(page.Values(), chk.NotNil)
 		count++
@@ -69,7 +216,7 @@ func (s *PagingGroupSuite) TestGetOdataMultiplePages(c *chk.C) {
 	c.Assert(count, chk.Equals, 10)
 
 	count = 0
-	for iter, err := pagingClient.GetOdataMultiplePagesComplete(context.Background(), clientID, nil, nil); iter.NotDone(); err = iter.Next() {
+	for iter, err := pagingClient.GetOdataMultiplePagesComplete(context.Background(), &paginggroup.PagingGetOdataMultiplePagesOptions{ClientRequestID: clientID}); iter.NotDone(); err = iter.Next() {
 		c.Assert(err, chk.IsNil)
 		c.Assert(iter.Value().Properties, chk.NotNil)
 		count++
@@ -80,7 +227,7 @@ func (s *PagingGroupSuite) TestGetOdataMultiplePages(c *chk.C) {
 func (s *PagingGroupSuite) TestGetMultiplePagesWithOffset(c *chk.C) {
 	count := 0
 	var id int32
-	for page, err := pagingClient.GetMultiplePagesWithOffset(context.Background(), 100, clientID, nil, nil); page.NotDone(); err = page.Next() {
+	for page, err := pagingClient.GetMultiplePagesWithOffset(context.Background(), 100, &paginggroup.PagingGetMultiplePagesWithOffsetOptions{ClientRequestID: clientID}); page.NotDone(); err = page.Next() {
 		c.Assert(err, chk.IsNil)
 		c.Assert(page.Values(), chk.NotNil)
 		count++
@@ -90,7 +237,7 @@ func (s *PagingGroupSuite) TestGetMultiplePagesWithOffset(c *chk.C) {
 	c.Assert(id, chk.Equals, int32(110))
 
 	count = 0
-	for iter, err := pagingClient.GetMultiplePagesWithOffsetComplete(context.Background(), 100, clientID, nil, nil); iter.NotDone(); err = iter.Next() {
+	for iter, err := pagingClient.GetMultiplePagesWithOffsetComplete(context.Background(), 100, &paginggroup.PagingGetMultiplePagesWithOffsetOptions{ClientRequestID: clientID}); iter.NotDone(); err = iter.Next() {
 		c.Assert(err, chk.IsNil)
 		c.Assert(iter.Value().Properties, chk.NotNil)
 		count++
@@ -167,6 +314,78 @@ func (s *PagingGroupSuite) TestGetMultiplePagesFailure(c *chk.C) {
 	c.Assert(count, chk.Equals, 1)
 }
 
+func (s *PagingGroupSuite) TestGetMultiplePagesForEach(c *chk.C) {
+	iter, err := pagingClient.GetMultiplePagesComplete(context.Background(), &paginggroup.PagingGetMultiplePagesOptions{ClientRequestID: clientID})
+	c.Assert(err, chk.IsNil)
+	count := 0
+	err = iter.ForEach(context.Background(), func(p paginggroup.Product) error {
+		c.Assert(p.Properties, chk.NotNil)
+		count++
+		return nil
+	})
+	c.Assert(err, chk.IsNil)
+	c.Assert(count, chk.Equals, 10)
+
+	page, err := pagingClient.GetMultiplePages(context.Background(), &paginggroup.PagingGetMultiplePagesOptions{ClientRequestID: clientID})
+	c.Assert(err, chk.IsNil)
+	count = 0
+	err = page.ForEach(context.Background(), func(p paginggroup.Product) error {
+		count++
+		if count == 3 {
+			return paginggroup.ErrStopIteration
+		}
+		return nil
+	})
+	c.Assert(err, chk.IsNil)
+	c.Assert(count, chk.Equals, 3)
+}
+
+func (s *PagingGroupSuite) TestGetMultiplePagesForEachWrappedStop(c *chk.C) {
+	client, sender := getCannedPagingClient(map[string]string{
+		"/paging/multiple":   `{"values":[{"properties":{"id":1}},{"properties":{"id":2}}],"nextLink":"http://localhost/paging/multiple/2"}`,
+		"/paging/multiple/2": `{"values":[{"properties":{"id":3}}]}`,
+	})
+	page, err := client.GetMultiplePages(context.Background(), nil)
+	c.Assert(err, chk.IsNil)
+	count := 0
+	err = page.ForEach(context.Background(), func(p paginggroup.Product) error {
+		count++
+		return fmt.Errorf("stopping at %d: %w", *p.Properties.ID, paginggroup.ErrStopIteration)
+	})
+	c.Assert(err, chk.IsNil)
+	c.Assert(count, chk.Equals, 1)
+	c.Assert(sender.urls(), chk.DeepEquals, []string{"/paging/multiple"})
+}
+
+func (s *PagingGroupSuite) TestGetMultiplePagesFailureForEach(c *chk.C) {
+	iter, err := pagingClient.GetMultiplePagesFailureComplete(context.Background())
+	c.Assert(err, chk.IsNil)
+	count := 0
+	err = iter.ForEach(context.Background(), func(p paginggroup.Product) error {
+		count++
+		return nil
+	})
+	c.Assert(count, chk.Equals, 1)
+	detErr, ok := err.(autorest.DetailedError)
+	c.Assert(ok, chk.Equals, true)
+	c.Assert(detErr.StatusCode, chk.Equals, http.StatusBadRequest)
+}
+
+func (s *PagingGroupSuite) TestGetMultiplePagesFailureStream(c *chk.C) {
+	iter, err := pagingClient.GetMultiplePagesFailureComplete(context.Background())
+	c.Assert(err, chk.IsNil)
+	values, errs := iter.Stream(context.Background())
+	count := 0
+	for range values {
+		count++
+	}
+	c.Assert(count, chk.Equals, 1)
+	err = <-errs
+	detErr, ok := err.(autorest.DetailedError)
+	c.Assert(ok, chk.Equals, true)
+	c.Assert(detErr.StatusCode, chk.Equals, http.StatusBadRequest)
+}
+
 func (s *PagingGroupSuite) TestGetMultiplePagesFailureURI(c *chk.C) {
 	page, err := pagingClient.GetMultiplePagesFailureURI(context.Background())
 	c.Assert(err, chk.IsNil)
@@ -186,6 +405,70 @@ func (s *PagingGroupSuite) TestGetMultiplePagesFailureURI(c *chk.C) {
 	c.Assert(err, chk.ErrorMatches, ".*No scheme detected in URL.*")
 }
 
+func (s *PagingGroupSuite) TestGetMultiplePagesFragmentNextLinkFromToken(c *chk.C) {
+	first, err := pagingClient.GetMultiplePagesFragmentNextLink(context.Background(), "1.6", "test_user")
+	c.Assert(err, chk.IsNil)
+	count := 0
+	for page, err := pagingClient.GetMultiplePagesFragmentNextLinkFromToken(context.Background(), "1.6", "test_user", first.ContinuationToken()); page.NotDone(); err = page.Next() {
+		c.Assert(err, chk.IsNil)
+		c.Assert(page.Values(), chk.NotNil)
+		count++
+	}
+	c.Assert(count, chk.Equals, 9)
+}
+
+func (s *PagingGroupSuite) TestGetOdataMultiplePagesContinuationToken(c *chk.C) {
+	client, sender := getCannedPagingClient(map[string]string{
+		"/paging/multiple/odata":   `{"values":[{"properties":{"id":1}}],"odata.nextLink":"http://localhost/paging/multiple/odata/2"}`,
+		"/paging/multiple/odata/2": `{"values":[{"properties":{"id":2}}],"odata.nextLink":"http://localhost/paging/multiple/odata/3"}`,
+		"/paging/multiple/odata/3": `{"values":[{"properties":{"id":3}}]}`,
+	})
+	first, err := client.GetOdataMultiplePages(context.Background(), nil)
+	c.Assert(err, chk.IsNil)
+	token := first.ContinuationToken()
+	c.Assert(token, chk.Equals, "http://localhost/paging/multiple/odata/2")
+
+	// resume the enumeration with a new client, as a process restarted from the token would
+	client, sender = getCannedPagingClient(sender.pages)
+	page, err := client.GetOdataMultiplePagesFromToken(context.Background(), token)
+	c.Assert(err, chk.IsNil)
+	c.Assert(*page.Values()[0].Properties.ID, chk.Equals, int32(2))
+	c.Assert(page.ContinuationToken(), chk.Equals, "http://localhost/paging/multiple/odata/3")
+	c.Assert(page.Next(), chk.IsNil)
+	c.Assert(*page.Values()[0].Properties.ID, chk.Equals, int32(3))
+	c.Assert(page.ContinuationToken(), chk.Equals, "")
+	c.Assert(page.Next(), chk.IsNil)
+	c.Assert(page.NotDone(), chk.Equals, false)
+	c.Assert(sender.urls(), chk.DeepEquals, []string{"/paging/multiple/odata/2", "/paging/multiple/odata/3"})
+}
+
+func (s *PagingGroupSuite) TestGetMultiplePagesFragmentNextLinkContinuationToken(c *chk.C) {
+	// the odata next links of these pages are fragments passed to the NextFragment operation
+	client, sender := getCannedPagingClient(map[string]string{
+		"/paging/multiple/fragment/test_user?api_version=1.6":             `{"values":[{"properties":{"id":1}}],"odata.nextLink":"next?page=2"}`,
+		"/paging/multiple/fragment/test_user/next?api_version=1.6&page=2": `{"values":[{"properties":{"id":2}}],"odata.nextLink":"next?page=3"}`,
+		"/paging/multiple/fragment/test_user/next?api_version=1.6&page=3": `{"values":[{"properties":{"id":3}}]}`,
+	})
+	first, err := client.GetMultiplePagesFragmentNextLink(context.Background(), "1.6", "test_user")
+	c.Assert(err, chk.IsNil)
+	token := first.ContinuationToken()
+	c.Assert(token, chk.Equals, "next?page=2")
+
+	ids := []int32{}
+	for page, err := client.GetMultiplePagesFragmentNextLinkFromToken(context.Background(), "1.6", "test_user", token); page.NotDone(); err = page.Next() {
+		c.Assert(err, chk.IsNil)
+		for _, p := range page.Values() {
+			ids = append(ids, *p.Properties.ID)
+		}
+	}
+	c.Assert(ids, chk.DeepEquals, []int32{2, 3})
+	c.Assert(sender.urls(), chk.DeepEquals, []string{
+		"/paging/multiple/fragment/test_user?api_version=1.6",
+		"/paging/multiple/fragment/test_user/next?api_version=1.6&page=2",
+		"/paging/multiple/fragment/test_user/next?api_version=1.6&page=3",
+	})
+}
+
 func (s *PagingGroupSuite) TestGetMultiplePagesFragmentNextLink(c *chk.C) {
 	count := 0
 	for page, err := pagingClient.GetMultiplePagesFragmentNextLink(context.Background(), "1.6", "test_user"); page.NotDone(); err = page.Next() {
@@ -205,7 +488,7 @@ func (s *PagingGroupSuite) TestGetMultiplePagesFragmentNextLink(c *chk.C) {
 }
 
 func (s *PagingGroupSuite) TestGetMultiplePagesLRO(c *chk.C) {
-	future, err := pagingClient.GetMultiplePagesLRO(context.Background(), clientID, nil, nil)
+	future, err := pagingClient.GetMultiplePagesLRO(context.Background(), &paginggroup.PagingGetMultiplePagesLROOptions{ClientRequestID: clientID})
 	c.Assert(err, chk.IsNil)
 	err = future.WaitForCompletionRef(context.Background(), pagingClient.Client)
 	c.Assert(err, chk.IsNil)
@@ -217,3 +500,154 @@ func (s *PagingGroupSuite) TestGetMultiplePagesLRO(c *chk.C) {
 	}
 	c.Assert(count, chk.Equals, 10)
 }
+
+func (s *PagingGroupSuite) TestGetMultiplePagesOptions(c *chk.C) {
+	client := getPagingClient()
+	var headers []http.Header
+	client.Sender = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		headers = append(headers, r.Header)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       ioutil.NopCloser(strings.NewReader(`{"values":[]}`)),
+			Request:    r,
+		}, nil
+	})
+	var api paginggroupapi.PagingClientAPI = client
+	maxresults := int32(5)
+	timeout := int32(10)
+	_, err := api.GetMultiplePages(context.Background(), &paginggroup.PagingGetMultiplePagesOptions{
+		ClientRequestID: "client-id",
+		Maxresults:      &maxresults,
+		Timeout:         &timeout,
+	})
+	c.Assert(err, chk.IsNil)
+	_, err = api.GetMultiplePages(context.Background(), nil)
+	c.Assert(err, chk.IsNil)
+
+	c.Assert(headers, chk.HasLen, 2)
+	c.Assert(headers[0].Get("client-request-id"), chk.Equals, "client-id")
+	c.Assert(headers[0].Get("maxresults"), chk.Equals, "5")
+	c.Assert(headers[0].Get("timeout"), chk.Equals, "10")
+	// nil options omit the optional headers and send the default timeout
+	c.Assert(headers[1].Get("client-request-id"), chk.Equals, "")
+	c.Assert(headers[1].Get("maxresults"), chk.Equals, "")
+	c.Assert(headers[1].Get("timeout"), chk.Equals, "30")
+}
+
+func (s *PagingGroupSuite) TestGetSinglePagesRequestOptions(c *chk.C) {
+	ctx := paginggroup.WithRequestOptions(context.Background(), paginggroup.RequestOptions{
+		Header: http.Header{"x-ms-test": []string{"value"}},
+		Query:  url.Values{"extra": []string{"1"}},
+	})
+	req, err := pagingClient.GetSinglePagesPreparer(ctx)
+	c.Assert(err, chk.IsNil)
+	c.Assert(req.Header.Get("x-ms-test"), chk.Equals, "value")
+	c.Assert(req.URL.Query().Get("extra"), chk.Equals, "1")
+
+	// the options only apply to calls made with ctx
+	req, err = pagingClient.GetSinglePagesPreparer(context.Background())
+	c.Assert(err, chk.IsNil)
+	c.Assert(req.Header.Get("x-ms-test"), chk.Equals, "")
+	c.Assert(req.URL.RawQuery, chk.Equals, "")
+}
+
+func (s *PagingGroupSuite) TestGetSinglePagesRequestOptionsTimeout(c *chk.C) {
+	client := getPagingClient()
+	client.Sender = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		<-r.Context().Done()
+		return nil, r.Context().Err()
+	})
+	ctx := paginggroup.WithRequestOptions(context.Background(), paginggroup.RequestOptions{Timeout: 10 * time.Millisecond})
+	_, err := client.GetSinglePages(ctx)
+	c.Assert(err, chk.NotNil)
+	c.Assert(err.(autorest.DetailedError).Original, chk.Equals, context.DeadlineExceeded)
+}
+
+func (s *PagingGroupSuite) TestGetMultiplePagesLogging(c *chk.C) {
+	client := getPagingClient()
+	client.BaseURI = "http://localhost"
+	client.Sender = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		body := `{"values":[{"properties":{"id":1}}],"nextLink":"http://localhost/page/2?sig=secret"}`
+		if r.URL.Path == "/page/2" {
+			body = `{"values":[{"properties":{"id":2}}]}`
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+			Request:    r,
+		}, nil
+	})
+	var events []paginggroup.LogEvent
+	client.Logger = paginggroup.LoggerFunc(func(e paginggroup.LogEvent) { events = append(events, e) })
+	maxresults := int32(5)
+	count := 0
+	for page, err := client.GetMultiplePages(context.Background(), &paginggroup.PagingGetMultiplePagesOptions{ClientRequestID: clientID, Maxresults: &maxresults}); page.NotDone(); err = page.Next() {
+		c.Assert(err, chk.IsNil)
+		count++
+	}
+	c.Assert(count, chk.Equals, 2)
+	c.Assert(events, chk.HasLen, 3)
+	c.Assert(events[0].Kind, chk.Equals, paginggroup.LogOperationStart)
+	c.Assert(events[0].Fields, chk.DeepEquals, map[string]interface{}{"client-request-id": clientID, "maxresults": int32(5), "timeout": nil})
+	c.Assert(events[1].Kind, chk.Equals, paginggroup.LogOperationFinish)
+	c.Assert(events[2].Kind, chk.Equals, paginggroup.LogPageFetch)
+	c.Assert(events[2].StatusCode, chk.Equals, http.StatusOK)
+	for _, e := range events {
+		c.Assert(e.Operation, chk.Equals, "PagingClient.GetMultiplePages")
+		c.Assert(strings.Contains(e.String(), "secret"), chk.Equals, false)
+	}
+	c.Assert(events[0].String(), chk.Equals, "event=OperationStart operation=PagingClient.GetMultiplePages client-request-id=client-id maxresults=5 timeout=<nil>")
+}
+
+// spanRecorder is a paginggroup.Tracer recording the names of the spans it starts and their attributes.
+type spanRecorder struct {
+	names []string
+	attrs []map[string]interface{}
+}
+
+type recordedSpan map[string]interface{}
+
+func (sr *spanRecorder) StartSpan(ctx context.Context, name string) (context.Context, paginggroup.Span) {
+	span := recordedSpan{}
+	sr.names = append(sr.names, name)
+	sr.attrs = append(sr.attrs, span)
+	return ctx, span
+}
+
+func (rs recordedSpan) SetAttribute(key string, value interface{}) { rs[key] = value }
+
+func (rs recordedSpan) End(err error) {}
+
+func (s *PagingGroupSuite) TestGetMultiplePagesTracing(c *chk.C) {
+	client := getPagingClient()
+	client.BaseURI = "http://localhost"
+	client.Sender = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		body := `{"values":[{"properties":{"id":1}}],"nextLink":"http://localhost/page/2"}`
+		if r.URL.Path == "/page/2" {
+			body = `{"values":[{"properties":{"id":2}}]}`
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+			Request:    r,
+		}, nil
+	})
+	tracer := &spanRecorder{}
+	client.Tracer = tracer
+	count := 0
+	for page, err := client.GetMultiplePages(context.Background(), &paginggroup.PagingGetMultiplePagesOptions{ClientRequestID: clientID}); page.NotDone(); err = page.Next() {
+		c.Assert(err, chk.IsNil)
+		count++
+	}
+	c.Assert(count, chk.Equals, 2)
+	c.Assert(tracer.names, chk.DeepEquals, []string{
+		"tests/generated/paginggroup/PagingClient.GetMultiplePages",
+		"tests/generated/paginggroup/PagingClient.GetMultiplePages/page",
+	})
+	c.Assert(tracer.attrs[0][paginggroup.AttributeURLTemplate], chk.Equals, "/paging/multiple")
+	c.Assert(tracer.attrs[1][paginggroup.AttributeHTTPMethod], chk.Equals, "GET")
+	c.Assert(tracer.attrs[1][paginggroup.AttributeStatusCode], chk.Equals, http.StatusOK)
+}
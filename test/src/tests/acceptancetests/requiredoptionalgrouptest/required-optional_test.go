@@ -2,8 +2,10 @@ package requiredoptionalgrouptest
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 	"tests/acceptancetests/utils"
 	. "tests/generated/optionalgroup"
@@ -17,6 +19,8 @@ type RequiredOptionalSuite struct{}
 
 var _ = chk.Suite(&RequiredOptionalSuite{})
 
+var recorder = utils.MustRecorder(NewRecorder(utils.CassettePath("optionalgroup"), utils.RecordingSender(), RecorderOptions{Mode: RecordingMode(utils.RecordingMode())}))
+
 var explicitClient = getRequiredExplicitTestClient()
 var implicitClient = getRequiredImplicitTestClient()
 
@@ -24,6 +28,7 @@ func getRequiredExplicitTestClient() ExplicitClient {
 	c := NewExplicitClient("", "", nil)
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -31,63 +36,56 @@ func getRequiredImplicitTestClient() ImplicitClient {
 	c := NewImplicitClient("", "", nil)
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
+// assertRequired asserts that err reports the missing required value at path as the only violation of the parameters of
+// the operation.
+func assertRequired(c *chk.C, err error, operation, path string) {
+	expected := ValidationError{Path: path, Constraint: "Null", Details: "value can not be null; required parameter"}
+	c.Assert(err, chk.ErrorMatches, fmt.Sprintf("optionalgroup.ExplicitClient#%s: Invalid input: %s", operation, regexp.QuoteMeta(expected.Error())))
+	var pve ParameterValidationError
+	c.Assert(errors.As(err, &pve), chk.Equals, true)
+	c.Assert(pve.Errors, chk.DeepEquals, ValidationErrors{expected})
+}
+
 //Explicit tests
 
 func (s *RequiredOptionalSuite) TestPostRequiredArrayHeader(c *chk.C) {
 	_, err := explicitClient.PostRequiredArrayHeader(context.Background(), nil)
 	c.Assert(err, chk.NotNil)
-	expected := fmt.Errorf("autorest/validation: validation failed: parameter=%s constraint=%s value=%#v details: %s",
-		"headerParameter", "Null", []string(nil), "value can not be null; required parameter")
-	c.Assert(err.Error(), chk.Equals,
-		fmt.Sprintf("optionalgroup.ExplicitClient#PostRequiredArrayHeader: Invalid input: %v", expected))
+	assertRequired(c, err, "PostRequiredArrayHeader", "headerParameter")
 }
 
 func (s *RequiredOptionalSuite) TestPostRequiredArrayParameter(c *chk.C) {
 	_, err := explicitClient.PostRequiredArrayParameter(context.Background(), nil)
 	c.Assert(err, chk.NotNil)
-	expected := fmt.Errorf("autorest/validation: validation failed: parameter=%s constraint=%s value=%#v details: %s",
-		"bodyParameter", "Null", []string(nil), "value can not be null; required parameter")
-	c.Assert(err.Error(), chk.Equals,
-		fmt.Sprintf("optionalgroup.ExplicitClient#PostRequiredArrayParameter: Invalid input: %v", expected))
+	assertRequired(c, err, "PostRequiredArrayParameter", "bodyParameter")
 }
 
 func (s *RequiredOptionalSuite) TestPostRequiredArrayProperty(c *chk.C) {
 	_, err := explicitClient.PostRequiredArrayProperty(context.Background(), ArrayWrapper{})
 	c.Assert(err, chk.NotNil)
-	expected := fmt.Errorf("autorest/validation: validation failed: parameter=%s constraint=%s value=%#v details: %s",
-		"bodyParameter.Value", "Null", (*[]string)(nil), "value can not be null; required parameter")
-	c.Assert(err.Error(), chk.Equals,
-		fmt.Sprintf("optionalgroup.ExplicitClient#PostRequiredArrayProperty: Invalid input: %v", expected))
+	assertRequired(c, err, "PostRequiredArrayProperty", "bodyParameter.value")
 }
 
 func (s *RequiredOptionalSuite) TestPostRequiredClassParameter(c *chk.C) {
 	_, err := explicitClient.PostRequiredClassParameter(context.Background(), Product{})
 	c.Assert(err, chk.NotNil)
-	expected := fmt.Errorf("autorest/validation: validation failed: parameter=%s constraint=%s value=%#v details: %s",
-		"bodyParameter.ID", "Null", (*int32)(nil), "value can not be null; required parameter")
-	c.Assert(err.Error(), chk.Equals,
-		fmt.Sprintf("optionalgroup.ExplicitClient#PostRequiredClassParameter: Invalid input: %v", expected))
+	assertRequired(c, err, "PostRequiredClassParameter", "bodyParameter.id")
 }
 
 func (s *RequiredOptionalSuite) TestPostRequiredClassProperty(c *chk.C) {
 	_, err := explicitClient.PostRequiredClassProperty(context.Background(), ClassWrapper{})
 	c.Assert(err, chk.NotNil)
-	expected := fmt.Errorf("autorest/validation: validation failed: parameter=%s constraint=%s value=%#v details: %s",
-		"bodyParameter.Value", "Null", (*Product)(nil), "value can not be null; required parameter")
-	c.Assert(err.Error(), chk.Equals,
-		fmt.Sprintf("optionalgroup.ExplicitClient#PostRequiredClassProperty: Invalid input: %v", expected))
+	assertRequired(c, err, "PostRequiredClassProperty", "bodyParameter.value")
 }
 
 func (s *RequiredOptionalSuite) TestPostRequiredIntegerProperty(c *chk.C) {
 	_, err := explicitClient.PostRequiredIntegerProperty(context.Background(), IntWrapper{})
 	c.Assert(err, chk.NotNil)
-	expected := fmt.Errorf("autorest/validation: validation failed: parameter=%s constraint=%s value=%#v details: %s",
-		"bodyParameter.Value", "Null", (*int32)(nil), "value can not be null; required parameter")
-	c.Assert(err.Error(), chk.Equals,
-		fmt.Sprintf("optionalgroup.ExplicitClient#PostRequiredIntegerProperty: Invalid input: %v", expected))
+	assertRequired(c, err, "PostRequiredIntegerProperty", "bodyParameter.value")
 }
 
 // Integer can't be null
@@ -112,7 +110,7 @@ func (s *RequiredOptionalSuite) TestPostOptionalArrayParameter(c *chk.C) {
 }
 
 func (s *RequiredOptionalSuite) TestPostOptionalArrayProperty(c *chk.C) {
-	_, err := explicitClient.PostOptionalArrayProperty(context.Background(), &ArrayOptionalWrapper{nil})
+	_, err := explicitClient.PostOptionalArrayProperty(context.Background(), &ExplicitPostOptionalArrayPropertyOptions{BodyParameter: &ArrayOptionalWrapper{nil}})
 	c.Assert(err, chk.IsNil)
 }
 
@@ -121,6 +119,12 @@ func (s *RequiredOptionalSuite) TestPostOptionalClassParameter(c *chk.C) {
 	c.Assert(err, chk.IsNil)
 }
 
+func (s *RequiredOptionalSuite) TestPostOptionalClassParameterValidation(c *chk.C) {
+	_, err := explicitClient.PostOptionalClassParameter(context.Background(), &ExplicitPostOptionalClassParameterOptions{BodyParameter: &Product{}})
+	c.Assert(err, chk.NotNil)
+	c.Assert(err, chk.ErrorMatches, ".*bodyParameter.id.*")
+}
+
 func (s *RequiredOptionalSuite) TestPostOptionalClassProperty(c *chk.C) {
 	_, err := explicitClient.PostOptionalClassProperty(context.Background(), nil)
 	c.Assert(err, chk.IsNil)
@@ -131,6 +135,13 @@ func (s *RequiredOptionalSuite) TestPostOptionalIntegerHeader(c *chk.C) {
 	c.Assert(err, chk.IsNil)
 }
 
+func (s *RequiredOptionalSuite) TestPostOptionalIntegerHeaderSendsValue(c *chk.C) {
+	value := int32(42)
+	req, err := explicitClient.PostOptionalIntegerHeaderPreparer(context.Background(), &ExplicitPostOptionalIntegerHeaderOptions{HeaderParameter: &value})
+	c.Assert(err, chk.IsNil)
+	c.Assert(req.Header.Get("headerParameter"), chk.Equals, "42")
+}
+
 func (s *RequiredOptionalSuite) TestPostOptionalIntegerParameter(c *chk.C) {
 	_, err := explicitClient.PostOptionalIntegerParameter(context.Background(), nil)
 	c.Assert(err, chk.IsNil)
@@ -142,12 +153,12 @@ func (s *RequiredOptionalSuite) TestPostOptionalIntegerProperty(c *chk.C) {
 }
 
 func (s *RequiredOptionalSuite) TestPostOptionalStringHeader(c *chk.C) {
-	_, err := explicitClient.PostOptionalStringHeader(context.Background(), "")
+	_, err := explicitClient.PostOptionalStringHeader(context.Background(), &ExplicitPostOptionalStringHeaderOptions{})
 	c.Assert(err, chk.IsNil)
 }
 
 func (s *RequiredOptionalSuite) TestPostOptionalStringParameter(c *chk.C) {
-	_, err := explicitClient.PostOptionalStringParameter(context.Background(), "")
+	_, err := explicitClient.PostOptionalStringParameter(context.Background(), &ExplicitPostOptionalStringParameterOptions{})
 	c.Assert(err, chk.IsNil)
 }
 
@@ -177,19 +188,19 @@ func (s *RequiredOptionalSuite) TestPostOptionalStringProperty(c *chk.C) {
 // }
 
 func (s *RequiredOptionalSuite) TestPutOptionalBody(c *chk.C) {
-	res, err := implicitClient.PutOptionalBody(context.Background(), "")
+	res, err := implicitClient.PutOptionalBody(context.Background(), &ImplicitPutOptionalBodyOptions{})
 	c.Assert(err, chk.IsNil)
 	c.Assert(res.StatusCode, chk.Equals, http.StatusOK)
 }
 
 func (s *RequiredOptionalSuite) TestPutOptionalHeader(c *chk.C) {
-	res, err := implicitClient.PutOptionalHeader(context.Background(), "")
+	res, err := implicitClient.PutOptionalHeader(context.Background(), &ImplicitPutOptionalHeaderOptions{})
 	c.Assert(err, chk.IsNil)
 	c.Assert(res.StatusCode, chk.Equals, http.StatusOK)
 }
 
 func (s *RequiredOptionalSuite) TestPutOptionalQuery(c *chk.C) {
-	res, err := implicitClient.PutOptionalQuery(context.Background(), "")
+	res, err := implicitClient.PutOptionalQuery(context.Background(), &ImplicitPutOptionalQueryOptions{})
 	c.Assert(err, chk.IsNil)
 	c.Assert(res.StatusCode, chk.Equals, http.StatusOK)
 }
@@ -199,3 +210,18 @@ func (s *RequiredOptionalSuite) TestGetOptionalGlobalQuery(c *chk.C) {
 	c.Assert(err, chk.IsNil)
 	c.Assert(res.StatusCode, chk.Equals, http.StatusOK)
 }
+
+func (s *RequiredOptionalSuite) TestNewWithOptionsBaseURI(c *chk.C) {
+	opts := ClientOptions{RequiredGlobalPath: "path", RequiredGlobalQuery: "query"}
+	client, err := NewImplicitClientWithOptions(opts)
+	c.Assert(err, chk.IsNil)
+	c.Assert(client.BaseURI, chk.Equals, DefaultBaseURI)
+	c.Assert(client.OptionalGlobalQuery, chk.IsNil)
+	opts.BaseURI = "localhost:3000/api"
+	_, err = NewImplicitClientWithOptions(opts)
+	c.Assert(err, chk.ErrorMatches, `.*: Invalid input: BaseURI "localhost:3000/api" isn't an absolute URI`)
+	opts.BaseURI = ""
+	opts.RequiredGlobalQuery = ""
+	_, err = NewExplicitClientWithOptions(opts)
+	c.Assert(err, chk.ErrorMatches, ".*: Invalid input: RequiredGlobalQuery must be set")
+}
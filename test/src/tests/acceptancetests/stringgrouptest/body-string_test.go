@@ -22,6 +22,8 @@ type StringSuite struct{}
 
 var _ = chk.Suite(&StringSuite{})
 
+var recorder = utils.MustRecorder(NewRecorder(utils.CassettePath("stringgroup"), utils.RecordingSender(), RecorderOptions{Mode: RecordingMode(utils.RecordingMode())}))
+
 var stringClient = getStringClient()
 var enumClient = getEnumClient()
 
@@ -29,6 +31,7 @@ func getStringClient() StringClient {
 	c := NewStringClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -36,6 +39,7 @@ func getEnumClient() EnumClient {
 	c := NewEnumClient()
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
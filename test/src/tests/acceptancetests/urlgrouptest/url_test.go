@@ -22,6 +22,8 @@ type URLSuite struct{}
 
 var _ = chk.Suite(&URLSuite{})
 
+var recorder = utils.MustRecorder(NewRecorder(utils.CassettePath("urlgroup"), utils.RecordingSender(), RecorderOptions{Mode: RecordingMode(utils.RecordingMode())}))
+
 var pathClient = getPathClient()
 var queryClient = getQueryClient()
 var pathItemClient = getPathItemsClient()
@@ -30,6 +32,7 @@ func getPathItemsClient() PathItemsClient {
 	c := NewPathItemsClient("globalStringPath", "globalStringQuery")
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -37,6 +40,7 @@ func getQueryClient() QueriesClient {
 	c := NewQueriesClient("globalStringPath", "globalStringQuery")
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -44,6 +48,7 @@ func getPathClient() PathsClient {
 	c := NewPathsClient("globalStringPath", "globalStringQuery")
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -1,9 +1,14 @@
 package utils
 
 import (
+	"net/http"
+	"net/http/cookiejar"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/date"
 )
 
@@ -20,3 +25,32 @@ func ToDateTime(s string) date.Time {
 func GetBaseURI() string {
 	return "http://localhost:3000"
 }
+
+// RecordingMode returns the mode of the recorders of the suites, set by the AUTOREST_TEST_MODE environment variable:
+// live (the default) sends the requests to the test server, record also records them to the cassettes of the suites
+// and playback replies to them from the cassettes, so that the suites can run without the test server.
+func RecordingMode() string {
+	return os.Getenv("AUTOREST_TEST_MODE")
+}
+
+// CassettePath returns the path of the cassette of the named suite, relative to the directory of the suite.
+func CassettePath(suite string) string {
+	return filepath.Join("testdata", suite+".json")
+}
+
+// RecordingSender returns the Sender the recorders of the suites send the requests with in the live and record modes.
+func RecordingSender() autorest.Sender {
+	jar, _ := cookiejar.New(nil)
+	return &http.Client{Jar: jar}
+}
+
+// MustRecorder returns the recorder created by the NewRecorder function generated in the package of a suite, or panics if
+// it couldn't be created, e.g. as the cassette is invalid:
+//
+//	var recorder = utils.MustRecorder(NewRecorder(utils.CassettePath("booleangroup"), utils.RecordingSender(), RecorderOptions{Mode: RecordingMode(utils.RecordingMode())}))
+func MustRecorder(recorder autorest.Sender, err error) autorest.Sender {
+	if err != nil {
+		panic(err)
+	}
+	return recorder
+}
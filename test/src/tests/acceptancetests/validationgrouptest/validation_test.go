@@ -2,10 +2,17 @@ package validationgrouptest
 
 import (
 	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
 	"testing"
 	"tests/acceptancetests/utils"
 	. "tests/generated/validationgroup"
 
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/Azure/go-autorest/autorest/validation"
 	chk "gopkg.in/check.v1"
 )
 
@@ -15,12 +22,15 @@ type ValidationSuite struct{}
 
 var _ = chk.Suite(&ValidationSuite{})
 
+var recorder = utils.MustRecorder(NewRecorder(utils.CassettePath("validationgroup"), utils.RecordingSender(), RecorderOptions{Mode: RecordingMode(utils.RecordingMode())}))
+
 var validationClient = getValidationClient()
 
 func getValidationClient() BaseClient {
 	c := New("abc123")
 	c.RetryDuration = 1
 	c.BaseURI = utils.GetBaseURI()
+	c.Sender = recorder
 	return c
 }
 
@@ -61,3 +71,134 @@ func (s *ValidationSuite) TestValidationOfBody(c *chk.C) {
 	})
 	c.Assert(err, chk.NotNil)
 }
+
+func (s *ValidationSuite) TestProductValidate(c *chk.C) {
+	capacity, image := int32(100), "ftp://example"
+	err := Product{
+		DisplayNames: &[]string{"displayname1", "displayname1"},
+		Capacity:     &capacity,
+		Image:        &image,
+		ConstChild:   &ConstantProduct{},
+	}.Validate()
+	errs, ok := err.(ValidationErrors)
+	c.Assert(ok, chk.Equals, true)
+	c.Assert(errs, chk.DeepEquals, ValidationErrors{
+		{Path: "display_names", Constraint: "UniqueItems", Details: "all items must be unique"},
+		{Path: "capacity", Constraint: "ExclusiveMaximum", Details: "value must be less than 100"},
+		{Path: "image", Constraint: "Pattern", Details: `value doesn't match pattern http://\w+`},
+		{Path: "child", Constraint: "Null", Details: "value can not be null; required parameter"},
+		{Path: "constChild.constProperty", Constraint: "Null", Details: "value can not be null; required parameter"},
+		{Path: "constChild.constProperty2", Constraint: "Null", Details: "value can not be null; required parameter"},
+		{Path: "constInt", Constraint: "Null", Details: "value can not be null; required parameter"},
+		{Path: "constString", Constraint: "Null", Details: "value can not be null; required parameter"},
+	})
+}
+
+func (s *ValidationSuite) TestValidationOfMethodParametersReportsAllViolations(c *chk.C) {
+	_, err := validationClient.ValidationOfMethodParameters(context.Background(), "1", 1005)
+	c.Assert(err, chk.NotNil)
+	c.Assert(err, chk.ErrorMatches, ".*resourceGroupName: value length must be greater than or equal to 3 \\(MinLength\\); "+
+		"id: value must be less than or equal to 1000 \\(InclusiveMaximum\\); id: value must be a multiple of 10 \\(MultipleOf\\).*")
+}
+
+func (s *ValidationSuite) TestValidationOfMethodParametersWrapsViolations(c *chk.C) {
+	_, err := validationClient.ValidationOfMethodParameters(context.Background(), "1", 1005)
+	var errs ValidationErrors
+	c.Assert(errors.As(err, &errs), chk.Equals, true)
+	c.Assert(errs, chk.DeepEquals, ValidationErrors{
+		{Path: "resourceGroupName", Constraint: "MinLength", Details: "value length must be greater than or equal to 3"},
+		{Path: "id", Constraint: "InclusiveMaximum", Details: "value must be less than or equal to 1000"},
+		{Path: "id", Constraint: "MultipleOf", Details: "value must be a multiple of 10"},
+	})
+	var verr validation.Error
+	c.Assert(errors.As(err, &verr), chk.Equals, true)
+	c.Assert(verr.PackageType, chk.Equals, "validationgroup.BaseClient")
+	c.Assert(verr.Method, chk.Equals, "ValidationOfMethodParameters")
+}
+
+func getOutOfSpecResponseClient() BaseClient {
+	return getCannedResponseClient(`{"capacity":100,"constChild":{"constProperty":"constant"},"constStringAsEnum":"unknown"}`)
+}
+
+// getCannedResponseClient returns a client whose requests all succeed with the specified JSON body.
+func getCannedResponseClient(body string) BaseClient {
+	client := getValidationClient()
+	client.Sender = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{
+			Request:    r,
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       ioutil.NopCloser(strings.NewReader(body)),
+		}, nil
+	})
+	return client
+}
+
+func (s *ValidationSuite) TestResponseValidationError(c *chk.C) {
+	client := getOutOfSpecResponseClient()
+	_, err := client.ValidationOfMethodParameters(context.Background(), "abc", 100)
+	c.Assert(err, chk.NotNil)
+	errs, ok := err.(autorest.DetailedError).Original.(ValidationErrors)
+	c.Assert(ok, chk.Equals, true)
+	paths := []string{}
+	for _, e := range errs {
+		paths = append(paths, e.Path)
+	}
+	c.Assert(paths, chk.DeepEquals, []string{"capacity", "child", "constChild.constProperty2", "constInt", "constString", "constStringAsEnum"})
+}
+
+func (s *ValidationSuite) TestResponseValidationWarn(c *chk.C) {
+	client := getOutOfSpecResponseClient()
+	var operation string
+	var warning error
+	client.ResponseValidation = ResponseValidation{
+		Warn: true,
+		OnWarning: func(op string, err error) {
+			operation, warning = op, err
+		},
+	}
+	result, err := client.ValidationOfMethodParameters(context.Background(), "abc", 100)
+	c.Assert(err, chk.IsNil)
+	c.Assert(*result.Capacity, chk.Equals, int32(100))
+	c.Assert(operation, chk.Equals, "tests/generated/validationgroup/BaseClient.ValidationOfMethodParameters")
+	c.Assert(warning, chk.FitsTypeOf, ValidationErrors{})
+}
+
+func (s *ValidationSuite) TestResponseValidationMissingReadOnlyProperty(c *chk.C) {
+	client := getCannedResponseClient(`{"name":"a very long name"}`)
+	_, err := client.GetWithReadOnlyProperty(context.Background())
+	c.Assert(err, chk.NotNil)
+	errs, ok := err.(autorest.DetailedError).Original.(ValidationErrors)
+	c.Assert(ok, chk.Equals, true)
+	c.Assert(errs, chk.DeepEquals, ValidationErrors{
+		{Path: "name", Constraint: "MaxLength", Details: "value length must be less than or equal to 10"},
+		{Path: "id", Constraint: "Null", Details: "value can not be null; required parameter"},
+	})
+
+	client = getCannedResponseClient(`{"id":"1","name":"abc"}`)
+	result, err := client.GetWithReadOnlyProperty(context.Background())
+	c.Assert(err, chk.IsNil)
+	c.Assert(*result.ID, chk.Equals, "1")
+}
+
+func (s *ValidationSuite) TestValidationOfMethodParametersErrorResponse(c *chk.C) {
+	client := getValidationClient()
+	client.Sender = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{
+			Request:    r,
+			StatusCode: http.StatusBadRequest,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       ioutil.NopCloser(strings.NewReader(`{"code":400,"message":"bad id","fields":"id"}`)),
+		}, nil
+	})
+	_, err := client.ValidationOfMethodParameters(context.Background(), "abc", 100)
+	er, ok := err.(ErrorResponse)
+	c.Assert(ok, chk.Equals, true)
+	c.Assert(er.StatusCode, chk.Equals, http.StatusBadRequest)
+	c.Assert(er.Model, chk.DeepEquals, Error{Code: to.Int32Ptr(400), Message: to.StringPtr("bad id"), Fields: to.StringPtr("id")})
+}
+
+func (s *ValidationSuite) TestReadOnlyPropertyNotRequiredInRequests(c *chk.C) {
+	name := "abc"
+	c.Assert(ReadOnlyProduct{Name: &name}.Validate(), chk.IsNil)
+}
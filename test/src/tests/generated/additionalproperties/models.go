@@ -7,13 +7,55 @@ package additionalproperties
 // Changes may cause incorrect behavior and will be lost if the code is regenerated.
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/validation"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // The package's fully qualified name.
 const fqdn = "tests/generated/additionalproperties"
 
+// deepCopyObject returns a deep copy of the value of a field of type interface{}.  The maps and slices created when
+// unmarshaling JSON are copied, the other values are returned as is.
+func deepCopyObject(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if v == nil {
+			return v
+		}
+		result := make(map[string]interface{}, len(v))
+		for key, e := range v {
+			result[key] = deepCopyObject(e)
+		}
+		return result
+	case []interface{}:
+		if v == nil {
+			return v
+		}
+		result := make([]interface{}, len(v))
+		for i, e := range v {
+			result[i] = deepCopyObject(e)
+		}
+		return result
+	default:
+		return value
+	}
+}
+
 // CatAPTrue ...
 type CatAPTrue struct {
 	autorest.Response `json:"-"`
@@ -107,12 +149,518 @@ func (cat *CatAPTrue) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// Validate checks that the CatAPTrue satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (cat CatAPTrue) Validate() error {
+	var errs ValidationErrors
+	if cat.ID == nil {
+		errs = append(errs, ValidationError{Path: "id", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// DeepCopy returns a copy of the CatAPTrue that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (cat CatAPTrue) DeepCopy() CatAPTrue {
+	result := cat
+	if cat.Friendly != nil {
+		v := *cat.Friendly
+		result.Friendly = &v
+	}
+	if cat.AdditionalProperties != nil {
+		result.AdditionalProperties = make(map[string]interface{}, len(cat.AdditionalProperties))
+		for k, e := range cat.AdditionalProperties {
+			result.AdditionalProperties[k] = deepCopyObject(e)
+		}
+	}
+	if cat.ID != nil {
+		v := *cat.ID
+		result.ID = &v
+	}
+	if cat.Name != nil {
+		v := *cat.Name
+		result.Name = &v
+	}
+	if cat.Status != nil {
+		v := *cat.Status
+		result.Status = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the CatAPTrue and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (cat CatAPTrue) Equal(other CatAPTrue) bool {
+	return cat.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (cat CatAPTrue) EqualIgnoreReadOnly(other CatAPTrue) bool {
+	return cat.equal(other, true)
+}
+
+// equal compares the fields of the CatAPTrue and other, skipping the read-only ones if ignoreReadOnly is true.
+func (cat CatAPTrue) equal(other CatAPTrue, ignoreReadOnly bool) bool {
+	if (cat.Friendly == nil) != (other.Friendly == nil) || (cat.Friendly != nil && *cat.Friendly != *other.Friendly) {
+		return false
+	}
+	if (cat.AdditionalProperties == nil) != (other.AdditionalProperties == nil) || len(cat.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	for k, e := range cat.AdditionalProperties {
+		f, ok := other.AdditionalProperties[k]
+		if !ok || !reflect.DeepEqual(e, f) {
+			return false
+		}
+	}
+	if (cat.ID == nil) != (other.ID == nil) || (cat.ID != nil && *cat.ID != *other.ID) {
+		return false
+	}
+	if (cat.Name == nil) != (other.Name == nil) || (cat.Name != nil && *cat.Name != *other.Name) {
+		return false
+	}
+	if !ignoreReadOnly {
+		if (cat.Status == nil) != (other.Status == nil) || (cat.Status != nil && *cat.Status != *other.Status) {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the CatAPTrue to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (cat *CatAPTrue) MergeFrom(patch CatAPTrue) {
+	if patch.Friendly != nil {
+		v := *patch.Friendly
+		cat.Friendly = &v
+	}
+	if patch.AdditionalProperties != nil {
+		if cat.AdditionalProperties == nil {
+			cat.AdditionalProperties = make(map[string]interface{}, len(patch.AdditionalProperties))
+		}
+		for k, e := range patch.AdditionalProperties {
+			cat.AdditionalProperties[k] = deepCopyObject(e)
+		}
+	}
+	if patch.ID != nil {
+		v := *patch.ID
+		cat.ID = &v
+	}
+	if patch.Name != nil {
+		v := *patch.Name
+		cat.Name = &v
+	}
+}
+
 // Error ...
 type Error struct {
 	Status  *int32  `json:"status,omitempty"`
 	Message *string `json:"message,omitempty"`
 }
 
+// DeepCopy returns a copy of the Error that doesn't share any pointers, slices or maps with it.
+func (e Error) DeepCopy() Error {
+	result := e
+	if e.Status != nil {
+		v := *e.Status
+		result.Status = &v
+	}
+	if e.Message != nil {
+		v := *e.Message
+		result.Message = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Error and other are equal.  Times are equal if they represent the same
+// instant.
+func (e Error) Equal(other Error) bool {
+	return e.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (e Error) EqualIgnoreReadOnly(other Error) bool {
+	return e.equal(other, true)
+}
+
+// equal compares the fields of the Error and other, skipping the read-only ones if ignoreReadOnly is true.
+func (e Error) equal(other Error, ignoreReadOnly bool) bool {
+	if (e.Status == nil) != (other.Status == nil) || (e.Status != nil && *e.Status != *other.Status) {
+		return false
+	}
+	if (e.Message == nil) != (other.Message == nil) || (e.Message != nil && *e.Message != *other.Message) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Error to copies of the fields set in patch.  Nested models and maps are merged, the
+// values of the other fields are replaced.  The read-only fields aren't changed.
+func (e *Error) MergeFrom(patch Error) {
+	if patch.Status != nil {
+		v := *patch.Status
+		e.Status = &v
+	}
+	if patch.Message != nil {
+		v := *patch.Message
+		e.Message = &v
+	}
+}
+
+// ErrorResponse is returned when an operation fails with a response containing the Error model.  Unlike the other
+// errors of the operations it isn't wrapped in an autorest.DetailedError.
+type ErrorResponse struct {
+	// Model - the error model unmarshalled from the response body.
+	Model Error
+	// StatusCode - the HTTP status code of the response.
+	StatusCode int
+	// Body - the raw response body.
+	Body []byte
+	// Err - the error encountered reading or unmarshalling the response body, if any.
+	Err error
+}
+
+// Error implements the error interface for type ErrorResponse.
+func (er ErrorResponse) Error() string {
+	if er.Err != nil {
+		return fmt.Sprintf("additionalproperties: StatusCode=%d Body=%s Err=%v", er.StatusCode, string(er.Body), er.Err)
+	}
+	return fmt.Sprintf("additionalproperties: StatusCode=%d Body=%s", er.StatusCode, string(er.Body))
+}
+
+// Unwrap returns the error encountered reading or unmarshalling the response body, if any.
+func (er ErrorResponse) Unwrap() error {
+	return er.Err
+}
+
+// withErrorResponseUnlessStatusCode returns a RespondDecorator that returns an ErrorResponse if the
+// response's status code isn't one of the specified codes.  The response body is left available to the caller.
+// When the body can't be read or unmarshalled the returned ErrorResponse wraps the error in its Err field.
+func withErrorResponseUnlessStatusCode(codes ...int) autorest.RespondDecorator {
+	return func(r autorest.Responder) autorest.Responder {
+		return autorest.ResponderFunc(func(resp *http.Response) error {
+			err := r.Respond(resp)
+			if err != nil || autorest.ResponseHasStatusCode(resp, codes...) {
+				return err
+			}
+			defer resp.Body.Close()
+			er := ErrorResponse{StatusCode: resp.StatusCode}
+			er.Body, er.Err = ioutil.ReadAll(resp.Body)
+			resp.Body = ioutil.NopCloser(bytes.NewReader(er.Body))
+			if er.Err == nil && len(bytes.TrimSpace(er.Body)) > 0 {
+				er.Err = json.Unmarshal(er.Body, &er.Model)
+			}
+			return er
+		})
+	}
+}
+
+// ExponentialRetry is a RetryPolicy that retries failed requests with exponentially increasing delays.  When the
+// RetryPolicy of a client is nil one is created from its RetryAttempts and RetryDuration.  Unlike
+// autorest.DoRetryForStatusCodes it only retries idempotent requests unless RetryNonIdempotent is true, so POST and
+// PATCH requests, including the initial requests of long-running operations, aren't retried unless the service marks
+// their operation x-ms-idempotent.  Responses with status code 429 count against MaxRetries.
+type ExponentialRetry struct {
+	// MaxRetries - the maximum number of times a request is retried.
+	MaxRetries int
+	// Delay - the delay before the first retry.  The delay is doubled for each following retry.
+	Delay time.Duration
+	// MaxDelay - the maximum delay between retries.  Zero means no limit.
+	MaxDelay time.Duration
+	// MaxElapsed - requests aren't retried once the delay would exceed this much time since the first attempt.  Zero means no limit.
+	MaxElapsed time.Duration
+	// Jitter - the fraction, from 0 to 1, of each delay that's randomized to spread out the retries of concurrent requests.
+	Jitter float64
+	// StatusCodes - the status codes of the responses that are retried.  Defaults to autorest.StatusCodesForRetry.
+	StatusCodes []int
+	// RetryNonIdempotent - if true, requests that aren't idempotent, e.g. POST requests of operations the service doesn't mark as idempotent, are retried too.
+	RetryNonIdempotent bool
+}
+
+// defaultRetryJitter is the Jitter of the ExponentialRetry used when the RetryPolicy of a client is nil.
+const defaultRetryJitter = 0.2
+
+// RetryPolicy decides if and when a failed attempt to send a request is retried.
+type RetryPolicy interface {
+	// ShouldRetry returns the delay before the request is sent again, or false if it isn't retried.
+	ShouldRetry(attempt RetryAttempt) (time.Duration, bool)
+}
+
+// LogEvent is an event logged by the operations of a client when its Logger isn't nil.  The URLs and headers of the
+// requests aren't included as they can contain secrets.
+type LogEvent struct {
+	// Kind - the kind of the event.
+	Kind LogEventKind
+	// Operation - the name of the operation, qualified by the name of its client.
+	Operation string
+	// Fields - the parameters of the operation keyed by their names in the request, for LogOperationStart events.  Body parameters aren't included and the values of secret parameters are replaced with REDACTED.
+	Fields map[string]interface{}
+	// Attempt - the number of the failed attempt, starting at one, for LogRetry events.
+	Attempt int
+	// Delay - the delay before the request is sent again, for LogRetry events.
+	Delay time.Duration
+	// State - the status of the long-running operation, for LogPoll events.
+	State string
+	// StatusCode - the status code of the response, or zero if none was received.
+	StatusCode int
+	// Elapsed - the time elapsed since the operation, the page fetch or the polling started.
+	Elapsed time.Duration
+	// Err - the error the operation, the attempt or the poll failed with, if any.
+	Err error
+}
+
+// redactedLogValue replaces the values of secret parameters in the Fields of a LogEvent.
+const redactedLogValue = "REDACTED"
+
+// LogEventKind is the kind of a LogEvent.
+type LogEventKind string
+
+const (
+	// LogOperationStart is logged when an operation starts, with its parameters.
+	LogOperationStart LogEventKind = "OperationStart"
+	// LogOperationFinish is logged when an operation finishes.
+	LogOperationFinish LogEventKind = "OperationFinish"
+	// LogRetry is logged when a failed attempt to send a request is retried.
+	LogRetry LogEventKind = "Retry"
+	// LogPageFetch is logged when the next page of the results of an operation has been fetched.
+	LogPageFetch LogEventKind = "PageFetch"
+	// LogPoll is logged each time PollUntilDone has polled the status of a long-running operation.
+	LogPoll LogEventKind = "Poll"
+)
+
+// Logger receives the events logged by the operations of a client.
+type Logger interface {
+	// Log is called synchronously for each event.
+	Log(event LogEvent)
+}
+
+// LoggerFunc is a function implementing the Logger interface.
+type LoggerFunc func(event LogEvent)
+
+// Log implements the Logger interface for LoggerFunc.
+func (lf LoggerFunc) Log(event LogEvent) {
+	lf(event)
+}
+
+// String formats the event as a line of key=value pairs, e.g. to pass to log.Print.
+func (le LogEvent) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "event=%s operation=%s", le.Kind, le.Operation)
+	names := make([]string, 0, len(le.Fields))
+	for name := range le.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&b, " %s=%v", name, le.Fields[name])
+	}
+	if le.Attempt > 0 {
+		fmt.Fprintf(&b, " attempt=%d", le.Attempt)
+	}
+	if le.State != "" {
+		fmt.Fprintf(&b, " state=%s", le.State)
+	}
+	if le.StatusCode > 0 {
+		fmt.Fprintf(&b, " status=%d", le.StatusCode)
+	}
+	if le.Delay > 0 {
+		fmt.Fprintf(&b, " delay=%v", le.Delay)
+	}
+	if le.Kind != LogOperationStart {
+		fmt.Fprintf(&b, " elapsed=%v", le.Elapsed)
+	}
+	if le.Err != nil {
+		fmt.Fprintf(&b, " error=%q", le.Err.Error())
+	}
+	return b.String()
+}
+
+// operationLog is the state used to log the events of an operation, carried by the contexts of its requests.
+type operationLog struct {
+	logger    Logger
+	operation string
+	start     time.Time
+}
+
+// operationLogKey is the key of the operationLog in a context.
+type operationLogKey struct{}
+
+// withOperationLog returns a context carrying the state used to log the events of operation to logger.
+func withOperationLog(ctx context.Context, logger Logger, operation string) context.Context {
+	return context.WithValue(ctx, operationLogKey{}, &operationLog{logger: logger, operation: operation, start: time.Now()})
+}
+
+// startOperationLog logs the start of operation with its parameters, then returns a context carrying the state used to
+// log its other events.
+func startOperationLog(ctx context.Context, logger Logger, operation string, fields map[string]interface{}) context.Context {
+	for name, value := range fields {
+		// log the values of optional parameters rather than their addresses
+		v := reflect.ValueOf(value)
+		for v.Kind() == reflect.Ptr && !v.IsNil() {
+			v = v.Elem()
+		}
+		if v.Kind() == reflect.Ptr {
+			fields[name] = nil
+		} else if v.IsValid() {
+			fields[name] = v.Interface()
+		}
+	}
+	ctx = withOperationLog(ctx, logger, operation)
+	logger.Log(LogEvent{Kind: LogOperationStart, Operation: operation, Fields: fields})
+	return ctx
+}
+
+// logResponse logs event for the operation whose state is carried by ctx, if any, along with the status code of resp
+// if it isn't nil.
+func logResponse(ctx context.Context, event LogEvent, resp *http.Response) {
+	ol, ok := ctx.Value(operationLogKey{}).(*operationLog)
+	if !ok {
+		return
+	}
+	event.Operation = ol.operation
+	event.Elapsed = time.Since(ol.start)
+	if resp != nil {
+		event.StatusCode = resp.StatusCode
+	}
+	ol.logger.Log(event)
+}
+
+// RetryAttempt describes a failed attempt to send a request.
+type RetryAttempt struct {
+	// Request - the request that was sent.
+	Request *http.Request
+	// Response - the response received, or nil if sending the request failed.
+	Response *http.Response
+	// Err - the error returned when sending the request, if any.
+	Err error
+	// Attempt - the number of the attempt, starting at one.
+	Attempt int
+	// Elapsed - the time elapsed since the first attempt was sent.
+	Elapsed time.Duration
+	// Idempotent - true if the request can safely be sent more than once, either because its HTTP method is
+	// idempotent or because the service marks the operation as idempotent.
+	Idempotent bool
+}
+
+// ShouldRetry implements the RetryPolicy interface for ExponentialRetry.  A delay requested by the Retry-After header
+// of the response takes precedence over the computed one.
+func (er ExponentialRetry) ShouldRetry(attempt RetryAttempt) (time.Duration, bool) {
+	if attempt.Attempt > er.MaxRetries || !(attempt.Idempotent || er.RetryNonIdempotent) {
+		return 0, false
+	}
+	if attempt.Err != nil {
+		if !autorest.IsTemporaryNetworkError(attempt.Err) || autorest.IsTokenRefreshError(attempt.Err) {
+			return 0, false
+		}
+	} else {
+		codes := er.StatusCodes
+		if codes == nil {
+			codes = autorest.StatusCodesForRetry
+		}
+		if !autorest.ResponseHasStatusCode(attempt.Response, codes...) {
+			return 0, false
+		}
+	}
+	delay, ok := retryAfter(attempt.Response)
+	if !ok {
+		delay = er.Delay
+		for i := 1; i < attempt.Attempt && delay < math.MaxInt64/2; i++ {
+			delay *= 2
+		}
+		if er.MaxDelay > 0 && delay > er.MaxDelay {
+			delay = er.MaxDelay
+		}
+		if er.Jitter > 0 {
+			delay -= time.Duration(math.Min(er.Jitter, 1) * rand.Float64() * float64(delay))
+		}
+	}
+	if er.MaxElapsed > 0 && attempt.Elapsed+delay > er.MaxElapsed {
+		return 0, false
+	}
+	return delay, true
+}
+
+// retryAfter returns the delay requested by the Retry-After header of resp, and false if it doesn't have one.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	ra := resp.Header.Get("Retry-After")
+	if ra == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(ra); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(ra); err == nil {
+		if delay := time.Until(t); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// isIdempotentMethod returns true if requests using the HTTP method can safely be sent more than once.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodTrace:
+		return true
+	}
+	return false
+}
+
+// withRetryPolicy returns a SendDecorator that sends a request again after each failed attempt policy decides to retry.
+// If policy is nil an ExponentialRetry created from the RetryAttempts and RetryDuration of c is used.  idempotent is
+// true for operations the service marks as idempotent.
+func withRetryPolicy(policy RetryPolicy, c autorest.Client, idempotent bool) autorest.SendDecorator {
+	if policy == nil {
+		policy = ExponentialRetry{MaxRetries: c.RetryAttempts, Delay: c.RetryDuration, Jitter: defaultRetryJitter}
+	}
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+			idempotent := idempotent || isIdempotentMethod(r.Method)
+			start := time.Now()
+			for attempt := 1; ; attempt++ {
+				if err := rr.Prepare(); err != nil {
+					return nil, err
+				}
+				resp, err := s.Do(rr.Request())
+				if r.Context().Err() != nil {
+					return resp, err
+				}
+				delay, retry := policy.ShouldRetry(RetryAttempt{
+					Request:    rr.Request(),
+					Response:   resp,
+					Err:        err,
+					Attempt:    attempt,
+					Elapsed:    time.Since(start),
+					Idempotent: idempotent,
+				})
+				if !retry {
+					return resp, err
+				}
+				logResponse(r.Context(), LogEvent{Kind: LogRetry, Attempt: attempt, Delay: delay, Err: err}, resp)
+				setSpanAttribute(r.Context(), AttributeRetryCount, attempt)
+				if resp != nil {
+					autorest.Respond(resp, autorest.ByDiscardingBody(), autorest.ByClosing())
+				}
+				timer := time.NewTimer(delay)
+				select {
+				case <-timer.C:
+				case <-r.Context().Done():
+					timer.Stop()
+					return nil, r.Context().Err()
+				}
+			}
+		})
+	}
+}
+
 // PetAPInProperties ...
 type PetAPInProperties struct {
 	autorest.Response `json:"-"`
@@ -138,6 +686,109 @@ func (paip PetAPInProperties) MarshalJSON() ([]byte, error) {
 	return json.Marshal(objectMap)
 }
 
+// Validate checks that the PetAPInProperties satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (paip PetAPInProperties) Validate() error {
+	var errs ValidationErrors
+	if paip.ID == nil {
+		errs = append(errs, ValidationError{Path: "id", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// DeepCopy returns a copy of the PetAPInProperties that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (paip PetAPInProperties) DeepCopy() PetAPInProperties {
+	result := paip
+	if paip.ID != nil {
+		v := *paip.ID
+		result.ID = &v
+	}
+	if paip.Name != nil {
+		v := *paip.Name
+		result.Name = &v
+	}
+	if paip.Status != nil {
+		v := *paip.Status
+		result.Status = &v
+	}
+	if paip.AdditionalProperties != nil {
+		result.AdditionalProperties = make(map[string]*float64, len(paip.AdditionalProperties))
+		for k, e := range paip.AdditionalProperties {
+			if e != nil {
+				v := *e
+				e = &v
+			}
+			result.AdditionalProperties[k] = e
+		}
+	}
+	return result
+}
+
+// Equal returns true if the fields of the PetAPInProperties and other are equal.  Times are equal if they represent the
+// same instant.  Their autorest.Response isn't compared.
+func (paip PetAPInProperties) Equal(other PetAPInProperties) bool {
+	return paip.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (paip PetAPInProperties) EqualIgnoreReadOnly(other PetAPInProperties) bool {
+	return paip.equal(other, true)
+}
+
+// equal compares the fields of the PetAPInProperties and other, skipping the read-only ones if ignoreReadOnly is true.
+func (paip PetAPInProperties) equal(other PetAPInProperties, ignoreReadOnly bool) bool {
+	if (paip.ID == nil) != (other.ID == nil) || (paip.ID != nil && *paip.ID != *other.ID) {
+		return false
+	}
+	if (paip.Name == nil) != (other.Name == nil) || (paip.Name != nil && *paip.Name != *other.Name) {
+		return false
+	}
+	if !ignoreReadOnly {
+		if (paip.Status == nil) != (other.Status == nil) || (paip.Status != nil && *paip.Status != *other.Status) {
+			return false
+		}
+	}
+	if (paip.AdditionalProperties == nil) != (other.AdditionalProperties == nil) || len(paip.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	for k, e := range paip.AdditionalProperties {
+		f, ok := other.AdditionalProperties[k]
+		if !ok || (e == nil) != (f == nil) || (e != nil && *e != *f) {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the PetAPInProperties to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (paip *PetAPInProperties) MergeFrom(patch PetAPInProperties) {
+	if patch.ID != nil {
+		v := *patch.ID
+		paip.ID = &v
+	}
+	if patch.Name != nil {
+		v := *patch.Name
+		paip.Name = &v
+	}
+	if patch.AdditionalProperties != nil {
+		if paip.AdditionalProperties == nil {
+			paip.AdditionalProperties = make(map[string]*float64, len(patch.AdditionalProperties))
+		}
+		for k, e := range patch.AdditionalProperties {
+			if e != nil {
+				v := *e
+				e = &v
+			}
+			paip.AdditionalProperties[k] = e
+		}
+	}
+}
+
 // PetAPInPropertiesWithAPString ...
 type PetAPInPropertiesWithAPString struct {
 	autorest.Response `json:"-"`
@@ -244,6 +895,155 @@ func (paipwas *PetAPInPropertiesWithAPString) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// Validate checks that the PetAPInPropertiesWithAPString satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (paipwas PetAPInPropertiesWithAPString) Validate() error {
+	var errs ValidationErrors
+	if paipwas.ID == nil {
+		errs = append(errs, ValidationError{Path: "id", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if paipwas.OdataLocation == nil {
+		errs = append(errs, ValidationError{Path: "@odata.location", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// DeepCopy returns a copy of the PetAPInPropertiesWithAPString that doesn't share any pointers, slices or maps with it.
+// Its autorest.Response is copied as is.
+func (paipwas PetAPInPropertiesWithAPString) DeepCopy() PetAPInPropertiesWithAPString {
+	result := paipwas
+	if paipwas.AdditionalProperties != nil {
+		result.AdditionalProperties = make(map[string]*string, len(paipwas.AdditionalProperties))
+		for k, e := range paipwas.AdditionalProperties {
+			if e != nil {
+				v := *e
+				e = &v
+			}
+			result.AdditionalProperties[k] = e
+		}
+	}
+	if paipwas.ID != nil {
+		v := *paipwas.ID
+		result.ID = &v
+	}
+	if paipwas.Name != nil {
+		v := *paipwas.Name
+		result.Name = &v
+	}
+	if paipwas.Status != nil {
+		v := *paipwas.Status
+		result.Status = &v
+	}
+	if paipwas.OdataLocation != nil {
+		v := *paipwas.OdataLocation
+		result.OdataLocation = &v
+	}
+	if paipwas.AdditionalProperties1 != nil {
+		result.AdditionalProperties1 = make(map[string]*float64, len(paipwas.AdditionalProperties1))
+		for k, e := range paipwas.AdditionalProperties1 {
+			if e != nil {
+				v := *e
+				e = &v
+			}
+			result.AdditionalProperties1[k] = e
+		}
+	}
+	return result
+}
+
+// Equal returns true if the fields of the PetAPInPropertiesWithAPString and other are equal.  Times are equal if they
+// represent the same instant.  Their autorest.Response isn't compared.
+func (paipwas PetAPInPropertiesWithAPString) Equal(other PetAPInPropertiesWithAPString) bool {
+	return paipwas.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (paipwas PetAPInPropertiesWithAPString) EqualIgnoreReadOnly(other PetAPInPropertiesWithAPString) bool {
+	return paipwas.equal(other, true)
+}
+
+// equal compares the fields of the PetAPInPropertiesWithAPString and other, skipping the read-only ones if ignoreReadOnly is true.
+func (paipwas PetAPInPropertiesWithAPString) equal(other PetAPInPropertiesWithAPString, ignoreReadOnly bool) bool {
+	if (paipwas.AdditionalProperties == nil) != (other.AdditionalProperties == nil) || len(paipwas.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	for k, e := range paipwas.AdditionalProperties {
+		f, ok := other.AdditionalProperties[k]
+		if !ok || (e == nil) != (f == nil) || (e != nil && *e != *f) {
+			return false
+		}
+	}
+	if (paipwas.ID == nil) != (other.ID == nil) || (paipwas.ID != nil && *paipwas.ID != *other.ID) {
+		return false
+	}
+	if (paipwas.Name == nil) != (other.Name == nil) || (paipwas.Name != nil && *paipwas.Name != *other.Name) {
+		return false
+	}
+	if !ignoreReadOnly {
+		if (paipwas.Status == nil) != (other.Status == nil) || (paipwas.Status != nil && *paipwas.Status != *other.Status) {
+			return false
+		}
+	}
+	if (paipwas.OdataLocation == nil) != (other.OdataLocation == nil) || (paipwas.OdataLocation != nil && *paipwas.OdataLocation != *other.OdataLocation) {
+		return false
+	}
+	if (paipwas.AdditionalProperties1 == nil) != (other.AdditionalProperties1 == nil) || len(paipwas.AdditionalProperties1) != len(other.AdditionalProperties1) {
+		return false
+	}
+	for k, e := range paipwas.AdditionalProperties1 {
+		f, ok := other.AdditionalProperties1[k]
+		if !ok || (e == nil) != (f == nil) || (e != nil && *e != *f) {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the PetAPInPropertiesWithAPString to copies of the fields set in patch.  Nested models
+// and maps are merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response
+// aren't changed.
+func (paipwas *PetAPInPropertiesWithAPString) MergeFrom(patch PetAPInPropertiesWithAPString) {
+	if patch.AdditionalProperties != nil {
+		if paipwas.AdditionalProperties == nil {
+			paipwas.AdditionalProperties = make(map[string]*string, len(patch.AdditionalProperties))
+		}
+		for k, e := range patch.AdditionalProperties {
+			if e != nil {
+				v := *e
+				e = &v
+			}
+			paipwas.AdditionalProperties[k] = e
+		}
+	}
+	if patch.ID != nil {
+		v := *patch.ID
+		paipwas.ID = &v
+	}
+	if patch.Name != nil {
+		v := *patch.Name
+		paipwas.Name = &v
+	}
+	if patch.OdataLocation != nil {
+		v := *patch.OdataLocation
+		paipwas.OdataLocation = &v
+	}
+	if patch.AdditionalProperties1 != nil {
+		if paipwas.AdditionalProperties1 == nil {
+			paipwas.AdditionalProperties1 = make(map[string]*float64, len(patch.AdditionalProperties1))
+		}
+		for k, e := range patch.AdditionalProperties1 {
+			if e != nil {
+				v := *e
+				e = &v
+			}
+			paipwas.AdditionalProperties1[k] = e
+		}
+	}
+}
+
 // PetAPObject ...
 type PetAPObject struct {
 	autorest.Response `json:"-"`
@@ -324,6 +1124,101 @@ func (pao *PetAPObject) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// Validate checks that the PetAPObject satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (pao PetAPObject) Validate() error {
+	var errs ValidationErrors
+	if pao.ID == nil {
+		errs = append(errs, ValidationError{Path: "id", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// DeepCopy returns a copy of the PetAPObject that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (pao PetAPObject) DeepCopy() PetAPObject {
+	result := pao
+	if pao.AdditionalProperties != nil {
+		result.AdditionalProperties = make(map[string]interface{}, len(pao.AdditionalProperties))
+		for k, e := range pao.AdditionalProperties {
+			result.AdditionalProperties[k] = deepCopyObject(e)
+		}
+	}
+	if pao.ID != nil {
+		v := *pao.ID
+		result.ID = &v
+	}
+	if pao.Name != nil {
+		v := *pao.Name
+		result.Name = &v
+	}
+	if pao.Status != nil {
+		v := *pao.Status
+		result.Status = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the PetAPObject and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (pao PetAPObject) Equal(other PetAPObject) bool {
+	return pao.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (pao PetAPObject) EqualIgnoreReadOnly(other PetAPObject) bool {
+	return pao.equal(other, true)
+}
+
+// equal compares the fields of the PetAPObject and other, skipping the read-only ones if ignoreReadOnly is true.
+func (pao PetAPObject) equal(other PetAPObject, ignoreReadOnly bool) bool {
+	if (pao.AdditionalProperties == nil) != (other.AdditionalProperties == nil) || len(pao.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	for k, e := range pao.AdditionalProperties {
+		f, ok := other.AdditionalProperties[k]
+		if !ok || !reflect.DeepEqual(e, f) {
+			return false
+		}
+	}
+	if (pao.ID == nil) != (other.ID == nil) || (pao.ID != nil && *pao.ID != *other.ID) {
+		return false
+	}
+	if (pao.Name == nil) != (other.Name == nil) || (pao.Name != nil && *pao.Name != *other.Name) {
+		return false
+	}
+	if !ignoreReadOnly {
+		if (pao.Status == nil) != (other.Status == nil) || (pao.Status != nil && *pao.Status != *other.Status) {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the PetAPObject to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (pao *PetAPObject) MergeFrom(patch PetAPObject) {
+	if patch.AdditionalProperties != nil {
+		if pao.AdditionalProperties == nil {
+			pao.AdditionalProperties = make(map[string]interface{}, len(patch.AdditionalProperties))
+		}
+		for k, e := range patch.AdditionalProperties {
+			pao.AdditionalProperties[k] = deepCopyObject(e)
+		}
+	}
+	if patch.ID != nil {
+		v := *patch.ID
+		pao.ID = &v
+	}
+	if patch.Name != nil {
+		v := *patch.Name
+		pao.Name = &v
+	}
+}
+
 // PetAPString ...
 type PetAPString struct {
 	autorest.Response `json:"-"`
@@ -404,6 +1299,109 @@ func (pas *PetAPString) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// Validate checks that the PetAPString satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (pas PetAPString) Validate() error {
+	var errs ValidationErrors
+	if pas.ID == nil {
+		errs = append(errs, ValidationError{Path: "id", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// DeepCopy returns a copy of the PetAPString that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (pas PetAPString) DeepCopy() PetAPString {
+	result := pas
+	if pas.AdditionalProperties != nil {
+		result.AdditionalProperties = make(map[string]*string, len(pas.AdditionalProperties))
+		for k, e := range pas.AdditionalProperties {
+			if e != nil {
+				v := *e
+				e = &v
+			}
+			result.AdditionalProperties[k] = e
+		}
+	}
+	if pas.ID != nil {
+		v := *pas.ID
+		result.ID = &v
+	}
+	if pas.Name != nil {
+		v := *pas.Name
+		result.Name = &v
+	}
+	if pas.Status != nil {
+		v := *pas.Status
+		result.Status = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the PetAPString and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (pas PetAPString) Equal(other PetAPString) bool {
+	return pas.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (pas PetAPString) EqualIgnoreReadOnly(other PetAPString) bool {
+	return pas.equal(other, true)
+}
+
+// equal compares the fields of the PetAPString and other, skipping the read-only ones if ignoreReadOnly is true.
+func (pas PetAPString) equal(other PetAPString, ignoreReadOnly bool) bool {
+	if (pas.AdditionalProperties == nil) != (other.AdditionalProperties == nil) || len(pas.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	for k, e := range pas.AdditionalProperties {
+		f, ok := other.AdditionalProperties[k]
+		if !ok || (e == nil) != (f == nil) || (e != nil && *e != *f) {
+			return false
+		}
+	}
+	if (pas.ID == nil) != (other.ID == nil) || (pas.ID != nil && *pas.ID != *other.ID) {
+		return false
+	}
+	if (pas.Name == nil) != (other.Name == nil) || (pas.Name != nil && *pas.Name != *other.Name) {
+		return false
+	}
+	if !ignoreReadOnly {
+		if (pas.Status == nil) != (other.Status == nil) || (pas.Status != nil && *pas.Status != *other.Status) {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the PetAPString to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (pas *PetAPString) MergeFrom(patch PetAPString) {
+	if patch.AdditionalProperties != nil {
+		if pas.AdditionalProperties == nil {
+			pas.AdditionalProperties = make(map[string]*string, len(patch.AdditionalProperties))
+		}
+		for k, e := range patch.AdditionalProperties {
+			if e != nil {
+				v := *e
+				e = &v
+			}
+			pas.AdditionalProperties[k] = e
+		}
+	}
+	if patch.ID != nil {
+		v := *patch.ID
+		pas.ID = &v
+	}
+	if patch.Name != nil {
+		v := *patch.Name
+		pas.Name = &v
+	}
+}
+
 // PetAPTrue ...
 type PetAPTrue struct {
 	autorest.Response `json:"-"`
@@ -483,3 +1481,238 @@ func (pat *PetAPTrue) UnmarshalJSON(body []byte) error {
 
 	return nil
 }
+
+// Validate checks that the PetAPTrue satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (pat PetAPTrue) Validate() error {
+	var errs ValidationErrors
+	if pat.ID == nil {
+		errs = append(errs, ValidationError{Path: "id", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// DeepCopy returns a copy of the PetAPTrue that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (pat PetAPTrue) DeepCopy() PetAPTrue {
+	result := pat
+	if pat.AdditionalProperties != nil {
+		result.AdditionalProperties = make(map[string]interface{}, len(pat.AdditionalProperties))
+		for k, e := range pat.AdditionalProperties {
+			result.AdditionalProperties[k] = deepCopyObject(e)
+		}
+	}
+	if pat.ID != nil {
+		v := *pat.ID
+		result.ID = &v
+	}
+	if pat.Name != nil {
+		v := *pat.Name
+		result.Name = &v
+	}
+	if pat.Status != nil {
+		v := *pat.Status
+		result.Status = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the PetAPTrue and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (pat PetAPTrue) Equal(other PetAPTrue) bool {
+	return pat.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (pat PetAPTrue) EqualIgnoreReadOnly(other PetAPTrue) bool {
+	return pat.equal(other, true)
+}
+
+// equal compares the fields of the PetAPTrue and other, skipping the read-only ones if ignoreReadOnly is true.
+func (pat PetAPTrue) equal(other PetAPTrue, ignoreReadOnly bool) bool {
+	if (pat.AdditionalProperties == nil) != (other.AdditionalProperties == nil) || len(pat.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	for k, e := range pat.AdditionalProperties {
+		f, ok := other.AdditionalProperties[k]
+		if !ok || !reflect.DeepEqual(e, f) {
+			return false
+		}
+	}
+	if (pat.ID == nil) != (other.ID == nil) || (pat.ID != nil && *pat.ID != *other.ID) {
+		return false
+	}
+	if (pat.Name == nil) != (other.Name == nil) || (pat.Name != nil && *pat.Name != *other.Name) {
+		return false
+	}
+	if !ignoreReadOnly {
+		if (pat.Status == nil) != (other.Status == nil) || (pat.Status != nil && *pat.Status != *other.Status) {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the PetAPTrue to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (pat *PetAPTrue) MergeFrom(patch PetAPTrue) {
+	if patch.AdditionalProperties != nil {
+		if pat.AdditionalProperties == nil {
+			pat.AdditionalProperties = make(map[string]interface{}, len(patch.AdditionalProperties))
+		}
+		for k, e := range patch.AdditionalProperties {
+			pat.AdditionalProperties[k] = deepCopyObject(e)
+		}
+	}
+	if patch.ID != nil {
+		v := *patch.ID
+		pat.ID = &v
+	}
+	if patch.Name != nil {
+		v := *patch.Name
+		pat.Name = &v
+	}
+}
+
+// RequestOptions contains the per-call options applied to the requests of operations whose context was created with WithRequestOptions.
+type RequestOptions struct {
+	// Header - additional headers to send.  They replace any values of the same headers set by the operation.
+	Header http.Header
+	// Query - additional query parameters to send.  They replace any values of the same parameters set by the operation.
+	Query url.Values
+	// Timeout - if greater than zero, the maximum amount of time allowed for sending the request, including retries, and reading its response.
+	Timeout time.Duration
+}
+
+type requestOptionsKey struct{}
+
+// WithRequestOptions returns a copy of ctx carrying opts.  Operations called with the returned
+// context apply opts to their requests; other calls aren't affected.
+func WithRequestOptions(ctx context.Context, opts RequestOptions) context.Context {
+	return context.WithValue(ctx, requestOptionsKey{}, opts)
+}
+
+// withRequestOptions returns a PrepareDecorator that merges the headers and query parameters
+// of the RequestOptions carried by the request's context into the request.
+func withRequestOptions() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+			opts, ok := r.Context().Value(requestOptionsKey{}).(RequestOptions)
+			if !ok {
+				return r, nil
+			}
+			if len(opts.Header) > 0 {
+				if r.Header == nil {
+					r.Header = make(http.Header)
+				}
+				for k, v := range opts.Header {
+					r.Header[http.CanonicalHeaderKey(k)] = v
+				}
+			}
+			if len(opts.Query) > 0 {
+				q := r.URL.Query()
+				for k, v := range opts.Query {
+					q[k] = v
+				}
+				r.URL.RawQuery = q.Encode()
+			}
+			return r, nil
+		})
+	}
+}
+
+// sendWithRequestOptions sends the request like autorest.SendWithSender.  If the request's context
+// carries a RequestOptions with a Timeout, the request is cancelled once the timeout elapses
+// or the response body is closed, whichever happens first.
+func sendWithRequestOptions(s autorest.Sender, r *http.Request, decorators ...autorest.SendDecorator) (*http.Response, error) {
+	opts, ok := r.Context().Value(requestOptionsKey{}).(RequestOptions)
+	if !ok || opts.Timeout <= 0 {
+		return autorest.SendWithSender(s, r, decorators...)
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), opts.Timeout)
+	resp, err := autorest.SendWithSender(s, r.WithContext(ctx), decorators...)
+	if err != nil || resp == nil || resp.Body == nil {
+		cancel()
+		return resp, err
+	}
+	resp.Body = requestOptionsBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// requestOptionsBody releases the timeout of a request once its response body is closed.
+type requestOptionsBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b requestOptionsBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+// ValidationError describes a value that violates one of the constraints defined by the service.
+type ValidationError struct {
+	// Path - the JSON path of the value, relative to the value being validated.
+	Path string
+	// Constraint - the name of the violated constraint, e.g. MaxLength.
+	Constraint string
+	// Details - describes the violation.
+	Details string
+}
+
+// Error implements the error interface for type ValidationError.
+func (ve ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s (%s)", ve.Path, ve.Details, ve.Constraint)
+}
+
+// ValidationErrors contains every constraint violation found when validating a value.
+type ValidationErrors []ValidationError
+
+// Error implements the error interface for type ValidationErrors.
+func (ve ValidationErrors) Error() string {
+	msgs := make([]string, len(ve))
+	for i := range ve {
+		msgs[i] = ve[i].Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// addNested appends the violations in err, as returned by the Validate method
+// of the value at path, to ve after prefixing their paths with path.
+func (ve ValidationErrors) addNested(path string, err error) ValidationErrors {
+	if nested, ok := err.(ValidationErrors); ok {
+		for _, e := range nested {
+			e.Path = path + "." + e.Path
+			ve = append(ve, e)
+		}
+	} else if err != nil {
+		ve = append(ve, ValidationError{Path: path, Details: err.Error()})
+	}
+	return ve
+}
+
+// ParameterValidationError is returned by an operation whose parameters violate the constraints defined
+// by the service.  Use errors.As to retrieve the validation.Error or the ValidationErrors it wraps.
+type ParameterValidationError struct {
+	// Err - identifies the operation, its message lists every violation.
+	Err validation.Error
+	// Errors - contains every violation.
+	Errors ValidationErrors
+}
+
+// Error implements the error interface for type ParameterValidationError.
+func (pve ParameterValidationError) Error() string {
+	return pve.Err.Error()
+}
+
+// Unwrap returns the validation.Error and the ValidationErrors wrapped by the ParameterValidationError.
+func (pve ParameterValidationError) Unwrap() []error {
+	return []error{pve.Err, pve.Errors}
+}
@@ -9,9 +9,7 @@ package additionalproperties
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/validation"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -30,22 +28,31 @@ func NewPetsClientWithBaseURI(baseURI string) PetsClient {
 	return PetsClient{NewWithBaseURI(baseURI)}
 }
 
+// NewPetsClientWithOptions creates an instance of the PetsClient client with the specified options.  It returns an
+// error if the options are invalid.
+func NewPetsClientWithOptions(opts ClientOptions) (PetsClient, error) {
+	client, err := NewWithOptions(opts)
+	return PetsClient{client}, err
+}
+
 // CreateAPInProperties create a Pet which contains more properties than what is defined.
 func (client PetsClient) CreateAPInProperties(ctx context.Context, createParameters PetAPInProperties) (result PetAPInProperties, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PetsClient.CreateAPInProperties")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PetsClient.CreateAPInProperties", "PUT", "/additionalProperties/in/properties")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: createParameters,
-			Constraints: []validation.Constraint{{Target: "createParameters.ID", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("additionalproperties.PetsClient", "CreateAPInProperties", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PetsClient.CreateAPInProperties", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
+		}()
+	}
+	var errs ValidationErrors
+	errs = errs.addNested("createParameters", createParameters.Validate())
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("additionalproperties.PetsClient", "CreateAPInProperties", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.CreateAPInPropertiesPreparer(ctx, createParameters)
@@ -62,7 +69,7 @@ func (client PetsClient) CreateAPInProperties(ctx context.Context, createParamet
 	}
 
 	result, err = client.CreateAPInPropertiesResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "additionalproperties.PetsClient", "CreateAPInProperties", resp, "Failure responding to request")
 	}
 
@@ -78,14 +85,15 @@ func (client PetsClient) CreateAPInPropertiesPreparer(ctx context.Context, creat
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/additionalProperties/in/properties"),
 		autorest.WithJSON(createParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // CreateAPInPropertiesSender sends the CreateAPInProperties request. The method will close the
 // http.Response Body if it receives an error.
 func (client PetsClient) CreateAPInPropertiesSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // CreateAPInPropertiesResponder handles the response to the CreateAPInProperties request. The method always
@@ -94,7 +102,7 @@ func (client PetsClient) CreateAPInPropertiesResponder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -103,21 +111,22 @@ func (client PetsClient) CreateAPInPropertiesResponder(resp *http.Response) (res
 
 // CreateAPInPropertiesWithAPString create a Pet which contains more properties than what is defined.
 func (client PetsClient) CreateAPInPropertiesWithAPString(ctx context.Context, createParameters PetAPInPropertiesWithAPString) (result PetAPInPropertiesWithAPString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PetsClient.CreateAPInPropertiesWithAPString")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PetsClient.CreateAPInPropertiesWithAPString", "PUT", "/additionalProperties/in/properties/with/additionalProperties/string")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PetsClient.CreateAPInPropertiesWithAPString", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: createParameters,
-			Constraints: []validation.Constraint{{Target: "createParameters.ID", Name: validation.Null, Rule: true, Chain: nil},
-				{Target: "createParameters.OdataLocation", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("additionalproperties.PetsClient", "CreateAPInPropertiesWithAPString", err.Error())
+	var errs ValidationErrors
+	errs = errs.addNested("createParameters", createParameters.Validate())
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("additionalproperties.PetsClient", "CreateAPInPropertiesWithAPString", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.CreateAPInPropertiesWithAPStringPreparer(ctx, createParameters)
@@ -134,7 +143,7 @@ func (client PetsClient) CreateAPInPropertiesWithAPString(ctx context.Context, c
 	}
 
 	result, err = client.CreateAPInPropertiesWithAPStringResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "additionalproperties.PetsClient", "CreateAPInPropertiesWithAPString", resp, "Failure responding to request")
 	}
 
@@ -150,14 +159,15 @@ func (client PetsClient) CreateAPInPropertiesWithAPStringPreparer(ctx context.Co
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/additionalProperties/in/properties/with/additionalProperties/string"),
 		autorest.WithJSON(createParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // CreateAPInPropertiesWithAPStringSender sends the CreateAPInPropertiesWithAPString request. The method will close the
 // http.Response Body if it receives an error.
 func (client PetsClient) CreateAPInPropertiesWithAPStringSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // CreateAPInPropertiesWithAPStringResponder handles the response to the CreateAPInPropertiesWithAPString request. The method always
@@ -166,7 +176,7 @@ func (client PetsClient) CreateAPInPropertiesWithAPStringResponder(resp *http.Re
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -175,20 +185,22 @@ func (client PetsClient) CreateAPInPropertiesWithAPStringResponder(resp *http.Re
 
 // CreateAPObject create a Pet which contains more properties than what is defined.
 func (client PetsClient) CreateAPObject(ctx context.Context, createParameters PetAPObject) (result PetAPObject, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PetsClient.CreateAPObject")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PetsClient.CreateAPObject", "PUT", "/additionalProperties/type/object")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: createParameters,
-			Constraints: []validation.Constraint{{Target: "createParameters.ID", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("additionalproperties.PetsClient", "CreateAPObject", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PetsClient.CreateAPObject", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
+		}()
+	}
+	var errs ValidationErrors
+	errs = errs.addNested("createParameters", createParameters.Validate())
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("additionalproperties.PetsClient", "CreateAPObject", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.CreateAPObjectPreparer(ctx, createParameters)
@@ -205,7 +217,7 @@ func (client PetsClient) CreateAPObject(ctx context.Context, createParameters Pe
 	}
 
 	result, err = client.CreateAPObjectResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "additionalproperties.PetsClient", "CreateAPObject", resp, "Failure responding to request")
 	}
 
@@ -221,14 +233,15 @@ func (client PetsClient) CreateAPObjectPreparer(ctx context.Context, createParam
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/additionalProperties/type/object"),
 		autorest.WithJSON(createParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // CreateAPObjectSender sends the CreateAPObject request. The method will close the
 // http.Response Body if it receives an error.
 func (client PetsClient) CreateAPObjectSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // CreateAPObjectResponder handles the response to the CreateAPObject request. The method always
@@ -237,7 +250,7 @@ func (client PetsClient) CreateAPObjectResponder(resp *http.Response) (result Pe
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -246,20 +259,22 @@ func (client PetsClient) CreateAPObjectResponder(resp *http.Response) (result Pe
 
 // CreateAPString create a Pet which contains more properties than what is defined.
 func (client PetsClient) CreateAPString(ctx context.Context, createParameters PetAPString) (result PetAPString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PetsClient.CreateAPString")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PetsClient.CreateAPString", "PUT", "/additionalProperties/type/string")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: createParameters,
-			Constraints: []validation.Constraint{{Target: "createParameters.ID", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("additionalproperties.PetsClient", "CreateAPString", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PetsClient.CreateAPString", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
+		}()
+	}
+	var errs ValidationErrors
+	errs = errs.addNested("createParameters", createParameters.Validate())
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("additionalproperties.PetsClient", "CreateAPString", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.CreateAPStringPreparer(ctx, createParameters)
@@ -276,7 +291,7 @@ func (client PetsClient) CreateAPString(ctx context.Context, createParameters Pe
 	}
 
 	result, err = client.CreateAPStringResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "additionalproperties.PetsClient", "CreateAPString", resp, "Failure responding to request")
 	}
 
@@ -292,14 +307,15 @@ func (client PetsClient) CreateAPStringPreparer(ctx context.Context, createParam
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/additionalProperties/type/string"),
 		autorest.WithJSON(createParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // CreateAPStringSender sends the CreateAPString request. The method will close the
 // http.Response Body if it receives an error.
 func (client PetsClient) CreateAPStringSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // CreateAPStringResponder handles the response to the CreateAPString request. The method always
@@ -308,7 +324,7 @@ func (client PetsClient) CreateAPStringResponder(resp *http.Response) (result Pe
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -317,20 +333,22 @@ func (client PetsClient) CreateAPStringResponder(resp *http.Response) (result Pe
 
 // CreateAPTrue create a Pet which contains more properties than what is defined.
 func (client PetsClient) CreateAPTrue(ctx context.Context, createParameters PetAPTrue) (result PetAPTrue, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PetsClient.CreateAPTrue")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PetsClient.CreateAPTrue", "PUT", "/additionalProperties/true")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PetsClient.CreateAPTrue", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: createParameters,
-			Constraints: []validation.Constraint{{Target: "createParameters.ID", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("additionalproperties.PetsClient", "CreateAPTrue", err.Error())
+	var errs ValidationErrors
+	errs = errs.addNested("createParameters", createParameters.Validate())
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("additionalproperties.PetsClient", "CreateAPTrue", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.CreateAPTruePreparer(ctx, createParameters)
@@ -347,7 +365,7 @@ func (client PetsClient) CreateAPTrue(ctx context.Context, createParameters PetA
 	}
 
 	result, err = client.CreateAPTrueResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "additionalproperties.PetsClient", "CreateAPTrue", resp, "Failure responding to request")
 	}
 
@@ -363,14 +381,15 @@ func (client PetsClient) CreateAPTruePreparer(ctx context.Context, createParamet
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/additionalProperties/true"),
 		autorest.WithJSON(createParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // CreateAPTrueSender sends the CreateAPTrue request. The method will close the
 // http.Response Body if it receives an error.
 func (client PetsClient) CreateAPTrueSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // CreateAPTrueResponder handles the response to the CreateAPTrue request. The method always
@@ -379,7 +398,7 @@ func (client PetsClient) CreateAPTrueResponder(resp *http.Response) (result PetA
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -388,16 +407,24 @@ func (client PetsClient) CreateAPTrueResponder(resp *http.Response) (result PetA
 
 // CreateCatAPTrue create a CatAPTrue which contains more properties than what is defined.
 func (client PetsClient) CreateCatAPTrue(ctx context.Context, createParameters CatAPTrue) (result CatAPTrue, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PetsClient.CreateCatAPTrue")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PetsClient.CreateCatAPTrue", "PUT", "/additionalProperties/true-subclass")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PetsClient.CreateCatAPTrue", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
+	var errs ValidationErrors
+	errs = errs.addNested("createParameters", createParameters.Validate())
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("additionalproperties.PetsClient", "CreateCatAPTrue", errs.Error()), Errors: errs}
+	}
+
 	req, err := client.CreateCatAPTruePreparer(ctx, createParameters)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "additionalproperties.PetsClient", "CreateCatAPTrue", nil, "Failure preparing request")
@@ -412,7 +439,7 @@ func (client PetsClient) CreateCatAPTrue(ctx context.Context, createParameters C
 	}
 
 	result, err = client.CreateCatAPTrueResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "additionalproperties.PetsClient", "CreateCatAPTrue", resp, "Failure responding to request")
 	}
 
@@ -427,14 +454,15 @@ func (client PetsClient) CreateCatAPTruePreparer(ctx context.Context, createPara
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/additionalProperties/true-subclass"),
 		autorest.WithJSON(createParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // CreateCatAPTrueSender sends the CreateCatAPTrue request. The method will close the
 // http.Response Body if it receives an error.
 func (client PetsClient) CreateCatAPTrueSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // CreateCatAPTrueResponder handles the response to the CreateCatAPTrue request. The method always
@@ -443,7 +471,7 @@ func (client PetsClient) CreateCatAPTrueResponder(resp *http.Response) (result C
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -9,10 +9,8 @@ package arraygroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/Azure/go-autorest/autorest/validation"
-	"github.com/Azure/go-autorest/tracing"
 	"github.com/satori/go.uuid"
 	"net/http"
 )
@@ -32,16 +30,25 @@ func NewArrayClientWithBaseURI(baseURI string) ArrayClient {
 	return ArrayClient{NewWithBaseURI(baseURI)}
 }
 
+// NewArrayClientWithOptions creates an instance of the ArrayClient client with the specified options.  It returns an
+// error if the options are invalid.
+func NewArrayClientWithOptions(opts ClientOptions) (ArrayClient, error) {
+	client, err := NewWithOptions(opts)
+	return ArrayClient{client}, err
+}
+
 // GetArrayEmpty get an empty array []
 func (client ArrayClient) GetArrayEmpty(ctx context.Context) (result ListListString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetArrayEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetArrayEmpty", "GET", "/array/array/empty")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetArrayEmpty", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetArrayEmptyPreparer(ctx)
@@ -58,7 +65,7 @@ func (client ArrayClient) GetArrayEmpty(ctx context.Context) (result ListListStr
 	}
 
 	result, err = client.GetArrayEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetArrayEmpty", resp, "Failure responding to request")
 	}
 
@@ -71,14 +78,15 @@ func (client ArrayClient) GetArrayEmptyPreparer(ctx context.Context) (*http.Requ
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/array/empty"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetArrayEmptySender sends the GetArrayEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetArrayEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetArrayEmptyResponder handles the response to the GetArrayEmpty request. The method always
@@ -87,7 +95,7 @@ func (client ArrayClient) GetArrayEmptyResponder(resp *http.Response) (result Li
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -96,14 +104,16 @@ func (client ArrayClient) GetArrayEmptyResponder(resp *http.Response) (result Li
 
 // GetArrayItemEmpty get an array of array of strings [['1', '2', '3'], [], ['7', '8', '9']]
 func (client ArrayClient) GetArrayItemEmpty(ctx context.Context) (result ListListString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetArrayItemEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetArrayItemEmpty", "GET", "/array/array/itemempty")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetArrayItemEmpty", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetArrayItemEmptyPreparer(ctx)
@@ -120,7 +130,7 @@ func (client ArrayClient) GetArrayItemEmpty(ctx context.Context) (result ListLis
 	}
 
 	result, err = client.GetArrayItemEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetArrayItemEmpty", resp, "Failure responding to request")
 	}
 
@@ -133,14 +143,15 @@ func (client ArrayClient) GetArrayItemEmptyPreparer(ctx context.Context) (*http.
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/array/itemempty"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetArrayItemEmptySender sends the GetArrayItemEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetArrayItemEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetArrayItemEmptyResponder handles the response to the GetArrayItemEmpty request. The method always
@@ -149,7 +160,7 @@ func (client ArrayClient) GetArrayItemEmptyResponder(resp *http.Response) (resul
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -158,14 +169,16 @@ func (client ArrayClient) GetArrayItemEmptyResponder(resp *http.Response) (resul
 
 // GetArrayItemNull get an array of array of strings [['1', '2', '3'], null, ['7', '8', '9']]
 func (client ArrayClient) GetArrayItemNull(ctx context.Context) (result ListListString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetArrayItemNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetArrayItemNull", "GET", "/array/array/itemnull")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetArrayItemNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetArrayItemNullPreparer(ctx)
@@ -182,7 +195,7 @@ func (client ArrayClient) GetArrayItemNull(ctx context.Context) (result ListList
 	}
 
 	result, err = client.GetArrayItemNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetArrayItemNull", resp, "Failure responding to request")
 	}
 
@@ -195,14 +208,15 @@ func (client ArrayClient) GetArrayItemNullPreparer(ctx context.Context) (*http.R
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/array/itemnull"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetArrayItemNullSender sends the GetArrayItemNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetArrayItemNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetArrayItemNullResponder handles the response to the GetArrayItemNull request. The method always
@@ -211,7 +225,7 @@ func (client ArrayClient) GetArrayItemNullResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -220,14 +234,16 @@ func (client ArrayClient) GetArrayItemNullResponder(resp *http.Response) (result
 
 // GetArrayNull get a null array
 func (client ArrayClient) GetArrayNull(ctx context.Context) (result ListListString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetArrayNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetArrayNull", "GET", "/array/array/null")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetArrayNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetArrayNullPreparer(ctx)
@@ -244,7 +260,7 @@ func (client ArrayClient) GetArrayNull(ctx context.Context) (result ListListStri
 	}
 
 	result, err = client.GetArrayNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetArrayNull", resp, "Failure responding to request")
 	}
 
@@ -257,14 +273,15 @@ func (client ArrayClient) GetArrayNullPreparer(ctx context.Context) (*http.Reque
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/array/null"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetArrayNullSender sends the GetArrayNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetArrayNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetArrayNullResponder handles the response to the GetArrayNull request. The method always
@@ -273,7 +290,7 @@ func (client ArrayClient) GetArrayNullResponder(resp *http.Response) (result Lis
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -282,14 +299,16 @@ func (client ArrayClient) GetArrayNullResponder(resp *http.Response) (result Lis
 
 // GetArrayValid get an array of array of strings [['1', '2', '3'], ['4', '5', '6'], ['7', '8', '9']]
 func (client ArrayClient) GetArrayValid(ctx context.Context) (result ListListString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetArrayValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetArrayValid", "GET", "/array/array/valid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetArrayValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetArrayValidPreparer(ctx)
@@ -306,7 +325,7 @@ func (client ArrayClient) GetArrayValid(ctx context.Context) (result ListListStr
 	}
 
 	result, err = client.GetArrayValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetArrayValid", resp, "Failure responding to request")
 	}
 
@@ -319,14 +338,15 @@ func (client ArrayClient) GetArrayValidPreparer(ctx context.Context) (*http.Requ
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/array/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetArrayValidSender sends the GetArrayValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetArrayValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetArrayValidResponder handles the response to the GetArrayValid request. The method always
@@ -335,7 +355,7 @@ func (client ArrayClient) GetArrayValidResponder(resp *http.Response) (result Li
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -345,14 +365,16 @@ func (client ArrayClient) GetArrayValidResponder(resp *http.Response) (result Li
 // GetBase64URL get array value ['a string that gets encoded with base64url', 'test string' 'Lorem ipsum'] with the
 // items base64url encoded
 func (client ArrayClient) GetBase64URL(ctx context.Context) (result ListBase64URL, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetBase64URL")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetBase64URL", "GET", "/array/prim/base64url/valid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetBase64URL", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetBase64URLPreparer(ctx)
@@ -369,7 +391,7 @@ func (client ArrayClient) GetBase64URL(ctx context.Context) (result ListBase64UR
 	}
 
 	result, err = client.GetBase64URLResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetBase64URL", resp, "Failure responding to request")
 	}
 
@@ -382,14 +404,15 @@ func (client ArrayClient) GetBase64URLPreparer(ctx context.Context) (*http.Reque
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/base64url/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetBase64URLSender sends the GetBase64URL request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetBase64URLSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetBase64URLResponder handles the response to the GetBase64URL request. The method always
@@ -398,7 +421,7 @@ func (client ArrayClient) GetBase64URLResponder(resp *http.Response) (result Lis
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -407,14 +430,16 @@ func (client ArrayClient) GetBase64URLResponder(resp *http.Response) (result Lis
 
 // GetBooleanInvalidNull get boolean array value [true, null, false]
 func (client ArrayClient) GetBooleanInvalidNull(ctx context.Context) (result ListBool, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetBooleanInvalidNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetBooleanInvalidNull", "GET", "/array/prim/boolean/true.null.false")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetBooleanInvalidNull", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetBooleanInvalidNullPreparer(ctx)
@@ -431,7 +456,7 @@ func (client ArrayClient) GetBooleanInvalidNull(ctx context.Context) (result Lis
 	}
 
 	result, err = client.GetBooleanInvalidNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetBooleanInvalidNull", resp, "Failure responding to request")
 	}
 
@@ -444,14 +469,15 @@ func (client ArrayClient) GetBooleanInvalidNullPreparer(ctx context.Context) (*h
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/boolean/true.null.false"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetBooleanInvalidNullSender sends the GetBooleanInvalidNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetBooleanInvalidNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetBooleanInvalidNullResponder handles the response to the GetBooleanInvalidNull request. The method always
@@ -460,7 +486,7 @@ func (client ArrayClient) GetBooleanInvalidNullResponder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -469,14 +495,16 @@ func (client ArrayClient) GetBooleanInvalidNullResponder(resp *http.Response) (r
 
 // GetBooleanInvalidString get boolean array value [true, 'boolean', false]
 func (client ArrayClient) GetBooleanInvalidString(ctx context.Context) (result ListBool, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetBooleanInvalidString")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetBooleanInvalidString", "GET", "/array/prim/boolean/true.boolean.false")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetBooleanInvalidString", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetBooleanInvalidStringPreparer(ctx)
@@ -493,7 +521,7 @@ func (client ArrayClient) GetBooleanInvalidString(ctx context.Context) (result L
 	}
 
 	result, err = client.GetBooleanInvalidStringResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetBooleanInvalidString", resp, "Failure responding to request")
 	}
 
@@ -506,14 +534,15 @@ func (client ArrayClient) GetBooleanInvalidStringPreparer(ctx context.Context) (
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/boolean/true.boolean.false"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetBooleanInvalidStringSender sends the GetBooleanInvalidString request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetBooleanInvalidStringSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetBooleanInvalidStringResponder handles the response to the GetBooleanInvalidString request. The method always
@@ -522,7 +551,7 @@ func (client ArrayClient) GetBooleanInvalidStringResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -531,14 +560,16 @@ func (client ArrayClient) GetBooleanInvalidStringResponder(resp *http.Response)
 
 // GetBooleanTfft get boolean array value [true, false, false, true]
 func (client ArrayClient) GetBooleanTfft(ctx context.Context) (result ListBool, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetBooleanTfft")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetBooleanTfft", "GET", "/array/prim/boolean/tfft")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetBooleanTfft", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetBooleanTfftPreparer(ctx)
@@ -555,7 +586,7 @@ func (client ArrayClient) GetBooleanTfft(ctx context.Context) (result ListBool,
 	}
 
 	result, err = client.GetBooleanTfftResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetBooleanTfft", resp, "Failure responding to request")
 	}
 
@@ -568,14 +599,15 @@ func (client ArrayClient) GetBooleanTfftPreparer(ctx context.Context) (*http.Req
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/boolean/tfft"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetBooleanTfftSender sends the GetBooleanTfft request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetBooleanTfftSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetBooleanTfftResponder handles the response to the GetBooleanTfft request. The method always
@@ -584,7 +616,7 @@ func (client ArrayClient) GetBooleanTfftResponder(resp *http.Response) (result L
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -593,14 +625,16 @@ func (client ArrayClient) GetBooleanTfftResponder(resp *http.Response) (result L
 
 // GetByteInvalidNull get byte array value [hex(AB, AC, AD), null] with the first item base64 encoded
 func (client ArrayClient) GetByteInvalidNull(ctx context.Context) (result ListByteArray, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetByteInvalidNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetByteInvalidNull", "GET", "/array/prim/byte/invalidnull")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetByteInvalidNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetByteInvalidNullPreparer(ctx)
@@ -617,7 +651,7 @@ func (client ArrayClient) GetByteInvalidNull(ctx context.Context) (result ListBy
 	}
 
 	result, err = client.GetByteInvalidNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetByteInvalidNull", resp, "Failure responding to request")
 	}
 
@@ -630,14 +664,15 @@ func (client ArrayClient) GetByteInvalidNullPreparer(ctx context.Context) (*http
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/byte/invalidnull"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetByteInvalidNullSender sends the GetByteInvalidNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetByteInvalidNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetByteInvalidNullResponder handles the response to the GetByteInvalidNull request. The method always
@@ -646,7 +681,7 @@ func (client ArrayClient) GetByteInvalidNullResponder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -656,14 +691,16 @@ func (client ArrayClient) GetByteInvalidNullResponder(resp *http.Response) (resu
 // GetByteValid get byte array value [hex(FF FF FF FA), hex(01 02 03), hex (25, 29, 43)] with each item encoded in
 // base64
 func (client ArrayClient) GetByteValid(ctx context.Context) (result ListByteArray, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetByteValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetByteValid", "GET", "/array/prim/byte/valid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetByteValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetByteValidPreparer(ctx)
@@ -680,7 +717,7 @@ func (client ArrayClient) GetByteValid(ctx context.Context) (result ListByteArra
 	}
 
 	result, err = client.GetByteValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetByteValid", resp, "Failure responding to request")
 	}
 
@@ -693,14 +730,15 @@ func (client ArrayClient) GetByteValidPreparer(ctx context.Context) (*http.Reque
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/byte/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetByteValidSender sends the GetByteValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetByteValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetByteValidResponder handles the response to the GetByteValid request. The method always
@@ -709,7 +747,7 @@ func (client ArrayClient) GetByteValidResponder(resp *http.Response) (result Lis
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -718,14 +756,16 @@ func (client ArrayClient) GetByteValidResponder(resp *http.Response) (result Lis
 
 // GetComplexEmpty get empty array of complex type []
 func (client ArrayClient) GetComplexEmpty(ctx context.Context) (result ListProduct, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetComplexEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetComplexEmpty", "GET", "/array/complex/empty")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetComplexEmpty", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetComplexEmptyPreparer(ctx)
@@ -742,7 +782,7 @@ func (client ArrayClient) GetComplexEmpty(ctx context.Context) (result ListProdu
 	}
 
 	result, err = client.GetComplexEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetComplexEmpty", resp, "Failure responding to request")
 	}
 
@@ -755,14 +795,15 @@ func (client ArrayClient) GetComplexEmptyPreparer(ctx context.Context) (*http.Re
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/complex/empty"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetComplexEmptySender sends the GetComplexEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetComplexEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetComplexEmptyResponder handles the response to the GetComplexEmpty request. The method always
@@ -771,7 +812,7 @@ func (client ArrayClient) GetComplexEmptyResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -781,14 +822,16 @@ func (client ArrayClient) GetComplexEmptyResponder(resp *http.Response) (result
 // GetComplexItemEmpty get array of complex type with empty item [{'integer': 1 'string': '2'}, {}, {'integer': 5,
 // 'string': '6'}]
 func (client ArrayClient) GetComplexItemEmpty(ctx context.Context) (result ListProduct, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetComplexItemEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetComplexItemEmpty", "GET", "/array/complex/itemempty")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetComplexItemEmpty", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetComplexItemEmptyPreparer(ctx)
@@ -805,7 +848,7 @@ func (client ArrayClient) GetComplexItemEmpty(ctx context.Context) (result ListP
 	}
 
 	result, err = client.GetComplexItemEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetComplexItemEmpty", resp, "Failure responding to request")
 	}
 
@@ -818,14 +861,15 @@ func (client ArrayClient) GetComplexItemEmptyPreparer(ctx context.Context) (*htt
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/complex/itemempty"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetComplexItemEmptySender sends the GetComplexItemEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetComplexItemEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetComplexItemEmptyResponder handles the response to the GetComplexItemEmpty request. The method always
@@ -834,7 +878,7 @@ func (client ArrayClient) GetComplexItemEmptyResponder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -844,14 +888,16 @@ func (client ArrayClient) GetComplexItemEmptyResponder(resp *http.Response) (res
 // GetComplexItemNull get array of complex type with null item [{'integer': 1 'string': '2'}, null, {'integer': 5,
 // 'string': '6'}]
 func (client ArrayClient) GetComplexItemNull(ctx context.Context) (result ListProduct, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetComplexItemNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetComplexItemNull", "GET", "/array/complex/itemnull")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetComplexItemNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetComplexItemNullPreparer(ctx)
@@ -868,7 +914,7 @@ func (client ArrayClient) GetComplexItemNull(ctx context.Context) (result ListPr
 	}
 
 	result, err = client.GetComplexItemNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetComplexItemNull", resp, "Failure responding to request")
 	}
 
@@ -881,14 +927,15 @@ func (client ArrayClient) GetComplexItemNullPreparer(ctx context.Context) (*http
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/complex/itemnull"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetComplexItemNullSender sends the GetComplexItemNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetComplexItemNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetComplexItemNullResponder handles the response to the GetComplexItemNull request. The method always
@@ -897,7 +944,7 @@ func (client ArrayClient) GetComplexItemNullResponder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -906,14 +953,16 @@ func (client ArrayClient) GetComplexItemNullResponder(resp *http.Response) (resu
 
 // GetComplexNull get array of complex type null value
 func (client ArrayClient) GetComplexNull(ctx context.Context) (result ListProduct, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetComplexNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetComplexNull", "GET", "/array/complex/null")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetComplexNull", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetComplexNullPreparer(ctx)
@@ -930,7 +979,7 @@ func (client ArrayClient) GetComplexNull(ctx context.Context) (result ListProduc
 	}
 
 	result, err = client.GetComplexNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetComplexNull", resp, "Failure responding to request")
 	}
 
@@ -943,14 +992,15 @@ func (client ArrayClient) GetComplexNullPreparer(ctx context.Context) (*http.Req
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/complex/null"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetComplexNullSender sends the GetComplexNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetComplexNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetComplexNullResponder handles the response to the GetComplexNull request. The method always
@@ -959,7 +1009,7 @@ func (client ArrayClient) GetComplexNullResponder(resp *http.Response) (result L
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -969,14 +1019,16 @@ func (client ArrayClient) GetComplexNullResponder(resp *http.Response) (result L
 // GetComplexValid get array of complex type with [{'integer': 1 'string': '2'}, {'integer': 3, 'string': '4'},
 // {'integer': 5, 'string': '6'}]
 func (client ArrayClient) GetComplexValid(ctx context.Context) (result ListProduct, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetComplexValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetComplexValid", "GET", "/array/complex/valid")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetComplexValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetComplexValidPreparer(ctx)
@@ -993,7 +1045,7 @@ func (client ArrayClient) GetComplexValid(ctx context.Context) (result ListProdu
 	}
 
 	result, err = client.GetComplexValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetComplexValid", resp, "Failure responding to request")
 	}
 
@@ -1006,14 +1058,15 @@ func (client ArrayClient) GetComplexValidPreparer(ctx context.Context) (*http.Re
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/complex/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetComplexValidSender sends the GetComplexValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetComplexValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetComplexValidResponder handles the response to the GetComplexValid request. The method always
@@ -1022,7 +1075,7 @@ func (client ArrayClient) GetComplexValidResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1031,14 +1084,16 @@ func (client ArrayClient) GetComplexValidResponder(resp *http.Response) (result
 
 // GetDateInvalidChars get date array value ['2011-03-22', 'date']
 func (client ArrayClient) GetDateInvalidChars(ctx context.Context) (result ListDate, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetDateInvalidChars")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetDateInvalidChars", "GET", "/array/prim/date/invalidchars")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetDateInvalidChars", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDateInvalidCharsPreparer(ctx)
@@ -1055,7 +1110,7 @@ func (client ArrayClient) GetDateInvalidChars(ctx context.Context) (result ListD
 	}
 
 	result, err = client.GetDateInvalidCharsResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetDateInvalidChars", resp, "Failure responding to request")
 	}
 
@@ -1068,14 +1123,15 @@ func (client ArrayClient) GetDateInvalidCharsPreparer(ctx context.Context) (*htt
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/date/invalidchars"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDateInvalidCharsSender sends the GetDateInvalidChars request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetDateInvalidCharsSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDateInvalidCharsResponder handles the response to the GetDateInvalidChars request. The method always
@@ -1084,7 +1140,7 @@ func (client ArrayClient) GetDateInvalidCharsResponder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1093,14 +1149,16 @@ func (client ArrayClient) GetDateInvalidCharsResponder(resp *http.Response) (res
 
 // GetDateInvalidNull get date array value ['2012-01-01', null, '1776-07-04']
 func (client ArrayClient) GetDateInvalidNull(ctx context.Context) (result ListDate, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetDateInvalidNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetDateInvalidNull", "GET", "/array/prim/date/invalidnull")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetDateInvalidNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDateInvalidNullPreparer(ctx)
@@ -1117,7 +1175,7 @@ func (client ArrayClient) GetDateInvalidNull(ctx context.Context) (result ListDa
 	}
 
 	result, err = client.GetDateInvalidNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetDateInvalidNull", resp, "Failure responding to request")
 	}
 
@@ -1130,14 +1188,15 @@ func (client ArrayClient) GetDateInvalidNullPreparer(ctx context.Context) (*http
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/date/invalidnull"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDateInvalidNullSender sends the GetDateInvalidNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetDateInvalidNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDateInvalidNullResponder handles the response to the GetDateInvalidNull request. The method always
@@ -1146,7 +1205,7 @@ func (client ArrayClient) GetDateInvalidNullResponder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1155,14 +1214,16 @@ func (client ArrayClient) GetDateInvalidNullResponder(resp *http.Response) (resu
 
 // GetDateTimeInvalidChars get date array value ['2000-12-01t00:00:01z', 'date-time']
 func (client ArrayClient) GetDateTimeInvalidChars(ctx context.Context) (result ListDateTime, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetDateTimeInvalidChars")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetDateTimeInvalidChars", "GET", "/array/prim/date-time/invalidchars")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetDateTimeInvalidChars", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDateTimeInvalidCharsPreparer(ctx)
@@ -1179,7 +1240,7 @@ func (client ArrayClient) GetDateTimeInvalidChars(ctx context.Context) (result L
 	}
 
 	result, err = client.GetDateTimeInvalidCharsResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetDateTimeInvalidChars", resp, "Failure responding to request")
 	}
 
@@ -1192,14 +1253,15 @@ func (client ArrayClient) GetDateTimeInvalidCharsPreparer(ctx context.Context) (
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/date-time/invalidchars"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDateTimeInvalidCharsSender sends the GetDateTimeInvalidChars request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetDateTimeInvalidCharsSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDateTimeInvalidCharsResponder handles the response to the GetDateTimeInvalidChars request. The method always
@@ -1208,7 +1270,7 @@ func (client ArrayClient) GetDateTimeInvalidCharsResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1217,14 +1279,16 @@ func (client ArrayClient) GetDateTimeInvalidCharsResponder(resp *http.Response)
 
 // GetDateTimeInvalidNull get date array value ['2000-12-01t00:00:01z', null]
 func (client ArrayClient) GetDateTimeInvalidNull(ctx context.Context) (result ListDateTime, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetDateTimeInvalidNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetDateTimeInvalidNull", "GET", "/array/prim/date-time/invalidnull")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetDateTimeInvalidNull", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDateTimeInvalidNullPreparer(ctx)
@@ -1241,7 +1305,7 @@ func (client ArrayClient) GetDateTimeInvalidNull(ctx context.Context) (result Li
 	}
 
 	result, err = client.GetDateTimeInvalidNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetDateTimeInvalidNull", resp, "Failure responding to request")
 	}
 
@@ -1254,14 +1318,15 @@ func (client ArrayClient) GetDateTimeInvalidNullPreparer(ctx context.Context) (*
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/date-time/invalidnull"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDateTimeInvalidNullSender sends the GetDateTimeInvalidNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetDateTimeInvalidNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDateTimeInvalidNullResponder handles the response to the GetDateTimeInvalidNull request. The method always
@@ -1270,7 +1335,7 @@ func (client ArrayClient) GetDateTimeInvalidNullResponder(resp *http.Response) (
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1280,14 +1345,16 @@ func (client ArrayClient) GetDateTimeInvalidNullResponder(resp *http.Response) (
 // GetDateTimeRfc1123Valid get date-time array value ['Fri, 01 Dec 2000 00:00:01 GMT', 'Wed, 02 Jan 1980 00:11:35 GMT',
 // 'Wed, 12 Oct 1492 10:15:01 GMT']
 func (client ArrayClient) GetDateTimeRfc1123Valid(ctx context.Context) (result ListDateTimeRfc1123, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetDateTimeRfc1123Valid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetDateTimeRfc1123Valid", "GET", "/array/prim/date-time-rfc1123/valid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetDateTimeRfc1123Valid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDateTimeRfc1123ValidPreparer(ctx)
@@ -1304,7 +1371,7 @@ func (client ArrayClient) GetDateTimeRfc1123Valid(ctx context.Context) (result L
 	}
 
 	result, err = client.GetDateTimeRfc1123ValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetDateTimeRfc1123Valid", resp, "Failure responding to request")
 	}
 
@@ -1317,14 +1384,15 @@ func (client ArrayClient) GetDateTimeRfc1123ValidPreparer(ctx context.Context) (
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/date-time-rfc1123/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDateTimeRfc1123ValidSender sends the GetDateTimeRfc1123Valid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetDateTimeRfc1123ValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDateTimeRfc1123ValidResponder handles the response to the GetDateTimeRfc1123Valid request. The method always
@@ -1333,7 +1401,7 @@ func (client ArrayClient) GetDateTimeRfc1123ValidResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1343,14 +1411,16 @@ func (client ArrayClient) GetDateTimeRfc1123ValidResponder(resp *http.Response)
 // GetDateTimeValid get date-time array value ['2000-12-01t00:00:01z', '1980-01-02T00:11:35+01:00',
 // '1492-10-12T10:15:01-08:00']
 func (client ArrayClient) GetDateTimeValid(ctx context.Context) (result ListDateTime, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetDateTimeValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetDateTimeValid", "GET", "/array/prim/date-time/valid")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetDateTimeValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDateTimeValidPreparer(ctx)
@@ -1367,7 +1437,7 @@ func (client ArrayClient) GetDateTimeValid(ctx context.Context) (result ListDate
 	}
 
 	result, err = client.GetDateTimeValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetDateTimeValid", resp, "Failure responding to request")
 	}
 
@@ -1380,14 +1450,15 @@ func (client ArrayClient) GetDateTimeValidPreparer(ctx context.Context) (*http.R
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/date-time/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDateTimeValidSender sends the GetDateTimeValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetDateTimeValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDateTimeValidResponder handles the response to the GetDateTimeValid request. The method always
@@ -1396,7 +1467,7 @@ func (client ArrayClient) GetDateTimeValidResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1405,14 +1476,16 @@ func (client ArrayClient) GetDateTimeValidResponder(resp *http.Response) (result
 
 // GetDateValid get integer array value ['2000-12-01', '1980-01-02', '1492-10-12']
 func (client ArrayClient) GetDateValid(ctx context.Context) (result ListDate, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetDateValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetDateValid", "GET", "/array/prim/date/valid")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetDateValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDateValidPreparer(ctx)
@@ -1429,7 +1502,7 @@ func (client ArrayClient) GetDateValid(ctx context.Context) (result ListDate, er
 	}
 
 	result, err = client.GetDateValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetDateValid", resp, "Failure responding to request")
 	}
 
@@ -1442,14 +1515,15 @@ func (client ArrayClient) GetDateValidPreparer(ctx context.Context) (*http.Reque
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/date/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDateValidSender sends the GetDateValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetDateValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDateValidResponder handles the response to the GetDateValid request. The method always
@@ -1458,7 +1532,7 @@ func (client ArrayClient) GetDateValidResponder(resp *http.Response) (result Lis
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1467,14 +1541,16 @@ func (client ArrayClient) GetDateValidResponder(resp *http.Response) (result Lis
 
 // GetDictionaryEmpty get an array of Dictionaries of type <string, string> with value []
 func (client ArrayClient) GetDictionaryEmpty(ctx context.Context) (result ListSetString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetDictionaryEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetDictionaryEmpty", "GET", "/array/dictionary/empty")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetDictionaryEmpty", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDictionaryEmptyPreparer(ctx)
@@ -1491,7 +1567,7 @@ func (client ArrayClient) GetDictionaryEmpty(ctx context.Context) (result ListSe
 	}
 
 	result, err = client.GetDictionaryEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetDictionaryEmpty", resp, "Failure responding to request")
 	}
 
@@ -1504,14 +1580,15 @@ func (client ArrayClient) GetDictionaryEmptyPreparer(ctx context.Context) (*http
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/dictionary/empty"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDictionaryEmptySender sends the GetDictionaryEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetDictionaryEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDictionaryEmptyResponder handles the response to the GetDictionaryEmpty request. The method always
@@ -1520,7 +1597,7 @@ func (client ArrayClient) GetDictionaryEmptyResponder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1530,14 +1607,16 @@ func (client ArrayClient) GetDictionaryEmptyResponder(resp *http.Response) (resu
 // GetDictionaryItemEmpty get an array of Dictionaries of type <string, string> with value [{'1': 'one', '2': 'two',
 // '3': 'three'}, {}, {'7': 'seven', '8': 'eight', '9': 'nine'}]
 func (client ArrayClient) GetDictionaryItemEmpty(ctx context.Context) (result ListSetString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetDictionaryItemEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetDictionaryItemEmpty", "GET", "/array/dictionary/itemempty")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetDictionaryItemEmpty", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDictionaryItemEmptyPreparer(ctx)
@@ -1554,7 +1633,7 @@ func (client ArrayClient) GetDictionaryItemEmpty(ctx context.Context) (result Li
 	}
 
 	result, err = client.GetDictionaryItemEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetDictionaryItemEmpty", resp, "Failure responding to request")
 	}
 
@@ -1567,14 +1646,15 @@ func (client ArrayClient) GetDictionaryItemEmptyPreparer(ctx context.Context) (*
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/dictionary/itemempty"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDictionaryItemEmptySender sends the GetDictionaryItemEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetDictionaryItemEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDictionaryItemEmptyResponder handles the response to the GetDictionaryItemEmpty request. The method always
@@ -1583,7 +1663,7 @@ func (client ArrayClient) GetDictionaryItemEmptyResponder(resp *http.Response) (
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1593,14 +1673,16 @@ func (client ArrayClient) GetDictionaryItemEmptyResponder(resp *http.Response) (
 // GetDictionaryItemNull get an array of Dictionaries of type <string, string> with value [{'1': 'one', '2': 'two',
 // '3': 'three'}, null, {'7': 'seven', '8': 'eight', '9': 'nine'}]
 func (client ArrayClient) GetDictionaryItemNull(ctx context.Context) (result ListSetString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetDictionaryItemNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetDictionaryItemNull", "GET", "/array/dictionary/itemnull")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetDictionaryItemNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDictionaryItemNullPreparer(ctx)
@@ -1617,7 +1699,7 @@ func (client ArrayClient) GetDictionaryItemNull(ctx context.Context) (result Lis
 	}
 
 	result, err = client.GetDictionaryItemNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetDictionaryItemNull", resp, "Failure responding to request")
 	}
 
@@ -1630,14 +1712,15 @@ func (client ArrayClient) GetDictionaryItemNullPreparer(ctx context.Context) (*h
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/dictionary/itemnull"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDictionaryItemNullSender sends the GetDictionaryItemNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetDictionaryItemNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDictionaryItemNullResponder handles the response to the GetDictionaryItemNull request. The method always
@@ -1646,7 +1729,7 @@ func (client ArrayClient) GetDictionaryItemNullResponder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1655,14 +1738,16 @@ func (client ArrayClient) GetDictionaryItemNullResponder(resp *http.Response) (r
 
 // GetDictionaryNull get an array of Dictionaries with value null
 func (client ArrayClient) GetDictionaryNull(ctx context.Context) (result ListSetString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetDictionaryNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetDictionaryNull", "GET", "/array/dictionary/null")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetDictionaryNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDictionaryNullPreparer(ctx)
@@ -1679,7 +1764,7 @@ func (client ArrayClient) GetDictionaryNull(ctx context.Context) (result ListSet
 	}
 
 	result, err = client.GetDictionaryNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetDictionaryNull", resp, "Failure responding to request")
 	}
 
@@ -1692,14 +1777,15 @@ func (client ArrayClient) GetDictionaryNullPreparer(ctx context.Context) (*http.
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/dictionary/null"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDictionaryNullSender sends the GetDictionaryNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetDictionaryNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDictionaryNullResponder handles the response to the GetDictionaryNull request. The method always
@@ -1708,7 +1794,7 @@ func (client ArrayClient) GetDictionaryNullResponder(resp *http.Response) (resul
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1718,14 +1804,16 @@ func (client ArrayClient) GetDictionaryNullResponder(resp *http.Response) (resul
 // GetDictionaryValid get an array of Dictionaries of type <string, string> with value [{'1': 'one', '2': 'two', '3':
 // 'three'}, {'4': 'four', '5': 'five', '6': 'six'}, {'7': 'seven', '8': 'eight', '9': 'nine'}]
 func (client ArrayClient) GetDictionaryValid(ctx context.Context) (result ListSetString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetDictionaryValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetDictionaryValid", "GET", "/array/dictionary/valid")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetDictionaryValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDictionaryValidPreparer(ctx)
@@ -1742,7 +1830,7 @@ func (client ArrayClient) GetDictionaryValid(ctx context.Context) (result ListSe
 	}
 
 	result, err = client.GetDictionaryValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetDictionaryValid", resp, "Failure responding to request")
 	}
 
@@ -1755,14 +1843,15 @@ func (client ArrayClient) GetDictionaryValidPreparer(ctx context.Context) (*http
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/dictionary/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDictionaryValidSender sends the GetDictionaryValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetDictionaryValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDictionaryValidResponder handles the response to the GetDictionaryValid request. The method always
@@ -1771,7 +1860,7 @@ func (client ArrayClient) GetDictionaryValidResponder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1780,14 +1869,16 @@ func (client ArrayClient) GetDictionaryValidResponder(resp *http.Response) (resu
 
 // GetDoubleInvalidNull get float array value [0.0, null, -1.2e20]
 func (client ArrayClient) GetDoubleInvalidNull(ctx context.Context) (result ListFloat64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetDoubleInvalidNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetDoubleInvalidNull", "GET", "/array/prim/double/0.0-null-1.2e20")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetDoubleInvalidNull", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDoubleInvalidNullPreparer(ctx)
@@ -1804,7 +1895,7 @@ func (client ArrayClient) GetDoubleInvalidNull(ctx context.Context) (result List
 	}
 
 	result, err = client.GetDoubleInvalidNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetDoubleInvalidNull", resp, "Failure responding to request")
 	}
 
@@ -1817,14 +1908,15 @@ func (client ArrayClient) GetDoubleInvalidNullPreparer(ctx context.Context) (*ht
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/double/0.0-null-1.2e20"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDoubleInvalidNullSender sends the GetDoubleInvalidNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetDoubleInvalidNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDoubleInvalidNullResponder handles the response to the GetDoubleInvalidNull request. The method always
@@ -1833,7 +1925,7 @@ func (client ArrayClient) GetDoubleInvalidNullResponder(resp *http.Response) (re
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1842,14 +1934,16 @@ func (client ArrayClient) GetDoubleInvalidNullResponder(resp *http.Response) (re
 
 // GetDoubleInvalidString get boolean array value [1.0, 'number', 0.0]
 func (client ArrayClient) GetDoubleInvalidString(ctx context.Context) (result ListFloat64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetDoubleInvalidString")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetDoubleInvalidString", "GET", "/array/prim/double/1.number.0")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetDoubleInvalidString", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDoubleInvalidStringPreparer(ctx)
@@ -1866,7 +1960,7 @@ func (client ArrayClient) GetDoubleInvalidString(ctx context.Context) (result Li
 	}
 
 	result, err = client.GetDoubleInvalidStringResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetDoubleInvalidString", resp, "Failure responding to request")
 	}
 
@@ -1879,14 +1973,15 @@ func (client ArrayClient) GetDoubleInvalidStringPreparer(ctx context.Context) (*
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/double/1.number.0"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDoubleInvalidStringSender sends the GetDoubleInvalidString request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetDoubleInvalidStringSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDoubleInvalidStringResponder handles the response to the GetDoubleInvalidString request. The method always
@@ -1895,7 +1990,7 @@ func (client ArrayClient) GetDoubleInvalidStringResponder(resp *http.Response) (
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1904,14 +1999,16 @@ func (client ArrayClient) GetDoubleInvalidStringResponder(resp *http.Response) (
 
 // GetDoubleValid get float array value [0, -0.01, 1.2e20]
 func (client ArrayClient) GetDoubleValid(ctx context.Context) (result ListFloat64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetDoubleValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetDoubleValid", "GET", "/array/prim/double/0--0.01-1.2e20")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetDoubleValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDoubleValidPreparer(ctx)
@@ -1928,7 +2025,7 @@ func (client ArrayClient) GetDoubleValid(ctx context.Context) (result ListFloat6
 	}
 
 	result, err = client.GetDoubleValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetDoubleValid", resp, "Failure responding to request")
 	}
 
@@ -1941,14 +2038,15 @@ func (client ArrayClient) GetDoubleValidPreparer(ctx context.Context) (*http.Req
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/double/0--0.01-1.2e20"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDoubleValidSender sends the GetDoubleValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetDoubleValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDoubleValidResponder handles the response to the GetDoubleValid request. The method always
@@ -1957,7 +2055,7 @@ func (client ArrayClient) GetDoubleValidResponder(resp *http.Response) (result L
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1966,14 +2064,16 @@ func (client ArrayClient) GetDoubleValidResponder(resp *http.Response) (result L
 
 // GetDurationValid get duration array value ['P123DT22H14M12.011S', 'P5DT1H0M0S']
 func (client ArrayClient) GetDurationValid(ctx context.Context) (result ListTimeSpan, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetDurationValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetDurationValid", "GET", "/array/prim/duration/valid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetDurationValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDurationValidPreparer(ctx)
@@ -1990,7 +2090,7 @@ func (client ArrayClient) GetDurationValid(ctx context.Context) (result ListTime
 	}
 
 	result, err = client.GetDurationValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetDurationValid", resp, "Failure responding to request")
 	}
 
@@ -2003,14 +2103,15 @@ func (client ArrayClient) GetDurationValidPreparer(ctx context.Context) (*http.R
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/duration/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDurationValidSender sends the GetDurationValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetDurationValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDurationValidResponder handles the response to the GetDurationValid request. The method always
@@ -2019,7 +2120,7 @@ func (client ArrayClient) GetDurationValidResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2028,14 +2129,16 @@ func (client ArrayClient) GetDurationValidResponder(resp *http.Response) (result
 
 // GetEmpty get empty array value []
 func (client ArrayClient) GetEmpty(ctx context.Context) (result ListInt32, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetEmpty", "GET", "/array/empty")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetEmpty", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetEmptyPreparer(ctx)
@@ -2052,7 +2155,7 @@ func (client ArrayClient) GetEmpty(ctx context.Context) (result ListInt32, err e
 	}
 
 	result, err = client.GetEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetEmpty", resp, "Failure responding to request")
 	}
 
@@ -2065,14 +2168,15 @@ func (client ArrayClient) GetEmptyPreparer(ctx context.Context) (*http.Request,
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/empty"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetEmptySender sends the GetEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetEmptyResponder handles the response to the GetEmpty request. The method always
@@ -2081,7 +2185,7 @@ func (client ArrayClient) GetEmptyResponder(resp *http.Response) (result ListInt
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2090,14 +2194,16 @@ func (client ArrayClient) GetEmptyResponder(resp *http.Response) (result ListInt
 
 // GetEnumValid get enum array value ['foo1', 'foo2', 'foo3']
 func (client ArrayClient) GetEnumValid(ctx context.Context) (result ListString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetEnumValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetEnumValid", "GET", "/array/prim/enum/foo1.foo2.foo3")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetEnumValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetEnumValidPreparer(ctx)
@@ -2114,7 +2220,7 @@ func (client ArrayClient) GetEnumValid(ctx context.Context) (result ListString,
 	}
 
 	result, err = client.GetEnumValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetEnumValid", resp, "Failure responding to request")
 	}
 
@@ -2127,14 +2233,15 @@ func (client ArrayClient) GetEnumValidPreparer(ctx context.Context) (*http.Reque
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/enum/foo1.foo2.foo3"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetEnumValidSender sends the GetEnumValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetEnumValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetEnumValidResponder handles the response to the GetEnumValid request. The method always
@@ -2143,7 +2250,7 @@ func (client ArrayClient) GetEnumValidResponder(resp *http.Response) (result Lis
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2152,14 +2259,16 @@ func (client ArrayClient) GetEnumValidResponder(resp *http.Response) (result Lis
 
 // GetFloatInvalidNull get float array value [0.0, null, -1.2e20]
 func (client ArrayClient) GetFloatInvalidNull(ctx context.Context) (result ListFloat64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetFloatInvalidNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetFloatInvalidNull", "GET", "/array/prim/float/0.0-null-1.2e20")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetFloatInvalidNull", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetFloatInvalidNullPreparer(ctx)
@@ -2176,7 +2285,7 @@ func (client ArrayClient) GetFloatInvalidNull(ctx context.Context) (result ListF
 	}
 
 	result, err = client.GetFloatInvalidNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetFloatInvalidNull", resp, "Failure responding to request")
 	}
 
@@ -2189,14 +2298,15 @@ func (client ArrayClient) GetFloatInvalidNullPreparer(ctx context.Context) (*htt
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/float/0.0-null-1.2e20"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetFloatInvalidNullSender sends the GetFloatInvalidNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetFloatInvalidNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetFloatInvalidNullResponder handles the response to the GetFloatInvalidNull request. The method always
@@ -2205,7 +2315,7 @@ func (client ArrayClient) GetFloatInvalidNullResponder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2214,14 +2324,16 @@ func (client ArrayClient) GetFloatInvalidNullResponder(resp *http.Response) (res
 
 // GetFloatInvalidString get boolean array value [1.0, 'number', 0.0]
 func (client ArrayClient) GetFloatInvalidString(ctx context.Context) (result ListFloat64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetFloatInvalidString")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetFloatInvalidString", "GET", "/array/prim/float/1.number.0")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetFloatInvalidString", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetFloatInvalidStringPreparer(ctx)
@@ -2238,7 +2350,7 @@ func (client ArrayClient) GetFloatInvalidString(ctx context.Context) (result Lis
 	}
 
 	result, err = client.GetFloatInvalidStringResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetFloatInvalidString", resp, "Failure responding to request")
 	}
 
@@ -2251,14 +2363,15 @@ func (client ArrayClient) GetFloatInvalidStringPreparer(ctx context.Context) (*h
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/float/1.number.0"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetFloatInvalidStringSender sends the GetFloatInvalidString request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetFloatInvalidStringSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetFloatInvalidStringResponder handles the response to the GetFloatInvalidString request. The method always
@@ -2267,7 +2380,7 @@ func (client ArrayClient) GetFloatInvalidStringResponder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2276,14 +2389,16 @@ func (client ArrayClient) GetFloatInvalidStringResponder(resp *http.Response) (r
 
 // GetFloatValid get float array value [0, -0.01, 1.2e20]
 func (client ArrayClient) GetFloatValid(ctx context.Context) (result ListFloat64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetFloatValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetFloatValid", "GET", "/array/prim/float/0--0.01-1.2e20")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetFloatValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetFloatValidPreparer(ctx)
@@ -2300,7 +2415,7 @@ func (client ArrayClient) GetFloatValid(ctx context.Context) (result ListFloat64
 	}
 
 	result, err = client.GetFloatValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetFloatValid", resp, "Failure responding to request")
 	}
 
@@ -2313,14 +2428,15 @@ func (client ArrayClient) GetFloatValidPreparer(ctx context.Context) (*http.Requ
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/float/0--0.01-1.2e20"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetFloatValidSender sends the GetFloatValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetFloatValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetFloatValidResponder handles the response to the GetFloatValid request. The method always
@@ -2329,7 +2445,7 @@ func (client ArrayClient) GetFloatValidResponder(resp *http.Response) (result Li
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2338,14 +2454,16 @@ func (client ArrayClient) GetFloatValidResponder(resp *http.Response) (result Li
 
 // GetIntegerValid get integer array value [1, -1, 3, 300]
 func (client ArrayClient) GetIntegerValid(ctx context.Context) (result ListInt32, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetIntegerValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetIntegerValid", "GET", "/array/prim/integer/1.-1.3.300")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetIntegerValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetIntegerValidPreparer(ctx)
@@ -2362,7 +2480,7 @@ func (client ArrayClient) GetIntegerValid(ctx context.Context) (result ListInt32
 	}
 
 	result, err = client.GetIntegerValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetIntegerValid", resp, "Failure responding to request")
 	}
 
@@ -2375,14 +2493,15 @@ func (client ArrayClient) GetIntegerValidPreparer(ctx context.Context) (*http.Re
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/integer/1.-1.3.300"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetIntegerValidSender sends the GetIntegerValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetIntegerValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetIntegerValidResponder handles the response to the GetIntegerValid request. The method always
@@ -2391,7 +2510,7 @@ func (client ArrayClient) GetIntegerValidResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2400,14 +2519,16 @@ func (client ArrayClient) GetIntegerValidResponder(resp *http.Response) (result
 
 // GetIntInvalidNull get integer array value [1, null, 0]
 func (client ArrayClient) GetIntInvalidNull(ctx context.Context) (result ListInt32, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetIntInvalidNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetIntInvalidNull", "GET", "/array/prim/integer/1.null.zero")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetIntInvalidNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetIntInvalidNullPreparer(ctx)
@@ -2424,7 +2545,7 @@ func (client ArrayClient) GetIntInvalidNull(ctx context.Context) (result ListInt
 	}
 
 	result, err = client.GetIntInvalidNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetIntInvalidNull", resp, "Failure responding to request")
 	}
 
@@ -2437,14 +2558,15 @@ func (client ArrayClient) GetIntInvalidNullPreparer(ctx context.Context) (*http.
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/integer/1.null.zero"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetIntInvalidNullSender sends the GetIntInvalidNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetIntInvalidNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetIntInvalidNullResponder handles the response to the GetIntInvalidNull request. The method always
@@ -2453,7 +2575,7 @@ func (client ArrayClient) GetIntInvalidNullResponder(resp *http.Response) (resul
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2462,14 +2584,16 @@ func (client ArrayClient) GetIntInvalidNullResponder(resp *http.Response) (resul
 
 // GetIntInvalidString get integer array value [1, 'integer', 0]
 func (client ArrayClient) GetIntInvalidString(ctx context.Context) (result ListInt32, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetIntInvalidString")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetIntInvalidString", "GET", "/array/prim/integer/1.integer.0")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetIntInvalidString", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetIntInvalidStringPreparer(ctx)
@@ -2486,7 +2610,7 @@ func (client ArrayClient) GetIntInvalidString(ctx context.Context) (result ListI
 	}
 
 	result, err = client.GetIntInvalidStringResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetIntInvalidString", resp, "Failure responding to request")
 	}
 
@@ -2499,14 +2623,15 @@ func (client ArrayClient) GetIntInvalidStringPreparer(ctx context.Context) (*htt
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/integer/1.integer.0"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetIntInvalidStringSender sends the GetIntInvalidString request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetIntInvalidStringSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetIntInvalidStringResponder handles the response to the GetIntInvalidString request. The method always
@@ -2515,7 +2640,7 @@ func (client ArrayClient) GetIntInvalidStringResponder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2524,14 +2649,16 @@ func (client ArrayClient) GetIntInvalidStringResponder(resp *http.Response) (res
 
 // GetInvalid get invalid array [1, 2, 3
 func (client ArrayClient) GetInvalid(ctx context.Context) (result ListInt32, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetInvalid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetInvalid", "GET", "/array/invalid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetInvalid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetInvalidPreparer(ctx)
@@ -2548,7 +2675,7 @@ func (client ArrayClient) GetInvalid(ctx context.Context) (result ListInt32, err
 	}
 
 	result, err = client.GetInvalidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetInvalid", resp, "Failure responding to request")
 	}
 
@@ -2561,14 +2688,15 @@ func (client ArrayClient) GetInvalidPreparer(ctx context.Context) (*http.Request
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/invalid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetInvalidSender sends the GetInvalid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetInvalidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetInvalidResponder handles the response to the GetInvalid request. The method always
@@ -2577,7 +2705,7 @@ func (client ArrayClient) GetInvalidResponder(resp *http.Response) (result ListI
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2586,14 +2714,16 @@ func (client ArrayClient) GetInvalidResponder(resp *http.Response) (result ListI
 
 // GetLongInvalidNull get long array value [1, null, 0]
 func (client ArrayClient) GetLongInvalidNull(ctx context.Context) (result ListInt64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetLongInvalidNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetLongInvalidNull", "GET", "/array/prim/long/1.null.zero")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetLongInvalidNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetLongInvalidNullPreparer(ctx)
@@ -2610,7 +2740,7 @@ func (client ArrayClient) GetLongInvalidNull(ctx context.Context) (result ListIn
 	}
 
 	result, err = client.GetLongInvalidNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetLongInvalidNull", resp, "Failure responding to request")
 	}
 
@@ -2623,14 +2753,15 @@ func (client ArrayClient) GetLongInvalidNullPreparer(ctx context.Context) (*http
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/long/1.null.zero"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetLongInvalidNullSender sends the GetLongInvalidNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetLongInvalidNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetLongInvalidNullResponder handles the response to the GetLongInvalidNull request. The method always
@@ -2639,7 +2770,7 @@ func (client ArrayClient) GetLongInvalidNullResponder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2648,14 +2779,16 @@ func (client ArrayClient) GetLongInvalidNullResponder(resp *http.Response) (resu
 
 // GetLongInvalidString get long array value [1, 'integer', 0]
 func (client ArrayClient) GetLongInvalidString(ctx context.Context) (result ListInt64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetLongInvalidString")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetLongInvalidString", "GET", "/array/prim/long/1.integer.0")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetLongInvalidString", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetLongInvalidStringPreparer(ctx)
@@ -2672,7 +2805,7 @@ func (client ArrayClient) GetLongInvalidString(ctx context.Context) (result List
 	}
 
 	result, err = client.GetLongInvalidStringResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetLongInvalidString", resp, "Failure responding to request")
 	}
 
@@ -2685,14 +2818,15 @@ func (client ArrayClient) GetLongInvalidStringPreparer(ctx context.Context) (*ht
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/long/1.integer.0"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetLongInvalidStringSender sends the GetLongInvalidString request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetLongInvalidStringSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetLongInvalidStringResponder handles the response to the GetLongInvalidString request. The method always
@@ -2701,7 +2835,7 @@ func (client ArrayClient) GetLongInvalidStringResponder(resp *http.Response) (re
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2710,14 +2844,16 @@ func (client ArrayClient) GetLongInvalidStringResponder(resp *http.Response) (re
 
 // GetLongValid get integer array value [1, -1, 3, 300]
 func (client ArrayClient) GetLongValid(ctx context.Context) (result ListInt64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetLongValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetLongValid", "GET", "/array/prim/long/1.-1.3.300")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetLongValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetLongValidPreparer(ctx)
@@ -2734,7 +2870,7 @@ func (client ArrayClient) GetLongValid(ctx context.Context) (result ListInt64, e
 	}
 
 	result, err = client.GetLongValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetLongValid", resp, "Failure responding to request")
 	}
 
@@ -2747,14 +2883,15 @@ func (client ArrayClient) GetLongValidPreparer(ctx context.Context) (*http.Reque
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/long/1.-1.3.300"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetLongValidSender sends the GetLongValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetLongValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetLongValidResponder handles the response to the GetLongValid request. The method always
@@ -2763,7 +2900,7 @@ func (client ArrayClient) GetLongValidResponder(resp *http.Response) (result Lis
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2772,14 +2909,16 @@ func (client ArrayClient) GetLongValidResponder(resp *http.Response) (result Lis
 
 // GetNull get null array value
 func (client ArrayClient) GetNull(ctx context.Context) (result ListInt32, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetNull", "GET", "/array/null")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetNull", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetNullPreparer(ctx)
@@ -2796,7 +2935,7 @@ func (client ArrayClient) GetNull(ctx context.Context) (result ListInt32, err er
 	}
 
 	result, err = client.GetNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetNull", resp, "Failure responding to request")
 	}
 
@@ -2809,14 +2948,15 @@ func (client ArrayClient) GetNullPreparer(ctx context.Context) (*http.Request, e
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/null"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetNullSender sends the GetNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetNullResponder handles the response to the GetNull request. The method always
@@ -2825,7 +2965,7 @@ func (client ArrayClient) GetNullResponder(resp *http.Response) (result ListInt3
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2834,14 +2974,16 @@ func (client ArrayClient) GetNullResponder(resp *http.Response) (result ListInt3
 
 // GetStringEnumValid get enum array value ['foo1', 'foo2', 'foo3']
 func (client ArrayClient) GetStringEnumValid(ctx context.Context) (result ListString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetStringEnumValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetStringEnumValid", "GET", "/array/prim/string-enum/foo1.foo2.foo3")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetStringEnumValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetStringEnumValidPreparer(ctx)
@@ -2858,7 +3000,7 @@ func (client ArrayClient) GetStringEnumValid(ctx context.Context) (result ListSt
 	}
 
 	result, err = client.GetStringEnumValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetStringEnumValid", resp, "Failure responding to request")
 	}
 
@@ -2871,14 +3013,15 @@ func (client ArrayClient) GetStringEnumValidPreparer(ctx context.Context) (*http
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/string-enum/foo1.foo2.foo3"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetStringEnumValidSender sends the GetStringEnumValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetStringEnumValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetStringEnumValidResponder handles the response to the GetStringEnumValid request. The method always
@@ -2887,7 +3030,7 @@ func (client ArrayClient) GetStringEnumValidResponder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2896,14 +3039,16 @@ func (client ArrayClient) GetStringEnumValidResponder(resp *http.Response) (resu
 
 // GetStringValid get string array value ['foo1', 'foo2', 'foo3']
 func (client ArrayClient) GetStringValid(ctx context.Context) (result ListString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetStringValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetStringValid", "GET", "/array/prim/string/foo1.foo2.foo3")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetStringValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetStringValidPreparer(ctx)
@@ -2920,7 +3065,7 @@ func (client ArrayClient) GetStringValid(ctx context.Context) (result ListString
 	}
 
 	result, err = client.GetStringValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetStringValid", resp, "Failure responding to request")
 	}
 
@@ -2933,14 +3078,15 @@ func (client ArrayClient) GetStringValidPreparer(ctx context.Context) (*http.Req
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/string/foo1.foo2.foo3"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetStringValidSender sends the GetStringValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetStringValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetStringValidResponder handles the response to the GetStringValid request. The method always
@@ -2949,7 +3095,7 @@ func (client ArrayClient) GetStringValidResponder(resp *http.Response) (result L
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2958,14 +3104,16 @@ func (client ArrayClient) GetStringValidResponder(resp *http.Response) (result L
 
 // GetStringWithInvalid get string array value ['foo', 123, 'foo2']
 func (client ArrayClient) GetStringWithInvalid(ctx context.Context) (result ListString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetStringWithInvalid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetStringWithInvalid", "GET", "/array/prim/string/foo.123.foo2")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetStringWithInvalid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetStringWithInvalidPreparer(ctx)
@@ -2982,7 +3130,7 @@ func (client ArrayClient) GetStringWithInvalid(ctx context.Context) (result List
 	}
 
 	result, err = client.GetStringWithInvalidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetStringWithInvalid", resp, "Failure responding to request")
 	}
 
@@ -2995,14 +3143,15 @@ func (client ArrayClient) GetStringWithInvalidPreparer(ctx context.Context) (*ht
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/string/foo.123.foo2"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetStringWithInvalidSender sends the GetStringWithInvalid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetStringWithInvalidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetStringWithInvalidResponder handles the response to the GetStringWithInvalid request. The method always
@@ -3011,7 +3160,7 @@ func (client ArrayClient) GetStringWithInvalidResponder(resp *http.Response) (re
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -3020,14 +3169,16 @@ func (client ArrayClient) GetStringWithInvalidResponder(resp *http.Response) (re
 
 // GetStringWithNull get string array value ['foo', null, 'foo2']
 func (client ArrayClient) GetStringWithNull(ctx context.Context) (result ListString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetStringWithNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetStringWithNull", "GET", "/array/prim/string/foo.null.foo2")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetStringWithNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetStringWithNullPreparer(ctx)
@@ -3044,7 +3195,7 @@ func (client ArrayClient) GetStringWithNull(ctx context.Context) (result ListStr
 	}
 
 	result, err = client.GetStringWithNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetStringWithNull", resp, "Failure responding to request")
 	}
 
@@ -3057,14 +3208,15 @@ func (client ArrayClient) GetStringWithNullPreparer(ctx context.Context) (*http.
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/string/foo.null.foo2"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetStringWithNullSender sends the GetStringWithNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetStringWithNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetStringWithNullResponder handles the response to the GetStringWithNull request. The method always
@@ -3073,7 +3225,7 @@ func (client ArrayClient) GetStringWithNullResponder(resp *http.Response) (resul
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -3082,14 +3234,16 @@ func (client ArrayClient) GetStringWithNullResponder(resp *http.Response) (resul
 
 // GetUUIDInvalidChars get uuid array value ['6dcc7237-45fe-45c4-8a6b-3a8a3f625652', 'foo']
 func (client ArrayClient) GetUUIDInvalidChars(ctx context.Context) (result ListUUID, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetUUIDInvalidChars")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetUUIDInvalidChars", "GET", "/array/prim/uuid/invalidchars")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetUUIDInvalidChars", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetUUIDInvalidCharsPreparer(ctx)
@@ -3106,7 +3260,7 @@ func (client ArrayClient) GetUUIDInvalidChars(ctx context.Context) (result ListU
 	}
 
 	result, err = client.GetUUIDInvalidCharsResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetUUIDInvalidChars", resp, "Failure responding to request")
 	}
 
@@ -3119,14 +3273,15 @@ func (client ArrayClient) GetUUIDInvalidCharsPreparer(ctx context.Context) (*htt
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/uuid/invalidchars"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetUUIDInvalidCharsSender sends the GetUUIDInvalidChars request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetUUIDInvalidCharsSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetUUIDInvalidCharsResponder handles the response to the GetUUIDInvalidChars request. The method always
@@ -3135,7 +3290,7 @@ func (client ArrayClient) GetUUIDInvalidCharsResponder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -3145,14 +3300,16 @@ func (client ArrayClient) GetUUIDInvalidCharsResponder(resp *http.Response) (res
 // GetUUIDValid get uuid array value ['6dcc7237-45fe-45c4-8a6b-3a8a3f625652', 'd1399005-30f7-40d6-8da6-dd7c89ad34db',
 // 'f42f6aa1-a5bc-4ddf-907e-5f915de43205']
 func (client ArrayClient) GetUUIDValid(ctx context.Context) (result ListUUID, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetUUIDValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetUUIDValid", "GET", "/array/prim/uuid/valid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetUUIDValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetUUIDValidPreparer(ctx)
@@ -3169,7 +3326,7 @@ func (client ArrayClient) GetUUIDValid(ctx context.Context) (result ListUUID, er
 	}
 
 	result, err = client.GetUUIDValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "GetUUIDValid", resp, "Failure responding to request")
 	}
 
@@ -3182,14 +3339,15 @@ func (client ArrayClient) GetUUIDValidPreparer(ctx context.Context) (*http.Reque
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/uuid/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetUUIDValidSender sends the GetUUIDValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetUUIDValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetUUIDValidResponder handles the response to the GetUUIDValid request. The method always
@@ -3198,7 +3356,7 @@ func (client ArrayClient) GetUUIDValidResponder(resp *http.Response) (result Lis
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -3207,20 +3365,24 @@ func (client ArrayClient) GetUUIDValidResponder(resp *http.Response) (result Lis
 
 // PutArrayValid put An array of array of strings [['1', '2', '3'], ['4', '5', '6'], ['7', '8', '9']]
 func (client ArrayClient) PutArrayValid(ctx context.Context, arrayBody [][]string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.PutArrayValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.PutArrayValid", "PUT", "/array/array/valid")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.PutArrayValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("arraygroup.ArrayClient", "PutArrayValid", err.Error())
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("arraygroup.ArrayClient", "PutArrayValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutArrayValidPreparer(ctx, arrayBody)
@@ -3237,7 +3399,7 @@ func (client ArrayClient) PutArrayValid(ctx context.Context, arrayBody [][]strin
 	}
 
 	result, err = client.PutArrayValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "PutArrayValid", resp, "Failure responding to request")
 	}
 
@@ -3252,14 +3414,15 @@ func (client ArrayClient) PutArrayValidPreparer(ctx context.Context, arrayBody [
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/array/valid"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutArrayValidSender sends the PutArrayValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) PutArrayValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutArrayValidResponder handles the response to the PutArrayValid request. The method always
@@ -3268,7 +3431,7 @@ func (client ArrayClient) PutArrayValidResponder(resp *http.Response) (result au
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -3276,20 +3439,24 @@ func (client ArrayClient) PutArrayValidResponder(resp *http.Response) (result au
 
 // PutBooleanTfft set array value empty [true, false, false, true]
 func (client ArrayClient) PutBooleanTfft(ctx context.Context, arrayBody []bool) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.PutBooleanTfft")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.PutBooleanTfft", "PUT", "/array/prim/boolean/tfft")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.PutBooleanTfft", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("arraygroup.ArrayClient", "PutBooleanTfft", err.Error())
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("arraygroup.ArrayClient", "PutBooleanTfft", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutBooleanTfftPreparer(ctx, arrayBody)
@@ -3306,7 +3473,7 @@ func (client ArrayClient) PutBooleanTfft(ctx context.Context, arrayBody []bool)
 	}
 
 	result, err = client.PutBooleanTfftResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "PutBooleanTfft", resp, "Failure responding to request")
 	}
 
@@ -3321,14 +3488,15 @@ func (client ArrayClient) PutBooleanTfftPreparer(ctx context.Context, arrayBody
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/boolean/tfft"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutBooleanTfftSender sends the PutBooleanTfft request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) PutBooleanTfftSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutBooleanTfftResponder handles the response to the PutBooleanTfft request. The method always
@@ -3337,7 +3505,7 @@ func (client ArrayClient) PutBooleanTfftResponder(resp *http.Response) (result a
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -3346,20 +3514,24 @@ func (client ArrayClient) PutBooleanTfftResponder(resp *http.Response) (result a
 // PutByteValid put the array value [hex(FF FF FF FA), hex(01 02 03), hex (25, 29, 43)] with each elementencoded in
 // base 64
 func (client ArrayClient) PutByteValid(ctx context.Context, arrayBody [][]byte) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.PutByteValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.PutByteValid", "PUT", "/array/prim/byte/valid")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("arraygroup.ArrayClient", "PutByteValid", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.PutByteValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("arraygroup.ArrayClient", "PutByteValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutByteValidPreparer(ctx, arrayBody)
@@ -3376,7 +3548,7 @@ func (client ArrayClient) PutByteValid(ctx context.Context, arrayBody [][]byte)
 	}
 
 	result, err = client.PutByteValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "PutByteValid", resp, "Failure responding to request")
 	}
 
@@ -3391,14 +3563,15 @@ func (client ArrayClient) PutByteValidPreparer(ctx context.Context, arrayBody []
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/byte/valid"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutByteValidSender sends the PutByteValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) PutByteValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutByteValidResponder handles the response to the PutByteValid request. The method always
@@ -3407,7 +3580,7 @@ func (client ArrayClient) PutByteValidResponder(resp *http.Response) (result aut
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -3416,20 +3589,24 @@ func (client ArrayClient) PutByteValidResponder(resp *http.Response) (result aut
 // PutComplexValid put an array of complex type with values [{'integer': 1 'string': '2'}, {'integer': 3, 'string':
 // '4'}, {'integer': 5, 'string': '6'}]
 func (client ArrayClient) PutComplexValid(ctx context.Context, arrayBody []Product) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.PutComplexValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.PutComplexValid", "PUT", "/array/complex/valid")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.PutComplexValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("arraygroup.ArrayClient", "PutComplexValid", err.Error())
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("arraygroup.ArrayClient", "PutComplexValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutComplexValidPreparer(ctx, arrayBody)
@@ -3446,7 +3623,7 @@ func (client ArrayClient) PutComplexValid(ctx context.Context, arrayBody []Produ
 	}
 
 	result, err = client.PutComplexValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "PutComplexValid", resp, "Failure responding to request")
 	}
 
@@ -3461,14 +3638,15 @@ func (client ArrayClient) PutComplexValidPreparer(ctx context.Context, arrayBody
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/complex/valid"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutComplexValidSender sends the PutComplexValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) PutComplexValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutComplexValidResponder handles the response to the PutComplexValid request. The method always
@@ -3477,7 +3655,7 @@ func (client ArrayClient) PutComplexValidResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -3486,20 +3664,24 @@ func (client ArrayClient) PutComplexValidResponder(resp *http.Response) (result
 // PutDateTimeRfc1123Valid set array value  ['Fri, 01 Dec 2000 00:00:01 GMT', 'Wed, 02 Jan 1980 00:11:35 GMT', 'Wed, 12
 // Oct 1492 10:15:01 GMT']
 func (client ArrayClient) PutDateTimeRfc1123Valid(ctx context.Context, arrayBody []date.TimeRFC1123) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.PutDateTimeRfc1123Valid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.PutDateTimeRfc1123Valid", "PUT", "/array/prim/date-time-rfc1123/valid")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.PutDateTimeRfc1123Valid", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("arraygroup.ArrayClient", "PutDateTimeRfc1123Valid", err.Error())
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("arraygroup.ArrayClient", "PutDateTimeRfc1123Valid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutDateTimeRfc1123ValidPreparer(ctx, arrayBody)
@@ -3516,7 +3698,7 @@ func (client ArrayClient) PutDateTimeRfc1123Valid(ctx context.Context, arrayBody
 	}
 
 	result, err = client.PutDateTimeRfc1123ValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "PutDateTimeRfc1123Valid", resp, "Failure responding to request")
 	}
 
@@ -3531,14 +3713,15 @@ func (client ArrayClient) PutDateTimeRfc1123ValidPreparer(ctx context.Context, a
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/date-time-rfc1123/valid"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutDateTimeRfc1123ValidSender sends the PutDateTimeRfc1123Valid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) PutDateTimeRfc1123ValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutDateTimeRfc1123ValidResponder handles the response to the PutDateTimeRfc1123Valid request. The method always
@@ -3547,7 +3730,7 @@ func (client ArrayClient) PutDateTimeRfc1123ValidResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -3555,20 +3738,24 @@ func (client ArrayClient) PutDateTimeRfc1123ValidResponder(resp *http.Response)
 
 // PutDateTimeValid set array value  ['2000-12-01t00:00:01z', '1980-01-02T00:11:35+01:00', '1492-10-12T10:15:01-08:00']
 func (client ArrayClient) PutDateTimeValid(ctx context.Context, arrayBody []date.Time) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.PutDateTimeValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.PutDateTimeValid", "PUT", "/array/prim/date-time/valid")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("arraygroup.ArrayClient", "PutDateTimeValid", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.PutDateTimeValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("arraygroup.ArrayClient", "PutDateTimeValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutDateTimeValidPreparer(ctx, arrayBody)
@@ -3585,7 +3772,7 @@ func (client ArrayClient) PutDateTimeValid(ctx context.Context, arrayBody []date
 	}
 
 	result, err = client.PutDateTimeValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "PutDateTimeValid", resp, "Failure responding to request")
 	}
 
@@ -3600,14 +3787,15 @@ func (client ArrayClient) PutDateTimeValidPreparer(ctx context.Context, arrayBod
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/date-time/valid"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutDateTimeValidSender sends the PutDateTimeValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) PutDateTimeValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutDateTimeValidResponder handles the response to the PutDateTimeValid request. The method always
@@ -3616,7 +3804,7 @@ func (client ArrayClient) PutDateTimeValidResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -3624,20 +3812,24 @@ func (client ArrayClient) PutDateTimeValidResponder(resp *http.Response) (result
 
 // PutDateValid set array value  ['2000-12-01', '1980-01-02', '1492-10-12']
 func (client ArrayClient) PutDateValid(ctx context.Context, arrayBody []date.Date) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.PutDateValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.PutDateValid", "PUT", "/array/prim/date/valid")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("arraygroup.ArrayClient", "PutDateValid", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.PutDateValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("arraygroup.ArrayClient", "PutDateValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutDateValidPreparer(ctx, arrayBody)
@@ -3654,7 +3846,7 @@ func (client ArrayClient) PutDateValid(ctx context.Context, arrayBody []date.Dat
 	}
 
 	result, err = client.PutDateValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "PutDateValid", resp, "Failure responding to request")
 	}
 
@@ -3669,14 +3861,15 @@ func (client ArrayClient) PutDateValidPreparer(ctx context.Context, arrayBody []
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/date/valid"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutDateValidSender sends the PutDateValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) PutDateValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutDateValidResponder handles the response to the PutDateValid request. The method always
@@ -3685,7 +3878,7 @@ func (client ArrayClient) PutDateValidResponder(resp *http.Response) (result aut
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -3694,20 +3887,24 @@ func (client ArrayClient) PutDateValidResponder(resp *http.Response) (result aut
 // PutDictionaryValid get an array of Dictionaries of type <string, string> with value [{'1': 'one', '2': 'two', '3':
 // 'three'}, {'4': 'four', '5': 'five', '6': 'six'}, {'7': 'seven', '8': 'eight', '9': 'nine'}]
 func (client ArrayClient) PutDictionaryValid(ctx context.Context, arrayBody []map[string]*string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.PutDictionaryValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.PutDictionaryValid", "PUT", "/array/dictionary/valid")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("arraygroup.ArrayClient", "PutDictionaryValid", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.PutDictionaryValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("arraygroup.ArrayClient", "PutDictionaryValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutDictionaryValidPreparer(ctx, arrayBody)
@@ -3724,7 +3921,7 @@ func (client ArrayClient) PutDictionaryValid(ctx context.Context, arrayBody []ma
 	}
 
 	result, err = client.PutDictionaryValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "PutDictionaryValid", resp, "Failure responding to request")
 	}
 
@@ -3739,14 +3936,15 @@ func (client ArrayClient) PutDictionaryValidPreparer(ctx context.Context, arrayB
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/dictionary/valid"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutDictionaryValidSender sends the PutDictionaryValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) PutDictionaryValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutDictionaryValidResponder handles the response to the PutDictionaryValid request. The method always
@@ -3755,7 +3953,7 @@ func (client ArrayClient) PutDictionaryValidResponder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -3763,20 +3961,24 @@ func (client ArrayClient) PutDictionaryValidResponder(resp *http.Response) (resu
 
 // PutDoubleValid set array value [0, -0.01, 1.2e20]
 func (client ArrayClient) PutDoubleValid(ctx context.Context, arrayBody []float64) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.PutDoubleValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.PutDoubleValid", "PUT", "/array/prim/double/0--0.01-1.2e20")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("arraygroup.ArrayClient", "PutDoubleValid", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.PutDoubleValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("arraygroup.ArrayClient", "PutDoubleValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutDoubleValidPreparer(ctx, arrayBody)
@@ -3793,7 +3995,7 @@ func (client ArrayClient) PutDoubleValid(ctx context.Context, arrayBody []float6
 	}
 
 	result, err = client.PutDoubleValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "PutDoubleValid", resp, "Failure responding to request")
 	}
 
@@ -3808,14 +4010,15 @@ func (client ArrayClient) PutDoubleValidPreparer(ctx context.Context, arrayBody
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/double/0--0.01-1.2e20"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutDoubleValidSender sends the PutDoubleValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) PutDoubleValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutDoubleValidResponder handles the response to the PutDoubleValid request. The method always
@@ -3824,7 +4027,7 @@ func (client ArrayClient) PutDoubleValidResponder(resp *http.Response) (result a
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -3832,20 +4035,24 @@ func (client ArrayClient) PutDoubleValidResponder(resp *http.Response) (result a
 
 // PutDurationValid set array value  ['P123DT22H14M12.011S', 'P5DT1H0M0S']
 func (client ArrayClient) PutDurationValid(ctx context.Context, arrayBody []string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.PutDurationValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.PutDurationValid", "PUT", "/array/prim/duration/valid")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("arraygroup.ArrayClient", "PutDurationValid", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.PutDurationValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("arraygroup.ArrayClient", "PutDurationValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutDurationValidPreparer(ctx, arrayBody)
@@ -3862,7 +4069,7 @@ func (client ArrayClient) PutDurationValid(ctx context.Context, arrayBody []stri
 	}
 
 	result, err = client.PutDurationValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "PutDurationValid", resp, "Failure responding to request")
 	}
 
@@ -3877,14 +4084,15 @@ func (client ArrayClient) PutDurationValidPreparer(ctx context.Context, arrayBod
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/duration/valid"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutDurationValidSender sends the PutDurationValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) PutDurationValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutDurationValidResponder handles the response to the PutDurationValid request. The method always
@@ -3893,7 +4101,7 @@ func (client ArrayClient) PutDurationValidResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -3901,20 +4109,24 @@ func (client ArrayClient) PutDurationValidResponder(resp *http.Response) (result
 
 // PutEmpty set array value empty []
 func (client ArrayClient) PutEmpty(ctx context.Context, arrayBody []string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.PutEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.PutEmpty", "PUT", "/array/empty")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.PutEmpty", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("arraygroup.ArrayClient", "PutEmpty", err.Error())
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("arraygroup.ArrayClient", "PutEmpty", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutEmptyPreparer(ctx, arrayBody)
@@ -3931,7 +4143,7 @@ func (client ArrayClient) PutEmpty(ctx context.Context, arrayBody []string) (res
 	}
 
 	result, err = client.PutEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "PutEmpty", resp, "Failure responding to request")
 	}
 
@@ -3946,14 +4158,15 @@ func (client ArrayClient) PutEmptyPreparer(ctx context.Context, arrayBody []stri
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/empty"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutEmptySender sends the PutEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) PutEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutEmptyResponder handles the response to the PutEmpty request. The method always
@@ -3962,7 +4175,7 @@ func (client ArrayClient) PutEmptyResponder(resp *http.Response) (result autores
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -3970,20 +4183,24 @@ func (client ArrayClient) PutEmptyResponder(resp *http.Response) (result autores
 
 // PutEnumValid set array value ['foo1', 'foo2', 'foo3']
 func (client ArrayClient) PutEnumValid(ctx context.Context, arrayBody []FooEnum) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.PutEnumValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.PutEnumValid", "PUT", "/array/prim/enum/foo1.foo2.foo3")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.PutEnumValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("arraygroup.ArrayClient", "PutEnumValid", err.Error())
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("arraygroup.ArrayClient", "PutEnumValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutEnumValidPreparer(ctx, arrayBody)
@@ -4000,7 +4217,7 @@ func (client ArrayClient) PutEnumValid(ctx context.Context, arrayBody []FooEnum)
 	}
 
 	result, err = client.PutEnumValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "PutEnumValid", resp, "Failure responding to request")
 	}
 
@@ -4015,14 +4232,15 @@ func (client ArrayClient) PutEnumValidPreparer(ctx context.Context, arrayBody []
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/enum/foo1.foo2.foo3"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutEnumValidSender sends the PutEnumValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) PutEnumValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutEnumValidResponder handles the response to the PutEnumValid request. The method always
@@ -4031,7 +4249,7 @@ func (client ArrayClient) PutEnumValidResponder(resp *http.Response) (result aut
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -4039,20 +4257,24 @@ func (client ArrayClient) PutEnumValidResponder(resp *http.Response) (result aut
 
 // PutFloatValid set array value [0, -0.01, 1.2e20]
 func (client ArrayClient) PutFloatValid(ctx context.Context, arrayBody []float64) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.PutFloatValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.PutFloatValid", "PUT", "/array/prim/float/0--0.01-1.2e20")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.PutFloatValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("arraygroup.ArrayClient", "PutFloatValid", err.Error())
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("arraygroup.ArrayClient", "PutFloatValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutFloatValidPreparer(ctx, arrayBody)
@@ -4069,7 +4291,7 @@ func (client ArrayClient) PutFloatValid(ctx context.Context, arrayBody []float64
 	}
 
 	result, err = client.PutFloatValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "PutFloatValid", resp, "Failure responding to request")
 	}
 
@@ -4084,14 +4306,15 @@ func (client ArrayClient) PutFloatValidPreparer(ctx context.Context, arrayBody [
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/float/0--0.01-1.2e20"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutFloatValidSender sends the PutFloatValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) PutFloatValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutFloatValidResponder handles the response to the PutFloatValid request. The method always
@@ -4100,7 +4323,7 @@ func (client ArrayClient) PutFloatValidResponder(resp *http.Response) (result au
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -4108,20 +4331,24 @@ func (client ArrayClient) PutFloatValidResponder(resp *http.Response) (result au
 
 // PutIntegerValid set array value empty [1, -1, 3, 300]
 func (client ArrayClient) PutIntegerValid(ctx context.Context, arrayBody []int32) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.PutIntegerValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.PutIntegerValid", "PUT", "/array/prim/integer/1.-1.3.300")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.PutIntegerValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("arraygroup.ArrayClient", "PutIntegerValid", err.Error())
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("arraygroup.ArrayClient", "PutIntegerValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutIntegerValidPreparer(ctx, arrayBody)
@@ -4138,7 +4365,7 @@ func (client ArrayClient) PutIntegerValid(ctx context.Context, arrayBody []int32
 	}
 
 	result, err = client.PutIntegerValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "PutIntegerValid", resp, "Failure responding to request")
 	}
 
@@ -4153,14 +4380,15 @@ func (client ArrayClient) PutIntegerValidPreparer(ctx context.Context, arrayBody
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/integer/1.-1.3.300"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutIntegerValidSender sends the PutIntegerValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) PutIntegerValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutIntegerValidResponder handles the response to the PutIntegerValid request. The method always
@@ -4169,7 +4397,7 @@ func (client ArrayClient) PutIntegerValidResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -4177,20 +4405,24 @@ func (client ArrayClient) PutIntegerValidResponder(resp *http.Response) (result
 
 // PutLongValid set array value empty [1, -1, 3, 300]
 func (client ArrayClient) PutLongValid(ctx context.Context, arrayBody []int64) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.PutLongValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.PutLongValid", "PUT", "/array/prim/long/1.-1.3.300")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("arraygroup.ArrayClient", "PutLongValid", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.PutLongValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("arraygroup.ArrayClient", "PutLongValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutLongValidPreparer(ctx, arrayBody)
@@ -4207,7 +4439,7 @@ func (client ArrayClient) PutLongValid(ctx context.Context, arrayBody []int64) (
 	}
 
 	result, err = client.PutLongValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "PutLongValid", resp, "Failure responding to request")
 	}
 
@@ -4222,14 +4454,15 @@ func (client ArrayClient) PutLongValidPreparer(ctx context.Context, arrayBody []
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/long/1.-1.3.300"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutLongValidSender sends the PutLongValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) PutLongValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutLongValidResponder handles the response to the PutLongValid request. The method always
@@ -4238,7 +4471,7 @@ func (client ArrayClient) PutLongValidResponder(resp *http.Response) (result aut
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -4246,20 +4479,24 @@ func (client ArrayClient) PutLongValidResponder(resp *http.Response) (result aut
 
 // PutStringEnumValid set array value ['foo1', 'foo2', 'foo3']
 func (client ArrayClient) PutStringEnumValid(ctx context.Context, arrayBody []string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.PutStringEnumValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.PutStringEnumValid", "PUT", "/array/prim/string-enum/foo1.foo2.foo3")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("arraygroup.ArrayClient", "PutStringEnumValid", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.PutStringEnumValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("arraygroup.ArrayClient", "PutStringEnumValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutStringEnumValidPreparer(ctx, arrayBody)
@@ -4276,7 +4513,7 @@ func (client ArrayClient) PutStringEnumValid(ctx context.Context, arrayBody []st
 	}
 
 	result, err = client.PutStringEnumValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "PutStringEnumValid", resp, "Failure responding to request")
 	}
 
@@ -4291,14 +4528,15 @@ func (client ArrayClient) PutStringEnumValidPreparer(ctx context.Context, arrayB
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/string-enum/foo1.foo2.foo3"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutStringEnumValidSender sends the PutStringEnumValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) PutStringEnumValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutStringEnumValidResponder handles the response to the PutStringEnumValid request. The method always
@@ -4307,7 +4545,7 @@ func (client ArrayClient) PutStringEnumValidResponder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -4315,20 +4553,24 @@ func (client ArrayClient) PutStringEnumValidResponder(resp *http.Response) (resu
 
 // PutStringValid set array value ['foo1', 'foo2', 'foo3']
 func (client ArrayClient) PutStringValid(ctx context.Context, arrayBody []string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.PutStringValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.PutStringValid", "PUT", "/array/prim/string/foo1.foo2.foo3")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.PutStringValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("arraygroup.ArrayClient", "PutStringValid", err.Error())
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("arraygroup.ArrayClient", "PutStringValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutStringValidPreparer(ctx, arrayBody)
@@ -4345,7 +4587,7 @@ func (client ArrayClient) PutStringValid(ctx context.Context, arrayBody []string
 	}
 
 	result, err = client.PutStringValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "PutStringValid", resp, "Failure responding to request")
 	}
 
@@ -4360,14 +4602,15 @@ func (client ArrayClient) PutStringValidPreparer(ctx context.Context, arrayBody
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/string/foo1.foo2.foo3"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutStringValidSender sends the PutStringValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) PutStringValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutStringValidResponder handles the response to the PutStringValid request. The method always
@@ -4376,7 +4619,7 @@ func (client ArrayClient) PutStringValidResponder(resp *http.Response) (result a
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -4385,20 +4628,24 @@ func (client ArrayClient) PutStringValidResponder(resp *http.Response) (result a
 // PutUUIDValid set array value  ['6dcc7237-45fe-45c4-8a6b-3a8a3f625652', 'd1399005-30f7-40d6-8da6-dd7c89ad34db',
 // 'f42f6aa1-a5bc-4ddf-907e-5f915de43205']
 func (client ArrayClient) PutUUIDValid(ctx context.Context, arrayBody []uuid.UUID) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.PutUUIDValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.PutUUIDValid", "PUT", "/array/prim/uuid/valid")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("arraygroup.ArrayClient", "PutUUIDValid", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.PutUUIDValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("arraygroup.ArrayClient", "PutUUIDValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutUUIDValidPreparer(ctx, arrayBody)
@@ -4415,7 +4662,7 @@ func (client ArrayClient) PutUUIDValid(ctx context.Context, arrayBody []uuid.UUI
 	}
 
 	result, err = client.PutUUIDValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "arraygroup.ArrayClient", "PutUUIDValid", resp, "Failure responding to request")
 	}
 
@@ -4430,14 +4677,15 @@ func (client ArrayClient) PutUUIDValidPreparer(ctx context.Context, arrayBody []
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/array/prim/uuid/valid"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutUUIDValidSender sends the PutUUIDValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) PutUUIDValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutUUIDValidResponder handles the response to the PutUUIDValid request. The method always
@@ -4446,7 +4694,7 @@ func (client ArrayClient) PutUUIDValidResponder(resp *http.Response) (result aut
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -7,9 +7,25 @@ package arraygroup
 // Changes may cause incorrect behavior and will be lost if the code is regenerated.
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/Azure/go-autorest/autorest/validation"
 	"github.com/satori/go.uuid"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // The package's fully qualified name.
@@ -32,104 +48,1642 @@ func PossibleFooEnumValues() []FooEnum {
 	return []FooEnum{Foo1, Foo2, Foo3}
 }
 
+// knownFooEnumValues is the set of the possible values for the FooEnum const type.
+var knownFooEnumValues = map[FooEnum]struct{}{Foo1: {}, Foo2: {}, Foo3: {}}
+
+// ParseFooEnum returns the FooEnum whose value is s, or an error if s isn't one of its possible values.
+func ParseFooEnum(s string) (FooEnum, error) {
+	if _, ok := knownFooEnumValues[FooEnum(s)]; ok {
+		return FooEnum(s), nil
+	}
+	return "", fmt.Errorf("%q isn't a possible value for FooEnum", s)
+}
+
+// ParseFooEnumIgnoreCase returns the FooEnum whose value equals s without regard to case, or an error if s isn't one of
+// its possible values.  Exact matches are preferred.
+func ParseFooEnumIgnoreCase(s string) (FooEnum, error) {
+	if value, err := ParseFooEnum(s); err == nil {
+		return value, nil
+	}
+	for _, value := range PossibleFooEnumValues() {
+		if strings.EqualFold(string(value), s) {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("%q isn't a possible value for FooEnum", s)
+}
+
+// IsKnown returns true if fe is one of the possible values for the FooEnum const type.
+func (fe FooEnum) IsKnown() bool {
+	_, ok := knownFooEnumValues[fe]
+	return ok
+}
+
+// String returns the value of fe.
+func (fe FooEnum) String() string {
+	return string(fe)
+}
+
 // Error ...
 type Error struct {
 	Status  *int32  `json:"status,omitempty"`
 	Message *string `json:"message,omitempty"`
 }
 
+// DeepCopy returns a copy of the Error that doesn't share any pointers, slices or maps with it.
+func (e Error) DeepCopy() Error {
+	result := e
+	if e.Status != nil {
+		v := *e.Status
+		result.Status = &v
+	}
+	if e.Message != nil {
+		v := *e.Message
+		result.Message = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Error and other are equal.  Times are equal if they represent the same
+// instant.
+func (e Error) Equal(other Error) bool {
+	return e.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (e Error) EqualIgnoreReadOnly(other Error) bool {
+	return e.equal(other, true)
+}
+
+// equal compares the fields of the Error and other, skipping the read-only ones if ignoreReadOnly is true.
+func (e Error) equal(other Error, ignoreReadOnly bool) bool {
+	if (e.Status == nil) != (other.Status == nil) || (e.Status != nil && *e.Status != *other.Status) {
+		return false
+	}
+	if (e.Message == nil) != (other.Message == nil) || (e.Message != nil && *e.Message != *other.Message) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Error to copies of the fields set in patch.  Nested models and maps are merged, the
+// values of the other fields are replaced.  The read-only fields aren't changed.
+func (e *Error) MergeFrom(patch Error) {
+	if patch.Status != nil {
+		v := *patch.Status
+		e.Status = &v
+	}
+	if patch.Message != nil {
+		v := *patch.Message
+		e.Message = &v
+	}
+}
+
+// ErrorResponse is returned when an operation fails with a response containing the Error model.  Unlike the other
+// errors of the operations it isn't wrapped in an autorest.DetailedError.
+type ErrorResponse struct {
+	// Model - the error model unmarshalled from the response body.
+	Model Error
+	// StatusCode - the HTTP status code of the response.
+	StatusCode int
+	// Body - the raw response body.
+	Body []byte
+	// Err - the error encountered reading or unmarshalling the response body, if any.
+	Err error
+}
+
+// Error implements the error interface for type ErrorResponse.
+func (er ErrorResponse) Error() string {
+	if er.Err != nil {
+		return fmt.Sprintf("arraygroup: StatusCode=%d Body=%s Err=%v", er.StatusCode, string(er.Body), er.Err)
+	}
+	return fmt.Sprintf("arraygroup: StatusCode=%d Body=%s", er.StatusCode, string(er.Body))
+}
+
+// Unwrap returns the error encountered reading or unmarshalling the response body, if any.
+func (er ErrorResponse) Unwrap() error {
+	return er.Err
+}
+
+// withErrorResponseUnlessStatusCode returns a RespondDecorator that returns an ErrorResponse if the
+// response's status code isn't one of the specified codes.  The response body is left available to the caller.
+// When the body can't be read or unmarshalled the returned ErrorResponse wraps the error in its Err field.
+func withErrorResponseUnlessStatusCode(codes ...int) autorest.RespondDecorator {
+	return func(r autorest.Responder) autorest.Responder {
+		return autorest.ResponderFunc(func(resp *http.Response) error {
+			err := r.Respond(resp)
+			if err != nil || autorest.ResponseHasStatusCode(resp, codes...) {
+				return err
+			}
+			defer resp.Body.Close()
+			er := ErrorResponse{StatusCode: resp.StatusCode}
+			er.Body, er.Err = ioutil.ReadAll(resp.Body)
+			resp.Body = ioutil.NopCloser(bytes.NewReader(er.Body))
+			if er.Err == nil && len(bytes.TrimSpace(er.Body)) > 0 {
+				er.Err = json.Unmarshal(er.Body, &er.Model)
+			}
+			return er
+		})
+	}
+}
+
+// ExponentialRetry is a RetryPolicy that retries failed requests with exponentially increasing delays.  When the
+// RetryPolicy of a client is nil one is created from its RetryAttempts and RetryDuration.  Unlike
+// autorest.DoRetryForStatusCodes it only retries idempotent requests unless RetryNonIdempotent is true, so POST and
+// PATCH requests, including the initial requests of long-running operations, aren't retried unless the service marks
+// their operation x-ms-idempotent.  Responses with status code 429 count against MaxRetries.
+type ExponentialRetry struct {
+	// MaxRetries - the maximum number of times a request is retried.
+	MaxRetries int
+	// Delay - the delay before the first retry.  The delay is doubled for each following retry.
+	Delay time.Duration
+	// MaxDelay - the maximum delay between retries.  Zero means no limit.
+	MaxDelay time.Duration
+	// MaxElapsed - requests aren't retried once the delay would exceed this much time since the first attempt.  Zero means no limit.
+	MaxElapsed time.Duration
+	// Jitter - the fraction, from 0 to 1, of each delay that's randomized to spread out the retries of concurrent requests.
+	Jitter float64
+	// StatusCodes - the status codes of the responses that are retried.  Defaults to autorest.StatusCodesForRetry.
+	StatusCodes []int
+	// RetryNonIdempotent - if true, requests that aren't idempotent, e.g. POST requests of operations the service doesn't mark as idempotent, are retried too.
+	RetryNonIdempotent bool
+}
+
+// defaultRetryJitter is the Jitter of the ExponentialRetry used when the RetryPolicy of a client is nil.
+const defaultRetryJitter = 0.2
+
+// RetryPolicy decides if and when a failed attempt to send a request is retried.
+type RetryPolicy interface {
+	// ShouldRetry returns the delay before the request is sent again, or false if it isn't retried.
+	ShouldRetry(attempt RetryAttempt) (time.Duration, bool)
+}
+
+// LogEvent is an event logged by the operations of a client when its Logger isn't nil.  The URLs and headers of the
+// requests aren't included as they can contain secrets.
+type LogEvent struct {
+	// Kind - the kind of the event.
+	Kind LogEventKind
+	// Operation - the name of the operation, qualified by the name of its client.
+	Operation string
+	// Fields - the parameters of the operation keyed by their names in the request, for LogOperationStart events.  Body parameters aren't included and the values of secret parameters are replaced with REDACTED.
+	Fields map[string]interface{}
+	// Attempt - the number of the failed attempt, starting at one, for LogRetry events.
+	Attempt int
+	// Delay - the delay before the request is sent again, for LogRetry events.
+	Delay time.Duration
+	// State - the status of the long-running operation, for LogPoll events.
+	State string
+	// StatusCode - the status code of the response, or zero if none was received.
+	StatusCode int
+	// Elapsed - the time elapsed since the operation, the page fetch or the polling started.
+	Elapsed time.Duration
+	// Err - the error the operation, the attempt or the poll failed with, if any.
+	Err error
+}
+
+// redactedLogValue replaces the values of secret parameters in the Fields of a LogEvent.
+const redactedLogValue = "REDACTED"
+
+// LogEventKind is the kind of a LogEvent.
+type LogEventKind string
+
+const (
+	// LogOperationStart is logged when an operation starts, with its parameters.
+	LogOperationStart LogEventKind = "OperationStart"
+	// LogOperationFinish is logged when an operation finishes.
+	LogOperationFinish LogEventKind = "OperationFinish"
+	// LogRetry is logged when a failed attempt to send a request is retried.
+	LogRetry LogEventKind = "Retry"
+	// LogPageFetch is logged when the next page of the results of an operation has been fetched.
+	LogPageFetch LogEventKind = "PageFetch"
+	// LogPoll is logged each time PollUntilDone has polled the status of a long-running operation.
+	LogPoll LogEventKind = "Poll"
+)
+
+// Logger receives the events logged by the operations of a client.
+type Logger interface {
+	// Log is called synchronously for each event.
+	Log(event LogEvent)
+}
+
+// LoggerFunc is a function implementing the Logger interface.
+type LoggerFunc func(event LogEvent)
+
+// Log implements the Logger interface for LoggerFunc.
+func (lf LoggerFunc) Log(event LogEvent) {
+	lf(event)
+}
+
+// String formats the event as a line of key=value pairs, e.g. to pass to log.Print.
+func (le LogEvent) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "event=%s operation=%s", le.Kind, le.Operation)
+	names := make([]string, 0, len(le.Fields))
+	for name := range le.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&b, " %s=%v", name, le.Fields[name])
+	}
+	if le.Attempt > 0 {
+		fmt.Fprintf(&b, " attempt=%d", le.Attempt)
+	}
+	if le.State != "" {
+		fmt.Fprintf(&b, " state=%s", le.State)
+	}
+	if le.StatusCode > 0 {
+		fmt.Fprintf(&b, " status=%d", le.StatusCode)
+	}
+	if le.Delay > 0 {
+		fmt.Fprintf(&b, " delay=%v", le.Delay)
+	}
+	if le.Kind != LogOperationStart {
+		fmt.Fprintf(&b, " elapsed=%v", le.Elapsed)
+	}
+	if le.Err != nil {
+		fmt.Fprintf(&b, " error=%q", le.Err.Error())
+	}
+	return b.String()
+}
+
+// operationLog is the state used to log the events of an operation, carried by the contexts of its requests.
+type operationLog struct {
+	logger    Logger
+	operation string
+	start     time.Time
+}
+
+// operationLogKey is the key of the operationLog in a context.
+type operationLogKey struct{}
+
+// withOperationLog returns a context carrying the state used to log the events of operation to logger.
+func withOperationLog(ctx context.Context, logger Logger, operation string) context.Context {
+	return context.WithValue(ctx, operationLogKey{}, &operationLog{logger: logger, operation: operation, start: time.Now()})
+}
+
+// startOperationLog logs the start of operation with its parameters, then returns a context carrying the state used to
+// log its other events.
+func startOperationLog(ctx context.Context, logger Logger, operation string, fields map[string]interface{}) context.Context {
+	for name, value := range fields {
+		// log the values of optional parameters rather than their addresses
+		v := reflect.ValueOf(value)
+		for v.Kind() == reflect.Ptr && !v.IsNil() {
+			v = v.Elem()
+		}
+		if v.Kind() == reflect.Ptr {
+			fields[name] = nil
+		} else if v.IsValid() {
+			fields[name] = v.Interface()
+		}
+	}
+	ctx = withOperationLog(ctx, logger, operation)
+	logger.Log(LogEvent{Kind: LogOperationStart, Operation: operation, Fields: fields})
+	return ctx
+}
+
+// logResponse logs event for the operation whose state is carried by ctx, if any, along with the status code of resp
+// if it isn't nil.
+func logResponse(ctx context.Context, event LogEvent, resp *http.Response) {
+	ol, ok := ctx.Value(operationLogKey{}).(*operationLog)
+	if !ok {
+		return
+	}
+	event.Operation = ol.operation
+	event.Elapsed = time.Since(ol.start)
+	if resp != nil {
+		event.StatusCode = resp.StatusCode
+	}
+	ol.logger.Log(event)
+}
+
+// RetryAttempt describes a failed attempt to send a request.
+type RetryAttempt struct {
+	// Request - the request that was sent.
+	Request *http.Request
+	// Response - the response received, or nil if sending the request failed.
+	Response *http.Response
+	// Err - the error returned when sending the request, if any.
+	Err error
+	// Attempt - the number of the attempt, starting at one.
+	Attempt int
+	// Elapsed - the time elapsed since the first attempt was sent.
+	Elapsed time.Duration
+	// Idempotent - true if the request can safely be sent more than once, either because its HTTP method is
+	// idempotent or because the service marks the operation as idempotent.
+	Idempotent bool
+}
+
+// ShouldRetry implements the RetryPolicy interface for ExponentialRetry.  A delay requested by the Retry-After header
+// of the response takes precedence over the computed one.
+func (er ExponentialRetry) ShouldRetry(attempt RetryAttempt) (time.Duration, bool) {
+	if attempt.Attempt > er.MaxRetries || !(attempt.Idempotent || er.RetryNonIdempotent) {
+		return 0, false
+	}
+	if attempt.Err != nil {
+		if !autorest.IsTemporaryNetworkError(attempt.Err) || autorest.IsTokenRefreshError(attempt.Err) {
+			return 0, false
+		}
+	} else {
+		codes := er.StatusCodes
+		if codes == nil {
+			codes = autorest.StatusCodesForRetry
+		}
+		if !autorest.ResponseHasStatusCode(attempt.Response, codes...) {
+			return 0, false
+		}
+	}
+	delay, ok := retryAfter(attempt.Response)
+	if !ok {
+		delay = er.Delay
+		for i := 1; i < attempt.Attempt && delay < math.MaxInt64/2; i++ {
+			delay *= 2
+		}
+		if er.MaxDelay > 0 && delay > er.MaxDelay {
+			delay = er.MaxDelay
+		}
+		if er.Jitter > 0 {
+			delay -= time.Duration(math.Min(er.Jitter, 1) * rand.Float64() * float64(delay))
+		}
+	}
+	if er.MaxElapsed > 0 && attempt.Elapsed+delay > er.MaxElapsed {
+		return 0, false
+	}
+	return delay, true
+}
+
+// retryAfter returns the delay requested by the Retry-After header of resp, and false if it doesn't have one.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	ra := resp.Header.Get("Retry-After")
+	if ra == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(ra); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(ra); err == nil {
+		if delay := time.Until(t); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// isIdempotentMethod returns true if requests using the HTTP method can safely be sent more than once.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodTrace:
+		return true
+	}
+	return false
+}
+
+// withRetryPolicy returns a SendDecorator that sends a request again after each failed attempt policy decides to retry.
+// If policy is nil an ExponentialRetry created from the RetryAttempts and RetryDuration of c is used.  idempotent is
+// true for operations the service marks as idempotent.
+func withRetryPolicy(policy RetryPolicy, c autorest.Client, idempotent bool) autorest.SendDecorator {
+	if policy == nil {
+		policy = ExponentialRetry{MaxRetries: c.RetryAttempts, Delay: c.RetryDuration, Jitter: defaultRetryJitter}
+	}
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+			idempotent := idempotent || isIdempotentMethod(r.Method)
+			start := time.Now()
+			for attempt := 1; ; attempt++ {
+				if err := rr.Prepare(); err != nil {
+					return nil, err
+				}
+				resp, err := s.Do(rr.Request())
+				if r.Context().Err() != nil {
+					return resp, err
+				}
+				delay, retry := policy.ShouldRetry(RetryAttempt{
+					Request:    rr.Request(),
+					Response:   resp,
+					Err:        err,
+					Attempt:    attempt,
+					Elapsed:    time.Since(start),
+					Idempotent: idempotent,
+				})
+				if !retry {
+					return resp, err
+				}
+				logResponse(r.Context(), LogEvent{Kind: LogRetry, Attempt: attempt, Delay: delay, Err: err}, resp)
+				setSpanAttribute(r.Context(), AttributeRetryCount, attempt)
+				if resp != nil {
+					autorest.Respond(resp, autorest.ByDiscardingBody(), autorest.ByClosing())
+				}
+				timer := time.NewTimer(delay)
+				select {
+				case <-timer.C:
+				case <-r.Context().Done():
+					timer.Stop()
+					return nil, r.Context().Err()
+				}
+			}
+		})
+	}
+}
+
 // ListBase64URL ...
 type ListBase64URL struct {
 	autorest.Response `json:"-"`
 	Value             *[]string `json:"value,omitempty"`
 }
 
+// DeepCopy returns a copy of the ListBase64URL that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (lb6 ListBase64URL) DeepCopy() ListBase64URL {
+	result := lb6
+	if lb6.Value != nil {
+		var v []string
+		if *lb6.Value != nil {
+			v = make([]string, len(*lb6.Value))
+			copy(v, *lb6.Value)
+		}
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ListBase64URL and other are equal.  Times are equal if they represent the
+// same instant.  Their autorest.Response isn't compared.
+func (lb6 ListBase64URL) Equal(other ListBase64URL) bool {
+	return lb6.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (lb6 ListBase64URL) EqualIgnoreReadOnly(other ListBase64URL) bool {
+	return lb6.equal(other, true)
+}
+
+// equal compares the fields of the ListBase64URL and other, skipping the read-only ones if ignoreReadOnly is true.
+func (lb6 ListBase64URL) equal(other ListBase64URL, ignoreReadOnly bool) bool {
+	if (lb6.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if lb6.Value != nil {
+		if (*lb6.Value == nil) != (*other.Value == nil) || len(*lb6.Value) != len(*other.Value) {
+			return false
+		}
+		for i := range *lb6.Value {
+			if (*lb6.Value)[i] != (*other.Value)[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ListBase64URL to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (lb6 *ListBase64URL) MergeFrom(patch ListBase64URL) {
+	if patch.Value != nil {
+		var v []string
+		if *patch.Value != nil {
+			v = make([]string, len(*patch.Value))
+			copy(v, *patch.Value)
+		}
+		lb6.Value = &v
+	}
+}
+
 // ListBool ...
 type ListBool struct {
 	autorest.Response `json:"-"`
 	Value             *[]bool `json:"value,omitempty"`
 }
 
+// DeepCopy returns a copy of the ListBool that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (lb ListBool) DeepCopy() ListBool {
+	result := lb
+	if lb.Value != nil {
+		var v []bool
+		if *lb.Value != nil {
+			v = make([]bool, len(*lb.Value))
+			copy(v, *lb.Value)
+		}
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ListBool and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (lb ListBool) Equal(other ListBool) bool {
+	return lb.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (lb ListBool) EqualIgnoreReadOnly(other ListBool) bool {
+	return lb.equal(other, true)
+}
+
+// equal compares the fields of the ListBool and other, skipping the read-only ones if ignoreReadOnly is true.
+func (lb ListBool) equal(other ListBool, ignoreReadOnly bool) bool {
+	if (lb.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if lb.Value != nil {
+		if (*lb.Value == nil) != (*other.Value == nil) || len(*lb.Value) != len(*other.Value) {
+			return false
+		}
+		for i := range *lb.Value {
+			if (*lb.Value)[i] != (*other.Value)[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ListBool to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (lb *ListBool) MergeFrom(patch ListBool) {
+	if patch.Value != nil {
+		var v []bool
+		if *patch.Value != nil {
+			v = make([]bool, len(*patch.Value))
+			copy(v, *patch.Value)
+		}
+		lb.Value = &v
+	}
+}
+
 // ListByteArray ...
 type ListByteArray struct {
 	autorest.Response `json:"-"`
 	Value             *[][]byte `json:"value,omitempty"`
 }
 
+// DeepCopy returns a copy of the ListByteArray that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (lba ListByteArray) DeepCopy() ListByteArray {
+	result := lba
+	if lba.Value != nil {
+		var v [][]byte
+		if *lba.Value != nil {
+			v = make([][]byte, len(*lba.Value))
+			for i := range *lba.Value {
+				if (*lba.Value)[i] != nil {
+					v[i] = make([]byte, len((*lba.Value)[i]))
+					copy(v[i], (*lba.Value)[i])
+				}
+			}
+		}
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ListByteArray and other are equal.  Times are equal if they represent the
+// same instant.  Their autorest.Response isn't compared.
+func (lba ListByteArray) Equal(other ListByteArray) bool {
+	return lba.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (lba ListByteArray) EqualIgnoreReadOnly(other ListByteArray) bool {
+	return lba.equal(other, true)
+}
+
+// equal compares the fields of the ListByteArray and other, skipping the read-only ones if ignoreReadOnly is true.
+func (lba ListByteArray) equal(other ListByteArray, ignoreReadOnly bool) bool {
+	if (lba.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if lba.Value != nil {
+		if (*lba.Value == nil) != (*other.Value == nil) || len(*lba.Value) != len(*other.Value) {
+			return false
+		}
+		for i := range *lba.Value {
+			if ((*lba.Value)[i] == nil) != ((*other.Value)[i] == nil) || !bytes.Equal((*lba.Value)[i], (*other.Value)[i]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ListByteArray to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (lba *ListByteArray) MergeFrom(patch ListByteArray) {
+	if patch.Value != nil {
+		var v [][]byte
+		if *patch.Value != nil {
+			v = make([][]byte, len(*patch.Value))
+			for i := range *patch.Value {
+				if (*patch.Value)[i] != nil {
+					v[i] = make([]byte, len((*patch.Value)[i]))
+					copy(v[i], (*patch.Value)[i])
+				}
+			}
+		}
+		lba.Value = &v
+	}
+}
+
 // ListDate ...
 type ListDate struct {
 	autorest.Response `json:"-"`
 	Value             *[]date.Date `json:"value,omitempty"`
 }
 
+// DeepCopy returns a copy of the ListDate that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (ld ListDate) DeepCopy() ListDate {
+	result := ld
+	if ld.Value != nil {
+		var v []date.Date
+		if *ld.Value != nil {
+			v = make([]date.Date, len(*ld.Value))
+			copy(v, *ld.Value)
+		}
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ListDate and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (ld ListDate) Equal(other ListDate) bool {
+	return ld.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (ld ListDate) EqualIgnoreReadOnly(other ListDate) bool {
+	return ld.equal(other, true)
+}
+
+// equal compares the fields of the ListDate and other, skipping the read-only ones if ignoreReadOnly is true.
+func (ld ListDate) equal(other ListDate, ignoreReadOnly bool) bool {
+	if (ld.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if ld.Value != nil {
+		if (*ld.Value == nil) != (*other.Value == nil) || len(*ld.Value) != len(*other.Value) {
+			return false
+		}
+		for i := range *ld.Value {
+			if !(*ld.Value)[i].Equal((*other.Value)[i].Time) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ListDate to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (ld *ListDate) MergeFrom(patch ListDate) {
+	if patch.Value != nil {
+		var v []date.Date
+		if *patch.Value != nil {
+			v = make([]date.Date, len(*patch.Value))
+			copy(v, *patch.Value)
+		}
+		ld.Value = &v
+	}
+}
+
 // ListDateTime ...
 type ListDateTime struct {
 	autorest.Response `json:"-"`
 	Value             *[]date.Time `json:"value,omitempty"`
 }
 
+// DeepCopy returns a copy of the ListDateTime that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (ldt ListDateTime) DeepCopy() ListDateTime {
+	result := ldt
+	if ldt.Value != nil {
+		var v []date.Time
+		if *ldt.Value != nil {
+			v = make([]date.Time, len(*ldt.Value))
+			copy(v, *ldt.Value)
+		}
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ListDateTime and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (ldt ListDateTime) Equal(other ListDateTime) bool {
+	return ldt.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (ldt ListDateTime) EqualIgnoreReadOnly(other ListDateTime) bool {
+	return ldt.equal(other, true)
+}
+
+// equal compares the fields of the ListDateTime and other, skipping the read-only ones if ignoreReadOnly is true.
+func (ldt ListDateTime) equal(other ListDateTime, ignoreReadOnly bool) bool {
+	if (ldt.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if ldt.Value != nil {
+		if (*ldt.Value == nil) != (*other.Value == nil) || len(*ldt.Value) != len(*other.Value) {
+			return false
+		}
+		for i := range *ldt.Value {
+			if !(*ldt.Value)[i].Equal((*other.Value)[i].Time) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ListDateTime to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (ldt *ListDateTime) MergeFrom(patch ListDateTime) {
+	if patch.Value != nil {
+		var v []date.Time
+		if *patch.Value != nil {
+			v = make([]date.Time, len(*patch.Value))
+			copy(v, *patch.Value)
+		}
+		ldt.Value = &v
+	}
+}
+
 // ListDateTimeRfc1123 ...
 type ListDateTimeRfc1123 struct {
 	autorest.Response `json:"-"`
 	Value             *[]date.TimeRFC1123 `json:"value,omitempty"`
 }
 
+// DeepCopy returns a copy of the ListDateTimeRfc1123 that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (ldtr1 ListDateTimeRfc1123) DeepCopy() ListDateTimeRfc1123 {
+	result := ldtr1
+	if ldtr1.Value != nil {
+		var v []date.TimeRFC1123
+		if *ldtr1.Value != nil {
+			v = make([]date.TimeRFC1123, len(*ldtr1.Value))
+			copy(v, *ldtr1.Value)
+		}
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ListDateTimeRfc1123 and other are equal.  Times are equal if they represent
+// the same instant.  Their autorest.Response isn't compared.
+func (ldtr1 ListDateTimeRfc1123) Equal(other ListDateTimeRfc1123) bool {
+	return ldtr1.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (ldtr1 ListDateTimeRfc1123) EqualIgnoreReadOnly(other ListDateTimeRfc1123) bool {
+	return ldtr1.equal(other, true)
+}
+
+// equal compares the fields of the ListDateTimeRfc1123 and other, skipping the read-only ones if ignoreReadOnly is true.
+func (ldtr1 ListDateTimeRfc1123) equal(other ListDateTimeRfc1123, ignoreReadOnly bool) bool {
+	if (ldtr1.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if ldtr1.Value != nil {
+		if (*ldtr1.Value == nil) != (*other.Value == nil) || len(*ldtr1.Value) != len(*other.Value) {
+			return false
+		}
+		for i := range *ldtr1.Value {
+			if !(*ldtr1.Value)[i].Equal((*other.Value)[i].Time) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ListDateTimeRfc1123 to copies of the fields set in patch.  Nested models and maps
+// are merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't
+// changed.
+func (ldtr1 *ListDateTimeRfc1123) MergeFrom(patch ListDateTimeRfc1123) {
+	if patch.Value != nil {
+		var v []date.TimeRFC1123
+		if *patch.Value != nil {
+			v = make([]date.TimeRFC1123, len(*patch.Value))
+			copy(v, *patch.Value)
+		}
+		ldtr1.Value = &v
+	}
+}
+
 // ListFloat64 ...
 type ListFloat64 struct {
 	autorest.Response `json:"-"`
 	Value             *[]float64 `json:"value,omitempty"`
 }
 
+// DeepCopy returns a copy of the ListFloat64 that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (lf6 ListFloat64) DeepCopy() ListFloat64 {
+	result := lf6
+	if lf6.Value != nil {
+		var v []float64
+		if *lf6.Value != nil {
+			v = make([]float64, len(*lf6.Value))
+			copy(v, *lf6.Value)
+		}
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ListFloat64 and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (lf6 ListFloat64) Equal(other ListFloat64) bool {
+	return lf6.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (lf6 ListFloat64) EqualIgnoreReadOnly(other ListFloat64) bool {
+	return lf6.equal(other, true)
+}
+
+// equal compares the fields of the ListFloat64 and other, skipping the read-only ones if ignoreReadOnly is true.
+func (lf6 ListFloat64) equal(other ListFloat64, ignoreReadOnly bool) bool {
+	if (lf6.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if lf6.Value != nil {
+		if (*lf6.Value == nil) != (*other.Value == nil) || len(*lf6.Value) != len(*other.Value) {
+			return false
+		}
+		for i := range *lf6.Value {
+			if (*lf6.Value)[i] != (*other.Value)[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ListFloat64 to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (lf6 *ListFloat64) MergeFrom(patch ListFloat64) {
+	if patch.Value != nil {
+		var v []float64
+		if *patch.Value != nil {
+			v = make([]float64, len(*patch.Value))
+			copy(v, *patch.Value)
+		}
+		lf6.Value = &v
+	}
+}
+
 // ListInt32 ...
 type ListInt32 struct {
 	autorest.Response `json:"-"`
 	Value             *[]int32 `json:"value,omitempty"`
 }
 
+// DeepCopy returns a copy of the ListInt32 that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (li3 ListInt32) DeepCopy() ListInt32 {
+	result := li3
+	if li3.Value != nil {
+		var v []int32
+		if *li3.Value != nil {
+			v = make([]int32, len(*li3.Value))
+			copy(v, *li3.Value)
+		}
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ListInt32 and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (li3 ListInt32) Equal(other ListInt32) bool {
+	return li3.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (li3 ListInt32) EqualIgnoreReadOnly(other ListInt32) bool {
+	return li3.equal(other, true)
+}
+
+// equal compares the fields of the ListInt32 and other, skipping the read-only ones if ignoreReadOnly is true.
+func (li3 ListInt32) equal(other ListInt32, ignoreReadOnly bool) bool {
+	if (li3.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if li3.Value != nil {
+		if (*li3.Value == nil) != (*other.Value == nil) || len(*li3.Value) != len(*other.Value) {
+			return false
+		}
+		for i := range *li3.Value {
+			if (*li3.Value)[i] != (*other.Value)[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ListInt32 to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (li3 *ListInt32) MergeFrom(patch ListInt32) {
+	if patch.Value != nil {
+		var v []int32
+		if *patch.Value != nil {
+			v = make([]int32, len(*patch.Value))
+			copy(v, *patch.Value)
+		}
+		li3.Value = &v
+	}
+}
+
 // ListInt64 ...
 type ListInt64 struct {
 	autorest.Response `json:"-"`
 	Value             *[]int64 `json:"value,omitempty"`
 }
 
+// DeepCopy returns a copy of the ListInt64 that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (li6 ListInt64) DeepCopy() ListInt64 {
+	result := li6
+	if li6.Value != nil {
+		var v []int64
+		if *li6.Value != nil {
+			v = make([]int64, len(*li6.Value))
+			copy(v, *li6.Value)
+		}
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ListInt64 and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (li6 ListInt64) Equal(other ListInt64) bool {
+	return li6.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (li6 ListInt64) EqualIgnoreReadOnly(other ListInt64) bool {
+	return li6.equal(other, true)
+}
+
+// equal compares the fields of the ListInt64 and other, skipping the read-only ones if ignoreReadOnly is true.
+func (li6 ListInt64) equal(other ListInt64, ignoreReadOnly bool) bool {
+	if (li6.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if li6.Value != nil {
+		if (*li6.Value == nil) != (*other.Value == nil) || len(*li6.Value) != len(*other.Value) {
+			return false
+		}
+		for i := range *li6.Value {
+			if (*li6.Value)[i] != (*other.Value)[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ListInt64 to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (li6 *ListInt64) MergeFrom(patch ListInt64) {
+	if patch.Value != nil {
+		var v []int64
+		if *patch.Value != nil {
+			v = make([]int64, len(*patch.Value))
+			copy(v, *patch.Value)
+		}
+		li6.Value = &v
+	}
+}
+
 // ListListString ...
 type ListListString struct {
 	autorest.Response `json:"-"`
 	Value             *[][]string `json:"value,omitempty"`
 }
 
+// DeepCopy returns a copy of the ListListString that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (lls ListListString) DeepCopy() ListListString {
+	result := lls
+	if lls.Value != nil {
+		var v [][]string
+		if *lls.Value != nil {
+			v = make([][]string, len(*lls.Value))
+			for i := range *lls.Value {
+				if (*lls.Value)[i] != nil {
+					v[i] = make([]string, len((*lls.Value)[i]))
+					copy(v[i], (*lls.Value)[i])
+				}
+			}
+		}
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ListListString and other are equal.  Times are equal if they represent the
+// same instant.  Their autorest.Response isn't compared.
+func (lls ListListString) Equal(other ListListString) bool {
+	return lls.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (lls ListListString) EqualIgnoreReadOnly(other ListListString) bool {
+	return lls.equal(other, true)
+}
+
+// equal compares the fields of the ListListString and other, skipping the read-only ones if ignoreReadOnly is true.
+func (lls ListListString) equal(other ListListString, ignoreReadOnly bool) bool {
+	if (lls.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if lls.Value != nil {
+		if (*lls.Value == nil) != (*other.Value == nil) || len(*lls.Value) != len(*other.Value) {
+			return false
+		}
+		for i := range *lls.Value {
+			if ((*lls.Value)[i] == nil) != ((*other.Value)[i] == nil) || len((*lls.Value)[i]) != len((*other.Value)[i]) {
+				return false
+			}
+			for i1 := range (*lls.Value)[i] {
+				if (*lls.Value)[i][i1] != (*other.Value)[i][i1] {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ListListString to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (lls *ListListString) MergeFrom(patch ListListString) {
+	if patch.Value != nil {
+		var v [][]string
+		if *patch.Value != nil {
+			v = make([][]string, len(*patch.Value))
+			for i := range *patch.Value {
+				if (*patch.Value)[i] != nil {
+					v[i] = make([]string, len((*patch.Value)[i]))
+					copy(v[i], (*patch.Value)[i])
+				}
+			}
+		}
+		lls.Value = &v
+	}
+}
+
 // ListProduct ...
 type ListProduct struct {
 	autorest.Response `json:"-"`
 	Value             *[]Product `json:"value,omitempty"`
 }
 
+// DeepCopy returns a copy of the ListProduct that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (lp ListProduct) DeepCopy() ListProduct {
+	result := lp
+	if lp.Value != nil {
+		var v []Product
+		if *lp.Value != nil {
+			v = make([]Product, len(*lp.Value))
+			for i := range *lp.Value {
+				v[i] = (*lp.Value)[i].DeepCopy()
+			}
+		}
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ListProduct and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (lp ListProduct) Equal(other ListProduct) bool {
+	return lp.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (lp ListProduct) EqualIgnoreReadOnly(other ListProduct) bool {
+	return lp.equal(other, true)
+}
+
+// equal compares the fields of the ListProduct and other, skipping the read-only ones if ignoreReadOnly is true.
+func (lp ListProduct) equal(other ListProduct, ignoreReadOnly bool) bool {
+	if (lp.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if lp.Value != nil {
+		if (*lp.Value == nil) != (*other.Value == nil) || len(*lp.Value) != len(*other.Value) {
+			return false
+		}
+		for i := range *lp.Value {
+			if !(*lp.Value)[i].equal((*other.Value)[i], ignoreReadOnly) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ListProduct to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (lp *ListProduct) MergeFrom(patch ListProduct) {
+	if patch.Value != nil {
+		var v []Product
+		if *patch.Value != nil {
+			v = make([]Product, len(*patch.Value))
+			for i := range *patch.Value {
+				v[i] = (*patch.Value)[i].DeepCopy()
+			}
+		}
+		lp.Value = &v
+	}
+}
+
 // ListSetString ...
 type ListSetString struct {
 	autorest.Response `json:"-"`
 	Value             *[]map[string]*string `json:"value,omitempty"`
 }
 
+// DeepCopy returns a copy of the ListSetString that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (lss ListSetString) DeepCopy() ListSetString {
+	result := lss
+	if lss.Value != nil {
+		var v []map[string]*string
+		if *lss.Value != nil {
+			v = make([]map[string]*string, len(*lss.Value))
+			for i := range *lss.Value {
+				if (*lss.Value)[i] != nil {
+					v[i] = make(map[string]*string, len((*lss.Value)[i]))
+					for k, e := range (*lss.Value)[i] {
+						if e != nil {
+							v1 := *e
+							e = &v1
+						}
+						v[i][k] = e
+					}
+				}
+			}
+		}
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ListSetString and other are equal.  Times are equal if they represent the
+// same instant.  Their autorest.Response isn't compared.
+func (lss ListSetString) Equal(other ListSetString) bool {
+	return lss.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (lss ListSetString) EqualIgnoreReadOnly(other ListSetString) bool {
+	return lss.equal(other, true)
+}
+
+// equal compares the fields of the ListSetString and other, skipping the read-only ones if ignoreReadOnly is true.
+func (lss ListSetString) equal(other ListSetString, ignoreReadOnly bool) bool {
+	if (lss.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if lss.Value != nil {
+		if (*lss.Value == nil) != (*other.Value == nil) || len(*lss.Value) != len(*other.Value) {
+			return false
+		}
+		for i := range *lss.Value {
+			if ((*lss.Value)[i] == nil) != ((*other.Value)[i] == nil) || len((*lss.Value)[i]) != len((*other.Value)[i]) {
+				return false
+			}
+			for k, e := range (*lss.Value)[i] {
+				f, ok := (*other.Value)[i][k]
+				if !ok || (e == nil) != (f == nil) || (e != nil && *e != *f) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ListSetString to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (lss *ListSetString) MergeFrom(patch ListSetString) {
+	if patch.Value != nil {
+		var v []map[string]*string
+		if *patch.Value != nil {
+			v = make([]map[string]*string, len(*patch.Value))
+			for i := range *patch.Value {
+				if (*patch.Value)[i] != nil {
+					v[i] = make(map[string]*string, len((*patch.Value)[i]))
+					for k, e := range (*patch.Value)[i] {
+						if e != nil {
+							v1 := *e
+							e = &v1
+						}
+						v[i][k] = e
+					}
+				}
+			}
+		}
+		lss.Value = &v
+	}
+}
+
 // ListString ...
 type ListString struct {
 	autorest.Response `json:"-"`
 	Value             *[]string `json:"value,omitempty"`
 }
 
+// DeepCopy returns a copy of the ListString that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (ls ListString) DeepCopy() ListString {
+	result := ls
+	if ls.Value != nil {
+		var v []string
+		if *ls.Value != nil {
+			v = make([]string, len(*ls.Value))
+			copy(v, *ls.Value)
+		}
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ListString and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (ls ListString) Equal(other ListString) bool {
+	return ls.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (ls ListString) EqualIgnoreReadOnly(other ListString) bool {
+	return ls.equal(other, true)
+}
+
+// equal compares the fields of the ListString and other, skipping the read-only ones if ignoreReadOnly is true.
+func (ls ListString) equal(other ListString, ignoreReadOnly bool) bool {
+	if (ls.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if ls.Value != nil {
+		if (*ls.Value == nil) != (*other.Value == nil) || len(*ls.Value) != len(*other.Value) {
+			return false
+		}
+		for i := range *ls.Value {
+			if (*ls.Value)[i] != (*other.Value)[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ListString to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (ls *ListString) MergeFrom(patch ListString) {
+	if patch.Value != nil {
+		var v []string
+		if *patch.Value != nil {
+			v = make([]string, len(*patch.Value))
+			copy(v, *patch.Value)
+		}
+		ls.Value = &v
+	}
+}
+
 // ListTimeSpan ...
 type ListTimeSpan struct {
 	autorest.Response `json:"-"`
 	Value             *[]string `json:"value,omitempty"`
 }
 
+// DeepCopy returns a copy of the ListTimeSpan that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (lts ListTimeSpan) DeepCopy() ListTimeSpan {
+	result := lts
+	if lts.Value != nil {
+		var v []string
+		if *lts.Value != nil {
+			v = make([]string, len(*lts.Value))
+			copy(v, *lts.Value)
+		}
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ListTimeSpan and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (lts ListTimeSpan) Equal(other ListTimeSpan) bool {
+	return lts.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (lts ListTimeSpan) EqualIgnoreReadOnly(other ListTimeSpan) bool {
+	return lts.equal(other, true)
+}
+
+// equal compares the fields of the ListTimeSpan and other, skipping the read-only ones if ignoreReadOnly is true.
+func (lts ListTimeSpan) equal(other ListTimeSpan, ignoreReadOnly bool) bool {
+	if (lts.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if lts.Value != nil {
+		if (*lts.Value == nil) != (*other.Value == nil) || len(*lts.Value) != len(*other.Value) {
+			return false
+		}
+		for i := range *lts.Value {
+			if (*lts.Value)[i] != (*other.Value)[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ListTimeSpan to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (lts *ListTimeSpan) MergeFrom(patch ListTimeSpan) {
+	if patch.Value != nil {
+		var v []string
+		if *patch.Value != nil {
+			v = make([]string, len(*patch.Value))
+			copy(v, *patch.Value)
+		}
+		lts.Value = &v
+	}
+}
+
 // ListUUID ...
 type ListUUID struct {
 	autorest.Response `json:"-"`
 	Value             *[]uuid.UUID `json:"value,omitempty"`
 }
 
+// DeepCopy returns a copy of the ListUUID that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (lu ListUUID) DeepCopy() ListUUID {
+	result := lu
+	if lu.Value != nil {
+		var v []uuid.UUID
+		if *lu.Value != nil {
+			v = make([]uuid.UUID, len(*lu.Value))
+			copy(v, *lu.Value)
+		}
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ListUUID and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (lu ListUUID) Equal(other ListUUID) bool {
+	return lu.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (lu ListUUID) EqualIgnoreReadOnly(other ListUUID) bool {
+	return lu.equal(other, true)
+}
+
+// equal compares the fields of the ListUUID and other, skipping the read-only ones if ignoreReadOnly is true.
+func (lu ListUUID) equal(other ListUUID, ignoreReadOnly bool) bool {
+	if (lu.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if lu.Value != nil {
+		if (*lu.Value == nil) != (*other.Value == nil) || len(*lu.Value) != len(*other.Value) {
+			return false
+		}
+		for i := range *lu.Value {
+			if (*lu.Value)[i] != (*other.Value)[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ListUUID to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (lu *ListUUID) MergeFrom(patch ListUUID) {
+	if patch.Value != nil {
+		var v []uuid.UUID
+		if *patch.Value != nil {
+			v = make([]uuid.UUID, len(*patch.Value))
+			copy(v, *patch.Value)
+		}
+		lu.Value = &v
+	}
+}
+
 // Product ...
 type Product struct {
 	Integer *int32  `json:"integer,omitempty"`
 	String  *string `json:"string,omitempty"`
 }
+
+// DeepCopy returns a copy of the Product that doesn't share any pointers, slices or maps with it.
+func (p Product) DeepCopy() Product {
+	result := p
+	if p.Integer != nil {
+		v := *p.Integer
+		result.Integer = &v
+	}
+	if p.String != nil {
+		v := *p.String
+		result.String = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Product and other are equal.  Times are equal if they represent the same
+// instant.
+func (p Product) Equal(other Product) bool {
+	return p.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (p Product) EqualIgnoreReadOnly(other Product) bool {
+	return p.equal(other, true)
+}
+
+// equal compares the fields of the Product and other, skipping the read-only ones if ignoreReadOnly is true.
+func (p Product) equal(other Product, ignoreReadOnly bool) bool {
+	if (p.Integer == nil) != (other.Integer == nil) || (p.Integer != nil && *p.Integer != *other.Integer) {
+		return false
+	}
+	if (p.String == nil) != (other.String == nil) || (p.String != nil && *p.String != *other.String) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Product to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields aren't changed.
+func (p *Product) MergeFrom(patch Product) {
+	if patch.Integer != nil {
+		v := *patch.Integer
+		p.Integer = &v
+	}
+	if patch.String != nil {
+		v := *patch.String
+		p.String = &v
+	}
+}
+
+// RequestOptions contains the per-call options applied to the requests of operations whose context was created with WithRequestOptions.
+type RequestOptions struct {
+	// Header - additional headers to send.  They replace any values of the same headers set by the operation.
+	Header http.Header
+	// Query - additional query parameters to send.  They replace any values of the same parameters set by the operation.
+	Query url.Values
+	// Timeout - if greater than zero, the maximum amount of time allowed for sending the request, including retries, and reading its response.
+	Timeout time.Duration
+}
+
+type requestOptionsKey struct{}
+
+// WithRequestOptions returns a copy of ctx carrying opts.  Operations called with the returned
+// context apply opts to their requests; other calls aren't affected.
+func WithRequestOptions(ctx context.Context, opts RequestOptions) context.Context {
+	return context.WithValue(ctx, requestOptionsKey{}, opts)
+}
+
+// withRequestOptions returns a PrepareDecorator that merges the headers and query parameters
+// of the RequestOptions carried by the request's context into the request.
+func withRequestOptions() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+			opts, ok := r.Context().Value(requestOptionsKey{}).(RequestOptions)
+			if !ok {
+				return r, nil
+			}
+			if len(opts.Header) > 0 {
+				if r.Header == nil {
+					r.Header = make(http.Header)
+				}
+				for k, v := range opts.Header {
+					r.Header[http.CanonicalHeaderKey(k)] = v
+				}
+			}
+			if len(opts.Query) > 0 {
+				q := r.URL.Query()
+				for k, v := range opts.Query {
+					q[k] = v
+				}
+				r.URL.RawQuery = q.Encode()
+			}
+			return r, nil
+		})
+	}
+}
+
+// sendWithRequestOptions sends the request like autorest.SendWithSender.  If the request's context
+// carries a RequestOptions with a Timeout, the request is cancelled once the timeout elapses
+// or the response body is closed, whichever happens first.
+func sendWithRequestOptions(s autorest.Sender, r *http.Request, decorators ...autorest.SendDecorator) (*http.Response, error) {
+	opts, ok := r.Context().Value(requestOptionsKey{}).(RequestOptions)
+	if !ok || opts.Timeout <= 0 {
+		return autorest.SendWithSender(s, r, decorators...)
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), opts.Timeout)
+	resp, err := autorest.SendWithSender(s, r.WithContext(ctx), decorators...)
+	if err != nil || resp == nil || resp.Body == nil {
+		cancel()
+		return resp, err
+	}
+	resp.Body = requestOptionsBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// requestOptionsBody releases the timeout of a request once its response body is closed.
+type requestOptionsBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b requestOptionsBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+// ValidationError describes a value that violates one of the constraints defined by the service.
+type ValidationError struct {
+	// Path - the JSON path of the value, relative to the value being validated.
+	Path string
+	// Constraint - the name of the violated constraint, e.g. MaxLength.
+	Constraint string
+	// Details - describes the violation.
+	Details string
+}
+
+// Error implements the error interface for type ValidationError.
+func (ve ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s (%s)", ve.Path, ve.Details, ve.Constraint)
+}
+
+// ValidationErrors contains every constraint violation found when validating a value.
+type ValidationErrors []ValidationError
+
+// Error implements the error interface for type ValidationErrors.
+func (ve ValidationErrors) Error() string {
+	msgs := make([]string, len(ve))
+	for i := range ve {
+		msgs[i] = ve[i].Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// addNested appends the violations in err, as returned by the Validate method
+// of the value at path, to ve after prefixing their paths with path.
+func (ve ValidationErrors) addNested(path string, err error) ValidationErrors {
+	if nested, ok := err.(ValidationErrors); ok {
+		for _, e := range nested {
+			e.Path = path + "." + e.Path
+			ve = append(ve, e)
+		}
+	} else if err != nil {
+		ve = append(ve, ValidationError{Path: path, Details: err.Error()})
+	}
+	return ve
+}
+
+// ParameterValidationError is returned by an operation whose parameters violate the constraints defined
+// by the service.  Use errors.As to retrieve the validation.Error or the ValidationErrors it wraps.
+type ParameterValidationError struct {
+	// Err - identifies the operation, its message lists every violation.
+	Err validation.Error
+	// Errors - contains every violation.
+	Errors ValidationErrors
+}
+
+// Error implements the error interface for type ParameterValidationError.
+func (pve ParameterValidationError) Error() string {
+	return pve.Err.Error()
+}
+
+// Unwrap returns the validation.Error and the ValidationErrors wrapped by the ParameterValidationError.
+func (pve ParameterValidationError) Unwrap() []error {
+	return []error{pve.Err, pve.Errors}
+}
@@ -11,10 +11,16 @@ package azurereport
 
 import (
 	"context"
+	"crypto/rand"
+	"fmt"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/validation"
 	"github.com/Azure/go-autorest/tracing"
 	"net/http"
+	"net/url"
+	"sync"
+	"time"
 )
 
 const (
@@ -25,7 +31,11 @@ const (
 // BaseClient is the base client for Azurereport.
 type BaseClient struct {
 	autorest.Client
-	BaseURI string
+	BaseURI     string
+	RetryPolicy RetryPolicy
+	Pipeline    []PipelinePolicy
+	Logger      Logger
+	Tracer      Tracer
 }
 
 // New creates an instance of the BaseClient client.
@@ -41,19 +51,292 @@ func NewWithBaseURI(baseURI string) BaseClient {
 	}
 }
 
+// ClientOptions contains the options used to create a BaseClient with NewWithOptions.
+type ClientOptions struct {
+	// BaseURI - the endpoint of the service.  Defaults to DefaultBaseURI.
+	BaseURI string
+	// Authorizer - authorizes the requests sent by the client.  Requests aren't authorized if it's nil.
+	Authorizer autorest.Authorizer
+	// RetryAttempts - the number of times a request is retried after a retriable failure.  Zero uses autorest.DefaultRetryAttempts
+	// and a negative value disables retries.
+	RetryAttempts int
+	// RetryDuration - the delay between retries.  Zero uses autorest.DefaultRetryDuration.
+	RetryDuration time.Duration
+	// RetryPolicy - decides if and when failed requests are retried.  If nil, an ExponentialRetry created from
+	// RetryAttempts and RetryDuration is used.
+	RetryPolicy RetryPolicy
+	// HTTPClient - sends the requests, e.g. an *http.Client.  Defaults to the sender created by autorest.
+	HTTPClient autorest.Sender
+	// Pipeline - the policies the requests go through, in order, before being sent.
+	Pipeline []PipelinePolicy
+	// Logger - receives the events logged by the operations.  Nothing is logged if it's nil.
+	Logger Logger
+	// Tracer - starts the spans tracing the operations.  If nil, the Tracer passed to RegisterTracer is used.  If
+	// that's nil too, the operations are traced by github.com/Azure/go-autorest/tracing when it's enabled.
+	Tracer Tracer
+	// UserAgentSuffix - appended to the User-Agent header of the requests.
+	UserAgentSuffix string
+}
+
+// NewWithOptions creates an instance of the BaseClient client with the specified options.  It returns an error if the
+// options are invalid.
+func NewWithOptions(opts ClientOptions) (BaseClient, error) {
+	if opts.BaseURI == "" {
+		opts.BaseURI = DefaultBaseURI
+	} else if u, err := url.Parse(opts.BaseURI); err != nil || !u.IsAbs() || u.Host == "" {
+		return BaseClient{}, validation.NewError("azurereport.BaseClient", "NewWithOptions", "BaseURI %q isn't an absolute URI", opts.BaseURI)
+	}
+	if opts.RetryDuration < 0 {
+		return BaseClient{}, validation.NewError("azurereport.BaseClient", "NewWithOptions", "RetryDuration can't be negative")
+	}
+	client := BaseClient{
+		Client:  autorest.NewClientWithUserAgent(UserAgent()),
+		BaseURI: opts.BaseURI,
+	}
+	if opts.Authorizer != nil {
+		client.Authorizer = opts.Authorizer
+	}
+	if opts.RetryAttempts > 0 {
+		client.RetryAttempts = opts.RetryAttempts
+	} else if opts.RetryAttempts < 0 {
+		client.RetryAttempts = 0
+	}
+	if opts.RetryDuration > 0 {
+		client.RetryDuration = opts.RetryDuration
+	}
+	client.RetryPolicy = opts.RetryPolicy
+	if opts.HTTPClient != nil {
+		client.Sender = opts.HTTPClient
+	}
+	client.Pipeline = opts.Pipeline
+	client.Logger = opts.Logger
+	client.Tracer = opts.Tracer
+	if opts.UserAgentSuffix != "" {
+		client.AddToUserAgent(opts.UserAgentSuffix)
+	}
+	return client, nil
+}
+
+// PipelinePolicy is a step of the pipeline the requests sent by a client go through.  It can modify the request, pass it
+// to next to send it, and inspect or replace the response.  The policies run for each attempt to send a request.
+type PipelinePolicy func(req *http.Request, next autorest.Sender) (*http.Response, error)
+
+// pipeline returns a Sender that runs requests through the Pipeline of the client, in order, before sending them.
+func (client BaseClient) pipeline() autorest.Sender {
+	var s autorest.Sender = client.Client
+	for i := len(client.Pipeline) - 1; i >= 0; i-- {
+		policy, next := client.Pipeline[i], s
+		s = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			return policy(r, next)
+		})
+	}
+	return s
+}
+
+// NewTelemetryPolicy returns a PipelinePolicy that passes each request, its outcome and the time it took to record, e.g.
+// to update metrics.
+func NewTelemetryPolicy(record func(req *http.Request, resp *http.Response, err error, elapsed time.Duration)) PipelinePolicy {
+	return func(req *http.Request, next autorest.Sender) (*http.Response, error) {
+		start := time.Now()
+		resp, err := next.Do(req)
+		record(req, resp, err, time.Since(start))
+		return resp, err
+	}
+}
+
+// NewLoggingPolicy returns a PipelinePolicy that passes a line describing each request and its outcome to logf, e.g.
+// log.Printf.  The user information and the query of the URL aren't logged as they can contain secrets.
+func NewLoggingPolicy(logf func(format string, v ...interface{})) PipelinePolicy {
+	return NewTelemetryPolicy(func(req *http.Request, resp *http.Response, err error, elapsed time.Duration) {
+		u := *req.URL
+		u.User = nil
+		u.RawQuery = ""
+		if err != nil {
+			logf("%s %s failed after %v: %v", req.Method, u.String(), elapsed, err)
+		} else {
+			logf("%s %s returned %d after %v", req.Method, u.String(), resp.StatusCode, elapsed)
+		}
+	})
+}
+
+// NewRequestIDPolicy returns a PipelinePolicy that stamps each request with a unique ID in the specified header, e.g.
+// x-ms-client-request-id, unless the request already has one.  Retries of a request send the same ID.
+func NewRequestIDPolicy(header string) PipelinePolicy {
+	return func(req *http.Request, next autorest.Sender) (*http.Response, error) {
+		if req.Header.Get(header) == "" {
+			if req.Header == nil {
+				req.Header = http.Header{}
+			}
+			var id [16]byte
+			if _, err := rand.Read(id[:]); err != nil {
+				return nil, err
+			}
+			// format the ID as a version 4 UUID
+			id[6] = id[6]&0x0f | 0x40
+			id[8] = id[8]&0x3f | 0x80
+			req.Header.Set(header, fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:]))
+		}
+		return next.Do(req)
+	}
+}
+
+// Attributes set on the spans of operations.
+const (
+	// AttributeHTTPMethod is the HTTP method of the operation.
+	AttributeHTTPMethod = "http.method"
+	// AttributeURLTemplate is the template of the URL path of the operation.
+	AttributeURLTemplate = "http.url_template"
+	// AttributeStatusCode is the status code of the last response received.
+	AttributeStatusCode = "http.status_code"
+	// AttributeRetryCount is the number of times the request was retried.
+	AttributeRetryCount = "http.retry_count"
+)
+
+// Tracer starts the spans tracing the operations of clients, e.g. an adapter to OpenTelemetry.
+type Tracer interface {
+	// StartSpan starts a span named name as a child of the span in ctx, if any, and returns a context containing the
+	// new span.
+	StartSpan(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a span started by a Tracer.
+type Span interface {
+	// SetAttribute sets the value of an attribute of the span.
+	SetAttribute(key string, value interface{})
+	// End ends the span.  err is the error the traced call failed with, if any.
+	End(err error)
+}
+
+// registeredTracer is the Tracer used by the clients whose Tracer is nil, guarded by registeredTracerMu.
+var (
+	registeredTracerMu sync.RWMutex
+	registeredTracer   Tracer
+)
+
+// RegisterTracer sets the Tracer used by the clients whose Tracer is nil.  Pass nil to trace them with
+// github.com/Azure/go-autorest/tracing, which only traces them when it's enabled.  It's safe to call RegisterTracer
+// while operations are running: the operations started after it returns use tracer.
+func RegisterTracer(tracer Tracer) {
+	registeredTracerMu.Lock()
+	defer registeredTracerMu.Unlock()
+	registeredTracer = tracer
+}
+
+// tracer returns the Tracer of the client, or the registered one if it's nil.  If neither is set it returns an
+// adapter to github.com/Azure/go-autorest/tracing when it's enabled, and nil otherwise.
+func (client BaseClient) tracer() Tracer {
+	if client.Tracer != nil {
+		return client.Tracer
+	}
+	registeredTracerMu.RLock()
+	tracer := registeredTracer
+	registeredTracerMu.RUnlock()
+	if tracer != nil {
+		return tracer
+	}
+	if tracing.IsEnabled() {
+		return autorestTracer{}
+	}
+	return nil
+}
+
+// autorestTracer is a Tracer starting the OpenCensus spans of github.com/Azure/go-autorest/tracing.
+type autorestTracer struct{}
+
+// StartSpan implements the Tracer interface for autorestTracer.
+func (autorestTracer) StartSpan(ctx context.Context, name string) (context.Context, Span) {
+	ctx = tracing.StartSpan(ctx, name)
+	return ctx, &autorestSpan{ctx: ctx, statusCode: -1}
+}
+
+// autorestSpan is a span started by autorestTracer.  go-autorest/tracing only records the status code of a span, the
+// other attributes are ignored.
+type autorestSpan struct {
+	ctx        context.Context
+	statusCode int
+}
+
+// SetAttribute implements the Span interface for autorestSpan.
+func (as *autorestSpan) SetAttribute(key string, value interface{}) {
+	if sc, ok := value.(int); ok && key == AttributeStatusCode {
+		as.statusCode = sc
+	}
+}
+
+// End implements the Span interface for autorestSpan.
+func (as *autorestSpan) End(err error) {
+	tracing.EndSpan(as.ctx, as.statusCode, err)
+}
+
+// traceSpan is a span started by startSpan, carried by the contexts of the requests of the traced call.
+type traceSpan struct {
+	tracer Tracer
+	name   string
+	span   Span
+}
+
+// traceSpanKey is the key of the traceSpan in a context.
+type traceSpanKey struct{}
+
+// startSpan starts a span named name with tracer and returns a context carrying it.  The HTTP method and the URL
+// template of the operation are set on the span unless they're empty.
+func startSpan(ctx context.Context, tracer Tracer, name, method, urlTemplate string) context.Context {
+	ctx, span := tracer.StartSpan(ctx, name)
+	if method != "" {
+		span.SetAttribute(AttributeHTTPMethod, method)
+	}
+	if urlTemplate != "" {
+		span.SetAttribute(AttributeURLTemplate, urlTemplate)
+	}
+	return context.WithValue(ctx, traceSpanKey{}, &traceSpan{tracer: tracer, name: name, span: span})
+}
+
+// startChildSpan starts a child of the span carried by ctx, named after it with suffix appended, and returns a context
+// carrying it.  It returns ctx if it doesn't carry a span.
+func startChildSpan(ctx context.Context, suffix string) context.Context {
+	ts, ok := ctx.Value(traceSpanKey{}).(*traceSpan)
+	if !ok {
+		return ctx
+	}
+	return startSpan(ctx, ts.tracer, ts.name+"/"+suffix, "", "")
+}
+
+// setSpanAttribute sets an attribute of the span carried by ctx, if any.
+func setSpanAttribute(ctx context.Context, key string, value interface{}) {
+	if ts, ok := ctx.Value(traceSpanKey{}).(*traceSpan); ok {
+		ts.span.SetAttribute(key, value)
+	}
+}
+
+// endSpan ends the span carried by ctx, if any, setting the status code of resp on it if resp isn't nil.
+func endSpan(ctx context.Context, resp *http.Response, err error) {
+	ts, ok := ctx.Value(traceSpanKey{}).(*traceSpan)
+	if !ok {
+		return
+	}
+	if resp != nil {
+		ts.span.SetAttribute(AttributeStatusCode, resp.StatusCode)
+	}
+	ts.span.End(err)
+}
+
 // GetReport get test coverage report
 // Parameters:
 // qualifier - if specified, qualifies the generated report further (e.g. '2.7' vs '3.5' in for Python). The
 // only effect is, that generators that run all tests several times, can distinguish the generated reports.
 func (client BaseClient) GetReport(ctx context.Context, qualifier string) (result SetInt32, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.GetReport")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BaseClient.GetReport", "GET", "/report/azure")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BaseClient.GetReport", map[string]interface{}{
+			"qualifier": qualifier,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetReportPreparer(ctx, qualifier)
@@ -89,14 +372,15 @@ func (client BaseClient) GetReportPreparer(ctx context.Context, qualifier string
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/report/azure"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetReportSender sends the GetReport request. The method will close the
 // http.Response Body if it receives an error.
 func (client BaseClient) GetReportSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetReportResponder handles the response to the GetReport request. The method always
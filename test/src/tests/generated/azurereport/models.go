@@ -7,8 +7,22 @@ package azurereport
 // Changes may cause incorrect behavior and will be lost if the code is regenerated.
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/Azure/go-autorest/autorest"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // The package's fully qualified name.
@@ -20,6 +34,486 @@ type Error struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// DeepCopy returns a copy of the Error that doesn't share any pointers, slices or maps with it.
+func (e Error) DeepCopy() Error {
+	result := e
+	if e.Status != nil {
+		v := *e.Status
+		result.Status = &v
+	}
+	if e.Message != nil {
+		v := *e.Message
+		result.Message = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Error and other are equal.  Times are equal if they represent the same
+// instant.
+func (e Error) Equal(other Error) bool {
+	return e.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (e Error) EqualIgnoreReadOnly(other Error) bool {
+	return e.equal(other, true)
+}
+
+// equal compares the fields of the Error and other, skipping the read-only ones if ignoreReadOnly is true.
+func (e Error) equal(other Error, ignoreReadOnly bool) bool {
+	if (e.Status == nil) != (other.Status == nil) || (e.Status != nil && *e.Status != *other.Status) {
+		return false
+	}
+	if (e.Message == nil) != (other.Message == nil) || (e.Message != nil && *e.Message != *other.Message) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Error to copies of the fields set in patch.  Nested models and maps are merged, the
+// values of the other fields are replaced.  The read-only fields aren't changed.
+func (e *Error) MergeFrom(patch Error) {
+	if patch.Status != nil {
+		v := *patch.Status
+		e.Status = &v
+	}
+	if patch.Message != nil {
+		v := *patch.Message
+		e.Message = &v
+	}
+}
+
+// ErrorResponse is returned when an operation fails with a response containing the Error model.  Unlike the other
+// errors of the operations it isn't wrapped in an autorest.DetailedError.
+type ErrorResponse struct {
+	// Model - the error model unmarshalled from the response body.
+	Model Error
+	// StatusCode - the HTTP status code of the response.
+	StatusCode int
+	// Body - the raw response body.
+	Body []byte
+	// Err - the error encountered reading or unmarshalling the response body, if any.
+	Err error
+}
+
+// Error implements the error interface for type ErrorResponse.
+func (er ErrorResponse) Error() string {
+	if er.Err != nil {
+		return fmt.Sprintf("azurereport: StatusCode=%d Body=%s Err=%v", er.StatusCode, string(er.Body), er.Err)
+	}
+	return fmt.Sprintf("azurereport: StatusCode=%d Body=%s", er.StatusCode, string(er.Body))
+}
+
+// Unwrap returns the error encountered reading or unmarshalling the response body, if any.
+func (er ErrorResponse) Unwrap() error {
+	return er.Err
+}
+
+// withErrorResponseUnlessStatusCode returns a RespondDecorator that returns an ErrorResponse if the
+// response's status code isn't one of the specified codes.  The response body is left available to the caller.
+// When the body can't be read or unmarshalled the returned ErrorResponse wraps the error in its Err field.
+func withErrorResponseUnlessStatusCode(codes ...int) autorest.RespondDecorator {
+	return func(r autorest.Responder) autorest.Responder {
+		return autorest.ResponderFunc(func(resp *http.Response) error {
+			err := r.Respond(resp)
+			if err != nil || autorest.ResponseHasStatusCode(resp, codes...) {
+				return err
+			}
+			defer resp.Body.Close()
+			er := ErrorResponse{StatusCode: resp.StatusCode}
+			er.Body, er.Err = ioutil.ReadAll(resp.Body)
+			resp.Body = ioutil.NopCloser(bytes.NewReader(er.Body))
+			if er.Err == nil && len(bytes.TrimSpace(er.Body)) > 0 {
+				er.Err = json.Unmarshal(er.Body, &er.Model)
+			}
+			return er
+		})
+	}
+}
+
+// ExponentialRetry is a RetryPolicy that retries failed requests with exponentially increasing delays.  When the
+// RetryPolicy of a client is nil one is created from its RetryAttempts and RetryDuration.  Unlike
+// autorest.DoRetryForStatusCodes it only retries idempotent requests unless RetryNonIdempotent is true, so POST and
+// PATCH requests, including the initial requests of long-running operations, aren't retried unless the service marks
+// their operation x-ms-idempotent.  Responses with status code 429 count against MaxRetries.
+type ExponentialRetry struct {
+	// MaxRetries - the maximum number of times a request is retried.
+	MaxRetries int
+	// Delay - the delay before the first retry.  The delay is doubled for each following retry.
+	Delay time.Duration
+	// MaxDelay - the maximum delay between retries.  Zero means no limit.
+	MaxDelay time.Duration
+	// MaxElapsed - requests aren't retried once the delay would exceed this much time since the first attempt.  Zero means no limit.
+	MaxElapsed time.Duration
+	// Jitter - the fraction, from 0 to 1, of each delay that's randomized to spread out the retries of concurrent requests.
+	Jitter float64
+	// StatusCodes - the status codes of the responses that are retried.  Defaults to autorest.StatusCodesForRetry.
+	StatusCodes []int
+	// RetryNonIdempotent - if true, requests that aren't idempotent, e.g. POST requests of operations the service doesn't mark as idempotent, are retried too.
+	RetryNonIdempotent bool
+}
+
+// defaultRetryJitter is the Jitter of the ExponentialRetry used when the RetryPolicy of a client is nil.
+const defaultRetryJitter = 0.2
+
+// RetryPolicy decides if and when a failed attempt to send a request is retried.
+type RetryPolicy interface {
+	// ShouldRetry returns the delay before the request is sent again, or false if it isn't retried.
+	ShouldRetry(attempt RetryAttempt) (time.Duration, bool)
+}
+
+// LogEvent is an event logged by the operations of a client when its Logger isn't nil.  The URLs and headers of the
+// requests aren't included as they can contain secrets.
+type LogEvent struct {
+	// Kind - the kind of the event.
+	Kind LogEventKind
+	// Operation - the name of the operation, qualified by the name of its client.
+	Operation string
+	// Fields - the parameters of the operation keyed by their names in the request, for LogOperationStart events.  Body parameters aren't included and the values of secret parameters are replaced with REDACTED.
+	Fields map[string]interface{}
+	// Attempt - the number of the failed attempt, starting at one, for LogRetry events.
+	Attempt int
+	// Delay - the delay before the request is sent again, for LogRetry events.
+	Delay time.Duration
+	// State - the status of the long-running operation, for LogPoll events.
+	State string
+	// StatusCode - the status code of the response, or zero if none was received.
+	StatusCode int
+	// Elapsed - the time elapsed since the operation, the page fetch or the polling started.
+	Elapsed time.Duration
+	// Err - the error the operation, the attempt or the poll failed with, if any.
+	Err error
+}
+
+// redactedLogValue replaces the values of secret parameters in the Fields of a LogEvent.
+const redactedLogValue = "REDACTED"
+
+// LogEventKind is the kind of a LogEvent.
+type LogEventKind string
+
+const (
+	// LogOperationStart is logged when an operation starts, with its parameters.
+	LogOperationStart LogEventKind = "OperationStart"
+	// LogOperationFinish is logged when an operation finishes.
+	LogOperationFinish LogEventKind = "OperationFinish"
+	// LogRetry is logged when a failed attempt to send a request is retried.
+	LogRetry LogEventKind = "Retry"
+	// LogPageFetch is logged when the next page of the results of an operation has been fetched.
+	LogPageFetch LogEventKind = "PageFetch"
+	// LogPoll is logged each time PollUntilDone has polled the status of a long-running operation.
+	LogPoll LogEventKind = "Poll"
+)
+
+// Logger receives the events logged by the operations of a client.
+type Logger interface {
+	// Log is called synchronously for each event.
+	Log(event LogEvent)
+}
+
+// LoggerFunc is a function implementing the Logger interface.
+type LoggerFunc func(event LogEvent)
+
+// Log implements the Logger interface for LoggerFunc.
+func (lf LoggerFunc) Log(event LogEvent) {
+	lf(event)
+}
+
+// String formats the event as a line of key=value pairs, e.g. to pass to log.Print.
+func (le LogEvent) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "event=%s operation=%s", le.Kind, le.Operation)
+	names := make([]string, 0, len(le.Fields))
+	for name := range le.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&b, " %s=%v", name, le.Fields[name])
+	}
+	if le.Attempt > 0 {
+		fmt.Fprintf(&b, " attempt=%d", le.Attempt)
+	}
+	if le.State != "" {
+		fmt.Fprintf(&b, " state=%s", le.State)
+	}
+	if le.StatusCode > 0 {
+		fmt.Fprintf(&b, " status=%d", le.StatusCode)
+	}
+	if le.Delay > 0 {
+		fmt.Fprintf(&b, " delay=%v", le.Delay)
+	}
+	if le.Kind != LogOperationStart {
+		fmt.Fprintf(&b, " elapsed=%v", le.Elapsed)
+	}
+	if le.Err != nil {
+		fmt.Fprintf(&b, " error=%q", le.Err.Error())
+	}
+	return b.String()
+}
+
+// operationLog is the state used to log the events of an operation, carried by the contexts of its requests.
+type operationLog struct {
+	logger    Logger
+	operation string
+	start     time.Time
+}
+
+// operationLogKey is the key of the operationLog in a context.
+type operationLogKey struct{}
+
+// withOperationLog returns a context carrying the state used to log the events of operation to logger.
+func withOperationLog(ctx context.Context, logger Logger, operation string) context.Context {
+	return context.WithValue(ctx, operationLogKey{}, &operationLog{logger: logger, operation: operation, start: time.Now()})
+}
+
+// startOperationLog logs the start of operation with its parameters, then returns a context carrying the state used to
+// log its other events.
+func startOperationLog(ctx context.Context, logger Logger, operation string, fields map[string]interface{}) context.Context {
+	for name, value := range fields {
+		// log the values of optional parameters rather than their addresses
+		v := reflect.ValueOf(value)
+		for v.Kind() == reflect.Ptr && !v.IsNil() {
+			v = v.Elem()
+		}
+		if v.Kind() == reflect.Ptr {
+			fields[name] = nil
+		} else if v.IsValid() {
+			fields[name] = v.Interface()
+		}
+	}
+	ctx = withOperationLog(ctx, logger, operation)
+	logger.Log(LogEvent{Kind: LogOperationStart, Operation: operation, Fields: fields})
+	return ctx
+}
+
+// logResponse logs event for the operation whose state is carried by ctx, if any, along with the status code of resp
+// if it isn't nil.
+func logResponse(ctx context.Context, event LogEvent, resp *http.Response) {
+	ol, ok := ctx.Value(operationLogKey{}).(*operationLog)
+	if !ok {
+		return
+	}
+	event.Operation = ol.operation
+	event.Elapsed = time.Since(ol.start)
+	if resp != nil {
+		event.StatusCode = resp.StatusCode
+	}
+	ol.logger.Log(event)
+}
+
+// RetryAttempt describes a failed attempt to send a request.
+type RetryAttempt struct {
+	// Request - the request that was sent.
+	Request *http.Request
+	// Response - the response received, or nil if sending the request failed.
+	Response *http.Response
+	// Err - the error returned when sending the request, if any.
+	Err error
+	// Attempt - the number of the attempt, starting at one.
+	Attempt int
+	// Elapsed - the time elapsed since the first attempt was sent.
+	Elapsed time.Duration
+	// Idempotent - true if the request can safely be sent more than once, either because its HTTP method is
+	// idempotent or because the service marks the operation as idempotent.
+	Idempotent bool
+}
+
+// ShouldRetry implements the RetryPolicy interface for ExponentialRetry.  A delay requested by the Retry-After header
+// of the response takes precedence over the computed one.
+func (er ExponentialRetry) ShouldRetry(attempt RetryAttempt) (time.Duration, bool) {
+	if attempt.Attempt > er.MaxRetries || !(attempt.Idempotent || er.RetryNonIdempotent) {
+		return 0, false
+	}
+	if attempt.Err != nil {
+		if !autorest.IsTemporaryNetworkError(attempt.Err) || autorest.IsTokenRefreshError(attempt.Err) {
+			return 0, false
+		}
+	} else {
+		codes := er.StatusCodes
+		if codes == nil {
+			codes = autorest.StatusCodesForRetry
+		}
+		if !autorest.ResponseHasStatusCode(attempt.Response, codes...) {
+			return 0, false
+		}
+	}
+	delay, ok := retryAfter(attempt.Response)
+	if !ok {
+		delay = er.Delay
+		for i := 1; i < attempt.Attempt && delay < math.MaxInt64/2; i++ {
+			delay *= 2
+		}
+		if er.MaxDelay > 0 && delay > er.MaxDelay {
+			delay = er.MaxDelay
+		}
+		if er.Jitter > 0 {
+			delay -= time.Duration(math.Min(er.Jitter, 1) * rand.Float64() * float64(delay))
+		}
+	}
+	if er.MaxElapsed > 0 && attempt.Elapsed+delay > er.MaxElapsed {
+		return 0, false
+	}
+	return delay, true
+}
+
+// retryAfter returns the delay requested by the Retry-After header of resp, and false if it doesn't have one.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	ra := resp.Header.Get("Retry-After")
+	if ra == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(ra); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(ra); err == nil {
+		if delay := time.Until(t); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// isIdempotentMethod returns true if requests using the HTTP method can safely be sent more than once.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodTrace:
+		return true
+	}
+	return false
+}
+
+// withRetryPolicy returns a SendDecorator that sends a request again after each failed attempt policy decides to retry.
+// If policy is nil an ExponentialRetry created from the RetryAttempts and RetryDuration of c is used.  idempotent is
+// true for operations the service marks as idempotent.
+func withRetryPolicy(policy RetryPolicy, c autorest.Client, idempotent bool) autorest.SendDecorator {
+	if policy == nil {
+		policy = ExponentialRetry{MaxRetries: c.RetryAttempts, Delay: c.RetryDuration, Jitter: defaultRetryJitter}
+	}
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+			idempotent := idempotent || isIdempotentMethod(r.Method)
+			start := time.Now()
+			for attempt := 1; ; attempt++ {
+				if err := rr.Prepare(); err != nil {
+					return nil, err
+				}
+				resp, err := s.Do(rr.Request())
+				if r.Context().Err() != nil {
+					return resp, err
+				}
+				delay, retry := policy.ShouldRetry(RetryAttempt{
+					Request:    rr.Request(),
+					Response:   resp,
+					Err:        err,
+					Attempt:    attempt,
+					Elapsed:    time.Since(start),
+					Idempotent: idempotent,
+				})
+				if !retry {
+					return resp, err
+				}
+				logResponse(r.Context(), LogEvent{Kind: LogRetry, Attempt: attempt, Delay: delay, Err: err}, resp)
+				setSpanAttribute(r.Context(), AttributeRetryCount, attempt)
+				if resp != nil {
+					autorest.Respond(resp, autorest.ByDiscardingBody(), autorest.ByClosing())
+				}
+				timer := time.NewTimer(delay)
+				select {
+				case <-timer.C:
+				case <-r.Context().Done():
+					timer.Stop()
+					return nil, r.Context().Err()
+				}
+			}
+		})
+	}
+}
+
+// RequestOptions contains the per-call options applied to the requests of operations whose context was created with WithRequestOptions.
+type RequestOptions struct {
+	// Header - additional headers to send.  They replace any values of the same headers set by the operation.
+	Header http.Header
+	// Query - additional query parameters to send.  They replace any values of the same parameters set by the operation.
+	Query url.Values
+	// Timeout - if greater than zero, the maximum amount of time allowed for sending the request, including retries, and reading its response.
+	Timeout time.Duration
+}
+
+type requestOptionsKey struct{}
+
+// WithRequestOptions returns a copy of ctx carrying opts.  Operations called with the returned
+// context apply opts to their requests; other calls aren't affected.
+func WithRequestOptions(ctx context.Context, opts RequestOptions) context.Context {
+	return context.WithValue(ctx, requestOptionsKey{}, opts)
+}
+
+// withRequestOptions returns a PrepareDecorator that merges the headers and query parameters
+// of the RequestOptions carried by the request's context into the request.
+func withRequestOptions() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+			opts, ok := r.Context().Value(requestOptionsKey{}).(RequestOptions)
+			if !ok {
+				return r, nil
+			}
+			if len(opts.Header) > 0 {
+				if r.Header == nil {
+					r.Header = make(http.Header)
+				}
+				for k, v := range opts.Header {
+					r.Header[http.CanonicalHeaderKey(k)] = v
+				}
+			}
+			if len(opts.Query) > 0 {
+				q := r.URL.Query()
+				for k, v := range opts.Query {
+					q[k] = v
+				}
+				r.URL.RawQuery = q.Encode()
+			}
+			return r, nil
+		})
+	}
+}
+
+// sendWithRequestOptions sends the request like autorest.SendWithSender.  If the request's context
+// carries a RequestOptions with a Timeout, the request is cancelled once the timeout elapses
+// or the response body is closed, whichever happens first.
+func sendWithRequestOptions(s autorest.Sender, r *http.Request, decorators ...autorest.SendDecorator) (*http.Response, error) {
+	opts, ok := r.Context().Value(requestOptionsKey{}).(RequestOptions)
+	if !ok || opts.Timeout <= 0 {
+		return autorest.SendWithSender(s, r, decorators...)
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), opts.Timeout)
+	resp, err := autorest.SendWithSender(s, r.WithContext(ctx), decorators...)
+	if err != nil || resp == nil || resp.Body == nil {
+		cancel()
+		return resp, err
+	}
+	resp.Body = requestOptionsBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// requestOptionsBody releases the timeout of a request once its response body is closed.
+type requestOptionsBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b requestOptionsBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
 // SetInt32 ...
 type SetInt32 struct {
 	autorest.Response `json:"-"`
@@ -34,3 +528,62 @@ func (si3 SetInt32) MarshalJSON() ([]byte, error) {
 	}
 	return json.Marshal(objectMap)
 }
+
+// DeepCopy returns a copy of the SetInt32 that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (si3 SetInt32) DeepCopy() SetInt32 {
+	result := si3
+	if si3.Value != nil {
+		result.Value = make(map[string]*int32, len(si3.Value))
+		for k, e := range si3.Value {
+			if e != nil {
+				v := *e
+				e = &v
+			}
+			result.Value[k] = e
+		}
+	}
+	return result
+}
+
+// Equal returns true if the fields of the SetInt32 and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (si3 SetInt32) Equal(other SetInt32) bool {
+	return si3.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (si3 SetInt32) EqualIgnoreReadOnly(other SetInt32) bool {
+	return si3.equal(other, true)
+}
+
+// equal compares the fields of the SetInt32 and other, skipping the read-only ones if ignoreReadOnly is true.
+func (si3 SetInt32) equal(other SetInt32, ignoreReadOnly bool) bool {
+	if (si3.Value == nil) != (other.Value == nil) || len(si3.Value) != len(other.Value) {
+		return false
+	}
+	for k, e := range si3.Value {
+		f, ok := other.Value[k]
+		if !ok || (e == nil) != (f == nil) || (e != nil && *e != *f) {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the SetInt32 to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (si3 *SetInt32) MergeFrom(patch SetInt32) {
+	if patch.Value != nil {
+		if si3.Value == nil {
+			si3.Value = make(map[string]*int32, len(patch.Value))
+		}
+		for k, e := range patch.Value {
+			if e != nil {
+				v := *e
+				e = &v
+			}
+			si3.Value[k] = e
+		}
+	}
+}
@@ -9,8 +9,6 @@ package booleangroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -29,16 +27,25 @@ func NewBoolClientWithBaseURI(baseURI string) BoolClient {
 	return BoolClient{NewWithBaseURI(baseURI)}
 }
 
+// NewBoolClientWithOptions creates an instance of the BoolClient client with the specified options.  It returns an
+// error if the options are invalid.
+func NewBoolClientWithOptions(opts ClientOptions) (BoolClient, error) {
+	client, err := NewWithOptions(opts)
+	return BoolClient{client}, err
+}
+
 // GetFalse get false Boolean value
 func (client BoolClient) GetFalse(ctx context.Context) (result BoolModel, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BoolClient.GetFalse")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BoolClient.GetFalse", "GET", "/bool/false")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BoolClient.GetFalse", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetFalsePreparer(ctx)
@@ -55,7 +62,7 @@ func (client BoolClient) GetFalse(ctx context.Context) (result BoolModel, err er
 	}
 
 	result, err = client.GetFalseResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "booleangroup.BoolClient", "GetFalse", resp, "Failure responding to request")
 	}
 
@@ -68,14 +75,15 @@ func (client BoolClient) GetFalsePreparer(ctx context.Context) (*http.Request, e
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/bool/false"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetFalseSender sends the GetFalse request. The method will close the
 // http.Response Body if it receives an error.
 func (client BoolClient) GetFalseSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetFalseResponder handles the response to the GetFalse request. The method always
@@ -84,7 +92,7 @@ func (client BoolClient) GetFalseResponder(resp *http.Response) (result BoolMode
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -93,14 +101,16 @@ func (client BoolClient) GetFalseResponder(resp *http.Response) (result BoolMode
 
 // GetInvalid get invalid Boolean value
 func (client BoolClient) GetInvalid(ctx context.Context) (result BoolModel, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BoolClient.GetInvalid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BoolClient.GetInvalid", "GET", "/bool/invalid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BoolClient.GetInvalid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetInvalidPreparer(ctx)
@@ -117,7 +127,7 @@ func (client BoolClient) GetInvalid(ctx context.Context) (result BoolModel, err
 	}
 
 	result, err = client.GetInvalidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "booleangroup.BoolClient", "GetInvalid", resp, "Failure responding to request")
 	}
 
@@ -130,14 +140,15 @@ func (client BoolClient) GetInvalidPreparer(ctx context.Context) (*http.Request,
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/bool/invalid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetInvalidSender sends the GetInvalid request. The method will close the
 // http.Response Body if it receives an error.
 func (client BoolClient) GetInvalidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetInvalidResponder handles the response to the GetInvalid request. The method always
@@ -146,7 +157,7 @@ func (client BoolClient) GetInvalidResponder(resp *http.Response) (result BoolMo
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -155,14 +166,16 @@ func (client BoolClient) GetInvalidResponder(resp *http.Response) (result BoolMo
 
 // GetNull get null Boolean value
 func (client BoolClient) GetNull(ctx context.Context) (result BoolModel, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BoolClient.GetNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BoolClient.GetNull", "GET", "/bool/null")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BoolClient.GetNull", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetNullPreparer(ctx)
@@ -179,7 +192,7 @@ func (client BoolClient) GetNull(ctx context.Context) (result BoolModel, err err
 	}
 
 	result, err = client.GetNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "booleangroup.BoolClient", "GetNull", resp, "Failure responding to request")
 	}
 
@@ -192,14 +205,15 @@ func (client BoolClient) GetNullPreparer(ctx context.Context) (*http.Request, er
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/bool/null"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetNullSender sends the GetNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client BoolClient) GetNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetNullResponder handles the response to the GetNull request. The method always
@@ -208,7 +222,7 @@ func (client BoolClient) GetNullResponder(resp *http.Response) (result BoolModel
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -217,14 +231,16 @@ func (client BoolClient) GetNullResponder(resp *http.Response) (result BoolModel
 
 // GetTrue get true Boolean value
 func (client BoolClient) GetTrue(ctx context.Context) (result BoolModel, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BoolClient.GetTrue")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BoolClient.GetTrue", "GET", "/bool/true")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BoolClient.GetTrue", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetTruePreparer(ctx)
@@ -241,7 +257,7 @@ func (client BoolClient) GetTrue(ctx context.Context) (result BoolModel, err err
 	}
 
 	result, err = client.GetTrueResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "booleangroup.BoolClient", "GetTrue", resp, "Failure responding to request")
 	}
 
@@ -254,14 +270,15 @@ func (client BoolClient) GetTruePreparer(ctx context.Context) (*http.Request, er
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/bool/true"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetTrueSender sends the GetTrue request. The method will close the
 // http.Response Body if it receives an error.
 func (client BoolClient) GetTrueSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetTrueResponder handles the response to the GetTrue request. The method always
@@ -270,7 +287,7 @@ func (client BoolClient) GetTrueResponder(resp *http.Response) (result BoolModel
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -279,14 +296,16 @@ func (client BoolClient) GetTrueResponder(resp *http.Response) (result BoolModel
 
 // PutFalse set Boolean value false
 func (client BoolClient) PutFalse(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BoolClient.PutFalse")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BoolClient.PutFalse", "PUT", "/bool/false")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BoolClient.PutFalse", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutFalsePreparer(ctx)
@@ -303,7 +322,7 @@ func (client BoolClient) PutFalse(ctx context.Context) (result autorest.Response
 	}
 
 	result, err = client.PutFalseResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "booleangroup.BoolClient", "PutFalse", resp, "Failure responding to request")
 	}
 
@@ -318,14 +337,15 @@ func (client BoolClient) PutFalsePreparer(ctx context.Context) (*http.Request, e
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/bool/false"),
 		autorest.WithJSON(false))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutFalseSender sends the PutFalse request. The method will close the
 // http.Response Body if it receives an error.
 func (client BoolClient) PutFalseSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutFalseResponder handles the response to the PutFalse request. The method always
@@ -334,7 +354,7 @@ func (client BoolClient) PutFalseResponder(resp *http.Response) (result autorest
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -342,14 +362,16 @@ func (client BoolClient) PutFalseResponder(resp *http.Response) (result autorest
 
 // PutTrue set Boolean value true
 func (client BoolClient) PutTrue(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BoolClient.PutTrue")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BoolClient.PutTrue", "PUT", "/bool/true")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BoolClient.PutTrue", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutTruePreparer(ctx)
@@ -366,7 +388,7 @@ func (client BoolClient) PutTrue(ctx context.Context) (result autorest.Response,
 	}
 
 	result, err = client.PutTrueResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "booleangroup.BoolClient", "PutTrue", resp, "Failure responding to request")
 	}
 
@@ -381,14 +403,15 @@ func (client BoolClient) PutTruePreparer(ctx context.Context) (*http.Request, er
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/bool/true"),
 		autorest.WithJSON(true))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutTrueSender sends the PutTrue request. The method will close the
 // http.Response Body if it receives an error.
 func (client BoolClient) PutTrueSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutTrueResponder handles the response to the PutTrue request. The method always
@@ -397,7 +420,7 @@ func (client BoolClient) PutTrueResponder(resp *http.Response) (result autorest.
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
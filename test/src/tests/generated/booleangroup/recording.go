@@ -0,0 +1,335 @@
+package booleangroup
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+//
+// Code generated by Microsoft (R) AutoRest Code Generator.
+// Changes may cause incorrect behavior and will be lost if the code is regenerated.
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/Azure/go-autorest/autorest"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"unicode/utf8"
+)
+
+// RecordingMode selects whether a Recorder sends the requests, records them or plays recorded responses back.
+type RecordingMode string
+
+const (
+	// RecordingModeLive sends the requests without recording them.
+	RecordingModeLive RecordingMode = "live"
+	// RecordingModeRecord sends the requests and records them along with their responses to the cassette.
+	RecordingModeRecord RecordingMode = "record"
+	// RecordingModePlayback responds to the requests with the responses recorded in the cassette, without sending them.
+	RecordingModePlayback RecordingMode = "playback"
+)
+
+// redactedRecordingValue replaces the values of the scrubbed headers and query parameters in a Cassette.
+const redactedRecordingValue = "REDACTED"
+
+// Cassette contains the interactions recorded by a Recorder.  It's saved as JSON.
+type Cassette struct {
+	// Interactions - the recorded interactions, in the order the requests were sent.
+	Interactions []RecordedInteraction `json:"interactions"`
+}
+
+// RecordedInteraction is a request recorded along with its response.
+type RecordedInteraction struct {
+	// Request - the recorded request.
+	Request RecordedRequest `json:"request"`
+	// Response - the recorded response.
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request recorded by a Recorder.
+type RecordedRequest struct {
+	// Method - the HTTP method of the request.
+	Method string `json:"method"`
+	// URL - the URL of the request, without its user information.
+	URL string `json:"url"`
+	// Header - the headers of the request.
+	Header http.Header `json:"header,omitempty"`
+	// Body - the body of the request, base64 encoded if BodyEncoding is base64.
+	Body string `json:"body,omitempty"`
+	// BodyEncoding - base64 if the body isn't valid UTF-8, else empty.
+	BodyEncoding string `json:"bodyEncoding,omitempty"`
+	// BodyHash - the hex encoded SHA-256 hash of the body as it was sent, or empty if the request had no body.
+	BodyHash string `json:"bodyHash,omitempty"`
+}
+
+// RecordedResponse is a response recorded by a Recorder.
+type RecordedResponse struct {
+	// StatusCode - the status code of the response.
+	StatusCode int `json:"statusCode"`
+	// Header - the headers of the response.
+	Header http.Header `json:"header,omitempty"`
+	// Body - the body of the response, base64 encoded if BodyEncoding is base64.
+	Body string `json:"body,omitempty"`
+	// BodyEncoding - base64 if the body isn't valid UTF-8, else empty.
+	BodyEncoding string `json:"bodyEncoding,omitempty"`
+}
+
+// RecordingMatcher selects the parts of a request that must equal those of a recorded request for its response to be
+// played back.  Scrubbed values are compared after scrubbing.
+type RecordingMatcher struct {
+	// Method - match the HTTP method.
+	Method bool
+	// Path - match the path of the URL.
+	Path bool
+	// Query - match the query parameters of the URL, in any order.
+	Query bool
+	// BodyHash - match the hash of the body.
+	BodyHash bool
+}
+
+// DefaultRecordingMatcher matches the HTTP method, the path and the query parameters of the requests.
+var DefaultRecordingMatcher = RecordingMatcher{Method: true, Path: true, Query: true}
+
+func (m RecordingMatcher) matches(recorded, req RecordedRequest) bool {
+	if m.Method && recorded.Method != req.Method {
+		return false
+	}
+	if m.BodyHash && recorded.BodyHash != req.BodyHash {
+		return false
+	}
+	ru, err := url.Parse(recorded.URL)
+	if err != nil {
+		return recorded.URL == req.URL
+	}
+	u, err := url.Parse(req.URL)
+	if err != nil {
+		return false
+	}
+	if m.Path && ru.Path != u.Path {
+		return false
+	}
+	return !m.Query || ru.Query().Encode() == u.Query().Encode()
+}
+
+// RecorderOptions contains the optional settings of a Recorder.
+type RecorderOptions struct {
+	// Mode - whether the requests are sent, recorded or played back.  Defaults to RecordingModeLive.
+	Mode RecordingMode
+	// Matcher - the parts of the requests matched against the recorded ones.  Defaults to DefaultRecordingMatcher.
+	Matcher *RecordingMatcher
+	// ScrubHeaders - the headers whose values are replaced with REDACTED, in addition to Authorization,
+	// Proxy-Authorization, Cookie and Set-Cookie.
+	ScrubHeaders []string
+	// ScrubQueryParameters - the query parameters whose values are replaced with REDACTED, in addition to sig.
+	ScrubQueryParameters []string
+	// Scrubber - if not nil, called with each interaction before it's recorded, e.g. to remove secrets from bodies.  In
+	// playback mode it's called with the requests, along with an empty response, before they're matched.
+	Scrubber func(*RecordedInteraction)
+}
+
+// Recorder is an autorest.Sender that records the requests sent through it and their responses to a cassette file, or
+// plays the responses back from it, so that tests can run without the service.  Use it as the Sender of a client.  It's
+// safe for concurrent use, though the order of the interactions recorded concurrently is undefined.
+type Recorder struct {
+	path     string
+	sender   autorest.Sender
+	opts     RecorderOptions
+	mu       sync.Mutex
+	cassette Cassette
+	played   []bool
+}
+
+// NewRecorder creates a Recorder sending the requests with sender and recording them to the cassette file at path.  In
+// record mode the file is overwritten as the interactions are recorded, and in playback mode it's read by NewRecorder.
+func NewRecorder(path string, sender autorest.Sender, opts RecorderOptions) (*Recorder, error) {
+	if opts.Mode == "" {
+		opts.Mode = RecordingModeLive
+	}
+	if opts.Matcher == nil {
+		matcher := DefaultRecordingMatcher
+		opts.Matcher = &matcher
+	}
+	r := &Recorder{path: path, sender: sender, opts: opts}
+	switch opts.Mode {
+	case RecordingModeLive, RecordingModeRecord:
+	case RecordingModePlayback:
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(b, &r.cassette); err != nil {
+			return nil, fmt.Errorf("failed to read the cassette %s: %v", path, err)
+		}
+		r.played = make([]bool, len(r.cassette.Interactions))
+	default:
+		return nil, fmt.Errorf("unknown recording mode %q", opts.Mode)
+	}
+	return r, nil
+}
+
+// Mode returns the mode of the recorder.
+func (r *Recorder) Mode() RecordingMode {
+	return r.opts.Mode
+}
+
+// Do sends req, records it or plays its response back, depending on the mode of the recorder.  In playback mode the
+// response is the one of the first recorded request matching req that wasn't played back yet.
+func (r *Recorder) Do(req *http.Request) (*http.Response, error) {
+	if r.opts.Mode == RecordingModeLive {
+		return r.sender.Do(req)
+	}
+	body, err := readRecordedBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	interaction := RecordedInteraction{Request: RecordedRequest{
+		Method:   req.Method,
+		URL:      req.URL.String(),
+		Header:   cloneRecordedHeader(req.Header),
+		BodyHash: hashRecordedBody(body),
+	}}
+	interaction.Request.Body, interaction.Request.BodyEncoding = encodeRecordedBody(body)
+	if r.opts.Mode == RecordingModePlayback {
+		r.scrub(&interaction)
+		return r.playback(req, interaction.Request)
+	}
+	resp, err := r.sender.Do(req)
+	if err != nil {
+		return resp, err
+	}
+	body, err = readRecordedBody(&resp.Body)
+	if err != nil {
+		return resp, err
+	}
+	interaction.Response = RecordedResponse{StatusCode: resp.StatusCode, Header: cloneRecordedHeader(resp.Header)}
+	interaction.Response.Body, interaction.Response.BodyEncoding = encodeRecordedBody(body)
+	r.scrub(&interaction)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	return resp, r.save()
+}
+
+func (r *Recorder) playback(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, interaction := range r.cassette.Interactions {
+		if r.played[i] || !r.opts.Matcher.matches(interaction.Request, recorded) {
+			continue
+		}
+		r.played[i] = true
+		body, err := decodeRecordedBody(interaction.Response.Body, interaction.Response.BodyEncoding)
+		if err != nil {
+			return nil, err
+		}
+		header := cloneRecordedHeader(interaction.Response.Header)
+		if header == nil {
+			header = http.Header{}
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          ioutil.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("no interaction recorded in %s matches %s %s", r.path, recorded.Method, recorded.URL)
+}
+
+// scrub redacts the secrets of interaction, then passes it to the Scrubber, if any.
+func (r *Recorder) scrub(interaction *RecordedInteraction) {
+	headers := append([]string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}, r.opts.ScrubHeaders...)
+	for _, h := range []http.Header{interaction.Request.Header, interaction.Response.Header} {
+		for _, name := range headers {
+			if _, ok := h[http.CanonicalHeaderKey(name)]; ok {
+				h.Set(name, redactedRecordingValue)
+			}
+		}
+	}
+	if u, err := url.Parse(interaction.Request.URL); err == nil {
+		u.User = nil
+		query := u.Query()
+		scrubbed := false
+		for _, name := range append([]string{"sig"}, r.opts.ScrubQueryParameters...) {
+			if _, ok := query[name]; ok {
+				query.Set(name, redactedRecordingValue)
+				scrubbed = true
+			}
+		}
+		if scrubbed {
+			u.RawQuery = query.Encode()
+		}
+		interaction.Request.URL = u.String()
+	}
+	if r.opts.Scrubber != nil {
+		r.opts.Scrubber(interaction)
+	}
+}
+
+// save writes the cassette to its file, creating its directory if needed.
+func (r *Recorder) save() error {
+	b, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, b, 0644)
+}
+
+// readRecordedBody reads the body and replaces it with a reader of its content so that it can be read again.
+func readRecordedBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	b, err := ioutil.ReadAll(*body)
+	(*body).Close()
+	*body = ioutil.NopCloser(bytes.NewReader(b))
+	return b, err
+}
+
+func hashRecordedBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+func encodeRecordedBody(body []byte) (string, string) {
+	if utf8.Valid(body) {
+		return string(body), ""
+	}
+	return base64.StdEncoding.EncodeToString(body), "base64"
+}
+
+func decodeRecordedBody(body, encoding string) ([]byte, error) {
+	if encoding == "base64" {
+		return base64.StdEncoding.DecodeString(body)
+	}
+	return []byte(body), nil
+}
+
+func cloneRecordedHeader(h http.Header) http.Header {
+	if h == nil {
+		return nil
+	}
+	clone := make(http.Header, len(h))
+	for k, v := range h {
+		clone[k] = append([]string(nil), v...)
+	}
+	return clone
+}
@@ -9,9 +9,7 @@ package bytegroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/validation"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -30,16 +28,25 @@ func NewByteClientWithBaseURI(baseURI string) ByteClient {
 	return ByteClient{NewWithBaseURI(baseURI)}
 }
 
+// NewByteClientWithOptions creates an instance of the ByteClient client with the specified options.  It returns an
+// error if the options are invalid.
+func NewByteClientWithOptions(opts ClientOptions) (ByteClient, error) {
+	client, err := NewWithOptions(opts)
+	return ByteClient{client}, err
+}
+
 // GetEmpty get empty byte value ''
 func (client ByteClient) GetEmpty(ctx context.Context) (result ByteArray, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ByteClient.GetEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ByteClient.GetEmpty", "GET", "/byte/empty")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ByteClient.GetEmpty", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetEmptyPreparer(ctx)
@@ -56,7 +63,7 @@ func (client ByteClient) GetEmpty(ctx context.Context) (result ByteArray, err er
 	}
 
 	result, err = client.GetEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "bytegroup.ByteClient", "GetEmpty", resp, "Failure responding to request")
 	}
 
@@ -69,14 +76,15 @@ func (client ByteClient) GetEmptyPreparer(ctx context.Context) (*http.Request, e
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/byte/empty"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetEmptySender sends the GetEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client ByteClient) GetEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetEmptyResponder handles the response to the GetEmpty request. The method always
@@ -85,7 +93,7 @@ func (client ByteClient) GetEmptyResponder(resp *http.Response) (result ByteArra
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -94,14 +102,16 @@ func (client ByteClient) GetEmptyResponder(resp *http.Response) (result ByteArra
 
 // GetInvalid get invalid byte value ':::SWAGGER::::'
 func (client ByteClient) GetInvalid(ctx context.Context) (result ByteArray, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ByteClient.GetInvalid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ByteClient.GetInvalid", "GET", "/byte/invalid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ByteClient.GetInvalid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetInvalidPreparer(ctx)
@@ -118,7 +128,7 @@ func (client ByteClient) GetInvalid(ctx context.Context) (result ByteArray, err
 	}
 
 	result, err = client.GetInvalidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "bytegroup.ByteClient", "GetInvalid", resp, "Failure responding to request")
 	}
 
@@ -131,14 +141,15 @@ func (client ByteClient) GetInvalidPreparer(ctx context.Context) (*http.Request,
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/byte/invalid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetInvalidSender sends the GetInvalid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ByteClient) GetInvalidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetInvalidResponder handles the response to the GetInvalid request. The method always
@@ -147,7 +158,7 @@ func (client ByteClient) GetInvalidResponder(resp *http.Response) (result ByteAr
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -156,14 +167,16 @@ func (client ByteClient) GetInvalidResponder(resp *http.Response) (result ByteAr
 
 // GetNonASCII get non-ascii byte string hex(FF FE FD FC FB FA F9 F8 F7 F6)
 func (client ByteClient) GetNonASCII(ctx context.Context) (result ByteArray, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ByteClient.GetNonASCII")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ByteClient.GetNonASCII", "GET", "/byte/nonAscii")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ByteClient.GetNonASCII", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetNonASCIIPreparer(ctx)
@@ -180,7 +193,7 @@ func (client ByteClient) GetNonASCII(ctx context.Context) (result ByteArray, err
 	}
 
 	result, err = client.GetNonASCIIResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "bytegroup.ByteClient", "GetNonASCII", resp, "Failure responding to request")
 	}
 
@@ -193,14 +206,15 @@ func (client ByteClient) GetNonASCIIPreparer(ctx context.Context) (*http.Request
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/byte/nonAscii"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetNonASCIISender sends the GetNonASCII request. The method will close the
 // http.Response Body if it receives an error.
 func (client ByteClient) GetNonASCIISender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetNonASCIIResponder handles the response to the GetNonASCII request. The method always
@@ -209,7 +223,7 @@ func (client ByteClient) GetNonASCIIResponder(resp *http.Response) (result ByteA
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -218,14 +232,16 @@ func (client ByteClient) GetNonASCIIResponder(resp *http.Response) (result ByteA
 
 // GetNull get null byte value
 func (client ByteClient) GetNull(ctx context.Context) (result ByteArray, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ByteClient.GetNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ByteClient.GetNull", "GET", "/byte/null")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ByteClient.GetNull", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetNullPreparer(ctx)
@@ -242,7 +258,7 @@ func (client ByteClient) GetNull(ctx context.Context) (result ByteArray, err err
 	}
 
 	result, err = client.GetNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "bytegroup.ByteClient", "GetNull", resp, "Failure responding to request")
 	}
 
@@ -255,14 +271,15 @@ func (client ByteClient) GetNullPreparer(ctx context.Context) (*http.Request, er
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/byte/null"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetNullSender sends the GetNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client ByteClient) GetNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetNullResponder handles the response to the GetNull request. The method always
@@ -271,7 +288,7 @@ func (client ByteClient) GetNullResponder(resp *http.Response) (result ByteArray
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -282,20 +299,24 @@ func (client ByteClient) GetNullResponder(resp *http.Response) (result ByteArray
 // Parameters:
 // byteBody - base64-encoded non-ascii byte string hex(FF FE FD FC FB FA F9 F8 F7 F6)
 func (client ByteClient) PutNonASCII(ctx context.Context, byteBody []byte) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ByteClient.PutNonASCII")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ByteClient.PutNonASCII", "PUT", "/byte/nonAscii")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: byteBody,
-			Constraints: []validation.Constraint{{Target: "byteBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("bytegroup.ByteClient", "PutNonASCII", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ByteClient.PutNonASCII", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if byteBody == nil {
+		errs = append(errs, ValidationError{Path: "byteBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("bytegroup.ByteClient", "PutNonASCII", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutNonASCIIPreparer(ctx, byteBody)
@@ -312,7 +333,7 @@ func (client ByteClient) PutNonASCII(ctx context.Context, byteBody []byte) (resu
 	}
 
 	result, err = client.PutNonASCIIResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "bytegroup.ByteClient", "PutNonASCII", resp, "Failure responding to request")
 	}
 
@@ -327,14 +348,15 @@ func (client ByteClient) PutNonASCIIPreparer(ctx context.Context, byteBody []byt
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/byte/nonAscii"),
 		autorest.WithJSON(byteBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutNonASCIISender sends the PutNonASCII request. The method will close the
 // http.Response Body if it receives an error.
 func (client ByteClient) PutNonASCIISender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutNonASCIIResponder handles the response to the PutNonASCII request. The method always
@@ -343,7 +365,7 @@ func (client ByteClient) PutNonASCIIResponder(resp *http.Response) (result autor
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
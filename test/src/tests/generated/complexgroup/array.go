@@ -9,8 +9,6 @@ package complexgroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -29,16 +27,25 @@ func NewArrayClientWithBaseURI(baseURI string) ArrayClient {
 	return ArrayClient{NewWithBaseURI(baseURI)}
 }
 
+// NewArrayClientWithOptions creates an instance of the ArrayClient client with the specified options.  It returns an
+// error if the options are invalid.
+func NewArrayClientWithOptions(opts ClientOptions) (ArrayClient, error) {
+	client, err := NewWithOptions(opts)
+	return ArrayClient{client}, err
+}
+
 // GetEmpty get complex types with array property which is empty
 func (client ArrayClient) GetEmpty(ctx context.Context) (result ArrayWrapper, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetEmpty", "GET", "/complex/array/empty")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetEmpty", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetEmptyPreparer(ctx)
@@ -55,7 +62,7 @@ func (client ArrayClient) GetEmpty(ctx context.Context) (result ArrayWrapper, er
 	}
 
 	result, err = client.GetEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.ArrayClient", "GetEmpty", resp, "Failure responding to request")
 	}
 
@@ -68,14 +75,15 @@ func (client ArrayClient) GetEmptyPreparer(ctx context.Context) (*http.Request,
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/array/empty"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetEmptySender sends the GetEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetEmptyResponder handles the response to the GetEmpty request. The method always
@@ -84,7 +92,7 @@ func (client ArrayClient) GetEmptyResponder(resp *http.Response) (result ArrayWr
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -93,14 +101,16 @@ func (client ArrayClient) GetEmptyResponder(resp *http.Response) (result ArrayWr
 
 // GetNotProvided get complex types with array property while server doesn't provide a response payload
 func (client ArrayClient) GetNotProvided(ctx context.Context) (result ArrayWrapper, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetNotProvided")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetNotProvided", "GET", "/complex/array/notprovided")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetNotProvided", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetNotProvidedPreparer(ctx)
@@ -117,7 +127,7 @@ func (client ArrayClient) GetNotProvided(ctx context.Context) (result ArrayWrapp
 	}
 
 	result, err = client.GetNotProvidedResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.ArrayClient", "GetNotProvided", resp, "Failure responding to request")
 	}
 
@@ -130,14 +140,15 @@ func (client ArrayClient) GetNotProvidedPreparer(ctx context.Context) (*http.Req
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/array/notprovided"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetNotProvidedSender sends the GetNotProvided request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetNotProvidedSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetNotProvidedResponder handles the response to the GetNotProvided request. The method always
@@ -146,7 +157,7 @@ func (client ArrayClient) GetNotProvidedResponder(resp *http.Response) (result A
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -155,14 +166,16 @@ func (client ArrayClient) GetNotProvidedResponder(resp *http.Response) (result A
 
 // GetValid get complex types with array property
 func (client ArrayClient) GetValid(ctx context.Context) (result ArrayWrapper, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.GetValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.GetValid", "GET", "/complex/array/valid")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.GetValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetValidPreparer(ctx)
@@ -179,7 +192,7 @@ func (client ArrayClient) GetValid(ctx context.Context) (result ArrayWrapper, er
 	}
 
 	result, err = client.GetValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.ArrayClient", "GetValid", resp, "Failure responding to request")
 	}
 
@@ -192,14 +205,15 @@ func (client ArrayClient) GetValidPreparer(ctx context.Context) (*http.Request,
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/array/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetValidSender sends the GetValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) GetValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetValidResponder handles the response to the GetValid request. The method always
@@ -208,7 +222,7 @@ func (client ArrayClient) GetValidResponder(resp *http.Response) (result ArrayWr
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -219,14 +233,16 @@ func (client ArrayClient) GetValidResponder(resp *http.Response) (result ArrayWr
 // Parameters:
 // complexBody - please put an empty array
 func (client ArrayClient) PutEmpty(ctx context.Context, complexBody ArrayWrapper) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.PutEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.PutEmpty", "PUT", "/complex/array/empty")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.PutEmpty", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutEmptyPreparer(ctx, complexBody)
@@ -243,7 +259,7 @@ func (client ArrayClient) PutEmpty(ctx context.Context, complexBody ArrayWrapper
 	}
 
 	result, err = client.PutEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.ArrayClient", "PutEmpty", resp, "Failure responding to request")
 	}
 
@@ -258,14 +274,15 @@ func (client ArrayClient) PutEmptyPreparer(ctx context.Context, complexBody Arra
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/array/empty"),
 		autorest.WithJSON(complexBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutEmptySender sends the PutEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) PutEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutEmptyResponder handles the response to the PutEmpty request. The method always
@@ -274,7 +291,7 @@ func (client ArrayClient) PutEmptyResponder(resp *http.Response) (result autores
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -285,14 +302,16 @@ func (client ArrayClient) PutEmptyResponder(resp *http.Response) (result autores
 // complexBody - please put an array with 4 items: "1, 2, 3, 4", "", null, "&S#$(*Y", "The quick brown fox
 // jumps over the lazy dog"
 func (client ArrayClient) PutValid(ctx context.Context, complexBody ArrayWrapper) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ArrayClient.PutValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ArrayClient.PutValid", "PUT", "/complex/array/valid")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ArrayClient.PutValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutValidPreparer(ctx, complexBody)
@@ -309,7 +328,7 @@ func (client ArrayClient) PutValid(ctx context.Context, complexBody ArrayWrapper
 	}
 
 	result, err = client.PutValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.ArrayClient", "PutValid", resp, "Failure responding to request")
 	}
 
@@ -324,14 +343,15 @@ func (client ArrayClient) PutValidPreparer(ctx context.Context, complexBody Arra
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/array/valid"),
 		autorest.WithJSON(complexBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutValidSender sends the PutValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client ArrayClient) PutValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutValidResponder handles the response to the PutValid request. The method always
@@ -340,7 +360,7 @@ func (client ArrayClient) PutValidResponder(resp *http.Response) (result autores
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
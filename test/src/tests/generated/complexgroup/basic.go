@@ -9,8 +9,6 @@ package complexgroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -29,16 +27,25 @@ func NewBasicClientWithBaseURI(baseURI string) BasicClient {
 	return BasicClient{NewWithBaseURI(baseURI)}
 }
 
+// NewBasicClientWithOptions creates an instance of the BasicClient client with the specified options.  It returns an
+// error if the options are invalid.
+func NewBasicClientWithOptions(opts ClientOptions) (BasicClient, error) {
+	client, err := NewWithOptions(opts)
+	return BasicClient{client}, err
+}
+
 // GetEmpty get a basic complex type that is empty
 func (client BasicClient) GetEmpty(ctx context.Context) (result Basic, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BasicClient.GetEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BasicClient.GetEmpty", "GET", "/complex/basic/empty")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BasicClient.GetEmpty", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetEmptyPreparer(ctx)
@@ -55,7 +62,7 @@ func (client BasicClient) GetEmpty(ctx context.Context) (result Basic, err error
 	}
 
 	result, err = client.GetEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.BasicClient", "GetEmpty", resp, "Failure responding to request")
 	}
 
@@ -68,14 +75,15 @@ func (client BasicClient) GetEmptyPreparer(ctx context.Context) (*http.Request,
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/basic/empty"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetEmptySender sends the GetEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client BasicClient) GetEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetEmptyResponder handles the response to the GetEmpty request. The method always
@@ -84,7 +92,7 @@ func (client BasicClient) GetEmptyResponder(resp *http.Response) (result Basic,
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -93,14 +101,16 @@ func (client BasicClient) GetEmptyResponder(resp *http.Response) (result Basic,
 
 // GetInvalid get a basic complex type that is invalid for the local strong type
 func (client BasicClient) GetInvalid(ctx context.Context) (result Basic, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BasicClient.GetInvalid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BasicClient.GetInvalid", "GET", "/complex/basic/invalid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BasicClient.GetInvalid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetInvalidPreparer(ctx)
@@ -117,7 +127,7 @@ func (client BasicClient) GetInvalid(ctx context.Context) (result Basic, err err
 	}
 
 	result, err = client.GetInvalidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.BasicClient", "GetInvalid", resp, "Failure responding to request")
 	}
 
@@ -130,14 +140,15 @@ func (client BasicClient) GetInvalidPreparer(ctx context.Context) (*http.Request
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/basic/invalid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetInvalidSender sends the GetInvalid request. The method will close the
 // http.Response Body if it receives an error.
 func (client BasicClient) GetInvalidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetInvalidResponder handles the response to the GetInvalid request. The method always
@@ -146,7 +157,7 @@ func (client BasicClient) GetInvalidResponder(resp *http.Response) (result Basic
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -155,14 +166,16 @@ func (client BasicClient) GetInvalidResponder(resp *http.Response) (result Basic
 
 // GetNotProvided get a basic complex type while the server doesn't provide a response payload
 func (client BasicClient) GetNotProvided(ctx context.Context) (result Basic, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BasicClient.GetNotProvided")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BasicClient.GetNotProvided", "GET", "/complex/basic/notprovided")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BasicClient.GetNotProvided", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetNotProvidedPreparer(ctx)
@@ -179,7 +192,7 @@ func (client BasicClient) GetNotProvided(ctx context.Context) (result Basic, err
 	}
 
 	result, err = client.GetNotProvidedResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.BasicClient", "GetNotProvided", resp, "Failure responding to request")
 	}
 
@@ -192,14 +205,15 @@ func (client BasicClient) GetNotProvidedPreparer(ctx context.Context) (*http.Req
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/basic/notprovided"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetNotProvidedSender sends the GetNotProvided request. The method will close the
 // http.Response Body if it receives an error.
 func (client BasicClient) GetNotProvidedSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetNotProvidedResponder handles the response to the GetNotProvided request. The method always
@@ -208,7 +222,7 @@ func (client BasicClient) GetNotProvidedResponder(resp *http.Response) (result B
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -217,14 +231,16 @@ func (client BasicClient) GetNotProvidedResponder(resp *http.Response) (result B
 
 // GetNull get a basic complex type whose properties are null
 func (client BasicClient) GetNull(ctx context.Context) (result Basic, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BasicClient.GetNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BasicClient.GetNull", "GET", "/complex/basic/null")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BasicClient.GetNull", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetNullPreparer(ctx)
@@ -241,7 +257,7 @@ func (client BasicClient) GetNull(ctx context.Context) (result Basic, err error)
 	}
 
 	result, err = client.GetNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.BasicClient", "GetNull", resp, "Failure responding to request")
 	}
 
@@ -254,14 +270,15 @@ func (client BasicClient) GetNullPreparer(ctx context.Context) (*http.Request, e
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/basic/null"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetNullSender sends the GetNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client BasicClient) GetNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetNullResponder handles the response to the GetNull request. The method always
@@ -270,7 +287,7 @@ func (client BasicClient) GetNullResponder(resp *http.Response) (result Basic, e
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -279,14 +296,16 @@ func (client BasicClient) GetNullResponder(resp *http.Response) (result Basic, e
 
 // GetValid get complex type {id: 2, name: 'abc', color: 'YELLOW'}
 func (client BasicClient) GetValid(ctx context.Context) (result Basic, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BasicClient.GetValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BasicClient.GetValid", "GET", "/complex/basic/valid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BasicClient.GetValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetValidPreparer(ctx)
@@ -303,7 +322,7 @@ func (client BasicClient) GetValid(ctx context.Context) (result Basic, err error
 	}
 
 	result, err = client.GetValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.BasicClient", "GetValid", resp, "Failure responding to request")
 	}
 
@@ -316,14 +335,15 @@ func (client BasicClient) GetValidPreparer(ctx context.Context) (*http.Request,
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/basic/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetValidSender sends the GetValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client BasicClient) GetValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetValidResponder handles the response to the GetValid request. The method always
@@ -332,7 +352,7 @@ func (client BasicClient) GetValidResponder(resp *http.Response) (result Basic,
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -343,14 +363,16 @@ func (client BasicClient) GetValidResponder(resp *http.Response) (result Basic,
 // Parameters:
 // complexBody - please put {id: 2, name: 'abc', color: 'Magenta'}
 func (client BasicClient) PutValid(ctx context.Context, complexBody Basic) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BasicClient.PutValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BasicClient.PutValid", "PUT", "/complex/basic/valid")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BasicClient.PutValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutValidPreparer(ctx, complexBody)
@@ -367,7 +389,7 @@ func (client BasicClient) PutValid(ctx context.Context, complexBody Basic) (resu
 	}
 
 	result, err = client.PutValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.BasicClient", "PutValid", resp, "Failure responding to request")
 	}
 
@@ -388,14 +410,15 @@ func (client BasicClient) PutValidPreparer(ctx context.Context, complexBody Basi
 		autorest.WithPath("/complex/basic/valid"),
 		autorest.WithJSON(complexBody),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutValidSender sends the PutValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client BasicClient) PutValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutValidResponder handles the response to the PutValid request. The method always
@@ -404,7 +427,7 @@ func (client BasicClient) PutValidResponder(resp *http.Response) (result autores
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
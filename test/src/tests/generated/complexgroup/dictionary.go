@@ -9,8 +9,6 @@ package complexgroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -29,16 +27,25 @@ func NewDictionaryClientWithBaseURI(baseURI string) DictionaryClient {
 	return DictionaryClient{NewWithBaseURI(baseURI)}
 }
 
+// NewDictionaryClientWithOptions creates an instance of the DictionaryClient client with the specified options.  It
+// returns an error if the options are invalid.
+func NewDictionaryClientWithOptions(opts ClientOptions) (DictionaryClient, error) {
+	client, err := NewWithOptions(opts)
+	return DictionaryClient{client}, err
+}
+
 // GetEmpty get complex types with dictionary property which is empty
 func (client DictionaryClient) GetEmpty(ctx context.Context) (result DictionaryWrapper, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetEmpty", "GET", "/complex/dictionary/typed/empty")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetEmpty", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetEmptyPreparer(ctx)
@@ -55,7 +62,7 @@ func (client DictionaryClient) GetEmpty(ctx context.Context) (result DictionaryW
 	}
 
 	result, err = client.GetEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.DictionaryClient", "GetEmpty", resp, "Failure responding to request")
 	}
 
@@ -68,14 +75,15 @@ func (client DictionaryClient) GetEmptyPreparer(ctx context.Context) (*http.Requ
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/dictionary/typed/empty"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetEmptySender sends the GetEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetEmptyResponder handles the response to the GetEmpty request. The method always
@@ -84,7 +92,7 @@ func (client DictionaryClient) GetEmptyResponder(resp *http.Response) (result Di
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -93,14 +101,16 @@ func (client DictionaryClient) GetEmptyResponder(resp *http.Response) (result Di
 
 // GetNotProvided get complex types with dictionary property while server doesn't provide a response payload
 func (client DictionaryClient) GetNotProvided(ctx context.Context) (result DictionaryWrapper, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetNotProvided")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetNotProvided", "GET", "/complex/dictionary/typed/notprovided")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetNotProvided", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetNotProvidedPreparer(ctx)
@@ -117,7 +127,7 @@ func (client DictionaryClient) GetNotProvided(ctx context.Context) (result Dicti
 	}
 
 	result, err = client.GetNotProvidedResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.DictionaryClient", "GetNotProvided", resp, "Failure responding to request")
 	}
 
@@ -130,14 +140,15 @@ func (client DictionaryClient) GetNotProvidedPreparer(ctx context.Context) (*htt
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/dictionary/typed/notprovided"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetNotProvidedSender sends the GetNotProvided request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetNotProvidedSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetNotProvidedResponder handles the response to the GetNotProvided request. The method always
@@ -146,7 +157,7 @@ func (client DictionaryClient) GetNotProvidedResponder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -155,14 +166,16 @@ func (client DictionaryClient) GetNotProvidedResponder(resp *http.Response) (res
 
 // GetNull get complex types with dictionary property which is null
 func (client DictionaryClient) GetNull(ctx context.Context) (result DictionaryWrapper, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetNull", "GET", "/complex/dictionary/typed/null")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetNull", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetNullPreparer(ctx)
@@ -179,7 +192,7 @@ func (client DictionaryClient) GetNull(ctx context.Context) (result DictionaryWr
 	}
 
 	result, err = client.GetNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.DictionaryClient", "GetNull", resp, "Failure responding to request")
 	}
 
@@ -192,14 +205,15 @@ func (client DictionaryClient) GetNullPreparer(ctx context.Context) (*http.Reque
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/dictionary/typed/null"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetNullSender sends the GetNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetNullResponder handles the response to the GetNull request. The method always
@@ -208,7 +222,7 @@ func (client DictionaryClient) GetNullResponder(resp *http.Response) (result Dic
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -217,14 +231,16 @@ func (client DictionaryClient) GetNullResponder(resp *http.Response) (result Dic
 
 // GetValid get complex types with dictionary property
 func (client DictionaryClient) GetValid(ctx context.Context) (result DictionaryWrapper, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetValid", "GET", "/complex/dictionary/typed/valid")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetValidPreparer(ctx)
@@ -241,7 +257,7 @@ func (client DictionaryClient) GetValid(ctx context.Context) (result DictionaryW
 	}
 
 	result, err = client.GetValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.DictionaryClient", "GetValid", resp, "Failure responding to request")
 	}
 
@@ -254,14 +270,15 @@ func (client DictionaryClient) GetValidPreparer(ctx context.Context) (*http.Requ
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/dictionary/typed/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetValidSender sends the GetValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetValidResponder handles the response to the GetValid request. The method always
@@ -270,7 +287,7 @@ func (client DictionaryClient) GetValidResponder(resp *http.Response) (result Di
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -281,14 +298,16 @@ func (client DictionaryClient) GetValidResponder(resp *http.Response) (result Di
 // Parameters:
 // complexBody - please put an empty dictionary
 func (client DictionaryClient) PutEmpty(ctx context.Context, complexBody DictionaryWrapper) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.PutEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.PutEmpty", "PUT", "/complex/dictionary/typed/empty")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.PutEmpty", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutEmptyPreparer(ctx, complexBody)
@@ -305,7 +324,7 @@ func (client DictionaryClient) PutEmpty(ctx context.Context, complexBody Diction
 	}
 
 	result, err = client.PutEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.DictionaryClient", "PutEmpty", resp, "Failure responding to request")
 	}
 
@@ -320,14 +339,15 @@ func (client DictionaryClient) PutEmptyPreparer(ctx context.Context, complexBody
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/dictionary/typed/empty"),
 		autorest.WithJSON(complexBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutEmptySender sends the PutEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) PutEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutEmptyResponder handles the response to the PutEmpty request. The method always
@@ -336,7 +356,7 @@ func (client DictionaryClient) PutEmptyResponder(resp *http.Response) (result au
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -347,14 +367,16 @@ func (client DictionaryClient) PutEmptyResponder(resp *http.Response) (result au
 // complexBody - please put a dictionary with 5 key-value pairs: "txt":"notepad", "bmp":"mspaint",
 // "xls":"excel", "exe":"", "":null
 func (client DictionaryClient) PutValid(ctx context.Context, complexBody DictionaryWrapper) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.PutValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.PutValid", "PUT", "/complex/dictionary/typed/valid")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.PutValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutValidPreparer(ctx, complexBody)
@@ -371,7 +393,7 @@ func (client DictionaryClient) PutValid(ctx context.Context, complexBody Diction
 	}
 
 	result, err = client.PutValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.DictionaryClient", "PutValid", resp, "Failure responding to request")
 	}
 
@@ -386,14 +408,15 @@ func (client DictionaryClient) PutValidPreparer(ctx context.Context, complexBody
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/dictionary/typed/valid"),
 		autorest.WithJSON(complexBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutValidSender sends the PutValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) PutValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutValidResponder handles the response to the PutValid request. The method always
@@ -402,7 +425,7 @@ func (client DictionaryClient) PutValidResponder(resp *http.Response) (result au
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -9,8 +9,6 @@ package complexgroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -29,16 +27,25 @@ func NewFlattencomplexClientWithBaseURI(baseURI string) FlattencomplexClient {
 	return FlattencomplexClient{NewWithBaseURI(baseURI)}
 }
 
+// NewFlattencomplexClientWithOptions creates an instance of the FlattencomplexClient client with the specified options.
+// It returns an error if the options are invalid.
+func NewFlattencomplexClientWithOptions(opts ClientOptions) (FlattencomplexClient, error) {
+	client, err := NewWithOptions(opts)
+	return FlattencomplexClient{client}, err
+}
+
 // GetValid sends the get valid request.
 func (client FlattencomplexClient) GetValid(ctx context.Context) (result MyBaseTypeModel, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/FlattencomplexClient.GetValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/FlattencomplexClient.GetValid", "GET", "/complex/flatten/valid")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "FlattencomplexClient.GetValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetValidPreparer(ctx)
@@ -55,7 +62,7 @@ func (client FlattencomplexClient) GetValid(ctx context.Context) (result MyBaseT
 	}
 
 	result, err = client.GetValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.FlattencomplexClient", "GetValid", resp, "Failure responding to request")
 	}
 
@@ -68,14 +75,15 @@ func (client FlattencomplexClient) GetValidPreparer(ctx context.Context) (*http.
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/flatten/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetValidSender sends the GetValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client FlattencomplexClient) GetValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetValidResponder handles the response to the GetValid request. The method always
@@ -84,7 +92,7 @@ func (client FlattencomplexClient) GetValidResponder(resp *http.Response) (resul
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
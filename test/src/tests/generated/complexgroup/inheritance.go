@@ -9,8 +9,6 @@ package complexgroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -29,16 +27,25 @@ func NewInheritanceClientWithBaseURI(baseURI string) InheritanceClient {
 	return InheritanceClient{NewWithBaseURI(baseURI)}
 }
 
+// NewInheritanceClientWithOptions creates an instance of the InheritanceClient client with the specified options.  It
+// returns an error if the options are invalid.
+func NewInheritanceClientWithOptions(opts ClientOptions) (InheritanceClient, error) {
+	client, err := NewWithOptions(opts)
+	return InheritanceClient{client}, err
+}
+
 // GetValid get complex types that extend others
 func (client InheritanceClient) GetValid(ctx context.Context) (result Siamese, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/InheritanceClient.GetValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/InheritanceClient.GetValid", "GET", "/complex/inheritance/valid")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "InheritanceClient.GetValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetValidPreparer(ctx)
@@ -55,7 +62,7 @@ func (client InheritanceClient) GetValid(ctx context.Context) (result Siamese, e
 	}
 
 	result, err = client.GetValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.InheritanceClient", "GetValid", resp, "Failure responding to request")
 	}
 
@@ -68,14 +75,15 @@ func (client InheritanceClient) GetValidPreparer(ctx context.Context) (*http.Req
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/inheritance/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetValidSender sends the GetValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client InheritanceClient) GetValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetValidResponder handles the response to the GetValid request. The method always
@@ -84,7 +92,7 @@ func (client InheritanceClient) GetValidResponder(resp *http.Response) (result S
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -97,14 +105,16 @@ func (client InheritanceClient) GetValidResponder(resp *http.Response) (result S
 // dogs, the 1st one named "Potato" with id=1 and food="tomato", and the 2nd one named "Tomato" with id=-1 and
 // food="french fries".
 func (client InheritanceClient) PutValid(ctx context.Context, complexBody Siamese) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/InheritanceClient.PutValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/InheritanceClient.PutValid", "PUT", "/complex/inheritance/valid")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "InheritanceClient.PutValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutValidPreparer(ctx, complexBody)
@@ -121,7 +131,7 @@ func (client InheritanceClient) PutValid(ctx context.Context, complexBody Siames
 	}
 
 	result, err = client.PutValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.InheritanceClient", "PutValid", resp, "Failure responding to request")
 	}
 
@@ -136,14 +146,15 @@ func (client InheritanceClient) PutValidPreparer(ctx context.Context, complexBod
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/inheritance/valid"),
 		autorest.WithJSON(complexBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutValidSender sends the PutValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client InheritanceClient) PutValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutValidResponder handles the response to the PutValid request. The method always
@@ -152,7 +163,7 @@ func (client InheritanceClient) PutValidResponder(resp *http.Response) (result a
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
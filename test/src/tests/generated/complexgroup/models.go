@@ -7,31 +7,172 @@ package complexgroup
 // Changes may cause incorrect behavior and will be lost if the code is regenerated.
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/Azure/go-autorest/autorest/validation"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // The package's fully qualified name.
 const fqdn = "tests/generated/complexgroup"
 
+// deepCopyObject returns a deep copy of the value of a field of type interface{}.  The maps and slices created when
+// unmarshaling JSON are copied, the other values are returned as is.
+func deepCopyObject(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if v == nil {
+			return v
+		}
+		result := make(map[string]interface{}, len(v))
+		for key, e := range v {
+			result[key] = deepCopyObject(e)
+		}
+		return result
+	case []interface{}:
+		if v == nil {
+			return v
+		}
+		result := make([]interface{}, len(v))
+		for i, e := range v {
+			result[i] = deepCopyObject(e)
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+// unmarshalDiscriminator returns the value of the member named name of the JSON object body, or nil if body is null or
+// has no such member.  Like encoding/json it matches name case-insensitively.  The members preceding it are skipped without being
+// decoded and the ones following it aren't scanned.
+func unmarshalDiscriminator(body []byte, name string) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	token, err := dec.Token()
+	if err != nil || token == nil {
+		return nil, err
+	}
+	if token != json.Delim('{') {
+		// let encoding/json report the mismatch
+		return nil, json.Unmarshal(body, &struct{}{})
+	}
+	for dec.More() {
+		if token, err = dec.Token(); err != nil {
+			return nil, err
+		}
+		if key, _ := token.(string); strings.EqualFold(key, name) {
+			var value interface{}
+			if err = dec.Decode(&value); err != nil {
+				return nil, err
+			}
+			return value, nil
+		}
+		var skipped json.RawMessage
+		if err = dec.Decode(&skipped); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
 // CMYKColors enumerates the values for cmyk colors.
 type CMYKColors string
 
 const (
-	// BlacK ...
-	BlacK CMYKColors = "blacK"
-	// Cyan ...
-	Cyan CMYKColors = "cyan"
-	// Magenta ...
-	Magenta CMYKColors = "Magenta"
-	// YELLOW ...
-	YELLOW CMYKColors = "YELLOW"
+	// CMYKColorsBlack ...
+	CMYKColorsBlack CMYKColors = "blacK"
+	// CMYKColorsCyan ...
+	CMYKColorsCyan CMYKColors = "cyan"
+	// CMYKColorsMagenta ...
+	CMYKColorsMagenta CMYKColors = "Magenta"
+	// CMYKColorsYellow ...
+	CMYKColorsYellow CMYKColors = "YELLOW"
+)
+
+// The names of the CMYKColors constants before they were prefixed with the name of their type.
+const (
+	// Deprecated: Use CMYKColorsBlack instead.
+	BlacK = CMYKColorsBlack
+	// Deprecated: Use CMYKColorsCyan instead.
+	Cyan = CMYKColorsCyan
+	// Deprecated: Use CMYKColorsMagenta instead.
+	Magenta = CMYKColorsMagenta
+	// Deprecated: Use CMYKColorsYellow instead.
+	YELLOW = CMYKColorsYellow
 )
 
 // PossibleCMYKColorsValues returns an array of possible values for the CMYKColors const type.
 func PossibleCMYKColorsValues() []CMYKColors {
-	return []CMYKColors{BlacK, Cyan, Magenta, YELLOW}
+	return []CMYKColors{CMYKColorsBlack, CMYKColorsCyan, CMYKColorsMagenta, CMYKColorsYellow}
+}
+
+// knownCMYKColorsValues is the set of the possible values for the CMYKColors const type.
+var knownCMYKColorsValues = map[CMYKColors]struct{}{CMYKColorsBlack: {}, CMYKColorsCyan: {}, CMYKColorsMagenta: {}, CMYKColorsYellow: {}}
+
+// ParseCMYKColors returns the CMYKColors whose value is s, or an error if s isn't one of its possible values.
+func ParseCMYKColors(s string) (CMYKColors, error) {
+	if _, ok := knownCMYKColorsValues[CMYKColors(s)]; ok {
+		return CMYKColors(s), nil
+	}
+	return "", fmt.Errorf("%q isn't a possible value for CMYKColors", s)
+}
+
+// ParseCMYKColorsIgnoreCase returns the CMYKColors whose value equals s without regard to case, or an error if s isn't
+// one of its possible values.  Exact matches are preferred.
+func ParseCMYKColorsIgnoreCase(s string) (CMYKColors, error) {
+	if value, err := ParseCMYKColors(s); err == nil {
+		return value, nil
+	}
+	for _, value := range PossibleCMYKColorsValues() {
+		if strings.EqualFold(string(value), s) {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("%q isn't a possible value for CMYKColors", s)
+}
+
+// IsKnown returns true if cc is one of the possible values for the CMYKColors const type.
+func (cc CMYKColors) IsKnown() bool {
+	_, ok := knownCMYKColorsValues[cc]
+	return ok
+}
+
+// String returns the value of cc.
+func (cc CMYKColors) String() string {
+	return string(cc)
+}
+
+// UnmarshalJSON is the custom unmarshaler for CMYKColors.  It returns an error if the value isn't one of the possible
+// values for CMYKColors as the enum isn't extensible.  null and "" unset it.
+func (cc *CMYKColors) UnmarshalJSON(body []byte) error {
+	var value string
+	if err := json.Unmarshal(body, &value); err != nil {
+		return err
+	}
+	if value == "" {
+		*cc = ""
+		return nil
+	}
+	parsed, err := ParseCMYKColors(value)
+	if err != nil {
+		return err
+	}
+	*cc = parsed
+	return nil
 }
 
 // FishType enumerates the values for fish type.
@@ -49,46 +190,182 @@ func PossibleFishTypeValues() []FishType {
 	return []FishType{FishTypeDotFish, FishTypeDotSalmon}
 }
 
+// knownFishTypeValues is the set of the possible values for the FishType const type.
+var knownFishTypeValues = map[FishType]struct{}{FishTypeDotFish: {}, FishTypeDotSalmon: {}}
+
+// ParseFishType returns the FishType whose value is s, or an error if s isn't one of its possible values.
+func ParseFishType(s string) (FishType, error) {
+	if _, ok := knownFishTypeValues[FishType(s)]; ok {
+		return FishType(s), nil
+	}
+	return "", fmt.Errorf("%q isn't a possible value for FishType", s)
+}
+
+// ParseFishTypeIgnoreCase returns the FishType whose value equals s without regard to case, or an error if s isn't one
+// of its possible values.  Exact matches are preferred.
+func ParseFishTypeIgnoreCase(s string) (FishType, error) {
+	if value, err := ParseFishType(s); err == nil {
+		return value, nil
+	}
+	for _, value := range PossibleFishTypeValues() {
+		if strings.EqualFold(string(value), s) {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("%q isn't a possible value for FishType", s)
+}
+
+// IsKnown returns true if ft is one of the possible values for the FishType const type.
+func (ft FishType) IsKnown() bool {
+	_, ok := knownFishTypeValues[ft]
+	return ok
+}
+
+// String returns the value of ft.
+func (ft FishType) String() string {
+	return string(ft)
+}
+
 // FishtypeBasicFish enumerates the values for fishtype basic fish.
 type FishtypeBasicFish string
 
 const (
-	// FishtypeCookiecuttershark ...
-	FishtypeCookiecuttershark FishtypeBasicFish = "cookiecuttershark"
-	// FishtypeFish ...
-	FishtypeFish FishtypeBasicFish = "Fish"
-	// FishtypeGoblin ...
-	FishtypeGoblin FishtypeBasicFish = "goblin"
-	// FishtypeSalmon ...
-	FishtypeSalmon FishtypeBasicFish = "salmon"
-	// FishtypeSawshark ...
-	FishtypeSawshark FishtypeBasicFish = "sawshark"
-	// FishtypeShark ...
-	FishtypeShark FishtypeBasicFish = "shark"
-	// FishtypeSmartSalmon ...
-	FishtypeSmartSalmon FishtypeBasicFish = "smart_salmon"
+	// FishtypeBasicFishCookiecuttershark ...
+	FishtypeBasicFishCookiecuttershark FishtypeBasicFish = "cookiecuttershark"
+	// FishtypeBasicFishFish ...
+	FishtypeBasicFishFish FishtypeBasicFish = "Fish"
+	// FishtypeBasicFishGoblin ...
+	FishtypeBasicFishGoblin FishtypeBasicFish = "goblin"
+	// FishtypeBasicFishSalmon ...
+	FishtypeBasicFishSalmon FishtypeBasicFish = "salmon"
+	// FishtypeBasicFishSawshark ...
+	FishtypeBasicFishSawshark FishtypeBasicFish = "sawshark"
+	// FishtypeBasicFishShark ...
+	FishtypeBasicFishShark FishtypeBasicFish = "shark"
+	// FishtypeBasicFishSmartSalmon ...
+	FishtypeBasicFishSmartSalmon FishtypeBasicFish = "smart_salmon"
+)
+
+// The names of the FishtypeBasicFish constants before they were prefixed with the name of their type.
+const (
+	// Deprecated: Use FishtypeBasicFishCookiecuttershark instead.
+	FishtypeCookiecuttershark = FishtypeBasicFishCookiecuttershark
+	// Deprecated: Use FishtypeBasicFishFish instead.
+	FishtypeFish = FishtypeBasicFishFish
+	// Deprecated: Use FishtypeBasicFishGoblin instead.
+	FishtypeGoblin = FishtypeBasicFishGoblin
+	// Deprecated: Use FishtypeBasicFishSalmon instead.
+	FishtypeSalmon = FishtypeBasicFishSalmon
+	// Deprecated: Use FishtypeBasicFishSawshark instead.
+	FishtypeSawshark = FishtypeBasicFishSawshark
+	// Deprecated: Use FishtypeBasicFishShark instead.
+	FishtypeShark = FishtypeBasicFishShark
+	// Deprecated: Use FishtypeBasicFishSmartSalmon instead.
+	FishtypeSmartSalmon = FishtypeBasicFishSmartSalmon
 )
 
 // PossibleFishtypeBasicFishValues returns an array of possible values for the FishtypeBasicFish const type.
 func PossibleFishtypeBasicFishValues() []FishtypeBasicFish {
-	return []FishtypeBasicFish{FishtypeCookiecuttershark, FishtypeFish, FishtypeGoblin, FishtypeSalmon, FishtypeSawshark, FishtypeShark, FishtypeSmartSalmon}
+	return []FishtypeBasicFish{FishtypeBasicFishCookiecuttershark, FishtypeBasicFishFish, FishtypeBasicFishGoblin, FishtypeBasicFishSalmon, FishtypeBasicFishSawshark, FishtypeBasicFishShark, FishtypeBasicFishSmartSalmon}
+}
+
+// knownFishtypeBasicFishValues is the set of the possible values for the FishtypeBasicFish const type.
+var knownFishtypeBasicFishValues = map[FishtypeBasicFish]struct{}{FishtypeBasicFishCookiecuttershark: {}, FishtypeBasicFishFish: {}, FishtypeBasicFishGoblin: {}, FishtypeBasicFishSalmon: {}, FishtypeBasicFishSawshark: {}, FishtypeBasicFishShark: {}, FishtypeBasicFishSmartSalmon: {}}
+
+// ParseFishtypeBasicFish returns the FishtypeBasicFish whose value is s, or an error if s isn't one of its possible values.
+func ParseFishtypeBasicFish(s string) (FishtypeBasicFish, error) {
+	if _, ok := knownFishtypeBasicFishValues[FishtypeBasicFish(s)]; ok {
+		return FishtypeBasicFish(s), nil
+	}
+	return "", fmt.Errorf("%q isn't a possible value for FishtypeBasicFish", s)
+}
+
+// ParseFishtypeBasicFishIgnoreCase returns the FishtypeBasicFish whose value equals s without regard to case, or an
+// error if s isn't one of its possible values.  Exact matches are preferred.
+func ParseFishtypeBasicFishIgnoreCase(s string) (FishtypeBasicFish, error) {
+	if value, err := ParseFishtypeBasicFish(s); err == nil {
+		return value, nil
+	}
+	for _, value := range PossibleFishtypeBasicFishValues() {
+		if strings.EqualFold(string(value), s) {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("%q isn't a possible value for FishtypeBasicFish", s)
+}
+
+// IsKnown returns true if fbf is one of the possible values for the FishtypeBasicFish const type.
+func (fbf FishtypeBasicFish) IsKnown() bool {
+	_, ok := knownFishtypeBasicFishValues[fbf]
+	return ok
+}
+
+// String returns the value of fbf.
+func (fbf FishtypeBasicFish) String() string {
+	return string(fbf)
 }
 
 // GoblinSharkColor enumerates the values for goblin shark color.
 type GoblinSharkColor string
 
 const (
-	// Brown ...
-	Brown GoblinSharkColor = "brown"
-	// Gray ...
-	Gray GoblinSharkColor = "gray"
-	// Pink ...
-	Pink GoblinSharkColor = "pink"
+	// GoblinSharkColorBrown ...
+	GoblinSharkColorBrown GoblinSharkColor = "brown"
+	// GoblinSharkColorGray ...
+	GoblinSharkColorGray GoblinSharkColor = "gray"
+	// GoblinSharkColorPink ...
+	GoblinSharkColorPink GoblinSharkColor = "pink"
+)
+
+// The names of the GoblinSharkColor constants before they were prefixed with the name of their type.
+const (
+	// Deprecated: Use GoblinSharkColorBrown instead.
+	Brown = GoblinSharkColorBrown
+	// Deprecated: Use GoblinSharkColorGray instead.
+	Gray = GoblinSharkColorGray
+	// Deprecated: Use GoblinSharkColorPink instead.
+	Pink = GoblinSharkColorPink
 )
 
 // PossibleGoblinSharkColorValues returns an array of possible values for the GoblinSharkColor const type.
 func PossibleGoblinSharkColorValues() []GoblinSharkColor {
-	return []GoblinSharkColor{Brown, Gray, Pink}
+	return []GoblinSharkColor{GoblinSharkColorBrown, GoblinSharkColorGray, GoblinSharkColorPink}
+}
+
+// knownGoblinSharkColorValues is the set of the possible values for the GoblinSharkColor const type.
+var knownGoblinSharkColorValues = map[GoblinSharkColor]struct{}{GoblinSharkColorBrown: {}, GoblinSharkColorGray: {}, GoblinSharkColorPink: {}}
+
+// ParseGoblinSharkColor returns the GoblinSharkColor whose value is s, or an error if s isn't one of its possible values.
+func ParseGoblinSharkColor(s string) (GoblinSharkColor, error) {
+	if _, ok := knownGoblinSharkColorValues[GoblinSharkColor(s)]; ok {
+		return GoblinSharkColor(s), nil
+	}
+	return "", fmt.Errorf("%q isn't a possible value for GoblinSharkColor", s)
+}
+
+// ParseGoblinSharkColorIgnoreCase returns the GoblinSharkColor whose value equals s without regard to case, or an error
+// if s isn't one of its possible values.  Exact matches are preferred.
+func ParseGoblinSharkColorIgnoreCase(s string) (GoblinSharkColor, error) {
+	if value, err := ParseGoblinSharkColor(s); err == nil {
+		return value, nil
+	}
+	for _, value := range PossibleGoblinSharkColorValues() {
+		if strings.EqualFold(string(value), s) {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("%q isn't a possible value for GoblinSharkColor", s)
+}
+
+// IsKnown returns true if gsc is one of the possible values for the GoblinSharkColor const type.
+func (gsc GoblinSharkColor) IsKnown() bool {
+	_, ok := knownGoblinSharkColorValues[gsc]
+	return ok
+}
+
+// String returns the value of gsc.
+func (gsc GoblinSharkColor) String() string {
+	return string(gsc)
 }
 
 // Kind enumerates the values for kind.
@@ -106,17 +383,95 @@ func PossibleKindValues() []Kind {
 	return []Kind{KindKind1, KindMyBaseType}
 }
 
+// knownKindValues is the set of the possible values for the Kind const type.
+var knownKindValues = map[Kind]struct{}{KindKind1: {}, KindMyBaseType: {}}
+
+// ParseKind returns the Kind whose value is s, or an error if s isn't one of its possible values.
+func ParseKind(s string) (Kind, error) {
+	if _, ok := knownKindValues[Kind(s)]; ok {
+		return Kind(s), nil
+	}
+	return "", fmt.Errorf("%q isn't a possible value for Kind", s)
+}
+
+// ParseKindIgnoreCase returns the Kind whose value equals s without regard to case, or an error if s isn't one of its
+// possible values.  Exact matches are preferred.
+func ParseKindIgnoreCase(s string) (Kind, error) {
+	if value, err := ParseKind(s); err == nil {
+		return value, nil
+	}
+	for _, value := range PossibleKindValues() {
+		if strings.EqualFold(string(value), s) {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("%q isn't a possible value for Kind", s)
+}
+
+// IsKnown returns true if k is one of the possible values for the Kind const type.
+func (k Kind) IsKnown() bool {
+	_, ok := knownKindValues[k]
+	return ok
+}
+
+// String returns the value of k.
+func (k Kind) String() string {
+	return string(k)
+}
+
 // MyKind enumerates the values for my kind.
 type MyKind string
 
 const (
-	// Kind1 ...
-	Kind1 MyKind = "Kind1"
+	// MyKindKind1 ...
+	MyKindKind1 MyKind = "Kind1"
+)
+
+// The names of the MyKind constants before they were prefixed with the name of their type.
+const (
+	// Deprecated: Use MyKindKind1 instead.
+	Kind1 = MyKindKind1
 )
 
 // PossibleMyKindValues returns an array of possible values for the MyKind const type.
 func PossibleMyKindValues() []MyKind {
-	return []MyKind{Kind1}
+	return []MyKind{MyKindKind1}
+}
+
+// knownMyKindValues is the set of the possible values for the MyKind const type.
+var knownMyKindValues = map[MyKind]struct{}{MyKindKind1: {}}
+
+// ParseMyKind returns the MyKind whose value is s, or an error if s isn't one of its possible values.
+func ParseMyKind(s string) (MyKind, error) {
+	if _, ok := knownMyKindValues[MyKind(s)]; ok {
+		return MyKind(s), nil
+	}
+	return "", fmt.Errorf("%q isn't a possible value for MyKind", s)
+}
+
+// ParseMyKindIgnoreCase returns the MyKind whose value equals s without regard to case, or an error if s isn't one of
+// its possible values.  Exact matches are preferred.
+func ParseMyKindIgnoreCase(s string) (MyKind, error) {
+	if value, err := ParseMyKind(s); err == nil {
+		return value, nil
+	}
+	for _, value := range PossibleMyKindValues() {
+		if strings.EqualFold(string(value), s) {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("%q isn't a possible value for MyKind", s)
+}
+
+// IsKnown returns true if mk is one of the possible values for the MyKind const type.
+func (mk MyKind) IsKnown() bool {
+	_, ok := knownMyKindValues[mk]
+	return ok
+}
+
+// String returns the value of mk.
+func (mk MyKind) String() string {
+	return string(mk)
 }
 
 // ArrayWrapper ...
@@ -125,6 +480,63 @@ type ArrayWrapper struct {
 	Array             *[]string `json:"array,omitempty"`
 }
 
+// DeepCopy returns a copy of the ArrayWrapper that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (aw ArrayWrapper) DeepCopy() ArrayWrapper {
+	result := aw
+	if aw.Array != nil {
+		var v []string
+		if *aw.Array != nil {
+			v = make([]string, len(*aw.Array))
+			copy(v, *aw.Array)
+		}
+		result.Array = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ArrayWrapper and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (aw ArrayWrapper) Equal(other ArrayWrapper) bool {
+	return aw.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (aw ArrayWrapper) EqualIgnoreReadOnly(other ArrayWrapper) bool {
+	return aw.equal(other, true)
+}
+
+// equal compares the fields of the ArrayWrapper and other, skipping the read-only ones if ignoreReadOnly is true.
+func (aw ArrayWrapper) equal(other ArrayWrapper, ignoreReadOnly bool) bool {
+	if (aw.Array == nil) != (other.Array == nil) {
+		return false
+	}
+	if aw.Array != nil {
+		if (*aw.Array == nil) != (*other.Array == nil) || len(*aw.Array) != len(*other.Array) {
+			return false
+		}
+		for i := range *aw.Array {
+			if (*aw.Array)[i] != (*other.Array)[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ArrayWrapper to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (aw *ArrayWrapper) MergeFrom(patch ArrayWrapper) {
+	if patch.Array != nil {
+		var v []string
+		if *patch.Array != nil {
+			v = make([]string, len(*patch.Array))
+			copy(v, *patch.Array)
+		}
+		aw.Array = &v
+	}
+}
+
 // Basic ...
 type Basic struct {
 	autorest.Response `json:"-"`
@@ -132,10 +544,66 @@ type Basic struct {
 	ID *int32 `json:"id,omitempty"`
 	// Name - Name property with a very long description that does not fit on a single line and a line break.
 	Name *string `json:"name,omitempty"`
-	// Color - Possible values include: 'Cyan', 'Magenta', 'YELLOW', 'BlacK'
+	// Color - Possible values include: 'CMYKColorsCyan', 'CMYKColorsMagenta', 'CMYKColorsYellow', 'CMYKColorsBlack'
 	Color CMYKColors `json:"color,omitempty"`
 }
 
+// DeepCopy returns a copy of the Basic that doesn't share any pointers, slices or maps with it.  Its autorest.Response
+// is copied as is.
+func (b Basic) DeepCopy() Basic {
+	result := b
+	if b.ID != nil {
+		v := *b.ID
+		result.ID = &v
+	}
+	if b.Name != nil {
+		v := *b.Name
+		result.Name = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Basic and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (b Basic) Equal(other Basic) bool {
+	return b.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (b Basic) EqualIgnoreReadOnly(other Basic) bool {
+	return b.equal(other, true)
+}
+
+// equal compares the fields of the Basic and other, skipping the read-only ones if ignoreReadOnly is true.
+func (b Basic) equal(other Basic, ignoreReadOnly bool) bool {
+	if (b.ID == nil) != (other.ID == nil) || (b.ID != nil && *b.ID != *other.ID) {
+		return false
+	}
+	if (b.Name == nil) != (other.Name == nil) || (b.Name != nil && *b.Name != *other.Name) {
+		return false
+	}
+	if b.Color != other.Color {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Basic to copies of the fields set in patch.  Nested models and maps are merged, the
+// values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (b *Basic) MergeFrom(patch Basic) {
+	if patch.ID != nil {
+		v := *patch.ID
+		b.ID = &v
+	}
+	if patch.Name != nil {
+		v := *patch.Name
+		b.Name = &v
+	}
+	if patch.Color != "" {
+		b.Color = patch.Color
+	}
+}
+
 // BooleanWrapper ...
 type BooleanWrapper struct {
 	autorest.Response `json:"-"`
@@ -143,12 +611,114 @@ type BooleanWrapper struct {
 	FieldFalse        *bool `json:"field_false,omitempty"`
 }
 
+// DeepCopy returns a copy of the BooleanWrapper that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (bw BooleanWrapper) DeepCopy() BooleanWrapper {
+	result := bw
+	if bw.FieldTrue != nil {
+		v := *bw.FieldTrue
+		result.FieldTrue = &v
+	}
+	if bw.FieldFalse != nil {
+		v := *bw.FieldFalse
+		result.FieldFalse = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the BooleanWrapper and other are equal.  Times are equal if they represent the
+// same instant.  Their autorest.Response isn't compared.
+func (bw BooleanWrapper) Equal(other BooleanWrapper) bool {
+	return bw.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (bw BooleanWrapper) EqualIgnoreReadOnly(other BooleanWrapper) bool {
+	return bw.equal(other, true)
+}
+
+// equal compares the fields of the BooleanWrapper and other, skipping the read-only ones if ignoreReadOnly is true.
+func (bw BooleanWrapper) equal(other BooleanWrapper, ignoreReadOnly bool) bool {
+	if (bw.FieldTrue == nil) != (other.FieldTrue == nil) || (bw.FieldTrue != nil && *bw.FieldTrue != *other.FieldTrue) {
+		return false
+	}
+	if (bw.FieldFalse == nil) != (other.FieldFalse == nil) || (bw.FieldFalse != nil && *bw.FieldFalse != *other.FieldFalse) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the BooleanWrapper to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (bw *BooleanWrapper) MergeFrom(patch BooleanWrapper) {
+	if patch.FieldTrue != nil {
+		v := *patch.FieldTrue
+		bw.FieldTrue = &v
+	}
+	if patch.FieldFalse != nil {
+		v := *patch.FieldFalse
+		bw.FieldFalse = &v
+	}
+}
+
 // ByteWrapper ...
 type ByteWrapper struct {
 	autorest.Response `json:"-"`
 	Field             *[]byte `json:"field,omitempty"`
 }
 
+// DeepCopy returns a copy of the ByteWrapper that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (bw ByteWrapper) DeepCopy() ByteWrapper {
+	result := bw
+	if bw.Field != nil {
+		var v []byte
+		if *bw.Field != nil {
+			v = make([]byte, len(*bw.Field))
+			copy(v, *bw.Field)
+		}
+		result.Field = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ByteWrapper and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (bw ByteWrapper) Equal(other ByteWrapper) bool {
+	return bw.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (bw ByteWrapper) EqualIgnoreReadOnly(other ByteWrapper) bool {
+	return bw.equal(other, true)
+}
+
+// equal compares the fields of the ByteWrapper and other, skipping the read-only ones if ignoreReadOnly is true.
+func (bw ByteWrapper) equal(other ByteWrapper, ignoreReadOnly bool) bool {
+	if (bw.Field == nil) != (other.Field == nil) {
+		return false
+	}
+	if bw.Field != nil {
+		if (*bw.Field == nil) != (*other.Field == nil) || !bytes.Equal(*bw.Field, *other.Field) {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ByteWrapper to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (bw *ByteWrapper) MergeFrom(patch ByteWrapper) {
+	if patch.Field != nil {
+		var v []byte
+		if *patch.Field != nil {
+			v = make([]byte, len(*patch.Field))
+			copy(v, *patch.Field)
+		}
+		bw.Field = &v
+	}
+}
+
 // Cat ...
 type Cat struct {
 	Color *string `json:"color,omitempty"`
@@ -157,6 +727,98 @@ type Cat struct {
 	Name  *string `json:"name,omitempty"`
 }
 
+// DeepCopy returns a copy of the Cat that doesn't share any pointers, slices or maps with it.
+func (c Cat) DeepCopy() Cat {
+	result := c
+	if c.Color != nil {
+		v := *c.Color
+		result.Color = &v
+	}
+	if c.Hates != nil {
+		var v []Dog
+		if *c.Hates != nil {
+			v = make([]Dog, len(*c.Hates))
+			for i := range *c.Hates {
+				v[i] = (*c.Hates)[i].DeepCopy()
+			}
+		}
+		result.Hates = &v
+	}
+	if c.ID != nil {
+		v := *c.ID
+		result.ID = &v
+	}
+	if c.Name != nil {
+		v := *c.Name
+		result.Name = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Cat and other are equal.  Times are equal if they represent the same instant.
+func (c Cat) Equal(other Cat) bool {
+	return c.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (c Cat) EqualIgnoreReadOnly(other Cat) bool {
+	return c.equal(other, true)
+}
+
+// equal compares the fields of the Cat and other, skipping the read-only ones if ignoreReadOnly is true.
+func (c Cat) equal(other Cat, ignoreReadOnly bool) bool {
+	if (c.Color == nil) != (other.Color == nil) || (c.Color != nil && *c.Color != *other.Color) {
+		return false
+	}
+	if (c.Hates == nil) != (other.Hates == nil) {
+		return false
+	}
+	if c.Hates != nil {
+		if (*c.Hates == nil) != (*other.Hates == nil) || len(*c.Hates) != len(*other.Hates) {
+			return false
+		}
+		for i := range *c.Hates {
+			if !(*c.Hates)[i].equal((*other.Hates)[i], ignoreReadOnly) {
+				return false
+			}
+		}
+	}
+	if (c.ID == nil) != (other.ID == nil) || (c.ID != nil && *c.ID != *other.ID) {
+		return false
+	}
+	if (c.Name == nil) != (other.Name == nil) || (c.Name != nil && *c.Name != *other.Name) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Cat to copies of the fields set in patch.  Nested models and maps are merged, the
+// values of the other fields are replaced.  The read-only fields aren't changed.
+func (c *Cat) MergeFrom(patch Cat) {
+	if patch.Color != nil {
+		v := *patch.Color
+		c.Color = &v
+	}
+	if patch.Hates != nil {
+		var v []Dog
+		if *patch.Hates != nil {
+			v = make([]Dog, len(*patch.Hates))
+			for i := range *patch.Hates {
+				v[i] = (*patch.Hates)[i].DeepCopy()
+			}
+		}
+		c.Hates = &v
+	}
+	if patch.ID != nil {
+		v := *patch.ID
+		c.ID = &v
+	}
+	if patch.Name != nil {
+		v := *patch.Name
+		c.Name = &v
+	}
+}
+
 // Cookiecuttershark ...
 type Cookiecuttershark struct {
 	Age      *int32       `json:"age,omitempty"`
@@ -164,13 +826,13 @@ type Cookiecuttershark struct {
 	Species  *string      `json:"species,omitempty"`
 	Length   *float64     `json:"length,omitempty"`
 	Siblings *[]BasicFish `json:"siblings,omitempty"`
-	// Fishtype - Possible values include: 'FishtypeFish', 'FishtypeSalmon', 'FishtypeSmartSalmon', 'FishtypeShark', 'FishtypeSawshark', 'FishtypeGoblin', 'FishtypeCookiecuttershark'
+	// Fishtype - Possible values include: 'FishtypeBasicFishFish', 'FishtypeBasicFishSalmon', 'FishtypeBasicFishSmartSalmon', 'FishtypeBasicFishShark', 'FishtypeBasicFishSawshark', 'FishtypeBasicFishGoblin', 'FishtypeBasicFishCookiecuttershark'
 	Fishtype FishtypeBasicFish `json:"fishtype,omitempty"`
 }
 
 // MarshalJSON is the custom marshaler for Cookiecuttershark.
 func (c Cookiecuttershark) MarshalJSON() ([]byte, error) {
-	c.Fishtype = FishtypeCookiecuttershark
+	c.Fishtype = FishtypeBasicFishCookiecuttershark
 	objectMap := make(map[string]interface{})
 	if c.Age != nil {
 		objectMap["age"] = c.Age
@@ -311,35 +973,312 @@ func (c *Cookiecuttershark) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
-// Datetimerfc1123Wrapper ...
-type Datetimerfc1123Wrapper struct {
-	autorest.Response `json:"-"`
-	Field             *date.TimeRFC1123 `json:"field,omitempty"`
-	Now               *date.TimeRFC1123 `json:"now,omitempty"`
+// Validate checks that the Cookiecuttershark satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (c Cookiecuttershark) Validate() error {
+	var errs ValidationErrors
+	if c.Birthday == nil {
+		errs = append(errs, ValidationError{Path: "birthday", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if c.Length == nil {
+		errs = append(errs, ValidationError{Path: "length", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
 }
 
-// DatetimeWrapper ...
-type DatetimeWrapper struct {
-	autorest.Response `json:"-"`
-	Field             *date.Time `json:"field,omitempty"`
-	Now               *date.Time `json:"now,omitempty"`
+// DeepCopy returns a copy of the Cookiecuttershark that doesn't share any pointers, slices or maps with it.
+func (c Cookiecuttershark) DeepCopy() Cookiecuttershark {
+	result := c
+	if c.Age != nil {
+		v := *c.Age
+		result.Age = &v
+	}
+	if c.Birthday != nil {
+		v := *c.Birthday
+		result.Birthday = &v
+	}
+	if c.Species != nil {
+		v := *c.Species
+		result.Species = &v
+	}
+	if c.Length != nil {
+		v := *c.Length
+		result.Length = &v
+	}
+	if c.Siblings != nil {
+		var v []BasicFish
+		if *c.Siblings != nil {
+			v = make([]BasicFish, len(*c.Siblings))
+			for i := range *c.Siblings {
+				v[i] = deepCopyBasicFish((*c.Siblings)[i])
+			}
+		}
+		result.Siblings = &v
+	}
+	return result
 }
 
-// DateWrapper ...
-type DateWrapper struct {
-	autorest.Response `json:"-"`
-	Field             *date.Date `json:"field,omitempty"`
-	Leap              *date.Date `json:"leap,omitempty"`
+// Equal returns true if the fields of the Cookiecuttershark and other are equal.  Times are equal if they represent the
+// same instant.
+func (c Cookiecuttershark) Equal(other Cookiecuttershark) bool {
+	return c.equal(other, false)
 }
 
-// DictionaryWrapper ...
-type DictionaryWrapper struct {
-	autorest.Response `json:"-"`
-	DefaultProgram    map[string]*string `json:"defaultProgram"`
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (c Cookiecuttershark) EqualIgnoreReadOnly(other Cookiecuttershark) bool {
+	return c.equal(other, true)
 }
 
-// MarshalJSON is the custom marshaler for DictionaryWrapper.
-func (d DictionaryWrapper) MarshalJSON() ([]byte, error) {
+// equal compares the fields of the Cookiecuttershark and other, skipping the read-only ones if ignoreReadOnly is true.
+func (c Cookiecuttershark) equal(other Cookiecuttershark, ignoreReadOnly bool) bool {
+	if (c.Age == nil) != (other.Age == nil) || (c.Age != nil && *c.Age != *other.Age) {
+		return false
+	}
+	if (c.Birthday == nil) != (other.Birthday == nil) || (c.Birthday != nil && !c.Birthday.Equal(other.Birthday.Time)) {
+		return false
+	}
+	if (c.Species == nil) != (other.Species == nil) || (c.Species != nil && *c.Species != *other.Species) {
+		return false
+	}
+	if (c.Length == nil) != (other.Length == nil) || (c.Length != nil && *c.Length != *other.Length) {
+		return false
+	}
+	if (c.Siblings == nil) != (other.Siblings == nil) {
+		return false
+	}
+	if c.Siblings != nil {
+		if (*c.Siblings == nil) != (*other.Siblings == nil) || len(*c.Siblings) != len(*other.Siblings) {
+			return false
+		}
+		for i := range *c.Siblings {
+			if !equalBasicFish((*c.Siblings)[i], (*other.Siblings)[i], ignoreReadOnly) {
+				return false
+			}
+		}
+	}
+	if c.Fishtype != other.Fishtype {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Cookiecuttershark to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (c *Cookiecuttershark) MergeFrom(patch Cookiecuttershark) {
+	if patch.Age != nil {
+		v := *patch.Age
+		c.Age = &v
+	}
+	if patch.Birthday != nil {
+		v := *patch.Birthday
+		c.Birthday = &v
+	}
+	if patch.Species != nil {
+		v := *patch.Species
+		c.Species = &v
+	}
+	if patch.Length != nil {
+		v := *patch.Length
+		c.Length = &v
+	}
+	if patch.Siblings != nil {
+		var v []BasicFish
+		if *patch.Siblings != nil {
+			v = make([]BasicFish, len(*patch.Siblings))
+			for i := range *patch.Siblings {
+				v[i] = deepCopyBasicFish((*patch.Siblings)[i])
+			}
+		}
+		c.Siblings = &v
+	}
+	if patch.Fishtype != "" {
+		c.Fishtype = patch.Fishtype
+	}
+}
+
+// Datetimerfc1123Wrapper ...
+type Datetimerfc1123Wrapper struct {
+	autorest.Response `json:"-"`
+	Field             *date.TimeRFC1123 `json:"field,omitempty"`
+	Now               *date.TimeRFC1123 `json:"now,omitempty"`
+}
+
+// DeepCopy returns a copy of the Datetimerfc1123Wrapper that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (d1w Datetimerfc1123Wrapper) DeepCopy() Datetimerfc1123Wrapper {
+	result := d1w
+	if d1w.Field != nil {
+		v := *d1w.Field
+		result.Field = &v
+	}
+	if d1w.Now != nil {
+		v := *d1w.Now
+		result.Now = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Datetimerfc1123Wrapper and other are equal.  Times are equal if they
+// represent the same instant.  Their autorest.Response isn't compared.
+func (d1w Datetimerfc1123Wrapper) Equal(other Datetimerfc1123Wrapper) bool {
+	return d1w.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (d1w Datetimerfc1123Wrapper) EqualIgnoreReadOnly(other Datetimerfc1123Wrapper) bool {
+	return d1w.equal(other, true)
+}
+
+// equal compares the fields of the Datetimerfc1123Wrapper and other, skipping the read-only ones if ignoreReadOnly is true.
+func (d1w Datetimerfc1123Wrapper) equal(other Datetimerfc1123Wrapper, ignoreReadOnly bool) bool {
+	if (d1w.Field == nil) != (other.Field == nil) || (d1w.Field != nil && !d1w.Field.Equal(other.Field.Time)) {
+		return false
+	}
+	if (d1w.Now == nil) != (other.Now == nil) || (d1w.Now != nil && !d1w.Now.Equal(other.Now.Time)) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Datetimerfc1123Wrapper to copies of the fields set in patch.  Nested models and maps
+// are merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't
+// changed.
+func (d1w *Datetimerfc1123Wrapper) MergeFrom(patch Datetimerfc1123Wrapper) {
+	if patch.Field != nil {
+		v := *patch.Field
+		d1w.Field = &v
+	}
+	if patch.Now != nil {
+		v := *patch.Now
+		d1w.Now = &v
+	}
+}
+
+// DatetimeWrapper ...
+type DatetimeWrapper struct {
+	autorest.Response `json:"-"`
+	Field             *date.Time `json:"field,omitempty"`
+	Now               *date.Time `json:"now,omitempty"`
+}
+
+// DeepCopy returns a copy of the DatetimeWrapper that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (dw DatetimeWrapper) DeepCopy() DatetimeWrapper {
+	result := dw
+	if dw.Field != nil {
+		v := *dw.Field
+		result.Field = &v
+	}
+	if dw.Now != nil {
+		v := *dw.Now
+		result.Now = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the DatetimeWrapper and other are equal.  Times are equal if they represent the
+// same instant.  Their autorest.Response isn't compared.
+func (dw DatetimeWrapper) Equal(other DatetimeWrapper) bool {
+	return dw.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (dw DatetimeWrapper) EqualIgnoreReadOnly(other DatetimeWrapper) bool {
+	return dw.equal(other, true)
+}
+
+// equal compares the fields of the DatetimeWrapper and other, skipping the read-only ones if ignoreReadOnly is true.
+func (dw DatetimeWrapper) equal(other DatetimeWrapper, ignoreReadOnly bool) bool {
+	if (dw.Field == nil) != (other.Field == nil) || (dw.Field != nil && !dw.Field.Equal(other.Field.Time)) {
+		return false
+	}
+	if (dw.Now == nil) != (other.Now == nil) || (dw.Now != nil && !dw.Now.Equal(other.Now.Time)) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the DatetimeWrapper to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (dw *DatetimeWrapper) MergeFrom(patch DatetimeWrapper) {
+	if patch.Field != nil {
+		v := *patch.Field
+		dw.Field = &v
+	}
+	if patch.Now != nil {
+		v := *patch.Now
+		dw.Now = &v
+	}
+}
+
+// DateWrapper ...
+type DateWrapper struct {
+	autorest.Response `json:"-"`
+	Field             *date.Date `json:"field,omitempty"`
+	Leap              *date.Date `json:"leap,omitempty"`
+}
+
+// DeepCopy returns a copy of the DateWrapper that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (dw DateWrapper) DeepCopy() DateWrapper {
+	result := dw
+	if dw.Field != nil {
+		v := *dw.Field
+		result.Field = &v
+	}
+	if dw.Leap != nil {
+		v := *dw.Leap
+		result.Leap = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the DateWrapper and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (dw DateWrapper) Equal(other DateWrapper) bool {
+	return dw.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (dw DateWrapper) EqualIgnoreReadOnly(other DateWrapper) bool {
+	return dw.equal(other, true)
+}
+
+// equal compares the fields of the DateWrapper and other, skipping the read-only ones if ignoreReadOnly is true.
+func (dw DateWrapper) equal(other DateWrapper, ignoreReadOnly bool) bool {
+	if (dw.Field == nil) != (other.Field == nil) || (dw.Field != nil && !dw.Field.Equal(other.Field.Time)) {
+		return false
+	}
+	if (dw.Leap == nil) != (other.Leap == nil) || (dw.Leap != nil && !dw.Leap.Equal(other.Leap.Time)) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the DateWrapper to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (dw *DateWrapper) MergeFrom(patch DateWrapper) {
+	if patch.Field != nil {
+		v := *patch.Field
+		dw.Field = &v
+	}
+	if patch.Leap != nil {
+		v := *patch.Leap
+		dw.Leap = &v
+	}
+}
+
+// DictionaryWrapper ...
+type DictionaryWrapper struct {
+	autorest.Response `json:"-"`
+	DefaultProgram    map[string]*string `json:"defaultProgram"`
+}
+
+// MarshalJSON is the custom marshaler for DictionaryWrapper.
+func (d DictionaryWrapper) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]interface{})
 	if d.DefaultProgram != nil {
 		objectMap["defaultProgram"] = d.DefaultProgram
@@ -347,6 +1286,65 @@ func (d DictionaryWrapper) MarshalJSON() ([]byte, error) {
 	return json.Marshal(objectMap)
 }
 
+// DeepCopy returns a copy of the DictionaryWrapper that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (d DictionaryWrapper) DeepCopy() DictionaryWrapper {
+	result := d
+	if d.DefaultProgram != nil {
+		result.DefaultProgram = make(map[string]*string, len(d.DefaultProgram))
+		for k, e := range d.DefaultProgram {
+			if e != nil {
+				v := *e
+				e = &v
+			}
+			result.DefaultProgram[k] = e
+		}
+	}
+	return result
+}
+
+// Equal returns true if the fields of the DictionaryWrapper and other are equal.  Times are equal if they represent the
+// same instant.  Their autorest.Response isn't compared.
+func (d DictionaryWrapper) Equal(other DictionaryWrapper) bool {
+	return d.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (d DictionaryWrapper) EqualIgnoreReadOnly(other DictionaryWrapper) bool {
+	return d.equal(other, true)
+}
+
+// equal compares the fields of the DictionaryWrapper and other, skipping the read-only ones if ignoreReadOnly is true.
+func (d DictionaryWrapper) equal(other DictionaryWrapper, ignoreReadOnly bool) bool {
+	if (d.DefaultProgram == nil) != (other.DefaultProgram == nil) || len(d.DefaultProgram) != len(other.DefaultProgram) {
+		return false
+	}
+	for k, e := range d.DefaultProgram {
+		f, ok := other.DefaultProgram[k]
+		if !ok || (e == nil) != (f == nil) || (e != nil && *e != *f) {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the DictionaryWrapper to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (d *DictionaryWrapper) MergeFrom(patch DictionaryWrapper) {
+	if patch.DefaultProgram != nil {
+		if d.DefaultProgram == nil {
+			d.DefaultProgram = make(map[string]*string, len(patch.DefaultProgram))
+		}
+		for k, e := range patch.DefaultProgram {
+			if e != nil {
+				v := *e
+				e = &v
+			}
+			d.DefaultProgram[k] = e
+		}
+	}
+}
+
 // Dog ...
 type Dog struct {
 	Food *string `json:"food,omitempty"`
@@ -354,6 +1352,65 @@ type Dog struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// DeepCopy returns a copy of the Dog that doesn't share any pointers, slices or maps with it.
+func (d Dog) DeepCopy() Dog {
+	result := d
+	if d.Food != nil {
+		v := *d.Food
+		result.Food = &v
+	}
+	if d.ID != nil {
+		v := *d.ID
+		result.ID = &v
+	}
+	if d.Name != nil {
+		v := *d.Name
+		result.Name = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Dog and other are equal.  Times are equal if they represent the same instant.
+func (d Dog) Equal(other Dog) bool {
+	return d.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (d Dog) EqualIgnoreReadOnly(other Dog) bool {
+	return d.equal(other, true)
+}
+
+// equal compares the fields of the Dog and other, skipping the read-only ones if ignoreReadOnly is true.
+func (d Dog) equal(other Dog, ignoreReadOnly bool) bool {
+	if (d.Food == nil) != (other.Food == nil) || (d.Food != nil && *d.Food != *other.Food) {
+		return false
+	}
+	if (d.ID == nil) != (other.ID == nil) || (d.ID != nil && *d.ID != *other.ID) {
+		return false
+	}
+	if (d.Name == nil) != (other.Name == nil) || (d.Name != nil && *d.Name != *other.Name) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Dog to copies of the fields set in patch.  Nested models and maps are merged, the
+// values of the other fields are replaced.  The read-only fields aren't changed.
+func (d *Dog) MergeFrom(patch Dog) {
+	if patch.Food != nil {
+		v := *patch.Food
+		d.Food = &v
+	}
+	if patch.ID != nil {
+		v := *patch.ID
+		d.ID = &v
+	}
+	if patch.Name != nil {
+		v := *patch.Name
+		d.Name = &v
+	}
+}
+
 // BasicDotFish ...
 type BasicDotFish interface {
 	AsDotSalmon() (*DotSalmon, bool)
@@ -369,25 +1426,28 @@ type DotFish struct {
 }
 
 func unmarshalBasicDotFish(body []byte) (BasicDotFish, error) {
-	var m map[string]interface{}
-	err := json.Unmarshal(body, &m)
+	discriminator, err := unmarshalDiscriminator(body, "fish.type")
 	if err != nil {
 		return nil, err
 	}
 
-	switch m["fish.type"] {
+	switch discriminator {
 	case string(FishTypeDotSalmon):
 		var ds DotSalmon
 		err := json.Unmarshal(body, &ds)
 		return ds, err
-	default:
+	case string(FishTypeDotFish), nil:
 		var df DotFish
 		err := json.Unmarshal(body, &df)
 		return df, err
+	default:
+		var udf UnknownDotFish
+		err := udf.unmarshal(discriminator, body)
+		return udf, err
 	}
 }
 func unmarshalBasicDotFishArray(body []byte) ([]BasicDotFish, error) {
-	var rawMessages []*json.RawMessage
+	var rawMessages []json.RawMessage
 	err := json.Unmarshal(body, &rawMessages)
 	if err != nil {
 		return nil, err
@@ -396,7 +1456,7 @@ func unmarshalBasicDotFishArray(body []byte) ([]BasicDotFish, error) {
 	dfArray := make([]BasicDotFish, len(rawMessages))
 
 	for index, rawMessage := range rawMessages {
-		df, err := unmarshalBasicDotFish(*rawMessage)
+		df, err := unmarshalBasicDotFish(rawMessage)
 		if err != nil {
 			return nil, err
 		}
@@ -405,6 +1465,65 @@ func unmarshalBasicDotFishArray(body []byte) ([]BasicDotFish, error) {
 	return dfArray, nil
 }
 
+// deepCopyBasicDotFish returns a deep copy of the BasicDotFish, or the value itself if its type isn't one of the package's or a
+// pointer to one.
+func deepCopyBasicDotFish(value BasicDotFish) BasicDotFish {
+	switch v := value.(type) {
+	case DotSalmon:
+		return v.DeepCopy()
+	case *DotSalmon:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	case DotFish:
+		return v.DeepCopy()
+	case *DotFish:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	case UnknownDotFish:
+		return v.DeepCopy()
+	case *UnknownDotFish:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	default:
+		return value
+	}
+}
+
+// equalBasicDotFish returns true if a and b are of the same type and their fields are equal.
+func equalBasicDotFish(a, b BasicDotFish, ignoreReadOnly bool) bool {
+	switch v := a.(type) {
+	case DotSalmon:
+		w, ok := b.(DotSalmon)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *DotSalmon:
+		w, ok := b.(*DotSalmon)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	case DotFish:
+		w, ok := b.(DotFish)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *DotFish:
+		w, ok := b.(*DotFish)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	case UnknownDotFish:
+		w, ok := b.(UnknownDotFish)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *UnknownDotFish:
+		w, ok := b.(*UnknownDotFish)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
 // MarshalJSON is the custom marshaler for DotFish.
 func (df DotFish) MarshalJSON() ([]byte, error) {
 	df.FishType = FishTypeDotFish
@@ -433,6 +1552,51 @@ func (df DotFish) AsBasicDotFish() (BasicDotFish, bool) {
 	return &df, true
 }
 
+// DeepCopy returns a copy of the DotFish that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (df DotFish) DeepCopy() DotFish {
+	result := df
+	if df.Species != nil {
+		v := *df.Species
+		result.Species = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the DotFish and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (df DotFish) Equal(other DotFish) bool {
+	return df.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (df DotFish) EqualIgnoreReadOnly(other DotFish) bool {
+	return df.equal(other, true)
+}
+
+// equal compares the fields of the DotFish and other, skipping the read-only ones if ignoreReadOnly is true.
+func (df DotFish) equal(other DotFish, ignoreReadOnly bool) bool {
+	if (df.Species == nil) != (other.Species == nil) || (df.Species != nil && *df.Species != *other.Species) {
+		return false
+	}
+	if df.FishType != other.FishType {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the DotFish to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (df *DotFish) MergeFrom(patch DotFish) {
+	if patch.Species != nil {
+		v := *patch.Species
+		df.Species = &v
+	}
+	if patch.FishType != "" {
+		df.FishType = patch.FishType
+	}
+}
+
 // DotFishMarket ...
 type DotFishMarket struct {
 	autorest.Response `json:"-"`
@@ -491,6 +1655,120 @@ func (dfm *DotFishMarket) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// DeepCopy returns a copy of the DotFishMarket that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (dfm DotFishMarket) DeepCopy() DotFishMarket {
+	result := dfm
+	if dfm.SampleSalmon != nil {
+		v := dfm.SampleSalmon.DeepCopy()
+		result.SampleSalmon = &v
+	}
+	if dfm.Salmons != nil {
+		var v []DotSalmon
+		if *dfm.Salmons != nil {
+			v = make([]DotSalmon, len(*dfm.Salmons))
+			for i := range *dfm.Salmons {
+				v[i] = (*dfm.Salmons)[i].DeepCopy()
+			}
+		}
+		result.Salmons = &v
+	}
+	result.SampleFish = deepCopyBasicDotFish(dfm.SampleFish)
+	if dfm.Fishes != nil {
+		var v []BasicDotFish
+		if *dfm.Fishes != nil {
+			v = make([]BasicDotFish, len(*dfm.Fishes))
+			for i := range *dfm.Fishes {
+				v[i] = deepCopyBasicDotFish((*dfm.Fishes)[i])
+			}
+		}
+		result.Fishes = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the DotFishMarket and other are equal.  Times are equal if they represent the
+// same instant.  Their autorest.Response isn't compared.
+func (dfm DotFishMarket) Equal(other DotFishMarket) bool {
+	return dfm.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (dfm DotFishMarket) EqualIgnoreReadOnly(other DotFishMarket) bool {
+	return dfm.equal(other, true)
+}
+
+// equal compares the fields of the DotFishMarket and other, skipping the read-only ones if ignoreReadOnly is true.
+func (dfm DotFishMarket) equal(other DotFishMarket, ignoreReadOnly bool) bool {
+	if (dfm.SampleSalmon == nil) != (other.SampleSalmon == nil) || (dfm.SampleSalmon != nil && !dfm.SampleSalmon.equal(*other.SampleSalmon, ignoreReadOnly)) {
+		return false
+	}
+	if (dfm.Salmons == nil) != (other.Salmons == nil) {
+		return false
+	}
+	if dfm.Salmons != nil {
+		if (*dfm.Salmons == nil) != (*other.Salmons == nil) || len(*dfm.Salmons) != len(*other.Salmons) {
+			return false
+		}
+		for i := range *dfm.Salmons {
+			if !(*dfm.Salmons)[i].equal((*other.Salmons)[i], ignoreReadOnly) {
+				return false
+			}
+		}
+	}
+	if !equalBasicDotFish(dfm.SampleFish, other.SampleFish, ignoreReadOnly) {
+		return false
+	}
+	if (dfm.Fishes == nil) != (other.Fishes == nil) {
+		return false
+	}
+	if dfm.Fishes != nil {
+		if (*dfm.Fishes == nil) != (*other.Fishes == nil) || len(*dfm.Fishes) != len(*other.Fishes) {
+			return false
+		}
+		for i := range *dfm.Fishes {
+			if !equalBasicDotFish((*dfm.Fishes)[i], (*other.Fishes)[i], ignoreReadOnly) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the DotFishMarket to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (dfm *DotFishMarket) MergeFrom(patch DotFishMarket) {
+	if patch.SampleSalmon != nil {
+		if dfm.SampleSalmon == nil {
+			dfm.SampleSalmon = &DotSalmon{}
+		}
+		dfm.SampleSalmon.MergeFrom(*patch.SampleSalmon)
+	}
+	if patch.Salmons != nil {
+		var v []DotSalmon
+		if *patch.Salmons != nil {
+			v = make([]DotSalmon, len(*patch.Salmons))
+			for i := range *patch.Salmons {
+				v[i] = (*patch.Salmons)[i].DeepCopy()
+			}
+		}
+		dfm.Salmons = &v
+	}
+	if patch.SampleFish != nil {
+		dfm.SampleFish = deepCopyBasicDotFish(patch.SampleFish)
+	}
+	if patch.Fishes != nil {
+		var v []BasicDotFish
+		if *patch.Fishes != nil {
+			v = make([]BasicDotFish, len(*patch.Fishes))
+			for i := range *patch.Fishes {
+				v[i] = deepCopyBasicDotFish((*patch.Fishes)[i])
+			}
+		}
+		dfm.Fishes = &v
+	}
+}
+
 // DotFishModel ...
 type DotFishModel struct {
 	autorest.Response `json:"-"`
@@ -508,6 +1786,41 @@ func (dfm *DotFishModel) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// DeepCopy returns a copy of the DotFishModel that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (dfm DotFishModel) DeepCopy() DotFishModel {
+	result := dfm
+	result.Value = deepCopyBasicDotFish(dfm.Value)
+	return result
+}
+
+// Equal returns true if the fields of the DotFishModel and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (dfm DotFishModel) Equal(other DotFishModel) bool {
+	return dfm.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (dfm DotFishModel) EqualIgnoreReadOnly(other DotFishModel) bool {
+	return dfm.equal(other, true)
+}
+
+// equal compares the fields of the DotFishModel and other, skipping the read-only ones if ignoreReadOnly is true.
+func (dfm DotFishModel) equal(other DotFishModel, ignoreReadOnly bool) bool {
+	if !equalBasicDotFish(dfm.Value, other.Value, ignoreReadOnly) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the DotFishModel to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (dfm *DotFishModel) MergeFrom(patch DotFishModel) {
+	if patch.Value != nil {
+		dfm.Value = deepCopyBasicDotFish(patch.Value)
+	}
+}
+
 // DotSalmon ...
 type DotSalmon struct {
 	Location *string `json:"location,omitempty"`
@@ -551,6 +1864,72 @@ func (ds DotSalmon) AsBasicDotFish() (BasicDotFish, bool) {
 	return &ds, true
 }
 
+// DeepCopy returns a copy of the DotSalmon that doesn't share any pointers, slices or maps with it.
+func (ds DotSalmon) DeepCopy() DotSalmon {
+	result := ds
+	if ds.Location != nil {
+		v := *ds.Location
+		result.Location = &v
+	}
+	if ds.Iswild != nil {
+		v := *ds.Iswild
+		result.Iswild = &v
+	}
+	if ds.Species != nil {
+		v := *ds.Species
+		result.Species = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the DotSalmon and other are equal.  Times are equal if they represent the same
+// instant.
+func (ds DotSalmon) Equal(other DotSalmon) bool {
+	return ds.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (ds DotSalmon) EqualIgnoreReadOnly(other DotSalmon) bool {
+	return ds.equal(other, true)
+}
+
+// equal compares the fields of the DotSalmon and other, skipping the read-only ones if ignoreReadOnly is true.
+func (ds DotSalmon) equal(other DotSalmon, ignoreReadOnly bool) bool {
+	if (ds.Location == nil) != (other.Location == nil) || (ds.Location != nil && *ds.Location != *other.Location) {
+		return false
+	}
+	if (ds.Iswild == nil) != (other.Iswild == nil) || (ds.Iswild != nil && *ds.Iswild != *other.Iswild) {
+		return false
+	}
+	if (ds.Species == nil) != (other.Species == nil) || (ds.Species != nil && *ds.Species != *other.Species) {
+		return false
+	}
+	if ds.FishType != other.FishType {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the DotSalmon to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields aren't changed.
+func (ds *DotSalmon) MergeFrom(patch DotSalmon) {
+	if patch.Location != nil {
+		v := *patch.Location
+		ds.Location = &v
+	}
+	if patch.Iswild != nil {
+		v := *patch.Iswild
+		ds.Iswild = &v
+	}
+	if patch.Species != nil {
+		v := *patch.Species
+		ds.Species = &v
+	}
+	if patch.FishType != "" {
+		ds.FishType = patch.FishType
+	}
+}
+
 // DoubleWrapper ...
 type DoubleWrapper struct {
 	autorest.Response                                                               `json:"-"`
@@ -558,20 +1937,509 @@ type DoubleWrapper struct {
 	Field56ZerosAfterTheDotAndNegativeZeroBeforeDotAndThisIsALongFieldNameOnPurpose *float64 `json:"field_56_zeros_after_the_dot_and_negative_zero_before_dot_and_this_is_a_long_field_name_on_purpose,omitempty"`
 }
 
-// DurationWrapper ...
-type DurationWrapper struct {
-	autorest.Response `json:"-"`
-	Field             *string `json:"field,omitempty"`
+// DeepCopy returns a copy of the DoubleWrapper that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (dw DoubleWrapper) DeepCopy() DoubleWrapper {
+	result := dw
+	if dw.Field1 != nil {
+		v := *dw.Field1
+		result.Field1 = &v
+	}
+	if dw.Field56ZerosAfterTheDotAndNegativeZeroBeforeDotAndThisIsALongFieldNameOnPurpose != nil {
+		v := *dw.Field56ZerosAfterTheDotAndNegativeZeroBeforeDotAndThisIsALongFieldNameOnPurpose
+		result.Field56ZerosAfterTheDotAndNegativeZeroBeforeDotAndThisIsALongFieldNameOnPurpose = &v
+	}
+	return result
 }
 
-// Error ...
-type Error struct {
-	Status  *int32  `json:"status,omitempty"`
-	Message *string `json:"message,omitempty"`
+// Equal returns true if the fields of the DoubleWrapper and other are equal.  Times are equal if they represent the
+// same instant.  Their autorest.Response isn't compared.
+func (dw DoubleWrapper) Equal(other DoubleWrapper) bool {
+	return dw.equal(other, false)
 }
 
-// BasicFish ...
-type BasicFish interface {
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (dw DoubleWrapper) EqualIgnoreReadOnly(other DoubleWrapper) bool {
+	return dw.equal(other, true)
+}
+
+// equal compares the fields of the DoubleWrapper and other, skipping the read-only ones if ignoreReadOnly is true.
+func (dw DoubleWrapper) equal(other DoubleWrapper, ignoreReadOnly bool) bool {
+	if (dw.Field1 == nil) != (other.Field1 == nil) || (dw.Field1 != nil && *dw.Field1 != *other.Field1) {
+		return false
+	}
+	if (dw.Field56ZerosAfterTheDotAndNegativeZeroBeforeDotAndThisIsALongFieldNameOnPurpose == nil) != (other.Field56ZerosAfterTheDotAndNegativeZeroBeforeDotAndThisIsALongFieldNameOnPurpose == nil) || (dw.Field56ZerosAfterTheDotAndNegativeZeroBeforeDotAndThisIsALongFieldNameOnPurpose != nil && *dw.Field56ZerosAfterTheDotAndNegativeZeroBeforeDotAndThisIsALongFieldNameOnPurpose != *other.Field56ZerosAfterTheDotAndNegativeZeroBeforeDotAndThisIsALongFieldNameOnPurpose) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the DoubleWrapper to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (dw *DoubleWrapper) MergeFrom(patch DoubleWrapper) {
+	if patch.Field1 != nil {
+		v := *patch.Field1
+		dw.Field1 = &v
+	}
+	if patch.Field56ZerosAfterTheDotAndNegativeZeroBeforeDotAndThisIsALongFieldNameOnPurpose != nil {
+		v := *patch.Field56ZerosAfterTheDotAndNegativeZeroBeforeDotAndThisIsALongFieldNameOnPurpose
+		dw.Field56ZerosAfterTheDotAndNegativeZeroBeforeDotAndThisIsALongFieldNameOnPurpose = &v
+	}
+}
+
+// DurationWrapper ...
+type DurationWrapper struct {
+	autorest.Response `json:"-"`
+	Field             *string `json:"field,omitempty"`
+}
+
+// DeepCopy returns a copy of the DurationWrapper that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (dw DurationWrapper) DeepCopy() DurationWrapper {
+	result := dw
+	if dw.Field != nil {
+		v := *dw.Field
+		result.Field = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the DurationWrapper and other are equal.  Times are equal if they represent the
+// same instant.  Their autorest.Response isn't compared.
+func (dw DurationWrapper) Equal(other DurationWrapper) bool {
+	return dw.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (dw DurationWrapper) EqualIgnoreReadOnly(other DurationWrapper) bool {
+	return dw.equal(other, true)
+}
+
+// equal compares the fields of the DurationWrapper and other, skipping the read-only ones if ignoreReadOnly is true.
+func (dw DurationWrapper) equal(other DurationWrapper, ignoreReadOnly bool) bool {
+	if (dw.Field == nil) != (other.Field == nil) || (dw.Field != nil && *dw.Field != *other.Field) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the DurationWrapper to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (dw *DurationWrapper) MergeFrom(patch DurationWrapper) {
+	if patch.Field != nil {
+		v := *patch.Field
+		dw.Field = &v
+	}
+}
+
+// Error ...
+type Error struct {
+	Status  *int32  `json:"status,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+// DeepCopy returns a copy of the Error that doesn't share any pointers, slices or maps with it.
+func (e Error) DeepCopy() Error {
+	result := e
+	if e.Status != nil {
+		v := *e.Status
+		result.Status = &v
+	}
+	if e.Message != nil {
+		v := *e.Message
+		result.Message = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Error and other are equal.  Times are equal if they represent the same
+// instant.
+func (e Error) Equal(other Error) bool {
+	return e.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (e Error) EqualIgnoreReadOnly(other Error) bool {
+	return e.equal(other, true)
+}
+
+// equal compares the fields of the Error and other, skipping the read-only ones if ignoreReadOnly is true.
+func (e Error) equal(other Error, ignoreReadOnly bool) bool {
+	if (e.Status == nil) != (other.Status == nil) || (e.Status != nil && *e.Status != *other.Status) {
+		return false
+	}
+	if (e.Message == nil) != (other.Message == nil) || (e.Message != nil && *e.Message != *other.Message) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Error to copies of the fields set in patch.  Nested models and maps are merged, the
+// values of the other fields are replaced.  The read-only fields aren't changed.
+func (e *Error) MergeFrom(patch Error) {
+	if patch.Status != nil {
+		v := *patch.Status
+		e.Status = &v
+	}
+	if patch.Message != nil {
+		v := *patch.Message
+		e.Message = &v
+	}
+}
+
+// ErrorResponse is returned when an operation fails with a response containing the Error model.  Unlike the other
+// errors of the operations it isn't wrapped in an autorest.DetailedError.
+type ErrorResponse struct {
+	// Model - the error model unmarshalled from the response body.
+	Model Error
+	// StatusCode - the HTTP status code of the response.
+	StatusCode int
+	// Body - the raw response body.
+	Body []byte
+	// Err - the error encountered reading or unmarshalling the response body, if any.
+	Err error
+}
+
+// Error implements the error interface for type ErrorResponse.
+func (er ErrorResponse) Error() string {
+	if er.Err != nil {
+		return fmt.Sprintf("complexgroup: StatusCode=%d Body=%s Err=%v", er.StatusCode, string(er.Body), er.Err)
+	}
+	return fmt.Sprintf("complexgroup: StatusCode=%d Body=%s", er.StatusCode, string(er.Body))
+}
+
+// Unwrap returns the error encountered reading or unmarshalling the response body, if any.
+func (er ErrorResponse) Unwrap() error {
+	return er.Err
+}
+
+// withErrorResponseUnlessStatusCode returns a RespondDecorator that returns an ErrorResponse if the
+// response's status code isn't one of the specified codes.  The response body is left available to the caller.
+// When the body can't be read or unmarshalled the returned ErrorResponse wraps the error in its Err field.
+func withErrorResponseUnlessStatusCode(codes ...int) autorest.RespondDecorator {
+	return func(r autorest.Responder) autorest.Responder {
+		return autorest.ResponderFunc(func(resp *http.Response) error {
+			err := r.Respond(resp)
+			if err != nil || autorest.ResponseHasStatusCode(resp, codes...) {
+				return err
+			}
+			defer resp.Body.Close()
+			er := ErrorResponse{StatusCode: resp.StatusCode}
+			er.Body, er.Err = ioutil.ReadAll(resp.Body)
+			resp.Body = ioutil.NopCloser(bytes.NewReader(er.Body))
+			if er.Err == nil && len(bytes.TrimSpace(er.Body)) > 0 {
+				er.Err = json.Unmarshal(er.Body, &er.Model)
+			}
+			return er
+		})
+	}
+}
+
+// ExponentialRetry is a RetryPolicy that retries failed requests with exponentially increasing delays.  When the
+// RetryPolicy of a client is nil one is created from its RetryAttempts and RetryDuration.  Unlike
+// autorest.DoRetryForStatusCodes it only retries idempotent requests unless RetryNonIdempotent is true, so POST and
+// PATCH requests, including the initial requests of long-running operations, aren't retried unless the service marks
+// their operation x-ms-idempotent.  Responses with status code 429 count against MaxRetries.
+type ExponentialRetry struct {
+	// MaxRetries - the maximum number of times a request is retried.
+	MaxRetries int
+	// Delay - the delay before the first retry.  The delay is doubled for each following retry.
+	Delay time.Duration
+	// MaxDelay - the maximum delay between retries.  Zero means no limit.
+	MaxDelay time.Duration
+	// MaxElapsed - requests aren't retried once the delay would exceed this much time since the first attempt.  Zero means no limit.
+	MaxElapsed time.Duration
+	// Jitter - the fraction, from 0 to 1, of each delay that's randomized to spread out the retries of concurrent requests.
+	Jitter float64
+	// StatusCodes - the status codes of the responses that are retried.  Defaults to autorest.StatusCodesForRetry.
+	StatusCodes []int
+	// RetryNonIdempotent - if true, requests that aren't idempotent, e.g. POST requests of operations the service doesn't mark as idempotent, are retried too.
+	RetryNonIdempotent bool
+}
+
+// defaultRetryJitter is the Jitter of the ExponentialRetry used when the RetryPolicy of a client is nil.
+const defaultRetryJitter = 0.2
+
+// RetryPolicy decides if and when a failed attempt to send a request is retried.
+type RetryPolicy interface {
+	// ShouldRetry returns the delay before the request is sent again, or false if it isn't retried.
+	ShouldRetry(attempt RetryAttempt) (time.Duration, bool)
+}
+
+// LogEvent is an event logged by the operations of a client when its Logger isn't nil.  The URLs and headers of the
+// requests aren't included as they can contain secrets.
+type LogEvent struct {
+	// Kind - the kind of the event.
+	Kind LogEventKind
+	// Operation - the name of the operation, qualified by the name of its client.
+	Operation string
+	// Fields - the parameters of the operation keyed by their names in the request, for LogOperationStart events.  Body parameters aren't included and the values of secret parameters are replaced with REDACTED.
+	Fields map[string]interface{}
+	// Attempt - the number of the failed attempt, starting at one, for LogRetry events.
+	Attempt int
+	// Delay - the delay before the request is sent again, for LogRetry events.
+	Delay time.Duration
+	// State - the status of the long-running operation, for LogPoll events.
+	State string
+	// StatusCode - the status code of the response, or zero if none was received.
+	StatusCode int
+	// Elapsed - the time elapsed since the operation, the page fetch or the polling started.
+	Elapsed time.Duration
+	// Err - the error the operation, the attempt or the poll failed with, if any.
+	Err error
+}
+
+// redactedLogValue replaces the values of secret parameters in the Fields of a LogEvent.
+const redactedLogValue = "REDACTED"
+
+// LogEventKind is the kind of a LogEvent.
+type LogEventKind string
+
+const (
+	// LogOperationStart is logged when an operation starts, with its parameters.
+	LogOperationStart LogEventKind = "OperationStart"
+	// LogOperationFinish is logged when an operation finishes.
+	LogOperationFinish LogEventKind = "OperationFinish"
+	// LogRetry is logged when a failed attempt to send a request is retried.
+	LogRetry LogEventKind = "Retry"
+	// LogPageFetch is logged when the next page of the results of an operation has been fetched.
+	LogPageFetch LogEventKind = "PageFetch"
+	// LogPoll is logged each time PollUntilDone has polled the status of a long-running operation.
+	LogPoll LogEventKind = "Poll"
+)
+
+// Logger receives the events logged by the operations of a client.
+type Logger interface {
+	// Log is called synchronously for each event.
+	Log(event LogEvent)
+}
+
+// LoggerFunc is a function implementing the Logger interface.
+type LoggerFunc func(event LogEvent)
+
+// Log implements the Logger interface for LoggerFunc.
+func (lf LoggerFunc) Log(event LogEvent) {
+	lf(event)
+}
+
+// String formats the event as a line of key=value pairs, e.g. to pass to log.Print.
+func (le LogEvent) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "event=%s operation=%s", le.Kind, le.Operation)
+	names := make([]string, 0, len(le.Fields))
+	for name := range le.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&b, " %s=%v", name, le.Fields[name])
+	}
+	if le.Attempt > 0 {
+		fmt.Fprintf(&b, " attempt=%d", le.Attempt)
+	}
+	if le.State != "" {
+		fmt.Fprintf(&b, " state=%s", le.State)
+	}
+	if le.StatusCode > 0 {
+		fmt.Fprintf(&b, " status=%d", le.StatusCode)
+	}
+	if le.Delay > 0 {
+		fmt.Fprintf(&b, " delay=%v", le.Delay)
+	}
+	if le.Kind != LogOperationStart {
+		fmt.Fprintf(&b, " elapsed=%v", le.Elapsed)
+	}
+	if le.Err != nil {
+		fmt.Fprintf(&b, " error=%q", le.Err.Error())
+	}
+	return b.String()
+}
+
+// operationLog is the state used to log the events of an operation, carried by the contexts of its requests.
+type operationLog struct {
+	logger    Logger
+	operation string
+	start     time.Time
+}
+
+// operationLogKey is the key of the operationLog in a context.
+type operationLogKey struct{}
+
+// withOperationLog returns a context carrying the state used to log the events of operation to logger.
+func withOperationLog(ctx context.Context, logger Logger, operation string) context.Context {
+	return context.WithValue(ctx, operationLogKey{}, &operationLog{logger: logger, operation: operation, start: time.Now()})
+}
+
+// startOperationLog logs the start of operation with its parameters, then returns a context carrying the state used to
+// log its other events.
+func startOperationLog(ctx context.Context, logger Logger, operation string, fields map[string]interface{}) context.Context {
+	for name, value := range fields {
+		// log the values of optional parameters rather than their addresses
+		v := reflect.ValueOf(value)
+		for v.Kind() == reflect.Ptr && !v.IsNil() {
+			v = v.Elem()
+		}
+		if v.Kind() == reflect.Ptr {
+			fields[name] = nil
+		} else if v.IsValid() {
+			fields[name] = v.Interface()
+		}
+	}
+	ctx = withOperationLog(ctx, logger, operation)
+	logger.Log(LogEvent{Kind: LogOperationStart, Operation: operation, Fields: fields})
+	return ctx
+}
+
+// logResponse logs event for the operation whose state is carried by ctx, if any, along with the status code of resp
+// if it isn't nil.
+func logResponse(ctx context.Context, event LogEvent, resp *http.Response) {
+	ol, ok := ctx.Value(operationLogKey{}).(*operationLog)
+	if !ok {
+		return
+	}
+	event.Operation = ol.operation
+	event.Elapsed = time.Since(ol.start)
+	if resp != nil {
+		event.StatusCode = resp.StatusCode
+	}
+	ol.logger.Log(event)
+}
+
+// RetryAttempt describes a failed attempt to send a request.
+type RetryAttempt struct {
+	// Request - the request that was sent.
+	Request *http.Request
+	// Response - the response received, or nil if sending the request failed.
+	Response *http.Response
+	// Err - the error returned when sending the request, if any.
+	Err error
+	// Attempt - the number of the attempt, starting at one.
+	Attempt int
+	// Elapsed - the time elapsed since the first attempt was sent.
+	Elapsed time.Duration
+	// Idempotent - true if the request can safely be sent more than once, either because its HTTP method is
+	// idempotent or because the service marks the operation as idempotent.
+	Idempotent bool
+}
+
+// ShouldRetry implements the RetryPolicy interface for ExponentialRetry.  A delay requested by the Retry-After header
+// of the response takes precedence over the computed one.
+func (er ExponentialRetry) ShouldRetry(attempt RetryAttempt) (time.Duration, bool) {
+	if attempt.Attempt > er.MaxRetries || !(attempt.Idempotent || er.RetryNonIdempotent) {
+		return 0, false
+	}
+	if attempt.Err != nil {
+		if !autorest.IsTemporaryNetworkError(attempt.Err) || autorest.IsTokenRefreshError(attempt.Err) {
+			return 0, false
+		}
+	} else {
+		codes := er.StatusCodes
+		if codes == nil {
+			codes = autorest.StatusCodesForRetry
+		}
+		if !autorest.ResponseHasStatusCode(attempt.Response, codes...) {
+			return 0, false
+		}
+	}
+	delay, ok := retryAfter(attempt.Response)
+	if !ok {
+		delay = er.Delay
+		for i := 1; i < attempt.Attempt && delay < math.MaxInt64/2; i++ {
+			delay *= 2
+		}
+		if er.MaxDelay > 0 && delay > er.MaxDelay {
+			delay = er.MaxDelay
+		}
+		if er.Jitter > 0 {
+			delay -= time.Duration(math.Min(er.Jitter, 1) * rand.Float64() * float64(delay))
+		}
+	}
+	if er.MaxElapsed > 0 && attempt.Elapsed+delay > er.MaxElapsed {
+		return 0, false
+	}
+	return delay, true
+}
+
+// retryAfter returns the delay requested by the Retry-After header of resp, and false if it doesn't have one.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	ra := resp.Header.Get("Retry-After")
+	if ra == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(ra); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(ra); err == nil {
+		if delay := time.Until(t); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// isIdempotentMethod returns true if requests using the HTTP method can safely be sent more than once.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodTrace:
+		return true
+	}
+	return false
+}
+
+// withRetryPolicy returns a SendDecorator that sends a request again after each failed attempt policy decides to retry.
+// If policy is nil an ExponentialRetry created from the RetryAttempts and RetryDuration of c is used.  idempotent is
+// true for operations the service marks as idempotent.
+func withRetryPolicy(policy RetryPolicy, c autorest.Client, idempotent bool) autorest.SendDecorator {
+	if policy == nil {
+		policy = ExponentialRetry{MaxRetries: c.RetryAttempts, Delay: c.RetryDuration, Jitter: defaultRetryJitter}
+	}
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+			idempotent := idempotent || isIdempotentMethod(r.Method)
+			start := time.Now()
+			for attempt := 1; ; attempt++ {
+				if err := rr.Prepare(); err != nil {
+					return nil, err
+				}
+				resp, err := s.Do(rr.Request())
+				if r.Context().Err() != nil {
+					return resp, err
+				}
+				delay, retry := policy.ShouldRetry(RetryAttempt{
+					Request:    rr.Request(),
+					Response:   resp,
+					Err:        err,
+					Attempt:    attempt,
+					Elapsed:    time.Since(start),
+					Idempotent: idempotent,
+				})
+				if !retry {
+					return resp, err
+				}
+				logResponse(r.Context(), LogEvent{Kind: LogRetry, Attempt: attempt, Delay: delay, Err: err}, resp)
+				setSpanAttribute(r.Context(), AttributeRetryCount, attempt)
+				if resp != nil {
+					autorest.Respond(resp, autorest.ByDiscardingBody(), autorest.ByClosing())
+				}
+				timer := time.NewTimer(delay)
+				select {
+				case <-timer.C:
+				case <-r.Context().Done():
+					timer.Stop()
+					return nil, r.Context().Err()
+				}
+			}
+		})
+	}
+}
+
+// BasicFish ...
+type BasicFish interface {
 	AsSalmon() (*Salmon, bool)
 	AsBasicSalmon() (BasicSalmon, bool)
 	AsSmartSalmon() (*SmartSalmon, bool)
@@ -581,6 +2449,7 @@ type BasicFish interface {
 	AsGoblinshark() (*Goblinshark, bool)
 	AsCookiecuttershark() (*Cookiecuttershark, bool)
 	AsFish() (*Fish, bool)
+	Validate() error
 }
 
 // Fish ...
@@ -589,50 +2458,53 @@ type Fish struct {
 	Species           *string      `json:"species,omitempty"`
 	Length            *float64     `json:"length,omitempty"`
 	Siblings          *[]BasicFish `json:"siblings,omitempty"`
-	// Fishtype - Possible values include: 'FishtypeFish', 'FishtypeSalmon', 'FishtypeSmartSalmon', 'FishtypeShark', 'FishtypeSawshark', 'FishtypeGoblin', 'FishtypeCookiecuttershark'
+	// Fishtype - Possible values include: 'FishtypeBasicFishFish', 'FishtypeBasicFishSalmon', 'FishtypeBasicFishSmartSalmon', 'FishtypeBasicFishShark', 'FishtypeBasicFishSawshark', 'FishtypeBasicFishGoblin', 'FishtypeBasicFishCookiecuttershark'
 	Fishtype FishtypeBasicFish `json:"fishtype,omitempty"`
 }
 
 func unmarshalBasicFish(body []byte) (BasicFish, error) {
-	var m map[string]interface{}
-	err := json.Unmarshal(body, &m)
+	discriminator, err := unmarshalDiscriminator(body, "fishtype")
 	if err != nil {
 		return nil, err
 	}
 
-	switch m["fishtype"] {
-	case string(FishtypeSalmon):
+	switch discriminator {
+	case string(FishtypeBasicFishSalmon):
 		var s Salmon
 		err := json.Unmarshal(body, &s)
 		return s, err
-	case string(FishtypeSmartSalmon):
+	case string(FishtypeBasicFishSmartSalmon):
 		var s SmartSalmon
 		err := json.Unmarshal(body, &s)
 		return s, err
-	case string(FishtypeShark):
+	case string(FishtypeBasicFishShark):
 		var s Shark
 		err := json.Unmarshal(body, &s)
 		return s, err
-	case string(FishtypeSawshark):
+	case string(FishtypeBasicFishSawshark):
 		var s Sawshark
 		err := json.Unmarshal(body, &s)
 		return s, err
-	case string(FishtypeGoblin):
+	case string(FishtypeBasicFishGoblin):
 		var g Goblinshark
 		err := json.Unmarshal(body, &g)
 		return g, err
-	case string(FishtypeCookiecuttershark):
+	case string(FishtypeBasicFishCookiecuttershark):
 		var c Cookiecuttershark
 		err := json.Unmarshal(body, &c)
 		return c, err
-	default:
+	case string(FishtypeBasicFishFish), nil:
 		var f Fish
 		err := json.Unmarshal(body, &f)
 		return f, err
+	default:
+		var uf UnknownFish
+		err := uf.unmarshal(discriminator, body)
+		return uf, err
 	}
 }
 func unmarshalBasicFishArray(body []byte) ([]BasicFish, error) {
-	var rawMessages []*json.RawMessage
+	var rawMessages []json.RawMessage
 	err := json.Unmarshal(body, &rawMessages)
 	if err != nil {
 		return nil, err
@@ -641,7 +2513,7 @@ func unmarshalBasicFishArray(body []byte) ([]BasicFish, error) {
 	fArray := make([]BasicFish, len(rawMessages))
 
 	for index, rawMessage := range rawMessages {
-		f, err := unmarshalBasicFish(*rawMessage)
+		f, err := unmarshalBasicFish(rawMessage)
 		if err != nil {
 			return nil, err
 		}
@@ -650,9 +2522,166 @@ func unmarshalBasicFishArray(body []byte) ([]BasicFish, error) {
 	return fArray, nil
 }
 
+// deepCopyBasicFish returns a deep copy of the BasicFish, or the value itself if its type isn't one of the package's or a
+// pointer to one.
+func deepCopyBasicFish(value BasicFish) BasicFish {
+	switch v := value.(type) {
+	case Salmon:
+		return v.DeepCopy()
+	case *Salmon:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	case SmartSalmon:
+		return v.DeepCopy()
+	case *SmartSalmon:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	case Shark:
+		return v.DeepCopy()
+	case *Shark:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	case Sawshark:
+		return v.DeepCopy()
+	case *Sawshark:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	case Goblinshark:
+		return v.DeepCopy()
+	case *Goblinshark:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	case Cookiecuttershark:
+		return v.DeepCopy()
+	case *Cookiecuttershark:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	case Fish:
+		return v.DeepCopy()
+	case *Fish:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	case UnknownFish:
+		return v.DeepCopy()
+	case *UnknownFish:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	case UnknownSalmon:
+		return v.DeepCopy()
+	case *UnknownSalmon:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	case UnknownShark:
+		return v.DeepCopy()
+	case *UnknownShark:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	default:
+		return value
+	}
+}
+
+// equalBasicFish returns true if a and b are of the same type and their fields are equal.
+func equalBasicFish(a, b BasicFish, ignoreReadOnly bool) bool {
+	switch v := a.(type) {
+	case Salmon:
+		w, ok := b.(Salmon)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *Salmon:
+		w, ok := b.(*Salmon)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	case SmartSalmon:
+		w, ok := b.(SmartSalmon)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *SmartSalmon:
+		w, ok := b.(*SmartSalmon)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	case Shark:
+		w, ok := b.(Shark)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *Shark:
+		w, ok := b.(*Shark)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	case Sawshark:
+		w, ok := b.(Sawshark)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *Sawshark:
+		w, ok := b.(*Sawshark)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	case Goblinshark:
+		w, ok := b.(Goblinshark)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *Goblinshark:
+		w, ok := b.(*Goblinshark)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	case Cookiecuttershark:
+		w, ok := b.(Cookiecuttershark)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *Cookiecuttershark:
+		w, ok := b.(*Cookiecuttershark)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	case Fish:
+		w, ok := b.(Fish)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *Fish:
+		w, ok := b.(*Fish)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	case UnknownFish:
+		w, ok := b.(UnknownFish)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *UnknownFish:
+		w, ok := b.(*UnknownFish)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	case UnknownSalmon:
+		w, ok := b.(UnknownSalmon)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *UnknownSalmon:
+		w, ok := b.(*UnknownSalmon)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	case UnknownShark:
+		w, ok := b.(UnknownShark)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *UnknownShark:
+		w, ok := b.(*UnknownShark)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
 // MarshalJSON is the custom marshaler for Fish.
 func (f Fish) MarshalJSON() ([]byte, error) {
-	f.Fishtype = FishtypeFish
+	f.Fishtype = FishtypeBasicFishFish
 	objectMap := make(map[string]interface{})
 	if f.Species != nil {
 		objectMap["species"] = f.Species
@@ -769,6 +2798,108 @@ func (f *Fish) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// Validate checks that the Fish satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (f Fish) Validate() error {
+	var errs ValidationErrors
+	if f.Length == nil {
+		errs = append(errs, ValidationError{Path: "length", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// DeepCopy returns a copy of the Fish that doesn't share any pointers, slices or maps with it.  Its autorest.Response
+// is copied as is.
+func (f Fish) DeepCopy() Fish {
+	result := f
+	if f.Species != nil {
+		v := *f.Species
+		result.Species = &v
+	}
+	if f.Length != nil {
+		v := *f.Length
+		result.Length = &v
+	}
+	if f.Siblings != nil {
+		var v []BasicFish
+		if *f.Siblings != nil {
+			v = make([]BasicFish, len(*f.Siblings))
+			for i := range *f.Siblings {
+				v[i] = deepCopyBasicFish((*f.Siblings)[i])
+			}
+		}
+		result.Siblings = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Fish and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (f Fish) Equal(other Fish) bool {
+	return f.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (f Fish) EqualIgnoreReadOnly(other Fish) bool {
+	return f.equal(other, true)
+}
+
+// equal compares the fields of the Fish and other, skipping the read-only ones if ignoreReadOnly is true.
+func (f Fish) equal(other Fish, ignoreReadOnly bool) bool {
+	if (f.Species == nil) != (other.Species == nil) || (f.Species != nil && *f.Species != *other.Species) {
+		return false
+	}
+	if (f.Length == nil) != (other.Length == nil) || (f.Length != nil && *f.Length != *other.Length) {
+		return false
+	}
+	if (f.Siblings == nil) != (other.Siblings == nil) {
+		return false
+	}
+	if f.Siblings != nil {
+		if (*f.Siblings == nil) != (*other.Siblings == nil) || len(*f.Siblings) != len(*other.Siblings) {
+			return false
+		}
+		for i := range *f.Siblings {
+			if !equalBasicFish((*f.Siblings)[i], (*other.Siblings)[i], ignoreReadOnly) {
+				return false
+			}
+		}
+	}
+	if f.Fishtype != other.Fishtype {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Fish to copies of the fields set in patch.  Nested models and maps are merged, the
+// values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (f *Fish) MergeFrom(patch Fish) {
+	if patch.Species != nil {
+		v := *patch.Species
+		f.Species = &v
+	}
+	if patch.Length != nil {
+		v := *patch.Length
+		f.Length = &v
+	}
+	if patch.Siblings != nil {
+		var v []BasicFish
+		if *patch.Siblings != nil {
+			v = make([]BasicFish, len(*patch.Siblings))
+			for i := range *patch.Siblings {
+				v[i] = deepCopyBasicFish((*patch.Siblings)[i])
+			}
+		}
+		f.Siblings = &v
+	}
+	if patch.Fishtype != "" {
+		f.Fishtype = patch.Fishtype
+	}
+}
+
 // FishModel ...
 type FishModel struct {
 	autorest.Response `json:"-"`
@@ -786,6 +2917,41 @@ func (fm *FishModel) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// DeepCopy returns a copy of the FishModel that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (fm FishModel) DeepCopy() FishModel {
+	result := fm
+	result.Value = deepCopyBasicFish(fm.Value)
+	return result
+}
+
+// Equal returns true if the fields of the FishModel and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (fm FishModel) Equal(other FishModel) bool {
+	return fm.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (fm FishModel) EqualIgnoreReadOnly(other FishModel) bool {
+	return fm.equal(other, true)
+}
+
+// equal compares the fields of the FishModel and other, skipping the read-only ones if ignoreReadOnly is true.
+func (fm FishModel) equal(other FishModel, ignoreReadOnly bool) bool {
+	if !equalBasicFish(fm.Value, other.Value, ignoreReadOnly) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the FishModel to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (fm *FishModel) MergeFrom(patch FishModel) {
+	if patch.Value != nil {
+		fm.Value = deepCopyBasicFish(patch.Value)
+	}
+}
+
 // FloatWrapper ...
 type FloatWrapper struct {
 	autorest.Response `json:"-"`
@@ -793,23 +2959,73 @@ type FloatWrapper struct {
 	Field2            *float64 `json:"field2,omitempty"`
 }
 
+// DeepCopy returns a copy of the FloatWrapper that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (fw FloatWrapper) DeepCopy() FloatWrapper {
+	result := fw
+	if fw.Field1 != nil {
+		v := *fw.Field1
+		result.Field1 = &v
+	}
+	if fw.Field2 != nil {
+		v := *fw.Field2
+		result.Field2 = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the FloatWrapper and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (fw FloatWrapper) Equal(other FloatWrapper) bool {
+	return fw.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (fw FloatWrapper) EqualIgnoreReadOnly(other FloatWrapper) bool {
+	return fw.equal(other, true)
+}
+
+// equal compares the fields of the FloatWrapper and other, skipping the read-only ones if ignoreReadOnly is true.
+func (fw FloatWrapper) equal(other FloatWrapper, ignoreReadOnly bool) bool {
+	if (fw.Field1 == nil) != (other.Field1 == nil) || (fw.Field1 != nil && *fw.Field1 != *other.Field1) {
+		return false
+	}
+	if (fw.Field2 == nil) != (other.Field2 == nil) || (fw.Field2 != nil && *fw.Field2 != *other.Field2) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the FloatWrapper to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (fw *FloatWrapper) MergeFrom(patch FloatWrapper) {
+	if patch.Field1 != nil {
+		v := *patch.Field1
+		fw.Field1 = &v
+	}
+	if patch.Field2 != nil {
+		v := *patch.Field2
+		fw.Field2 = &v
+	}
+}
+
 // Goblinshark ...
 type Goblinshark struct {
 	Jawsize *int32 `json:"jawsize,omitempty"`
-	// Color - Colors possible. Possible values include: 'Pink', 'Gray', 'Brown'
+	// Color - Colors possible. Possible values include: 'GoblinSharkColorPink', 'GoblinSharkColorGray', 'GoblinSharkColorBrown'
 	Color    GoblinSharkColor `json:"color,omitempty"`
 	Age      *int32           `json:"age,omitempty"`
 	Birthday *date.Time       `json:"birthday,omitempty"`
 	Species  *string          `json:"species,omitempty"`
 	Length   *float64         `json:"length,omitempty"`
 	Siblings *[]BasicFish     `json:"siblings,omitempty"`
-	// Fishtype - Possible values include: 'FishtypeFish', 'FishtypeSalmon', 'FishtypeSmartSalmon', 'FishtypeShark', 'FishtypeSawshark', 'FishtypeGoblin', 'FishtypeCookiecuttershark'
+	// Fishtype - Possible values include: 'FishtypeBasicFishFish', 'FishtypeBasicFishSalmon', 'FishtypeBasicFishSmartSalmon', 'FishtypeBasicFishShark', 'FishtypeBasicFishSawshark', 'FishtypeBasicFishGoblin', 'FishtypeBasicFishCookiecuttershark'
 	Fishtype FishtypeBasicFish `json:"fishtype,omitempty"`
 }
 
 // MarshalJSON is the custom marshaler for Goblinshark.
 func (g Goblinshark) MarshalJSON() ([]byte, error) {
-	g.Fishtype = FishtypeGoblin
+	g.Fishtype = FishtypeBasicFishGoblin
 	objectMap := make(map[string]interface{})
 	if g.Jawsize != nil {
 		objectMap["jawsize"] = g.Jawsize
@@ -974,60 +3190,344 @@ func (g *Goblinshark) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
-// IntWrapper ...
-type IntWrapper struct {
-	autorest.Response `json:"-"`
-	Field1            *int32 `json:"field1,omitempty"`
-	Field2            *int32 `json:"field2,omitempty"`
+// Validate checks that the Goblinshark satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (g Goblinshark) Validate() error {
+	var errs ValidationErrors
+	if g.Birthday == nil {
+		errs = append(errs, ValidationError{Path: "birthday", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if g.Length == nil {
+		errs = append(errs, ValidationError{Path: "length", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
 }
 
-// LongWrapper ...
-type LongWrapper struct {
-	autorest.Response `json:"-"`
-	Field1            *int64 `json:"field1,omitempty"`
-	Field2            *int64 `json:"field2,omitempty"`
+// DeepCopy returns a copy of the Goblinshark that doesn't share any pointers, slices or maps with it.
+func (g Goblinshark) DeepCopy() Goblinshark {
+	result := g
+	if g.Jawsize != nil {
+		v := *g.Jawsize
+		result.Jawsize = &v
+	}
+	if g.Age != nil {
+		v := *g.Age
+		result.Age = &v
+	}
+	if g.Birthday != nil {
+		v := *g.Birthday
+		result.Birthday = &v
+	}
+	if g.Species != nil {
+		v := *g.Species
+		result.Species = &v
+	}
+	if g.Length != nil {
+		v := *g.Length
+		result.Length = &v
+	}
+	if g.Siblings != nil {
+		var v []BasicFish
+		if *g.Siblings != nil {
+			v = make([]BasicFish, len(*g.Siblings))
+			for i := range *g.Siblings {
+				v[i] = deepCopyBasicFish((*g.Siblings)[i])
+			}
+		}
+		result.Siblings = &v
+	}
+	return result
 }
 
-// MyBaseHelperType ...
-type MyBaseHelperType struct {
-	PropBH1 *string `json:"propBH1,omitempty"`
+// Equal returns true if the fields of the Goblinshark and other are equal.  Times are equal if they represent the same
+// instant.
+func (g Goblinshark) Equal(other Goblinshark) bool {
+	return g.equal(other, false)
 }
 
-// BasicMyBaseType ...
-type BasicMyBaseType interface {
-	AsMyDerivedType() (*MyDerivedType, bool)
-	AsMyBaseType() (*MyBaseType, bool)
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (g Goblinshark) EqualIgnoreReadOnly(other Goblinshark) bool {
+	return g.equal(other, true)
 }
 
-// MyBaseType ...
-type MyBaseType struct {
-	autorest.Response `json:"-"`
-	PropB1            *string `json:"propB1,omitempty"`
-	*MyBaseHelperType `json:"helper,omitempty"`
-	// Kind - Possible values include: 'KindMyBaseType', 'KindKind1'
+// equal compares the fields of the Goblinshark and other, skipping the read-only ones if ignoreReadOnly is true.
+func (g Goblinshark) equal(other Goblinshark, ignoreReadOnly bool) bool {
+	if (g.Jawsize == nil) != (other.Jawsize == nil) || (g.Jawsize != nil && *g.Jawsize != *other.Jawsize) {
+		return false
+	}
+	if g.Color != other.Color {
+		return false
+	}
+	if (g.Age == nil) != (other.Age == nil) || (g.Age != nil && *g.Age != *other.Age) {
+		return false
+	}
+	if (g.Birthday == nil) != (other.Birthday == nil) || (g.Birthday != nil && !g.Birthday.Equal(other.Birthday.Time)) {
+		return false
+	}
+	if (g.Species == nil) != (other.Species == nil) || (g.Species != nil && *g.Species != *other.Species) {
+		return false
+	}
+	if (g.Length == nil) != (other.Length == nil) || (g.Length != nil && *g.Length != *other.Length) {
+		return false
+	}
+	if (g.Siblings == nil) != (other.Siblings == nil) {
+		return false
+	}
+	if g.Siblings != nil {
+		if (*g.Siblings == nil) != (*other.Siblings == nil) || len(*g.Siblings) != len(*other.Siblings) {
+			return false
+		}
+		for i := range *g.Siblings {
+			if !equalBasicFish((*g.Siblings)[i], (*other.Siblings)[i], ignoreReadOnly) {
+				return false
+			}
+		}
+	}
+	if g.Fishtype != other.Fishtype {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Goblinshark to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (g *Goblinshark) MergeFrom(patch Goblinshark) {
+	if patch.Jawsize != nil {
+		v := *patch.Jawsize
+		g.Jawsize = &v
+	}
+	if patch.Color != "" {
+		g.Color = patch.Color
+	}
+	if patch.Age != nil {
+		v := *patch.Age
+		g.Age = &v
+	}
+	if patch.Birthday != nil {
+		v := *patch.Birthday
+		g.Birthday = &v
+	}
+	if patch.Species != nil {
+		v := *patch.Species
+		g.Species = &v
+	}
+	if patch.Length != nil {
+		v := *patch.Length
+		g.Length = &v
+	}
+	if patch.Siblings != nil {
+		var v []BasicFish
+		if *patch.Siblings != nil {
+			v = make([]BasicFish, len(*patch.Siblings))
+			for i := range *patch.Siblings {
+				v[i] = deepCopyBasicFish((*patch.Siblings)[i])
+			}
+		}
+		g.Siblings = &v
+	}
+	if patch.Fishtype != "" {
+		g.Fishtype = patch.Fishtype
+	}
+}
+
+// IntWrapper ...
+type IntWrapper struct {
+	autorest.Response `json:"-"`
+	Field1            *int32 `json:"field1,omitempty"`
+	Field2            *int32 `json:"field2,omitempty"`
+}
+
+// DeepCopy returns a copy of the IntWrapper that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (iw IntWrapper) DeepCopy() IntWrapper {
+	result := iw
+	if iw.Field1 != nil {
+		v := *iw.Field1
+		result.Field1 = &v
+	}
+	if iw.Field2 != nil {
+		v := *iw.Field2
+		result.Field2 = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the IntWrapper and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (iw IntWrapper) Equal(other IntWrapper) bool {
+	return iw.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (iw IntWrapper) EqualIgnoreReadOnly(other IntWrapper) bool {
+	return iw.equal(other, true)
+}
+
+// equal compares the fields of the IntWrapper and other, skipping the read-only ones if ignoreReadOnly is true.
+func (iw IntWrapper) equal(other IntWrapper, ignoreReadOnly bool) bool {
+	if (iw.Field1 == nil) != (other.Field1 == nil) || (iw.Field1 != nil && *iw.Field1 != *other.Field1) {
+		return false
+	}
+	if (iw.Field2 == nil) != (other.Field2 == nil) || (iw.Field2 != nil && *iw.Field2 != *other.Field2) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the IntWrapper to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (iw *IntWrapper) MergeFrom(patch IntWrapper) {
+	if patch.Field1 != nil {
+		v := *patch.Field1
+		iw.Field1 = &v
+	}
+	if patch.Field2 != nil {
+		v := *patch.Field2
+		iw.Field2 = &v
+	}
+}
+
+// LongWrapper ...
+type LongWrapper struct {
+	autorest.Response `json:"-"`
+	Field1            *int64 `json:"field1,omitempty"`
+	Field2            *int64 `json:"field2,omitempty"`
+}
+
+// DeepCopy returns a copy of the LongWrapper that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (lw LongWrapper) DeepCopy() LongWrapper {
+	result := lw
+	if lw.Field1 != nil {
+		v := *lw.Field1
+		result.Field1 = &v
+	}
+	if lw.Field2 != nil {
+		v := *lw.Field2
+		result.Field2 = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the LongWrapper and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (lw LongWrapper) Equal(other LongWrapper) bool {
+	return lw.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (lw LongWrapper) EqualIgnoreReadOnly(other LongWrapper) bool {
+	return lw.equal(other, true)
+}
+
+// equal compares the fields of the LongWrapper and other, skipping the read-only ones if ignoreReadOnly is true.
+func (lw LongWrapper) equal(other LongWrapper, ignoreReadOnly bool) bool {
+	if (lw.Field1 == nil) != (other.Field1 == nil) || (lw.Field1 != nil && *lw.Field1 != *other.Field1) {
+		return false
+	}
+	if (lw.Field2 == nil) != (other.Field2 == nil) || (lw.Field2 != nil && *lw.Field2 != *other.Field2) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the LongWrapper to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (lw *LongWrapper) MergeFrom(patch LongWrapper) {
+	if patch.Field1 != nil {
+		v := *patch.Field1
+		lw.Field1 = &v
+	}
+	if patch.Field2 != nil {
+		v := *patch.Field2
+		lw.Field2 = &v
+	}
+}
+
+// MyBaseHelperType ...
+type MyBaseHelperType struct {
+	PropBH1 *string `json:"propBH1,omitempty"`
+}
+
+// DeepCopy returns a copy of the MyBaseHelperType that doesn't share any pointers, slices or maps with it.
+func (mbht MyBaseHelperType) DeepCopy() MyBaseHelperType {
+	result := mbht
+	if mbht.PropBH1 != nil {
+		v := *mbht.PropBH1
+		result.PropBH1 = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the MyBaseHelperType and other are equal.  Times are equal if they represent the
+// same instant.
+func (mbht MyBaseHelperType) Equal(other MyBaseHelperType) bool {
+	return mbht.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (mbht MyBaseHelperType) EqualIgnoreReadOnly(other MyBaseHelperType) bool {
+	return mbht.equal(other, true)
+}
+
+// equal compares the fields of the MyBaseHelperType and other, skipping the read-only ones if ignoreReadOnly is true.
+func (mbht MyBaseHelperType) equal(other MyBaseHelperType, ignoreReadOnly bool) bool {
+	if (mbht.PropBH1 == nil) != (other.PropBH1 == nil) || (mbht.PropBH1 != nil && *mbht.PropBH1 != *other.PropBH1) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the MyBaseHelperType to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (mbht *MyBaseHelperType) MergeFrom(patch MyBaseHelperType) {
+	if patch.PropBH1 != nil {
+		v := *patch.PropBH1
+		mbht.PropBH1 = &v
+	}
+}
+
+// BasicMyBaseType ...
+type BasicMyBaseType interface {
+	AsMyDerivedType() (*MyDerivedType, bool)
+	AsMyBaseType() (*MyBaseType, bool)
+}
+
+// MyBaseType ...
+type MyBaseType struct {
+	autorest.Response `json:"-"`
+	PropB1            *string `json:"propB1,omitempty"`
+	*MyBaseHelperType `json:"helper,omitempty"`
+	// Kind - Possible values include: 'KindMyBaseType', 'KindKind1'
 	Kind Kind `json:"kind,omitempty"`
 }
 
 func unmarshalBasicMyBaseType(body []byte) (BasicMyBaseType, error) {
-	var m map[string]interface{}
-	err := json.Unmarshal(body, &m)
+	discriminator, err := unmarshalDiscriminator(body, "kind")
 	if err != nil {
 		return nil, err
 	}
 
-	switch m["kind"] {
+	switch discriminator {
 	case string(KindKind1):
 		var mdt MyDerivedType
 		err := json.Unmarshal(body, &mdt)
 		return mdt, err
-	default:
+	case string(KindMyBaseType), nil:
 		var mbt MyBaseType
 		err := json.Unmarshal(body, &mbt)
 		return mbt, err
+	default:
+		var umbt UnknownMyBaseType
+		err := umbt.unmarshal(discriminator, body)
+		return umbt, err
 	}
 }
 func unmarshalBasicMyBaseTypeArray(body []byte) ([]BasicMyBaseType, error) {
-	var rawMessages []*json.RawMessage
+	var rawMessages []json.RawMessage
 	err := json.Unmarshal(body, &rawMessages)
 	if err != nil {
 		return nil, err
@@ -1036,7 +3536,7 @@ func unmarshalBasicMyBaseTypeArray(body []byte) ([]BasicMyBaseType, error) {
 	mbtArray := make([]BasicMyBaseType, len(rawMessages))
 
 	for index, rawMessage := range rawMessages {
-		mbt, err := unmarshalBasicMyBaseType(*rawMessage)
+		mbt, err := unmarshalBasicMyBaseType(rawMessage)
 		if err != nil {
 			return nil, err
 		}
@@ -1045,6 +3545,65 @@ func unmarshalBasicMyBaseTypeArray(body []byte) ([]BasicMyBaseType, error) {
 	return mbtArray, nil
 }
 
+// deepCopyBasicMyBaseType returns a deep copy of the BasicMyBaseType, or the value itself if its type isn't one of the package's or a
+// pointer to one.
+func deepCopyBasicMyBaseType(value BasicMyBaseType) BasicMyBaseType {
+	switch v := value.(type) {
+	case MyDerivedType:
+		return v.DeepCopy()
+	case *MyDerivedType:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	case MyBaseType:
+		return v.DeepCopy()
+	case *MyBaseType:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	case UnknownMyBaseType:
+		return v.DeepCopy()
+	case *UnknownMyBaseType:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	default:
+		return value
+	}
+}
+
+// equalBasicMyBaseType returns true if a and b are of the same type and their fields are equal.
+func equalBasicMyBaseType(a, b BasicMyBaseType, ignoreReadOnly bool) bool {
+	switch v := a.(type) {
+	case MyDerivedType:
+		w, ok := b.(MyDerivedType)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *MyDerivedType:
+		w, ok := b.(*MyDerivedType)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	case MyBaseType:
+		w, ok := b.(MyBaseType)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *MyBaseType:
+		w, ok := b.(*MyBaseType)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	case UnknownMyBaseType:
+		w, ok := b.(UnknownMyBaseType)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *UnknownMyBaseType:
+		w, ok := b.(*UnknownMyBaseType)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
 // MarshalJSON is the custom marshaler for MyBaseType.
 func (mbt MyBaseType) MarshalJSON() ([]byte, error) {
 	mbt.Kind = KindMyBaseType
@@ -1118,6 +3677,64 @@ func (mbt *MyBaseType) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// DeepCopy returns a copy of the MyBaseType that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (mbt MyBaseType) DeepCopy() MyBaseType {
+	result := mbt
+	if mbt.PropB1 != nil {
+		v := *mbt.PropB1
+		result.PropB1 = &v
+	}
+	if mbt.MyBaseHelperType != nil {
+		v := mbt.MyBaseHelperType.DeepCopy()
+		result.MyBaseHelperType = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the MyBaseType and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (mbt MyBaseType) Equal(other MyBaseType) bool {
+	return mbt.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (mbt MyBaseType) EqualIgnoreReadOnly(other MyBaseType) bool {
+	return mbt.equal(other, true)
+}
+
+// equal compares the fields of the MyBaseType and other, skipping the read-only ones if ignoreReadOnly is true.
+func (mbt MyBaseType) equal(other MyBaseType, ignoreReadOnly bool) bool {
+	if (mbt.PropB1 == nil) != (other.PropB1 == nil) || (mbt.PropB1 != nil && *mbt.PropB1 != *other.PropB1) {
+		return false
+	}
+	if (mbt.MyBaseHelperType == nil) != (other.MyBaseHelperType == nil) || (mbt.MyBaseHelperType != nil && !mbt.MyBaseHelperType.equal(*other.MyBaseHelperType, ignoreReadOnly)) {
+		return false
+	}
+	if mbt.Kind != other.Kind {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the MyBaseType to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (mbt *MyBaseType) MergeFrom(patch MyBaseType) {
+	if patch.PropB1 != nil {
+		v := *patch.PropB1
+		mbt.PropB1 = &v
+	}
+	if patch.MyBaseHelperType != nil {
+		if mbt.MyBaseHelperType == nil {
+			mbt.MyBaseHelperType = &MyBaseHelperType{}
+		}
+		mbt.MyBaseHelperType.MergeFrom(*patch.MyBaseHelperType)
+	}
+	if patch.Kind != "" {
+		mbt.Kind = patch.Kind
+	}
+}
+
 // MyBaseTypeModel ...
 type MyBaseTypeModel struct {
 	autorest.Response `json:"-"`
@@ -1135,6 +3752,41 @@ func (mbtm *MyBaseTypeModel) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// DeepCopy returns a copy of the MyBaseTypeModel that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (mbtm MyBaseTypeModel) DeepCopy() MyBaseTypeModel {
+	result := mbtm
+	result.Value = deepCopyBasicMyBaseType(mbtm.Value)
+	return result
+}
+
+// Equal returns true if the fields of the MyBaseTypeModel and other are equal.  Times are equal if they represent the
+// same instant.  Their autorest.Response isn't compared.
+func (mbtm MyBaseTypeModel) Equal(other MyBaseTypeModel) bool {
+	return mbtm.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (mbtm MyBaseTypeModel) EqualIgnoreReadOnly(other MyBaseTypeModel) bool {
+	return mbtm.equal(other, true)
+}
+
+// equal compares the fields of the MyBaseTypeModel and other, skipping the read-only ones if ignoreReadOnly is true.
+func (mbtm MyBaseTypeModel) equal(other MyBaseTypeModel, ignoreReadOnly bool) bool {
+	if !equalBasicMyBaseType(mbtm.Value, other.Value, ignoreReadOnly) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the MyBaseTypeModel to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (mbtm *MyBaseTypeModel) MergeFrom(patch MyBaseTypeModel) {
+	if patch.Value != nil {
+		mbtm.Value = deepCopyBasicMyBaseType(patch.Value)
+	}
+}
+
 // MyDerivedType ...
 type MyDerivedType struct {
 	PropD1            *string `json:"propD1,omitempty"`
@@ -1178,12 +3830,128 @@ func (mdt MyDerivedType) AsBasicMyBaseType() (BasicMyBaseType, bool) {
 	return &mdt, true
 }
 
+// DeepCopy returns a copy of the MyDerivedType that doesn't share any pointers, slices or maps with it.
+func (mdt MyDerivedType) DeepCopy() MyDerivedType {
+	result := mdt
+	if mdt.PropD1 != nil {
+		v := *mdt.PropD1
+		result.PropD1 = &v
+	}
+	if mdt.PropB1 != nil {
+		v := *mdt.PropB1
+		result.PropB1 = &v
+	}
+	if mdt.MyBaseHelperType != nil {
+		v := mdt.MyBaseHelperType.DeepCopy()
+		result.MyBaseHelperType = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the MyDerivedType and other are equal.  Times are equal if they represent the
+// same instant.
+func (mdt MyDerivedType) Equal(other MyDerivedType) bool {
+	return mdt.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (mdt MyDerivedType) EqualIgnoreReadOnly(other MyDerivedType) bool {
+	return mdt.equal(other, true)
+}
+
+// equal compares the fields of the MyDerivedType and other, skipping the read-only ones if ignoreReadOnly is true.
+func (mdt MyDerivedType) equal(other MyDerivedType, ignoreReadOnly bool) bool {
+	if (mdt.PropD1 == nil) != (other.PropD1 == nil) || (mdt.PropD1 != nil && *mdt.PropD1 != *other.PropD1) {
+		return false
+	}
+	if (mdt.PropB1 == nil) != (other.PropB1 == nil) || (mdt.PropB1 != nil && *mdt.PropB1 != *other.PropB1) {
+		return false
+	}
+	if (mdt.MyBaseHelperType == nil) != (other.MyBaseHelperType == nil) || (mdt.MyBaseHelperType != nil && !mdt.MyBaseHelperType.equal(*other.MyBaseHelperType, ignoreReadOnly)) {
+		return false
+	}
+	if mdt.Kind != other.Kind {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the MyDerivedType to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (mdt *MyDerivedType) MergeFrom(patch MyDerivedType) {
+	if patch.PropD1 != nil {
+		v := *patch.PropD1
+		mdt.PropD1 = &v
+	}
+	if patch.PropB1 != nil {
+		v := *patch.PropB1
+		mdt.PropB1 = &v
+	}
+	if patch.MyBaseHelperType != nil {
+		if mdt.MyBaseHelperType == nil {
+			mdt.MyBaseHelperType = &MyBaseHelperType{}
+		}
+		mdt.MyBaseHelperType.MergeFrom(*patch.MyBaseHelperType)
+	}
+	if patch.Kind != "" {
+		mdt.Kind = patch.Kind
+	}
+}
+
 // Pet ...
 type Pet struct {
 	ID   *int32  `json:"id,omitempty"`
 	Name *string `json:"name,omitempty"`
 }
 
+// DeepCopy returns a copy of the Pet that doesn't share any pointers, slices or maps with it.
+func (p Pet) DeepCopy() Pet {
+	result := p
+	if p.ID != nil {
+		v := *p.ID
+		result.ID = &v
+	}
+	if p.Name != nil {
+		v := *p.Name
+		result.Name = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Pet and other are equal.  Times are equal if they represent the same instant.
+func (p Pet) Equal(other Pet) bool {
+	return p.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (p Pet) EqualIgnoreReadOnly(other Pet) bool {
+	return p.equal(other, true)
+}
+
+// equal compares the fields of the Pet and other, skipping the read-only ones if ignoreReadOnly is true.
+func (p Pet) equal(other Pet, ignoreReadOnly bool) bool {
+	if (p.ID == nil) != (other.ID == nil) || (p.ID != nil && *p.ID != *other.ID) {
+		return false
+	}
+	if (p.Name == nil) != (other.Name == nil) || (p.Name != nil && *p.Name != *other.Name) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Pet to copies of the fields set in patch.  Nested models and maps are merged, the
+// values of the other fields are replaced.  The read-only fields aren't changed.
+func (p *Pet) MergeFrom(patch Pet) {
+	if patch.ID != nil {
+		v := *patch.ID
+		p.ID = &v
+	}
+	if patch.Name != nil {
+		v := *patch.Name
+		p.Name = &v
+	}
+}
+
 // ReadonlyObj ...
 type ReadonlyObj struct {
 	autorest.Response `json:"-"`
@@ -1192,10 +3960,139 @@ type ReadonlyObj struct {
 	Size *int32  `json:"size,omitempty"`
 }
 
+// DeepCopy returns a copy of the ReadonlyObj that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (ro ReadonlyObj) DeepCopy() ReadonlyObj {
+	result := ro
+	if ro.ID != nil {
+		v := *ro.ID
+		result.ID = &v
+	}
+	if ro.Size != nil {
+		v := *ro.Size
+		result.Size = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ReadonlyObj and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (ro ReadonlyObj) Equal(other ReadonlyObj) bool {
+	return ro.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (ro ReadonlyObj) EqualIgnoreReadOnly(other ReadonlyObj) bool {
+	return ro.equal(other, true)
+}
+
+// equal compares the fields of the ReadonlyObj and other, skipping the read-only ones if ignoreReadOnly is true.
+func (ro ReadonlyObj) equal(other ReadonlyObj, ignoreReadOnly bool) bool {
+	if !ignoreReadOnly {
+		if (ro.ID == nil) != (other.ID == nil) || (ro.ID != nil && *ro.ID != *other.ID) {
+			return false
+		}
+	}
+	if (ro.Size == nil) != (other.Size == nil) || (ro.Size != nil && *ro.Size != *other.Size) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ReadonlyObj to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (ro *ReadonlyObj) MergeFrom(patch ReadonlyObj) {
+	if patch.Size != nil {
+		v := *patch.Size
+		ro.Size = &v
+	}
+}
+
+// RequestOptions contains the per-call options applied to the requests of operations whose context was created with WithRequestOptions.
+type RequestOptions struct {
+	// Header - additional headers to send.  They replace any values of the same headers set by the operation.
+	Header http.Header
+	// Query - additional query parameters to send.  They replace any values of the same parameters set by the operation.
+	Query url.Values
+	// Timeout - if greater than zero, the maximum amount of time allowed for sending the request, including retries, and reading its response.
+	Timeout time.Duration
+}
+
+type requestOptionsKey struct{}
+
+// WithRequestOptions returns a copy of ctx carrying opts.  Operations called with the returned
+// context apply opts to their requests; other calls aren't affected.
+func WithRequestOptions(ctx context.Context, opts RequestOptions) context.Context {
+	return context.WithValue(ctx, requestOptionsKey{}, opts)
+}
+
+// withRequestOptions returns a PrepareDecorator that merges the headers and query parameters
+// of the RequestOptions carried by the request's context into the request.
+func withRequestOptions() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+			opts, ok := r.Context().Value(requestOptionsKey{}).(RequestOptions)
+			if !ok {
+				return r, nil
+			}
+			if len(opts.Header) > 0 {
+				if r.Header == nil {
+					r.Header = make(http.Header)
+				}
+				for k, v := range opts.Header {
+					r.Header[http.CanonicalHeaderKey(k)] = v
+				}
+			}
+			if len(opts.Query) > 0 {
+				q := r.URL.Query()
+				for k, v := range opts.Query {
+					q[k] = v
+				}
+				r.URL.RawQuery = q.Encode()
+			}
+			return r, nil
+		})
+	}
+}
+
+// sendWithRequestOptions sends the request like autorest.SendWithSender.  If the request's context
+// carries a RequestOptions with a Timeout, the request is cancelled once the timeout elapses
+// or the response body is closed, whichever happens first.
+func sendWithRequestOptions(s autorest.Sender, r *http.Request, decorators ...autorest.SendDecorator) (*http.Response, error) {
+	opts, ok := r.Context().Value(requestOptionsKey{}).(RequestOptions)
+	if !ok || opts.Timeout <= 0 {
+		return autorest.SendWithSender(s, r, decorators...)
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), opts.Timeout)
+	resp, err := autorest.SendWithSender(s, r.WithContext(ctx), decorators...)
+	if err != nil || resp == nil || resp.Body == nil {
+		cancel()
+		return resp, err
+	}
+	resp.Body = requestOptionsBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// requestOptionsBody releases the timeout of a request once its response body is closed.
+type requestOptionsBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b requestOptionsBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
 // BasicSalmon ...
 type BasicSalmon interface {
 	AsSmartSalmon() (*SmartSalmon, bool)
 	AsSalmon() (*Salmon, bool)
+	Validate() error
 }
 
 // Salmon ...
@@ -1206,30 +4103,33 @@ type Salmon struct {
 	Species           *string      `json:"species,omitempty"`
 	Length            *float64     `json:"length,omitempty"`
 	Siblings          *[]BasicFish `json:"siblings,omitempty"`
-	// Fishtype - Possible values include: 'FishtypeFish', 'FishtypeSalmon', 'FishtypeSmartSalmon', 'FishtypeShark', 'FishtypeSawshark', 'FishtypeGoblin', 'FishtypeCookiecuttershark'
+	// Fishtype - Possible values include: 'FishtypeBasicFishFish', 'FishtypeBasicFishSalmon', 'FishtypeBasicFishSmartSalmon', 'FishtypeBasicFishShark', 'FishtypeBasicFishSawshark', 'FishtypeBasicFishGoblin', 'FishtypeBasicFishCookiecuttershark'
 	Fishtype FishtypeBasicFish `json:"fishtype,omitempty"`
 }
 
 func unmarshalBasicSalmon(body []byte) (BasicSalmon, error) {
-	var m map[string]interface{}
-	err := json.Unmarshal(body, &m)
+	discriminator, err := unmarshalDiscriminator(body, "fishtype")
 	if err != nil {
 		return nil, err
 	}
 
-	switch m["fishtype"] {
-	case string(FishtypeSmartSalmon):
+	switch discriminator {
+	case string(FishtypeBasicFishSmartSalmon):
 		var s SmartSalmon
 		err := json.Unmarshal(body, &s)
 		return s, err
-	default:
+	case string(FishtypeBasicFishSalmon), nil:
 		var s Salmon
 		err := json.Unmarshal(body, &s)
 		return s, err
+	default:
+		var us UnknownSalmon
+		err := us.unmarshal(discriminator, body)
+		return us, err
 	}
 }
 func unmarshalBasicSalmonArray(body []byte) ([]BasicSalmon, error) {
-	var rawMessages []*json.RawMessage
+	var rawMessages []json.RawMessage
 	err := json.Unmarshal(body, &rawMessages)
 	if err != nil {
 		return nil, err
@@ -1238,7 +4138,7 @@ func unmarshalBasicSalmonArray(body []byte) ([]BasicSalmon, error) {
 	sArray := make([]BasicSalmon, len(rawMessages))
 
 	for index, rawMessage := range rawMessages {
-		s, err := unmarshalBasicSalmon(*rawMessage)
+		s, err := unmarshalBasicSalmon(rawMessage)
 		if err != nil {
 			return nil, err
 		}
@@ -1247,9 +4147,68 @@ func unmarshalBasicSalmonArray(body []byte) ([]BasicSalmon, error) {
 	return sArray, nil
 }
 
+// deepCopyBasicSalmon returns a deep copy of the BasicSalmon, or the value itself if its type isn't one of the package's or a
+// pointer to one.
+func deepCopyBasicSalmon(value BasicSalmon) BasicSalmon {
+	switch v := value.(type) {
+	case SmartSalmon:
+		return v.DeepCopy()
+	case *SmartSalmon:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	case Salmon:
+		return v.DeepCopy()
+	case *Salmon:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	case UnknownSalmon:
+		return v.DeepCopy()
+	case *UnknownSalmon:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	default:
+		return value
+	}
+}
+
+// equalBasicSalmon returns true if a and b are of the same type and their fields are equal.
+func equalBasicSalmon(a, b BasicSalmon, ignoreReadOnly bool) bool {
+	switch v := a.(type) {
+	case SmartSalmon:
+		w, ok := b.(SmartSalmon)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *SmartSalmon:
+		w, ok := b.(*SmartSalmon)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	case Salmon:
+		w, ok := b.(Salmon)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *Salmon:
+		w, ok := b.(*Salmon)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	case UnknownSalmon:
+		w, ok := b.(UnknownSalmon)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *UnknownSalmon:
+		w, ok := b.(*UnknownSalmon)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
 // MarshalJSON is the custom marshaler for Salmon.
 func (s Salmon) MarshalJSON() ([]byte, error) {
-	s.Fishtype = FishtypeSalmon
+	s.Fishtype = FishtypeBasicFishSalmon
 	objectMap := make(map[string]interface{})
 	if s.Location != nil {
 		objectMap["location"] = s.Location
@@ -1390,23 +4349,182 @@ func (s *Salmon) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
-// SalmonModel ...
-type SalmonModel struct {
-	autorest.Response `json:"-"`
-	Value             BasicSalmon `json:"value,omitempty"`
+// Validate checks that the Salmon satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (s Salmon) Validate() error {
+	var errs ValidationErrors
+	if s.Length == nil {
+		errs = append(errs, ValidationError{Path: "length", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
 }
 
-// UnmarshalJSON is the custom unmarshaler for SalmonModel struct.
-func (sm *SalmonModel) UnmarshalJSON(body []byte) error {
-	s, err := unmarshalBasicSalmon(body)
-	if err != nil {
-		return err
+// DeepCopy returns a copy of the Salmon that doesn't share any pointers, slices or maps with it.  Its autorest.Response
+// is copied as is.
+func (s Salmon) DeepCopy() Salmon {
+	result := s
+	if s.Location != nil {
+		v := *s.Location
+		result.Location = &v
+	}
+	if s.Iswild != nil {
+		v := *s.Iswild
+		result.Iswild = &v
+	}
+	if s.Species != nil {
+		v := *s.Species
+		result.Species = &v
+	}
+	if s.Length != nil {
+		v := *s.Length
+		result.Length = &v
+	}
+	if s.Siblings != nil {
+		var v []BasicFish
+		if *s.Siblings != nil {
+			v = make([]BasicFish, len(*s.Siblings))
+			for i := range *s.Siblings {
+				v[i] = deepCopyBasicFish((*s.Siblings)[i])
+			}
+		}
+		result.Siblings = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Salmon and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (s Salmon) Equal(other Salmon) bool {
+	return s.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (s Salmon) EqualIgnoreReadOnly(other Salmon) bool {
+	return s.equal(other, true)
+}
+
+// equal compares the fields of the Salmon and other, skipping the read-only ones if ignoreReadOnly is true.
+func (s Salmon) equal(other Salmon, ignoreReadOnly bool) bool {
+	if (s.Location == nil) != (other.Location == nil) || (s.Location != nil && *s.Location != *other.Location) {
+		return false
+	}
+	if (s.Iswild == nil) != (other.Iswild == nil) || (s.Iswild != nil && *s.Iswild != *other.Iswild) {
+		return false
+	}
+	if (s.Species == nil) != (other.Species == nil) || (s.Species != nil && *s.Species != *other.Species) {
+		return false
+	}
+	if (s.Length == nil) != (other.Length == nil) || (s.Length != nil && *s.Length != *other.Length) {
+		return false
+	}
+	if (s.Siblings == nil) != (other.Siblings == nil) {
+		return false
+	}
+	if s.Siblings != nil {
+		if (*s.Siblings == nil) != (*other.Siblings == nil) || len(*s.Siblings) != len(*other.Siblings) {
+			return false
+		}
+		for i := range *s.Siblings {
+			if !equalBasicFish((*s.Siblings)[i], (*other.Siblings)[i], ignoreReadOnly) {
+				return false
+			}
+		}
+	}
+	if s.Fishtype != other.Fishtype {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Salmon to copies of the fields set in patch.  Nested models and maps are merged, the
+// values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (s *Salmon) MergeFrom(patch Salmon) {
+	if patch.Location != nil {
+		v := *patch.Location
+		s.Location = &v
+	}
+	if patch.Iswild != nil {
+		v := *patch.Iswild
+		s.Iswild = &v
+	}
+	if patch.Species != nil {
+		v := *patch.Species
+		s.Species = &v
+	}
+	if patch.Length != nil {
+		v := *patch.Length
+		s.Length = &v
+	}
+	if patch.Siblings != nil {
+		var v []BasicFish
+		if *patch.Siblings != nil {
+			v = make([]BasicFish, len(*patch.Siblings))
+			for i := range *patch.Siblings {
+				v[i] = deepCopyBasicFish((*patch.Siblings)[i])
+			}
+		}
+		s.Siblings = &v
+	}
+	if patch.Fishtype != "" {
+		s.Fishtype = patch.Fishtype
+	}
+}
+
+// SalmonModel ...
+type SalmonModel struct {
+	autorest.Response `json:"-"`
+	Value             BasicSalmon `json:"value,omitempty"`
+}
+
+// UnmarshalJSON is the custom unmarshaler for SalmonModel struct.
+func (sm *SalmonModel) UnmarshalJSON(body []byte) error {
+	s, err := unmarshalBasicSalmon(body)
+	if err != nil {
+		return err
 	}
 	sm.Value = s
 
 	return nil
 }
 
+// DeepCopy returns a copy of the SalmonModel that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (sm SalmonModel) DeepCopy() SalmonModel {
+	result := sm
+	result.Value = deepCopyBasicSalmon(sm.Value)
+	return result
+}
+
+// Equal returns true if the fields of the SalmonModel and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (sm SalmonModel) Equal(other SalmonModel) bool {
+	return sm.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (sm SalmonModel) EqualIgnoreReadOnly(other SalmonModel) bool {
+	return sm.equal(other, true)
+}
+
+// equal compares the fields of the SalmonModel and other, skipping the read-only ones if ignoreReadOnly is true.
+func (sm SalmonModel) equal(other SalmonModel, ignoreReadOnly bool) bool {
+	if !equalBasicSalmon(sm.Value, other.Value, ignoreReadOnly) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the SalmonModel to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (sm *SalmonModel) MergeFrom(patch SalmonModel) {
+	if patch.Value != nil {
+		sm.Value = deepCopyBasicSalmon(patch.Value)
+	}
+}
+
 // Sawshark ...
 type Sawshark struct {
 	Picture  *[]byte      `json:"picture,omitempty"`
@@ -1415,13 +4533,13 @@ type Sawshark struct {
 	Species  *string      `json:"species,omitempty"`
 	Length   *float64     `json:"length,omitempty"`
 	Siblings *[]BasicFish `json:"siblings,omitempty"`
-	// Fishtype - Possible values include: 'FishtypeFish', 'FishtypeSalmon', 'FishtypeSmartSalmon', 'FishtypeShark', 'FishtypeSawshark', 'FishtypeGoblin', 'FishtypeCookiecuttershark'
+	// Fishtype - Possible values include: 'FishtypeBasicFishFish', 'FishtypeBasicFishSalmon', 'FishtypeBasicFishSmartSalmon', 'FishtypeBasicFishShark', 'FishtypeBasicFishSawshark', 'FishtypeBasicFishGoblin', 'FishtypeBasicFishCookiecuttershark'
 	Fishtype FishtypeBasicFish `json:"fishtype,omitempty"`
 }
 
 // MarshalJSON is the custom marshaler for Sawshark.
 func (s Sawshark) MarshalJSON() ([]byte, error) {
-	s.Fishtype = FishtypeSawshark
+	s.Fishtype = FishtypeBasicFishSawshark
 	objectMap := make(map[string]interface{})
 	if s.Picture != nil {
 		objectMap["picture"] = s.Picture
@@ -1574,12 +4692,163 @@ func (s *Sawshark) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// Validate checks that the Sawshark satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (s Sawshark) Validate() error {
+	var errs ValidationErrors
+	if s.Birthday == nil {
+		errs = append(errs, ValidationError{Path: "birthday", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if s.Length == nil {
+		errs = append(errs, ValidationError{Path: "length", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// DeepCopy returns a copy of the Sawshark that doesn't share any pointers, slices or maps with it.
+func (s Sawshark) DeepCopy() Sawshark {
+	result := s
+	if s.Picture != nil {
+		var v []byte
+		if *s.Picture != nil {
+			v = make([]byte, len(*s.Picture))
+			copy(v, *s.Picture)
+		}
+		result.Picture = &v
+	}
+	if s.Age != nil {
+		v := *s.Age
+		result.Age = &v
+	}
+	if s.Birthday != nil {
+		v := *s.Birthday
+		result.Birthday = &v
+	}
+	if s.Species != nil {
+		v := *s.Species
+		result.Species = &v
+	}
+	if s.Length != nil {
+		v := *s.Length
+		result.Length = &v
+	}
+	if s.Siblings != nil {
+		var v []BasicFish
+		if *s.Siblings != nil {
+			v = make([]BasicFish, len(*s.Siblings))
+			for i := range *s.Siblings {
+				v[i] = deepCopyBasicFish((*s.Siblings)[i])
+			}
+		}
+		result.Siblings = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Sawshark and other are equal.  Times are equal if they represent the same
+// instant.
+func (s Sawshark) Equal(other Sawshark) bool {
+	return s.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (s Sawshark) EqualIgnoreReadOnly(other Sawshark) bool {
+	return s.equal(other, true)
+}
+
+// equal compares the fields of the Sawshark and other, skipping the read-only ones if ignoreReadOnly is true.
+func (s Sawshark) equal(other Sawshark, ignoreReadOnly bool) bool {
+	if (s.Picture == nil) != (other.Picture == nil) {
+		return false
+	}
+	if s.Picture != nil {
+		if (*s.Picture == nil) != (*other.Picture == nil) || !bytes.Equal(*s.Picture, *other.Picture) {
+			return false
+		}
+	}
+	if (s.Age == nil) != (other.Age == nil) || (s.Age != nil && *s.Age != *other.Age) {
+		return false
+	}
+	if (s.Birthday == nil) != (other.Birthday == nil) || (s.Birthday != nil && !s.Birthday.Equal(other.Birthday.Time)) {
+		return false
+	}
+	if (s.Species == nil) != (other.Species == nil) || (s.Species != nil && *s.Species != *other.Species) {
+		return false
+	}
+	if (s.Length == nil) != (other.Length == nil) || (s.Length != nil && *s.Length != *other.Length) {
+		return false
+	}
+	if (s.Siblings == nil) != (other.Siblings == nil) {
+		return false
+	}
+	if s.Siblings != nil {
+		if (*s.Siblings == nil) != (*other.Siblings == nil) || len(*s.Siblings) != len(*other.Siblings) {
+			return false
+		}
+		for i := range *s.Siblings {
+			if !equalBasicFish((*s.Siblings)[i], (*other.Siblings)[i], ignoreReadOnly) {
+				return false
+			}
+		}
+	}
+	if s.Fishtype != other.Fishtype {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Sawshark to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields aren't changed.
+func (s *Sawshark) MergeFrom(patch Sawshark) {
+	if patch.Picture != nil {
+		var v []byte
+		if *patch.Picture != nil {
+			v = make([]byte, len(*patch.Picture))
+			copy(v, *patch.Picture)
+		}
+		s.Picture = &v
+	}
+	if patch.Age != nil {
+		v := *patch.Age
+		s.Age = &v
+	}
+	if patch.Birthday != nil {
+		v := *patch.Birthday
+		s.Birthday = &v
+	}
+	if patch.Species != nil {
+		v := *patch.Species
+		s.Species = &v
+	}
+	if patch.Length != nil {
+		v := *patch.Length
+		s.Length = &v
+	}
+	if patch.Siblings != nil {
+		var v []BasicFish
+		if *patch.Siblings != nil {
+			v = make([]BasicFish, len(*patch.Siblings))
+			for i := range *patch.Siblings {
+				v[i] = deepCopyBasicFish((*patch.Siblings)[i])
+			}
+		}
+		s.Siblings = &v
+	}
+	if patch.Fishtype != "" {
+		s.Fishtype = patch.Fishtype
+	}
+}
+
 // BasicShark ...
 type BasicShark interface {
 	AsSawshark() (*Sawshark, bool)
 	AsGoblinshark() (*Goblinshark, bool)
 	AsCookiecuttershark() (*Cookiecuttershark, bool)
 	AsShark() (*Shark, bool)
+	Validate() error
 }
 
 // Shark ...
@@ -1589,38 +4858,41 @@ type Shark struct {
 	Species  *string      `json:"species,omitempty"`
 	Length   *float64     `json:"length,omitempty"`
 	Siblings *[]BasicFish `json:"siblings,omitempty"`
-	// Fishtype - Possible values include: 'FishtypeFish', 'FishtypeSalmon', 'FishtypeSmartSalmon', 'FishtypeShark', 'FishtypeSawshark', 'FishtypeGoblin', 'FishtypeCookiecuttershark'
+	// Fishtype - Possible values include: 'FishtypeBasicFishFish', 'FishtypeBasicFishSalmon', 'FishtypeBasicFishSmartSalmon', 'FishtypeBasicFishShark', 'FishtypeBasicFishSawshark', 'FishtypeBasicFishGoblin', 'FishtypeBasicFishCookiecuttershark'
 	Fishtype FishtypeBasicFish `json:"fishtype,omitempty"`
 }
 
 func unmarshalBasicShark(body []byte) (BasicShark, error) {
-	var m map[string]interface{}
-	err := json.Unmarshal(body, &m)
+	discriminator, err := unmarshalDiscriminator(body, "fishtype")
 	if err != nil {
 		return nil, err
 	}
 
-	switch m["fishtype"] {
-	case string(FishtypeSawshark):
+	switch discriminator {
+	case string(FishtypeBasicFishSawshark):
 		var s Sawshark
 		err := json.Unmarshal(body, &s)
 		return s, err
-	case string(FishtypeGoblin):
+	case string(FishtypeBasicFishGoblin):
 		var g Goblinshark
 		err := json.Unmarshal(body, &g)
 		return g, err
-	case string(FishtypeCookiecuttershark):
+	case string(FishtypeBasicFishCookiecuttershark):
 		var c Cookiecuttershark
 		err := json.Unmarshal(body, &c)
 		return c, err
-	default:
+	case string(FishtypeBasicFishShark), nil:
 		var s Shark
 		err := json.Unmarshal(body, &s)
 		return s, err
+	default:
+		var us UnknownShark
+		err := us.unmarshal(discriminator, body)
+		return us, err
 	}
 }
 func unmarshalBasicSharkArray(body []byte) ([]BasicShark, error) {
-	var rawMessages []*json.RawMessage
+	var rawMessages []json.RawMessage
 	err := json.Unmarshal(body, &rawMessages)
 	if err != nil {
 		return nil, err
@@ -1629,7 +4901,7 @@ func unmarshalBasicSharkArray(body []byte) ([]BasicShark, error) {
 	sArray := make([]BasicShark, len(rawMessages))
 
 	for index, rawMessage := range rawMessages {
-		s, err := unmarshalBasicShark(*rawMessage)
+		s, err := unmarshalBasicShark(rawMessage)
 		if err != nil {
 			return nil, err
 		}
@@ -1638,9 +4910,96 @@ func unmarshalBasicSharkArray(body []byte) ([]BasicShark, error) {
 	return sArray, nil
 }
 
+// deepCopyBasicShark returns a deep copy of the BasicShark, or the value itself if its type isn't one of the package's or a
+// pointer to one.
+func deepCopyBasicShark(value BasicShark) BasicShark {
+	switch v := value.(type) {
+	case Sawshark:
+		return v.DeepCopy()
+	case *Sawshark:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	case Goblinshark:
+		return v.DeepCopy()
+	case *Goblinshark:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	case Cookiecuttershark:
+		return v.DeepCopy()
+	case *Cookiecuttershark:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	case Shark:
+		return v.DeepCopy()
+	case *Shark:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	case UnknownShark:
+		return v.DeepCopy()
+	case *UnknownShark:
+		if v == nil {
+			return v
+		}
+		result := v.DeepCopy()
+		return &result
+	default:
+		return value
+	}
+}
+
+// equalBasicShark returns true if a and b are of the same type and their fields are equal.
+func equalBasicShark(a, b BasicShark, ignoreReadOnly bool) bool {
+	switch v := a.(type) {
+	case Sawshark:
+		w, ok := b.(Sawshark)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *Sawshark:
+		w, ok := b.(*Sawshark)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	case Goblinshark:
+		w, ok := b.(Goblinshark)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *Goblinshark:
+		w, ok := b.(*Goblinshark)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	case Cookiecuttershark:
+		w, ok := b.(Cookiecuttershark)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *Cookiecuttershark:
+		w, ok := b.(*Cookiecuttershark)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	case Shark:
+		w, ok := b.(Shark)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *Shark:
+		w, ok := b.(*Shark)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	case UnknownShark:
+		w, ok := b.(UnknownShark)
+		return ok && v.equal(w, ignoreReadOnly)
+	case *UnknownShark:
+		w, ok := b.(*UnknownShark)
+		return ok && (v == w || v != nil && w != nil && v.equal(*w, ignoreReadOnly))
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
 // MarshalJSON is the custom marshaler for Shark.
 func (s Shark) MarshalJSON() ([]byte, error) {
-	s.Fishtype = FishtypeShark
+	s.Fishtype = FishtypeBasicFishShark
 	objectMap := make(map[string]interface{})
 	if s.Age != nil {
 		objectMap["age"] = s.Age
@@ -1781,6 +5140,132 @@ func (s *Shark) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// Validate checks that the Shark satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (s Shark) Validate() error {
+	var errs ValidationErrors
+	if s.Birthday == nil {
+		errs = append(errs, ValidationError{Path: "birthday", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if s.Length == nil {
+		errs = append(errs, ValidationError{Path: "length", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// DeepCopy returns a copy of the Shark that doesn't share any pointers, slices or maps with it.
+func (s Shark) DeepCopy() Shark {
+	result := s
+	if s.Age != nil {
+		v := *s.Age
+		result.Age = &v
+	}
+	if s.Birthday != nil {
+		v := *s.Birthday
+		result.Birthday = &v
+	}
+	if s.Species != nil {
+		v := *s.Species
+		result.Species = &v
+	}
+	if s.Length != nil {
+		v := *s.Length
+		result.Length = &v
+	}
+	if s.Siblings != nil {
+		var v []BasicFish
+		if *s.Siblings != nil {
+			v = make([]BasicFish, len(*s.Siblings))
+			for i := range *s.Siblings {
+				v[i] = deepCopyBasicFish((*s.Siblings)[i])
+			}
+		}
+		result.Siblings = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Shark and other are equal.  Times are equal if they represent the same
+// instant.
+func (s Shark) Equal(other Shark) bool {
+	return s.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (s Shark) EqualIgnoreReadOnly(other Shark) bool {
+	return s.equal(other, true)
+}
+
+// equal compares the fields of the Shark and other, skipping the read-only ones if ignoreReadOnly is true.
+func (s Shark) equal(other Shark, ignoreReadOnly bool) bool {
+	if (s.Age == nil) != (other.Age == nil) || (s.Age != nil && *s.Age != *other.Age) {
+		return false
+	}
+	if (s.Birthday == nil) != (other.Birthday == nil) || (s.Birthday != nil && !s.Birthday.Equal(other.Birthday.Time)) {
+		return false
+	}
+	if (s.Species == nil) != (other.Species == nil) || (s.Species != nil && *s.Species != *other.Species) {
+		return false
+	}
+	if (s.Length == nil) != (other.Length == nil) || (s.Length != nil && *s.Length != *other.Length) {
+		return false
+	}
+	if (s.Siblings == nil) != (other.Siblings == nil) {
+		return false
+	}
+	if s.Siblings != nil {
+		if (*s.Siblings == nil) != (*other.Siblings == nil) || len(*s.Siblings) != len(*other.Siblings) {
+			return false
+		}
+		for i := range *s.Siblings {
+			if !equalBasicFish((*s.Siblings)[i], (*other.Siblings)[i], ignoreReadOnly) {
+				return false
+			}
+		}
+	}
+	if s.Fishtype != other.Fishtype {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Shark to copies of the fields set in patch.  Nested models and maps are merged, the
+// values of the other fields are replaced.  The read-only fields aren't changed.
+func (s *Shark) MergeFrom(patch Shark) {
+	if patch.Age != nil {
+		v := *patch.Age
+		s.Age = &v
+	}
+	if patch.Birthday != nil {
+		v := *patch.Birthday
+		s.Birthday = &v
+	}
+	if patch.Species != nil {
+		v := *patch.Species
+		s.Species = &v
+	}
+	if patch.Length != nil {
+		v := *patch.Length
+		s.Length = &v
+	}
+	if patch.Siblings != nil {
+		var v []BasicFish
+		if *patch.Siblings != nil {
+			v = make([]BasicFish, len(*patch.Siblings))
+			for i := range *patch.Siblings {
+				v[i] = deepCopyBasicFish((*patch.Siblings)[i])
+			}
+		}
+		s.Siblings = &v
+	}
+	if patch.Fishtype != "" {
+		s.Fishtype = patch.Fishtype
+	}
+}
+
 // Siamese ...
 type Siamese struct {
 	autorest.Response `json:"-"`
@@ -1791,6 +5276,111 @@ type Siamese struct {
 	Name              *string `json:"name,omitempty"`
 }
 
+// DeepCopy returns a copy of the Siamese that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (s Siamese) DeepCopy() Siamese {
+	result := s
+	if s.Breed != nil {
+		v := *s.Breed
+		result.Breed = &v
+	}
+	if s.Color != nil {
+		v := *s.Color
+		result.Color = &v
+	}
+	if s.Hates != nil {
+		var v []Dog
+		if *s.Hates != nil {
+			v = make([]Dog, len(*s.Hates))
+			for i := range *s.Hates {
+				v[i] = (*s.Hates)[i].DeepCopy()
+			}
+		}
+		result.Hates = &v
+	}
+	if s.ID != nil {
+		v := *s.ID
+		result.ID = &v
+	}
+	if s.Name != nil {
+		v := *s.Name
+		result.Name = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Siamese and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (s Siamese) Equal(other Siamese) bool {
+	return s.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (s Siamese) EqualIgnoreReadOnly(other Siamese) bool {
+	return s.equal(other, true)
+}
+
+// equal compares the fields of the Siamese and other, skipping the read-only ones if ignoreReadOnly is true.
+func (s Siamese) equal(other Siamese, ignoreReadOnly bool) bool {
+	if (s.Breed == nil) != (other.Breed == nil) || (s.Breed != nil && *s.Breed != *other.Breed) {
+		return false
+	}
+	if (s.Color == nil) != (other.Color == nil) || (s.Color != nil && *s.Color != *other.Color) {
+		return false
+	}
+	if (s.Hates == nil) != (other.Hates == nil) {
+		return false
+	}
+	if s.Hates != nil {
+		if (*s.Hates == nil) != (*other.Hates == nil) || len(*s.Hates) != len(*other.Hates) {
+			return false
+		}
+		for i := range *s.Hates {
+			if !(*s.Hates)[i].equal((*other.Hates)[i], ignoreReadOnly) {
+				return false
+			}
+		}
+	}
+	if (s.ID == nil) != (other.ID == nil) || (s.ID != nil && *s.ID != *other.ID) {
+		return false
+	}
+	if (s.Name == nil) != (other.Name == nil) || (s.Name != nil && *s.Name != *other.Name) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Siamese to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (s *Siamese) MergeFrom(patch Siamese) {
+	if patch.Breed != nil {
+		v := *patch.Breed
+		s.Breed = &v
+	}
+	if patch.Color != nil {
+		v := *patch.Color
+		s.Color = &v
+	}
+	if patch.Hates != nil {
+		var v []Dog
+		if *patch.Hates != nil {
+			v = make([]Dog, len(*patch.Hates))
+			for i := range *patch.Hates {
+				v[i] = (*patch.Hates)[i].DeepCopy()
+			}
+		}
+		s.Hates = &v
+	}
+	if patch.ID != nil {
+		v := *patch.ID
+		s.ID = &v
+	}
+	if patch.Name != nil {
+		v := *patch.Name
+		s.Name = &v
+	}
+}
+
 // SmartSalmon ...
 type SmartSalmon struct {
 	// AdditionalProperties - Unmatched properties from the message are deserialized this collection
@@ -1801,13 +5391,13 @@ type SmartSalmon struct {
 	Species              *string                `json:"species,omitempty"`
 	Length               *float64               `json:"length,omitempty"`
 	Siblings             *[]BasicFish           `json:"siblings,omitempty"`
-	// Fishtype - Possible values include: 'FishtypeFish', 'FishtypeSalmon', 'FishtypeSmartSalmon', 'FishtypeShark', 'FishtypeSawshark', 'FishtypeGoblin', 'FishtypeCookiecuttershark'
+	// Fishtype - Possible values include: 'FishtypeBasicFishFish', 'FishtypeBasicFishSalmon', 'FishtypeBasicFishSmartSalmon', 'FishtypeBasicFishShark', 'FishtypeBasicFishSawshark', 'FishtypeBasicFishGoblin', 'FishtypeBasicFishCookiecuttershark'
 	Fishtype FishtypeBasicFish `json:"fishtype,omitempty"`
 }
 
 // MarshalJSON is the custom marshaler for SmartSalmon.
 func (s SmartSalmon) MarshalJSON() ([]byte, error) {
-	s.Fishtype = FishtypeSmartSalmon
+	s.Fishtype = FishtypeBasicFishSmartSalmon
 	objectMap := make(map[string]interface{})
 	if s.CollegeDegree != nil {
 		objectMap["college_degree"] = s.CollegeDegree
@@ -1975,10 +5565,793 @@ func (s *SmartSalmon) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
-// StringWrapper ...
-type StringWrapper struct {
-	autorest.Response `json:"-"`
-	Field             *string `json:"field,omitempty"`
-	Empty             *string `json:"empty,omitempty"`
-	Null              *string `json:"null,omitempty"`
+// Validate checks that the SmartSalmon satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (s SmartSalmon) Validate() error {
+	var errs ValidationErrors
+	if s.Length == nil {
+		errs = append(errs, ValidationError{Path: "length", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// DeepCopy returns a copy of the SmartSalmon that doesn't share any pointers, slices or maps with it.
+func (s SmartSalmon) DeepCopy() SmartSalmon {
+	result := s
+	if s.AdditionalProperties != nil {
+		result.AdditionalProperties = make(map[string]interface{}, len(s.AdditionalProperties))
+		for k, e := range s.AdditionalProperties {
+			result.AdditionalProperties[k] = deepCopyObject(e)
+		}
+	}
+	if s.CollegeDegree != nil {
+		v := *s.CollegeDegree
+		result.CollegeDegree = &v
+	}
+	if s.Location != nil {
+		v := *s.Location
+		result.Location = &v
+	}
+	if s.Iswild != nil {
+		v := *s.Iswild
+		result.Iswild = &v
+	}
+	if s.Species != nil {
+		v := *s.Species
+		result.Species = &v
+	}
+	if s.Length != nil {
+		v := *s.Length
+		result.Length = &v
+	}
+	if s.Siblings != nil {
+		var v []BasicFish
+		if *s.Siblings != nil {
+			v = make([]BasicFish, len(*s.Siblings))
+			for i := range *s.Siblings {
+				v[i] = deepCopyBasicFish((*s.Siblings)[i])
+			}
+		}
+		result.Siblings = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the SmartSalmon and other are equal.  Times are equal if they represent the same
+// instant.
+func (s SmartSalmon) Equal(other SmartSalmon) bool {
+	return s.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (s SmartSalmon) EqualIgnoreReadOnly(other SmartSalmon) bool {
+	return s.equal(other, true)
+}
+
+// equal compares the fields of the SmartSalmon and other, skipping the read-only ones if ignoreReadOnly is true.
+func (s SmartSalmon) equal(other SmartSalmon, ignoreReadOnly bool) bool {
+	if (s.AdditionalProperties == nil) != (other.AdditionalProperties == nil) || len(s.AdditionalProperties) != len(other.AdditionalProperties) {
+		return false
+	}
+	for k, e := range s.AdditionalProperties {
+		f, ok := other.AdditionalProperties[k]
+		if !ok || !reflect.DeepEqual(e, f) {
+			return false
+		}
+	}
+	if (s.CollegeDegree == nil) != (other.CollegeDegree == nil) || (s.CollegeDegree != nil && *s.CollegeDegree != *other.CollegeDegree) {
+		return false
+	}
+	if (s.Location == nil) != (other.Location == nil) || (s.Location != nil && *s.Location != *other.Location) {
+		return false
+	}
+	if (s.Iswild == nil) != (other.Iswild == nil) || (s.Iswild != nil && *s.Iswild != *other.Iswild) {
+		return false
+	}
+	if (s.Species == nil) != (other.Species == nil) || (s.Species != nil && *s.Species != *other.Species) {
+		return false
+	}
+	if (s.Length == nil) != (other.Length == nil) || (s.Length != nil && *s.Length != *other.Length) {
+		return false
+	}
+	if (s.Siblings == nil) != (other.Siblings == nil) {
+		return false
+	}
+	if s.Siblings != nil {
+		if (*s.Siblings == nil) != (*other.Siblings == nil) || len(*s.Siblings) != len(*other.Siblings) {
+			return false
+		}
+		for i := range *s.Siblings {
+			if !equalBasicFish((*s.Siblings)[i], (*other.Siblings)[i], ignoreReadOnly) {
+				return false
+			}
+		}
+	}
+	if s.Fishtype != other.Fishtype {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the SmartSalmon to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (s *SmartSalmon) MergeFrom(patch SmartSalmon) {
+	if patch.AdditionalProperties != nil {
+		if s.AdditionalProperties == nil {
+			s.AdditionalProperties = make(map[string]interface{}, len(patch.AdditionalProperties))
+		}
+		for k, e := range patch.AdditionalProperties {
+			s.AdditionalProperties[k] = deepCopyObject(e)
+		}
+	}
+	if patch.CollegeDegree != nil {
+		v := *patch.CollegeDegree
+		s.CollegeDegree = &v
+	}
+	if patch.Location != nil {
+		v := *patch.Location
+		s.Location = &v
+	}
+	if patch.Iswild != nil {
+		v := *patch.Iswild
+		s.Iswild = &v
+	}
+	if patch.Species != nil {
+		v := *patch.Species
+		s.Species = &v
+	}
+	if patch.Length != nil {
+		v := *patch.Length
+		s.Length = &v
+	}
+	if patch.Siblings != nil {
+		var v []BasicFish
+		if *patch.Siblings != nil {
+			v = make([]BasicFish, len(*patch.Siblings))
+			for i := range *patch.Siblings {
+				v[i] = deepCopyBasicFish((*patch.Siblings)[i])
+			}
+		}
+		s.Siblings = &v
+	}
+	if patch.Fishtype != "" {
+		s.Fishtype = patch.Fishtype
+	}
+}
+
+// StringWrapper ...
+type StringWrapper struct {
+	autorest.Response `json:"-"`
+	Field             *string `json:"field,omitempty"`
+	Empty             *string `json:"empty,omitempty"`
+	Null              *string `json:"null,omitempty"`
+}
+
+// DeepCopy returns a copy of the StringWrapper that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (sw StringWrapper) DeepCopy() StringWrapper {
+	result := sw
+	if sw.Field != nil {
+		v := *sw.Field
+		result.Field = &v
+	}
+	if sw.Empty != nil {
+		v := *sw.Empty
+		result.Empty = &v
+	}
+	if sw.Null != nil {
+		v := *sw.Null
+		result.Null = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the StringWrapper and other are equal.  Times are equal if they represent the
+// same instant.  Their autorest.Response isn't compared.
+func (sw StringWrapper) Equal(other StringWrapper) bool {
+	return sw.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (sw StringWrapper) EqualIgnoreReadOnly(other StringWrapper) bool {
+	return sw.equal(other, true)
+}
+
+// equal compares the fields of the StringWrapper and other, skipping the read-only ones if ignoreReadOnly is true.
+func (sw StringWrapper) equal(other StringWrapper, ignoreReadOnly bool) bool {
+	if (sw.Field == nil) != (other.Field == nil) || (sw.Field != nil && *sw.Field != *other.Field) {
+		return false
+	}
+	if (sw.Empty == nil) != (other.Empty == nil) || (sw.Empty != nil && *sw.Empty != *other.Empty) {
+		return false
+	}
+	if (sw.Null == nil) != (other.Null == nil) || (sw.Null != nil && *sw.Null != *other.Null) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the StringWrapper to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (sw *StringWrapper) MergeFrom(patch StringWrapper) {
+	if patch.Field != nil {
+		v := *patch.Field
+		sw.Field = &v
+	}
+	if patch.Empty != nil {
+		v := *patch.Empty
+		sw.Empty = &v
+	}
+	if patch.Null != nil {
+		v := *patch.Null
+		sw.Null = &v
+	}
+}
+
+// UnknownDotFish is a BasicDotFish whose fish.type isn't known to this version of the package, e.g. a type added to the
+// service since.  It keeps the JSON it was unmarshaled from so that it's marshaled back unchanged.
+type UnknownDotFish struct {
+	// FishType - the value of the discriminator.
+	FishType FishType
+	// Raw - the JSON the UnknownDotFish was unmarshaled from.
+	Raw json.RawMessage
+}
+
+// MarshalJSON is the custom marshaler for UnknownDotFish.  It returns the JSON the UnknownDotFish was unmarshaled from, if any.
+func (udf UnknownDotFish) MarshalJSON() ([]byte, error) {
+	if len(udf.Raw) > 0 {
+		return udf.Raw, nil
+	}
+	objectMap := make(map[string]interface{})
+	objectMap["fish.type"] = udf.FishType
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON is the custom unmarshaler for UnknownDotFish struct.  It keeps a copy of body.
+func (udf *UnknownDotFish) UnmarshalJSON(body []byte) error {
+	discriminator, err := unmarshalDiscriminator(body, "fish.type")
+	if err != nil {
+		return err
+	}
+	return udf.unmarshal(discriminator, body)
+}
+
+// unmarshal sets the fields of the UnknownDotFish from body and the value of its discriminator.
+func (udf *UnknownDotFish) unmarshal(discriminator interface{}, body []byte) error {
+	value, ok := discriminator.(string)
+	if !ok && discriminator != nil {
+		// let encoding/json report the mismatch
+		var mismatch struct {
+			FishType FishType `json:"fish.type"`
+		}
+		return json.Unmarshal(body, &mismatch)
+	}
+	udf.FishType = FishType(value)
+	udf.Raw = append(json.RawMessage(nil), body...)
+	return nil
+}
+
+// AsDotSalmon is the BasicDotFish implementation for UnknownDotFish.
+func (udf UnknownDotFish) AsDotSalmon() (*DotSalmon, bool) {
+	return nil, false
+}
+
+// AsDotFish is the BasicDotFish implementation for UnknownDotFish.
+func (udf UnknownDotFish) AsDotFish() (*DotFish, bool) {
+	return nil, false
+}
+
+// AsBasicDotFish is the BasicDotFish implementation for UnknownDotFish.
+func (udf UnknownDotFish) AsBasicDotFish() (BasicDotFish, bool) {
+	return udf, true
+}
+
+// DeepCopy returns a copy of the UnknownDotFish that doesn't share the JSON it was unmarshaled from with it.
+func (udf UnknownDotFish) DeepCopy() UnknownDotFish {
+	result := udf
+	if udf.Raw != nil {
+		result.Raw = append(json.RawMessage(nil), udf.Raw...)
+	}
+	return result
+}
+
+// Equal returns true if the UnknownDotFish and other have the same discriminator and were unmarshaled from the same JSON.
+func (udf UnknownDotFish) Equal(other UnknownDotFish) bool {
+	return udf.equal(other, false)
+}
+
+// equal is Equal, the read-only fields of an unknown type can't be told apart from the others.
+func (udf UnknownDotFish) equal(other UnknownDotFish, ignoreReadOnly bool) bool {
+	return udf.FishType == other.FishType && bytes.Equal(udf.Raw, other.Raw)
+}
+
+// UnknownFish is a BasicFish whose fishtype isn't known to this version of the package, e.g. a type added to the
+// service since.  It keeps the JSON it was unmarshaled from so that it's marshaled back unchanged.
+type UnknownFish struct {
+	// Fishtype - the value of the discriminator.
+	Fishtype FishtypeBasicFish
+	// Raw - the JSON the UnknownFish was unmarshaled from.
+	Raw json.RawMessage
+}
+
+// MarshalJSON is the custom marshaler for UnknownFish.  It returns the JSON the UnknownFish was unmarshaled from, if any.
+func (uf UnknownFish) MarshalJSON() ([]byte, error) {
+	if len(uf.Raw) > 0 {
+		return uf.Raw, nil
+	}
+	objectMap := make(map[string]interface{})
+	objectMap["fishtype"] = uf.Fishtype
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON is the custom unmarshaler for UnknownFish struct.  It keeps a copy of body.
+func (uf *UnknownFish) UnmarshalJSON(body []byte) error {
+	discriminator, err := unmarshalDiscriminator(body, "fishtype")
+	if err != nil {
+		return err
+	}
+	return uf.unmarshal(discriminator, body)
+}
+
+// unmarshal sets the fields of the UnknownFish from body and the value of its discriminator.
+func (uf *UnknownFish) unmarshal(discriminator interface{}, body []byte) error {
+	value, ok := discriminator.(string)
+	if !ok && discriminator != nil {
+		// let encoding/json report the mismatch
+		var mismatch struct {
+			Fishtype FishtypeBasicFish `json:"fishtype"`
+		}
+		return json.Unmarshal(body, &mismatch)
+	}
+	uf.Fishtype = FishtypeBasicFish(value)
+	uf.Raw = append(json.RawMessage(nil), body...)
+	return nil
+}
+
+// AsSalmon is the BasicFish implementation for UnknownFish.
+func (uf UnknownFish) AsSalmon() (*Salmon, bool) {
+	return nil, false
+}
+
+// AsBasicSalmon is the BasicFish implementation for UnknownFish.
+func (uf UnknownFish) AsBasicSalmon() (BasicSalmon, bool) {
+	return nil, false
+}
+
+// AsSmartSalmon is the BasicFish implementation for UnknownFish.
+func (uf UnknownFish) AsSmartSalmon() (*SmartSalmon, bool) {
+	return nil, false
+}
+
+// AsShark is the BasicFish implementation for UnknownFish.
+func (uf UnknownFish) AsShark() (*Shark, bool) {
+	return nil, false
+}
+
+// AsBasicShark is the BasicFish implementation for UnknownFish.
+func (uf UnknownFish) AsBasicShark() (BasicShark, bool) {
+	return nil, false
+}
+
+// AsSawshark is the BasicFish implementation for UnknownFish.
+func (uf UnknownFish) AsSawshark() (*Sawshark, bool) {
+	return nil, false
+}
+
+// AsGoblinshark is the BasicFish implementation for UnknownFish.
+func (uf UnknownFish) AsGoblinshark() (*Goblinshark, bool) {
+	return nil, false
+}
+
+// AsCookiecuttershark is the BasicFish implementation for UnknownFish.
+func (uf UnknownFish) AsCookiecuttershark() (*Cookiecuttershark, bool) {
+	return nil, false
+}
+
+// AsFish is the BasicFish implementation for UnknownFish.
+func (uf UnknownFish) AsFish() (*Fish, bool) {
+	return nil, false
+}
+
+// AsBasicFish is the BasicFish implementation for UnknownFish.
+func (uf UnknownFish) AsBasicFish() (BasicFish, bool) {
+	return uf, true
+}
+
+// Validate is the BasicFish implementation for UnknownFish.  The content of an unknown type can't be validated.
+func (uf UnknownFish) Validate() error {
+	return nil
+}
+
+// DeepCopy returns a copy of the UnknownFish that doesn't share the JSON it was unmarshaled from with it.
+func (uf UnknownFish) DeepCopy() UnknownFish {
+	result := uf
+	if uf.Raw != nil {
+		result.Raw = append(json.RawMessage(nil), uf.Raw...)
+	}
+	return result
+}
+
+// Equal returns true if the UnknownFish and other have the same discriminator and were unmarshaled from the same JSON.
+func (uf UnknownFish) Equal(other UnknownFish) bool {
+	return uf.equal(other, false)
+}
+
+// equal is Equal, the read-only fields of an unknown type can't be told apart from the others.
+func (uf UnknownFish) equal(other UnknownFish, ignoreReadOnly bool) bool {
+	return uf.Fishtype == other.Fishtype && bytes.Equal(uf.Raw, other.Raw)
+}
+
+// UnknownMyBaseType is a BasicMyBaseType whose kind isn't known to this version of the package, e.g. a type added to
+// the service since.  It keeps the JSON it was unmarshaled from so that it's marshaled back unchanged.
+type UnknownMyBaseType struct {
+	// Kind - the value of the discriminator.
+	Kind Kind
+	// Raw - the JSON the UnknownMyBaseType was unmarshaled from.
+	Raw json.RawMessage
+}
+
+// MarshalJSON is the custom marshaler for UnknownMyBaseType.  It returns the JSON the UnknownMyBaseType was unmarshaled from, if any.
+func (umbt UnknownMyBaseType) MarshalJSON() ([]byte, error) {
+	if len(umbt.Raw) > 0 {
+		return umbt.Raw, nil
+	}
+	objectMap := make(map[string]interface{})
+	objectMap["kind"] = umbt.Kind
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON is the custom unmarshaler for UnknownMyBaseType struct.  It keeps a copy of body.
+func (umbt *UnknownMyBaseType) UnmarshalJSON(body []byte) error {
+	discriminator, err := unmarshalDiscriminator(body, "kind")
+	if err != nil {
+		return err
+	}
+	return umbt.unmarshal(discriminator, body)
+}
+
+// unmarshal sets the fields of the UnknownMyBaseType from body and the value of its discriminator.
+func (umbt *UnknownMyBaseType) unmarshal(discriminator interface{}, body []byte) error {
+	value, ok := discriminator.(string)
+	if !ok && discriminator != nil {
+		// let encoding/json report the mismatch
+		var mismatch struct {
+			Kind Kind `json:"kind"`
+		}
+		return json.Unmarshal(body, &mismatch)
+	}
+	umbt.Kind = Kind(value)
+	umbt.Raw = append(json.RawMessage(nil), body...)
+	return nil
+}
+
+// AsMyDerivedType is the BasicMyBaseType implementation for UnknownMyBaseType.
+func (umbt UnknownMyBaseType) AsMyDerivedType() (*MyDerivedType, bool) {
+	return nil, false
+}
+
+// AsMyBaseType is the BasicMyBaseType implementation for UnknownMyBaseType.
+func (umbt UnknownMyBaseType) AsMyBaseType() (*MyBaseType, bool) {
+	return nil, false
+}
+
+// AsBasicMyBaseType is the BasicMyBaseType implementation for UnknownMyBaseType.
+func (umbt UnknownMyBaseType) AsBasicMyBaseType() (BasicMyBaseType, bool) {
+	return umbt, true
+}
+
+// DeepCopy returns a copy of the UnknownMyBaseType that doesn't share the JSON it was unmarshaled from with it.
+func (umbt UnknownMyBaseType) DeepCopy() UnknownMyBaseType {
+	result := umbt
+	if umbt.Raw != nil {
+		result.Raw = append(json.RawMessage(nil), umbt.Raw...)
+	}
+	return result
+}
+
+// Equal returns true if the UnknownMyBaseType and other have the same discriminator and were unmarshaled from the same JSON.
+func (umbt UnknownMyBaseType) Equal(other UnknownMyBaseType) bool {
+	return umbt.equal(other, false)
+}
+
+// equal is Equal, the read-only fields of an unknown type can't be told apart from the others.
+func (umbt UnknownMyBaseType) equal(other UnknownMyBaseType, ignoreReadOnly bool) bool {
+	return umbt.Kind == other.Kind && bytes.Equal(umbt.Raw, other.Raw)
+}
+
+// UnknownSalmon is a BasicSalmon whose fishtype isn't known to this version of the package, e.g. a type added to the
+// service since.  It keeps the JSON it was unmarshaled from so that it's marshaled back unchanged.
+type UnknownSalmon struct {
+	// Fishtype - the value of the discriminator.
+	Fishtype FishtypeBasicFish
+	// Raw - the JSON the UnknownSalmon was unmarshaled from.
+	Raw json.RawMessage
+}
+
+// MarshalJSON is the custom marshaler for UnknownSalmon.  It returns the JSON the UnknownSalmon was unmarshaled from, if any.
+func (us UnknownSalmon) MarshalJSON() ([]byte, error) {
+	if len(us.Raw) > 0 {
+		return us.Raw, nil
+	}
+	objectMap := make(map[string]interface{})
+	objectMap["fishtype"] = us.Fishtype
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON is the custom unmarshaler for UnknownSalmon struct.  It keeps a copy of body.
+func (us *UnknownSalmon) UnmarshalJSON(body []byte) error {
+	discriminator, err := unmarshalDiscriminator(body, "fishtype")
+	if err != nil {
+		return err
+	}
+	return us.unmarshal(discriminator, body)
+}
+
+// unmarshal sets the fields of the UnknownSalmon from body and the value of its discriminator.
+func (us *UnknownSalmon) unmarshal(discriminator interface{}, body []byte) error {
+	value, ok := discriminator.(string)
+	if !ok && discriminator != nil {
+		// let encoding/json report the mismatch
+		var mismatch struct {
+			Fishtype FishtypeBasicFish `json:"fishtype"`
+		}
+		return json.Unmarshal(body, &mismatch)
+	}
+	us.Fishtype = FishtypeBasicFish(value)
+	us.Raw = append(json.RawMessage(nil), body...)
+	return nil
+}
+
+// AsSalmon is the BasicFish implementation for UnknownSalmon.
+func (us UnknownSalmon) AsSalmon() (*Salmon, bool) {
+	return nil, false
+}
+
+// AsBasicSalmon is the BasicFish implementation for UnknownSalmon.
+func (us UnknownSalmon) AsBasicSalmon() (BasicSalmon, bool) {
+	return us, true
+}
+
+// AsSmartSalmon is the BasicFish implementation for UnknownSalmon.
+func (us UnknownSalmon) AsSmartSalmon() (*SmartSalmon, bool) {
+	return nil, false
+}
+
+// AsShark is the BasicFish implementation for UnknownSalmon.
+func (us UnknownSalmon) AsShark() (*Shark, bool) {
+	return nil, false
+}
+
+// AsBasicShark is the BasicFish implementation for UnknownSalmon.
+func (us UnknownSalmon) AsBasicShark() (BasicShark, bool) {
+	return nil, false
+}
+
+// AsSawshark is the BasicFish implementation for UnknownSalmon.
+func (us UnknownSalmon) AsSawshark() (*Sawshark, bool) {
+	return nil, false
+}
+
+// AsGoblinshark is the BasicFish implementation for UnknownSalmon.
+func (us UnknownSalmon) AsGoblinshark() (*Goblinshark, bool) {
+	return nil, false
+}
+
+// AsCookiecuttershark is the BasicFish implementation for UnknownSalmon.
+func (us UnknownSalmon) AsCookiecuttershark() (*Cookiecuttershark, bool) {
+	return nil, false
+}
+
+// AsFish is the BasicFish implementation for UnknownSalmon.
+func (us UnknownSalmon) AsFish() (*Fish, bool) {
+	return nil, false
+}
+
+// AsBasicFish is the BasicFish implementation for UnknownSalmon.
+func (us UnknownSalmon) AsBasicFish() (BasicFish, bool) {
+	return us, true
+}
+
+// Validate is the BasicFish implementation for UnknownSalmon.  The content of an unknown type can't be validated.
+func (us UnknownSalmon) Validate() error {
+	return nil
+}
+
+// DeepCopy returns a copy of the UnknownSalmon that doesn't share the JSON it was unmarshaled from with it.
+func (us UnknownSalmon) DeepCopy() UnknownSalmon {
+	result := us
+	if us.Raw != nil {
+		result.Raw = append(json.RawMessage(nil), us.Raw...)
+	}
+	return result
+}
+
+// Equal returns true if the UnknownSalmon and other have the same discriminator and were unmarshaled from the same JSON.
+func (us UnknownSalmon) Equal(other UnknownSalmon) bool {
+	return us.equal(other, false)
+}
+
+// equal is Equal, the read-only fields of an unknown type can't be told apart from the others.
+func (us UnknownSalmon) equal(other UnknownSalmon, ignoreReadOnly bool) bool {
+	return us.Fishtype == other.Fishtype && bytes.Equal(us.Raw, other.Raw)
+}
+
+// UnknownShark is a BasicShark whose fishtype isn't known to this version of the package, e.g. a type added to the
+// service since.  It keeps the JSON it was unmarshaled from so that it's marshaled back unchanged.
+type UnknownShark struct {
+	// Fishtype - the value of the discriminator.
+	Fishtype FishtypeBasicFish
+	// Raw - the JSON the UnknownShark was unmarshaled from.
+	Raw json.RawMessage
+}
+
+// MarshalJSON is the custom marshaler for UnknownShark.  It returns the JSON the UnknownShark was unmarshaled from, if any.
+func (us UnknownShark) MarshalJSON() ([]byte, error) {
+	if len(us.Raw) > 0 {
+		return us.Raw, nil
+	}
+	objectMap := make(map[string]interface{})
+	objectMap["fishtype"] = us.Fishtype
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON is the custom unmarshaler for UnknownShark struct.  It keeps a copy of body.
+func (us *UnknownShark) UnmarshalJSON(body []byte) error {
+	discriminator, err := unmarshalDiscriminator(body, "fishtype")
+	if err != nil {
+		return err
+	}
+	return us.unmarshal(discriminator, body)
+}
+
+// unmarshal sets the fields of the UnknownShark from body and the value of its discriminator.
+func (us *UnknownShark) unmarshal(discriminator interface{}, body []byte) error {
+	value, ok := discriminator.(string)
+	if !ok && discriminator != nil {
+		// let encoding/json report the mismatch
+		var mismatch struct {
+			Fishtype FishtypeBasicFish `json:"fishtype"`
+		}
+		return json.Unmarshal(body, &mismatch)
+	}
+	us.Fishtype = FishtypeBasicFish(value)
+	us.Raw = append(json.RawMessage(nil), body...)
+	return nil
+}
+
+// AsSalmon is the BasicFish implementation for UnknownShark.
+func (us UnknownShark) AsSalmon() (*Salmon, bool) {
+	return nil, false
+}
+
+// AsBasicSalmon is the BasicFish implementation for UnknownShark.
+func (us UnknownShark) AsBasicSalmon() (BasicSalmon, bool) {
+	return nil, false
+}
+
+// AsSmartSalmon is the BasicFish implementation for UnknownShark.
+func (us UnknownShark) AsSmartSalmon() (*SmartSalmon, bool) {
+	return nil, false
+}
+
+// AsShark is the BasicFish implementation for UnknownShark.
+func (us UnknownShark) AsShark() (*Shark, bool) {
+	return nil, false
+}
+
+// AsBasicShark is the BasicFish implementation for UnknownShark.
+func (us UnknownShark) AsBasicShark() (BasicShark, bool) {
+	return us, true
+}
+
+// AsSawshark is the BasicFish implementation for UnknownShark.
+func (us UnknownShark) AsSawshark() (*Sawshark, bool) {
+	return nil, false
+}
+
+// AsGoblinshark is the BasicFish implementation for UnknownShark.
+func (us UnknownShark) AsGoblinshark() (*Goblinshark, bool) {
+	return nil, false
+}
+
+// AsCookiecuttershark is the BasicFish implementation for UnknownShark.
+func (us UnknownShark) AsCookiecuttershark() (*Cookiecuttershark, bool) {
+	return nil, false
+}
+
+// AsFish is the BasicFish implementation for UnknownShark.
+func (us UnknownShark) AsFish() (*Fish, bool) {
+	return nil, false
+}
+
+// AsBasicFish is the BasicFish implementation for UnknownShark.
+func (us UnknownShark) AsBasicFish() (BasicFish, bool) {
+	return us, true
+}
+
+// Validate is the BasicFish implementation for UnknownShark.  The content of an unknown type can't be validated.
+func (us UnknownShark) Validate() error {
+	return nil
+}
+
+// DeepCopy returns a copy of the UnknownShark that doesn't share the JSON it was unmarshaled from with it.
+func (us UnknownShark) DeepCopy() UnknownShark {
+	result := us
+	if us.Raw != nil {
+		result.Raw = append(json.RawMessage(nil), us.Raw...)
+	}
+	return result
+}
+
+// Equal returns true if the UnknownShark and other have the same discriminator and were unmarshaled from the same JSON.
+func (us UnknownShark) Equal(other UnknownShark) bool {
+	return us.equal(other, false)
+}
+
+// equal is Equal, the read-only fields of an unknown type can't be told apart from the others.
+func (us UnknownShark) equal(other UnknownShark, ignoreReadOnly bool) bool {
+	return us.Fishtype == other.Fishtype && bytes.Equal(us.Raw, other.Raw)
+}
+
+// ValidationError describes a value that violates one of the constraints defined by the service.
+type ValidationError struct {
+	// Path - the JSON path of the value, relative to the value being validated.
+	Path string
+	// Constraint - the name of the violated constraint, e.g. MaxLength.
+	Constraint string
+	// Details - describes the violation.
+	Details string
+}
+
+// Error implements the error interface for type ValidationError.
+func (ve ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s (%s)", ve.Path, ve.Details, ve.Constraint)
+}
+
+// ValidationErrors contains every constraint violation found when validating a value.
+type ValidationErrors []ValidationError
+
+// Error implements the error interface for type ValidationErrors.
+func (ve ValidationErrors) Error() string {
+	msgs := make([]string, len(ve))
+	for i := range ve {
+		msgs[i] = ve[i].Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// addNested appends the violations in err, as returned by the Validate method
+// of the value at path, to ve after prefixing their paths with path.
+func (ve ValidationErrors) addNested(path string, err error) ValidationErrors {
+	if nested, ok := err.(ValidationErrors); ok {
+		for _, e := range nested {
+			e.Path = path + "." + e.Path
+			ve = append(ve, e)
+		}
+	} else if err != nil {
+		ve = append(ve, ValidationError{Path: path, Details: err.Error()})
+	}
+	return ve
+}
+
+// ParameterValidationError is returned by an operation whose parameters violate the constraints defined
+// by the service.  Use errors.As to retrieve the validation.Error or the ValidationErrors it wraps.
+type ParameterValidationError struct {
+	// Err - identifies the operation, its message lists every violation.
+	Err validation.Error
+	// Errors - contains every violation.
+	Errors ValidationErrors
+}
+
+// Error implements the error interface for type ParameterValidationError.
+func (pve ParameterValidationError) Error() string {
+	return pve.Err.Error()
+}
+
+// Unwrap returns the validation.Error and the ValidationErrors wrapped by the ParameterValidationError.
+func (pve ParameterValidationError) Unwrap() []error {
+	return []error{pve.Err, pve.Errors}
 }
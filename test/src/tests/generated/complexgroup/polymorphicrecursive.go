@@ -9,9 +9,7 @@ package complexgroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/validation"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -30,16 +28,25 @@ func NewPolymorphicrecursiveClientWithBaseURI(baseURI string) Polymorphicrecursi
 	return PolymorphicrecursiveClient{NewWithBaseURI(baseURI)}
 }
 
+// NewPolymorphicrecursiveClientWithOptions creates an instance of the PolymorphicrecursiveClient client with the
+// specified options.  It returns an error if the options are invalid.
+func NewPolymorphicrecursiveClientWithOptions(opts ClientOptions) (PolymorphicrecursiveClient, error) {
+	client, err := NewWithOptions(opts)
+	return PolymorphicrecursiveClient{client}, err
+}
+
 // GetValid get complex types that are polymorphic and have recursive references
 func (client PolymorphicrecursiveClient) GetValid(ctx context.Context) (result FishModel, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PolymorphicrecursiveClient.GetValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PolymorphicrecursiveClient.GetValid", "GET", "/complex/polymorphicrecursive/valid")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PolymorphicrecursiveClient.GetValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetValidPreparer(ctx)
@@ -56,7 +63,7 @@ func (client PolymorphicrecursiveClient) GetValid(ctx context.Context) (result F
 	}
 
 	result, err = client.GetValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PolymorphicrecursiveClient", "GetValid", resp, "Failure responding to request")
 	}
 
@@ -69,14 +76,15 @@ func (client PolymorphicrecursiveClient) GetValidPreparer(ctx context.Context) (
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/polymorphicrecursive/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetValidSender sends the GetValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client PolymorphicrecursiveClient) GetValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetValidResponder handles the response to the GetValid request. The method always
@@ -85,7 +93,7 @@ func (client PolymorphicrecursiveClient) GetValidResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -148,20 +156,26 @@ func (client PolymorphicrecursiveClient) GetValidResponder(resp *http.Response)
 // ]
 // }
 func (client PolymorphicrecursiveClient) PutValid(ctx context.Context, complexBody BasicFish) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PolymorphicrecursiveClient.PutValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PolymorphicrecursiveClient.PutValid", "PUT", "/complex/polymorphicrecursive/valid")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: complexBody,
-			Constraints: []validation.Constraint{{Target: "complexBody.Length", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("complexgroup.PolymorphicrecursiveClient", "PutValid", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PolymorphicrecursiveClient.PutValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if complexBody == nil {
+		errs = append(errs, ValidationError{Path: "complexBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	} else {
+		errs = errs.addNested("complexBody", complexBody.Validate())
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("complexgroup.PolymorphicrecursiveClient", "PutValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutValidPreparer(ctx, complexBody)
@@ -178,7 +192,7 @@ func (client PolymorphicrecursiveClient) PutValid(ctx context.Context, complexBo
 	}
 
 	result, err = client.PutValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PolymorphicrecursiveClient", "PutValid", resp, "Failure responding to request")
 	}
 
@@ -193,14 +207,15 @@ func (client PolymorphicrecursiveClient) PutValidPreparer(ctx context.Context, c
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/polymorphicrecursive/valid"),
 		autorest.WithJSON(complexBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutValidSender sends the PutValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client PolymorphicrecursiveClient) PutValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutValidResponder handles the response to the PutValid request. The method always
@@ -209,7 +224,7 @@ func (client PolymorphicrecursiveClient) PutValidResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
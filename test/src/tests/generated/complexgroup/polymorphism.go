@@ -9,9 +9,7 @@ package complexgroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/validation"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -30,17 +28,26 @@ func NewPolymorphismClientWithBaseURI(baseURI string) PolymorphismClient {
 	return PolymorphismClient{NewWithBaseURI(baseURI)}
 }
 
+// NewPolymorphismClientWithOptions creates an instance of the PolymorphismClient client with the specified options.  It
+// returns an error if the options are invalid.
+func NewPolymorphismClientWithOptions(opts ClientOptions) (PolymorphismClient, error) {
+	client, err := NewWithOptions(opts)
+	return PolymorphismClient{client}, err
+}
+
 // GetComplicated get complex types that are polymorphic, but not at the root of the hierarchy; also have additional
 // properties
 func (client PolymorphismClient) GetComplicated(ctx context.Context) (result SalmonModel, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PolymorphismClient.GetComplicated")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PolymorphismClient.GetComplicated", "GET", "/complex/polymorphism/complicated")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PolymorphismClient.GetComplicated", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetComplicatedPreparer(ctx)
@@ -57,7 +64,7 @@ func (client PolymorphismClient) GetComplicated(ctx context.Context) (result Sal
 	}
 
 	result, err = client.GetComplicatedResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PolymorphismClient", "GetComplicated", resp, "Failure responding to request")
 	}
 
@@ -70,14 +77,15 @@ func (client PolymorphismClient) GetComplicatedPreparer(ctx context.Context) (*h
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/polymorphism/complicated"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetComplicatedSender sends the GetComplicated request. The method will close the
 // http.Response Body if it receives an error.
 func (client PolymorphismClient) GetComplicatedSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetComplicatedResponder handles the response to the GetComplicated request. The method always
@@ -86,7 +94,7 @@ func (client PolymorphismClient) GetComplicatedResponder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -97,14 +105,16 @@ func (client PolymorphismClient) GetComplicatedResponder(resp *http.Response) (r
 // polymorphic element type, with discriminator specified. Deserialization must NOT fail and use the discriminator type
 // specified on the wire.
 func (client PolymorphismClient) GetComposedWithDiscriminator(ctx context.Context) (result DotFishMarket, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PolymorphismClient.GetComposedWithDiscriminator")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PolymorphismClient.GetComposedWithDiscriminator", "GET", "/complex/polymorphism/composedWithDiscriminator")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PolymorphismClient.GetComposedWithDiscriminator", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetComposedWithDiscriminatorPreparer(ctx)
@@ -121,7 +131,7 @@ func (client PolymorphismClient) GetComposedWithDiscriminator(ctx context.Contex
 	}
 
 	result, err = client.GetComposedWithDiscriminatorResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PolymorphismClient", "GetComposedWithDiscriminator", resp, "Failure responding to request")
 	}
 
@@ -134,14 +144,15 @@ func (client PolymorphismClient) GetComposedWithDiscriminatorPreparer(ctx contex
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/polymorphism/composedWithDiscriminator"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetComposedWithDiscriminatorSender sends the GetComposedWithDiscriminator request. The method will close the
 // http.Response Body if it receives an error.
 func (client PolymorphismClient) GetComposedWithDiscriminatorSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetComposedWithDiscriminatorResponder handles the response to the GetComposedWithDiscriminator request. The method always
@@ -150,7 +161,7 @@ func (client PolymorphismClient) GetComposedWithDiscriminatorResponder(resp *htt
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -161,14 +172,16 @@ func (client PolymorphismClient) GetComposedWithDiscriminatorResponder(resp *htt
 // polymorphic element type, without discriminator specified on wire. Deserialization must NOT fail and use the
 // explicit type of the property.
 func (client PolymorphismClient) GetComposedWithoutDiscriminator(ctx context.Context) (result DotFishMarket, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PolymorphismClient.GetComposedWithoutDiscriminator")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PolymorphismClient.GetComposedWithoutDiscriminator", "GET", "/complex/polymorphism/composedWithoutDiscriminator")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PolymorphismClient.GetComposedWithoutDiscriminator", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetComposedWithoutDiscriminatorPreparer(ctx)
@@ -185,7 +198,7 @@ func (client PolymorphismClient) GetComposedWithoutDiscriminator(ctx context.Con
 	}
 
 	result, err = client.GetComposedWithoutDiscriminatorResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PolymorphismClient", "GetComposedWithoutDiscriminator", resp, "Failure responding to request")
 	}
 
@@ -198,14 +211,15 @@ func (client PolymorphismClient) GetComposedWithoutDiscriminatorPreparer(ctx con
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/polymorphism/composedWithoutDiscriminator"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetComposedWithoutDiscriminatorSender sends the GetComposedWithoutDiscriminator request. The method will close the
 // http.Response Body if it receives an error.
 func (client PolymorphismClient) GetComposedWithoutDiscriminatorSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetComposedWithoutDiscriminatorResponder handles the response to the GetComposedWithoutDiscriminator request. The method always
@@ -214,7 +228,7 @@ func (client PolymorphismClient) GetComposedWithoutDiscriminatorResponder(resp *
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -223,14 +237,16 @@ func (client PolymorphismClient) GetComposedWithoutDiscriminatorResponder(resp *
 
 // GetDotSyntax get complex types that are polymorphic, JSON key contains a dot
 func (client PolymorphismClient) GetDotSyntax(ctx context.Context) (result DotFishModel, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PolymorphismClient.GetDotSyntax")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PolymorphismClient.GetDotSyntax", "GET", "/complex/polymorphism/dotsyntax")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PolymorphismClient.GetDotSyntax", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDotSyntaxPreparer(ctx)
@@ -247,7 +263,7 @@ func (client PolymorphismClient) GetDotSyntax(ctx context.Context) (result DotFi
 	}
 
 	result, err = client.GetDotSyntaxResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PolymorphismClient", "GetDotSyntax", resp, "Failure responding to request")
 	}
 
@@ -260,14 +276,15 @@ func (client PolymorphismClient) GetDotSyntaxPreparer(ctx context.Context) (*htt
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/polymorphism/dotsyntax"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDotSyntaxSender sends the GetDotSyntax request. The method will close the
 // http.Response Body if it receives an error.
 func (client PolymorphismClient) GetDotSyntaxSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDotSyntaxResponder handles the response to the GetDotSyntax request. The method always
@@ -276,7 +293,7 @@ func (client PolymorphismClient) GetDotSyntaxResponder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -285,14 +302,16 @@ func (client PolymorphismClient) GetDotSyntaxResponder(resp *http.Response) (res
 
 // GetValid get complex types that are polymorphic
 func (client PolymorphismClient) GetValid(ctx context.Context) (result FishModel, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PolymorphismClient.GetValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PolymorphismClient.GetValid", "GET", "/complex/polymorphism/valid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PolymorphismClient.GetValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetValidPreparer(ctx)
@@ -309,7 +328,7 @@ func (client PolymorphismClient) GetValid(ctx context.Context) (result FishModel
 	}
 
 	result, err = client.GetValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PolymorphismClient", "GetValid", resp, "Failure responding to request")
 	}
 
@@ -322,14 +341,15 @@ func (client PolymorphismClient) GetValidPreparer(ctx context.Context) (*http.Re
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/polymorphism/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetValidSender sends the GetValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client PolymorphismClient) GetValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetValidResponder handles the response to the GetValid request. The method always
@@ -338,7 +358,7 @@ func (client PolymorphismClient) GetValidResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -348,16 +368,28 @@ func (client PolymorphismClient) GetValidResponder(resp *http.Response) (result
 // PutComplicated put complex types that are polymorphic, but not at the root of the hierarchy; also have additional
 // properties
 func (client PolymorphismClient) PutComplicated(ctx context.Context, complexBody BasicSalmon) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PolymorphismClient.PutComplicated")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PolymorphismClient.PutComplicated", "PUT", "/complex/polymorphism/complicated")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PolymorphismClient.PutComplicated", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
+	var errs ValidationErrors
+	if complexBody == nil {
+		errs = append(errs, ValidationError{Path: "complexBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	} else {
+		errs = errs.addNested("complexBody", complexBody.Validate())
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("complexgroup.PolymorphismClient", "PutComplicated", errs.Error()), Errors: errs}
+	}
+
 	req, err := client.PutComplicatedPreparer(ctx, complexBody)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "complexgroup.PolymorphismClient", "PutComplicated", nil, "Failure preparing request")
@@ -372,7 +404,7 @@ func (client PolymorphismClient) PutComplicated(ctx context.Context, complexBody
 	}
 
 	result, err = client.PutComplicatedResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PolymorphismClient", "PutComplicated", resp, "Failure responding to request")
 	}
 
@@ -387,14 +419,15 @@ func (client PolymorphismClient) PutComplicatedPreparer(ctx context.Context, com
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/polymorphism/complicated"),
 		autorest.WithJSON(complexBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutComplicatedSender sends the PutComplicated request. The method will close the
 // http.Response Body if it receives an error.
 func (client PolymorphismClient) PutComplicatedSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutComplicatedResponder handles the response to the PutComplicated request. The method always
@@ -403,7 +436,7 @@ func (client PolymorphismClient) PutComplicatedResponder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -411,16 +444,28 @@ func (client PolymorphismClient) PutComplicatedResponder(resp *http.Response) (r
 
 // PutMissingDiscriminator put complex types that are polymorphic, omitting the discriminator
 func (client PolymorphismClient) PutMissingDiscriminator(ctx context.Context, complexBody BasicSalmon) (result SalmonModel, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PolymorphismClient.PutMissingDiscriminator")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PolymorphismClient.PutMissingDiscriminator", "PUT", "/complex/polymorphism/missingdiscriminator")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
 		}()
 	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PolymorphismClient.PutMissingDiscriminator", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if complexBody == nil {
+		errs = append(errs, ValidationError{Path: "complexBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	} else {
+		errs = errs.addNested("complexBody", complexBody.Validate())
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("complexgroup.PolymorphismClient", "PutMissingDiscriminator", errs.Error()), Errors: errs}
+	}
+
 	req, err := client.PutMissingDiscriminatorPreparer(ctx, complexBody)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "complexgroup.PolymorphismClient", "PutMissingDiscriminator", nil, "Failure preparing request")
@@ -435,7 +480,7 @@ func (client PolymorphismClient) PutMissingDiscriminator(ctx context.Context, co
 	}
 
 	result, err = client.PutMissingDiscriminatorResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PolymorphismClient", "PutMissingDiscriminator", resp, "Failure responding to request")
 	}
 
@@ -450,14 +495,15 @@ func (client PolymorphismClient) PutMissingDiscriminatorPreparer(ctx context.Con
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/polymorphism/missingdiscriminator"),
 		autorest.WithJSON(complexBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutMissingDiscriminatorSender sends the PutMissingDiscriminator request. The method will close the
 // http.Response Body if it receives an error.
 func (client PolymorphismClient) PutMissingDiscriminatorSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutMissingDiscriminatorResponder handles the response to the PutMissingDiscriminator request. The method always
@@ -466,7 +512,7 @@ func (client PolymorphismClient) PutMissingDiscriminatorResponder(resp *http.Res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -509,20 +555,26 @@ func (client PolymorphismClient) PutMissingDiscriminatorResponder(resp *http.Res
 // ]
 // };
 func (client PolymorphismClient) PutValid(ctx context.Context, complexBody BasicFish) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PolymorphismClient.PutValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PolymorphismClient.PutValid", "PUT", "/complex/polymorphism/valid")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PolymorphismClient.PutValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: complexBody,
-			Constraints: []validation.Constraint{{Target: "complexBody.Length", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("complexgroup.PolymorphismClient", "PutValid", err.Error())
+	var errs ValidationErrors
+	if complexBody == nil {
+		errs = append(errs, ValidationError{Path: "complexBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	} else {
+		errs = errs.addNested("complexBody", complexBody.Validate())
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("complexgroup.PolymorphismClient", "PutValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutValidPreparer(ctx, complexBody)
@@ -539,7 +591,7 @@ func (client PolymorphismClient) PutValid(ctx context.Context, complexBody Basic
 	}
 
 	result, err = client.PutValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PolymorphismClient", "PutValid", resp, "Failure responding to request")
 	}
 
@@ -554,14 +606,15 @@ func (client PolymorphismClient) PutValidPreparer(ctx context.Context, complexBo
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/polymorphism/valid"),
 		autorest.WithJSON(complexBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutValidSender sends the PutValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client PolymorphismClient) PutValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutValidResponder handles the response to the PutValid request. The method always
@@ -570,7 +623,7 @@ func (client PolymorphismClient) PutValidResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -607,20 +660,26 @@ func (client PolymorphismClient) PutValidResponder(resp *http.Response) (result
 // ]
 // }
 func (client PolymorphismClient) PutValidMissingRequired(ctx context.Context, complexBody BasicFish) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PolymorphismClient.PutValidMissingRequired")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PolymorphismClient.PutValidMissingRequired", "PUT", "/complex/polymorphism/missingrequired/invalid")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: complexBody,
-			Constraints: []validation.Constraint{{Target: "complexBody.Length", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("complexgroup.PolymorphismClient", "PutValidMissingRequired", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PolymorphismClient.PutValidMissingRequired", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if complexBody == nil {
+		errs = append(errs, ValidationError{Path: "complexBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	} else {
+		errs = errs.addNested("complexBody", complexBody.Validate())
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("complexgroup.PolymorphismClient", "PutValidMissingRequired", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutValidMissingRequiredPreparer(ctx, complexBody)
@@ -637,7 +696,7 @@ func (client PolymorphismClient) PutValidMissingRequired(ctx context.Context, co
 	}
 
 	result, err = client.PutValidMissingRequiredResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PolymorphismClient", "PutValidMissingRequired", resp, "Failure responding to request")
 	}
 
@@ -652,14 +711,15 @@ func (client PolymorphismClient) PutValidMissingRequiredPreparer(ctx context.Con
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/polymorphism/missingrequired/invalid"),
 		autorest.WithJSON(complexBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutValidMissingRequiredSender sends the PutValidMissingRequired request. The method will close the
 // http.Response Body if it receives an error.
 func (client PolymorphismClient) PutValidMissingRequiredSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutValidMissingRequiredResponder handles the response to the PutValidMissingRequired request. The method always
@@ -668,7 +728,7 @@ func (client PolymorphismClient) PutValidMissingRequiredResponder(resp *http.Res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
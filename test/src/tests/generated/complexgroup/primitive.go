@@ -9,8 +9,6 @@ package complexgroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -29,16 +27,25 @@ func NewPrimitiveClientWithBaseURI(baseURI string) PrimitiveClient {
 	return PrimitiveClient{NewWithBaseURI(baseURI)}
 }
 
+// NewPrimitiveClientWithOptions creates an instance of the PrimitiveClient client with the specified options.  It
+// returns an error if the options are invalid.
+func NewPrimitiveClientWithOptions(opts ClientOptions) (PrimitiveClient, error) {
+	client, err := NewWithOptions(opts)
+	return PrimitiveClient{client}, err
+}
+
 // GetBool get complex types with bool properties
 func (client PrimitiveClient) GetBool(ctx context.Context) (result BooleanWrapper, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PrimitiveClient.GetBool")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PrimitiveClient.GetBool", "GET", "/complex/primitive/bool")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PrimitiveClient.GetBool", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetBoolPreparer(ctx)
@@ -55,7 +62,7 @@ func (client PrimitiveClient) GetBool(ctx context.Context) (result BooleanWrappe
 	}
 
 	result, err = client.GetBoolResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PrimitiveClient", "GetBool", resp, "Failure responding to request")
 	}
 
@@ -68,14 +75,15 @@ func (client PrimitiveClient) GetBoolPreparer(ctx context.Context) (*http.Reques
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/primitive/bool"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetBoolSender sends the GetBool request. The method will close the
 // http.Response Body if it receives an error.
 func (client PrimitiveClient) GetBoolSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetBoolResponder handles the response to the GetBool request. The method always
@@ -84,7 +92,7 @@ func (client PrimitiveClient) GetBoolResponder(resp *http.Response) (result Bool
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -93,14 +101,16 @@ func (client PrimitiveClient) GetBoolResponder(resp *http.Response) (result Bool
 
 // GetByte get complex types with byte properties
 func (client PrimitiveClient) GetByte(ctx context.Context) (result ByteWrapper, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PrimitiveClient.GetByte")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PrimitiveClient.GetByte", "GET", "/complex/primitive/byte")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PrimitiveClient.GetByte", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetBytePreparer(ctx)
@@ -117,7 +127,7 @@ func (client PrimitiveClient) GetByte(ctx context.Context) (result ByteWrapper,
 	}
 
 	result, err = client.GetByteResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PrimitiveClient", "GetByte", resp, "Failure responding to request")
 	}
 
@@ -130,14 +140,15 @@ func (client PrimitiveClient) GetBytePreparer(ctx context.Context) (*http.Reques
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/primitive/byte"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetByteSender sends the GetByte request. The method will close the
 // http.Response Body if it receives an error.
 func (client PrimitiveClient) GetByteSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetByteResponder handles the response to the GetByte request. The method always
@@ -146,7 +157,7 @@ func (client PrimitiveClient) GetByteResponder(resp *http.Response) (result Byte
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -155,14 +166,16 @@ func (client PrimitiveClient) GetByteResponder(resp *http.Response) (result Byte
 
 // GetDate get complex types with date properties
 func (client PrimitiveClient) GetDate(ctx context.Context) (result DateWrapper, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PrimitiveClient.GetDate")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PrimitiveClient.GetDate", "GET", "/complex/primitive/date")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PrimitiveClient.GetDate", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDatePreparer(ctx)
@@ -179,7 +192,7 @@ func (client PrimitiveClient) GetDate(ctx context.Context) (result DateWrapper,
 	}
 
 	result, err = client.GetDateResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PrimitiveClient", "GetDate", resp, "Failure responding to request")
 	}
 
@@ -192,14 +205,15 @@ func (client PrimitiveClient) GetDatePreparer(ctx context.Context) (*http.Reques
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/primitive/date"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDateSender sends the GetDate request. The method will close the
 // http.Response Body if it receives an error.
 func (client PrimitiveClient) GetDateSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDateResponder handles the response to the GetDate request. The method always
@@ -208,7 +222,7 @@ func (client PrimitiveClient) GetDateResponder(resp *http.Response) (result Date
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -217,14 +231,16 @@ func (client PrimitiveClient) GetDateResponder(resp *http.Response) (result Date
 
 // GetDateTime get complex types with datetime properties
 func (client PrimitiveClient) GetDateTime(ctx context.Context) (result DatetimeWrapper, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PrimitiveClient.GetDateTime")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PrimitiveClient.GetDateTime", "GET", "/complex/primitive/datetime")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PrimitiveClient.GetDateTime", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDateTimePreparer(ctx)
@@ -241,7 +257,7 @@ func (client PrimitiveClient) GetDateTime(ctx context.Context) (result DatetimeW
 	}
 
 	result, err = client.GetDateTimeResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PrimitiveClient", "GetDateTime", resp, "Failure responding to request")
 	}
 
@@ -254,14 +270,15 @@ func (client PrimitiveClient) GetDateTimePreparer(ctx context.Context) (*http.Re
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/primitive/datetime"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDateTimeSender sends the GetDateTime request. The method will close the
 // http.Response Body if it receives an error.
 func (client PrimitiveClient) GetDateTimeSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDateTimeResponder handles the response to the GetDateTime request. The method always
@@ -270,7 +287,7 @@ func (client PrimitiveClient) GetDateTimeResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -279,14 +296,16 @@ func (client PrimitiveClient) GetDateTimeResponder(resp *http.Response) (result
 
 // GetDateTimeRfc1123 get complex types with datetimeRfc1123 properties
 func (client PrimitiveClient) GetDateTimeRfc1123(ctx context.Context) (result Datetimerfc1123Wrapper, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PrimitiveClient.GetDateTimeRfc1123")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PrimitiveClient.GetDateTimeRfc1123", "GET", "/complex/primitive/datetimerfc1123")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PrimitiveClient.GetDateTimeRfc1123", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDateTimeRfc1123Preparer(ctx)
@@ -303,7 +322,7 @@ func (client PrimitiveClient) GetDateTimeRfc1123(ctx context.Context) (result Da
 	}
 
 	result, err = client.GetDateTimeRfc1123Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PrimitiveClient", "GetDateTimeRfc1123", resp, "Failure responding to request")
 	}
 
@@ -316,14 +335,15 @@ func (client PrimitiveClient) GetDateTimeRfc1123Preparer(ctx context.Context) (*
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/primitive/datetimerfc1123"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDateTimeRfc1123Sender sends the GetDateTimeRfc1123 request. The method will close the
 // http.Response Body if it receives an error.
 func (client PrimitiveClient) GetDateTimeRfc1123Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDateTimeRfc1123Responder handles the response to the GetDateTimeRfc1123 request. The method always
@@ -332,7 +352,7 @@ func (client PrimitiveClient) GetDateTimeRfc1123Responder(resp *http.Response) (
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -341,14 +361,16 @@ func (client PrimitiveClient) GetDateTimeRfc1123Responder(resp *http.Response) (
 
 // GetDouble get complex types with double properties
 func (client PrimitiveClient) GetDouble(ctx context.Context) (result DoubleWrapper, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PrimitiveClient.GetDouble")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PrimitiveClient.GetDouble", "GET", "/complex/primitive/double")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PrimitiveClient.GetDouble", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDoublePreparer(ctx)
@@ -365,7 +387,7 @@ func (client PrimitiveClient) GetDouble(ctx context.Context) (result DoubleWrapp
 	}
 
 	result, err = client.GetDoubleResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PrimitiveClient", "GetDouble", resp, "Failure responding to request")
 	}
 
@@ -378,14 +400,15 @@ func (client PrimitiveClient) GetDoublePreparer(ctx context.Context) (*http.Requ
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/primitive/double"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDoubleSender sends the GetDouble request. The method will close the
 // http.Response Body if it receives an error.
 func (client PrimitiveClient) GetDoubleSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDoubleResponder handles the response to the GetDouble request. The method always
@@ -394,7 +417,7 @@ func (client PrimitiveClient) GetDoubleResponder(resp *http.Response) (result Do
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -403,14 +426,16 @@ func (client PrimitiveClient) GetDoubleResponder(resp *http.Response) (result Do
 
 // GetDuration get complex types with duration properties
 func (client PrimitiveClient) GetDuration(ctx context.Context) (result DurationWrapper, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PrimitiveClient.GetDuration")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PrimitiveClient.GetDuration", "GET", "/complex/primitive/duration")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PrimitiveClient.GetDuration", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDurationPreparer(ctx)
@@ -427,7 +452,7 @@ func (client PrimitiveClient) GetDuration(ctx context.Context) (result DurationW
 	}
 
 	result, err = client.GetDurationResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PrimitiveClient", "GetDuration", resp, "Failure responding to request")
 	}
 
@@ -440,14 +465,15 @@ func (client PrimitiveClient) GetDurationPreparer(ctx context.Context) (*http.Re
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/primitive/duration"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDurationSender sends the GetDuration request. The method will close the
 // http.Response Body if it receives an error.
 func (client PrimitiveClient) GetDurationSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDurationResponder handles the response to the GetDuration request. The method always
@@ -456,7 +482,7 @@ func (client PrimitiveClient) GetDurationResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -465,14 +491,16 @@ func (client PrimitiveClient) GetDurationResponder(resp *http.Response) (result
 
 // GetFloat get complex types with float properties
 func (client PrimitiveClient) GetFloat(ctx context.Context) (result FloatWrapper, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PrimitiveClient.GetFloat")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PrimitiveClient.GetFloat", "GET", "/complex/primitive/float")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PrimitiveClient.GetFloat", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetFloatPreparer(ctx)
@@ -489,7 +517,7 @@ func (client PrimitiveClient) GetFloat(ctx context.Context) (result FloatWrapper
 	}
 
 	result, err = client.GetFloatResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PrimitiveClient", "GetFloat", resp, "Failure responding to request")
 	}
 
@@ -502,14 +530,15 @@ func (client PrimitiveClient) GetFloatPreparer(ctx context.Context) (*http.Reque
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/primitive/float"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetFloatSender sends the GetFloat request. The method will close the
 // http.Response Body if it receives an error.
 func (client PrimitiveClient) GetFloatSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetFloatResponder handles the response to the GetFloat request. The method always
@@ -518,7 +547,7 @@ func (client PrimitiveClient) GetFloatResponder(resp *http.Response) (result Flo
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -527,14 +556,16 @@ func (client PrimitiveClient) GetFloatResponder(resp *http.Response) (result Flo
 
 // GetInt get complex types with integer properties
 func (client PrimitiveClient) GetInt(ctx context.Context) (result IntWrapper, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PrimitiveClient.GetInt")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PrimitiveClient.GetInt", "GET", "/complex/primitive/integer")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PrimitiveClient.GetInt", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetIntPreparer(ctx)
@@ -551,7 +582,7 @@ func (client PrimitiveClient) GetInt(ctx context.Context) (result IntWrapper, er
 	}
 
 	result, err = client.GetIntResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PrimitiveClient", "GetInt", resp, "Failure responding to request")
 	}
 
@@ -564,14 +595,15 @@ func (client PrimitiveClient) GetIntPreparer(ctx context.Context) (*http.Request
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/primitive/integer"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetIntSender sends the GetInt request. The method will close the
 // http.Response Body if it receives an error.
 func (client PrimitiveClient) GetIntSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetIntResponder handles the response to the GetInt request. The method always
@@ -580,7 +612,7 @@ func (client PrimitiveClient) GetIntResponder(resp *http.Response) (result IntWr
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -589,14 +621,16 @@ func (client PrimitiveClient) GetIntResponder(resp *http.Response) (result IntWr
 
 // GetLong get complex types with long properties
 func (client PrimitiveClient) GetLong(ctx context.Context) (result LongWrapper, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PrimitiveClient.GetLong")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PrimitiveClient.GetLong", "GET", "/complex/primitive/long")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PrimitiveClient.GetLong", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetLongPreparer(ctx)
@@ -613,7 +647,7 @@ func (client PrimitiveClient) GetLong(ctx context.Context) (result LongWrapper,
 	}
 
 	result, err = client.GetLongResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PrimitiveClient", "GetLong", resp, "Failure responding to request")
 	}
 
@@ -626,14 +660,15 @@ func (client PrimitiveClient) GetLongPreparer(ctx context.Context) (*http.Reques
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/primitive/long"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetLongSender sends the GetLong request. The method will close the
 // http.Response Body if it receives an error.
 func (client PrimitiveClient) GetLongSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetLongResponder handles the response to the GetLong request. The method always
@@ -642,7 +677,7 @@ func (client PrimitiveClient) GetLongResponder(resp *http.Response) (result Long
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -651,14 +686,16 @@ func (client PrimitiveClient) GetLongResponder(resp *http.Response) (result Long
 
 // GetString get complex types with string properties
 func (client PrimitiveClient) GetString(ctx context.Context) (result StringWrapper, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PrimitiveClient.GetString")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PrimitiveClient.GetString", "GET", "/complex/primitive/string")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PrimitiveClient.GetString", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetStringPreparer(ctx)
@@ -675,7 +712,7 @@ func (client PrimitiveClient) GetString(ctx context.Context) (result StringWrapp
 	}
 
 	result, err = client.GetStringResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PrimitiveClient", "GetString", resp, "Failure responding to request")
 	}
 
@@ -688,14 +725,15 @@ func (client PrimitiveClient) GetStringPreparer(ctx context.Context) (*http.Requ
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/primitive/string"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetStringSender sends the GetString request. The method will close the
 // http.Response Body if it receives an error.
 func (client PrimitiveClient) GetStringSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetStringResponder handles the response to the GetString request. The method always
@@ -704,7 +742,7 @@ func (client PrimitiveClient) GetStringResponder(resp *http.Response) (result St
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -715,14 +753,16 @@ func (client PrimitiveClient) GetStringResponder(resp *http.Response) (result St
 // Parameters:
 // complexBody - please put true and false
 func (client PrimitiveClient) PutBool(ctx context.Context, complexBody BooleanWrapper) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PrimitiveClient.PutBool")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PrimitiveClient.PutBool", "PUT", "/complex/primitive/bool")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PrimitiveClient.PutBool", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutBoolPreparer(ctx, complexBody)
@@ -739,7 +779,7 @@ func (client PrimitiveClient) PutBool(ctx context.Context, complexBody BooleanWr
 	}
 
 	result, err = client.PutBoolResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PrimitiveClient", "PutBool", resp, "Failure responding to request")
 	}
 
@@ -754,14 +794,15 @@ func (client PrimitiveClient) PutBoolPreparer(ctx context.Context, complexBody B
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/primitive/bool"),
 		autorest.WithJSON(complexBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutBoolSender sends the PutBool request. The method will close the
 // http.Response Body if it receives an error.
 func (client PrimitiveClient) PutBoolSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutBoolResponder handles the response to the PutBool request. The method always
@@ -770,7 +811,7 @@ func (client PrimitiveClient) PutBoolResponder(resp *http.Response) (result auto
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -780,14 +821,16 @@ func (client PrimitiveClient) PutBoolResponder(resp *http.Response) (result auto
 // Parameters:
 // complexBody - please put non-ascii byte string hex(FF FE FD FC 00 FA F9 F8 F7 F6)
 func (client PrimitiveClient) PutByte(ctx context.Context, complexBody ByteWrapper) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PrimitiveClient.PutByte")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PrimitiveClient.PutByte", "PUT", "/complex/primitive/byte")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PrimitiveClient.PutByte", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutBytePreparer(ctx, complexBody)
@@ -804,7 +847,7 @@ func (client PrimitiveClient) PutByte(ctx context.Context, complexBody ByteWrapp
 	}
 
 	result, err = client.PutByteResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PrimitiveClient", "PutByte", resp, "Failure responding to request")
 	}
 
@@ -819,14 +862,15 @@ func (client PrimitiveClient) PutBytePreparer(ctx context.Context, complexBody B
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/primitive/byte"),
 		autorest.WithJSON(complexBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutByteSender sends the PutByte request. The method will close the
 // http.Response Body if it receives an error.
 func (client PrimitiveClient) PutByteSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutByteResponder handles the response to the PutByte request. The method always
@@ -835,7 +879,7 @@ func (client PrimitiveClient) PutByteResponder(resp *http.Response) (result auto
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -845,14 +889,16 @@ func (client PrimitiveClient) PutByteResponder(resp *http.Response) (result auto
 // Parameters:
 // complexBody - please put '0001-01-01' and '2016-02-29'
 func (client PrimitiveClient) PutDate(ctx context.Context, complexBody DateWrapper) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PrimitiveClient.PutDate")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PrimitiveClient.PutDate", "PUT", "/complex/primitive/date")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PrimitiveClient.PutDate", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutDatePreparer(ctx, complexBody)
@@ -869,7 +915,7 @@ func (client PrimitiveClient) PutDate(ctx context.Context, complexBody DateWrapp
 	}
 
 	result, err = client.PutDateResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PrimitiveClient", "PutDate", resp, "Failure responding to request")
 	}
 
@@ -884,14 +930,15 @@ func (client PrimitiveClient) PutDatePreparer(ctx context.Context, complexBody D
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/primitive/date"),
 		autorest.WithJSON(complexBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutDateSender sends the PutDate request. The method will close the
 // http.Response Body if it receives an error.
 func (client PrimitiveClient) PutDateSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutDateResponder handles the response to the PutDate request. The method always
@@ -900,7 +947,7 @@ func (client PrimitiveClient) PutDateResponder(resp *http.Response) (result auto
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -910,14 +957,16 @@ func (client PrimitiveClient) PutDateResponder(resp *http.Response) (result auto
 // Parameters:
 // complexBody - please put '0001-01-01T12:00:00-04:00' and '2015-05-18T11:38:00-08:00'
 func (client PrimitiveClient) PutDateTime(ctx context.Context, complexBody DatetimeWrapper) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PrimitiveClient.PutDateTime")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PrimitiveClient.PutDateTime", "PUT", "/complex/primitive/datetime")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PrimitiveClient.PutDateTime", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutDateTimePreparer(ctx, complexBody)
@@ -934,7 +983,7 @@ func (client PrimitiveClient) PutDateTime(ctx context.Context, complexBody Datet
 	}
 
 	result, err = client.PutDateTimeResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PrimitiveClient", "PutDateTime", resp, "Failure responding to request")
 	}
 
@@ -949,14 +998,15 @@ func (client PrimitiveClient) PutDateTimePreparer(ctx context.Context, complexBo
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/primitive/datetime"),
 		autorest.WithJSON(complexBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutDateTimeSender sends the PutDateTime request. The method will close the
 // http.Response Body if it receives an error.
 func (client PrimitiveClient) PutDateTimeSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutDateTimeResponder handles the response to the PutDateTime request. The method always
@@ -965,7 +1015,7 @@ func (client PrimitiveClient) PutDateTimeResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -975,14 +1025,16 @@ func (client PrimitiveClient) PutDateTimeResponder(resp *http.Response) (result
 // Parameters:
 // complexBody - please put 'Mon, 01 Jan 0001 12:00:00 GMT' and 'Mon, 18 May 2015 11:38:00 GMT'
 func (client PrimitiveClient) PutDateTimeRfc1123(ctx context.Context, complexBody Datetimerfc1123Wrapper) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PrimitiveClient.PutDateTimeRfc1123")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PrimitiveClient.PutDateTimeRfc1123", "PUT", "/complex/primitive/datetimerfc1123")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PrimitiveClient.PutDateTimeRfc1123", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutDateTimeRfc1123Preparer(ctx, complexBody)
@@ -999,7 +1051,7 @@ func (client PrimitiveClient) PutDateTimeRfc1123(ctx context.Context, complexBod
 	}
 
 	result, err = client.PutDateTimeRfc1123Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PrimitiveClient", "PutDateTimeRfc1123", resp, "Failure responding to request")
 	}
 
@@ -1014,14 +1066,15 @@ func (client PrimitiveClient) PutDateTimeRfc1123Preparer(ctx context.Context, co
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/primitive/datetimerfc1123"),
 		autorest.WithJSON(complexBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutDateTimeRfc1123Sender sends the PutDateTimeRfc1123 request. The method will close the
 // http.Response Body if it receives an error.
 func (client PrimitiveClient) PutDateTimeRfc1123Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutDateTimeRfc1123Responder handles the response to the PutDateTimeRfc1123 request. The method always
@@ -1030,7 +1083,7 @@ func (client PrimitiveClient) PutDateTimeRfc1123Responder(resp *http.Response) (
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1040,14 +1093,16 @@ func (client PrimitiveClient) PutDateTimeRfc1123Responder(resp *http.Response) (
 // Parameters:
 // complexBody - please put 3e-100 and -0.000000000000000000000000000000000000000000000000000000005
 func (client PrimitiveClient) PutDouble(ctx context.Context, complexBody DoubleWrapper) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PrimitiveClient.PutDouble")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PrimitiveClient.PutDouble", "PUT", "/complex/primitive/double")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PrimitiveClient.PutDouble", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutDoublePreparer(ctx, complexBody)
@@ -1064,7 +1119,7 @@ func (client PrimitiveClient) PutDouble(ctx context.Context, complexBody DoubleW
 	}
 
 	result, err = client.PutDoubleResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PrimitiveClient", "PutDouble", resp, "Failure responding to request")
 	}
 
@@ -1079,14 +1134,15 @@ func (client PrimitiveClient) PutDoublePreparer(ctx context.Context, complexBody
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/primitive/double"),
 		autorest.WithJSON(complexBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutDoubleSender sends the PutDouble request. The method will close the
 // http.Response Body if it receives an error.
 func (client PrimitiveClient) PutDoubleSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutDoubleResponder handles the response to the PutDouble request. The method always
@@ -1095,7 +1151,7 @@ func (client PrimitiveClient) PutDoubleResponder(resp *http.Response) (result au
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1105,14 +1161,16 @@ func (client PrimitiveClient) PutDoubleResponder(resp *http.Response) (result au
 // Parameters:
 // complexBody - please put 'P123DT22H14M12.011S'
 func (client PrimitiveClient) PutDuration(ctx context.Context, complexBody DurationWrapper) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PrimitiveClient.PutDuration")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PrimitiveClient.PutDuration", "PUT", "/complex/primitive/duration")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PrimitiveClient.PutDuration", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutDurationPreparer(ctx, complexBody)
@@ -1129,7 +1187,7 @@ func (client PrimitiveClient) PutDuration(ctx context.Context, complexBody Durat
 	}
 
 	result, err = client.PutDurationResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PrimitiveClient", "PutDuration", resp, "Failure responding to request")
 	}
 
@@ -1144,14 +1202,15 @@ func (client PrimitiveClient) PutDurationPreparer(ctx context.Context, complexBo
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/primitive/duration"),
 		autorest.WithJSON(complexBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutDurationSender sends the PutDuration request. The method will close the
 // http.Response Body if it receives an error.
 func (client PrimitiveClient) PutDurationSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutDurationResponder handles the response to the PutDuration request. The method always
@@ -1160,7 +1219,7 @@ func (client PrimitiveClient) PutDurationResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1170,14 +1229,16 @@ func (client PrimitiveClient) PutDurationResponder(resp *http.Response) (result
 // Parameters:
 // complexBody - please put 1.05 and -0.003
 func (client PrimitiveClient) PutFloat(ctx context.Context, complexBody FloatWrapper) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PrimitiveClient.PutFloat")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PrimitiveClient.PutFloat", "PUT", "/complex/primitive/float")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PrimitiveClient.PutFloat", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutFloatPreparer(ctx, complexBody)
@@ -1194,7 +1255,7 @@ func (client PrimitiveClient) PutFloat(ctx context.Context, complexBody FloatWra
 	}
 
 	result, err = client.PutFloatResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PrimitiveClient", "PutFloat", resp, "Failure responding to request")
 	}
 
@@ -1209,14 +1270,15 @@ func (client PrimitiveClient) PutFloatPreparer(ctx context.Context, complexBody
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/primitive/float"),
 		autorest.WithJSON(complexBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutFloatSender sends the PutFloat request. The method will close the
 // http.Response Body if it receives an error.
 func (client PrimitiveClient) PutFloatSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutFloatResponder handles the response to the PutFloat request. The method always
@@ -1225,7 +1287,7 @@ func (client PrimitiveClient) PutFloatResponder(resp *http.Response) (result aut
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1235,14 +1297,16 @@ func (client PrimitiveClient) PutFloatResponder(resp *http.Response) (result aut
 // Parameters:
 // complexBody - please put -1 and 2
 func (client PrimitiveClient) PutInt(ctx context.Context, complexBody IntWrapper) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PrimitiveClient.PutInt")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PrimitiveClient.PutInt", "PUT", "/complex/primitive/integer")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PrimitiveClient.PutInt", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutIntPreparer(ctx, complexBody)
@@ -1259,7 +1323,7 @@ func (client PrimitiveClient) PutInt(ctx context.Context, complexBody IntWrapper
 	}
 
 	result, err = client.PutIntResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PrimitiveClient", "PutInt", resp, "Failure responding to request")
 	}
 
@@ -1274,14 +1338,15 @@ func (client PrimitiveClient) PutIntPreparer(ctx context.Context, complexBody In
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/primitive/integer"),
 		autorest.WithJSON(complexBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutIntSender sends the PutInt request. The method will close the
 // http.Response Body if it receives an error.
 func (client PrimitiveClient) PutIntSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutIntResponder handles the response to the PutInt request. The method always
@@ -1290,7 +1355,7 @@ func (client PrimitiveClient) PutIntResponder(resp *http.Response) (result autor
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1300,14 +1365,16 @@ func (client PrimitiveClient) PutIntResponder(resp *http.Response) (result autor
 // Parameters:
 // complexBody - please put 1099511627775 and -999511627788
 func (client PrimitiveClient) PutLong(ctx context.Context, complexBody LongWrapper) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PrimitiveClient.PutLong")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PrimitiveClient.PutLong", "PUT", "/complex/primitive/long")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PrimitiveClient.PutLong", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutLongPreparer(ctx, complexBody)
@@ -1324,7 +1391,7 @@ func (client PrimitiveClient) PutLong(ctx context.Context, complexBody LongWrapp
 	}
 
 	result, err = client.PutLongResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PrimitiveClient", "PutLong", resp, "Failure responding to request")
 	}
 
@@ -1339,14 +1406,15 @@ func (client PrimitiveClient) PutLongPreparer(ctx context.Context, complexBody L
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/primitive/long"),
 		autorest.WithJSON(complexBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutLongSender sends the PutLong request. The method will close the
 // http.Response Body if it receives an error.
 func (client PrimitiveClient) PutLongSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutLongResponder handles the response to the PutLong request. The method always
@@ -1355,7 +1423,7 @@ func (client PrimitiveClient) PutLongResponder(resp *http.Response) (result auto
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1365,14 +1433,16 @@ func (client PrimitiveClient) PutLongResponder(resp *http.Response) (result auto
 // Parameters:
 // complexBody - please put 'goodrequest', '', and null
 func (client PrimitiveClient) PutString(ctx context.Context, complexBody StringWrapper) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PrimitiveClient.PutString")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PrimitiveClient.PutString", "PUT", "/complex/primitive/string")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PrimitiveClient.PutString", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutStringPreparer(ctx, complexBody)
@@ -1389,7 +1459,7 @@ func (client PrimitiveClient) PutString(ctx context.Context, complexBody StringW
 	}
 
 	result, err = client.PutStringResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "complexgroup.PrimitiveClient", "PutString", resp, "Failure responding to request")
 	}
 
@@ -1404,14 +1474,15 @@ func (client PrimitiveClient) PutStringPreparer(ctx context.Context, complexBody
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/complex/primitive/string"),
 		autorest.WithJSON(complexBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutStringSender sends the PutString request. The method will close the
 // http.Response Body if it receives an error.
 func (client PrimitiveClient) PutStringSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutStringResponder handles the response to the PutString request. The method always
@@ -1420,7 +1491,7 @@ func (client PrimitiveClient) PutStringResponder(resp *http.Response) (result au
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
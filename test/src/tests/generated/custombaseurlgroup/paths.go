@@ -9,8 +9,6 @@ package custombaseurlgroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -24,18 +22,29 @@ func NewPathsClient() PathsClient {
 	return PathsClient{New()}
 }
 
+// NewPathsClientWithOptions creates an instance of the PathsClient client with the specified options.  It returns an
+// error if the options are invalid.
+func NewPathsClientWithOptions(opts ClientOptions) (PathsClient, error) {
+	client, err := NewWithOptions(opts)
+	return PathsClient{client}, err
+}
+
 // GetEmpty get a 200 to test a valid base uri
 // Parameters:
 // accountName - account Name
 func (client PathsClient) GetEmpty(ctx context.Context, accountName string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.GetEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.GetEmpty", "GET", "/customuri")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.GetEmpty", map[string]interface{}{
+			"accountName": accountName,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetEmptyPreparer(ctx, accountName)
@@ -52,7 +61,7 @@ func (client PathsClient) GetEmpty(ctx context.Context, accountName string) (res
 	}
 
 	result, err = client.GetEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "custombaseurlgroup.PathsClient", "GetEmpty", resp, "Failure responding to request")
 	}
 
@@ -70,14 +79,15 @@ func (client PathsClient) GetEmptyPreparer(ctx context.Context, accountName stri
 		autorest.AsGet(),
 		autorest.WithCustomBaseURL("http://{accountName}{host}", urlParameters),
 		autorest.WithPath("/customuri"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetEmptySender sends the GetEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) GetEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetEmptyResponder handles the response to the GetEmpty request. The method always
@@ -86,7 +96,7 @@ func (client PathsClient) GetEmptyResponder(resp *http.Response) (result autores
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
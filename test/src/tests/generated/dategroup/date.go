@@ -9,9 +9,7 @@ package dategroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/date"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -30,16 +28,25 @@ func NewDateClientWithBaseURI(baseURI string) DateClient {
 	return DateClient{NewWithBaseURI(baseURI)}
 }
 
+// NewDateClientWithOptions creates an instance of the DateClient client with the specified options.  It returns an
+// error if the options are invalid.
+func NewDateClientWithOptions(opts ClientOptions) (DateClient, error) {
+	client, err := NewWithOptions(opts)
+	return DateClient{client}, err
+}
+
 // GetInvalidDate get invalid date value
 func (client DateClient) GetInvalidDate(ctx context.Context) (result DateModel, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DateClient.GetInvalidDate")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DateClient.GetInvalidDate", "GET", "/date/invaliddate")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DateClient.GetInvalidDate", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetInvalidDatePreparer(ctx)
@@ -56,7 +63,7 @@ func (client DateClient) GetInvalidDate(ctx context.Context) (result DateModel,
 	}
 
 	result, err = client.GetInvalidDateResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dategroup.DateClient", "GetInvalidDate", resp, "Failure responding to request")
 	}
 
@@ -69,14 +76,15 @@ func (client DateClient) GetInvalidDatePreparer(ctx context.Context) (*http.Requ
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/date/invaliddate"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetInvalidDateSender sends the GetInvalidDate request. The method will close the
 // http.Response Body if it receives an error.
 func (client DateClient) GetInvalidDateSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetInvalidDateResponder handles the response to the GetInvalidDate request. The method always
@@ -85,7 +93,7 @@ func (client DateClient) GetInvalidDateResponder(resp *http.Response) (result Da
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -94,14 +102,16 @@ func (client DateClient) GetInvalidDateResponder(resp *http.Response) (result Da
 
 // GetMaxDate get max date value 9999-12-31
 func (client DateClient) GetMaxDate(ctx context.Context) (result DateModel, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DateClient.GetMaxDate")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DateClient.GetMaxDate", "GET", "/date/max")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DateClient.GetMaxDate", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetMaxDatePreparer(ctx)
@@ -118,7 +128,7 @@ func (client DateClient) GetMaxDate(ctx context.Context) (result DateModel, err
 	}
 
 	result, err = client.GetMaxDateResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dategroup.DateClient", "GetMaxDate", resp, "Failure responding to request")
 	}
 
@@ -131,14 +141,15 @@ func (client DateClient) GetMaxDatePreparer(ctx context.Context) (*http.Request,
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/date/max"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetMaxDateSender sends the GetMaxDate request. The method will close the
 // http.Response Body if it receives an error.
 func (client DateClient) GetMaxDateSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetMaxDateResponder handles the response to the GetMaxDate request. The method always
@@ -147,7 +158,7 @@ func (client DateClient) GetMaxDateResponder(resp *http.Response) (result DateMo
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -156,14 +167,16 @@ func (client DateClient) GetMaxDateResponder(resp *http.Response) (result DateMo
 
 // GetMinDate get min date value 0000-01-01
 func (client DateClient) GetMinDate(ctx context.Context) (result DateModel, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DateClient.GetMinDate")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DateClient.GetMinDate", "GET", "/date/min")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DateClient.GetMinDate", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetMinDatePreparer(ctx)
@@ -180,7 +193,7 @@ func (client DateClient) GetMinDate(ctx context.Context) (result DateModel, err
 	}
 
 	result, err = client.GetMinDateResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dategroup.DateClient", "GetMinDate", resp, "Failure responding to request")
 	}
 
@@ -193,14 +206,15 @@ func (client DateClient) GetMinDatePreparer(ctx context.Context) (*http.Request,
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/date/min"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetMinDateSender sends the GetMinDate request. The method will close the
 // http.Response Body if it receives an error.
 func (client DateClient) GetMinDateSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetMinDateResponder handles the response to the GetMinDate request. The method always
@@ -209,7 +223,7 @@ func (client DateClient) GetMinDateResponder(resp *http.Response) (result DateMo
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -218,14 +232,16 @@ func (client DateClient) GetMinDateResponder(resp *http.Response) (result DateMo
 
 // GetNull get null date value
 func (client DateClient) GetNull(ctx context.Context) (result DateModel, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DateClient.GetNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DateClient.GetNull", "GET", "/date/null")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DateClient.GetNull", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetNullPreparer(ctx)
@@ -242,7 +258,7 @@ func (client DateClient) GetNull(ctx context.Context) (result DateModel, err err
 	}
 
 	result, err = client.GetNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dategroup.DateClient", "GetNull", resp, "Failure responding to request")
 	}
 
@@ -255,14 +271,15 @@ func (client DateClient) GetNullPreparer(ctx context.Context) (*http.Request, er
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/date/null"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetNullSender sends the GetNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client DateClient) GetNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetNullResponder handles the response to the GetNull request. The method always
@@ -271,7 +288,7 @@ func (client DateClient) GetNullResponder(resp *http.Response) (result DateModel
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -280,14 +297,16 @@ func (client DateClient) GetNullResponder(resp *http.Response) (result DateModel
 
 // GetOverflowDate get overflow date value
 func (client DateClient) GetOverflowDate(ctx context.Context) (result DateModel, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DateClient.GetOverflowDate")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DateClient.GetOverflowDate", "GET", "/date/overflowdate")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DateClient.GetOverflowDate", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetOverflowDatePreparer(ctx)
@@ -304,7 +323,7 @@ func (client DateClient) GetOverflowDate(ctx context.Context) (result DateModel,
 	}
 
 	result, err = client.GetOverflowDateResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dategroup.DateClient", "GetOverflowDate", resp, "Failure responding to request")
 	}
 
@@ -317,14 +336,15 @@ func (client DateClient) GetOverflowDatePreparer(ctx context.Context) (*http.Req
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/date/overflowdate"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetOverflowDateSender sends the GetOverflowDate request. The method will close the
 // http.Response Body if it receives an error.
 func (client DateClient) GetOverflowDateSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetOverflowDateResponder handles the response to the GetOverflowDate request. The method always
@@ -333,7 +353,7 @@ func (client DateClient) GetOverflowDateResponder(resp *http.Response) (result D
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -342,14 +362,16 @@ func (client DateClient) GetOverflowDateResponder(resp *http.Response) (result D
 
 // GetUnderflowDate get underflow date value
 func (client DateClient) GetUnderflowDate(ctx context.Context) (result DateModel, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DateClient.GetUnderflowDate")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DateClient.GetUnderflowDate", "GET", "/date/underflowdate")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DateClient.GetUnderflowDate", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetUnderflowDatePreparer(ctx)
@@ -366,7 +388,7 @@ func (client DateClient) GetUnderflowDate(ctx context.Context) (result DateModel
 	}
 
 	result, err = client.GetUnderflowDateResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dategroup.DateClient", "GetUnderflowDate", resp, "Failure responding to request")
 	}
 
@@ -379,14 +401,15 @@ func (client DateClient) GetUnderflowDatePreparer(ctx context.Context) (*http.Re
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/date/underflowdate"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetUnderflowDateSender sends the GetUnderflowDate request. The method will close the
 // http.Response Body if it receives an error.
 func (client DateClient) GetUnderflowDateSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetUnderflowDateResponder handles the response to the GetUnderflowDate request. The method always
@@ -395,7 +418,7 @@ func (client DateClient) GetUnderflowDateResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -404,14 +427,16 @@ func (client DateClient) GetUnderflowDateResponder(resp *http.Response) (result
 
 // PutMaxDate put max date value 9999-12-31
 func (client DateClient) PutMaxDate(ctx context.Context, dateBody date.Date) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DateClient.PutMaxDate")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DateClient.PutMaxDate", "PUT", "/date/max")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DateClient.PutMaxDate", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutMaxDatePreparer(ctx, dateBody)
@@ -428,7 +453,7 @@ func (client DateClient) PutMaxDate(ctx context.Context, dateBody date.Date) (re
 	}
 
 	result, err = client.PutMaxDateResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dategroup.DateClient", "PutMaxDate", resp, "Failure responding to request")
 	}
 
@@ -443,14 +468,15 @@ func (client DateClient) PutMaxDatePreparer(ctx context.Context, dateBody date.D
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/date/max"),
 		autorest.WithJSON(dateBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutMaxDateSender sends the PutMaxDate request. The method will close the
 // http.Response Body if it receives an error.
 func (client DateClient) PutMaxDateSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutMaxDateResponder handles the response to the PutMaxDate request. The method always
@@ -459,7 +485,7 @@ func (client DateClient) PutMaxDateResponder(resp *http.Response) (result autore
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -467,14 +493,16 @@ func (client DateClient) PutMaxDateResponder(resp *http.Response) (result autore
 
 // PutMinDate put min date value 0000-01-01
 func (client DateClient) PutMinDate(ctx context.Context, dateBody date.Date) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DateClient.PutMinDate")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DateClient.PutMinDate", "PUT", "/date/min")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DateClient.PutMinDate", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutMinDatePreparer(ctx, dateBody)
@@ -491,7 +519,7 @@ func (client DateClient) PutMinDate(ctx context.Context, dateBody date.Date) (re
 	}
 
 	result, err = client.PutMinDateResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dategroup.DateClient", "PutMinDate", resp, "Failure responding to request")
 	}
 
@@ -506,14 +534,15 @@ func (client DateClient) PutMinDatePreparer(ctx context.Context, dateBody date.D
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/date/min"),
 		autorest.WithJSON(dateBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutMinDateSender sends the PutMinDate request. The method will close the
 // http.Response Body if it receives an error.
 func (client DateClient) PutMinDateSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutMinDateResponder handles the response to the PutMinDate request. The method always
@@ -522,7 +551,7 @@ func (client DateClient) PutMinDateResponder(resp *http.Response) (result autore
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
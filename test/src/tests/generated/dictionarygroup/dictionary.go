@@ -9,10 +9,8 @@ package dictionarygroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/Azure/go-autorest/autorest/validation"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -31,16 +29,25 @@ func NewDictionaryClientWithBaseURI(baseURI string) DictionaryClient {
 	return DictionaryClient{NewWithBaseURI(baseURI)}
 }
 
+// NewDictionaryClientWithOptions creates an instance of the DictionaryClient client with the specified options.  It
+// returns an error if the options are invalid.
+func NewDictionaryClientWithOptions(opts ClientOptions) (DictionaryClient, error) {
+	client, err := NewWithOptions(opts)
+	return DictionaryClient{client}, err
+}
+
 // GetArrayEmpty get an empty dictionary {}
 func (client DictionaryClient) GetArrayEmpty(ctx context.Context) (result SetListString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetArrayEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetArrayEmpty", "GET", "/dictionary/array/empty")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetArrayEmpty", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetArrayEmptyPreparer(ctx)
@@ -57,7 +64,7 @@ func (client DictionaryClient) GetArrayEmpty(ctx context.Context) (result SetLis
 	}
 
 	result, err = client.GetArrayEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetArrayEmpty", resp, "Failure responding to request")
 	}
 
@@ -70,14 +77,15 @@ func (client DictionaryClient) GetArrayEmptyPreparer(ctx context.Context) (*http
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/array/empty"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetArrayEmptySender sends the GetArrayEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetArrayEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetArrayEmptyResponder handles the response to the GetArrayEmpty request. The method always
@@ -86,7 +94,7 @@ func (client DictionaryClient) GetArrayEmptyResponder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -95,14 +103,16 @@ func (client DictionaryClient) GetArrayEmptyResponder(resp *http.Response) (resu
 
 // GetArrayItemEmpty get an array of array of strings [{"0": ["1", "2", "3"], "1": [], "2": ["7", "8", "9"]}
 func (client DictionaryClient) GetArrayItemEmpty(ctx context.Context) (result SetListString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetArrayItemEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetArrayItemEmpty", "GET", "/dictionary/array/itemempty")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetArrayItemEmpty", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetArrayItemEmptyPreparer(ctx)
@@ -119,7 +129,7 @@ func (client DictionaryClient) GetArrayItemEmpty(ctx context.Context) (result Se
 	}
 
 	result, err = client.GetArrayItemEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetArrayItemEmpty", resp, "Failure responding to request")
 	}
 
@@ -132,14 +142,15 @@ func (client DictionaryClient) GetArrayItemEmptyPreparer(ctx context.Context) (*
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/array/itemempty"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetArrayItemEmptySender sends the GetArrayItemEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetArrayItemEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetArrayItemEmptyResponder handles the response to the GetArrayItemEmpty request. The method always
@@ -148,7 +159,7 @@ func (client DictionaryClient) GetArrayItemEmptyResponder(resp *http.Response) (
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -157,14 +168,16 @@ func (client DictionaryClient) GetArrayItemEmptyResponder(resp *http.Response) (
 
 // GetArrayItemNull get an dictionary of array of strings {"0": ["1", "2", "3"], "1": null, "2": ["7", "8", "9"]}
 func (client DictionaryClient) GetArrayItemNull(ctx context.Context) (result SetListString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetArrayItemNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetArrayItemNull", "GET", "/dictionary/array/itemnull")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetArrayItemNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetArrayItemNullPreparer(ctx)
@@ -181,7 +194,7 @@ func (client DictionaryClient) GetArrayItemNull(ctx context.Context) (result Set
 	}
 
 	result, err = client.GetArrayItemNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetArrayItemNull", resp, "Failure responding to request")
 	}
 
@@ -194,14 +207,15 @@ func (client DictionaryClient) GetArrayItemNullPreparer(ctx context.Context) (*h
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/array/itemnull"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetArrayItemNullSender sends the GetArrayItemNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetArrayItemNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetArrayItemNullResponder handles the response to the GetArrayItemNull request. The method always
@@ -210,7 +224,7 @@ func (client DictionaryClient) GetArrayItemNullResponder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -219,14 +233,16 @@ func (client DictionaryClient) GetArrayItemNullResponder(resp *http.Response) (r
 
 // GetArrayNull get a null array
 func (client DictionaryClient) GetArrayNull(ctx context.Context) (result SetListString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetArrayNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetArrayNull", "GET", "/dictionary/array/null")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetArrayNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetArrayNullPreparer(ctx)
@@ -243,7 +259,7 @@ func (client DictionaryClient) GetArrayNull(ctx context.Context) (result SetList
 	}
 
 	result, err = client.GetArrayNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetArrayNull", resp, "Failure responding to request")
 	}
 
@@ -256,14 +272,15 @@ func (client DictionaryClient) GetArrayNullPreparer(ctx context.Context) (*http.
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/array/null"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetArrayNullSender sends the GetArrayNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetArrayNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetArrayNullResponder handles the response to the GetArrayNull request. The method always
@@ -272,7 +289,7 @@ func (client DictionaryClient) GetArrayNullResponder(resp *http.Response) (resul
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -281,14 +298,16 @@ func (client DictionaryClient) GetArrayNullResponder(resp *http.Response) (resul
 
 // GetArrayValid get an array of array of strings {"0": ["1", "2", "3"], "1": ["4", "5", "6"], "2": ["7", "8", "9"]}
 func (client DictionaryClient) GetArrayValid(ctx context.Context) (result SetListString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetArrayValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetArrayValid", "GET", "/dictionary/array/valid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetArrayValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetArrayValidPreparer(ctx)
@@ -305,7 +324,7 @@ func (client DictionaryClient) GetArrayValid(ctx context.Context) (result SetLis
 	}
 
 	result, err = client.GetArrayValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetArrayValid", resp, "Failure responding to request")
 	}
 
@@ -318,14 +337,15 @@ func (client DictionaryClient) GetArrayValidPreparer(ctx context.Context) (*http
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/array/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetArrayValidSender sends the GetArrayValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetArrayValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetArrayValidResponder handles the response to the GetArrayValid request. The method always
@@ -334,7 +354,7 @@ func (client DictionaryClient) GetArrayValidResponder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -344,14 +364,16 @@ func (client DictionaryClient) GetArrayValidResponder(resp *http.Response) (resu
 // GetBase64URL get base64url dictionary value {"0": "a string that gets encoded with base64url", "1": "test string",
 // "2": "Lorem ipsum"}
 func (client DictionaryClient) GetBase64URL(ctx context.Context) (result SetBase64URL, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetBase64URL")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetBase64URL", "GET", "/dictionary/prim/base64url/valid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetBase64URL", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetBase64URLPreparer(ctx)
@@ -368,7 +390,7 @@ func (client DictionaryClient) GetBase64URL(ctx context.Context) (result SetBase
 	}
 
 	result, err = client.GetBase64URLResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetBase64URL", resp, "Failure responding to request")
 	}
 
@@ -381,14 +403,15 @@ func (client DictionaryClient) GetBase64URLPreparer(ctx context.Context) (*http.
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/base64url/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetBase64URLSender sends the GetBase64URL request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetBase64URLSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetBase64URLResponder handles the response to the GetBase64URL request. The method always
@@ -397,7 +420,7 @@ func (client DictionaryClient) GetBase64URLResponder(resp *http.Response) (resul
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -406,14 +429,16 @@ func (client DictionaryClient) GetBase64URLResponder(resp *http.Response) (resul
 
 // GetBooleanInvalidNull get boolean dictionary value {"0": true, "1": null, "2": false }
 func (client DictionaryClient) GetBooleanInvalidNull(ctx context.Context) (result SetBool, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetBooleanInvalidNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetBooleanInvalidNull", "GET", "/dictionary/prim/boolean/true.null.false")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetBooleanInvalidNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetBooleanInvalidNullPreparer(ctx)
@@ -430,7 +455,7 @@ func (client DictionaryClient) GetBooleanInvalidNull(ctx context.Context) (resul
 	}
 
 	result, err = client.GetBooleanInvalidNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetBooleanInvalidNull", resp, "Failure responding to request")
 	}
 
@@ -443,14 +468,15 @@ func (client DictionaryClient) GetBooleanInvalidNullPreparer(ctx context.Context
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/boolean/true.null.false"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetBooleanInvalidNullSender sends the GetBooleanInvalidNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetBooleanInvalidNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetBooleanInvalidNullResponder handles the response to the GetBooleanInvalidNull request. The method always
@@ -459,7 +485,7 @@ func (client DictionaryClient) GetBooleanInvalidNullResponder(resp *http.Respons
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -468,14 +494,16 @@ func (client DictionaryClient) GetBooleanInvalidNullResponder(resp *http.Respons
 
 // GetBooleanInvalidString get boolean dictionary value '{"0": true, "1": "boolean", "2": false}'
 func (client DictionaryClient) GetBooleanInvalidString(ctx context.Context) (result SetBool, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetBooleanInvalidString")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetBooleanInvalidString", "GET", "/dictionary/prim/boolean/true.boolean.false")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetBooleanInvalidString", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetBooleanInvalidStringPreparer(ctx)
@@ -492,7 +520,7 @@ func (client DictionaryClient) GetBooleanInvalidString(ctx context.Context) (res
 	}
 
 	result, err = client.GetBooleanInvalidStringResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetBooleanInvalidString", resp, "Failure responding to request")
 	}
 
@@ -505,14 +533,15 @@ func (client DictionaryClient) GetBooleanInvalidStringPreparer(ctx context.Conte
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/boolean/true.boolean.false"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetBooleanInvalidStringSender sends the GetBooleanInvalidString request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetBooleanInvalidStringSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetBooleanInvalidStringResponder handles the response to the GetBooleanInvalidString request. The method always
@@ -521,7 +550,7 @@ func (client DictionaryClient) GetBooleanInvalidStringResponder(resp *http.Respo
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -530,14 +559,16 @@ func (client DictionaryClient) GetBooleanInvalidStringResponder(resp *http.Respo
 
 // GetBooleanTfft get boolean dictionary value {"0": true, "1": false, "2": false, "3": true }
 func (client DictionaryClient) GetBooleanTfft(ctx context.Context) (result SetBool, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetBooleanTfft")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetBooleanTfft", "GET", "/dictionary/prim/boolean/tfft")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetBooleanTfft", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetBooleanTfftPreparer(ctx)
@@ -554,7 +585,7 @@ func (client DictionaryClient) GetBooleanTfft(ctx context.Context) (result SetBo
 	}
 
 	result, err = client.GetBooleanTfftResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetBooleanTfft", resp, "Failure responding to request")
 	}
 
@@ -567,14 +598,15 @@ func (client DictionaryClient) GetBooleanTfftPreparer(ctx context.Context) (*htt
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/boolean/tfft"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetBooleanTfftSender sends the GetBooleanTfft request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetBooleanTfftSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetBooleanTfftResponder handles the response to the GetBooleanTfft request. The method always
@@ -583,7 +615,7 @@ func (client DictionaryClient) GetBooleanTfftResponder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -592,14 +624,16 @@ func (client DictionaryClient) GetBooleanTfftResponder(resp *http.Response) (res
 
 // GetByteInvalidNull get byte dictionary value {"0": hex(FF FF FF FA), "1": null} with the first item base64 encoded
 func (client DictionaryClient) GetByteInvalidNull(ctx context.Context) (result SetByteArray, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetByteInvalidNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetByteInvalidNull", "GET", "/dictionary/prim/byte/invalidnull")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetByteInvalidNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetByteInvalidNullPreparer(ctx)
@@ -616,7 +650,7 @@ func (client DictionaryClient) GetByteInvalidNull(ctx context.Context) (result S
 	}
 
 	result, err = client.GetByteInvalidNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetByteInvalidNull", resp, "Failure responding to request")
 	}
 
@@ -629,14 +663,15 @@ func (client DictionaryClient) GetByteInvalidNullPreparer(ctx context.Context) (
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/byte/invalidnull"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetByteInvalidNullSender sends the GetByteInvalidNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetByteInvalidNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetByteInvalidNullResponder handles the response to the GetByteInvalidNull request. The method always
@@ -645,7 +680,7 @@ func (client DictionaryClient) GetByteInvalidNullResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -655,14 +690,16 @@ func (client DictionaryClient) GetByteInvalidNullResponder(resp *http.Response)
 // GetByteValid get byte dictionary value {"0": hex(FF FF FF FA), "1": hex(01 02 03), "2": hex (25, 29, 43)} with each
 // item encoded in base64
 func (client DictionaryClient) GetByteValid(ctx context.Context) (result SetByteArray, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetByteValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetByteValid", "GET", "/dictionary/prim/byte/valid")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetByteValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetByteValidPreparer(ctx)
@@ -679,7 +716,7 @@ func (client DictionaryClient) GetByteValid(ctx context.Context) (result SetByte
 	}
 
 	result, err = client.GetByteValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetByteValid", resp, "Failure responding to request")
 	}
 
@@ -692,14 +729,15 @@ func (client DictionaryClient) GetByteValidPreparer(ctx context.Context) (*http.
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/byte/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetByteValidSender sends the GetByteValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetByteValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetByteValidResponder handles the response to the GetByteValid request. The method always
@@ -708,7 +746,7 @@ func (client DictionaryClient) GetByteValidResponder(resp *http.Response) (resul
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -717,14 +755,16 @@ func (client DictionaryClient) GetByteValidResponder(resp *http.Response) (resul
 
 // GetComplexEmpty get empty dictionary of complex type {}
 func (client DictionaryClient) GetComplexEmpty(ctx context.Context) (result SetWidget, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetComplexEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetComplexEmpty", "GET", "/dictionary/complex/empty")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetComplexEmpty", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetComplexEmptyPreparer(ctx)
@@ -741,7 +781,7 @@ func (client DictionaryClient) GetComplexEmpty(ctx context.Context) (result SetW
 	}
 
 	result, err = client.GetComplexEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetComplexEmpty", resp, "Failure responding to request")
 	}
 
@@ -754,14 +794,15 @@ func (client DictionaryClient) GetComplexEmptyPreparer(ctx context.Context) (*ht
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/complex/empty"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetComplexEmptySender sends the GetComplexEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetComplexEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetComplexEmptyResponder handles the response to the GetComplexEmpty request. The method always
@@ -770,7 +811,7 @@ func (client DictionaryClient) GetComplexEmptyResponder(resp *http.Response) (re
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -780,14 +821,16 @@ func (client DictionaryClient) GetComplexEmptyResponder(resp *http.Response) (re
 // GetComplexItemEmpty get dictionary of complex type with empty item {"0": {"integer": 1, "string": "2"}, "1:" {},
 // "2": {"integer": 5, "string": "6"}}
 func (client DictionaryClient) GetComplexItemEmpty(ctx context.Context) (result SetWidget, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetComplexItemEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetComplexItemEmpty", "GET", "/dictionary/complex/itemempty")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetComplexItemEmpty", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetComplexItemEmptyPreparer(ctx)
@@ -804,7 +847,7 @@ func (client DictionaryClient) GetComplexItemEmpty(ctx context.Context) (result
 	}
 
 	result, err = client.GetComplexItemEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetComplexItemEmpty", resp, "Failure responding to request")
 	}
 
@@ -817,14 +860,15 @@ func (client DictionaryClient) GetComplexItemEmptyPreparer(ctx context.Context)
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/complex/itemempty"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetComplexItemEmptySender sends the GetComplexItemEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetComplexItemEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetComplexItemEmptyResponder handles the response to the GetComplexItemEmpty request. The method always
@@ -833,7 +877,7 @@ func (client DictionaryClient) GetComplexItemEmptyResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -843,14 +887,16 @@ func (client DictionaryClient) GetComplexItemEmptyResponder(resp *http.Response)
 // GetComplexItemNull get dictionary of complex type with null item {"0": {"integer": 1, "string": "2"}, "1": null,
 // "2": {"integer": 5, "string": "6"}}
 func (client DictionaryClient) GetComplexItemNull(ctx context.Context) (result SetWidget, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetComplexItemNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetComplexItemNull", "GET", "/dictionary/complex/itemnull")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetComplexItemNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetComplexItemNullPreparer(ctx)
@@ -867,7 +913,7 @@ func (client DictionaryClient) GetComplexItemNull(ctx context.Context) (result S
 	}
 
 	result, err = client.GetComplexItemNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetComplexItemNull", resp, "Failure responding to request")
 	}
 
@@ -880,14 +926,15 @@ func (client DictionaryClient) GetComplexItemNullPreparer(ctx context.Context) (
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/complex/itemnull"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetComplexItemNullSender sends the GetComplexItemNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetComplexItemNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetComplexItemNullResponder handles the response to the GetComplexItemNull request. The method always
@@ -896,7 +943,7 @@ func (client DictionaryClient) GetComplexItemNullResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -905,14 +952,16 @@ func (client DictionaryClient) GetComplexItemNullResponder(resp *http.Response)
 
 // GetComplexNull get dictionary of complex type null value
 func (client DictionaryClient) GetComplexNull(ctx context.Context) (result SetWidget, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetComplexNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetComplexNull", "GET", "/dictionary/complex/null")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetComplexNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetComplexNullPreparer(ctx)
@@ -929,7 +978,7 @@ func (client DictionaryClient) GetComplexNull(ctx context.Context) (result SetWi
 	}
 
 	result, err = client.GetComplexNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetComplexNull", resp, "Failure responding to request")
 	}
 
@@ -942,14 +991,15 @@ func (client DictionaryClient) GetComplexNullPreparer(ctx context.Context) (*htt
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/complex/null"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetComplexNullSender sends the GetComplexNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetComplexNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetComplexNullResponder handles the response to the GetComplexNull request. The method always
@@ -958,7 +1008,7 @@ func (client DictionaryClient) GetComplexNullResponder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -968,14 +1018,16 @@ func (client DictionaryClient) GetComplexNullResponder(resp *http.Response) (res
 // GetComplexValid get dictionary of complex type with {"0": {"integer": 1, "string": "2"}, "1": {"integer": 3,
 // "string": "4"}, "2": {"integer": 5, "string": "6"}}
 func (client DictionaryClient) GetComplexValid(ctx context.Context) (result SetWidget, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetComplexValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetComplexValid", "GET", "/dictionary/complex/valid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetComplexValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetComplexValidPreparer(ctx)
@@ -992,7 +1044,7 @@ func (client DictionaryClient) GetComplexValid(ctx context.Context) (result SetW
 	}
 
 	result, err = client.GetComplexValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetComplexValid", resp, "Failure responding to request")
 	}
 
@@ -1005,14 +1057,15 @@ func (client DictionaryClient) GetComplexValidPreparer(ctx context.Context) (*ht
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/complex/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetComplexValidSender sends the GetComplexValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetComplexValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetComplexValidResponder handles the response to the GetComplexValid request. The method always
@@ -1021,7 +1074,7 @@ func (client DictionaryClient) GetComplexValidResponder(resp *http.Response) (re
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1030,14 +1083,16 @@ func (client DictionaryClient) GetComplexValidResponder(resp *http.Response) (re
 
 // GetDateInvalidChars get date dictionary value {"0": "2011-03-22", "1": "date"}
 func (client DictionaryClient) GetDateInvalidChars(ctx context.Context) (result SetDate, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetDateInvalidChars")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetDateInvalidChars", "GET", "/dictionary/prim/date/invalidchars")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetDateInvalidChars", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDateInvalidCharsPreparer(ctx)
@@ -1054,7 +1109,7 @@ func (client DictionaryClient) GetDateInvalidChars(ctx context.Context) (result
 	}
 
 	result, err = client.GetDateInvalidCharsResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetDateInvalidChars", resp, "Failure responding to request")
 	}
 
@@ -1067,14 +1122,15 @@ func (client DictionaryClient) GetDateInvalidCharsPreparer(ctx context.Context)
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/date/invalidchars"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDateInvalidCharsSender sends the GetDateInvalidChars request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetDateInvalidCharsSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDateInvalidCharsResponder handles the response to the GetDateInvalidChars request. The method always
@@ -1083,7 +1139,7 @@ func (client DictionaryClient) GetDateInvalidCharsResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1092,14 +1148,16 @@ func (client DictionaryClient) GetDateInvalidCharsResponder(resp *http.Response)
 
 // GetDateInvalidNull get date dictionary value {"0": "2012-01-01", "1": null, "2": "1776-07-04"}
 func (client DictionaryClient) GetDateInvalidNull(ctx context.Context) (result SetDate, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetDateInvalidNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetDateInvalidNull", "GET", "/dictionary/prim/date/invalidnull")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetDateInvalidNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDateInvalidNullPreparer(ctx)
@@ -1116,7 +1174,7 @@ func (client DictionaryClient) GetDateInvalidNull(ctx context.Context) (result S
 	}
 
 	result, err = client.GetDateInvalidNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetDateInvalidNull", resp, "Failure responding to request")
 	}
 
@@ -1129,14 +1187,15 @@ func (client DictionaryClient) GetDateInvalidNullPreparer(ctx context.Context) (
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/date/invalidnull"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDateInvalidNullSender sends the GetDateInvalidNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetDateInvalidNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDateInvalidNullResponder handles the response to the GetDateInvalidNull request. The method always
@@ -1145,7 +1204,7 @@ func (client DictionaryClient) GetDateInvalidNullResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1154,14 +1213,16 @@ func (client DictionaryClient) GetDateInvalidNullResponder(resp *http.Response)
 
 // GetDateTimeInvalidChars get date dictionary value {"0": "2000-12-01t00:00:01z", "1": "date-time"}
 func (client DictionaryClient) GetDateTimeInvalidChars(ctx context.Context) (result SetDateTime, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetDateTimeInvalidChars")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetDateTimeInvalidChars", "GET", "/dictionary/prim/date-time/invalidchars")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetDateTimeInvalidChars", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDateTimeInvalidCharsPreparer(ctx)
@@ -1178,7 +1239,7 @@ func (client DictionaryClient) GetDateTimeInvalidChars(ctx context.Context) (res
 	}
 
 	result, err = client.GetDateTimeInvalidCharsResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetDateTimeInvalidChars", resp, "Failure responding to request")
 	}
 
@@ -1191,14 +1252,15 @@ func (client DictionaryClient) GetDateTimeInvalidCharsPreparer(ctx context.Conte
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/date-time/invalidchars"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDateTimeInvalidCharsSender sends the GetDateTimeInvalidChars request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetDateTimeInvalidCharsSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDateTimeInvalidCharsResponder handles the response to the GetDateTimeInvalidChars request. The method always
@@ -1207,7 +1269,7 @@ func (client DictionaryClient) GetDateTimeInvalidCharsResponder(resp *http.Respo
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1216,14 +1278,16 @@ func (client DictionaryClient) GetDateTimeInvalidCharsResponder(resp *http.Respo
 
 // GetDateTimeInvalidNull get date dictionary value {"0": "2000-12-01t00:00:01z", "1": null}
 func (client DictionaryClient) GetDateTimeInvalidNull(ctx context.Context) (result SetDateTime, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetDateTimeInvalidNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetDateTimeInvalidNull", "GET", "/dictionary/prim/date-time/invalidnull")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetDateTimeInvalidNull", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDateTimeInvalidNullPreparer(ctx)
@@ -1240,7 +1304,7 @@ func (client DictionaryClient) GetDateTimeInvalidNull(ctx context.Context) (resu
 	}
 
 	result, err = client.GetDateTimeInvalidNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetDateTimeInvalidNull", resp, "Failure responding to request")
 	}
 
@@ -1253,14 +1317,15 @@ func (client DictionaryClient) GetDateTimeInvalidNullPreparer(ctx context.Contex
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/date-time/invalidnull"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDateTimeInvalidNullSender sends the GetDateTimeInvalidNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetDateTimeInvalidNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDateTimeInvalidNullResponder handles the response to the GetDateTimeInvalidNull request. The method always
@@ -1269,7 +1334,7 @@ func (client DictionaryClient) GetDateTimeInvalidNullResponder(resp *http.Respon
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1279,14 +1344,16 @@ func (client DictionaryClient) GetDateTimeInvalidNullResponder(resp *http.Respon
 // GetDateTimeRfc1123Valid get date-time-rfc1123 dictionary value {"0": "Fri, 01 Dec 2000 00:00:01 GMT", "1": "Wed, 02
 // Jan 1980 00:11:35 GMT", "2": "Wed, 12 Oct 1492 10:15:01 GMT"}
 func (client DictionaryClient) GetDateTimeRfc1123Valid(ctx context.Context) (result SetDateTimeRfc1123, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetDateTimeRfc1123Valid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetDateTimeRfc1123Valid", "GET", "/dictionary/prim/date-time-rfc1123/valid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetDateTimeRfc1123Valid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDateTimeRfc1123ValidPreparer(ctx)
@@ -1303,7 +1370,7 @@ func (client DictionaryClient) GetDateTimeRfc1123Valid(ctx context.Context) (res
 	}
 
 	result, err = client.GetDateTimeRfc1123ValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetDateTimeRfc1123Valid", resp, "Failure responding to request")
 	}
 
@@ -1316,14 +1383,15 @@ func (client DictionaryClient) GetDateTimeRfc1123ValidPreparer(ctx context.Conte
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/date-time-rfc1123/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDateTimeRfc1123ValidSender sends the GetDateTimeRfc1123Valid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetDateTimeRfc1123ValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDateTimeRfc1123ValidResponder handles the response to the GetDateTimeRfc1123Valid request. The method always
@@ -1332,7 +1400,7 @@ func (client DictionaryClient) GetDateTimeRfc1123ValidResponder(resp *http.Respo
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1342,14 +1410,16 @@ func (client DictionaryClient) GetDateTimeRfc1123ValidResponder(resp *http.Respo
 // GetDateTimeValid get date-time dictionary value {"0": "2000-12-01t00:00:01z", "1": "1980-01-02T00:11:35+01:00", "2":
 // "1492-10-12T10:15:01-08:00"}
 func (client DictionaryClient) GetDateTimeValid(ctx context.Context) (result SetDateTime, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetDateTimeValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetDateTimeValid", "GET", "/dictionary/prim/date-time/valid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetDateTimeValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDateTimeValidPreparer(ctx)
@@ -1366,7 +1436,7 @@ func (client DictionaryClient) GetDateTimeValid(ctx context.Context) (result Set
 	}
 
 	result, err = client.GetDateTimeValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetDateTimeValid", resp, "Failure responding to request")
 	}
 
@@ -1379,14 +1449,15 @@ func (client DictionaryClient) GetDateTimeValidPreparer(ctx context.Context) (*h
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/date-time/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDateTimeValidSender sends the GetDateTimeValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetDateTimeValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDateTimeValidResponder handles the response to the GetDateTimeValid request. The method always
@@ -1395,7 +1466,7 @@ func (client DictionaryClient) GetDateTimeValidResponder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1404,14 +1475,16 @@ func (client DictionaryClient) GetDateTimeValidResponder(resp *http.Response) (r
 
 // GetDateValid get integer dictionary value {"0": "2000-12-01", "1": "1980-01-02", "2": "1492-10-12"}
 func (client DictionaryClient) GetDateValid(ctx context.Context) (result SetDate, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetDateValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetDateValid", "GET", "/dictionary/prim/date/valid")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetDateValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDateValidPreparer(ctx)
@@ -1428,7 +1501,7 @@ func (client DictionaryClient) GetDateValid(ctx context.Context) (result SetDate
 	}
 
 	result, err = client.GetDateValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetDateValid", resp, "Failure responding to request")
 	}
 
@@ -1441,14 +1514,15 @@ func (client DictionaryClient) GetDateValidPreparer(ctx context.Context) (*http.
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/date/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDateValidSender sends the GetDateValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetDateValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDateValidResponder handles the response to the GetDateValid request. The method always
@@ -1457,7 +1531,7 @@ func (client DictionaryClient) GetDateValidResponder(resp *http.Response) (resul
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1466,14 +1540,16 @@ func (client DictionaryClient) GetDateValidResponder(resp *http.Response) (resul
 
 // GetDictionaryEmpty get an dictionaries of dictionaries of type <string, string> with value {}
 func (client DictionaryClient) GetDictionaryEmpty(ctx context.Context) (result SetSetString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetDictionaryEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetDictionaryEmpty", "GET", "/dictionary/dictionary/empty")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetDictionaryEmpty", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDictionaryEmptyPreparer(ctx)
@@ -1490,7 +1566,7 @@ func (client DictionaryClient) GetDictionaryEmpty(ctx context.Context) (result S
 	}
 
 	result, err = client.GetDictionaryEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetDictionaryEmpty", resp, "Failure responding to request")
 	}
 
@@ -1503,14 +1579,15 @@ func (client DictionaryClient) GetDictionaryEmptyPreparer(ctx context.Context) (
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/dictionary/empty"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDictionaryEmptySender sends the GetDictionaryEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetDictionaryEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDictionaryEmptyResponder handles the response to the GetDictionaryEmpty request. The method always
@@ -1519,7 +1596,7 @@ func (client DictionaryClient) GetDictionaryEmptyResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1529,14 +1606,16 @@ func (client DictionaryClient) GetDictionaryEmptyResponder(resp *http.Response)
 // GetDictionaryItemEmpty get an dictionaries of dictionaries of type <string, string> with value {"0": {"1": "one",
 // "2": "two", "3": "three"}, "1": {}, "2": {"7": "seven", "8": "eight", "9": "nine"}}
 func (client DictionaryClient) GetDictionaryItemEmpty(ctx context.Context) (result SetSetString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetDictionaryItemEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetDictionaryItemEmpty", "GET", "/dictionary/dictionary/itemempty")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetDictionaryItemEmpty", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDictionaryItemEmptyPreparer(ctx)
@@ -1553,7 +1632,7 @@ func (client DictionaryClient) GetDictionaryItemEmpty(ctx context.Context) (resu
 	}
 
 	result, err = client.GetDictionaryItemEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetDictionaryItemEmpty", resp, "Failure responding to request")
 	}
 
@@ -1566,14 +1645,15 @@ func (client DictionaryClient) GetDictionaryItemEmptyPreparer(ctx context.Contex
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/dictionary/itemempty"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDictionaryItemEmptySender sends the GetDictionaryItemEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetDictionaryItemEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDictionaryItemEmptyResponder handles the response to the GetDictionaryItemEmpty request. The method always
@@ -1582,7 +1662,7 @@ func (client DictionaryClient) GetDictionaryItemEmptyResponder(resp *http.Respon
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1592,14 +1672,16 @@ func (client DictionaryClient) GetDictionaryItemEmptyResponder(resp *http.Respon
 // GetDictionaryItemNull get an dictionaries of dictionaries of type <string, string> with value {"0": {"1": "one",
 // "2": "two", "3": "three"}, "1": null, "2": {"7": "seven", "8": "eight", "9": "nine"}}
 func (client DictionaryClient) GetDictionaryItemNull(ctx context.Context) (result SetSetString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetDictionaryItemNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetDictionaryItemNull", "GET", "/dictionary/dictionary/itemnull")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetDictionaryItemNull", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDictionaryItemNullPreparer(ctx)
@@ -1616,7 +1698,7 @@ func (client DictionaryClient) GetDictionaryItemNull(ctx context.Context) (resul
 	}
 
 	result, err = client.GetDictionaryItemNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetDictionaryItemNull", resp, "Failure responding to request")
 	}
 
@@ -1629,14 +1711,15 @@ func (client DictionaryClient) GetDictionaryItemNullPreparer(ctx context.Context
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/dictionary/itemnull"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDictionaryItemNullSender sends the GetDictionaryItemNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetDictionaryItemNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDictionaryItemNullResponder handles the response to the GetDictionaryItemNull request. The method always
@@ -1645,7 +1728,7 @@ func (client DictionaryClient) GetDictionaryItemNullResponder(resp *http.Respons
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1654,14 +1737,16 @@ func (client DictionaryClient) GetDictionaryItemNullResponder(resp *http.Respons
 
 // GetDictionaryNull get an dictionaries of dictionaries with value null
 func (client DictionaryClient) GetDictionaryNull(ctx context.Context) (result SetSetString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetDictionaryNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetDictionaryNull", "GET", "/dictionary/dictionary/null")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetDictionaryNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDictionaryNullPreparer(ctx)
@@ -1678,7 +1763,7 @@ func (client DictionaryClient) GetDictionaryNull(ctx context.Context) (result Se
 	}
 
 	result, err = client.GetDictionaryNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetDictionaryNull", resp, "Failure responding to request")
 	}
 
@@ -1691,14 +1776,15 @@ func (client DictionaryClient) GetDictionaryNullPreparer(ctx context.Context) (*
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/dictionary/null"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDictionaryNullSender sends the GetDictionaryNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetDictionaryNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDictionaryNullResponder handles the response to the GetDictionaryNull request. The method always
@@ -1707,7 +1793,7 @@ func (client DictionaryClient) GetDictionaryNullResponder(resp *http.Response) (
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1717,14 +1803,16 @@ func (client DictionaryClient) GetDictionaryNullResponder(resp *http.Response) (
 // GetDictionaryValid get an dictionaries of dictionaries of type <string, string> with value {"0": {"1": "one", "2":
 // "two", "3": "three"}, "1": {"4": "four", "5": "five", "6": "six"}, "2": {"7": "seven", "8": "eight", "9": "nine"}}
 func (client DictionaryClient) GetDictionaryValid(ctx context.Context) (result SetSetString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetDictionaryValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetDictionaryValid", "GET", "/dictionary/dictionary/valid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetDictionaryValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDictionaryValidPreparer(ctx)
@@ -1741,7 +1829,7 @@ func (client DictionaryClient) GetDictionaryValid(ctx context.Context) (result S
 	}
 
 	result, err = client.GetDictionaryValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetDictionaryValid", resp, "Failure responding to request")
 	}
 
@@ -1754,14 +1842,15 @@ func (client DictionaryClient) GetDictionaryValidPreparer(ctx context.Context) (
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/dictionary/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDictionaryValidSender sends the GetDictionaryValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetDictionaryValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDictionaryValidResponder handles the response to the GetDictionaryValid request. The method always
@@ -1770,7 +1859,7 @@ func (client DictionaryClient) GetDictionaryValidResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1779,14 +1868,16 @@ func (client DictionaryClient) GetDictionaryValidResponder(resp *http.Response)
 
 // GetDoubleInvalidNull get float dictionary value {"0": 0.0, "1": null, "2": 1.2e20}
 func (client DictionaryClient) GetDoubleInvalidNull(ctx context.Context) (result SetFloat64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetDoubleInvalidNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetDoubleInvalidNull", "GET", "/dictionary/prim/double/0.0-null-1.2e20")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetDoubleInvalidNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDoubleInvalidNullPreparer(ctx)
@@ -1803,7 +1894,7 @@ func (client DictionaryClient) GetDoubleInvalidNull(ctx context.Context) (result
 	}
 
 	result, err = client.GetDoubleInvalidNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetDoubleInvalidNull", resp, "Failure responding to request")
 	}
 
@@ -1816,14 +1907,15 @@ func (client DictionaryClient) GetDoubleInvalidNullPreparer(ctx context.Context)
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/double/0.0-null-1.2e20"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDoubleInvalidNullSender sends the GetDoubleInvalidNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetDoubleInvalidNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDoubleInvalidNullResponder handles the response to the GetDoubleInvalidNull request. The method always
@@ -1832,7 +1924,7 @@ func (client DictionaryClient) GetDoubleInvalidNullResponder(resp *http.Response
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1841,14 +1933,16 @@ func (client DictionaryClient) GetDoubleInvalidNullResponder(resp *http.Response
 
 // GetDoubleInvalidString get boolean dictionary value {"0": 1.0, "1": "number", "2": 0.0}
 func (client DictionaryClient) GetDoubleInvalidString(ctx context.Context) (result SetFloat64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetDoubleInvalidString")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetDoubleInvalidString", "GET", "/dictionary/prim/double/1.number.0")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetDoubleInvalidString", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDoubleInvalidStringPreparer(ctx)
@@ -1865,7 +1959,7 @@ func (client DictionaryClient) GetDoubleInvalidString(ctx context.Context) (resu
 	}
 
 	result, err = client.GetDoubleInvalidStringResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetDoubleInvalidString", resp, "Failure responding to request")
 	}
 
@@ -1878,14 +1972,15 @@ func (client DictionaryClient) GetDoubleInvalidStringPreparer(ctx context.Contex
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/double/1.number.0"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDoubleInvalidStringSender sends the GetDoubleInvalidString request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetDoubleInvalidStringSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDoubleInvalidStringResponder handles the response to the GetDoubleInvalidString request. The method always
@@ -1894,7 +1989,7 @@ func (client DictionaryClient) GetDoubleInvalidStringResponder(resp *http.Respon
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1903,14 +1998,16 @@ func (client DictionaryClient) GetDoubleInvalidStringResponder(resp *http.Respon
 
 // GetDoubleValid get float dictionary value {"0": 0, "1": -0.01, "2": 1.2e20}
 func (client DictionaryClient) GetDoubleValid(ctx context.Context) (result SetFloat64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetDoubleValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetDoubleValid", "GET", "/dictionary/prim/double/0--0.01-1.2e20")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetDoubleValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDoubleValidPreparer(ctx)
@@ -1927,7 +2024,7 @@ func (client DictionaryClient) GetDoubleValid(ctx context.Context) (result SetFl
 	}
 
 	result, err = client.GetDoubleValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetDoubleValid", resp, "Failure responding to request")
 	}
 
@@ -1940,14 +2037,15 @@ func (client DictionaryClient) GetDoubleValidPreparer(ctx context.Context) (*htt
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/double/0--0.01-1.2e20"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDoubleValidSender sends the GetDoubleValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetDoubleValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDoubleValidResponder handles the response to the GetDoubleValid request. The method always
@@ -1956,7 +2054,7 @@ func (client DictionaryClient) GetDoubleValidResponder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1965,14 +2063,16 @@ func (client DictionaryClient) GetDoubleValidResponder(resp *http.Response) (res
 
 // GetDurationValid get duration dictionary value {"0": "P123DT22H14M12.011S", "1": "P5DT1H0M0S"}
 func (client DictionaryClient) GetDurationValid(ctx context.Context) (result SetTimeSpan, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetDurationValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetDurationValid", "GET", "/dictionary/prim/duration/valid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetDurationValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDurationValidPreparer(ctx)
@@ -1989,7 +2089,7 @@ func (client DictionaryClient) GetDurationValid(ctx context.Context) (result Set
 	}
 
 	result, err = client.GetDurationValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetDurationValid", resp, "Failure responding to request")
 	}
 
@@ -2002,14 +2102,15 @@ func (client DictionaryClient) GetDurationValidPreparer(ctx context.Context) (*h
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/duration/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDurationValidSender sends the GetDurationValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetDurationValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDurationValidResponder handles the response to the GetDurationValid request. The method always
@@ -2018,7 +2119,7 @@ func (client DictionaryClient) GetDurationValidResponder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2027,14 +2128,16 @@ func (client DictionaryClient) GetDurationValidResponder(resp *http.Response) (r
 
 // GetEmpty get empty dictionary value {}
 func (client DictionaryClient) GetEmpty(ctx context.Context) (result SetInt32, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetEmpty", "GET", "/dictionary/empty")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetEmpty", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetEmptyPreparer(ctx)
@@ -2051,7 +2154,7 @@ func (client DictionaryClient) GetEmpty(ctx context.Context) (result SetInt32, e
 	}
 
 	result, err = client.GetEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetEmpty", resp, "Failure responding to request")
 	}
 
@@ -2064,14 +2167,15 @@ func (client DictionaryClient) GetEmptyPreparer(ctx context.Context) (*http.Requ
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/empty"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetEmptySender sends the GetEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetEmptyResponder handles the response to the GetEmpty request. The method always
@@ -2080,7 +2184,7 @@ func (client DictionaryClient) GetEmptyResponder(resp *http.Response) (result Se
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2089,14 +2193,16 @@ func (client DictionaryClient) GetEmptyResponder(resp *http.Response) (result Se
 
 // GetEmptyStringKey get Dictionary with key as empty string
 func (client DictionaryClient) GetEmptyStringKey(ctx context.Context) (result SetString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetEmptyStringKey")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetEmptyStringKey", "GET", "/dictionary/keyemptystring")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetEmptyStringKey", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetEmptyStringKeyPreparer(ctx)
@@ -2113,7 +2219,7 @@ func (client DictionaryClient) GetEmptyStringKey(ctx context.Context) (result Se
 	}
 
 	result, err = client.GetEmptyStringKeyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetEmptyStringKey", resp, "Failure responding to request")
 	}
 
@@ -2126,14 +2232,15 @@ func (client DictionaryClient) GetEmptyStringKeyPreparer(ctx context.Context) (*
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/keyemptystring"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetEmptyStringKeySender sends the GetEmptyStringKey request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetEmptyStringKeySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetEmptyStringKeyResponder handles the response to the GetEmptyStringKey request. The method always
@@ -2142,7 +2249,7 @@ func (client DictionaryClient) GetEmptyStringKeyResponder(resp *http.Response) (
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2151,14 +2258,16 @@ func (client DictionaryClient) GetEmptyStringKeyResponder(resp *http.Response) (
 
 // GetFloatInvalidNull get float dictionary value {"0": 0.0, "1": null, "2": 1.2e20}
 func (client DictionaryClient) GetFloatInvalidNull(ctx context.Context) (result SetFloat64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetFloatInvalidNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetFloatInvalidNull", "GET", "/dictionary/prim/float/0.0-null-1.2e20")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetFloatInvalidNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetFloatInvalidNullPreparer(ctx)
@@ -2175,7 +2284,7 @@ func (client DictionaryClient) GetFloatInvalidNull(ctx context.Context) (result
 	}
 
 	result, err = client.GetFloatInvalidNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetFloatInvalidNull", resp, "Failure responding to request")
 	}
 
@@ -2188,14 +2297,15 @@ func (client DictionaryClient) GetFloatInvalidNullPreparer(ctx context.Context)
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/float/0.0-null-1.2e20"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetFloatInvalidNullSender sends the GetFloatInvalidNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetFloatInvalidNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetFloatInvalidNullResponder handles the response to the GetFloatInvalidNull request. The method always
@@ -2204,7 +2314,7 @@ func (client DictionaryClient) GetFloatInvalidNullResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2213,14 +2323,16 @@ func (client DictionaryClient) GetFloatInvalidNullResponder(resp *http.Response)
 
 // GetFloatInvalidString get boolean dictionary value {"0": 1.0, "1": "number", "2": 0.0}
 func (client DictionaryClient) GetFloatInvalidString(ctx context.Context) (result SetFloat64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetFloatInvalidString")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetFloatInvalidString", "GET", "/dictionary/prim/float/1.number.0")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetFloatInvalidString", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetFloatInvalidStringPreparer(ctx)
@@ -2237,7 +2349,7 @@ func (client DictionaryClient) GetFloatInvalidString(ctx context.Context) (resul
 	}
 
 	result, err = client.GetFloatInvalidStringResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetFloatInvalidString", resp, "Failure responding to request")
 	}
 
@@ -2250,14 +2362,15 @@ func (client DictionaryClient) GetFloatInvalidStringPreparer(ctx context.Context
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/float/1.number.0"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetFloatInvalidStringSender sends the GetFloatInvalidString request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetFloatInvalidStringSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetFloatInvalidStringResponder handles the response to the GetFloatInvalidString request. The method always
@@ -2266,7 +2379,7 @@ func (client DictionaryClient) GetFloatInvalidStringResponder(resp *http.Respons
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2275,14 +2388,16 @@ func (client DictionaryClient) GetFloatInvalidStringResponder(resp *http.Respons
 
 // GetFloatValid get float dictionary value {"0": 0, "1": -0.01, "2": 1.2e20}
 func (client DictionaryClient) GetFloatValid(ctx context.Context) (result SetFloat64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetFloatValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetFloatValid", "GET", "/dictionary/prim/float/0--0.01-1.2e20")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetFloatValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetFloatValidPreparer(ctx)
@@ -2299,7 +2414,7 @@ func (client DictionaryClient) GetFloatValid(ctx context.Context) (result SetFlo
 	}
 
 	result, err = client.GetFloatValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetFloatValid", resp, "Failure responding to request")
 	}
 
@@ -2312,14 +2427,15 @@ func (client DictionaryClient) GetFloatValidPreparer(ctx context.Context) (*http
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/float/0--0.01-1.2e20"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetFloatValidSender sends the GetFloatValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetFloatValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetFloatValidResponder handles the response to the GetFloatValid request. The method always
@@ -2328,7 +2444,7 @@ func (client DictionaryClient) GetFloatValidResponder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2337,14 +2453,16 @@ func (client DictionaryClient) GetFloatValidResponder(resp *http.Response) (resu
 
 // GetIntegerValid get integer dictionary value {"0": 1, "1": -1, "2": 3, "3": 300}
 func (client DictionaryClient) GetIntegerValid(ctx context.Context) (result SetInt32, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetIntegerValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetIntegerValid", "GET", "/dictionary/prim/integer/1.-1.3.300")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetIntegerValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetIntegerValidPreparer(ctx)
@@ -2361,7 +2479,7 @@ func (client DictionaryClient) GetIntegerValid(ctx context.Context) (result SetI
 	}
 
 	result, err = client.GetIntegerValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetIntegerValid", resp, "Failure responding to request")
 	}
 
@@ -2374,14 +2492,15 @@ func (client DictionaryClient) GetIntegerValidPreparer(ctx context.Context) (*ht
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/integer/1.-1.3.300"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetIntegerValidSender sends the GetIntegerValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetIntegerValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetIntegerValidResponder handles the response to the GetIntegerValid request. The method always
@@ -2390,7 +2509,7 @@ func (client DictionaryClient) GetIntegerValidResponder(resp *http.Response) (re
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2399,14 +2518,16 @@ func (client DictionaryClient) GetIntegerValidResponder(resp *http.Response) (re
 
 // GetIntInvalidNull get integer dictionary value {"0": 1, "1": null, "2": 0}
 func (client DictionaryClient) GetIntInvalidNull(ctx context.Context) (result SetInt32, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetIntInvalidNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetIntInvalidNull", "GET", "/dictionary/prim/integer/1.null.zero")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetIntInvalidNull", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetIntInvalidNullPreparer(ctx)
@@ -2423,7 +2544,7 @@ func (client DictionaryClient) GetIntInvalidNull(ctx context.Context) (result Se
 	}
 
 	result, err = client.GetIntInvalidNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetIntInvalidNull", resp, "Failure responding to request")
 	}
 
@@ -2436,14 +2557,15 @@ func (client DictionaryClient) GetIntInvalidNullPreparer(ctx context.Context) (*
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/integer/1.null.zero"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetIntInvalidNullSender sends the GetIntInvalidNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetIntInvalidNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetIntInvalidNullResponder handles the response to the GetIntInvalidNull request. The method always
@@ -2452,7 +2574,7 @@ func (client DictionaryClient) GetIntInvalidNullResponder(resp *http.Response) (
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2461,14 +2583,16 @@ func (client DictionaryClient) GetIntInvalidNullResponder(resp *http.Response) (
 
 // GetIntInvalidString get integer dictionary value {"0": 1, "1": "integer", "2": 0}
 func (client DictionaryClient) GetIntInvalidString(ctx context.Context) (result SetInt32, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetIntInvalidString")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetIntInvalidString", "GET", "/dictionary/prim/integer/1.integer.0")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetIntInvalidString", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetIntInvalidStringPreparer(ctx)
@@ -2485,7 +2609,7 @@ func (client DictionaryClient) GetIntInvalidString(ctx context.Context) (result
 	}
 
 	result, err = client.GetIntInvalidStringResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetIntInvalidString", resp, "Failure responding to request")
 	}
 
@@ -2498,14 +2622,15 @@ func (client DictionaryClient) GetIntInvalidStringPreparer(ctx context.Context)
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/integer/1.integer.0"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetIntInvalidStringSender sends the GetIntInvalidString request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetIntInvalidStringSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetIntInvalidStringResponder handles the response to the GetIntInvalidString request. The method always
@@ -2514,7 +2639,7 @@ func (client DictionaryClient) GetIntInvalidStringResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2523,14 +2648,16 @@ func (client DictionaryClient) GetIntInvalidStringResponder(resp *http.Response)
 
 // GetInvalid get invalid Dictionary value
 func (client DictionaryClient) GetInvalid(ctx context.Context) (result SetString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetInvalid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetInvalid", "GET", "/dictionary/invalid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetInvalid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetInvalidPreparer(ctx)
@@ -2547,7 +2674,7 @@ func (client DictionaryClient) GetInvalid(ctx context.Context) (result SetString
 	}
 
 	result, err = client.GetInvalidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetInvalid", resp, "Failure responding to request")
 	}
 
@@ -2560,14 +2687,15 @@ func (client DictionaryClient) GetInvalidPreparer(ctx context.Context) (*http.Re
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/invalid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetInvalidSender sends the GetInvalid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetInvalidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetInvalidResponder handles the response to the GetInvalid request. The method always
@@ -2576,7 +2704,7 @@ func (client DictionaryClient) GetInvalidResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2585,14 +2713,16 @@ func (client DictionaryClient) GetInvalidResponder(resp *http.Response) (result
 
 // GetLongInvalidNull get long dictionary value {"0": 1, "1": null, "2": 0}
 func (client DictionaryClient) GetLongInvalidNull(ctx context.Context) (result SetInt64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetLongInvalidNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetLongInvalidNull", "GET", "/dictionary/prim/long/1.null.zero")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetLongInvalidNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetLongInvalidNullPreparer(ctx)
@@ -2609,7 +2739,7 @@ func (client DictionaryClient) GetLongInvalidNull(ctx context.Context) (result S
 	}
 
 	result, err = client.GetLongInvalidNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetLongInvalidNull", resp, "Failure responding to request")
 	}
 
@@ -2622,14 +2752,15 @@ func (client DictionaryClient) GetLongInvalidNullPreparer(ctx context.Context) (
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/long/1.null.zero"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetLongInvalidNullSender sends the GetLongInvalidNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetLongInvalidNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetLongInvalidNullResponder handles the response to the GetLongInvalidNull request. The method always
@@ -2638,7 +2769,7 @@ func (client DictionaryClient) GetLongInvalidNullResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2647,14 +2778,16 @@ func (client DictionaryClient) GetLongInvalidNullResponder(resp *http.Response)
 
 // GetLongInvalidString get long dictionary value {"0": 1, "1": "integer", "2": 0}
 func (client DictionaryClient) GetLongInvalidString(ctx context.Context) (result SetInt64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetLongInvalidString")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetLongInvalidString", "GET", "/dictionary/prim/long/1.integer.0")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetLongInvalidString", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetLongInvalidStringPreparer(ctx)
@@ -2671,7 +2804,7 @@ func (client DictionaryClient) GetLongInvalidString(ctx context.Context) (result
 	}
 
 	result, err = client.GetLongInvalidStringResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetLongInvalidString", resp, "Failure responding to request")
 	}
 
@@ -2684,14 +2817,15 @@ func (client DictionaryClient) GetLongInvalidStringPreparer(ctx context.Context)
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/long/1.integer.0"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetLongInvalidStringSender sends the GetLongInvalidString request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetLongInvalidStringSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetLongInvalidStringResponder handles the response to the GetLongInvalidString request. The method always
@@ -2700,7 +2834,7 @@ func (client DictionaryClient) GetLongInvalidStringResponder(resp *http.Response
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2709,14 +2843,16 @@ func (client DictionaryClient) GetLongInvalidStringResponder(resp *http.Response
 
 // GetLongValid get integer dictionary value {"0": 1, "1": -1, "2": 3, "3": 300}
 func (client DictionaryClient) GetLongValid(ctx context.Context) (result SetInt64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetLongValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetLongValid", "GET", "/dictionary/prim/long/1.-1.3.300")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetLongValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetLongValidPreparer(ctx)
@@ -2733,7 +2869,7 @@ func (client DictionaryClient) GetLongValid(ctx context.Context) (result SetInt6
 	}
 
 	result, err = client.GetLongValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetLongValid", resp, "Failure responding to request")
 	}
 
@@ -2746,14 +2882,15 @@ func (client DictionaryClient) GetLongValidPreparer(ctx context.Context) (*http.
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/long/1.-1.3.300"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetLongValidSender sends the GetLongValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetLongValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetLongValidResponder handles the response to the GetLongValid request. The method always
@@ -2762,7 +2899,7 @@ func (client DictionaryClient) GetLongValidResponder(resp *http.Response) (resul
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2771,14 +2908,16 @@ func (client DictionaryClient) GetLongValidResponder(resp *http.Response) (resul
 
 // GetNull get null dictionary value
 func (client DictionaryClient) GetNull(ctx context.Context) (result SetInt32, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetNull", "GET", "/dictionary/null")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetNullPreparer(ctx)
@@ -2795,7 +2934,7 @@ func (client DictionaryClient) GetNull(ctx context.Context) (result SetInt32, er
 	}
 
 	result, err = client.GetNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetNull", resp, "Failure responding to request")
 	}
 
@@ -2808,14 +2947,15 @@ func (client DictionaryClient) GetNullPreparer(ctx context.Context) (*http.Reque
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/null"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetNullSender sends the GetNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetNullResponder handles the response to the GetNull request. The method always
@@ -2824,7 +2964,7 @@ func (client DictionaryClient) GetNullResponder(resp *http.Response) (result Set
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2833,14 +2973,16 @@ func (client DictionaryClient) GetNullResponder(resp *http.Response) (result Set
 
 // GetNullKey get Dictionary with null key
 func (client DictionaryClient) GetNullKey(ctx context.Context) (result SetString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetNullKey")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetNullKey", "GET", "/dictionary/nullkey")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetNullKey", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetNullKeyPreparer(ctx)
@@ -2857,7 +2999,7 @@ func (client DictionaryClient) GetNullKey(ctx context.Context) (result SetString
 	}
 
 	result, err = client.GetNullKeyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetNullKey", resp, "Failure responding to request")
 	}
 
@@ -2870,14 +3012,15 @@ func (client DictionaryClient) GetNullKeyPreparer(ctx context.Context) (*http.Re
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/nullkey"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetNullKeySender sends the GetNullKey request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetNullKeySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetNullKeyResponder handles the response to the GetNullKey request. The method always
@@ -2886,7 +3029,7 @@ func (client DictionaryClient) GetNullKeyResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2895,14 +3038,16 @@ func (client DictionaryClient) GetNullKeyResponder(resp *http.Response) (result
 
 // GetNullValue get Dictionary with null value
 func (client DictionaryClient) GetNullValue(ctx context.Context) (result SetString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetNullValue")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetNullValue", "GET", "/dictionary/nullvalue")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetNullValue", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetNullValuePreparer(ctx)
@@ -2919,7 +3064,7 @@ func (client DictionaryClient) GetNullValue(ctx context.Context) (result SetStri
 	}
 
 	result, err = client.GetNullValueResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetNullValue", resp, "Failure responding to request")
 	}
 
@@ -2932,14 +3077,15 @@ func (client DictionaryClient) GetNullValuePreparer(ctx context.Context) (*http.
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/nullvalue"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetNullValueSender sends the GetNullValue request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetNullValueSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetNullValueResponder handles the response to the GetNullValue request. The method always
@@ -2948,7 +3094,7 @@ func (client DictionaryClient) GetNullValueResponder(resp *http.Response) (resul
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2957,14 +3103,16 @@ func (client DictionaryClient) GetNullValueResponder(resp *http.Response) (resul
 
 // GetStringValid get string dictionary value {"0": "foo1", "1": "foo2", "2": "foo3"}
 func (client DictionaryClient) GetStringValid(ctx context.Context) (result SetString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetStringValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetStringValid", "GET", "/dictionary/prim/string/foo1.foo2.foo3")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetStringValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetStringValidPreparer(ctx)
@@ -2981,7 +3129,7 @@ func (client DictionaryClient) GetStringValid(ctx context.Context) (result SetSt
 	}
 
 	result, err = client.GetStringValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetStringValid", resp, "Failure responding to request")
 	}
 
@@ -2994,14 +3142,15 @@ func (client DictionaryClient) GetStringValidPreparer(ctx context.Context) (*htt
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/string/foo1.foo2.foo3"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetStringValidSender sends the GetStringValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetStringValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetStringValidResponder handles the response to the GetStringValid request. The method always
@@ -3010,7 +3159,7 @@ func (client DictionaryClient) GetStringValidResponder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -3019,14 +3168,16 @@ func (client DictionaryClient) GetStringValidResponder(resp *http.Response) (res
 
 // GetStringWithInvalid get string dictionary value {"0": "foo", "1": 123, "2": "foo2"}
 func (client DictionaryClient) GetStringWithInvalid(ctx context.Context) (result SetString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetStringWithInvalid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetStringWithInvalid", "GET", "/dictionary/prim/string/foo.123.foo2")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetStringWithInvalid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetStringWithInvalidPreparer(ctx)
@@ -3043,7 +3194,7 @@ func (client DictionaryClient) GetStringWithInvalid(ctx context.Context) (result
 	}
 
 	result, err = client.GetStringWithInvalidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetStringWithInvalid", resp, "Failure responding to request")
 	}
 
@@ -3056,14 +3207,15 @@ func (client DictionaryClient) GetStringWithInvalidPreparer(ctx context.Context)
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/string/foo.123.foo2"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetStringWithInvalidSender sends the GetStringWithInvalid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetStringWithInvalidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetStringWithInvalidResponder handles the response to the GetStringWithInvalid request. The method always
@@ -3072,7 +3224,7 @@ func (client DictionaryClient) GetStringWithInvalidResponder(resp *http.Response
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -3081,14 +3233,16 @@ func (client DictionaryClient) GetStringWithInvalidResponder(resp *http.Response
 
 // GetStringWithNull get string dictionary value {"0": "foo", "1": null, "2": "foo2"}
 func (client DictionaryClient) GetStringWithNull(ctx context.Context) (result SetString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.GetStringWithNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.GetStringWithNull", "GET", "/dictionary/prim/string/foo.null.foo2")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.GetStringWithNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetStringWithNullPreparer(ctx)
@@ -3105,7 +3259,7 @@ func (client DictionaryClient) GetStringWithNull(ctx context.Context) (result Se
 	}
 
 	result, err = client.GetStringWithNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "GetStringWithNull", resp, "Failure responding to request")
 	}
 
@@ -3118,14 +3272,15 @@ func (client DictionaryClient) GetStringWithNullPreparer(ctx context.Context) (*
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/string/foo.null.foo2"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetStringWithNullSender sends the GetStringWithNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) GetStringWithNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetStringWithNullResponder handles the response to the GetStringWithNull request. The method always
@@ -3134,7 +3289,7 @@ func (client DictionaryClient) GetStringWithNullResponder(resp *http.Response) (
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -3143,20 +3298,24 @@ func (client DictionaryClient) GetStringWithNullResponder(resp *http.Response) (
 
 // PutArrayValid put An array of array of strings {"0": ["1", "2", "3"], "1": ["4", "5", "6"], "2": ["7", "8", "9"]}
 func (client DictionaryClient) PutArrayValid(ctx context.Context, arrayBody map[string][]string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.PutArrayValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.PutArrayValid", "PUT", "/dictionary/array/valid")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.PutArrayValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("dictionarygroup.DictionaryClient", "PutArrayValid", err.Error())
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("dictionarygroup.DictionaryClient", "PutArrayValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutArrayValidPreparer(ctx, arrayBody)
@@ -3173,7 +3332,7 @@ func (client DictionaryClient) PutArrayValid(ctx context.Context, arrayBody map[
 	}
 
 	result, err = client.PutArrayValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "PutArrayValid", resp, "Failure responding to request")
 	}
 
@@ -3188,14 +3347,15 @@ func (client DictionaryClient) PutArrayValidPreparer(ctx context.Context, arrayB
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/array/valid"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutArrayValidSender sends the PutArrayValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) PutArrayValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutArrayValidResponder handles the response to the PutArrayValid request. The method always
@@ -3204,7 +3364,7 @@ func (client DictionaryClient) PutArrayValidResponder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -3212,20 +3372,24 @@ func (client DictionaryClient) PutArrayValidResponder(resp *http.Response) (resu
 
 // PutBooleanTfft set dictionary value empty {"0": true, "1": false, "2": false, "3": true }
 func (client DictionaryClient) PutBooleanTfft(ctx context.Context, arrayBody map[string]*bool) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.PutBooleanTfft")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.PutBooleanTfft", "PUT", "/dictionary/prim/boolean/tfft")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.PutBooleanTfft", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("dictionarygroup.DictionaryClient", "PutBooleanTfft", err.Error())
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("dictionarygroup.DictionaryClient", "PutBooleanTfft", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutBooleanTfftPreparer(ctx, arrayBody)
@@ -3242,7 +3406,7 @@ func (client DictionaryClient) PutBooleanTfft(ctx context.Context, arrayBody map
 	}
 
 	result, err = client.PutBooleanTfftResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "PutBooleanTfft", resp, "Failure responding to request")
 	}
 
@@ -3257,14 +3421,15 @@ func (client DictionaryClient) PutBooleanTfftPreparer(ctx context.Context, array
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/boolean/tfft"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutBooleanTfftSender sends the PutBooleanTfft request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) PutBooleanTfftSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutBooleanTfftResponder handles the response to the PutBooleanTfft request. The method always
@@ -3273,7 +3438,7 @@ func (client DictionaryClient) PutBooleanTfftResponder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -3282,20 +3447,24 @@ func (client DictionaryClient) PutBooleanTfftResponder(resp *http.Response) (res
 // PutByteValid put the dictionary value {"0": hex(FF FF FF FA), "1": hex(01 02 03), "2": hex (25, 29, 43)} with each
 // elementencoded in base 64
 func (client DictionaryClient) PutByteValid(ctx context.Context, arrayBody map[string][]byte) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.PutByteValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.PutByteValid", "PUT", "/dictionary/prim/byte/valid")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("dictionarygroup.DictionaryClient", "PutByteValid", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.PutByteValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("dictionarygroup.DictionaryClient", "PutByteValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutByteValidPreparer(ctx, arrayBody)
@@ -3312,7 +3481,7 @@ func (client DictionaryClient) PutByteValid(ctx context.Context, arrayBody map[s
 	}
 
 	result, err = client.PutByteValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "PutByteValid", resp, "Failure responding to request")
 	}
 
@@ -3327,14 +3496,15 @@ func (client DictionaryClient) PutByteValidPreparer(ctx context.Context, arrayBo
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/byte/valid"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutByteValidSender sends the PutByteValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) PutByteValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutByteValidResponder handles the response to the PutByteValid request. The method always
@@ -3343,7 +3513,7 @@ func (client DictionaryClient) PutByteValidResponder(resp *http.Response) (resul
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -3352,20 +3522,24 @@ func (client DictionaryClient) PutByteValidResponder(resp *http.Response) (resul
 // PutComplexValid put an dictionary of complex type with values {"0": {"integer": 1, "string": "2"}, "1": {"integer":
 // 3, "string": "4"}, "2": {"integer": 5, "string": "6"}}
 func (client DictionaryClient) PutComplexValid(ctx context.Context, arrayBody map[string]*Widget) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.PutComplexValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.PutComplexValid", "PUT", "/dictionary/complex/valid")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("dictionarygroup.DictionaryClient", "PutComplexValid", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.PutComplexValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("dictionarygroup.DictionaryClient", "PutComplexValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutComplexValidPreparer(ctx, arrayBody)
@@ -3382,7 +3556,7 @@ func (client DictionaryClient) PutComplexValid(ctx context.Context, arrayBody ma
 	}
 
 	result, err = client.PutComplexValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "PutComplexValid", resp, "Failure responding to request")
 	}
 
@@ -3397,14 +3571,15 @@ func (client DictionaryClient) PutComplexValidPreparer(ctx context.Context, arra
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/complex/valid"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutComplexValidSender sends the PutComplexValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) PutComplexValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutComplexValidResponder handles the response to the PutComplexValid request. The method always
@@ -3413,7 +3588,7 @@ func (client DictionaryClient) PutComplexValidResponder(resp *http.Response) (re
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -3422,20 +3597,24 @@ func (client DictionaryClient) PutComplexValidResponder(resp *http.Response) (re
 // PutDateTimeRfc1123Valid set dictionary value empty {"0": "Fri, 01 Dec 2000 00:00:01 GMT", "1": "Wed, 02 Jan 1980
 // 00:11:35 GMT", "2": "Wed, 12 Oct 1492 10:15:01 GMT"}
 func (client DictionaryClient) PutDateTimeRfc1123Valid(ctx context.Context, arrayBody map[string]*date.TimeRFC1123) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.PutDateTimeRfc1123Valid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.PutDateTimeRfc1123Valid", "PUT", "/dictionary/prim/date-time-rfc1123/valid")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("dictionarygroup.DictionaryClient", "PutDateTimeRfc1123Valid", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.PutDateTimeRfc1123Valid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("dictionarygroup.DictionaryClient", "PutDateTimeRfc1123Valid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutDateTimeRfc1123ValidPreparer(ctx, arrayBody)
@@ -3452,7 +3631,7 @@ func (client DictionaryClient) PutDateTimeRfc1123Valid(ctx context.Context, arra
 	}
 
 	result, err = client.PutDateTimeRfc1123ValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "PutDateTimeRfc1123Valid", resp, "Failure responding to request")
 	}
 
@@ -3467,14 +3646,15 @@ func (client DictionaryClient) PutDateTimeRfc1123ValidPreparer(ctx context.Conte
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/date-time-rfc1123/valid"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutDateTimeRfc1123ValidSender sends the PutDateTimeRfc1123Valid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) PutDateTimeRfc1123ValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutDateTimeRfc1123ValidResponder handles the response to the PutDateTimeRfc1123Valid request. The method always
@@ -3483,7 +3663,7 @@ func (client DictionaryClient) PutDateTimeRfc1123ValidResponder(resp *http.Respo
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -3492,20 +3672,24 @@ func (client DictionaryClient) PutDateTimeRfc1123ValidResponder(resp *http.Respo
 // PutDateTimeValid set dictionary value  {"0": "2000-12-01t00:00:01z", "1": "1980-01-02T00:11:35+01:00", "2":
 // "1492-10-12T10:15:01-08:00"}
 func (client DictionaryClient) PutDateTimeValid(ctx context.Context, arrayBody map[string]*date.Time) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.PutDateTimeValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.PutDateTimeValid", "PUT", "/dictionary/prim/date-time/valid")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("dictionarygroup.DictionaryClient", "PutDateTimeValid", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.PutDateTimeValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("dictionarygroup.DictionaryClient", "PutDateTimeValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutDateTimeValidPreparer(ctx, arrayBody)
@@ -3522,7 +3706,7 @@ func (client DictionaryClient) PutDateTimeValid(ctx context.Context, arrayBody m
 	}
 
 	result, err = client.PutDateTimeValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "PutDateTimeValid", resp, "Failure responding to request")
 	}
 
@@ -3537,14 +3721,15 @@ func (client DictionaryClient) PutDateTimeValidPreparer(ctx context.Context, arr
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/date-time/valid"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutDateTimeValidSender sends the PutDateTimeValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) PutDateTimeValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutDateTimeValidResponder handles the response to the PutDateTimeValid request. The method always
@@ -3553,7 +3738,7 @@ func (client DictionaryClient) PutDateTimeValidResponder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -3561,20 +3746,24 @@ func (client DictionaryClient) PutDateTimeValidResponder(resp *http.Response) (r
 
 // PutDateValid set dictionary value  {"0": "2000-12-01", "1": "1980-01-02", "2": "1492-10-12"}
 func (client DictionaryClient) PutDateValid(ctx context.Context, arrayBody map[string]*date.Date) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.PutDateValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.PutDateValid", "PUT", "/dictionary/prim/date/valid")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.PutDateValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("dictionarygroup.DictionaryClient", "PutDateValid", err.Error())
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("dictionarygroup.DictionaryClient", "PutDateValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutDateValidPreparer(ctx, arrayBody)
@@ -3591,7 +3780,7 @@ func (client DictionaryClient) PutDateValid(ctx context.Context, arrayBody map[s
 	}
 
 	result, err = client.PutDateValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "PutDateValid", resp, "Failure responding to request")
 	}
 
@@ -3606,14 +3795,15 @@ func (client DictionaryClient) PutDateValidPreparer(ctx context.Context, arrayBo
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/date/valid"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutDateValidSender sends the PutDateValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) PutDateValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutDateValidResponder handles the response to the PutDateValid request. The method always
@@ -3622,7 +3812,7 @@ func (client DictionaryClient) PutDateValidResponder(resp *http.Response) (resul
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -3631,20 +3821,24 @@ func (client DictionaryClient) PutDateValidResponder(resp *http.Response) (resul
 // PutDictionaryValid get an dictionaries of dictionaries of type <string, string> with value {"0": {"1": "one", "2":
 // "two", "3": "three"}, "1": {"4": "four", "5": "five", "6": "six"}, "2": {"7": "seven", "8": "eight", "9": "nine"}}
 func (client DictionaryClient) PutDictionaryValid(ctx context.Context, arrayBody map[string]map[string]*string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.PutDictionaryValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.PutDictionaryValid", "PUT", "/dictionary/dictionary/valid")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.PutDictionaryValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("dictionarygroup.DictionaryClient", "PutDictionaryValid", err.Error())
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("dictionarygroup.DictionaryClient", "PutDictionaryValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutDictionaryValidPreparer(ctx, arrayBody)
@@ -3661,7 +3855,7 @@ func (client DictionaryClient) PutDictionaryValid(ctx context.Context, arrayBody
 	}
 
 	result, err = client.PutDictionaryValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "PutDictionaryValid", resp, "Failure responding to request")
 	}
 
@@ -3676,14 +3870,15 @@ func (client DictionaryClient) PutDictionaryValidPreparer(ctx context.Context, a
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/dictionary/valid"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutDictionaryValidSender sends the PutDictionaryValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) PutDictionaryValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutDictionaryValidResponder handles the response to the PutDictionaryValid request. The method always
@@ -3692,7 +3887,7 @@ func (client DictionaryClient) PutDictionaryValidResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -3700,20 +3895,24 @@ func (client DictionaryClient) PutDictionaryValidResponder(resp *http.Response)
 
 // PutDoubleValid set dictionary value {"0": 0, "1": -0.01, "2": 1.2e20}
 func (client DictionaryClient) PutDoubleValid(ctx context.Context, arrayBody map[string]*float64) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.PutDoubleValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.PutDoubleValid", "PUT", "/dictionary/prim/double/0--0.01-1.2e20")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("dictionarygroup.DictionaryClient", "PutDoubleValid", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.PutDoubleValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("dictionarygroup.DictionaryClient", "PutDoubleValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutDoubleValidPreparer(ctx, arrayBody)
@@ -3730,7 +3929,7 @@ func (client DictionaryClient) PutDoubleValid(ctx context.Context, arrayBody map
 	}
 
 	result, err = client.PutDoubleValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "PutDoubleValid", resp, "Failure responding to request")
 	}
 
@@ -3745,14 +3944,15 @@ func (client DictionaryClient) PutDoubleValidPreparer(ctx context.Context, array
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/double/0--0.01-1.2e20"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutDoubleValidSender sends the PutDoubleValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) PutDoubleValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutDoubleValidResponder handles the response to the PutDoubleValid request. The method always
@@ -3761,7 +3961,7 @@ func (client DictionaryClient) PutDoubleValidResponder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -3769,20 +3969,24 @@ func (client DictionaryClient) PutDoubleValidResponder(resp *http.Response) (res
 
 // PutDurationValid set dictionary value  {"0": "P123DT22H14M12.011S", "1": "P5DT1H0M0S"}
 func (client DictionaryClient) PutDurationValid(ctx context.Context, arrayBody map[string]*string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.PutDurationValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.PutDurationValid", "PUT", "/dictionary/prim/duration/valid")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("dictionarygroup.DictionaryClient", "PutDurationValid", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.PutDurationValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("dictionarygroup.DictionaryClient", "PutDurationValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutDurationValidPreparer(ctx, arrayBody)
@@ -3799,7 +4003,7 @@ func (client DictionaryClient) PutDurationValid(ctx context.Context, arrayBody m
 	}
 
 	result, err = client.PutDurationValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "PutDurationValid", resp, "Failure responding to request")
 	}
 
@@ -3814,14 +4018,15 @@ func (client DictionaryClient) PutDurationValidPreparer(ctx context.Context, arr
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/duration/valid"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutDurationValidSender sends the PutDurationValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) PutDurationValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutDurationValidResponder handles the response to the PutDurationValid request. The method always
@@ -3830,7 +4035,7 @@ func (client DictionaryClient) PutDurationValidResponder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -3838,20 +4043,24 @@ func (client DictionaryClient) PutDurationValidResponder(resp *http.Response) (r
 
 // PutEmpty set dictionary value empty {}
 func (client DictionaryClient) PutEmpty(ctx context.Context, arrayBody map[string]*string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.PutEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.PutEmpty", "PUT", "/dictionary/empty")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("dictionarygroup.DictionaryClient", "PutEmpty", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.PutEmpty", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("dictionarygroup.DictionaryClient", "PutEmpty", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutEmptyPreparer(ctx, arrayBody)
@@ -3868,7 +4077,7 @@ func (client DictionaryClient) PutEmpty(ctx context.Context, arrayBody map[strin
 	}
 
 	result, err = client.PutEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "PutEmpty", resp, "Failure responding to request")
 	}
 
@@ -3883,14 +4092,15 @@ func (client DictionaryClient) PutEmptyPreparer(ctx context.Context, arrayBody m
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/empty"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutEmptySender sends the PutEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) PutEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutEmptyResponder handles the response to the PutEmpty request. The method always
@@ -3899,7 +4109,7 @@ func (client DictionaryClient) PutEmptyResponder(resp *http.Response) (result au
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -3907,20 +4117,24 @@ func (client DictionaryClient) PutEmptyResponder(resp *http.Response) (result au
 
 // PutFloatValid set dictionary value {"0": 0, "1": -0.01, "2": 1.2e20}
 func (client DictionaryClient) PutFloatValid(ctx context.Context, arrayBody map[string]*float64) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.PutFloatValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.PutFloatValid", "PUT", "/dictionary/prim/float/0--0.01-1.2e20")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.PutFloatValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("dictionarygroup.DictionaryClient", "PutFloatValid", err.Error())
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("dictionarygroup.DictionaryClient", "PutFloatValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutFloatValidPreparer(ctx, arrayBody)
@@ -3937,7 +4151,7 @@ func (client DictionaryClient) PutFloatValid(ctx context.Context, arrayBody map[
 	}
 
 	result, err = client.PutFloatValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "PutFloatValid", resp, "Failure responding to request")
 	}
 
@@ -3952,14 +4166,15 @@ func (client DictionaryClient) PutFloatValidPreparer(ctx context.Context, arrayB
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/float/0--0.01-1.2e20"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutFloatValidSender sends the PutFloatValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) PutFloatValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutFloatValidResponder handles the response to the PutFloatValid request. The method always
@@ -3968,7 +4183,7 @@ func (client DictionaryClient) PutFloatValidResponder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -3976,20 +4191,24 @@ func (client DictionaryClient) PutFloatValidResponder(resp *http.Response) (resu
 
 // PutIntegerValid set dictionary value empty {"0": 1, "1": -1, "2": 3, "3": 300}
 func (client DictionaryClient) PutIntegerValid(ctx context.Context, arrayBody map[string]*int32) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.PutIntegerValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.PutIntegerValid", "PUT", "/dictionary/prim/integer/1.-1.3.300")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.PutIntegerValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("dictionarygroup.DictionaryClient", "PutIntegerValid", err.Error())
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("dictionarygroup.DictionaryClient", "PutIntegerValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutIntegerValidPreparer(ctx, arrayBody)
@@ -4006,7 +4225,7 @@ func (client DictionaryClient) PutIntegerValid(ctx context.Context, arrayBody ma
 	}
 
 	result, err = client.PutIntegerValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "PutIntegerValid", resp, "Failure responding to request")
 	}
 
@@ -4021,14 +4240,15 @@ func (client DictionaryClient) PutIntegerValidPreparer(ctx context.Context, arra
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/integer/1.-1.3.300"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutIntegerValidSender sends the PutIntegerValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) PutIntegerValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutIntegerValidResponder handles the response to the PutIntegerValid request. The method always
@@ -4037,7 +4257,7 @@ func (client DictionaryClient) PutIntegerValidResponder(resp *http.Response) (re
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -4045,20 +4265,24 @@ func (client DictionaryClient) PutIntegerValidResponder(resp *http.Response) (re
 
 // PutLongValid set dictionary value empty {"0": 1, "1": -1, "2": 3, "3": 300}
 func (client DictionaryClient) PutLongValid(ctx context.Context, arrayBody map[string]*int64) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.PutLongValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.PutLongValid", "PUT", "/dictionary/prim/long/1.-1.3.300")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("dictionarygroup.DictionaryClient", "PutLongValid", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.PutLongValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("dictionarygroup.DictionaryClient", "PutLongValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutLongValidPreparer(ctx, arrayBody)
@@ -4075,7 +4299,7 @@ func (client DictionaryClient) PutLongValid(ctx context.Context, arrayBody map[s
 	}
 
 	result, err = client.PutLongValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "PutLongValid", resp, "Failure responding to request")
 	}
 
@@ -4090,14 +4314,15 @@ func (client DictionaryClient) PutLongValidPreparer(ctx context.Context, arrayBo
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/long/1.-1.3.300"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutLongValidSender sends the PutLongValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) PutLongValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutLongValidResponder handles the response to the PutLongValid request. The method always
@@ -4106,7 +4331,7 @@ func (client DictionaryClient) PutLongValidResponder(resp *http.Response) (resul
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -4114,20 +4339,24 @@ func (client DictionaryClient) PutLongValidResponder(resp *http.Response) (resul
 
 // PutStringValid set dictionary value {"0": "foo1", "1": "foo2", "2": "foo3"}
 func (client DictionaryClient) PutStringValid(ctx context.Context, arrayBody map[string]*string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/DictionaryClient.PutStringValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/DictionaryClient.PutStringValid", "PUT", "/dictionary/prim/string/foo1.foo2.foo3")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "DictionaryClient.PutStringValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayBody,
-			Constraints: []validation.Constraint{{Target: "arrayBody", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("dictionarygroup.DictionaryClient", "PutStringValid", err.Error())
+	var errs ValidationErrors
+	if arrayBody == nil {
+		errs = append(errs, ValidationError{Path: "arrayBody", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("dictionarygroup.DictionaryClient", "PutStringValid", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutStringValidPreparer(ctx, arrayBody)
@@ -4144,7 +4373,7 @@ func (client DictionaryClient) PutStringValid(ctx context.Context, arrayBody map
 	}
 
 	result, err = client.PutStringValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "dictionarygroup.DictionaryClient", "PutStringValid", resp, "Failure responding to request")
 	}
 
@@ -4159,14 +4388,15 @@ func (client DictionaryClient) PutStringValidPreparer(ctx context.Context, array
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/dictionary/prim/string/foo1.foo2.foo3"),
 		autorest.WithJSON(arrayBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutStringValidSender sends the PutStringValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client DictionaryClient) PutStringValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutStringValidResponder handles the response to the PutStringValid request. The method always
@@ -4175,7 +4405,7 @@ func (client DictionaryClient) PutStringValidResponder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -13,9 +13,9 @@ import (
 
 // FilesClientAPI contains the set of methods on the FilesClient type.
 type FilesClientAPI interface {
-	GetEmptyFile(ctx context.Context) (result filegroup.ReadCloser, err error)
-	GetFile(ctx context.Context) (result filegroup.ReadCloser, err error)
-	GetFileLarge(ctx context.Context) (result filegroup.ReadCloser, err error)
+	GetEmptyFile(ctx context.Context) (result filegroup.DownloadResult, err error)
+	GetFile(ctx context.Context) (result filegroup.DownloadResult, err error)
+	GetFileLarge(ctx context.Context, rangeParameter string) (result filegroup.DownloadResult, err error)
 }
 
 var _ FilesClientAPI = (*filegroup.FilesClient)(nil)
@@ -9,8 +9,6 @@ package filegroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -29,16 +27,25 @@ func NewFilesClientWithBaseURI(baseURI string) FilesClient {
 	return FilesClient{NewWithBaseURI(baseURI)}
 }
 
+// NewFilesClientWithOptions creates an instance of the FilesClient client with the specified options.  It returns an
+// error if the options are invalid.
+func NewFilesClientWithOptions(opts ClientOptions) (FilesClient, error) {
+	client, err := NewWithOptions(opts)
+	return FilesClient{client}, err
+}
+
 // GetEmptyFile get empty file
-func (client FilesClient) GetEmptyFile(ctx context.Context) (result ReadCloser, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/FilesClient.GetEmptyFile")
+func (client FilesClient) GetEmptyFile(ctx context.Context) (result DownloadResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/FilesClient.GetEmptyFile", "GET", "/files/stream/empty")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "FilesClient.GetEmptyFile", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetEmptyFilePreparer(ctx)
@@ -55,7 +62,7 @@ func (client FilesClient) GetEmptyFile(ctx context.Context) (result ReadCloser,
 	}
 
 	result, err = client.GetEmptyFileResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "filegroup.FilesClient", "GetEmptyFile", resp, "Failure responding to request")
 	}
 
@@ -68,38 +75,41 @@ func (client FilesClient) GetEmptyFilePreparer(ctx context.Context) (*http.Reque
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/files/stream/empty"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetEmptyFileSender sends the GetEmptyFile request. The method will close the
 // http.Response Body if it receives an error.
 func (client FilesClient) GetEmptyFileSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetEmptyFileResponder handles the response to the GetEmptyFile request. The method always
 // closes the http.Response Body.
-func (client FilesClient) GetEmptyFileResponder(resp *http.Response) (result ReadCloser, err error) {
-	result.Value = &resp.Body
+func (client FilesClient) GetEmptyFileResponder(resp *http.Response) (result DownloadResult, err error) {
+	result.readResponse(resp)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK))
+		withErrorResponseUnlessStatusCode(http.StatusOK))
 	result.Response = autorest.Response{Response: resp}
 	return
 }
 
 // GetFile get file
-func (client FilesClient) GetFile(ctx context.Context) (result ReadCloser, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/FilesClient.GetFile")
+func (client FilesClient) GetFile(ctx context.Context) (result DownloadResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/FilesClient.GetFile", "GET", "/files/stream/nonempty")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "FilesClient.GetFile", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetFilePreparer(ctx)
@@ -116,7 +126,7 @@ func (client FilesClient) GetFile(ctx context.Context) (result ReadCloser, err e
 	}
 
 	result, err = client.GetFileResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "filegroup.FilesClient", "GetFile", resp, "Failure responding to request")
 	}
 
@@ -129,41 +139,48 @@ func (client FilesClient) GetFilePreparer(ctx context.Context) (*http.Request, e
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/files/stream/nonempty"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetFileSender sends the GetFile request. The method will close the
 // http.Response Body if it receives an error.
 func (client FilesClient) GetFileSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetFileResponder handles the response to the GetFile request. The method always
 // closes the http.Response Body.
-func (client FilesClient) GetFileResponder(resp *http.Response) (result ReadCloser, err error) {
-	result.Value = &resp.Body
+func (client FilesClient) GetFileResponder(resp *http.Response) (result DownloadResult, err error) {
+	result.readResponse(resp)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK))
+		withErrorResponseUnlessStatusCode(http.StatusOK))
 	result.Response = autorest.Response{Response: resp}
 	return
 }
 
 // GetFileLarge get a large file
-func (client FilesClient) GetFileLarge(ctx context.Context) (result ReadCloser, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/FilesClient.GetFileLarge")
+// Parameters:
+// rangeParameter - the byte range of the file to get, e.g. bytes=0-1023.
+func (client FilesClient) GetFileLarge(ctx context.Context, rangeParameter string) (result DownloadResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/FilesClient.GetFileLarge", "GET", "/files/stream/verylarge")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "FilesClient.GetFileLarge", map[string]interface{}{
+			"Range": rangeParameter,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
-	req, err := client.GetFileLargePreparer(ctx)
+	req, err := client.GetFileLargePreparer(ctx, rangeParameter)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "filegroup.FilesClient", "GetFileLarge", nil, "Failure preparing request")
 		return
@@ -177,7 +194,7 @@ func (client FilesClient) GetFileLarge(ctx context.Context) (result ReadCloser,
 	}
 
 	result, err = client.GetFileLargeResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "filegroup.FilesClient", "GetFileLarge", resp, "Failure responding to request")
 	}
 
@@ -185,29 +202,43 @@ func (client FilesClient) GetFileLarge(ctx context.Context) (result ReadCloser,
 }
 
 // GetFileLargePreparer prepares the GetFileLarge request.
-func (client FilesClient) GetFileLargePreparer(ctx context.Context) (*http.Request, error) {
+func (client FilesClient) GetFileLargePreparer(ctx context.Context, rangeParameter string) (*http.Request, error) {
 	preparer := autorest.CreatePreparer(
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/files/stream/verylarge"))
+	if len(rangeParameter) > 0 {
+		preparer = autorest.DecoratePreparer(preparer,
+			autorest.WithHeader("Range", autorest.String(rangeParameter)))
+	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetFileLargeSender sends the GetFileLarge request. The method will close the
 // http.Response Body if it receives an error.
 func (client FilesClient) GetFileLargeSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetFileLargeResponder handles the response to the GetFileLarge request. The method always
 // closes the http.Response Body.
-func (client FilesClient) GetFileLargeResponder(resp *http.Response) (result ReadCloser, err error) {
-	result.Value = &resp.Body
+func (client FilesClient) GetFileLargeResponder(resp *http.Response) (result DownloadResult, err error) {
+	result.readResponse(resp)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK))
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusPartialContent))
 	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		result.resume = func(req *http.Request) (DownloadResult, error) {
+			resp, err := client.GetFileLargeSender(req)
+			if err != nil {
+				return DownloadResult{Response: autorest.Response{Response: resp}}, err
+			}
+			return client.GetFileLargeResponder(resp)
+		}
+	}
 	return
 }
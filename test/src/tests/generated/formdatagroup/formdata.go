@@ -9,8 +9,6 @@ package formdatagroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"io"
 	"net/http"
 )
@@ -30,19 +28,28 @@ func NewFormdataClientWithBaseURI(baseURI string) FormdataClient {
 	return FormdataClient{NewWithBaseURI(baseURI)}
 }
 
+// NewFormdataClientWithOptions creates an instance of the FormdataClient client with the specified options.  It returns
+// an error if the options are invalid.
+func NewFormdataClientWithOptions(opts ClientOptions) (FormdataClient, error) {
+	client, err := NewWithOptions(opts)
+	return FormdataClient{client}, err
+}
+
 // UploadFile upload file
 // Parameters:
 // fileContent - file to upload.
 // fileName - file name to upload. Name has to be spelled exactly as written here.
-func (client FormdataClient) UploadFile(ctx context.Context, fileContent io.ReadCloser, fileName string) (result ReadCloser, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/FormdataClient.UploadFile")
+func (client FormdataClient) UploadFile(ctx context.Context, fileContent io.ReadCloser, fileName string) (result DownloadResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/FormdataClient.UploadFile", "POST", "/formdata/stream/uploadfile")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "FormdataClient.UploadFile", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.UploadFilePreparer(ctx, fileContent, fileName)
@@ -59,7 +66,7 @@ func (client FormdataClient) UploadFile(ctx context.Context, fileContent io.Read
 	}
 
 	result, err = client.UploadFileResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "formdatagroup.FormdataClient", "UploadFile", resp, "Failure responding to request")
 	}
 
@@ -77,25 +84,26 @@ func (client FormdataClient) UploadFilePreparer(ctx context.Context, fileContent
 		autorest.AsPost(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/formdata/stream/uploadfile"),
-		autorest.WithMultiPartFormData(formDataParameters))
+		withMultipartFormData(formDataParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // UploadFileSender sends the UploadFile request. The method will close the
 // http.Response Body if it receives an error.
 func (client FormdataClient) UploadFileSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // UploadFileResponder handles the response to the UploadFile request. The method always
 // closes the http.Response Body.
-func (client FormdataClient) UploadFileResponder(resp *http.Response) (result ReadCloser, err error) {
-	result.Value = &resp.Body
+func (client FormdataClient) UploadFileResponder(resp *http.Response) (result DownloadResult, err error) {
+	result.readResponse(resp)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK))
+		withErrorResponseUnlessStatusCode(http.StatusOK))
 	result.Response = autorest.Response{Response: resp}
 	return
 }
@@ -103,15 +111,17 @@ func (client FormdataClient) UploadFileResponder(resp *http.Response) (result Re
 // UploadFileViaBody upload file
 // Parameters:
 // fileContent - file to upload.
-func (client FormdataClient) UploadFileViaBody(ctx context.Context, fileContent io.ReadCloser) (result ReadCloser, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/FormdataClient.UploadFileViaBody")
+func (client FormdataClient) UploadFileViaBody(ctx context.Context, fileContent io.ReadCloser) (result DownloadResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/FormdataClient.UploadFileViaBody", "PUT", "/formdata/stream/uploadfile")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "FormdataClient.UploadFileViaBody", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.UploadFileViaBodyPreparer(ctx, fileContent)
@@ -128,7 +138,7 @@ func (client FormdataClient) UploadFileViaBody(ctx context.Context, fileContent
 	}
 
 	result, err = client.UploadFileViaBodyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "formdatagroup.FormdataClient", "UploadFileViaBody", resp, "Failure responding to request")
 	}
 
@@ -143,24 +153,25 @@ func (client FormdataClient) UploadFileViaBodyPreparer(ctx context.Context, file
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/formdata/stream/uploadfile"),
 		autorest.WithFile(fileContent))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // UploadFileViaBodySender sends the UploadFileViaBody request. The method will close the
 // http.Response Body if it receives an error.
 func (client FormdataClient) UploadFileViaBodySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // UploadFileViaBodyResponder handles the response to the UploadFileViaBody request. The method always
 // closes the http.Response Body.
-func (client FormdataClient) UploadFileViaBodyResponder(resp *http.Response) (result ReadCloser, err error) {
-	result.Value = &resp.Body
+func (client FormdataClient) UploadFileViaBodyResponder(resp *http.Response) (result DownloadResult, err error) {
+	result.readResponse(resp)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK))
+		withErrorResponseUnlessStatusCode(http.StatusOK))
 	result.Response = autorest.Response{Response: resp}
 	return
 }
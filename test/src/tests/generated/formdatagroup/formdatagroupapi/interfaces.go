@@ -14,8 +14,8 @@ import (
 
 // FormdataClientAPI contains the set of methods on the FormdataClient type.
 type FormdataClientAPI interface {
-	UploadFile(ctx context.Context, fileContent io.ReadCloser, fileName string) (result formdatagroup.ReadCloser, err error)
-	UploadFileViaBody(ctx context.Context, fileContent io.ReadCloser) (result formdatagroup.ReadCloser, err error)
+	UploadFile(ctx context.Context, fileContent io.ReadCloser, fileName string) (result formdatagroup.DownloadResult, err error)
+	UploadFileViaBody(ctx context.Context, fileContent io.ReadCloser) (result formdatagroup.DownloadResult, err error)
 }
 
 var _ FormdataClientAPI = (*formdatagroup.FormdataClient)(nil)
@@ -7,21 +7,879 @@ package formdatagroup
 // Changes may cause incorrect behavior and will be lost if the code is regenerated.
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
 	"github.com/Azure/go-autorest/autorest"
 	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
 // The package's fully qualified name.
 const fqdn = "tests/generated/formdatagroup"
 
+// DownloadResult contains the body of a response returning binary content along with its content headers.
+type DownloadResult struct {
+	autorest.Response `json:"-"`
+	// Body - the content.  It must be closed unless it's consumed by WriteTo or DownloadToFile.
+	Body io.ReadCloser `json:"-"`
+	// ContentLength - the length of the content in bytes, or -1 if it isn't known.
+	ContentLength int64 `json:"-"`
+	// ContentType - the media type of the content.
+	ContentType string `json:"-"`
+	// ETag - the entity tag of the content.
+	ETag string `json:"-"`
+	// LastModified - the time the content was last modified, or the zero time if it isn't known.
+	LastModified time.Time `json:"-"`
+	// Progress - if not nil, called by WriteTo and DownloadToFile as the content is written with the number of bytes written so far and the length of the content, or -1 if it isn't known.
+	Progress func(written, total int64) `json:"-"`
+	resume   func(req *http.Request) (DownloadResult, error)
+}
+
+// downloadResumeAttempts is the number of times an interrupted download is resumed before giving up.
+const downloadResumeAttempts = 3
+
+// readResponse populates the body and the content headers from resp.
+func (dr *DownloadResult) readResponse(resp *http.Response) {
+	dr.Body = resp.Body
+	dr.ContentLength = resp.ContentLength
+	dr.ContentType = resp.Header.Get("Content-Type")
+	dr.ETag = resp.Header.Get("ETag")
+	if t, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		dr.LastModified = t
+	}
+}
+
+// WriteTo writes the content to w and closes the body.  If the operation supports range requests an interrupted
+// download is resumed from where it stopped.  It returns the number of bytes written.
+func (dr DownloadResult) WriteTo(w io.Writer) (int64, error) {
+	ctx := context.Background()
+	if dr.Response.Response != nil && dr.Response.Request != nil {
+		ctx = dr.Response.Request.Context()
+	}
+	return dr.writeTo(ctx, w)
+}
+
+// DownloadToFile writes the content to the file at path, creating or truncating it, and closes the body.  If the
+// operation supports range requests an interrupted download is resumed from where it stopped.  The file is removed
+// if the download fails.  It returns the number of bytes written.
+func (dr DownloadResult) DownloadToFile(ctx context.Context, path string) (int64, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		dr.Body.Close()
+		return 0, err
+	}
+	written, err := dr.writeTo(ctx, f)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(path)
+	}
+	return written, err
+}
+
+func (dr DownloadResult) writeTo(ctx context.Context, w io.Writer) (int64, error) {
+	body := dr.Body
+	defer func() {
+		body.Close()
+	}()
+	var written int64
+	buf := make([]byte, 32*1024)
+	for resumes := 0; ; {
+		if err := ctx.Err(); err != nil {
+			return written, err
+		}
+		n, rerr := body.Read(buf)
+		if n > 0 {
+			m, werr := w.Write(buf[:n])
+			written += int64(m)
+			if werr == nil && m < n {
+				werr = io.ErrShortWrite
+			}
+			if werr != nil {
+				return written, werr
+			}
+			if dr.Progress != nil {
+				dr.Progress(written, dr.ContentLength)
+			}
+		}
+		if rerr == io.EOF {
+			return written, nil
+		} else if rerr != nil {
+			if dr.resume == nil || resumes == downloadResumeAttempts {
+				return written, rerr
+			}
+			resumes++
+			body.Close()
+			next, err := dr.resumeAt(ctx, written)
+			if err != nil {
+				return written, err
+			}
+			body = next.Body
+		}
+	}
+}
+
+// resumeAt requests the content starting at offset, relative to the start of the range requested by the original
+// request if any.  It fails if the content has changed since the download started.
+func (dr DownloadResult) resumeAt(ctx context.Context, offset int64) (DownloadResult, error) {
+	req := dr.Response.Request.Clone(ctx)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return DownloadResult{}, err
+		}
+		req.Body = body
+	}
+	first, last := int64(0), int64(-1)
+	if h := req.Header.Get("Range"); h != "" {
+		var ok bool
+		if first, last, ok = parseByteRange(h); !ok {
+			return DownloadResult{}, fmt.Errorf("the download can't be resumed at offset %d: unsupported range %q", offset, h)
+		}
+	}
+	start := first + offset
+	if last >= 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", start, last))
+	} else {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", start))
+	}
+	if dr.ETag != "" {
+		req.Header.Set("If-Range", dr.ETag)
+	}
+	next, err := dr.resume(req)
+	if err != nil {
+		return next, err
+	}
+	if next.StatusCode != http.StatusPartialContent ||
+		!strings.HasPrefix(next.Header.Get("Content-Range"), fmt.Sprintf("bytes %d-", start)) ||
+		(dr.ETag != "" && next.ETag != dr.ETag) {
+		next.Body.Close()
+		return next, fmt.Errorf("the download can't be resumed at offset %d: the content has changed", offset)
+	}
+	return next, nil
+}
+
+// parseByteRange returns the first and last positions of the single byte range in the Range header value h, e.g.
+// "bytes=100-199".  last is -1 if the range is open-ended.  ok is false if h isn't a single range with a first position.
+func parseByteRange(h string) (first, last int64, ok bool) {
+	spec := strings.TrimPrefix(h, "bytes=")
+	i := strings.Index(spec, "-")
+	if spec == h || i < 1 || strings.Contains(spec, ",") {
+		return 0, -1, false
+	}
+	first, err := strconv.ParseInt(strings.TrimSpace(spec[:i]), 10, 64)
+	if err != nil {
+		return 0, -1, false
+	}
+	last = -1
+	if end := strings.TrimSpace(spec[i+1:]); end != "" {
+		if last, err = strconv.ParseInt(end, 10, 64); err != nil || last < first {
+			return 0, -1, false
+		}
+	}
+	return first, last, true
+}
+
 // Error ...
 type Error struct {
 	Status  *int32  `json:"status,omitempty"`
 	Message *string `json:"message,omitempty"`
 }
 
-// ReadCloser ...
-type ReadCloser struct {
-	autorest.Response `json:"-"`
-	Value             *io.ReadCloser `json:"value,omitempty"`
+// DeepCopy returns a copy of the Error that doesn't share any pointers, slices or maps with it.
+func (e Error) DeepCopy() Error {
+	result := e
+	if e.Status != nil {
+		v := *e.Status
+		result.Status = &v
+	}
+	if e.Message != nil {
+		v := *e.Message
+		result.Message = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Error and other are equal.  Times are equal if they represent the same
+// instant.
+func (e Error) Equal(other Error) bool {
+	return e.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (e Error) EqualIgnoreReadOnly(other Error) bool {
+	return e.equal(other, true)
+}
+
+// equal compares the fields of the Error and other, skipping the read-only ones if ignoreReadOnly is true.
+func (e Error) equal(other Error, ignoreReadOnly bool) bool {
+	if (e.Status == nil) != (other.Status == nil) || (e.Status != nil && *e.Status != *other.Status) {
+		return false
+	}
+	if (e.Message == nil) != (other.Message == nil) || (e.Message != nil && *e.Message != *other.Message) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Error to copies of the fields set in patch.  Nested models and maps are merged, the
+// values of the other fields are replaced.  The read-only fields aren't changed.
+func (e *Error) MergeFrom(patch Error) {
+	if patch.Status != nil {
+		v := *patch.Status
+		e.Status = &v
+	}
+	if patch.Message != nil {
+		v := *patch.Message
+		e.Message = &v
+	}
+}
+
+// ErrorResponse is returned when an operation fails with a response containing the Error model.  Unlike the other
+// errors of the operations it isn't wrapped in an autorest.DetailedError.
+type ErrorResponse struct {
+	// Model - the error model unmarshalled from the response body.
+	Model Error
+	// StatusCode - the HTTP status code of the response.
+	StatusCode int
+	// Body - the raw response body.
+	Body []byte
+	// Err - the error encountered reading or unmarshalling the response body, if any.
+	Err error
+}
+
+// Error implements the error interface for type ErrorResponse.
+func (er ErrorResponse) Error() string {
+	if er.Err != nil {
+		return fmt.Sprintf("formdatagroup: StatusCode=%d Body=%s Err=%v", er.StatusCode, string(er.Body), er.Err)
+	}
+	return fmt.Sprintf("formdatagroup: StatusCode=%d Body=%s", er.StatusCode, string(er.Body))
+}
+
+// Unwrap returns the error encountered reading or unmarshalling the response body, if any.
+func (er ErrorResponse) Unwrap() error {
+	return er.Err
+}
+
+// withErrorResponseUnlessStatusCode returns a RespondDecorator that returns an ErrorResponse if the
+// response's status code isn't one of the specified codes.  The response body is left available to the caller.
+// When the body can't be read or unmarshalled the returned ErrorResponse wraps the error in its Err field.
+func withErrorResponseUnlessStatusCode(codes ...int) autorest.RespondDecorator {
+	return func(r autorest.Responder) autorest.Responder {
+		return autorest.ResponderFunc(func(resp *http.Response) error {
+			err := r.Respond(resp)
+			if err != nil || autorest.ResponseHasStatusCode(resp, codes...) {
+				return err
+			}
+			defer resp.Body.Close()
+			er := ErrorResponse{StatusCode: resp.StatusCode}
+			er.Body, er.Err = ioutil.ReadAll(resp.Body)
+			resp.Body = ioutil.NopCloser(bytes.NewReader(er.Body))
+			if er.Err == nil && len(bytes.TrimSpace(er.Body)) > 0 {
+				er.Err = json.Unmarshal(er.Body, &er.Model)
+			}
+			return er
+		})
+	}
+}
+
+// ExponentialRetry is a RetryPolicy that retries failed requests with exponentially increasing delays.  When the
+// RetryPolicy of a client is nil one is created from its RetryAttempts and RetryDuration.  Unlike
+// autorest.DoRetryForStatusCodes it only retries idempotent requests unless RetryNonIdempotent is true, so POST and
+// PATCH requests, including the initial requests of long-running operations, aren't retried unless the service marks
+// their operation x-ms-idempotent.  Responses with status code 429 count against MaxRetries.
+type ExponentialRetry struct {
+	// MaxRetries - the maximum number of times a request is retried.
+	MaxRetries int
+	// Delay - the delay before the first retry.  The delay is doubled for each following retry.
+	Delay time.Duration
+	// MaxDelay - the maximum delay between retries.  Zero means no limit.
+	MaxDelay time.Duration
+	// MaxElapsed - requests aren't retried once the delay would exceed this much time since the first attempt.  Zero means no limit.
+	MaxElapsed time.Duration
+	// Jitter - the fraction, from 0 to 1, of each delay that's randomized to spread out the retries of concurrent requests.
+	Jitter float64
+	// StatusCodes - the status codes of the responses that are retried.  Defaults to autorest.StatusCodesForRetry.
+	StatusCodes []int
+	// RetryNonIdempotent - if true, requests that aren't idempotent, e.g. POST requests of operations the service doesn't mark as idempotent, are retried too.
+	RetryNonIdempotent bool
+}
+
+// defaultRetryJitter is the Jitter of the ExponentialRetry used when the RetryPolicy of a client is nil.
+const defaultRetryJitter = 0.2
+
+// RetryPolicy decides if and when a failed attempt to send a request is retried.
+type RetryPolicy interface {
+	// ShouldRetry returns the delay before the request is sent again, or false if it isn't retried.
+	ShouldRetry(attempt RetryAttempt) (time.Duration, bool)
+}
+
+// LogEvent is an event logged by the operations of a client when its Logger isn't nil.  The URLs and headers of the
+// requests aren't included as they can contain secrets.
+type LogEvent struct {
+	// Kind - the kind of the event.
+	Kind LogEventKind
+	// Operation - the name of the operation, qualified by the name of its client.
+	Operation string
+	// Fields - the parameters of the operation keyed by their names in the request, for LogOperationStart events.  Body parameters aren't included and the values of secret parameters are replaced with REDACTED.
+	Fields map[string]interface{}
+	// Attempt - the number of the failed attempt, starting at one, for LogRetry events.
+	Attempt int
+	// Delay - the delay before the request is sent again, for LogRetry events.
+	Delay time.Duration
+	// State - the status of the long-running operation, for LogPoll events.
+	State string
+	// StatusCode - the status code of the response, or zero if none was received.
+	StatusCode int
+	// Elapsed - the time elapsed since the operation, the page fetch or the polling started.
+	Elapsed time.Duration
+	// Err - the error the operation, the attempt or the poll failed with, if any.
+	Err error
+}
+
+// redactedLogValue replaces the values of secret parameters in the Fields of a LogEvent.
+const redactedLogValue = "REDACTED"
+
+// LogEventKind is the kind of a LogEvent.
+type LogEventKind string
+
+const (
+	// LogOperationStart is logged when an operation starts, with its parameters.
+	LogOperationStart LogEventKind = "OperationStart"
+	// LogOperationFinish is logged when an operation finishes.
+	LogOperationFinish LogEventKind = "OperationFinish"
+	// LogRetry is logged when a failed attempt to send a request is retried.
+	LogRetry LogEventKind = "Retry"
+	// LogPageFetch is logged when the next page of the results of an operation has been fetched.
+	LogPageFetch LogEventKind = "PageFetch"
+	// LogPoll is logged each time PollUntilDone has polled the status of a long-running operation.
+	LogPoll LogEventKind = "Poll"
+)
+
+// Logger receives the events logged by the operations of a client.
+type Logger interface {
+	// Log is called synchronously for each event.
+	Log(event LogEvent)
+}
+
+// LoggerFunc is a function implementing the Logger interface.
+type LoggerFunc func(event LogEvent)
+
+// Log implements the Logger interface for LoggerFunc.
+func (lf LoggerFunc) Log(event LogEvent) {
+	lf(event)
+}
+
+// String formats the event as a line of key=value pairs, e.g. to pass to log.Print.
+func (le LogEvent) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "event=%s operation=%s", le.Kind, le.Operation)
+	names := make([]string, 0, len(le.Fields))
+	for name := range le.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&b, " %s=%v", name, le.Fields[name])
+	}
+	if le.Attempt > 0 {
+		fmt.Fprintf(&b, " attempt=%d", le.Attempt)
+	}
+	if le.State != "" {
+		fmt.Fprintf(&b, " state=%s", le.State)
+	}
+	if le.StatusCode > 0 {
+		fmt.Fprintf(&b, " status=%d", le.StatusCode)
+	}
+	if le.Delay > 0 {
+		fmt.Fprintf(&b, " delay=%v", le.Delay)
+	}
+	if le.Kind != LogOperationStart {
+		fmt.Fprintf(&b, " elapsed=%v", le.Elapsed)
+	}
+	if le.Err != nil {
+		fmt.Fprintf(&b, " error=%q", le.Err.Error())
+	}
+	return b.String()
+}
+
+// operationLog is the state used to log the events of an operation, carried by the contexts of its requests.
+type operationLog struct {
+	logger    Logger
+	operation string
+	start     time.Time
+}
+
+// operationLogKey is the key of the operationLog in a context.
+type operationLogKey struct{}
+
+// withOperationLog returns a context carrying the state used to log the events of operation to logger.
+func withOperationLog(ctx context.Context, logger Logger, operation string) context.Context {
+	return context.WithValue(ctx, operationLogKey{}, &operationLog{logger: logger, operation: operation, start: time.Now()})
+}
+
+// startOperationLog logs the start of operation with its parameters, then returns a context carrying the state used to
+// log its other events.
+func startOperationLog(ctx context.Context, logger Logger, operation string, fields map[string]interface{}) context.Context {
+	for name, value := range fields {
+		// log the values of optional parameters rather than their addresses
+		v := reflect.ValueOf(value)
+		for v.Kind() == reflect.Ptr && !v.IsNil() {
+			v = v.Elem()
+		}
+		if v.Kind() == reflect.Ptr {
+			fields[name] = nil
+		} else if v.IsValid() {
+			fields[name] = v.Interface()
+		}
+	}
+	ctx = withOperationLog(ctx, logger, operation)
+	logger.Log(LogEvent{Kind: LogOperationStart, Operation: operation, Fields: fields})
+	return ctx
+}
+
+// logResponse logs event for the operation whose state is carried by ctx, if any, along with the status code of resp
+// if it isn't nil.
+func logResponse(ctx context.Context, event LogEvent, resp *http.Response) {
+	ol, ok := ctx.Value(operationLogKey{}).(*operationLog)
+	if !ok {
+		return
+	}
+	event.Operation = ol.operation
+	event.Elapsed = time.Since(ol.start)
+	if resp != nil {
+		event.StatusCode = resp.StatusCode
+	}
+	ol.logger.Log(event)
+}
+
+// RetryAttempt describes a failed attempt to send a request.
+type RetryAttempt struct {
+	// Request - the request that was sent.
+	Request *http.Request
+	// Response - the response received, or nil if sending the request failed.
+	Response *http.Response
+	// Err - the error returned when sending the request, if any.
+	Err error
+	// Attempt - the number of the attempt, starting at one.
+	Attempt int
+	// Elapsed - the time elapsed since the first attempt was sent.
+	Elapsed time.Duration
+	// Idempotent - true if the request can safely be sent more than once, either because its HTTP method is
+	// idempotent or because the service marks the operation as idempotent.
+	Idempotent bool
+}
+
+// ShouldRetry implements the RetryPolicy interface for ExponentialRetry.  A delay requested by the Retry-After header
+// of the response takes precedence over the computed one.
+func (er ExponentialRetry) ShouldRetry(attempt RetryAttempt) (time.Duration, bool) {
+	if attempt.Attempt > er.MaxRetries || !(attempt.Idempotent || er.RetryNonIdempotent) {
+		return 0, false
+	}
+	if attempt.Err != nil {
+		if !autorest.IsTemporaryNetworkError(attempt.Err) || autorest.IsTokenRefreshError(attempt.Err) {
+			return 0, false
+		}
+	} else {
+		codes := er.StatusCodes
+		if codes == nil {
+			codes = autorest.StatusCodesForRetry
+		}
+		if !autorest.ResponseHasStatusCode(attempt.Response, codes...) {
+			return 0, false
+		}
+	}
+	delay, ok := retryAfter(attempt.Response)
+	if !ok {
+		delay = er.Delay
+		for i := 1; i < attempt.Attempt && delay < math.MaxInt64/2; i++ {
+			delay *= 2
+		}
+		if er.MaxDelay > 0 && delay > er.MaxDelay {
+			delay = er.MaxDelay
+		}
+		if er.Jitter > 0 {
+			delay -= time.Duration(math.Min(er.Jitter, 1) * rand.Float64() * float64(delay))
+		}
+	}
+	if er.MaxElapsed > 0 && attempt.Elapsed+delay > er.MaxElapsed {
+		return 0, false
+	}
+	return delay, true
+}
+
+// retryAfter returns the delay requested by the Retry-After header of resp, and false if it doesn't have one.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	ra := resp.Header.Get("Retry-After")
+	if ra == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(ra); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(ra); err == nil {
+		if delay := time.Until(t); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// isIdempotentMethod returns true if requests using the HTTP method can safely be sent more than once.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodTrace:
+		return true
+	}
+	return false
+}
+
+// withRetryPolicy returns a SendDecorator that sends a request again after each failed attempt policy decides to retry.
+// If policy is nil an ExponentialRetry created from the RetryAttempts and RetryDuration of c is used.  idempotent is
+// true for operations the service marks as idempotent.
+func withRetryPolicy(policy RetryPolicy, c autorest.Client, idempotent bool) autorest.SendDecorator {
+	if policy == nil {
+		policy = ExponentialRetry{MaxRetries: c.RetryAttempts, Delay: c.RetryDuration, Jitter: defaultRetryJitter}
+	}
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+			idempotent := idempotent || isIdempotentMethod(r.Method)
+			start := time.Now()
+			for attempt := 1; ; attempt++ {
+				if err := rr.Prepare(); err != nil {
+					return nil, err
+				}
+				resp, err := s.Do(rr.Request())
+				if r.Context().Err() != nil {
+					return resp, err
+				}
+				delay, retry := policy.ShouldRetry(RetryAttempt{
+					Request:    rr.Request(),
+					Response:   resp,
+					Err:        err,
+					Attempt:    attempt,
+					Elapsed:    time.Since(start),
+					Idempotent: idempotent,
+				})
+				if !retry {
+					return resp, err
+				}
+				logResponse(r.Context(), LogEvent{Kind: LogRetry, Attempt: attempt, Delay: delay, Err: err}, resp)
+				setSpanAttribute(r.Context(), AttributeRetryCount, attempt)
+				if resp != nil {
+					autorest.Respond(resp, autorest.ByDiscardingBody(), autorest.ByClosing())
+				}
+				timer := time.NewTimer(delay)
+				select {
+				case <-timer.C:
+				case <-r.Context().Done():
+					timer.Stop()
+					return nil, r.Context().Err()
+				}
+			}
+		})
+	}
+}
+
+// MultipartOptions contains the options applied to the multipart/form-data request bodies of operations whose
+// context was created with WithMultipartOptions.
+type MultipartOptions struct {
+	// ContentLength - the lengths in bytes of the file parts, keyed by form field name.  The Content-Length of the request is only set if the lengths of all its file parts are known.
+	ContentLength map[string]int64
+	// ContentType - the content types of the parts, keyed by form field name.  File parts default to application/octet-stream.
+	ContentType map[string]string
+	// Progress - if not nil, called as the request body is sent with the number of bytes sent so far and the length of the body, or -1 if it isn't known.
+	Progress func(sent, total int64)
+}
+
+type multipartOptionsKey struct{}
+
+// WithMultipartOptions returns a copy of ctx carrying opts.  Operations uploading files called with the
+// returned context apply opts to their multipart/form-data request bodies.
+func WithMultipartOptions(ctx context.Context, opts MultipartOptions) context.Context {
+	return context.WithValue(ctx, multipartOptionsKey{}, opts)
+}
+
+// withMultipartFormData returns a PrepareDecorator that streams formDataParameters as the multipart/form-data
+// body of the request through an io.Pipe, so the contents of the files are never buffered in memory.  It
+// applies the MultipartOptions carried by the request's context.
+func withMultipartFormData(formDataParameters map[string]interface{}) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+			mf := multipartForm{
+				params:   formDataParameters,
+				boundary: multipart.NewWriter(ioutil.Discard).Boundary(),
+			}
+			mf.opts, _ = r.Context().Value(multipartOptionsKey{}).(MultipartOptions)
+			for key := range formDataParameters {
+				mf.keys = append(mf.keys, key)
+			}
+			sort.Strings(mf.keys)
+			if mf.length, err = mf.contentLength(); err != nil {
+				return r, err
+			}
+			if r.Header == nil {
+				r.Header = make(http.Header)
+			}
+			r.Header.Set("Content-Type", "multipart/form-data; boundary="+mf.boundary)
+			r.ContentLength = mf.length
+			r.Body = newMultipartBody(mf, false)
+			// retries send a new body, which can only be written if the files can be rewound
+			r.GetBody = func() (io.ReadCloser, error) {
+				return newMultipartBody(mf, true), nil
+			}
+			return r, nil
+		})
+	}
+}
+
+var multipartQuoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// multipartForm describes the multipart/form-data body of a request.
+type multipartForm struct {
+	params   map[string]interface{}
+	keys     []string
+	boundary string
+	opts     MultipartOptions
+	length   int64
+}
+
+// contentLength returns the length of the body, or -1 if the length of any of the files isn't known.
+func (mf multipartForm) contentLength() (int64, error) {
+	var length multipartCounter
+	if err := mf.write(&length, false); err != nil {
+		return 0, err
+	}
+	for key, value := range mf.params {
+		if _, ok := value.(io.ReadCloser); ok {
+			fileLength, ok := mf.opts.ContentLength[key]
+			if !ok || fileLength < 0 {
+				return -1, nil
+			}
+			length += multipartCounter(fileLength)
+		}
+	}
+	return int64(length), nil
+}
+
+// rewind seeks the files to their beginning, failing if any of them isn't an io.Seeker.
+func (mf multipartForm) rewind() error {
+	for _, key := range mf.keys {
+		if _, ok := mf.params[key].(io.ReadCloser); !ok {
+			continue
+		}
+		s, ok := mf.params[key].(io.Seeker)
+		if !ok {
+			return fmt.Errorf("the multipart/form-data body can't be resent as %s isn't an io.Seeker", key)
+		}
+		if _, err := s.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// write writes the body to w.  The contents of the files are only copied if files is true.
+func (mf multipartForm) write(w io.Writer, files bool) error {
+	mw := multipart.NewWriter(w)
+	if err := mw.SetBoundary(mf.boundary); err != nil {
+		return err
+	}
+	for _, key := range mf.keys {
+		h := make(textproto.MIMEHeader)
+		rc, isFile := mf.params[key].(io.ReadCloser)
+		if isFile {
+			h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, multipartQuoteEscaper.Replace(key), multipartQuoteEscaper.Replace(key)))
+			h.Set("Content-Type", "application/octet-stream")
+		} else {
+			h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"`, multipartQuoteEscaper.Replace(key)))
+		}
+		if contentType := mf.opts.ContentType[key]; contentType != "" {
+			h.Set("Content-Type", contentType)
+		}
+		part, err := mw.CreatePart(h)
+		if err != nil {
+			return err
+		}
+		if !isFile {
+			value := mf.params[key]
+			if b, ok := value.([]byte); ok {
+				value = string(b)
+			}
+			_, err = io.WriteString(part, fmt.Sprint(value))
+		} else if files {
+			_, err = io.Copy(part, rc)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return mw.Close()
+}
+
+// multipartBody streams a multipartForm.  The form is written by a goroutine started by the first call to Read.
+type multipartBody struct {
+	form   multipartForm
+	rewind bool
+	once   sync.Once
+	pr     *io.PipeReader
+	pw     *io.PipeWriter
+}
+
+func newMultipartBody(mf multipartForm, rewind bool) *multipartBody {
+	b := &multipartBody{form: mf, rewind: rewind}
+	b.pr, b.pw = io.Pipe()
+	return b
+}
+
+func (b *multipartBody) Read(p []byte) (int, error) {
+	b.once.Do(func() {
+		go func() {
+			var err error
+			if b.rewind {
+				err = b.form.rewind()
+			}
+			if err == nil {
+				var w io.Writer = b.pw
+				if b.form.opts.Progress != nil {
+					w = &multipartProgress{w: b.pw, total: b.form.length, progress: b.form.opts.Progress}
+				}
+				err = b.form.write(w, true)
+			}
+			b.pw.CloseWithError(err)
+		}()
+	})
+	return b.pr.Read(p)
+}
+
+func (b *multipartBody) Close() error {
+	return b.pr.Close()
+}
+
+// multipartCounter counts the bytes written to it.
+type multipartCounter int64
+
+func (c *multipartCounter) Write(p []byte) (int, error) {
+	*c += multipartCounter(len(p))
+	return len(p), nil
+}
+
+// multipartProgress reports the bytes written through it to a MultipartOptions.Progress callback.
+type multipartProgress struct {
+	w        io.Writer
+	sent     int64
+	total    int64
+	progress func(sent, total int64)
+}
+
+func (mp *multipartProgress) Write(p []byte) (int, error) {
+	n, err := mp.w.Write(p)
+	mp.sent += int64(n)
+	mp.progress(mp.sent, mp.total)
+	return n, err
+}
+
+// RequestOptions contains the per-call options applied to the requests of operations whose context was created with WithRequestOptions.
+type RequestOptions struct {
+	// Header - additional headers to send.  They replace any values of the same headers set by the operation.
+	Header http.Header
+	// Query - additional query parameters to send.  They replace any values of the same parameters set by the operation.
+	Query url.Values
+	// Timeout - if greater than zero, the maximum amount of time allowed for sending the request, including retries, and reading its response.
+	Timeout time.Duration
+}
+
+type requestOptionsKey struct{}
+
+// WithRequestOptions returns a copy of ctx carrying opts.  Operations called with the returned
+// context apply opts to their requests; other calls aren't affected.
+func WithRequestOptions(ctx context.Context, opts RequestOptions) context.Context {
+	return context.WithValue(ctx, requestOptionsKey{}, opts)
+}
+
+// withRequestOptions returns a PrepareDecorator that merges the headers and query parameters
+// of the RequestOptions carried by the request's context into the request.
+func withRequestOptions() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+			opts, ok := r.Context().Value(requestOptionsKey{}).(RequestOptions)
+			if !ok {
+				return r, nil
+			}
+			if len(opts.Header) > 0 {
+				if r.Header == nil {
+					r.Header = make(http.Header)
+				}
+				for k, v := range opts.Header {
+					r.Header[http.CanonicalHeaderKey(k)] = v
+				}
+			}
+			if len(opts.Query) > 0 {
+				q := r.URL.Query()
+				for k, v := range opts.Query {
+					q[k] = v
+				}
+				r.URL.RawQuery = q.Encode()
+			}
+			return r, nil
+		})
+	}
+}
+
+// sendWithRequestOptions sends the request like autorest.SendWithSender.  If the request's context
+// carries a RequestOptions with a Timeout, the request is cancelled once the timeout elapses
+// or the response body is closed, whichever happens first.
+func sendWithRequestOptions(s autorest.Sender, r *http.Request, decorators ...autorest.SendDecorator) (*http.Response, error) {
+	opts, ok := r.Context().Value(requestOptionsKey{}).(RequestOptions)
+	if !ok || opts.Timeout <= 0 {
+		return autorest.SendWithSender(s, r, decorators...)
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), opts.Timeout)
+	resp, err := autorest.SendWithSender(s, r.WithContext(ctx), decorators...)
+	if err != nil || resp == nil || resp.Body == nil {
+		cancel()
+		return resp, err
+	}
+	resp.Body = requestOptionsBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// requestOptionsBody releases the timeout of a request once its response body is closed.
+type requestOptionsBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b requestOptionsBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
 }
@@ -9,10 +9,8 @@ package headergroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/Azure/go-autorest/autorest/validation"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -31,16 +29,25 @@ func NewHeaderClientWithBaseURI(baseURI string) HeaderClient {
 	return HeaderClient{NewWithBaseURI(baseURI)}
 }
 
+// NewHeaderClientWithOptions creates an instance of the HeaderClient client with the specified options.  It returns an
+// error if the options are invalid.
+func NewHeaderClientWithOptions(opts ClientOptions) (HeaderClient, error) {
+	client, err := NewWithOptions(opts)
+	return HeaderClient{client}, err
+}
+
 // CustomRequestID send x-ms-client-request-id = 9C4D50EE-2D56-4CD3-8152-34347DC9F2B0 in the header of the request
 func (client HeaderClient) CustomRequestID(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.CustomRequestID")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.CustomRequestID", "POST", "/header/custom/x-ms-client-request-id/9C4D50EE-2D56-4CD3-8152-34347DC9F2B0")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.CustomRequestID", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.CustomRequestIDPreparer(ctx)
@@ -57,7 +64,7 @@ func (client HeaderClient) CustomRequestID(ctx context.Context) (result autorest
 	}
 
 	result, err = client.CustomRequestIDResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "CustomRequestID", resp, "Failure responding to request")
 	}
 
@@ -70,14 +77,15 @@ func (client HeaderClient) CustomRequestIDPreparer(ctx context.Context) (*http.R
 		autorest.AsPost(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/header/custom/x-ms-client-request-id/9C4D50EE-2D56-4CD3-8152-34347DC9F2B0"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // CustomRequestIDSender sends the CustomRequestID request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) CustomRequestIDSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // CustomRequestIDResponder handles the response to the CustomRequestID request. The method always
@@ -86,7 +94,7 @@ func (client HeaderClient) CustomRequestIDResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -98,14 +106,19 @@ func (client HeaderClient) CustomRequestIDResponder(resp *http.Response) (result
 // scenario - send a post request with header values "scenario": "true" or "false"
 // value - send a post request with header values true or false
 func (client HeaderClient) ParamBool(ctx context.Context, scenario string, value bool) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ParamBool")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ParamBool", "POST", "/header/param/prim/bool")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ParamBool", map[string]interface{}{
+			"scenario": scenario,
+			"value":    value,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.ParamBoolPreparer(ctx, scenario, value)
@@ -122,7 +135,7 @@ func (client HeaderClient) ParamBool(ctx context.Context, scenario string, value
 	}
 
 	result, err = client.ParamBoolResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ParamBool", resp, "Failure responding to request")
 	}
 
@@ -137,14 +150,15 @@ func (client HeaderClient) ParamBoolPreparer(ctx context.Context, scenario strin
 		autorest.WithPath("/header/param/prim/bool"),
 		autorest.WithHeader("scenario", autorest.String(scenario)),
 		autorest.WithHeader("value", autorest.String(value)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ParamBoolSender sends the ParamBool request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ParamBoolSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ParamBoolResponder handles the response to the ParamBool request. The method always
@@ -153,7 +167,7 @@ func (client HeaderClient) ParamBoolResponder(resp *http.Response) (result autor
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -164,20 +178,27 @@ func (client HeaderClient) ParamBoolResponder(resp *http.Response) (result autor
 // scenario - send a post request with header values "scenario": "valid"
 // value - send a post request with header values "啊齄丂狛狜隣郎隣兀﨩"
 func (client HeaderClient) ParamByte(ctx context.Context, scenario string, value []byte) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ParamByte")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ParamByte", "POST", "/header/param/prim/byte")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: value,
-			Constraints: []validation.Constraint{{Target: "value", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("headergroup.HeaderClient", "ParamByte", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ParamByte", map[string]interface{}{
+			"scenario": scenario,
+			"value":    value,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if value == nil {
+		errs = append(errs, ValidationError{Path: "value", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("headergroup.HeaderClient", "ParamByte", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.ParamBytePreparer(ctx, scenario, value)
@@ -194,7 +215,7 @@ func (client HeaderClient) ParamByte(ctx context.Context, scenario string, value
 	}
 
 	result, err = client.ParamByteResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ParamByte", resp, "Failure responding to request")
 	}
 
@@ -209,14 +230,15 @@ func (client HeaderClient) ParamBytePreparer(ctx context.Context, scenario strin
 		autorest.WithPath("/header/param/prim/byte"),
 		autorest.WithHeader("scenario", autorest.String(scenario)),
 		autorest.WithHeader("value", autorest.String(value)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ParamByteSender sends the ParamByte request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ParamByteSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ParamByteResponder handles the response to the ParamByte request. The method always
@@ -225,7 +247,7 @@ func (client HeaderClient) ParamByteResponder(resp *http.Response) (result autor
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -237,14 +259,19 @@ func (client HeaderClient) ParamByteResponder(resp *http.Response) (result autor
 // scenario - send a post request with header values "scenario": "valid" or "min"
 // value - send a post request with header values "2010-01-01" or "0001-01-01"
 func (client HeaderClient) ParamDate(ctx context.Context, scenario string, value date.Date) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ParamDate")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ParamDate", "POST", "/header/param/prim/date")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ParamDate", map[string]interface{}{
+			"scenario": scenario,
+			"value":    value,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.ParamDatePreparer(ctx, scenario, value)
@@ -261,7 +288,7 @@ func (client HeaderClient) ParamDate(ctx context.Context, scenario string, value
 	}
 
 	result, err = client.ParamDateResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ParamDate", resp, "Failure responding to request")
 	}
 
@@ -276,14 +303,15 @@ func (client HeaderClient) ParamDatePreparer(ctx context.Context, scenario strin
 		autorest.WithPath("/header/param/prim/date"),
 		autorest.WithHeader("scenario", autorest.String(scenario)),
 		autorest.WithHeader("value", autorest.String(value)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ParamDateSender sends the ParamDate request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ParamDateSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ParamDateResponder handles the response to the ParamDate request. The method always
@@ -292,7 +320,7 @@ func (client HeaderClient) ParamDateResponder(resp *http.Response) (result autor
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -304,14 +332,19 @@ func (client HeaderClient) ParamDateResponder(resp *http.Response) (result autor
 // scenario - send a post request with header values "scenario": "valid" or "min"
 // value - send a post request with header values "2010-01-01T12:34:56Z" or "0001-01-01T00:00:00Z"
 func (client HeaderClient) ParamDatetime(ctx context.Context, scenario string, value date.Time) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ParamDatetime")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ParamDatetime", "POST", "/header/param/prim/datetime")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ParamDatetime", map[string]interface{}{
+			"scenario": scenario,
+			"value":    value,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.ParamDatetimePreparer(ctx, scenario, value)
@@ -328,7 +361,7 @@ func (client HeaderClient) ParamDatetime(ctx context.Context, scenario string, v
 	}
 
 	result, err = client.ParamDatetimeResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ParamDatetime", resp, "Failure responding to request")
 	}
 
@@ -343,14 +376,15 @@ func (client HeaderClient) ParamDatetimePreparer(ctx context.Context, scenario s
 		autorest.WithPath("/header/param/prim/datetime"),
 		autorest.WithHeader("scenario", autorest.String(scenario)),
 		autorest.WithHeader("value", autorest.String(value)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ParamDatetimeSender sends the ParamDatetime request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ParamDatetimeSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ParamDatetimeResponder handles the response to the ParamDatetime request. The method always
@@ -359,7 +393,7 @@ func (client HeaderClient) ParamDatetimeResponder(resp *http.Response) (result a
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -372,14 +406,19 @@ func (client HeaderClient) ParamDatetimeResponder(resp *http.Response) (result a
 // value - send a post request with header values "Wed, 01 Jan 2010 12:34:56 GMT" or "Mon, 01 Jan 0001 00:00:00
 // GMT"
 func (client HeaderClient) ParamDatetimeRfc1123(ctx context.Context, scenario string, value *date.TimeRFC1123) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ParamDatetimeRfc1123")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ParamDatetimeRfc1123", "POST", "/header/param/prim/datetimerfc1123")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ParamDatetimeRfc1123", map[string]interface{}{
+			"scenario": scenario,
+			"value":    value,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.ParamDatetimeRfc1123Preparer(ctx, scenario, value)
@@ -396,7 +435,7 @@ func (client HeaderClient) ParamDatetimeRfc1123(ctx context.Context, scenario st
 	}
 
 	result, err = client.ParamDatetimeRfc1123Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ParamDatetimeRfc1123", resp, "Failure responding to request")
 	}
 
@@ -412,16 +451,17 @@ func (client HeaderClient) ParamDatetimeRfc1123Preparer(ctx context.Context, sce
 		autorest.WithHeader("scenario", autorest.String(scenario)))
 	if value != nil {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithHeader("value", autorest.String(value)))
+			autorest.WithHeader("value", autorest.String(*value)))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ParamDatetimeRfc1123Sender sends the ParamDatetimeRfc1123 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ParamDatetimeRfc1123Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ParamDatetimeRfc1123Responder handles the response to the ParamDatetimeRfc1123 request. The method always
@@ -430,7 +470,7 @@ func (client HeaderClient) ParamDatetimeRfc1123Responder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -442,14 +482,19 @@ func (client HeaderClient) ParamDatetimeRfc1123Responder(resp *http.Response) (r
 // scenario - send a post request with header values "scenario": "positive" or "negative"
 // value - send a post request with header values 7e120 or -3.0
 func (client HeaderClient) ParamDouble(ctx context.Context, scenario string, value float64) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ParamDouble")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ParamDouble", "POST", "/header/param/prim/double")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ParamDouble", map[string]interface{}{
+			"scenario": scenario,
+			"value":    value,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.ParamDoublePreparer(ctx, scenario, value)
@@ -466,7 +511,7 @@ func (client HeaderClient) ParamDouble(ctx context.Context, scenario string, val
 	}
 
 	result, err = client.ParamDoubleResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ParamDouble", resp, "Failure responding to request")
 	}
 
@@ -481,14 +526,15 @@ func (client HeaderClient) ParamDoublePreparer(ctx context.Context, scenario str
 		autorest.WithPath("/header/param/prim/double"),
 		autorest.WithHeader("scenario", autorest.String(scenario)),
 		autorest.WithHeader("value", autorest.String(value)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ParamDoubleSender sends the ParamDouble request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ParamDoubleSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ParamDoubleResponder handles the response to the ParamDouble request. The method always
@@ -497,7 +543,7 @@ func (client HeaderClient) ParamDoubleResponder(resp *http.Response) (result aut
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -508,14 +554,19 @@ func (client HeaderClient) ParamDoubleResponder(resp *http.Response) (result aut
 // scenario - send a post request with header values "scenario": "valid"
 // value - send a post request with header values "P123DT22H14M12.011S"
 func (client HeaderClient) ParamDuration(ctx context.Context, scenario string, value string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ParamDuration")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ParamDuration", "POST", "/header/param/prim/duration")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ParamDuration", map[string]interface{}{
+			"scenario": scenario,
+			"value":    value,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.ParamDurationPreparer(ctx, scenario, value)
@@ -532,7 +583,7 @@ func (client HeaderClient) ParamDuration(ctx context.Context, scenario string, v
 	}
 
 	result, err = client.ParamDurationResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ParamDuration", resp, "Failure responding to request")
 	}
 
@@ -547,14 +598,15 @@ func (client HeaderClient) ParamDurationPreparer(ctx context.Context, scenario s
 		autorest.WithPath("/header/param/prim/duration"),
 		autorest.WithHeader("scenario", autorest.String(scenario)),
 		autorest.WithHeader("value", autorest.String(value)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ParamDurationSender sends the ParamDuration request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ParamDurationSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ParamDurationResponder handles the response to the ParamDuration request. The method always
@@ -563,7 +615,7 @@ func (client HeaderClient) ParamDurationResponder(resp *http.Response) (result a
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -575,14 +627,19 @@ func (client HeaderClient) ParamDurationResponder(resp *http.Response) (result a
 // scenario - send a post request with header values "scenario": "valid" or "null" or "empty"
 // value - send a post request with header values 'GREY'
 func (client HeaderClient) ParamEnum(ctx context.Context, scenario string, value GreyscaleColors) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ParamEnum")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ParamEnum", "POST", "/header/param/prim/enum")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ParamEnum", map[string]interface{}{
+			"scenario": scenario,
+			"value":    value,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.ParamEnumPreparer(ctx, scenario, value)
@@ -599,7 +656,7 @@ func (client HeaderClient) ParamEnum(ctx context.Context, scenario string, value
 	}
 
 	result, err = client.ParamEnumResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ParamEnum", resp, "Failure responding to request")
 	}
 
@@ -617,14 +674,15 @@ func (client HeaderClient) ParamEnumPreparer(ctx context.Context, scenario strin
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithHeader("value", autorest.String(value)))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ParamEnumSender sends the ParamEnum request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ParamEnumSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ParamEnumResponder handles the response to the ParamEnum request. The method always
@@ -633,7 +691,7 @@ func (client HeaderClient) ParamEnumResponder(resp *http.Response) (result autor
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -643,14 +701,18 @@ func (client HeaderClient) ParamEnumResponder(resp *http.Response) (result autor
 // Parameters:
 // userAgent - send a post request with header value "User-Agent": "overwrite"
 func (client HeaderClient) ParamExistingKey(ctx context.Context, userAgent string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ParamExistingKey")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ParamExistingKey", "POST", "/header/param/existingkey")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ParamExistingKey", map[string]interface{}{
+			"User-Agent": redactedLogValue,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.ParamExistingKeyPreparer(ctx, userAgent)
@@ -667,7 +729,7 @@ func (client HeaderClient) ParamExistingKey(ctx context.Context, userAgent strin
 	}
 
 	result, err = client.ParamExistingKeyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ParamExistingKey", resp, "Failure responding to request")
 	}
 
@@ -681,14 +743,15 @@ func (client HeaderClient) ParamExistingKeyPreparer(ctx context.Context, userAge
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/header/param/existingkey"),
 		autorest.WithHeader("User-Agent", autorest.String(userAgent)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ParamExistingKeySender sends the ParamExistingKey request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ParamExistingKeySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ParamExistingKeyResponder handles the response to the ParamExistingKey request. The method always
@@ -697,7 +760,7 @@ func (client HeaderClient) ParamExistingKeyResponder(resp *http.Response) (resul
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -709,14 +772,19 @@ func (client HeaderClient) ParamExistingKeyResponder(resp *http.Response) (resul
 // scenario - send a post request with header values "scenario": "positive" or "negative"
 // value - send a post request with header values 0.07 or -3.0
 func (client HeaderClient) ParamFloat(ctx context.Context, scenario string, value float64) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ParamFloat")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ParamFloat", "POST", "/header/param/prim/float")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ParamFloat", map[string]interface{}{
+			"scenario": scenario,
+			"value":    value,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.ParamFloatPreparer(ctx, scenario, value)
@@ -733,7 +801,7 @@ func (client HeaderClient) ParamFloat(ctx context.Context, scenario string, valu
 	}
 
 	result, err = client.ParamFloatResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ParamFloat", resp, "Failure responding to request")
 	}
 
@@ -748,14 +816,15 @@ func (client HeaderClient) ParamFloatPreparer(ctx context.Context, scenario stri
 		autorest.WithPath("/header/param/prim/float"),
 		autorest.WithHeader("scenario", autorest.String(scenario)),
 		autorest.WithHeader("value", autorest.String(value)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ParamFloatSender sends the ParamFloat request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ParamFloatSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ParamFloatResponder handles the response to the ParamFloat request. The method always
@@ -764,7 +833,7 @@ func (client HeaderClient) ParamFloatResponder(resp *http.Response) (result auto
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -776,14 +845,19 @@ func (client HeaderClient) ParamFloatResponder(resp *http.Response) (result auto
 // scenario - send a post request with header values "scenario": "positive" or "negative"
 // value - send a post request with header values 1 or -2
 func (client HeaderClient) ParamInteger(ctx context.Context, scenario string, value int32) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ParamInteger")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ParamInteger", "POST", "/header/param/prim/integer")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ParamInteger", map[string]interface{}{
+			"scenario": scenario,
+			"value":    value,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.ParamIntegerPreparer(ctx, scenario, value)
@@ -800,7 +874,7 @@ func (client HeaderClient) ParamInteger(ctx context.Context, scenario string, va
 	}
 
 	result, err = client.ParamIntegerResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ParamInteger", resp, "Failure responding to request")
 	}
 
@@ -815,14 +889,15 @@ func (client HeaderClient) ParamIntegerPreparer(ctx context.Context, scenario st
 		autorest.WithPath("/header/param/prim/integer"),
 		autorest.WithHeader("scenario", autorest.String(scenario)),
 		autorest.WithHeader("value", autorest.String(value)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ParamIntegerSender sends the ParamInteger request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ParamIntegerSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ParamIntegerResponder handles the response to the ParamInteger request. The method always
@@ -831,7 +906,7 @@ func (client HeaderClient) ParamIntegerResponder(resp *http.Response) (result au
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -843,14 +918,19 @@ func (client HeaderClient) ParamIntegerResponder(resp *http.Response) (result au
 // scenario - send a post request with header values "scenario": "positive" or "negative"
 // value - send a post request with header values 105 or -2
 func (client HeaderClient) ParamLong(ctx context.Context, scenario string, value int64) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ParamLong")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ParamLong", "POST", "/header/param/prim/long")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ParamLong", map[string]interface{}{
+			"scenario": scenario,
+			"value":    value,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.ParamLongPreparer(ctx, scenario, value)
@@ -867,7 +947,7 @@ func (client HeaderClient) ParamLong(ctx context.Context, scenario string, value
 	}
 
 	result, err = client.ParamLongResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ParamLong", resp, "Failure responding to request")
 	}
 
@@ -882,14 +962,15 @@ func (client HeaderClient) ParamLongPreparer(ctx context.Context, scenario strin
 		autorest.WithPath("/header/param/prim/long"),
 		autorest.WithHeader("scenario", autorest.String(scenario)),
 		autorest.WithHeader("value", autorest.String(value)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ParamLongSender sends the ParamLong request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ParamLongSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ParamLongResponder handles the response to the ParamLong request. The method always
@@ -898,7 +979,7 @@ func (client HeaderClient) ParamLongResponder(resp *http.Response) (result autor
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -908,14 +989,18 @@ func (client HeaderClient) ParamLongResponder(resp *http.Response) (result autor
 // Parameters:
 // contentType - send a post request with header value "Content-Type": "text/html"
 func (client HeaderClient) ParamProtectedKey(ctx context.Context, contentType string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ParamProtectedKey")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ParamProtectedKey", "POST", "/header/param/protectedkey")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ParamProtectedKey", map[string]interface{}{
+			"Content-Type": contentType,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.ParamProtectedKeyPreparer(ctx, contentType)
@@ -932,7 +1017,7 @@ func (client HeaderClient) ParamProtectedKey(ctx context.Context, contentType st
 	}
 
 	result, err = client.ParamProtectedKeyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ParamProtectedKey", resp, "Failure responding to request")
 	}
 
@@ -946,14 +1031,15 @@ func (client HeaderClient) ParamProtectedKeyPreparer(ctx context.Context, conten
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/header/param/protectedkey"),
 		autorest.WithHeader("Content-Type", autorest.String(contentType)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ParamProtectedKeySender sends the ParamProtectedKey request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ParamProtectedKeySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ParamProtectedKeyResponder handles the response to the ParamProtectedKey request. The method always
@@ -962,7 +1048,7 @@ func (client HeaderClient) ParamProtectedKeyResponder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -974,14 +1060,19 @@ func (client HeaderClient) ParamProtectedKeyResponder(resp *http.Response) (resu
 // scenario - send a post request with header values "scenario": "valid" or "null" or "empty"
 // value - send a post request with header values "The quick brown fox jumps over the lazy dog" or null or ""
 func (client HeaderClient) ParamString(ctx context.Context, scenario string, value string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ParamString")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ParamString", "POST", "/header/param/prim/string")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ParamString", map[string]interface{}{
+			"scenario": scenario,
+			"value":    redactedLogValue,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.ParamStringPreparer(ctx, scenario, value)
@@ -998,7 +1089,7 @@ func (client HeaderClient) ParamString(ctx context.Context, scenario string, val
 	}
 
 	result, err = client.ParamStringResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ParamString", resp, "Failure responding to request")
 	}
 
@@ -1016,14 +1107,15 @@ func (client HeaderClient) ParamStringPreparer(ctx context.Context, scenario str
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithHeader("value", autorest.String(value)))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ParamStringSender sends the ParamString request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ParamStringSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ParamStringResponder handles the response to the ParamString request. The method always
@@ -1032,7 +1124,7 @@ func (client HeaderClient) ParamStringResponder(resp *http.Response) (result aut
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1041,15 +1133,19 @@ func (client HeaderClient) ParamStringResponder(resp *http.Response) (result aut
 // ResponseBool get a response with header value "value": true or false
 // Parameters:
 // scenario - send a post request with header values "scenario": "true" or "false"
-func (client HeaderClient) ResponseBool(ctx context.Context, scenario string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ResponseBool")
+func (client HeaderClient) ResponseBool(ctx context.Context, scenario string) (result HeaderResponseBoolResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ResponseBool", "POST", "/header/response/prim/bool")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ResponseBool", map[string]interface{}{
+			"scenario": scenario,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.ResponseBoolPreparer(ctx, scenario)
@@ -1060,13 +1156,13 @@ func (client HeaderClient) ResponseBool(ctx context.Context, scenario string) (r
 
 	resp, err := client.ResponseBoolSender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseBool", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.ResponseBoolResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseBool", resp, "Failure responding to request")
 	}
 
@@ -1080,40 +1176,48 @@ func (client HeaderClient) ResponseBoolPreparer(ctx context.Context, scenario st
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/header/response/prim/bool"),
 		autorest.WithHeader("scenario", autorest.String(scenario)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ResponseBoolSender sends the ResponseBool request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ResponseBoolSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ResponseBoolResponder handles the response to the ResponseBool request. The method always
 // closes the http.Response Body.
-func (client HeaderClient) ResponseBoolResponder(resp *http.Response) (result autorest.Response, err error) {
+func (client HeaderClient) ResponseBoolResponder(resp *http.Response) (result HeaderResponseBoolResult, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
 // ResponseByte get a response with header values "啊齄丂狛狜隣郎隣兀﨩"
 // Parameters:
 // scenario - send a post request with header values "scenario": "valid"
-func (client HeaderClient) ResponseByte(ctx context.Context, scenario string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ResponseByte")
+func (client HeaderClient) ResponseByte(ctx context.Context, scenario string) (result HeaderResponseByteResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ResponseByte", "POST", "/header/response/prim/byte")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ResponseByte", map[string]interface{}{
+			"scenario": scenario,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.ResponseBytePreparer(ctx, scenario)
@@ -1124,13 +1228,13 @@ func (client HeaderClient) ResponseByte(ctx context.Context, scenario string) (r
 
 	resp, err := client.ResponseByteSender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseByte", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.ResponseByteResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseByte", resp, "Failure responding to request")
 	}
 
@@ -1144,40 +1248,48 @@ func (client HeaderClient) ResponseBytePreparer(ctx context.Context, scenario st
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/header/response/prim/byte"),
 		autorest.WithHeader("scenario", autorest.String(scenario)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ResponseByteSender sends the ResponseByte request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ResponseByteSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ResponseByteResponder handles the response to the ResponseByte request. The method always
 // closes the http.Response Body.
-func (client HeaderClient) ResponseByteResponder(resp *http.Response) (result autorest.Response, err error) {
+func (client HeaderClient) ResponseByteResponder(resp *http.Response) (result HeaderResponseByteResult, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
 // ResponseDate get a response with header values "2010-01-01" or "0001-01-01"
 // Parameters:
 // scenario - send a post request with header values "scenario": "valid" or "min"
-func (client HeaderClient) ResponseDate(ctx context.Context, scenario string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ResponseDate")
+func (client HeaderClient) ResponseDate(ctx context.Context, scenario string) (result HeaderResponseDateResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ResponseDate", "POST", "/header/response/prim/date")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ResponseDate", map[string]interface{}{
+			"scenario": scenario,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.ResponseDatePreparer(ctx, scenario)
@@ -1188,13 +1300,13 @@ func (client HeaderClient) ResponseDate(ctx context.Context, scenario string) (r
 
 	resp, err := client.ResponseDateSender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseDate", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.ResponseDateResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseDate", resp, "Failure responding to request")
 	}
 
@@ -1208,40 +1320,48 @@ func (client HeaderClient) ResponseDatePreparer(ctx context.Context, scenario st
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/header/response/prim/date"),
 		autorest.WithHeader("scenario", autorest.String(scenario)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ResponseDateSender sends the ResponseDate request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ResponseDateSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ResponseDateResponder handles the response to the ResponseDate request. The method always
 // closes the http.Response Body.
-func (client HeaderClient) ResponseDateResponder(resp *http.Response) (result autorest.Response, err error) {
+func (client HeaderClient) ResponseDateResponder(resp *http.Response) (result HeaderResponseDateResult, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
 // ResponseDatetime get a response with header values "2010-01-01T12:34:56Z" or "0001-01-01T00:00:00Z"
 // Parameters:
 // scenario - send a post request with header values "scenario": "valid" or "min"
-func (client HeaderClient) ResponseDatetime(ctx context.Context, scenario string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ResponseDatetime")
+func (client HeaderClient) ResponseDatetime(ctx context.Context, scenario string) (result HeaderResponseDatetimeResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ResponseDatetime", "POST", "/header/response/prim/datetime")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ResponseDatetime", map[string]interface{}{
+			"scenario": scenario,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.ResponseDatetimePreparer(ctx, scenario)
@@ -1252,13 +1372,13 @@ func (client HeaderClient) ResponseDatetime(ctx context.Context, scenario string
 
 	resp, err := client.ResponseDatetimeSender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseDatetime", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.ResponseDatetimeResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseDatetime", resp, "Failure responding to request")
 	}
 
@@ -1272,25 +1392,29 @@ func (client HeaderClient) ResponseDatetimePreparer(ctx context.Context, scenari
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/header/response/prim/datetime"),
 		autorest.WithHeader("scenario", autorest.String(scenario)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ResponseDatetimeSender sends the ResponseDatetime request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ResponseDatetimeSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ResponseDatetimeResponder handles the response to the ResponseDatetime request. The method always
 // closes the http.Response Body.
-func (client HeaderClient) ResponseDatetimeResponder(resp *http.Response) (result autorest.Response, err error) {
+func (client HeaderClient) ResponseDatetimeResponder(resp *http.Response) (result HeaderResponseDatetimeResult, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
@@ -1298,15 +1422,19 @@ func (client HeaderClient) ResponseDatetimeResponder(resp *http.Response) (resul
 // 00:00:00 GMT"
 // Parameters:
 // scenario - send a post request with header values "scenario": "valid" or "min"
-func (client HeaderClient) ResponseDatetimeRfc1123(ctx context.Context, scenario string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ResponseDatetimeRfc1123")
+func (client HeaderClient) ResponseDatetimeRfc1123(ctx context.Context, scenario string) (result HeaderResponseDatetimeRfc1123Result, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ResponseDatetimeRfc1123", "POST", "/header/response/prim/datetimerfc1123")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ResponseDatetimeRfc1123", map[string]interface{}{
+			"scenario": scenario,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.ResponseDatetimeRfc1123Preparer(ctx, scenario)
@@ -1317,13 +1445,13 @@ func (client HeaderClient) ResponseDatetimeRfc1123(ctx context.Context, scenario
 
 	resp, err := client.ResponseDatetimeRfc1123Sender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseDatetimeRfc1123", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.ResponseDatetimeRfc1123Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseDatetimeRfc1123", resp, "Failure responding to request")
 	}
 
@@ -1337,40 +1465,48 @@ func (client HeaderClient) ResponseDatetimeRfc1123Preparer(ctx context.Context,
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/header/response/prim/datetimerfc1123"),
 		autorest.WithHeader("scenario", autorest.String(scenario)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ResponseDatetimeRfc1123Sender sends the ResponseDatetimeRfc1123 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ResponseDatetimeRfc1123Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ResponseDatetimeRfc1123Responder handles the response to the ResponseDatetimeRfc1123 request. The method always
 // closes the http.Response Body.
-func (client HeaderClient) ResponseDatetimeRfc1123Responder(resp *http.Response) (result autorest.Response, err error) {
+func (client HeaderClient) ResponseDatetimeRfc1123Responder(resp *http.Response) (result HeaderResponseDatetimeRfc1123Result, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
 // ResponseDouble get a response with header value "value": 7e120 or -3.0
 // Parameters:
 // scenario - send a post request with header values "scenario": "positive" or "negative"
-func (client HeaderClient) ResponseDouble(ctx context.Context, scenario string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ResponseDouble")
+func (client HeaderClient) ResponseDouble(ctx context.Context, scenario string) (result HeaderResponseDoubleResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ResponseDouble", "POST", "/header/response/prim/double")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ResponseDouble", map[string]interface{}{
+			"scenario": scenario,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.ResponseDoublePreparer(ctx, scenario)
@@ -1381,13 +1517,13 @@ func (client HeaderClient) ResponseDouble(ctx context.Context, scenario string)
 
 	resp, err := client.ResponseDoubleSender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseDouble", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.ResponseDoubleResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseDouble", resp, "Failure responding to request")
 	}
 
@@ -1401,40 +1537,48 @@ func (client HeaderClient) ResponseDoublePreparer(ctx context.Context, scenario
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/header/response/prim/double"),
 		autorest.WithHeader("scenario", autorest.String(scenario)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ResponseDoubleSender sends the ResponseDouble request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ResponseDoubleSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ResponseDoubleResponder handles the response to the ResponseDouble request. The method always
 // closes the http.Response Body.
-func (client HeaderClient) ResponseDoubleResponder(resp *http.Response) (result autorest.Response, err error) {
+func (client HeaderClient) ResponseDoubleResponder(resp *http.Response) (result HeaderResponseDoubleResult, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
 // ResponseDuration get a response with header values "P123DT22H14M12.011S"
 // Parameters:
 // scenario - send a post request with header values "scenario": "valid"
-func (client HeaderClient) ResponseDuration(ctx context.Context, scenario string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ResponseDuration")
+func (client HeaderClient) ResponseDuration(ctx context.Context, scenario string) (result HeaderResponseDurationResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ResponseDuration", "POST", "/header/response/prim/duration")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ResponseDuration", map[string]interface{}{
+			"scenario": scenario,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.ResponseDurationPreparer(ctx, scenario)
@@ -1445,13 +1589,13 @@ func (client HeaderClient) ResponseDuration(ctx context.Context, scenario string
 
 	resp, err := client.ResponseDurationSender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseDuration", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.ResponseDurationResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseDuration", resp, "Failure responding to request")
 	}
 
@@ -1465,40 +1609,48 @@ func (client HeaderClient) ResponseDurationPreparer(ctx context.Context, scenari
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/header/response/prim/duration"),
 		autorest.WithHeader("scenario", autorest.String(scenario)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ResponseDurationSender sends the ResponseDuration request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ResponseDurationSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ResponseDurationResponder handles the response to the ResponseDuration request. The method always
 // closes the http.Response Body.
-func (client HeaderClient) ResponseDurationResponder(resp *http.Response) (result autorest.Response, err error) {
+func (client HeaderClient) ResponseDurationResponder(resp *http.Response) (result HeaderResponseDurationResult, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
 // ResponseEnum get a response with header values "GREY" or null
 // Parameters:
 // scenario - send a post request with header values "scenario": "valid" or "null" or "empty"
-func (client HeaderClient) ResponseEnum(ctx context.Context, scenario string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ResponseEnum")
+func (client HeaderClient) ResponseEnum(ctx context.Context, scenario string) (result HeaderResponseEnumResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ResponseEnum", "POST", "/header/response/prim/enum")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ResponseEnum", map[string]interface{}{
+			"scenario": scenario,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.ResponseEnumPreparer(ctx, scenario)
@@ -1509,13 +1661,13 @@ func (client HeaderClient) ResponseEnum(ctx context.Context, scenario string) (r
 
 	resp, err := client.ResponseEnumSender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseEnum", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.ResponseEnumResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseEnum", resp, "Failure responding to request")
 	}
 
@@ -1529,38 +1681,44 @@ func (client HeaderClient) ResponseEnumPreparer(ctx context.Context, scenario st
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/header/response/prim/enum"),
 		autorest.WithHeader("scenario", autorest.String(scenario)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ResponseEnumSender sends the ResponseEnum request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ResponseEnumSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ResponseEnumResponder handles the response to the ResponseEnum request. The method always
 // closes the http.Response Body.
-func (client HeaderClient) ResponseEnumResponder(resp *http.Response) (result autorest.Response, err error) {
+func (client HeaderClient) ResponseEnumResponder(resp *http.Response) (result HeaderResponseEnumResult, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
 // ResponseExistingKey get a response with header value "User-Agent": "overwrite"
-func (client HeaderClient) ResponseExistingKey(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ResponseExistingKey")
+func (client HeaderClient) ResponseExistingKey(ctx context.Context) (result HeaderResponseExistingKeyResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ResponseExistingKey", "POST", "/header/response/existingkey")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ResponseExistingKey", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.ResponseExistingKeyPreparer(ctx)
@@ -1571,13 +1729,13 @@ func (client HeaderClient) ResponseExistingKey(ctx context.Context) (result auto
 
 	resp, err := client.ResponseExistingKeySender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseExistingKey", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.ResponseExistingKeyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseExistingKey", resp, "Failure responding to request")
 	}
 
@@ -1590,40 +1748,48 @@ func (client HeaderClient) ResponseExistingKeyPreparer(ctx context.Context) (*ht
 		autorest.AsPost(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/header/response/existingkey"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ResponseExistingKeySender sends the ResponseExistingKey request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ResponseExistingKeySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ResponseExistingKeyResponder handles the response to the ResponseExistingKey request. The method always
 // closes the http.Response Body.
-func (client HeaderClient) ResponseExistingKeyResponder(resp *http.Response) (result autorest.Response, err error) {
+func (client HeaderClient) ResponseExistingKeyResponder(resp *http.Response) (result HeaderResponseExistingKeyResult, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
 // ResponseFloat get a response with header value "value": 0.07 or -3.0
 // Parameters:
 // scenario - send a post request with header values "scenario": "positive" or "negative"
-func (client HeaderClient) ResponseFloat(ctx context.Context, scenario string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ResponseFloat")
+func (client HeaderClient) ResponseFloat(ctx context.Context, scenario string) (result HeaderResponseFloatResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ResponseFloat", "POST", "/header/response/prim/float")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ResponseFloat", map[string]interface{}{
+			"scenario": scenario,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.ResponseFloatPreparer(ctx, scenario)
@@ -1634,13 +1800,13 @@ func (client HeaderClient) ResponseFloat(ctx context.Context, scenario string) (
 
 	resp, err := client.ResponseFloatSender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseFloat", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.ResponseFloatResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseFloat", resp, "Failure responding to request")
 	}
 
@@ -1654,40 +1820,48 @@ func (client HeaderClient) ResponseFloatPreparer(ctx context.Context, scenario s
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/header/response/prim/float"),
 		autorest.WithHeader("scenario", autorest.String(scenario)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ResponseFloatSender sends the ResponseFloat request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ResponseFloatSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ResponseFloatResponder handles the response to the ResponseFloat request. The method always
 // closes the http.Response Body.
-func (client HeaderClient) ResponseFloatResponder(resp *http.Response) (result autorest.Response, err error) {
+func (client HeaderClient) ResponseFloatResponder(resp *http.Response) (result HeaderResponseFloatResult, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
 // ResponseInteger get a response with header value "value": 1 or -2
 // Parameters:
 // scenario - send a post request with header values "scenario": "positive" or "negative"
-func (client HeaderClient) ResponseInteger(ctx context.Context, scenario string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ResponseInteger")
+func (client HeaderClient) ResponseInteger(ctx context.Context, scenario string) (result HeaderResponseIntegerResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ResponseInteger", "POST", "/header/response/prim/integer")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ResponseInteger", map[string]interface{}{
+			"scenario": scenario,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.ResponseIntegerPreparer(ctx, scenario)
@@ -1698,13 +1872,13 @@ func (client HeaderClient) ResponseInteger(ctx context.Context, scenario string)
 
 	resp, err := client.ResponseIntegerSender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseInteger", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.ResponseIntegerResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseInteger", resp, "Failure responding to request")
 	}
 
@@ -1718,40 +1892,48 @@ func (client HeaderClient) ResponseIntegerPreparer(ctx context.Context, scenario
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/header/response/prim/integer"),
 		autorest.WithHeader("scenario", autorest.String(scenario)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ResponseIntegerSender sends the ResponseInteger request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ResponseIntegerSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ResponseIntegerResponder handles the response to the ResponseInteger request. The method always
 // closes the http.Response Body.
-func (client HeaderClient) ResponseIntegerResponder(resp *http.Response) (result autorest.Response, err error) {
+func (client HeaderClient) ResponseIntegerResponder(resp *http.Response) (result HeaderResponseIntegerResult, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
 // ResponseLong get a response with header value "value": 105 or -2
 // Parameters:
 // scenario - send a post request with header values "scenario": "positive" or "negative"
-func (client HeaderClient) ResponseLong(ctx context.Context, scenario string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ResponseLong")
+func (client HeaderClient) ResponseLong(ctx context.Context, scenario string) (result HeaderResponseLongResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ResponseLong", "POST", "/header/response/prim/long")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ResponseLong", map[string]interface{}{
+			"scenario": scenario,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.ResponseLongPreparer(ctx, scenario)
@@ -1762,13 +1944,13 @@ func (client HeaderClient) ResponseLong(ctx context.Context, scenario string) (r
 
 	resp, err := client.ResponseLongSender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseLong", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.ResponseLongResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseLong", resp, "Failure responding to request")
 	}
 
@@ -1782,38 +1964,44 @@ func (client HeaderClient) ResponseLongPreparer(ctx context.Context, scenario st
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/header/response/prim/long"),
 		autorest.WithHeader("scenario", autorest.String(scenario)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ResponseLongSender sends the ResponseLong request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ResponseLongSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ResponseLongResponder handles the response to the ResponseLong request. The method always
 // closes the http.Response Body.
-func (client HeaderClient) ResponseLongResponder(resp *http.Response) (result autorest.Response, err error) {
+func (client HeaderClient) ResponseLongResponder(resp *http.Response) (result HeaderResponseLongResult, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
 // ResponseProtectedKey get a response with header value "Content-Type": "text/html"
-func (client HeaderClient) ResponseProtectedKey(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ResponseProtectedKey")
+func (client HeaderClient) ResponseProtectedKey(ctx context.Context) (result HeaderResponseProtectedKeyResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ResponseProtectedKey", "POST", "/header/response/protectedkey")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ResponseProtectedKey", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.ResponseProtectedKeyPreparer(ctx)
@@ -1824,13 +2012,13 @@ func (client HeaderClient) ResponseProtectedKey(ctx context.Context) (result aut
 
 	resp, err := client.ResponseProtectedKeySender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseProtectedKey", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.ResponseProtectedKeyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseProtectedKey", resp, "Failure responding to request")
 	}
 
@@ -1843,40 +2031,48 @@ func (client HeaderClient) ResponseProtectedKeyPreparer(ctx context.Context) (*h
 		autorest.AsPost(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/header/response/protectedkey"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ResponseProtectedKeySender sends the ResponseProtectedKey request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ResponseProtectedKeySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ResponseProtectedKeyResponder handles the response to the ResponseProtectedKey request. The method always
 // closes the http.Response Body.
-func (client HeaderClient) ResponseProtectedKeyResponder(resp *http.Response) (result autorest.Response, err error) {
+func (client HeaderClient) ResponseProtectedKeyResponder(resp *http.Response) (result HeaderResponseProtectedKeyResult, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
 // ResponseString get a response with header values "The quick brown fox jumps over the lazy dog" or null or ""
 // Parameters:
 // scenario - send a post request with header values "scenario": "valid" or "null" or "empty"
-func (client HeaderClient) ResponseString(ctx context.Context, scenario string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HeaderClient.ResponseString")
+func (client HeaderClient) ResponseString(ctx context.Context, scenario string) (result HeaderResponseStringResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HeaderClient.ResponseString", "POST", "/header/response/prim/string")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HeaderClient.ResponseString", map[string]interface{}{
+			"scenario": scenario,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.ResponseStringPreparer(ctx, scenario)
@@ -1887,13 +2083,13 @@ func (client HeaderClient) ResponseString(ctx context.Context, scenario string)
 
 	resp, err := client.ResponseStringSender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseString", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.ResponseStringResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "headergroup.HeaderClient", "ResponseString", resp, "Failure responding to request")
 	}
 
@@ -1907,24 +2103,28 @@ func (client HeaderClient) ResponseStringPreparer(ctx context.Context, scenario
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/header/response/prim/string"),
 		autorest.WithHeader("scenario", autorest.String(scenario)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ResponseStringSender sends the ResponseString request. The method will close the
 // http.Response Body if it receives an error.
 func (client HeaderClient) ResponseStringSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ResponseStringResponder handles the response to the ResponseString request. The method always
 // closes the http.Response Body.
-func (client HeaderClient) ResponseStringResponder(resp *http.Response) (result autorest.Response, err error) {
+func (client HeaderClient) ResponseStringResponder(resp *http.Response) (result HeaderResponseStringResult, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
@@ -30,20 +30,20 @@ type HeaderClientAPI interface {
 	ParamLong(ctx context.Context, scenario string, value int64) (result autorest.Response, err error)
 	ParamProtectedKey(ctx context.Context, contentType string) (result autorest.Response, err error)
 	ParamString(ctx context.Context, scenario string, value string) (result autorest.Response, err error)
-	ResponseBool(ctx context.Context, scenario string) (result autorest.Response, err error)
-	ResponseByte(ctx context.Context, scenario string) (result autorest.Response, err error)
-	ResponseDate(ctx context.Context, scenario string) (result autorest.Response, err error)
-	ResponseDatetime(ctx context.Context, scenario string) (result autorest.Response, err error)
-	ResponseDatetimeRfc1123(ctx context.Context, scenario string) (result autorest.Response, err error)
-	ResponseDouble(ctx context.Context, scenario string) (result autorest.Response, err error)
-	ResponseDuration(ctx context.Context, scenario string) (result autorest.Response, err error)
-	ResponseEnum(ctx context.Context, scenario string) (result autorest.Response, err error)
-	ResponseExistingKey(ctx context.Context) (result autorest.Response, err error)
-	ResponseFloat(ctx context.Context, scenario string) (result autorest.Response, err error)
-	ResponseInteger(ctx context.Context, scenario string) (result autorest.Response, err error)
-	ResponseLong(ctx context.Context, scenario string) (result autorest.Response, err error)
-	ResponseProtectedKey(ctx context.Context) (result autorest.Response, err error)
-	ResponseString(ctx context.Context, scenario string) (result autorest.Response, err error)
+	ResponseBool(ctx context.Context, scenario string) (result headergroup.HeaderResponseBoolResult, err error)
+	ResponseByte(ctx context.Context, scenario string) (result headergroup.HeaderResponseByteResult, err error)
+	ResponseDate(ctx context.Context, scenario string) (result headergroup.HeaderResponseDateResult, err error)
+	ResponseDatetime(ctx context.Context, scenario string) (result headergroup.HeaderResponseDatetimeResult, err error)
+	ResponseDatetimeRfc1123(ctx context.Context, scenario string) (result headergroup.HeaderResponseDatetimeRfc1123Result, err error)
+	ResponseDouble(ctx context.Context, scenario string) (result headergroup.HeaderResponseDoubleResult, err error)
+	ResponseDuration(ctx context.Context, scenario string) (result headergroup.HeaderResponseDurationResult, err error)
+	ResponseEnum(ctx context.Context, scenario string) (result headergroup.HeaderResponseEnumResult, err error)
+	ResponseExistingKey(ctx context.Context) (result headergroup.HeaderResponseExistingKeyResult, err error)
+	ResponseFloat(ctx context.Context, scenario string) (result headergroup.HeaderResponseFloatResult, err error)
+	ResponseInteger(ctx context.Context, scenario string) (result headergroup.HeaderResponseIntegerResult, err error)
+	ResponseLong(ctx context.Context, scenario string) (result headergroup.HeaderResponseLongResult, err error)
+	ResponseProtectedKey(ctx context.Context) (result headergroup.HeaderResponseProtectedKeyResult, err error)
+	ResponseString(ctx context.Context, scenario string) (result headergroup.HeaderResponseStringResult, err error)
 }
 
 var _ HeaderClientAPI = (*headergroup.HeaderClient)(nil)
@@ -9,8 +9,6 @@ package httpinfrastructuregroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -29,18 +27,27 @@ func NewHTTPClientFailureClientWithBaseURI(baseURI string) HTTPClientFailureClie
 	return HTTPClientFailureClient{NewWithBaseURI(baseURI)}
 }
 
+// NewHTTPClientFailureClientWithOptions creates an instance of the HTTPClientFailureClient client with the specified
+// options.  It returns an error if the options are invalid.
+func NewHTTPClientFailureClientWithOptions(opts ClientOptions) (HTTPClientFailureClient, error) {
+	client, err := NewWithOptions(opts)
+	return HTTPClientFailureClient{client}, err
+}
+
 // Delete400 return 400 status code - should be represented in the client as an error
 // Parameters:
 // booleanValue - simple boolean value true
 func (client HTTPClientFailureClient) Delete400(ctx context.Context, booleanValue *bool) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPClientFailureClient.Delete400")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPClientFailureClient.Delete400", "DELETE", "/http/failure/client/400")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPClientFailureClient.Delete400", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Delete400Preparer(ctx, booleanValue)
@@ -57,7 +64,7 @@ func (client HTTPClientFailureClient) Delete400(ctx context.Context, booleanValu
 	}
 
 	result, err = client.Delete400Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPClientFailureClient", "Delete400", resp, "Failure responding to request")
 	}
 
@@ -75,14 +82,15 @@ func (client HTTPClientFailureClient) Delete400Preparer(ctx context.Context, boo
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Delete400Sender sends the Delete400 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPClientFailureClient) Delete400Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Delete400Responder handles the response to the Delete400 request. The method always
@@ -91,7 +99,7 @@ func (client HTTPClientFailureClient) Delete400Responder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -102,14 +110,16 @@ func (client HTTPClientFailureClient) Delete400Responder(resp *http.Response) (r
 // Parameters:
 // booleanValue - simple boolean value true
 func (client HTTPClientFailureClient) Delete407(ctx context.Context, booleanValue *bool) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPClientFailureClient.Delete407")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPClientFailureClient.Delete407", "DELETE", "/http/failure/client/407")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPClientFailureClient.Delete407", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Delete407Preparer(ctx, booleanValue)
@@ -126,7 +136,7 @@ func (client HTTPClientFailureClient) Delete407(ctx context.Context, booleanValu
 	}
 
 	result, err = client.Delete407Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPClientFailureClient", "Delete407", resp, "Failure responding to request")
 	}
 
@@ -144,14 +154,15 @@ func (client HTTPClientFailureClient) Delete407Preparer(ctx context.Context, boo
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Delete407Sender sends the Delete407 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPClientFailureClient) Delete407Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Delete407Responder handles the response to the Delete407 request. The method always
@@ -160,7 +171,7 @@ func (client HTTPClientFailureClient) Delete407Responder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -171,14 +182,16 @@ func (client HTTPClientFailureClient) Delete407Responder(resp *http.Response) (r
 // Parameters:
 // booleanValue - simple boolean value true
 func (client HTTPClientFailureClient) Delete417(ctx context.Context, booleanValue *bool) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPClientFailureClient.Delete417")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPClientFailureClient.Delete417", "DELETE", "/http/failure/client/417")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPClientFailureClient.Delete417", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Delete417Preparer(ctx, booleanValue)
@@ -195,7 +208,7 @@ func (client HTTPClientFailureClient) Delete417(ctx context.Context, booleanValu
 	}
 
 	result, err = client.Delete417Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPClientFailureClient", "Delete417", resp, "Failure responding to request")
 	}
 
@@ -213,14 +226,15 @@ func (client HTTPClientFailureClient) Delete417Preparer(ctx context.Context, boo
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Delete417Sender sends the Delete417 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPClientFailureClient) Delete417Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Delete417Responder handles the response to the Delete417 request. The method always
@@ -229,7 +243,7 @@ func (client HTTPClientFailureClient) Delete417Responder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -238,14 +252,16 @@ func (client HTTPClientFailureClient) Delete417Responder(resp *http.Response) (r
 
 // Get400 return 400 status code - should be represented in the client as an error
 func (client HTTPClientFailureClient) Get400(ctx context.Context) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPClientFailureClient.Get400")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPClientFailureClient.Get400", "GET", "/http/failure/client/400")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPClientFailureClient.Get400", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get400Preparer(ctx)
@@ -262,7 +278,7 @@ func (client HTTPClientFailureClient) Get400(ctx context.Context) (result Error,
 	}
 
 	result, err = client.Get400Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPClientFailureClient", "Get400", resp, "Failure responding to request")
 	}
 
@@ -275,14 +291,15 @@ func (client HTTPClientFailureClient) Get400Preparer(ctx context.Context) (*http
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/failure/client/400"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get400Sender sends the Get400 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPClientFailureClient) Get400Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get400Responder handles the response to the Get400 request. The method always
@@ -291,7 +308,7 @@ func (client HTTPClientFailureClient) Get400Responder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -300,14 +317,16 @@ func (client HTTPClientFailureClient) Get400Responder(resp *http.Response) (resu
 
 // Get402 return 402 status code - should be represented in the client as an error
 func (client HTTPClientFailureClient) Get402(ctx context.Context) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPClientFailureClient.Get402")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPClientFailureClient.Get402", "GET", "/http/failure/client/402")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPClientFailureClient.Get402", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get402Preparer(ctx)
@@ -324,7 +343,7 @@ func (client HTTPClientFailureClient) Get402(ctx context.Context) (result Error,
 	}
 
 	result, err = client.Get402Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPClientFailureClient", "Get402", resp, "Failure responding to request")
 	}
 
@@ -337,14 +356,15 @@ func (client HTTPClientFailureClient) Get402Preparer(ctx context.Context) (*http
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/failure/client/402"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get402Sender sends the Get402 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPClientFailureClient) Get402Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get402Responder handles the response to the Get402 request. The method always
@@ -353,7 +373,7 @@ func (client HTTPClientFailureClient) Get402Responder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -362,14 +382,16 @@ func (client HTTPClientFailureClient) Get402Responder(resp *http.Response) (resu
 
 // Get403 return 403 status code - should be represented in the client as an error
 func (client HTTPClientFailureClient) Get403(ctx context.Context) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPClientFailureClient.Get403")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPClientFailureClient.Get403", "GET", "/http/failure/client/403")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPClientFailureClient.Get403", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get403Preparer(ctx)
@@ -386,7 +408,7 @@ func (client HTTPClientFailureClient) Get403(ctx context.Context) (result Error,
 	}
 
 	result, err = client.Get403Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPClientFailureClient", "Get403", resp, "Failure responding to request")
 	}
 
@@ -399,14 +421,15 @@ func (client HTTPClientFailureClient) Get403Preparer(ctx context.Context) (*http
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/failure/client/403"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get403Sender sends the Get403 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPClientFailureClient) Get403Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get403Responder handles the response to the Get403 request. The method always
@@ -415,7 +438,7 @@ func (client HTTPClientFailureClient) Get403Responder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -424,14 +447,16 @@ func (client HTTPClientFailureClient) Get403Responder(resp *http.Response) (resu
 
 // Get411 return 411 status code - should be represented in the client as an error
 func (client HTTPClientFailureClient) Get411(ctx context.Context) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPClientFailureClient.Get411")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPClientFailureClient.Get411", "GET", "/http/failure/client/411")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPClientFailureClient.Get411", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get411Preparer(ctx)
@@ -448,7 +473,7 @@ func (client HTTPClientFailureClient) Get411(ctx context.Context) (result Error,
 	}
 
 	result, err = client.Get411Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPClientFailureClient", "Get411", resp, "Failure responding to request")
 	}
 
@@ -461,14 +486,15 @@ func (client HTTPClientFailureClient) Get411Preparer(ctx context.Context) (*http
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/failure/client/411"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get411Sender sends the Get411 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPClientFailureClient) Get411Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get411Responder handles the response to the Get411 request. The method always
@@ -477,7 +503,7 @@ func (client HTTPClientFailureClient) Get411Responder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -486,14 +512,16 @@ func (client HTTPClientFailureClient) Get411Responder(resp *http.Response) (resu
 
 // Get412 return 412 status code - should be represented in the client as an error
 func (client HTTPClientFailureClient) Get412(ctx context.Context) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPClientFailureClient.Get412")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPClientFailureClient.Get412", "GET", "/http/failure/client/412")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPClientFailureClient.Get412", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get412Preparer(ctx)
@@ -510,7 +538,7 @@ func (client HTTPClientFailureClient) Get412(ctx context.Context) (result Error,
 	}
 
 	result, err = client.Get412Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPClientFailureClient", "Get412", resp, "Failure responding to request")
 	}
 
@@ -523,14 +551,15 @@ func (client HTTPClientFailureClient) Get412Preparer(ctx context.Context) (*http
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/failure/client/412"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get412Sender sends the Get412 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPClientFailureClient) Get412Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get412Responder handles the response to the Get412 request. The method always
@@ -539,7 +568,7 @@ func (client HTTPClientFailureClient) Get412Responder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -548,14 +577,16 @@ func (client HTTPClientFailureClient) Get412Responder(resp *http.Response) (resu
 
 // Get416 return 416 status code - should be represented in the client as an error
 func (client HTTPClientFailureClient) Get416(ctx context.Context) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPClientFailureClient.Get416")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPClientFailureClient.Get416", "GET", "/http/failure/client/416")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPClientFailureClient.Get416", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get416Preparer(ctx)
@@ -572,7 +603,7 @@ func (client HTTPClientFailureClient) Get416(ctx context.Context) (result Error,
 	}
 
 	result, err = client.Get416Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPClientFailureClient", "Get416", resp, "Failure responding to request")
 	}
 
@@ -585,14 +616,15 @@ func (client HTTPClientFailureClient) Get416Preparer(ctx context.Context) (*http
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/failure/client/416"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get416Sender sends the Get416 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPClientFailureClient) Get416Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get416Responder handles the response to the Get416 request. The method always
@@ -601,7 +633,7 @@ func (client HTTPClientFailureClient) Get416Responder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -610,14 +642,16 @@ func (client HTTPClientFailureClient) Get416Responder(resp *http.Response) (resu
 
 // Head400 return 400 status code - should be represented in the client as an error
 func (client HTTPClientFailureClient) Head400(ctx context.Context) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPClientFailureClient.Head400")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPClientFailureClient.Head400", "HEAD", "/http/failure/client/400")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPClientFailureClient.Head400", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Head400Preparer(ctx)
@@ -634,7 +668,7 @@ func (client HTTPClientFailureClient) Head400(ctx context.Context) (result Error
 	}
 
 	result, err = client.Head400Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPClientFailureClient", "Head400", resp, "Failure responding to request")
 	}
 
@@ -647,14 +681,15 @@ func (client HTTPClientFailureClient) Head400Preparer(ctx context.Context) (*htt
 		autorest.AsHead(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/failure/client/400"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Head400Sender sends the Head400 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPClientFailureClient) Head400Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Head400Responder handles the response to the Head400 request. The method always
@@ -663,7 +698,7 @@ func (client HTTPClientFailureClient) Head400Responder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -672,14 +707,16 @@ func (client HTTPClientFailureClient) Head400Responder(resp *http.Response) (res
 
 // Head401 return 401 status code - should be represented in the client as an error
 func (client HTTPClientFailureClient) Head401(ctx context.Context) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPClientFailureClient.Head401")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPClientFailureClient.Head401", "HEAD", "/http/failure/client/401")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPClientFailureClient.Head401", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Head401Preparer(ctx)
@@ -696,7 +733,7 @@ func (client HTTPClientFailureClient) Head401(ctx context.Context) (result Error
 	}
 
 	result, err = client.Head401Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPClientFailureClient", "Head401", resp, "Failure responding to request")
 	}
 
@@ -709,14 +746,15 @@ func (client HTTPClientFailureClient) Head401Preparer(ctx context.Context) (*htt
 		autorest.AsHead(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/failure/client/401"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Head401Sender sends the Head401 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPClientFailureClient) Head401Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Head401Responder handles the response to the Head401 request. The method always
@@ -725,7 +763,7 @@ func (client HTTPClientFailureClient) Head401Responder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -734,14 +772,16 @@ func (client HTTPClientFailureClient) Head401Responder(resp *http.Response) (res
 
 // Head410 return 410 status code - should be represented in the client as an error
 func (client HTTPClientFailureClient) Head410(ctx context.Context) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPClientFailureClient.Head410")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPClientFailureClient.Head410", "HEAD", "/http/failure/client/410")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPClientFailureClient.Head410", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Head410Preparer(ctx)
@@ -758,7 +798,7 @@ func (client HTTPClientFailureClient) Head410(ctx context.Context) (result Error
 	}
 
 	result, err = client.Head410Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPClientFailureClient", "Head410", resp, "Failure responding to request")
 	}
 
@@ -771,14 +811,15 @@ func (client HTTPClientFailureClient) Head410Preparer(ctx context.Context) (*htt
 		autorest.AsHead(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/failure/client/410"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Head410Sender sends the Head410 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPClientFailureClient) Head410Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Head410Responder handles the response to the Head410 request. The method always
@@ -787,7 +828,7 @@ func (client HTTPClientFailureClient) Head410Responder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -796,14 +837,16 @@ func (client HTTPClientFailureClient) Head410Responder(resp *http.Response) (res
 
 // Head429 return 429 status code - should be represented in the client as an error
 func (client HTTPClientFailureClient) Head429(ctx context.Context) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPClientFailureClient.Head429")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPClientFailureClient.Head429", "HEAD", "/http/failure/client/429")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPClientFailureClient.Head429", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Head429Preparer(ctx)
@@ -820,7 +863,7 @@ func (client HTTPClientFailureClient) Head429(ctx context.Context) (result Error
 	}
 
 	result, err = client.Head429Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPClientFailureClient", "Head429", resp, "Failure responding to request")
 	}
 
@@ -833,14 +876,15 @@ func (client HTTPClientFailureClient) Head429Preparer(ctx context.Context) (*htt
 		autorest.AsHead(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/failure/client/429"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Head429Sender sends the Head429 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPClientFailureClient) Head429Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Head429Responder handles the response to the Head429 request. The method always
@@ -849,7 +893,7 @@ func (client HTTPClientFailureClient) Head429Responder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -860,14 +904,16 @@ func (client HTTPClientFailureClient) Head429Responder(resp *http.Response) (res
 // Parameters:
 // booleanValue - simple boolean value true
 func (client HTTPClientFailureClient) Patch400(ctx context.Context, booleanValue *bool) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPClientFailureClient.Patch400")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPClientFailureClient.Patch400", "PATCH", "/http/failure/client/400")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPClientFailureClient.Patch400", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Patch400Preparer(ctx, booleanValue)
@@ -884,7 +930,7 @@ func (client HTTPClientFailureClient) Patch400(ctx context.Context, booleanValue
 	}
 
 	result, err = client.Patch400Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPClientFailureClient", "Patch400", resp, "Failure responding to request")
 	}
 
@@ -902,14 +948,15 @@ func (client HTTPClientFailureClient) Patch400Preparer(ctx context.Context, bool
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Patch400Sender sends the Patch400 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPClientFailureClient) Patch400Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Patch400Responder handles the response to the Patch400 request. The method always
@@ -918,7 +965,7 @@ func (client HTTPClientFailureClient) Patch400Responder(resp *http.Response) (re
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -929,14 +976,16 @@ func (client HTTPClientFailureClient) Patch400Responder(resp *http.Response) (re
 // Parameters:
 // booleanValue - simple boolean value true
 func (client HTTPClientFailureClient) Patch405(ctx context.Context, booleanValue *bool) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPClientFailureClient.Patch405")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPClientFailureClient.Patch405", "PATCH", "/http/failure/client/405")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPClientFailureClient.Patch405", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Patch405Preparer(ctx, booleanValue)
@@ -953,7 +1002,7 @@ func (client HTTPClientFailureClient) Patch405(ctx context.Context, booleanValue
 	}
 
 	result, err = client.Patch405Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPClientFailureClient", "Patch405", resp, "Failure responding to request")
 	}
 
@@ -971,14 +1020,15 @@ func (client HTTPClientFailureClient) Patch405Preparer(ctx context.Context, bool
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Patch405Sender sends the Patch405 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPClientFailureClient) Patch405Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Patch405Responder handles the response to the Patch405 request. The method always
@@ -987,7 +1037,7 @@ func (client HTTPClientFailureClient) Patch405Responder(resp *http.Response) (re
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -998,14 +1048,16 @@ func (client HTTPClientFailureClient) Patch405Responder(resp *http.Response) (re
 // Parameters:
 // booleanValue - simple boolean value true
 func (client HTTPClientFailureClient) Patch414(ctx context.Context, booleanValue *bool) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPClientFailureClient.Patch414")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPClientFailureClient.Patch414", "PATCH", "/http/failure/client/414")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPClientFailureClient.Patch414", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Patch414Preparer(ctx, booleanValue)
@@ -1022,7 +1074,7 @@ func (client HTTPClientFailureClient) Patch414(ctx context.Context, booleanValue
 	}
 
 	result, err = client.Patch414Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPClientFailureClient", "Patch414", resp, "Failure responding to request")
 	}
 
@@ -1040,14 +1092,15 @@ func (client HTTPClientFailureClient) Patch414Preparer(ctx context.Context, bool
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Patch414Sender sends the Patch414 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPClientFailureClient) Patch414Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Patch414Responder handles the response to the Patch414 request. The method always
@@ -1056,7 +1109,7 @@ func (client HTTPClientFailureClient) Patch414Responder(resp *http.Response) (re
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1067,14 +1120,16 @@ func (client HTTPClientFailureClient) Patch414Responder(resp *http.Response) (re
 // Parameters:
 // booleanValue - simple boolean value true
 func (client HTTPClientFailureClient) Post400(ctx context.Context, booleanValue *bool) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPClientFailureClient.Post400")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPClientFailureClient.Post400", "POST", "/http/failure/client/400")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPClientFailureClient.Post400", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Post400Preparer(ctx, booleanValue)
@@ -1091,7 +1146,7 @@ func (client HTTPClientFailureClient) Post400(ctx context.Context, booleanValue
 	}
 
 	result, err = client.Post400Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPClientFailureClient", "Post400", resp, "Failure responding to request")
 	}
 
@@ -1109,14 +1164,15 @@ func (client HTTPClientFailureClient) Post400Preparer(ctx context.Context, boole
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Post400Sender sends the Post400 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPClientFailureClient) Post400Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Post400Responder handles the response to the Post400 request. The method always
@@ -1125,7 +1181,7 @@ func (client HTTPClientFailureClient) Post400Responder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1136,14 +1192,16 @@ func (client HTTPClientFailureClient) Post400Responder(resp *http.Response) (res
 // Parameters:
 // booleanValue - simple boolean value true
 func (client HTTPClientFailureClient) Post406(ctx context.Context, booleanValue *bool) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPClientFailureClient.Post406")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPClientFailureClient.Post406", "POST", "/http/failure/client/406")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPClientFailureClient.Post406", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Post406Preparer(ctx, booleanValue)
@@ -1160,7 +1218,7 @@ func (client HTTPClientFailureClient) Post406(ctx context.Context, booleanValue
 	}
 
 	result, err = client.Post406Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPClientFailureClient", "Post406", resp, "Failure responding to request")
 	}
 
@@ -1178,14 +1236,15 @@ func (client HTTPClientFailureClient) Post406Preparer(ctx context.Context, boole
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Post406Sender sends the Post406 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPClientFailureClient) Post406Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Post406Responder handles the response to the Post406 request. The method always
@@ -1194,7 +1253,7 @@ func (client HTTPClientFailureClient) Post406Responder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1205,14 +1264,16 @@ func (client HTTPClientFailureClient) Post406Responder(resp *http.Response) (res
 // Parameters:
 // booleanValue - simple boolean value true
 func (client HTTPClientFailureClient) Post415(ctx context.Context, booleanValue *bool) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPClientFailureClient.Post415")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPClientFailureClient.Post415", "POST", "/http/failure/client/415")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPClientFailureClient.Post415", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Post415Preparer(ctx, booleanValue)
@@ -1229,7 +1290,7 @@ func (client HTTPClientFailureClient) Post415(ctx context.Context, booleanValue
 	}
 
 	result, err = client.Post415Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPClientFailureClient", "Post415", resp, "Failure responding to request")
 	}
 
@@ -1247,14 +1308,15 @@ func (client HTTPClientFailureClient) Post415Preparer(ctx context.Context, boole
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Post415Sender sends the Post415 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPClientFailureClient) Post415Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Post415Responder handles the response to the Post415 request. The method always
@@ -1263,7 +1325,7 @@ func (client HTTPClientFailureClient) Post415Responder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1274,14 +1336,16 @@ func (client HTTPClientFailureClient) Post415Responder(resp *http.Response) (res
 // Parameters:
 // booleanValue - simple boolean value true
 func (client HTTPClientFailureClient) Put400(ctx context.Context, booleanValue *bool) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPClientFailureClient.Put400")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPClientFailureClient.Put400", "PUT", "/http/failure/client/400")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPClientFailureClient.Put400", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Put400Preparer(ctx, booleanValue)
@@ -1298,7 +1362,7 @@ func (client HTTPClientFailureClient) Put400(ctx context.Context, booleanValue *
 	}
 
 	result, err = client.Put400Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPClientFailureClient", "Put400", resp, "Failure responding to request")
 	}
 
@@ -1316,14 +1380,15 @@ func (client HTTPClientFailureClient) Put400Preparer(ctx context.Context, boolea
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Put400Sender sends the Put400 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPClientFailureClient) Put400Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Put400Responder handles the response to the Put400 request. The method always
@@ -1332,7 +1397,7 @@ func (client HTTPClientFailureClient) Put400Responder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1343,14 +1408,16 @@ func (client HTTPClientFailureClient) Put400Responder(resp *http.Response) (resu
 // Parameters:
 // booleanValue - simple boolean value true
 func (client HTTPClientFailureClient) Put404(ctx context.Context, booleanValue *bool) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPClientFailureClient.Put404")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPClientFailureClient.Put404", "PUT", "/http/failure/client/404")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPClientFailureClient.Put404", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Put404Preparer(ctx, booleanValue)
@@ -1367,7 +1434,7 @@ func (client HTTPClientFailureClient) Put404(ctx context.Context, booleanValue *
 	}
 
 	result, err = client.Put404Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPClientFailureClient", "Put404", resp, "Failure responding to request")
 	}
 
@@ -1385,14 +1452,15 @@ func (client HTTPClientFailureClient) Put404Preparer(ctx context.Context, boolea
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Put404Sender sends the Put404 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPClientFailureClient) Put404Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Put404Responder handles the response to the Put404 request. The method always
@@ -1401,7 +1469,7 @@ func (client HTTPClientFailureClient) Put404Responder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1412,14 +1480,16 @@ func (client HTTPClientFailureClient) Put404Responder(resp *http.Response) (resu
 // Parameters:
 // booleanValue - simple boolean value true
 func (client HTTPClientFailureClient) Put409(ctx context.Context, booleanValue *bool) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPClientFailureClient.Put409")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPClientFailureClient.Put409", "PUT", "/http/failure/client/409")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPClientFailureClient.Put409", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Put409Preparer(ctx, booleanValue)
@@ -1436,7 +1506,7 @@ func (client HTTPClientFailureClient) Put409(ctx context.Context, booleanValue *
 	}
 
 	result, err = client.Put409Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPClientFailureClient", "Put409", resp, "Failure responding to request")
 	}
 
@@ -1454,14 +1524,15 @@ func (client HTTPClientFailureClient) Put409Preparer(ctx context.Context, boolea
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Put409Sender sends the Put409 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPClientFailureClient) Put409Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Put409Responder handles the response to the Put409 request. The method always
@@ -1470,7 +1541,7 @@ func (client HTTPClientFailureClient) Put409Responder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1481,14 +1552,16 @@ func (client HTTPClientFailureClient) Put409Responder(resp *http.Response) (resu
 // Parameters:
 // booleanValue - simple boolean value true
 func (client HTTPClientFailureClient) Put413(ctx context.Context, booleanValue *bool) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPClientFailureClient.Put413")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPClientFailureClient.Put413", "PUT", "/http/failure/client/413")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPClientFailureClient.Put413", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Put413Preparer(ctx, booleanValue)
@@ -1505,7 +1578,7 @@ func (client HTTPClientFailureClient) Put413(ctx context.Context, booleanValue *
 	}
 
 	result, err = client.Put413Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPClientFailureClient", "Put413", resp, "Failure responding to request")
 	}
 
@@ -1523,14 +1596,15 @@ func (client HTTPClientFailureClient) Put413Preparer(ctx context.Context, boolea
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Put413Sender sends the Put413 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPClientFailureClient) Put413Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Put413Responder handles the response to the Put413 request. The method always
@@ -1539,7 +1613,7 @@ func (client HTTPClientFailureClient) Put413Responder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
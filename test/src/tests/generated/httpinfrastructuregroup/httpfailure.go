@@ -9,8 +9,6 @@ package httpinfrastructuregroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -29,16 +27,25 @@ func NewHTTPFailureClientWithBaseURI(baseURI string) HTTPFailureClient {
 	return HTTPFailureClient{NewWithBaseURI(baseURI)}
 }
 
+// NewHTTPFailureClientWithOptions creates an instance of the HTTPFailureClient client with the specified options.  It
+// returns an error if the options are invalid.
+func NewHTTPFailureClientWithOptions(opts ClientOptions) (HTTPFailureClient, error) {
+	client, err := NewWithOptions(opts)
+	return HTTPFailureClient{client}, err
+}
+
 // GetEmptyError get empty error form server
 func (client HTTPFailureClient) GetEmptyError(ctx context.Context) (result Bool, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPFailureClient.GetEmptyError")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPFailureClient.GetEmptyError", "GET", "/http/failure/emptybody/error")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPFailureClient.GetEmptyError", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetEmptyErrorPreparer(ctx)
@@ -55,7 +62,7 @@ func (client HTTPFailureClient) GetEmptyError(ctx context.Context) (result Bool,
 	}
 
 	result, err = client.GetEmptyErrorResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPFailureClient", "GetEmptyError", resp, "Failure responding to request")
 	}
 
@@ -68,14 +75,15 @@ func (client HTTPFailureClient) GetEmptyErrorPreparer(ctx context.Context) (*htt
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/failure/emptybody/error"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetEmptyErrorSender sends the GetEmptyError request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPFailureClient) GetEmptyErrorSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetEmptyErrorResponder handles the response to the GetEmptyError request. The method always
@@ -84,7 +92,7 @@ func (client HTTPFailureClient) GetEmptyErrorResponder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -93,14 +101,16 @@ func (client HTTPFailureClient) GetEmptyErrorResponder(resp *http.Response) (res
 
 // GetNoModelEmpty get empty response from server
 func (client HTTPFailureClient) GetNoModelEmpty(ctx context.Context) (result Bool, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPFailureClient.GetNoModelEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPFailureClient.GetNoModelEmpty", "GET", "/http/failure/nomodel/empty")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPFailureClient.GetNoModelEmpty", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetNoModelEmptyPreparer(ctx)
@@ -117,7 +127,7 @@ func (client HTTPFailureClient) GetNoModelEmpty(ctx context.Context) (result Boo
 	}
 
 	result, err = client.GetNoModelEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPFailureClient", "GetNoModelEmpty", resp, "Failure responding to request")
 	}
 
@@ -130,14 +140,15 @@ func (client HTTPFailureClient) GetNoModelEmptyPreparer(ctx context.Context) (*h
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/failure/nomodel/empty"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetNoModelEmptySender sends the GetNoModelEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPFailureClient) GetNoModelEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetNoModelEmptyResponder handles the response to the GetNoModelEmpty request. The method always
@@ -146,7 +157,7 @@ func (client HTTPFailureClient) GetNoModelEmptyResponder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -155,14 +166,16 @@ func (client HTTPFailureClient) GetNoModelEmptyResponder(resp *http.Response) (r
 
 // GetNoModelError get empty error form server
 func (client HTTPFailureClient) GetNoModelError(ctx context.Context) (result Bool, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPFailureClient.GetNoModelError")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPFailureClient.GetNoModelError", "GET", "/http/failure/nomodel/error")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPFailureClient.GetNoModelError", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetNoModelErrorPreparer(ctx)
@@ -179,7 +192,7 @@ func (client HTTPFailureClient) GetNoModelError(ctx context.Context) (result Boo
 	}
 
 	result, err = client.GetNoModelErrorResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPFailureClient", "GetNoModelError", resp, "Failure responding to request")
 	}
 
@@ -192,14 +205,15 @@ func (client HTTPFailureClient) GetNoModelErrorPreparer(ctx context.Context) (*h
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/failure/nomodel/error"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetNoModelErrorSender sends the GetNoModelError request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPFailureClient) GetNoModelErrorSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetNoModelErrorResponder handles the response to the GetNoModelError request. The method always
@@ -208,7 +222,7 @@ func (client HTTPFailureClient) GetNoModelErrorResponder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
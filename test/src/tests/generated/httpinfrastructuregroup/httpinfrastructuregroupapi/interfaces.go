@@ -47,21 +47,21 @@ var _ HTTPSuccessClientAPI = (*httpinfrastructuregroup.HTTPSuccessClient)(nil)
 
 // HTTPRedirectsClientAPI contains the set of methods on the HTTPRedirectsClient type.
 type HTTPRedirectsClientAPI interface {
-	Delete307(ctx context.Context, booleanValue *bool) (result autorest.Response, err error)
-	Get300(ctx context.Context) (result httpinfrastructuregroup.ListString, err error)
-	Get301(ctx context.Context) (result autorest.Response, err error)
-	Get302(ctx context.Context) (result autorest.Response, err error)
-	Get307(ctx context.Context) (result autorest.Response, err error)
-	Head300(ctx context.Context) (result autorest.Response, err error)
-	Head301(ctx context.Context) (result autorest.Response, err error)
-	Head302(ctx context.Context) (result autorest.Response, err error)
-	Head307(ctx context.Context) (result autorest.Response, err error)
-	Patch302(ctx context.Context, booleanValue *bool) (result autorest.Response, err error)
-	Patch307(ctx context.Context, booleanValue *bool) (result autorest.Response, err error)
-	Post303(ctx context.Context, booleanValue *bool) (result autorest.Response, err error)
-	Post307(ctx context.Context, booleanValue *bool) (result autorest.Response, err error)
-	Put301(ctx context.Context, booleanValue *bool) (result autorest.Response, err error)
-	Put307(ctx context.Context, booleanValue *bool) (result autorest.Response, err error)
+	Delete307(ctx context.Context, booleanValue *bool) (result httpinfrastructuregroup.HTTPRedirectsDelete307Result, err error)
+	Get300(ctx context.Context) (result httpinfrastructuregroup.HTTPRedirectsGet300Result, err error)
+	Get301(ctx context.Context) (result httpinfrastructuregroup.HTTPRedirectsGet301Result, err error)
+	Get302(ctx context.Context) (result httpinfrastructuregroup.HTTPRedirectsGet302Result, err error)
+	Get307(ctx context.Context) (result httpinfrastructuregroup.HTTPRedirectsGet307Result, err error)
+	Head300(ctx context.Context) (result httpinfrastructuregroup.HTTPRedirectsHead300Result, err error)
+	Head301(ctx context.Context) (result httpinfrastructuregroup.HTTPRedirectsHead301Result, err error)
+	Head302(ctx context.Context) (result httpinfrastructuregroup.HTTPRedirectsHead302Result, err error)
+	Head307(ctx context.Context) (result httpinfrastructuregroup.HTTPRedirectsHead307Result, err error)
+	Patch302(ctx context.Context, booleanValue *bool) (result httpinfrastructuregroup.HTTPRedirectsPatch302Result, err error)
+	Patch307(ctx context.Context, booleanValue *bool) (result httpinfrastructuregroup.HTTPRedirectsPatch307Result, err error)
+	Post303(ctx context.Context, booleanValue *bool) (result httpinfrastructuregroup.HTTPRedirectsPost303Result, err error)
+	Post307(ctx context.Context, booleanValue *bool) (result httpinfrastructuregroup.HTTPRedirectsPost307Result, err error)
+	Put301(ctx context.Context, booleanValue *bool) (result httpinfrastructuregroup.HTTPRedirectsPut301Result, err error)
+	Put307(ctx context.Context, booleanValue *bool) (result httpinfrastructuregroup.HTTPRedirectsPut307Result, err error)
 }
 
 var _ HTTPRedirectsClientAPI = (*httpinfrastructuregroup.HTTPRedirectsClient)(nil)
@@ -121,9 +121,9 @@ var _ HTTPRetryClientAPI = (*httpinfrastructuregroup.HTTPRetryClient)(nil)
 
 // MultipleResponsesClientAPI contains the set of methods on the MultipleResponsesClient type.
 type MultipleResponsesClientAPI interface {
-	Get200Model201ModelDefaultError200Valid(ctx context.Context) (result httpinfrastructuregroup.A, err error)
-	Get200Model201ModelDefaultError201Valid(ctx context.Context) (result httpinfrastructuregroup.A, err error)
-	Get200Model201ModelDefaultError400Valid(ctx context.Context) (result httpinfrastructuregroup.A, err error)
+	Get200Model201ModelDefaultError200Valid(ctx context.Context) (result httpinfrastructuregroup.MultipleResponsesGet200Model201ModelDefaultError200ValidResult, err error)
+	Get200Model201ModelDefaultError201Valid(ctx context.Context) (result httpinfrastructuregroup.MultipleResponsesGet200Model201ModelDefaultError201ValidResult, err error)
+	Get200Model201ModelDefaultError400Valid(ctx context.Context) (result httpinfrastructuregroup.MultipleResponsesGet200Model201ModelDefaultError400ValidResult, err error)
 	Get200Model204NoModelDefaultError200Valid(ctx context.Context) (result httpinfrastructuregroup.A, err error)
 	Get200Model204NoModelDefaultError201Invalid(ctx context.Context) (result httpinfrastructuregroup.A, err error)
 	Get200Model204NoModelDefaultError202None(ctx context.Context) (result httpinfrastructuregroup.A, err error)
@@ -132,10 +132,10 @@ type MultipleResponsesClientAPI interface {
 	Get200ModelA200Invalid(ctx context.Context) (result httpinfrastructuregroup.A, err error)
 	Get200ModelA200None(ctx context.Context) (result httpinfrastructuregroup.A, err error)
 	Get200ModelA200Valid(ctx context.Context) (result httpinfrastructuregroup.A, err error)
-	Get200ModelA201ModelC404ModelDDefaultError200Valid(ctx context.Context) (result httpinfrastructuregroup.SetObject, err error)
-	Get200ModelA201ModelC404ModelDDefaultError201Valid(ctx context.Context) (result httpinfrastructuregroup.SetObject, err error)
-	Get200ModelA201ModelC404ModelDDefaultError400Valid(ctx context.Context) (result httpinfrastructuregroup.SetObject, err error)
-	Get200ModelA201ModelC404ModelDDefaultError404Valid(ctx context.Context) (result httpinfrastructuregroup.SetObject, err error)
+	Get200ModelA201ModelC404ModelDDefaultError200Valid(ctx context.Context) (result httpinfrastructuregroup.MultipleResponsesGet200ModelA201ModelC404ModelDDefaultError200ValidResult, err error)
+	Get200ModelA201ModelC404ModelDDefaultError201Valid(ctx context.Context) (result httpinfrastructuregroup.MultipleResponsesGet200ModelA201ModelC404ModelDDefaultError201ValidResult, err error)
+	Get200ModelA201ModelC404ModelDDefaultError400Valid(ctx context.Context) (result httpinfrastructuregroup.MultipleResponsesGet200ModelA201ModelC404ModelDDefaultError400ValidResult, err error)
+	Get200ModelA201ModelC404ModelDDefaultError404Valid(ctx context.Context) (result httpinfrastructuregroup.MultipleResponsesGet200ModelA201ModelC404ModelDDefaultError404ValidResult, err error)
 	Get200ModelA202Valid(ctx context.Context) (result httpinfrastructuregroup.A, err error)
 	Get200ModelA400Invalid(ctx context.Context) (result httpinfrastructuregroup.A, err error)
 	Get200ModelA400None(ctx context.Context) (result httpinfrastructuregroup.A, err error)
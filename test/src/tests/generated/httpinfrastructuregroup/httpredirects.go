@@ -9,8 +9,6 @@ package httpinfrastructuregroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -29,18 +27,27 @@ func NewHTTPRedirectsClientWithBaseURI(baseURI string) HTTPRedirectsClient {
 	return HTTPRedirectsClient{NewWithBaseURI(baseURI)}
 }
 
+// NewHTTPRedirectsClientWithOptions creates an instance of the HTTPRedirectsClient client with the specified options.
+// It returns an error if the options are invalid.
+func NewHTTPRedirectsClientWithOptions(opts ClientOptions) (HTTPRedirectsClient, error) {
+	client, err := NewWithOptions(opts)
+	return HTTPRedirectsClient{client}, err
+}
+
 // Delete307 delete redirected with 307, resulting in a 200 after redirect
 // Parameters:
 // booleanValue - simple boolean value true
-func (client HTTPRedirectsClient) Delete307(ctx context.Context, booleanValue *bool) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPRedirectsClient.Delete307")
+func (client HTTPRedirectsClient) Delete307(ctx context.Context, booleanValue *bool) (result HTTPRedirectsDelete307Result, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPRedirectsClient.Delete307", "DELETE", "/http/redirect/307")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPRedirectsClient.Delete307", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Delete307Preparer(ctx, booleanValue)
@@ -51,13 +58,13 @@ func (client HTTPRedirectsClient) Delete307(ctx context.Context, booleanValue *b
 
 	resp, err := client.Delete307Sender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Delete307", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.Delete307Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Delete307", resp, "Failure responding to request")
 	}
 
@@ -75,38 +82,44 @@ func (client HTTPRedirectsClient) Delete307Preparer(ctx context.Context, boolean
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Delete307Sender sends the Delete307 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPRedirectsClient) Delete307Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Delete307Responder handles the response to the Delete307 request. The method always
 // closes the http.Response Body.
-func (client HTTPRedirectsClient) Delete307Responder(resp *http.Response) (result autorest.Response, err error) {
+func (client HTTPRedirectsClient) Delete307Responder(resp *http.Response) (result HTTPRedirectsDelete307Result, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusTemporaryRedirect),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusTemporaryRedirect),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
 // Get300 return 300 status code and redirect to /http/success/200
-func (client HTTPRedirectsClient) Get300(ctx context.Context) (result ListString, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPRedirectsClient.Get300")
+func (client HTTPRedirectsClient) Get300(ctx context.Context) (result HTTPRedirectsGet300Result, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPRedirectsClient.Get300", "GET", "/http/redirect/300")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPRedirectsClient.Get300", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get300Preparer(ctx)
@@ -123,7 +136,7 @@ func (client HTTPRedirectsClient) Get300(ctx context.Context) (result ListString
 	}
 
 	result, err = client.Get300Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Get300", resp, "Failure responding to request")
 	}
 
@@ -136,39 +149,45 @@ func (client HTTPRedirectsClient) Get300Preparer(ctx context.Context) (*http.Req
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/redirect/300"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get300Sender sends the Get300 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPRedirectsClient) Get300Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get300Responder handles the response to the Get300 request. The method always
 // closes the http.Response Body.
-func (client HTTPRedirectsClient) Get300Responder(resp *http.Response) (result ListString, err error) {
+func (client HTTPRedirectsClient) Get300Responder(resp *http.Response) (result HTTPRedirectsGet300Result, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusMultipleChoices),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusMultipleChoices),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
 // Get301 return 301 status code and redirect to /http/success/200
-func (client HTTPRedirectsClient) Get301(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPRedirectsClient.Get301")
+func (client HTTPRedirectsClient) Get301(ctx context.Context) (result HTTPRedirectsGet301Result, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPRedirectsClient.Get301", "GET", "/http/redirect/301")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPRedirectsClient.Get301", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get301Preparer(ctx)
@@ -179,13 +198,13 @@ func (client HTTPRedirectsClient) Get301(ctx context.Context) (result autorest.R
 
 	resp, err := client.Get301Sender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Get301", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.Get301Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Get301", resp, "Failure responding to request")
 	}
 
@@ -198,38 +217,44 @@ func (client HTTPRedirectsClient) Get301Preparer(ctx context.Context) (*http.Req
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/redirect/301"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get301Sender sends the Get301 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPRedirectsClient) Get301Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get301Responder handles the response to the Get301 request. The method always
 // closes the http.Response Body.
-func (client HTTPRedirectsClient) Get301Responder(resp *http.Response) (result autorest.Response, err error) {
+func (client HTTPRedirectsClient) Get301Responder(resp *http.Response) (result HTTPRedirectsGet301Result, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusMovedPermanently),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusMovedPermanently),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
 // Get302 return 302 status code and redirect to /http/success/200
-func (client HTTPRedirectsClient) Get302(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPRedirectsClient.Get302")
+func (client HTTPRedirectsClient) Get302(ctx context.Context) (result HTTPRedirectsGet302Result, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPRedirectsClient.Get302", "GET", "/http/redirect/302")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPRedirectsClient.Get302", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get302Preparer(ctx)
@@ -240,13 +265,13 @@ func (client HTTPRedirectsClient) Get302(ctx context.Context) (result autorest.R
 
 	resp, err := client.Get302Sender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Get302", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.Get302Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Get302", resp, "Failure responding to request")
 	}
 
@@ -259,38 +284,44 @@ func (client HTTPRedirectsClient) Get302Preparer(ctx context.Context) (*http.Req
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/redirect/302"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get302Sender sends the Get302 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPRedirectsClient) Get302Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get302Responder handles the response to the Get302 request. The method always
 // closes the http.Response Body.
-func (client HTTPRedirectsClient) Get302Responder(resp *http.Response) (result autorest.Response, err error) {
+func (client HTTPRedirectsClient) Get302Responder(resp *http.Response) (result HTTPRedirectsGet302Result, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusFound),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusFound),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
 // Get307 redirect get with 307, resulting in a 200 success
-func (client HTTPRedirectsClient) Get307(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPRedirectsClient.Get307")
+func (client HTTPRedirectsClient) Get307(ctx context.Context) (result HTTPRedirectsGet307Result, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPRedirectsClient.Get307", "GET", "/http/redirect/307")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPRedirectsClient.Get307", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get307Preparer(ctx)
@@ -301,13 +332,13 @@ func (client HTTPRedirectsClient) Get307(ctx context.Context) (result autorest.R
 
 	resp, err := client.Get307Sender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Get307", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.Get307Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Get307", resp, "Failure responding to request")
 	}
 
@@ -320,38 +351,44 @@ func (client HTTPRedirectsClient) Get307Preparer(ctx context.Context) (*http.Req
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/redirect/307"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get307Sender sends the Get307 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPRedirectsClient) Get307Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get307Responder handles the response to the Get307 request. The method always
 // closes the http.Response Body.
-func (client HTTPRedirectsClient) Get307Responder(resp *http.Response) (result autorest.Response, err error) {
+func (client HTTPRedirectsClient) Get307Responder(resp *http.Response) (result HTTPRedirectsGet307Result, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusTemporaryRedirect),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusTemporaryRedirect),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
 // Head300 return 300 status code and redirect to /http/success/200
-func (client HTTPRedirectsClient) Head300(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPRedirectsClient.Head300")
+func (client HTTPRedirectsClient) Head300(ctx context.Context) (result HTTPRedirectsHead300Result, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPRedirectsClient.Head300", "HEAD", "/http/redirect/300")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPRedirectsClient.Head300", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Head300Preparer(ctx)
@@ -362,13 +399,13 @@ func (client HTTPRedirectsClient) Head300(ctx context.Context) (result autorest.
 
 	resp, err := client.Head300Sender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Head300", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.Head300Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Head300", resp, "Failure responding to request")
 	}
 
@@ -381,38 +418,44 @@ func (client HTTPRedirectsClient) Head300Preparer(ctx context.Context) (*http.Re
 		autorest.AsHead(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/redirect/300"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Head300Sender sends the Head300 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPRedirectsClient) Head300Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Head300Responder handles the response to the Head300 request. The method always
 // closes the http.Response Body.
-func (client HTTPRedirectsClient) Head300Responder(resp *http.Response) (result autorest.Response, err error) {
+func (client HTTPRedirectsClient) Head300Responder(resp *http.Response) (result HTTPRedirectsHead300Result, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusMultipleChoices),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusMultipleChoices),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
 // Head301 return 301 status code and redirect to /http/success/200
-func (client HTTPRedirectsClient) Head301(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPRedirectsClient.Head301")
+func (client HTTPRedirectsClient) Head301(ctx context.Context) (result HTTPRedirectsHead301Result, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPRedirectsClient.Head301", "HEAD", "/http/redirect/301")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPRedirectsClient.Head301", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Head301Preparer(ctx)
@@ -423,13 +466,13 @@ func (client HTTPRedirectsClient) Head301(ctx context.Context) (result autorest.
 
 	resp, err := client.Head301Sender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Head301", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.Head301Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Head301", resp, "Failure responding to request")
 	}
 
@@ -442,38 +485,44 @@ func (client HTTPRedirectsClient) Head301Preparer(ctx context.Context) (*http.Re
 		autorest.AsHead(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/redirect/301"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Head301Sender sends the Head301 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPRedirectsClient) Head301Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Head301Responder handles the response to the Head301 request. The method always
 // closes the http.Response Body.
-func (client HTTPRedirectsClient) Head301Responder(resp *http.Response) (result autorest.Response, err error) {
+func (client HTTPRedirectsClient) Head301Responder(resp *http.Response) (result HTTPRedirectsHead301Result, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusMovedPermanently),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusMovedPermanently),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
 // Head302 return 302 status code and redirect to /http/success/200
-func (client HTTPRedirectsClient) Head302(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPRedirectsClient.Head302")
+func (client HTTPRedirectsClient) Head302(ctx context.Context) (result HTTPRedirectsHead302Result, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPRedirectsClient.Head302", "HEAD", "/http/redirect/302")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPRedirectsClient.Head302", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Head302Preparer(ctx)
@@ -484,13 +533,13 @@ func (client HTTPRedirectsClient) Head302(ctx context.Context) (result autorest.
 
 	resp, err := client.Head302Sender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Head302", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.Head302Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Head302", resp, "Failure responding to request")
 	}
 
@@ -503,38 +552,44 @@ func (client HTTPRedirectsClient) Head302Preparer(ctx context.Context) (*http.Re
 		autorest.AsHead(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/redirect/302"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Head302Sender sends the Head302 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPRedirectsClient) Head302Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Head302Responder handles the response to the Head302 request. The method always
 // closes the http.Response Body.
-func (client HTTPRedirectsClient) Head302Responder(resp *http.Response) (result autorest.Response, err error) {
+func (client HTTPRedirectsClient) Head302Responder(resp *http.Response) (result HTTPRedirectsHead302Result, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusFound),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusFound),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
 // Head307 redirect with 307, resulting in a 200 success
-func (client HTTPRedirectsClient) Head307(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPRedirectsClient.Head307")
+func (client HTTPRedirectsClient) Head307(ctx context.Context) (result HTTPRedirectsHead307Result, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPRedirectsClient.Head307", "HEAD", "/http/redirect/307")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPRedirectsClient.Head307", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Head307Preparer(ctx)
@@ -545,13 +600,13 @@ func (client HTTPRedirectsClient) Head307(ctx context.Context) (result autorest.
 
 	resp, err := client.Head307Sender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Head307", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.Head307Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Head307", resp, "Failure responding to request")
 	}
 
@@ -564,25 +619,29 @@ func (client HTTPRedirectsClient) Head307Preparer(ctx context.Context) (*http.Re
 		autorest.AsHead(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/redirect/307"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Head307Sender sends the Head307 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPRedirectsClient) Head307Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Head307Responder handles the response to the Head307 request. The method always
 // closes the http.Response Body.
-func (client HTTPRedirectsClient) Head307Responder(resp *http.Response) (result autorest.Response, err error) {
+func (client HTTPRedirectsClient) Head307Responder(resp *http.Response) (result HTTPRedirectsHead307Result, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusTemporaryRedirect),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusTemporaryRedirect),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
@@ -590,15 +649,17 @@ func (client HTTPRedirectsClient) Head307Responder(resp *http.Response) (result
 // should return the received 302 to the caller for evaluation
 // Parameters:
 // booleanValue - simple boolean value true
-func (client HTTPRedirectsClient) Patch302(ctx context.Context, booleanValue *bool) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPRedirectsClient.Patch302")
+func (client HTTPRedirectsClient) Patch302(ctx context.Context, booleanValue *bool) (result HTTPRedirectsPatch302Result, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPRedirectsClient.Patch302", "PATCH", "/http/redirect/302")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPRedirectsClient.Patch302", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Patch302Preparer(ctx, booleanValue)
@@ -609,13 +670,13 @@ func (client HTTPRedirectsClient) Patch302(ctx context.Context, booleanValue *bo
 
 	resp, err := client.Patch302Sender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Patch302", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.Patch302Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Patch302", resp, "Failure responding to request")
 	}
 
@@ -633,40 +694,46 @@ func (client HTTPRedirectsClient) Patch302Preparer(ctx context.Context, booleanV
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Patch302Sender sends the Patch302 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPRedirectsClient) Patch302Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Patch302Responder handles the response to the Patch302 request. The method always
 // closes the http.Response Body.
-func (client HTTPRedirectsClient) Patch302Responder(resp *http.Response) (result autorest.Response, err error) {
+func (client HTTPRedirectsClient) Patch302Responder(resp *http.Response) (result HTTPRedirectsPatch302Result, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusFound),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusFound),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
 // Patch307 patch redirected with 307, resulting in a 200 after redirect
 // Parameters:
 // booleanValue - simple boolean value true
-func (client HTTPRedirectsClient) Patch307(ctx context.Context, booleanValue *bool) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPRedirectsClient.Patch307")
+func (client HTTPRedirectsClient) Patch307(ctx context.Context, booleanValue *bool) (result HTTPRedirectsPatch307Result, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPRedirectsClient.Patch307", "PATCH", "/http/redirect/307")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPRedirectsClient.Patch307", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Patch307Preparer(ctx, booleanValue)
@@ -677,13 +744,13 @@ func (client HTTPRedirectsClient) Patch307(ctx context.Context, booleanValue *bo
 
 	resp, err := client.Patch307Sender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Patch307", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.Patch307Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Patch307", resp, "Failure responding to request")
 	}
 
@@ -701,25 +768,29 @@ func (client HTTPRedirectsClient) Patch307Preparer(ctx context.Context, booleanV
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Patch307Sender sends the Patch307 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPRedirectsClient) Patch307Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Patch307Responder handles the response to the Patch307 request. The method always
 // closes the http.Response Body.
-func (client HTTPRedirectsClient) Patch307Responder(resp *http.Response) (result autorest.Response, err error) {
+func (client HTTPRedirectsClient) Patch307Responder(resp *http.Response) (result HTTPRedirectsPatch307Result, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusTemporaryRedirect),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusTemporaryRedirect),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
@@ -727,15 +798,17 @@ func (client HTTPRedirectsClient) Patch307Responder(resp *http.Response) (result
 // get, ultimately returning a 200 status code
 // Parameters:
 // booleanValue - simple boolean value true
-func (client HTTPRedirectsClient) Post303(ctx context.Context, booleanValue *bool) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPRedirectsClient.Post303")
+func (client HTTPRedirectsClient) Post303(ctx context.Context, booleanValue *bool) (result HTTPRedirectsPost303Result, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPRedirectsClient.Post303", "POST", "/http/redirect/303")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPRedirectsClient.Post303", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Post303Preparer(ctx, booleanValue)
@@ -746,13 +819,13 @@ func (client HTTPRedirectsClient) Post303(ctx context.Context, booleanValue *boo
 
 	resp, err := client.Post303Sender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Post303", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.Post303Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Post303", resp, "Failure responding to request")
 	}
 
@@ -770,40 +843,46 @@ func (client HTTPRedirectsClient) Post303Preparer(ctx context.Context, booleanVa
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Post303Sender sends the Post303 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPRedirectsClient) Post303Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Post303Responder handles the response to the Post303 request. The method always
 // closes the http.Response Body.
-func (client HTTPRedirectsClient) Post303Responder(resp *http.Response) (result autorest.Response, err error) {
+func (client HTTPRedirectsClient) Post303Responder(resp *http.Response) (result HTTPRedirectsPost303Result, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusSeeOther),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusSeeOther),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
 // Post307 post redirected with 307, resulting in a 200 after redirect
 // Parameters:
 // booleanValue - simple boolean value true
-func (client HTTPRedirectsClient) Post307(ctx context.Context, booleanValue *bool) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPRedirectsClient.Post307")
+func (client HTTPRedirectsClient) Post307(ctx context.Context, booleanValue *bool) (result HTTPRedirectsPost307Result, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPRedirectsClient.Post307", "POST", "/http/redirect/307")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPRedirectsClient.Post307", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Post307Preparer(ctx, booleanValue)
@@ -814,13 +893,13 @@ func (client HTTPRedirectsClient) Post307(ctx context.Context, booleanValue *boo
 
 	resp, err := client.Post307Sender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Post307", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.Post307Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Post307", resp, "Failure responding to request")
 	}
 
@@ -838,25 +917,29 @@ func (client HTTPRedirectsClient) Post307Preparer(ctx context.Context, booleanVa
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Post307Sender sends the Post307 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPRedirectsClient) Post307Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Post307Responder handles the response to the Post307 request. The method always
 // closes the http.Response Body.
-func (client HTTPRedirectsClient) Post307Responder(resp *http.Response) (result autorest.Response, err error) {
+func (client HTTPRedirectsClient) Post307Responder(resp *http.Response) (result HTTPRedirectsPost307Result, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusTemporaryRedirect),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusTemporaryRedirect),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
@@ -864,15 +947,17 @@ func (client HTTPRedirectsClient) Post307Responder(resp *http.Response) (result
 // should return the received 301 to the caller for evaluation
 // Parameters:
 // booleanValue - simple boolean value true
-func (client HTTPRedirectsClient) Put301(ctx context.Context, booleanValue *bool) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPRedirectsClient.Put301")
+func (client HTTPRedirectsClient) Put301(ctx context.Context, booleanValue *bool) (result HTTPRedirectsPut301Result, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPRedirectsClient.Put301", "PUT", "/http/redirect/301")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPRedirectsClient.Put301", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Put301Preparer(ctx, booleanValue)
@@ -883,13 +968,13 @@ func (client HTTPRedirectsClient) Put301(ctx context.Context, booleanValue *bool
 
 	resp, err := client.Put301Sender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Put301", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.Put301Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Put301", resp, "Failure responding to request")
 	}
 
@@ -907,40 +992,46 @@ func (client HTTPRedirectsClient) Put301Preparer(ctx context.Context, booleanVal
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Put301Sender sends the Put301 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPRedirectsClient) Put301Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Put301Responder handles the response to the Put301 request. The method always
 // closes the http.Response Body.
-func (client HTTPRedirectsClient) Put301Responder(resp *http.Response) (result autorest.Response, err error) {
+func (client HTTPRedirectsClient) Put301Responder(resp *http.Response) (result HTTPRedirectsPut301Result, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusMovedPermanently),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusMovedPermanently),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
 
 // Put307 put redirected with 307, resulting in a 200 after redirect
 // Parameters:
 // booleanValue - simple boolean value true
-func (client HTTPRedirectsClient) Put307(ctx context.Context, booleanValue *bool) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPRedirectsClient.Put307")
+func (client HTTPRedirectsClient) Put307(ctx context.Context, booleanValue *bool) (result HTTPRedirectsPut307Result, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPRedirectsClient.Put307", "PUT", "/http/redirect/307")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPRedirectsClient.Put307", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Put307Preparer(ctx, booleanValue)
@@ -951,13 +1042,13 @@ func (client HTTPRedirectsClient) Put307(ctx context.Context, booleanValue *bool
 
 	resp, err := client.Put307Sender(req)
 	if err != nil {
-		result.Response = resp
+		result.Response = autorest.Response{Response: resp}
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Put307", resp, "Failure sending request")
 		return
 	}
 
 	result, err = client.Put307Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRedirectsClient", "Put307", resp, "Failure responding to request")
 	}
 
@@ -975,24 +1066,28 @@ func (client HTTPRedirectsClient) Put307Preparer(ctx context.Context, booleanVal
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Put307Sender sends the Put307 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPRedirectsClient) Put307Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Put307Responder handles the response to the Put307 request. The method always
 // closes the http.Response Body.
-func (client HTTPRedirectsClient) Put307Responder(resp *http.Response) (result autorest.Response, err error) {
+func (client HTTPRedirectsClient) Put307Responder(resp *http.Response) (result HTTPRedirectsPut307Result, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusTemporaryRedirect),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusTemporaryRedirect),
 		autorest.ByClosing())
-	result.Response = resp
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = result.unmarshalHeaders(resp.Header)
+	}
 	return
 }
@@ -9,8 +9,6 @@ package httpinfrastructuregroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -29,18 +27,27 @@ func NewHTTPRetryClientWithBaseURI(baseURI string) HTTPRetryClient {
 	return HTTPRetryClient{NewWithBaseURI(baseURI)}
 }
 
+// NewHTTPRetryClientWithOptions creates an instance of the HTTPRetryClient client with the specified options.  It
+// returns an error if the options are invalid.
+func NewHTTPRetryClientWithOptions(opts ClientOptions) (HTTPRetryClient, error) {
+	client, err := NewWithOptions(opts)
+	return HTTPRetryClient{client}, err
+}
+
 // Delete503 return 503 status code, then 200 after retry
 // Parameters:
 // booleanValue - simple boolean value true
 func (client HTTPRetryClient) Delete503(ctx context.Context, booleanValue *bool) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPRetryClient.Delete503")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPRetryClient.Delete503", "DELETE", "/http/retry/503")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPRetryClient.Delete503", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.Delete503Preparer(ctx, booleanValue)
@@ -57,7 +64,7 @@ func (client HTTPRetryClient) Delete503(ctx context.Context, booleanValue *bool)
 	}
 
 	result, err = client.Delete503Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRetryClient", "Delete503", resp, "Failure responding to request")
 	}
 
@@ -75,14 +82,15 @@ func (client HTTPRetryClient) Delete503Preparer(ctx context.Context, booleanValu
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Delete503Sender sends the Delete503 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPRetryClient) Delete503Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Delete503Responder handles the response to the Delete503 request. The method always
@@ -91,7 +99,7 @@ func (client HTTPRetryClient) Delete503Responder(resp *http.Response) (result au
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -99,14 +107,16 @@ func (client HTTPRetryClient) Delete503Responder(resp *http.Response) (result au
 
 // Get502 return 502 status code, then 200 after retry
 func (client HTTPRetryClient) Get502(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPRetryClient.Get502")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPRetryClient.Get502", "GET", "/http/retry/502")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPRetryClient.Get502", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.Get502Preparer(ctx)
@@ -123,7 +133,7 @@ func (client HTTPRetryClient) Get502(ctx context.Context) (result autorest.Respo
 	}
 
 	result, err = client.Get502Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRetryClient", "Get502", resp, "Failure responding to request")
 	}
 
@@ -136,14 +146,15 @@ func (client HTTPRetryClient) Get502Preparer(ctx context.Context) (*http.Request
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/retry/502"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get502Sender sends the Get502 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPRetryClient) Get502Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get502Responder handles the response to the Get502 request. The method always
@@ -152,7 +163,7 @@ func (client HTTPRetryClient) Get502Responder(resp *http.Response) (result autor
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -160,14 +171,16 @@ func (client HTTPRetryClient) Get502Responder(resp *http.Response) (result autor
 
 // Head408 return 408 status code, then 200 after retry
 func (client HTTPRetryClient) Head408(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPRetryClient.Head408")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPRetryClient.Head408", "HEAD", "/http/retry/408")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPRetryClient.Head408", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.Head408Preparer(ctx)
@@ -184,7 +197,7 @@ func (client HTTPRetryClient) Head408(ctx context.Context) (result autorest.Resp
 	}
 
 	result, err = client.Head408Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRetryClient", "Head408", resp, "Failure responding to request")
 	}
 
@@ -197,14 +210,15 @@ func (client HTTPRetryClient) Head408Preparer(ctx context.Context) (*http.Reques
 		autorest.AsHead(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/retry/408"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Head408Sender sends the Head408 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPRetryClient) Head408Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Head408Responder handles the response to the Head408 request. The method always
@@ -213,7 +227,7 @@ func (client HTTPRetryClient) Head408Responder(resp *http.Response) (result auto
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -223,14 +237,16 @@ func (client HTTPRetryClient) Head408Responder(resp *http.Response) (result auto
 // Parameters:
 // booleanValue - simple boolean value true
 func (client HTTPRetryClient) Patch500(ctx context.Context, booleanValue *bool) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPRetryClient.Patch500")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPRetryClient.Patch500", "PATCH", "/http/retry/500")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPRetryClient.Patch500", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.Patch500Preparer(ctx, booleanValue)
@@ -247,7 +263,7 @@ func (client HTTPRetryClient) Patch500(ctx context.Context, booleanValue *bool)
 	}
 
 	result, err = client.Patch500Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRetryClient", "Patch500", resp, "Failure responding to request")
 	}
 
@@ -265,14 +281,15 @@ func (client HTTPRetryClient) Patch500Preparer(ctx context.Context, booleanValue
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Patch500Sender sends the Patch500 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPRetryClient) Patch500Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, true))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Patch500Responder handles the response to the Patch500 request. The method always
@@ -281,7 +298,7 @@ func (client HTTPRetryClient) Patch500Responder(resp *http.Response) (result aut
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -291,14 +308,16 @@ func (client HTTPRetryClient) Patch500Responder(resp *http.Response) (result aut
 // Parameters:
 // booleanValue - simple boolean value true
 func (client HTTPRetryClient) Patch504(ctx context.Context, booleanValue *bool) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPRetryClient.Patch504")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPRetryClient.Patch504", "PATCH", "/http/retry/504")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPRetryClient.Patch504", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.Patch504Preparer(ctx, booleanValue)
@@ -315,7 +334,7 @@ func (client HTTPRetryClient) Patch504(ctx context.Context, booleanValue *bool)
 	}
 
 	result, err = client.Patch504Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRetryClient", "Patch504", resp, "Failure responding to request")
 	}
 
@@ -333,14 +352,15 @@ func (client HTTPRetryClient) Patch504Preparer(ctx context.Context, booleanValue
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Patch504Sender sends the Patch504 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPRetryClient) Patch504Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, true))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Patch504Responder handles the response to the Patch504 request. The method always
@@ -349,7 +369,7 @@ func (client HTTPRetryClient) Patch504Responder(resp *http.Response) (result aut
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -359,14 +379,16 @@ func (client HTTPRetryClient) Patch504Responder(resp *http.Response) (result aut
 // Parameters:
 // booleanValue - simple boolean value true
 func (client HTTPRetryClient) Post503(ctx context.Context, booleanValue *bool) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPRetryClient.Post503")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPRetryClient.Post503", "POST", "/http/retry/503")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPRetryClient.Post503", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.Post503Preparer(ctx, booleanValue)
@@ -383,7 +405,7 @@ func (client HTTPRetryClient) Post503(ctx context.Context, booleanValue *bool) (
 	}
 
 	result, err = client.Post503Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRetryClient", "Post503", resp, "Failure responding to request")
 	}
 
@@ -401,14 +423,15 @@ func (client HTTPRetryClient) Post503Preparer(ctx context.Context, booleanValue
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Post503Sender sends the Post503 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPRetryClient) Post503Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, true))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Post503Responder handles the response to the Post503 request. The method always
@@ -417,7 +440,7 @@ func (client HTTPRetryClient) Post503Responder(resp *http.Response) (result auto
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -427,14 +450,16 @@ func (client HTTPRetryClient) Post503Responder(resp *http.Response) (result auto
 // Parameters:
 // booleanValue - simple boolean value true
 func (client HTTPRetryClient) Put500(ctx context.Context, booleanValue *bool) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPRetryClient.Put500")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPRetryClient.Put500", "PUT", "/http/retry/500")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPRetryClient.Put500", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.Put500Preparer(ctx, booleanValue)
@@ -451,7 +476,7 @@ func (client HTTPRetryClient) Put500(ctx context.Context, booleanValue *bool) (r
 	}
 
 	result, err = client.Put500Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRetryClient", "Put500", resp, "Failure responding to request")
 	}
 
@@ -469,14 +494,15 @@ func (client HTTPRetryClient) Put500Preparer(ctx context.Context, booleanValue *
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Put500Sender sends the Put500 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPRetryClient) Put500Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Put500Responder handles the response to the Put500 request. The method always
@@ -485,7 +511,7 @@ func (client HTTPRetryClient) Put500Responder(resp *http.Response) (result autor
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -495,14 +521,16 @@ func (client HTTPRetryClient) Put500Responder(resp *http.Response) (result autor
 // Parameters:
 // booleanValue - simple boolean value true
 func (client HTTPRetryClient) Put504(ctx context.Context, booleanValue *bool) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPRetryClient.Put504")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPRetryClient.Put504", "PUT", "/http/retry/504")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPRetryClient.Put504", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.Put504Preparer(ctx, booleanValue)
@@ -519,7 +547,7 @@ func (client HTTPRetryClient) Put504(ctx context.Context, booleanValue *bool) (r
 	}
 
 	result, err = client.Put504Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPRetryClient", "Put504", resp, "Failure responding to request")
 	}
 
@@ -537,14 +565,15 @@ func (client HTTPRetryClient) Put504Preparer(ctx context.Context, booleanValue *
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Put504Sender sends the Put504 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPRetryClient) Put504Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Put504Responder handles the response to the Put504 request. The method always
@@ -553,7 +582,7 @@ func (client HTTPRetryClient) Put504Responder(resp *http.Response) (result autor
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
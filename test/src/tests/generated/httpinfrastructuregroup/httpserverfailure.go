@@ -9,8 +9,6 @@ package httpinfrastructuregroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -29,18 +27,27 @@ func NewHTTPServerFailureClientWithBaseURI(baseURI string) HTTPServerFailureClie
 	return HTTPServerFailureClient{NewWithBaseURI(baseURI)}
 }
 
+// NewHTTPServerFailureClientWithOptions creates an instance of the HTTPServerFailureClient client with the specified
+// options.  It returns an error if the options are invalid.
+func NewHTTPServerFailureClientWithOptions(opts ClientOptions) (HTTPServerFailureClient, error) {
+	client, err := NewWithOptions(opts)
+	return HTTPServerFailureClient{client}, err
+}
+
 // Delete505 return 505 status code - should be represented in the client as an error
 // Parameters:
 // booleanValue - simple boolean value true
 func (client HTTPServerFailureClient) Delete505(ctx context.Context, booleanValue *bool) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPServerFailureClient.Delete505")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPServerFailureClient.Delete505", "DELETE", "/http/failure/server/505")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPServerFailureClient.Delete505", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Delete505Preparer(ctx, booleanValue)
@@ -57,7 +64,7 @@ func (client HTTPServerFailureClient) Delete505(ctx context.Context, booleanValu
 	}
 
 	result, err = client.Delete505Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPServerFailureClient", "Delete505", resp, "Failure responding to request")
 	}
 
@@ -75,14 +82,15 @@ func (client HTTPServerFailureClient) Delete505Preparer(ctx context.Context, boo
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Delete505Sender sends the Delete505 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPServerFailureClient) Delete505Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Delete505Responder handles the response to the Delete505 request. The method always
@@ -91,7 +99,7 @@ func (client HTTPServerFailureClient) Delete505Responder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -100,14 +108,16 @@ func (client HTTPServerFailureClient) Delete505Responder(resp *http.Response) (r
 
 // Get501 return 501 status code - should be represented in the client as an error
 func (client HTTPServerFailureClient) Get501(ctx context.Context) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPServerFailureClient.Get501")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPServerFailureClient.Get501", "GET", "/http/failure/server/501")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPServerFailureClient.Get501", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get501Preparer(ctx)
@@ -124,7 +134,7 @@ func (client HTTPServerFailureClient) Get501(ctx context.Context) (result Error,
 	}
 
 	result, err = client.Get501Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPServerFailureClient", "Get501", resp, "Failure responding to request")
 	}
 
@@ -137,14 +147,15 @@ func (client HTTPServerFailureClient) Get501Preparer(ctx context.Context) (*http
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/failure/server/501"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get501Sender sends the Get501 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPServerFailureClient) Get501Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get501Responder handles the response to the Get501 request. The method always
@@ -153,7 +164,7 @@ func (client HTTPServerFailureClient) Get501Responder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -162,14 +173,16 @@ func (client HTTPServerFailureClient) Get501Responder(resp *http.Response) (resu
 
 // Head501 return 501 status code - should be represented in the client as an error
 func (client HTTPServerFailureClient) Head501(ctx context.Context) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPServerFailureClient.Head501")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPServerFailureClient.Head501", "HEAD", "/http/failure/server/501")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPServerFailureClient.Head501", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Head501Preparer(ctx)
@@ -186,7 +199,7 @@ func (client HTTPServerFailureClient) Head501(ctx context.Context) (result Error
 	}
 
 	result, err = client.Head501Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPServerFailureClient", "Head501", resp, "Failure responding to request")
 	}
 
@@ -199,14 +212,15 @@ func (client HTTPServerFailureClient) Head501Preparer(ctx context.Context) (*htt
 		autorest.AsHead(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/failure/server/501"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Head501Sender sends the Head501 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPServerFailureClient) Head501Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Head501Responder handles the response to the Head501 request. The method always
@@ -215,7 +229,7 @@ func (client HTTPServerFailureClient) Head501Responder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -226,14 +240,16 @@ func (client HTTPServerFailureClient) Head501Responder(resp *http.Response) (res
 // Parameters:
 // booleanValue - simple boolean value true
 func (client HTTPServerFailureClient) Post505(ctx context.Context, booleanValue *bool) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/HTTPServerFailureClient.Post505")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/HTTPServerFailureClient.Post505", "POST", "/http/failure/server/505")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "HTTPServerFailureClient.Post505", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Post505Preparer(ctx, booleanValue)
@@ -250,7 +266,7 @@ func (client HTTPServerFailureClient) Post505(ctx context.Context, booleanValue
 	}
 
 	result, err = client.Post505Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.HTTPServerFailureClient", "Post505", resp, "Failure responding to request")
 	}
 
@@ -268,14 +284,15 @@ func (client HTTPServerFailureClient) Post505Preparer(ctx context.Context, boole
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(booleanValue))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Post505Sender sends the Post505 request. The method will close the
 // http.Response Body if it receives an error.
 func (client HTTPServerFailureClient) Post505Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Post505Responder handles the response to the Post505 request. The method always
@@ -284,7 +301,7 @@ func (client HTTPServerFailureClient) Post505Responder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
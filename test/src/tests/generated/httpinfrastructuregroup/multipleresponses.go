@@ -10,7 +10,6 @@ import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -29,16 +28,25 @@ func NewMultipleResponsesClientWithBaseURI(baseURI string) MultipleResponsesClie
 	return MultipleResponsesClient{NewWithBaseURI(baseURI)}
 }
 
+// NewMultipleResponsesClientWithOptions creates an instance of the MultipleResponsesClient client with the specified
+// options.  It returns an error if the options are invalid.
+func NewMultipleResponsesClientWithOptions(opts ClientOptions) (MultipleResponsesClient, error) {
+	client, err := NewWithOptions(opts)
+	return MultipleResponsesClient{client}, err
+}
+
 // Get200Model201ModelDefaultError200Valid send a 200 response with valid payload: {'statusCode': '200'}
-func (client MultipleResponsesClient) Get200Model201ModelDefaultError200Valid(ctx context.Context) (result A, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get200Model201ModelDefaultError200Valid")
+func (client MultipleResponsesClient) Get200Model201ModelDefaultError200Valid(ctx context.Context) (result MultipleResponsesGet200Model201ModelDefaultError200ValidResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get200Model201ModelDefaultError200Valid", "GET", "/http/payloads/200/A/201/B/default/Error/response/200/valid")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get200Model201ModelDefaultError200Valid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get200Model201ModelDefaultError200ValidPreparer(ctx)
@@ -55,7 +63,7 @@ func (client MultipleResponsesClient) Get200Model201ModelDefaultError200Valid(ct
 	}
 
 	result, err = client.Get200Model201ModelDefaultError200ValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.MultipleResponsesClient", "Get200Model201ModelDefaultError200Valid", resp, "Failure responding to request")
 	}
 
@@ -68,24 +76,25 @@ func (client MultipleResponsesClient) Get200Model201ModelDefaultError200ValidPre
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/200/A/201/B/default/Error/response/200/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get200Model201ModelDefaultError200ValidSender sends the Get200Model201ModelDefaultError200Valid request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get200Model201ModelDefaultError200ValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get200Model201ModelDefaultError200ValidResponder handles the response to the Get200Model201ModelDefaultError200Valid request. The method always
 // closes the http.Response Body.
-func (client MultipleResponsesClient) Get200Model201ModelDefaultError200ValidResponder(resp *http.Response) (result A, err error) {
+func (client MultipleResponsesClient) Get200Model201ModelDefaultError200ValidResponder(resp *http.Response) (result MultipleResponsesGet200Model201ModelDefaultError200ValidResult, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
-		autorest.ByUnmarshallingJSON(&result),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		result.byUnmarshallingBody(resp.StatusCode),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
 	return
@@ -93,15 +102,17 @@ func (client MultipleResponsesClient) Get200Model201ModelDefaultError200ValidRes
 
 // Get200Model201ModelDefaultError201Valid send a 201 response with valid payload: {'statusCode': '201',
 // 'textStatusCode': 'Created'}
-func (client MultipleResponsesClient) Get200Model201ModelDefaultError201Valid(ctx context.Context) (result A, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get200Model201ModelDefaultError201Valid")
+func (client MultipleResponsesClient) Get200Model201ModelDefaultError201Valid(ctx context.Context) (result MultipleResponsesGet200Model201ModelDefaultError201ValidResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get200Model201ModelDefaultError201Valid", "GET", "/http/payloads/200/A/201/B/default/Error/response/201/valid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get200Model201ModelDefaultError201Valid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get200Model201ModelDefaultError201ValidPreparer(ctx)
@@ -118,7 +129,7 @@ func (client MultipleResponsesClient) Get200Model201ModelDefaultError201Valid(ct
 	}
 
 	result, err = client.Get200Model201ModelDefaultError201ValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.MultipleResponsesClient", "Get200Model201ModelDefaultError201Valid", resp, "Failure responding to request")
 	}
 
@@ -131,24 +142,25 @@ func (client MultipleResponsesClient) Get200Model201ModelDefaultError201ValidPre
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/200/A/201/B/default/Error/response/201/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get200Model201ModelDefaultError201ValidSender sends the Get200Model201ModelDefaultError201Valid request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get200Model201ModelDefaultError201ValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get200Model201ModelDefaultError201ValidResponder handles the response to the Get200Model201ModelDefaultError201Valid request. The method always
 // closes the http.Response Body.
-func (client MultipleResponsesClient) Get200Model201ModelDefaultError201ValidResponder(resp *http.Response) (result A, err error) {
+func (client MultipleResponsesClient) Get200Model201ModelDefaultError201ValidResponder(resp *http.Response) (result MultipleResponsesGet200Model201ModelDefaultError201ValidResult, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
-		autorest.ByUnmarshallingJSON(&result),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		result.byUnmarshallingBody(resp.StatusCode),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
 	return
@@ -156,15 +168,17 @@ func (client MultipleResponsesClient) Get200Model201ModelDefaultError201ValidRes
 
 // Get200Model201ModelDefaultError400Valid send a 400 response with valid payload: {'code': '400', 'message': 'client
 // error'}
-func (client MultipleResponsesClient) Get200Model201ModelDefaultError400Valid(ctx context.Context) (result A, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get200Model201ModelDefaultError400Valid")
+func (client MultipleResponsesClient) Get200Model201ModelDefaultError400Valid(ctx context.Context) (result MultipleResponsesGet200Model201ModelDefaultError400ValidResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get200Model201ModelDefaultError400Valid", "GET", "/http/payloads/200/A/201/B/default/Error/response/400/valid")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get200Model201ModelDefaultError400Valid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get200Model201ModelDefaultError400ValidPreparer(ctx)
@@ -181,7 +195,7 @@ func (client MultipleResponsesClient) Get200Model201ModelDefaultError400Valid(ct
 	}
 
 	result, err = client.Get200Model201ModelDefaultError400ValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.MultipleResponsesClient", "Get200Model201ModelDefaultError400Valid", resp, "Failure responding to request")
 	}
 
@@ -194,24 +208,25 @@ func (client MultipleResponsesClient) Get200Model201ModelDefaultError400ValidPre
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/200/A/201/B/default/Error/response/400/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get200Model201ModelDefaultError400ValidSender sends the Get200Model201ModelDefaultError400Valid request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get200Model201ModelDefaultError400ValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get200Model201ModelDefaultError400ValidResponder handles the response to the Get200Model201ModelDefaultError400Valid request. The method always
 // closes the http.Response Body.
-func (client MultipleResponsesClient) Get200Model201ModelDefaultError400ValidResponder(resp *http.Response) (result A, err error) {
+func (client MultipleResponsesClient) Get200Model201ModelDefaultError400ValidResponder(resp *http.Response) (result MultipleResponsesGet200Model201ModelDefaultError400ValidResult, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
-		autorest.ByUnmarshallingJSON(&result),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		result.byUnmarshallingBody(resp.StatusCode),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
 	return
@@ -219,14 +234,16 @@ func (client MultipleResponsesClient) Get200Model201ModelDefaultError400ValidRes
 
 // Get200Model204NoModelDefaultError200Valid send a 200 response with valid payload: {'statusCode': '200'}
 func (client MultipleResponsesClient) Get200Model204NoModelDefaultError200Valid(ctx context.Context) (result A, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get200Model204NoModelDefaultError200Valid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get200Model204NoModelDefaultError200Valid", "GET", "/http/payloads/200/A/204/none/default/Error/response/200/valid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get200Model204NoModelDefaultError200Valid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get200Model204NoModelDefaultError200ValidPreparer(ctx)
@@ -243,7 +260,7 @@ func (client MultipleResponsesClient) Get200Model204NoModelDefaultError200Valid(
 	}
 
 	result, err = client.Get200Model204NoModelDefaultError200ValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.MultipleResponsesClient", "Get200Model204NoModelDefaultError200Valid", resp, "Failure responding to request")
 	}
 
@@ -256,14 +273,15 @@ func (client MultipleResponsesClient) Get200Model204NoModelDefaultError200ValidP
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/200/A/204/none/default/Error/response/200/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get200Model204NoModelDefaultError200ValidSender sends the Get200Model204NoModelDefaultError200Valid request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get200Model204NoModelDefaultError200ValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get200Model204NoModelDefaultError200ValidResponder handles the response to the Get200Model204NoModelDefaultError200Valid request. The method always
@@ -272,7 +290,7 @@ func (client MultipleResponsesClient) Get200Model204NoModelDefaultError200ValidR
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusNoContent),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -281,14 +299,16 @@ func (client MultipleResponsesClient) Get200Model204NoModelDefaultError200ValidR
 
 // Get200Model204NoModelDefaultError201Invalid send a 201 response with valid payload: {'statusCode': '201'}
 func (client MultipleResponsesClient) Get200Model204NoModelDefaultError201Invalid(ctx context.Context) (result A, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get200Model204NoModelDefaultError201Invalid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get200Model204NoModelDefaultError201Invalid", "GET", "/http/payloads/200/A/204/none/default/Error/response/201/valid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get200Model204NoModelDefaultError201Invalid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get200Model204NoModelDefaultError201InvalidPreparer(ctx)
@@ -305,7 +325,7 @@ func (client MultipleResponsesClient) Get200Model204NoModelDefaultError201Invali
 	}
 
 	result, err = client.Get200Model204NoModelDefaultError201InvalidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.MultipleResponsesClient", "Get200Model204NoModelDefaultError201Invalid", resp, "Failure responding to request")
 	}
 
@@ -318,14 +338,15 @@ func (client MultipleResponsesClient) Get200Model204NoModelDefaultError201Invali
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/200/A/204/none/default/Error/response/201/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get200Model204NoModelDefaultError201InvalidSender sends the Get200Model204NoModelDefaultError201Invalid request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get200Model204NoModelDefaultError201InvalidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get200Model204NoModelDefaultError201InvalidResponder handles the response to the Get200Model204NoModelDefaultError201Invalid request. The method always
@@ -334,7 +355,7 @@ func (client MultipleResponsesClient) Get200Model204NoModelDefaultError201Invali
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusNoContent),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -343,14 +364,16 @@ func (client MultipleResponsesClient) Get200Model204NoModelDefaultError201Invali
 
 // Get200Model204NoModelDefaultError202None send a 202 response with no payload:
 func (client MultipleResponsesClient) Get200Model204NoModelDefaultError202None(ctx context.Context) (result A, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get200Model204NoModelDefaultError202None")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get200Model204NoModelDefaultError202None", "GET", "/http/payloads/200/A/204/none/default/Error/response/202/none")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get200Model204NoModelDefaultError202None", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get200Model204NoModelDefaultError202NonePreparer(ctx)
@@ -367,7 +390,7 @@ func (client MultipleResponsesClient) Get200Model204NoModelDefaultError202None(c
 	}
 
 	result, err = client.Get200Model204NoModelDefaultError202NoneResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.MultipleResponsesClient", "Get200Model204NoModelDefaultError202None", resp, "Failure responding to request")
 	}
 
@@ -380,14 +403,15 @@ func (client MultipleResponsesClient) Get200Model204NoModelDefaultError202NonePr
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/200/A/204/none/default/Error/response/202/none"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get200Model204NoModelDefaultError202NoneSender sends the Get200Model204NoModelDefaultError202None request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get200Model204NoModelDefaultError202NoneSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get200Model204NoModelDefaultError202NoneResponder handles the response to the Get200Model204NoModelDefaultError202None request. The method always
@@ -396,7 +420,7 @@ func (client MultipleResponsesClient) Get200Model204NoModelDefaultError202NoneRe
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusNoContent),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -405,14 +429,16 @@ func (client MultipleResponsesClient) Get200Model204NoModelDefaultError202NoneRe
 
 // Get200Model204NoModelDefaultError204Valid send a 204 response with no payload
 func (client MultipleResponsesClient) Get200Model204NoModelDefaultError204Valid(ctx context.Context) (result A, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get200Model204NoModelDefaultError204Valid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get200Model204NoModelDefaultError204Valid", "GET", "/http/payloads/200/A/204/none/default/Error/response/204/none")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get200Model204NoModelDefaultError204Valid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get200Model204NoModelDefaultError204ValidPreparer(ctx)
@@ -429,7 +455,7 @@ func (client MultipleResponsesClient) Get200Model204NoModelDefaultError204Valid(
 	}
 
 	result, err = client.Get200Model204NoModelDefaultError204ValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.MultipleResponsesClient", "Get200Model204NoModelDefaultError204Valid", resp, "Failure responding to request")
 	}
 
@@ -442,14 +468,15 @@ func (client MultipleResponsesClient) Get200Model204NoModelDefaultError204ValidP
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/200/A/204/none/default/Error/response/204/none"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get200Model204NoModelDefaultError204ValidSender sends the Get200Model204NoModelDefaultError204Valid request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get200Model204NoModelDefaultError204ValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get200Model204NoModelDefaultError204ValidResponder handles the response to the Get200Model204NoModelDefaultError204Valid request. The method always
@@ -458,7 +485,7 @@ func (client MultipleResponsesClient) Get200Model204NoModelDefaultError204ValidR
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusNoContent),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -468,14 +495,16 @@ func (client MultipleResponsesClient) Get200Model204NoModelDefaultError204ValidR
 // Get200Model204NoModelDefaultError400Valid send a 400 response with valid error payload: {'status': 400, 'message':
 // 'client error'}
 func (client MultipleResponsesClient) Get200Model204NoModelDefaultError400Valid(ctx context.Context) (result A, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get200Model204NoModelDefaultError400Valid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get200Model204NoModelDefaultError400Valid", "GET", "/http/payloads/200/A/204/none/default/Error/response/400/valid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get200Model204NoModelDefaultError400Valid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get200Model204NoModelDefaultError400ValidPreparer(ctx)
@@ -492,7 +521,7 @@ func (client MultipleResponsesClient) Get200Model204NoModelDefaultError400Valid(
 	}
 
 	result, err = client.Get200Model204NoModelDefaultError400ValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.MultipleResponsesClient", "Get200Model204NoModelDefaultError400Valid", resp, "Failure responding to request")
 	}
 
@@ -505,14 +534,15 @@ func (client MultipleResponsesClient) Get200Model204NoModelDefaultError400ValidP
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/200/A/204/none/default/Error/response/400/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get200Model204NoModelDefaultError400ValidSender sends the Get200Model204NoModelDefaultError400Valid request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get200Model204NoModelDefaultError400ValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get200Model204NoModelDefaultError400ValidResponder handles the response to the Get200Model204NoModelDefaultError400Valid request. The method always
@@ -521,7 +551,7 @@ func (client MultipleResponsesClient) Get200Model204NoModelDefaultError400ValidR
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusNoContent),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -530,14 +560,16 @@ func (client MultipleResponsesClient) Get200Model204NoModelDefaultError400ValidR
 
 // Get200ModelA200Invalid send a 200 response with invalid payload {'statusCodeInvalid': '200'}
 func (client MultipleResponsesClient) Get200ModelA200Invalid(ctx context.Context) (result A, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get200ModelA200Invalid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get200ModelA200Invalid", "GET", "/http/payloads/200/A/response/200/invalid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get200ModelA200Invalid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get200ModelA200InvalidPreparer(ctx)
@@ -567,14 +599,15 @@ func (client MultipleResponsesClient) Get200ModelA200InvalidPreparer(ctx context
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/200/A/response/200/invalid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get200ModelA200InvalidSender sends the Get200ModelA200Invalid request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get200ModelA200InvalidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get200ModelA200InvalidResponder handles the response to the Get200ModelA200Invalid request. The method always
@@ -593,14 +626,16 @@ func (client MultipleResponsesClient) Get200ModelA200InvalidResponder(resp *http
 // Get200ModelA200None send a 200 response with no payload, when a payload is expected - client should return a null
 // object of thde type for model A
 func (client MultipleResponsesClient) Get200ModelA200None(ctx context.Context) (result A, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get200ModelA200None")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get200ModelA200None", "GET", "/http/payloads/200/A/response/200/none")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get200ModelA200None", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get200ModelA200NonePreparer(ctx)
@@ -630,14 +665,15 @@ func (client MultipleResponsesClient) Get200ModelA200NonePreparer(ctx context.Co
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/200/A/response/200/none"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get200ModelA200NoneSender sends the Get200ModelA200None request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get200ModelA200NoneSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get200ModelA200NoneResponder handles the response to the Get200ModelA200None request. The method always
@@ -655,14 +691,16 @@ func (client MultipleResponsesClient) Get200ModelA200NoneResponder(resp *http.Re
 
 // Get200ModelA200Valid send a 200 response with payload {'statusCode': '200'}
 func (client MultipleResponsesClient) Get200ModelA200Valid(ctx context.Context) (result A, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get200ModelA200Valid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get200ModelA200Valid", "GET", "/http/payloads/200/A/response/200/valid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get200ModelA200Valid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get200ModelA200ValidPreparer(ctx)
@@ -692,14 +730,15 @@ func (client MultipleResponsesClient) Get200ModelA200ValidPreparer(ctx context.C
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/200/A/response/200/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get200ModelA200ValidSender sends the Get200ModelA200Valid request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get200ModelA200ValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get200ModelA200ValidResponder handles the response to the Get200ModelA200Valid request. The method always
@@ -716,15 +755,17 @@ func (client MultipleResponsesClient) Get200ModelA200ValidResponder(resp *http.R
 }
 
 // Get200ModelA201ModelC404ModelDDefaultError200Valid send a 200 response with valid payload: {'statusCode': '200'}
-func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError200Valid(ctx context.Context) (result SetObject, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get200ModelA201ModelC404ModelDDefaultError200Valid")
+func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError200Valid(ctx context.Context) (result MultipleResponsesGet200ModelA201ModelC404ModelDDefaultError200ValidResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get200ModelA201ModelC404ModelDDefaultError200Valid", "GET", "/http/payloads/200/A/201/C/404/D/default/Error/response/200/valid")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get200ModelA201ModelC404ModelDDefaultError200Valid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get200ModelA201ModelC404ModelDDefaultError200ValidPreparer(ctx)
@@ -741,7 +782,7 @@ func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError
 	}
 
 	result, err = client.Get200ModelA201ModelC404ModelDDefaultError200ValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.MultipleResponsesClient", "Get200ModelA201ModelC404ModelDDefaultError200Valid", resp, "Failure responding to request")
 	}
 
@@ -754,39 +795,42 @@ func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/200/A/201/C/404/D/default/Error/response/200/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get200ModelA201ModelC404ModelDDefaultError200ValidSender sends the Get200ModelA201ModelC404ModelDDefaultError200Valid request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError200ValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get200ModelA201ModelC404ModelDDefaultError200ValidResponder handles the response to the Get200ModelA201ModelC404ModelDDefaultError200Valid request. The method always
 // closes the http.Response Body.
-func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError200ValidResponder(resp *http.Response) (result SetObject, err error) {
+func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError200ValidResponder(resp *http.Response) (result MultipleResponsesGet200ModelA201ModelC404ModelDDefaultError200ValidResult, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated, http.StatusNotFound),
-		autorest.ByUnmarshallingJSON(&result.Value),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusCreated, http.StatusNotFound),
+		result.byUnmarshallingBody(resp.StatusCode),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
 	return
 }
 
 // Get200ModelA201ModelC404ModelDDefaultError201Valid send a 200 response with valid payload: {'httpCode': '201'}
-func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError201Valid(ctx context.Context) (result SetObject, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get200ModelA201ModelC404ModelDDefaultError201Valid")
+func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError201Valid(ctx context.Context) (result MultipleResponsesGet200ModelA201ModelC404ModelDDefaultError201ValidResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get200ModelA201ModelC404ModelDDefaultError201Valid", "GET", "/http/payloads/200/A/201/C/404/D/default/Error/response/201/valid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get200ModelA201ModelC404ModelDDefaultError201Valid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get200ModelA201ModelC404ModelDDefaultError201ValidPreparer(ctx)
@@ -803,7 +847,7 @@ func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError
 	}
 
 	result, err = client.Get200ModelA201ModelC404ModelDDefaultError201ValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.MultipleResponsesClient", "Get200ModelA201ModelC404ModelDDefaultError201Valid", resp, "Failure responding to request")
 	}
 
@@ -816,24 +860,25 @@ func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/200/A/201/C/404/D/default/Error/response/201/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get200ModelA201ModelC404ModelDDefaultError201ValidSender sends the Get200ModelA201ModelC404ModelDDefaultError201Valid request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError201ValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get200ModelA201ModelC404ModelDDefaultError201ValidResponder handles the response to the Get200ModelA201ModelC404ModelDDefaultError201Valid request. The method always
 // closes the http.Response Body.
-func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError201ValidResponder(resp *http.Response) (result SetObject, err error) {
+func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError201ValidResponder(resp *http.Response) (result MultipleResponsesGet200ModelA201ModelC404ModelDDefaultError201ValidResult, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated, http.StatusNotFound),
-		autorest.ByUnmarshallingJSON(&result.Value),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusCreated, http.StatusNotFound),
+		result.byUnmarshallingBody(resp.StatusCode),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
 	return
@@ -841,15 +886,17 @@ func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError
 
 // Get200ModelA201ModelC404ModelDDefaultError400Valid send a 400 response with valid payload: {'code': '400',
 // 'message': 'client error'}
-func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError400Valid(ctx context.Context) (result SetObject, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get200ModelA201ModelC404ModelDDefaultError400Valid")
+func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError400Valid(ctx context.Context) (result MultipleResponsesGet200ModelA201ModelC404ModelDDefaultError400ValidResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get200ModelA201ModelC404ModelDDefaultError400Valid", "GET", "/http/payloads/200/A/201/C/404/D/default/Error/response/400/valid")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get200ModelA201ModelC404ModelDDefaultError400Valid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get200ModelA201ModelC404ModelDDefaultError400ValidPreparer(ctx)
@@ -866,7 +913,7 @@ func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError
 	}
 
 	result, err = client.Get200ModelA201ModelC404ModelDDefaultError400ValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.MultipleResponsesClient", "Get200ModelA201ModelC404ModelDDefaultError400Valid", resp, "Failure responding to request")
 	}
 
@@ -879,39 +926,42 @@ func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/200/A/201/C/404/D/default/Error/response/400/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get200ModelA201ModelC404ModelDDefaultError400ValidSender sends the Get200ModelA201ModelC404ModelDDefaultError400Valid request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError400ValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get200ModelA201ModelC404ModelDDefaultError400ValidResponder handles the response to the Get200ModelA201ModelC404ModelDDefaultError400Valid request. The method always
 // closes the http.Response Body.
-func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError400ValidResponder(resp *http.Response) (result SetObject, err error) {
+func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError400ValidResponder(resp *http.Response) (result MultipleResponsesGet200ModelA201ModelC404ModelDDefaultError400ValidResult, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated, http.StatusNotFound),
-		autorest.ByUnmarshallingJSON(&result.Value),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusCreated, http.StatusNotFound),
+		result.byUnmarshallingBody(resp.StatusCode),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
 	return
 }
 
 // Get200ModelA201ModelC404ModelDDefaultError404Valid send a 200 response with valid payload: {'httpStatusCode': '404'}
-func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError404Valid(ctx context.Context) (result SetObject, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get200ModelA201ModelC404ModelDDefaultError404Valid")
+func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError404Valid(ctx context.Context) (result MultipleResponsesGet200ModelA201ModelC404ModelDDefaultError404ValidResult, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get200ModelA201ModelC404ModelDDefaultError404Valid", "GET", "/http/payloads/200/A/201/C/404/D/default/Error/response/404/valid")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get200ModelA201ModelC404ModelDDefaultError404Valid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get200ModelA201ModelC404ModelDDefaultError404ValidPreparer(ctx)
@@ -928,7 +978,7 @@ func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError
 	}
 
 	result, err = client.Get200ModelA201ModelC404ModelDDefaultError404ValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.MultipleResponsesClient", "Get200ModelA201ModelC404ModelDDefaultError404Valid", resp, "Failure responding to request")
 	}
 
@@ -941,24 +991,25 @@ func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/200/A/201/C/404/D/default/Error/response/404/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get200ModelA201ModelC404ModelDDefaultError404ValidSender sends the Get200ModelA201ModelC404ModelDDefaultError404Valid request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError404ValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get200ModelA201ModelC404ModelDDefaultError404ValidResponder handles the response to the Get200ModelA201ModelC404ModelDDefaultError404Valid request. The method always
 // closes the http.Response Body.
-func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError404ValidResponder(resp *http.Response) (result SetObject, err error) {
+func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError404ValidResponder(resp *http.Response) (result MultipleResponsesGet200ModelA201ModelC404ModelDDefaultError404ValidResult, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated, http.StatusNotFound),
-		autorest.ByUnmarshallingJSON(&result.Value),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusCreated, http.StatusNotFound),
+		result.byUnmarshallingBody(resp.StatusCode),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
 	return
@@ -966,14 +1017,16 @@ func (client MultipleResponsesClient) Get200ModelA201ModelC404ModelDDefaultError
 
 // Get200ModelA202Valid send a 202 response with payload {'statusCode': '202'}
 func (client MultipleResponsesClient) Get200ModelA202Valid(ctx context.Context) (result A, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get200ModelA202Valid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get200ModelA202Valid", "GET", "/http/payloads/200/A/response/202/valid")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get200ModelA202Valid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get200ModelA202ValidPreparer(ctx)
@@ -1003,14 +1056,15 @@ func (client MultipleResponsesClient) Get200ModelA202ValidPreparer(ctx context.C
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/200/A/response/202/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get200ModelA202ValidSender sends the Get200ModelA202Valid request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get200ModelA202ValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get200ModelA202ValidResponder handles the response to the Get200ModelA202Valid request. The method always
@@ -1028,14 +1082,16 @@ func (client MultipleResponsesClient) Get200ModelA202ValidResponder(resp *http.R
 
 // Get200ModelA400Invalid send a 200 response with invalid payload {'statusCodeInvalid': '400'}
 func (client MultipleResponsesClient) Get200ModelA400Invalid(ctx context.Context) (result A, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get200ModelA400Invalid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get200ModelA400Invalid", "GET", "/http/payloads/200/A/response/400/invalid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get200ModelA400Invalid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get200ModelA400InvalidPreparer(ctx)
@@ -1065,14 +1121,15 @@ func (client MultipleResponsesClient) Get200ModelA400InvalidPreparer(ctx context
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/200/A/response/400/invalid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get200ModelA400InvalidSender sends the Get200ModelA400Invalid request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get200ModelA400InvalidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get200ModelA400InvalidResponder handles the response to the Get200ModelA400Invalid request. The method always
@@ -1090,14 +1147,16 @@ func (client MultipleResponsesClient) Get200ModelA400InvalidResponder(resp *http
 
 // Get200ModelA400None send a 400 response with no payload client should treat as an http error with no error model
 func (client MultipleResponsesClient) Get200ModelA400None(ctx context.Context) (result A, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get200ModelA400None")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get200ModelA400None", "GET", "/http/payloads/200/A/response/400/none")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get200ModelA400None", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get200ModelA400NonePreparer(ctx)
@@ -1127,14 +1186,15 @@ func (client MultipleResponsesClient) Get200ModelA400NonePreparer(ctx context.Co
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/200/A/response/400/none"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get200ModelA400NoneSender sends the Get200ModelA400None request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get200ModelA400NoneSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get200ModelA400NoneResponder handles the response to the Get200ModelA400None request. The method always
@@ -1152,14 +1212,16 @@ func (client MultipleResponsesClient) Get200ModelA400NoneResponder(resp *http.Re
 
 // Get200ModelA400Valid send a 200 response with payload {'statusCode': '400'}
 func (client MultipleResponsesClient) Get200ModelA400Valid(ctx context.Context) (result A, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get200ModelA400Valid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get200ModelA400Valid", "GET", "/http/payloads/200/A/response/400/valid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get200ModelA400Valid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.Get200ModelA400ValidPreparer(ctx)
@@ -1189,14 +1251,15 @@ func (client MultipleResponsesClient) Get200ModelA400ValidPreparer(ctx context.C
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/200/A/response/400/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get200ModelA400ValidSender sends the Get200ModelA400Valid request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get200ModelA400ValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get200ModelA400ValidResponder handles the response to the Get200ModelA400Valid request. The method always
@@ -1214,14 +1277,16 @@ func (client MultipleResponsesClient) Get200ModelA400ValidResponder(resp *http.R
 
 // Get202None204NoneDefaultError202None send a 202 response with no payload
 func (client MultipleResponsesClient) Get202None204NoneDefaultError202None(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get202None204NoneDefaultError202None")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get202None204NoneDefaultError202None", "GET", "/http/payloads/202/none/204/none/default/Error/response/202/none")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get202None204NoneDefaultError202None", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.Get202None204NoneDefaultError202NonePreparer(ctx)
@@ -1238,7 +1303,7 @@ func (client MultipleResponsesClient) Get202None204NoneDefaultError202None(ctx c
 	}
 
 	result, err = client.Get202None204NoneDefaultError202NoneResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.MultipleResponsesClient", "Get202None204NoneDefaultError202None", resp, "Failure responding to request")
 	}
 
@@ -1251,14 +1316,15 @@ func (client MultipleResponsesClient) Get202None204NoneDefaultError202NonePrepar
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/202/none/204/none/default/Error/response/202/none"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get202None204NoneDefaultError202NoneSender sends the Get202None204NoneDefaultError202None request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get202None204NoneDefaultError202NoneSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get202None204NoneDefaultError202NoneResponder handles the response to the Get202None204NoneDefaultError202None request. The method always
@@ -1267,7 +1333,7 @@ func (client MultipleResponsesClient) Get202None204NoneDefaultError202NoneRespon
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted, http.StatusNoContent),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted, http.StatusNoContent),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1275,14 +1341,16 @@ func (client MultipleResponsesClient) Get202None204NoneDefaultError202NoneRespon
 
 // Get202None204NoneDefaultError204None send a 204 response with no payload
 func (client MultipleResponsesClient) Get202None204NoneDefaultError204None(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get202None204NoneDefaultError204None")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get202None204NoneDefaultError204None", "GET", "/http/payloads/202/none/204/none/default/Error/response/204/none")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get202None204NoneDefaultError204None", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.Get202None204NoneDefaultError204NonePreparer(ctx)
@@ -1299,7 +1367,7 @@ func (client MultipleResponsesClient) Get202None204NoneDefaultError204None(ctx c
 	}
 
 	result, err = client.Get202None204NoneDefaultError204NoneResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.MultipleResponsesClient", "Get202None204NoneDefaultError204None", resp, "Failure responding to request")
 	}
 
@@ -1312,14 +1380,15 @@ func (client MultipleResponsesClient) Get202None204NoneDefaultError204NonePrepar
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/202/none/204/none/default/Error/response/204/none"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get202None204NoneDefaultError204NoneSender sends the Get202None204NoneDefaultError204None request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get202None204NoneDefaultError204NoneSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get202None204NoneDefaultError204NoneResponder handles the response to the Get202None204NoneDefaultError204None request. The method always
@@ -1328,7 +1397,7 @@ func (client MultipleResponsesClient) Get202None204NoneDefaultError204NoneRespon
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted, http.StatusNoContent),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted, http.StatusNoContent),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1337,14 +1406,16 @@ func (client MultipleResponsesClient) Get202None204NoneDefaultError204NoneRespon
 // Get202None204NoneDefaultError400Valid send a 400 response with valid payload: {'code': '400', 'message': 'client
 // error'}
 func (client MultipleResponsesClient) Get202None204NoneDefaultError400Valid(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get202None204NoneDefaultError400Valid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get202None204NoneDefaultError400Valid", "GET", "/http/payloads/202/none/204/none/default/Error/response/400/valid")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get202None204NoneDefaultError400Valid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.Get202None204NoneDefaultError400ValidPreparer(ctx)
@@ -1361,7 +1432,7 @@ func (client MultipleResponsesClient) Get202None204NoneDefaultError400Valid(ctx
 	}
 
 	result, err = client.Get202None204NoneDefaultError400ValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.MultipleResponsesClient", "Get202None204NoneDefaultError400Valid", resp, "Failure responding to request")
 	}
 
@@ -1374,14 +1445,15 @@ func (client MultipleResponsesClient) Get202None204NoneDefaultError400ValidPrepa
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/202/none/204/none/default/Error/response/400/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get202None204NoneDefaultError400ValidSender sends the Get202None204NoneDefaultError400Valid request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get202None204NoneDefaultError400ValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get202None204NoneDefaultError400ValidResponder handles the response to the Get202None204NoneDefaultError400Valid request. The method always
@@ -1390,7 +1462,7 @@ func (client MultipleResponsesClient) Get202None204NoneDefaultError400ValidRespo
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted, http.StatusNoContent),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted, http.StatusNoContent),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1398,14 +1470,16 @@ func (client MultipleResponsesClient) Get202None204NoneDefaultError400ValidRespo
 
 // Get202None204NoneDefaultNone202Invalid send a 202 response with an unexpected payload {'property': 'value'}
 func (client MultipleResponsesClient) Get202None204NoneDefaultNone202Invalid(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get202None204NoneDefaultNone202Invalid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get202None204NoneDefaultNone202Invalid", "GET", "/http/payloads/202/none/204/none/default/none/response/202/invalid")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get202None204NoneDefaultNone202Invalid", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.Get202None204NoneDefaultNone202InvalidPreparer(ctx)
@@ -1435,14 +1509,15 @@ func (client MultipleResponsesClient) Get202None204NoneDefaultNone202InvalidPrep
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/202/none/204/none/default/none/response/202/invalid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get202None204NoneDefaultNone202InvalidSender sends the Get202None204NoneDefaultNone202Invalid request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get202None204NoneDefaultNone202InvalidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get202None204NoneDefaultNone202InvalidResponder handles the response to the Get202None204NoneDefaultNone202Invalid request. The method always
@@ -1459,14 +1534,16 @@ func (client MultipleResponsesClient) Get202None204NoneDefaultNone202InvalidResp
 
 // Get202None204NoneDefaultNone204None send a 204 response with no payload
 func (client MultipleResponsesClient) Get202None204NoneDefaultNone204None(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get202None204NoneDefaultNone204None")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get202None204NoneDefaultNone204None", "GET", "/http/payloads/202/none/204/none/default/none/response/204/none")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get202None204NoneDefaultNone204None", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.Get202None204NoneDefaultNone204NonePreparer(ctx)
@@ -1496,14 +1573,15 @@ func (client MultipleResponsesClient) Get202None204NoneDefaultNone204NonePrepare
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/202/none/204/none/default/none/response/204/none"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get202None204NoneDefaultNone204NoneSender sends the Get202None204NoneDefaultNone204None request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get202None204NoneDefaultNone204NoneSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get202None204NoneDefaultNone204NoneResponder handles the response to the Get202None204NoneDefaultNone204None request. The method always
@@ -1520,14 +1598,16 @@ func (client MultipleResponsesClient) Get202None204NoneDefaultNone204NoneRespond
 
 // Get202None204NoneDefaultNone400Invalid send a 400 response with an unexpected payload {'property': 'value'}
 func (client MultipleResponsesClient) Get202None204NoneDefaultNone400Invalid(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get202None204NoneDefaultNone400Invalid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get202None204NoneDefaultNone400Invalid", "GET", "/http/payloads/202/none/204/none/default/none/response/400/invalid")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get202None204NoneDefaultNone400Invalid", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.Get202None204NoneDefaultNone400InvalidPreparer(ctx)
@@ -1557,14 +1637,15 @@ func (client MultipleResponsesClient) Get202None204NoneDefaultNone400InvalidPrep
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/202/none/204/none/default/none/response/400/invalid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get202None204NoneDefaultNone400InvalidSender sends the Get202None204NoneDefaultNone400Invalid request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get202None204NoneDefaultNone400InvalidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get202None204NoneDefaultNone400InvalidResponder handles the response to the Get202None204NoneDefaultNone400Invalid request. The method always
@@ -1581,14 +1662,16 @@ func (client MultipleResponsesClient) Get202None204NoneDefaultNone400InvalidResp
 
 // Get202None204NoneDefaultNone400None send a 400 response with no payload
 func (client MultipleResponsesClient) Get202None204NoneDefaultNone400None(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.Get202None204NoneDefaultNone400None")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.Get202None204NoneDefaultNone400None", "GET", "/http/payloads/202/none/204/none/default/none/response/400/none")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.Get202None204NoneDefaultNone400None", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.Get202None204NoneDefaultNone400NonePreparer(ctx)
@@ -1618,14 +1701,15 @@ func (client MultipleResponsesClient) Get202None204NoneDefaultNone400NonePrepare
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/202/none/204/none/default/none/response/400/none"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Get202None204NoneDefaultNone400NoneSender sends the Get202None204NoneDefaultNone400None request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) Get202None204NoneDefaultNone400NoneSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Get202None204NoneDefaultNone400NoneResponder handles the response to the Get202None204NoneDefaultNone400None request. The method always
@@ -1642,14 +1726,16 @@ func (client MultipleResponsesClient) Get202None204NoneDefaultNone400NoneRespond
 
 // GetDefaultModelA200None send a 200 response with no payload
 func (client MultipleResponsesClient) GetDefaultModelA200None(ctx context.Context) (result A, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.GetDefaultModelA200None")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.GetDefaultModelA200None", "GET", "/http/payloads/default/A/response/200/none")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.GetDefaultModelA200None", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDefaultModelA200NonePreparer(ctx)
@@ -1666,7 +1752,7 @@ func (client MultipleResponsesClient) GetDefaultModelA200None(ctx context.Contex
 	}
 
 	result, err = client.GetDefaultModelA200NoneResponder(resp)
-	if err != nil {
+	if _, ok := err.(AErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.MultipleResponsesClient", "GetDefaultModelA200None", resp, "Failure responding to request")
 	}
 
@@ -1679,14 +1765,15 @@ func (client MultipleResponsesClient) GetDefaultModelA200NonePreparer(ctx contex
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/default/A/response/200/none"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDefaultModelA200NoneSender sends the GetDefaultModelA200None request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) GetDefaultModelA200NoneSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDefaultModelA200NoneResponder handles the response to the GetDefaultModelA200None request. The method always
@@ -1695,7 +1782,7 @@ func (client MultipleResponsesClient) GetDefaultModelA200NoneResponder(resp *htt
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withAErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1704,14 +1791,16 @@ func (client MultipleResponsesClient) GetDefaultModelA200NoneResponder(resp *htt
 
 // GetDefaultModelA200Valid send a 200 response with valid payload: {'statusCode': '200'}
 func (client MultipleResponsesClient) GetDefaultModelA200Valid(ctx context.Context) (result A, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.GetDefaultModelA200Valid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.GetDefaultModelA200Valid", "GET", "/http/payloads/default/A/response/200/valid")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.GetDefaultModelA200Valid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDefaultModelA200ValidPreparer(ctx)
@@ -1728,7 +1817,7 @@ func (client MultipleResponsesClient) GetDefaultModelA200Valid(ctx context.Conte
 	}
 
 	result, err = client.GetDefaultModelA200ValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(AErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.MultipleResponsesClient", "GetDefaultModelA200Valid", resp, "Failure responding to request")
 	}
 
@@ -1741,14 +1830,15 @@ func (client MultipleResponsesClient) GetDefaultModelA200ValidPreparer(ctx conte
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/default/A/response/200/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDefaultModelA200ValidSender sends the GetDefaultModelA200Valid request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) GetDefaultModelA200ValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDefaultModelA200ValidResponder handles the response to the GetDefaultModelA200Valid request. The method always
@@ -1757,7 +1847,7 @@ func (client MultipleResponsesClient) GetDefaultModelA200ValidResponder(resp *ht
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withAErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1766,14 +1856,16 @@ func (client MultipleResponsesClient) GetDefaultModelA200ValidResponder(resp *ht
 
 // GetDefaultModelA400None send a 400 response with no payload
 func (client MultipleResponsesClient) GetDefaultModelA400None(ctx context.Context) (result A, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.GetDefaultModelA400None")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.GetDefaultModelA400None", "GET", "/http/payloads/default/A/response/400/none")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.GetDefaultModelA400None", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDefaultModelA400NonePreparer(ctx)
@@ -1790,7 +1882,7 @@ func (client MultipleResponsesClient) GetDefaultModelA400None(ctx context.Contex
 	}
 
 	result, err = client.GetDefaultModelA400NoneResponder(resp)
-	if err != nil {
+	if _, ok := err.(AErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.MultipleResponsesClient", "GetDefaultModelA400None", resp, "Failure responding to request")
 	}
 
@@ -1803,14 +1895,15 @@ func (client MultipleResponsesClient) GetDefaultModelA400NonePreparer(ctx contex
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/default/A/response/400/none"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDefaultModelA400NoneSender sends the GetDefaultModelA400None request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) GetDefaultModelA400NoneSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDefaultModelA400NoneResponder handles the response to the GetDefaultModelA400None request. The method always
@@ -1819,7 +1912,7 @@ func (client MultipleResponsesClient) GetDefaultModelA400NoneResponder(resp *htt
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withAErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1828,14 +1921,16 @@ func (client MultipleResponsesClient) GetDefaultModelA400NoneResponder(resp *htt
 
 // GetDefaultModelA400Valid send a 400 response with valid payload: {'statusCode': '400'}
 func (client MultipleResponsesClient) GetDefaultModelA400Valid(ctx context.Context) (result A, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.GetDefaultModelA400Valid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.GetDefaultModelA400Valid", "GET", "/http/payloads/default/A/response/400/valid")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.GetDefaultModelA400Valid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDefaultModelA400ValidPreparer(ctx)
@@ -1852,7 +1947,7 @@ func (client MultipleResponsesClient) GetDefaultModelA400Valid(ctx context.Conte
 	}
 
 	result, err = client.GetDefaultModelA400ValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(AErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "httpinfrastructuregroup.MultipleResponsesClient", "GetDefaultModelA400Valid", resp, "Failure responding to request")
 	}
 
@@ -1865,14 +1960,15 @@ func (client MultipleResponsesClient) GetDefaultModelA400ValidPreparer(ctx conte
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/default/A/response/400/valid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDefaultModelA400ValidSender sends the GetDefaultModelA400Valid request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) GetDefaultModelA400ValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDefaultModelA400ValidResponder handles the response to the GetDefaultModelA400Valid request. The method always
@@ -1881,7 +1977,7 @@ func (client MultipleResponsesClient) GetDefaultModelA400ValidResponder(resp *ht
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withAErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1890,14 +1986,16 @@ func (client MultipleResponsesClient) GetDefaultModelA400ValidResponder(resp *ht
 
 // GetDefaultNone200Invalid send a 200 response with invalid payload: {'statusCode': '200'}
 func (client MultipleResponsesClient) GetDefaultNone200Invalid(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.GetDefaultNone200Invalid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.GetDefaultNone200Invalid", "GET", "/http/payloads/default/none/response/200/invalid")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.GetDefaultNone200Invalid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetDefaultNone200InvalidPreparer(ctx)
@@ -1927,14 +2025,15 @@ func (client MultipleResponsesClient) GetDefaultNone200InvalidPreparer(ctx conte
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/default/none/response/200/invalid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDefaultNone200InvalidSender sends the GetDefaultNone200Invalid request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) GetDefaultNone200InvalidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDefaultNone200InvalidResponder handles the response to the GetDefaultNone200Invalid request. The method always
@@ -1951,14 +2050,16 @@ func (client MultipleResponsesClient) GetDefaultNone200InvalidResponder(resp *ht
 
 // GetDefaultNone200None send a 200 response with no payload
 func (client MultipleResponsesClient) GetDefaultNone200None(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.GetDefaultNone200None")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.GetDefaultNone200None", "GET", "/http/payloads/default/none/response/200/none")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.GetDefaultNone200None", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetDefaultNone200NonePreparer(ctx)
@@ -1988,14 +2089,15 @@ func (client MultipleResponsesClient) GetDefaultNone200NonePreparer(ctx context.
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/default/none/response/200/none"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDefaultNone200NoneSender sends the GetDefaultNone200None request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) GetDefaultNone200NoneSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDefaultNone200NoneResponder handles the response to the GetDefaultNone200None request. The method always
@@ -2012,14 +2114,16 @@ func (client MultipleResponsesClient) GetDefaultNone200NoneResponder(resp *http.
 
 // GetDefaultNone400Invalid send a 400 response with valid payload: {'statusCode': '400'}
 func (client MultipleResponsesClient) GetDefaultNone400Invalid(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.GetDefaultNone400Invalid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.GetDefaultNone400Invalid", "GET", "/http/payloads/default/none/response/400/invalid")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.GetDefaultNone400Invalid", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetDefaultNone400InvalidPreparer(ctx)
@@ -2049,14 +2153,15 @@ func (client MultipleResponsesClient) GetDefaultNone400InvalidPreparer(ctx conte
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/default/none/response/400/invalid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDefaultNone400InvalidSender sends the GetDefaultNone400Invalid request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) GetDefaultNone400InvalidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDefaultNone400InvalidResponder handles the response to the GetDefaultNone400Invalid request. The method always
@@ -2073,14 +2178,16 @@ func (client MultipleResponsesClient) GetDefaultNone400InvalidResponder(resp *ht
 
 // GetDefaultNone400None send a 400 response with no payload
 func (client MultipleResponsesClient) GetDefaultNone400None(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/MultipleResponsesClient.GetDefaultNone400None")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/MultipleResponsesClient.GetDefaultNone400None", "GET", "/http/payloads/default/none/response/400/none")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "MultipleResponsesClient.GetDefaultNone400None", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetDefaultNone400NonePreparer(ctx)
@@ -2110,14 +2217,15 @@ func (client MultipleResponsesClient) GetDefaultNone400NonePreparer(ctx context.
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/http/payloads/default/none/response/400/none"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDefaultNone400NoneSender sends the GetDefaultNone400None request. The method will close the
 // http.Response Body if it receives an error.
 func (client MultipleResponsesClient) GetDefaultNone400NoneSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDefaultNone400NoneResponder handles the response to the GetDefaultNone400None request. The method always
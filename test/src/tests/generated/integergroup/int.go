@@ -9,9 +9,7 @@ package integergroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/date"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -30,16 +28,25 @@ func NewIntClientWithBaseURI(baseURI string) IntClient {
 	return IntClient{NewWithBaseURI(baseURI)}
 }
 
+// NewIntClientWithOptions creates an instance of the IntClient client with the specified options.  It returns an error
+// if the options are invalid.
+func NewIntClientWithOptions(opts ClientOptions) (IntClient, error) {
+	client, err := NewWithOptions(opts)
+	return IntClient{client}, err
+}
+
 // GetInvalid get invalid Int value
 func (client IntClient) GetInvalid(ctx context.Context) (result Int32, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/IntClient.GetInvalid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/IntClient.GetInvalid", "GET", "/int/invalid")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "IntClient.GetInvalid", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetInvalidPreparer(ctx)
@@ -56,7 +63,7 @@ func (client IntClient) GetInvalid(ctx context.Context) (result Int32, err error
 	}
 
 	result, err = client.GetInvalidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "integergroup.IntClient", "GetInvalid", resp, "Failure responding to request")
 	}
 
@@ -69,14 +76,15 @@ func (client IntClient) GetInvalidPreparer(ctx context.Context) (*http.Request,
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/int/invalid"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetInvalidSender sends the GetInvalid request. The method will close the
 // http.Response Body if it receives an error.
 func (client IntClient) GetInvalidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetInvalidResponder handles the response to the GetInvalid request. The method always
@@ -85,7 +93,7 @@ func (client IntClient) GetInvalidResponder(resp *http.Response) (result Int32,
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -94,14 +102,16 @@ func (client IntClient) GetInvalidResponder(resp *http.Response) (result Int32,
 
 // GetInvalidUnixTime get invalid Unix time value
 func (client IntClient) GetInvalidUnixTime(ctx context.Context) (result UnixTime, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/IntClient.GetInvalidUnixTime")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/IntClient.GetInvalidUnixTime", "GET", "/int/invalidunixtime")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "IntClient.GetInvalidUnixTime", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetInvalidUnixTimePreparer(ctx)
@@ -118,7 +128,7 @@ func (client IntClient) GetInvalidUnixTime(ctx context.Context) (result UnixTime
 	}
 
 	result, err = client.GetInvalidUnixTimeResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "integergroup.IntClient", "GetInvalidUnixTime", resp, "Failure responding to request")
 	}
 
@@ -131,14 +141,15 @@ func (client IntClient) GetInvalidUnixTimePreparer(ctx context.Context) (*http.R
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/int/invalidunixtime"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetInvalidUnixTimeSender sends the GetInvalidUnixTime request. The method will close the
 // http.Response Body if it receives an error.
 func (client IntClient) GetInvalidUnixTimeSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetInvalidUnixTimeResponder handles the response to the GetInvalidUnixTime request. The method always
@@ -147,7 +158,7 @@ func (client IntClient) GetInvalidUnixTimeResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -156,14 +167,16 @@ func (client IntClient) GetInvalidUnixTimeResponder(resp *http.Response) (result
 
 // GetNull get null Int value
 func (client IntClient) GetNull(ctx context.Context) (result Int32, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/IntClient.GetNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/IntClient.GetNull", "GET", "/int/null")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "IntClient.GetNull", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetNullPreparer(ctx)
@@ -180,7 +193,7 @@ func (client IntClient) GetNull(ctx context.Context) (result Int32, err error) {
 	}
 
 	result, err = client.GetNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "integergroup.IntClient", "GetNull", resp, "Failure responding to request")
 	}
 
@@ -193,14 +206,15 @@ func (client IntClient) GetNullPreparer(ctx context.Context) (*http.Request, err
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/int/null"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetNullSender sends the GetNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client IntClient) GetNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetNullResponder handles the response to the GetNull request. The method always
@@ -209,7 +223,7 @@ func (client IntClient) GetNullResponder(resp *http.Response) (result Int32, err
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -218,14 +232,16 @@ func (client IntClient) GetNullResponder(resp *http.Response) (result Int32, err
 
 // GetNullUnixTime get null Unix time value
 func (client IntClient) GetNullUnixTime(ctx context.Context) (result UnixTime, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/IntClient.GetNullUnixTime")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/IntClient.GetNullUnixTime", "GET", "/int/nullunixtime")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "IntClient.GetNullUnixTime", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetNullUnixTimePreparer(ctx)
@@ -242,7 +258,7 @@ func (client IntClient) GetNullUnixTime(ctx context.Context) (result UnixTime, e
 	}
 
 	result, err = client.GetNullUnixTimeResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "integergroup.IntClient", "GetNullUnixTime", resp, "Failure responding to request")
 	}
 
@@ -255,14 +271,15 @@ func (client IntClient) GetNullUnixTimePreparer(ctx context.Context) (*http.Requ
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/int/nullunixtime"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetNullUnixTimeSender sends the GetNullUnixTime request. The method will close the
 // http.Response Body if it receives an error.
 func (client IntClient) GetNullUnixTimeSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetNullUnixTimeResponder handles the response to the GetNullUnixTime request. The method always
@@ -271,7 +288,7 @@ func (client IntClient) GetNullUnixTimeResponder(resp *http.Response) (result Un
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -280,14 +297,16 @@ func (client IntClient) GetNullUnixTimeResponder(resp *http.Response) (result Un
 
 // GetOverflowInt32 get overflow Int32 value
 func (client IntClient) GetOverflowInt32(ctx context.Context) (result Int32, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/IntClient.GetOverflowInt32")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/IntClient.GetOverflowInt32", "GET", "/int/overflowint32")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "IntClient.GetOverflowInt32", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetOverflowInt32Preparer(ctx)
@@ -304,7 +323,7 @@ func (client IntClient) GetOverflowInt32(ctx context.Context) (result Int32, err
 	}
 
 	result, err = client.GetOverflowInt32Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "integergroup.IntClient", "GetOverflowInt32", resp, "Failure responding to request")
 	}
 
@@ -317,14 +336,15 @@ func (client IntClient) GetOverflowInt32Preparer(ctx context.Context) (*http.Req
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/int/overflowint32"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetOverflowInt32Sender sends the GetOverflowInt32 request. The method will close the
 // http.Response Body if it receives an error.
 func (client IntClient) GetOverflowInt32Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetOverflowInt32Responder handles the response to the GetOverflowInt32 request. The method always
@@ -333,7 +353,7 @@ func (client IntClient) GetOverflowInt32Responder(resp *http.Response) (result I
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -342,14 +362,16 @@ func (client IntClient) GetOverflowInt32Responder(resp *http.Response) (result I
 
 // GetOverflowInt64 get overflow Int64 value
 func (client IntClient) GetOverflowInt64(ctx context.Context) (result Int64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/IntClient.GetOverflowInt64")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/IntClient.GetOverflowInt64", "GET", "/int/overflowint64")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "IntClient.GetOverflowInt64", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetOverflowInt64Preparer(ctx)
@@ -366,7 +388,7 @@ func (client IntClient) GetOverflowInt64(ctx context.Context) (result Int64, err
 	}
 
 	result, err = client.GetOverflowInt64Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "integergroup.IntClient", "GetOverflowInt64", resp, "Failure responding to request")
 	}
 
@@ -379,14 +401,15 @@ func (client IntClient) GetOverflowInt64Preparer(ctx context.Context) (*http.Req
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/int/overflowint64"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetOverflowInt64Sender sends the GetOverflowInt64 request. The method will close the
 // http.Response Body if it receives an error.
 func (client IntClient) GetOverflowInt64Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetOverflowInt64Responder handles the response to the GetOverflowInt64 request. The method always
@@ -395,7 +418,7 @@ func (client IntClient) GetOverflowInt64Responder(resp *http.Response) (result I
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -404,14 +427,16 @@ func (client IntClient) GetOverflowInt64Responder(resp *http.Response) (result I
 
 // GetUnderflowInt32 get underflow Int32 value
 func (client IntClient) GetUnderflowInt32(ctx context.Context) (result Int32, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/IntClient.GetUnderflowInt32")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/IntClient.GetUnderflowInt32", "GET", "/int/underflowint32")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "IntClient.GetUnderflowInt32", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetUnderflowInt32Preparer(ctx)
@@ -428,7 +453,7 @@ func (client IntClient) GetUnderflowInt32(ctx context.Context) (result Int32, er
 	}
 
 	result, err = client.GetUnderflowInt32Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "integergroup.IntClient", "GetUnderflowInt32", resp, "Failure responding to request")
 	}
 
@@ -441,14 +466,15 @@ func (client IntClient) GetUnderflowInt32Preparer(ctx context.Context) (*http.Re
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/int/underflowint32"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetUnderflowInt32Sender sends the GetUnderflowInt32 request. The method will close the
 // http.Response Body if it receives an error.
 func (client IntClient) GetUnderflowInt32Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetUnderflowInt32Responder handles the response to the GetUnderflowInt32 request. The method always
@@ -457,7 +483,7 @@ func (client IntClient) GetUnderflowInt32Responder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -466,14 +492,16 @@ func (client IntClient) GetUnderflowInt32Responder(resp *http.Response) (result
 
 // GetUnderflowInt64 get underflow Int64 value
 func (client IntClient) GetUnderflowInt64(ctx context.Context) (result Int64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/IntClient.GetUnderflowInt64")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/IntClient.GetUnderflowInt64", "GET", "/int/underflowint64")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "IntClient.GetUnderflowInt64", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetUnderflowInt64Preparer(ctx)
@@ -490,7 +518,7 @@ func (client IntClient) GetUnderflowInt64(ctx context.Context) (result Int64, er
 	}
 
 	result, err = client.GetUnderflowInt64Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "integergroup.IntClient", "GetUnderflowInt64", resp, "Failure responding to request")
 	}
 
@@ -503,14 +531,15 @@ func (client IntClient) GetUnderflowInt64Preparer(ctx context.Context) (*http.Re
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/int/underflowint64"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetUnderflowInt64Sender sends the GetUnderflowInt64 request. The method will close the
 // http.Response Body if it receives an error.
 func (client IntClient) GetUnderflowInt64Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetUnderflowInt64Responder handles the response to the GetUnderflowInt64 request. The method always
@@ -519,7 +548,7 @@ func (client IntClient) GetUnderflowInt64Responder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -528,14 +557,16 @@ func (client IntClient) GetUnderflowInt64Responder(resp *http.Response) (result
 
 // GetUnixTime get datetime encoded as Unix time value
 func (client IntClient) GetUnixTime(ctx context.Context) (result UnixTime, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/IntClient.GetUnixTime")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/IntClient.GetUnixTime", "GET", "/int/unixtime")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "IntClient.GetUnixTime", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetUnixTimePreparer(ctx)
@@ -552,7 +583,7 @@ func (client IntClient) GetUnixTime(ctx context.Context) (result UnixTime, err e
 	}
 
 	result, err = client.GetUnixTimeResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "integergroup.IntClient", "GetUnixTime", resp, "Failure responding to request")
 	}
 
@@ -565,14 +596,15 @@ func (client IntClient) GetUnixTimePreparer(ctx context.Context) (*http.Request,
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/int/unixtime"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetUnixTimeSender sends the GetUnixTime request. The method will close the
 // http.Response Body if it receives an error.
 func (client IntClient) GetUnixTimeSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetUnixTimeResponder handles the response to the GetUnixTime request. The method always
@@ -581,7 +613,7 @@ func (client IntClient) GetUnixTimeResponder(resp *http.Response) (result UnixTi
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -590,14 +622,16 @@ func (client IntClient) GetUnixTimeResponder(resp *http.Response) (result UnixTi
 
 // PutMax32 put max int32 value
 func (client IntClient) PutMax32(ctx context.Context, intBody int32) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/IntClient.PutMax32")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/IntClient.PutMax32", "PUT", "/int/max/32")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "IntClient.PutMax32", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutMax32Preparer(ctx, intBody)
@@ -614,7 +648,7 @@ func (client IntClient) PutMax32(ctx context.Context, intBody int32) (result aut
 	}
 
 	result, err = client.PutMax32Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "integergroup.IntClient", "PutMax32", resp, "Failure responding to request")
 	}
 
@@ -629,14 +663,15 @@ func (client IntClient) PutMax32Preparer(ctx context.Context, intBody int32) (*h
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/int/max/32"),
 		autorest.WithJSON(intBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutMax32Sender sends the PutMax32 request. The method will close the
 // http.Response Body if it receives an error.
 func (client IntClient) PutMax32Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutMax32Responder handles the response to the PutMax32 request. The method always
@@ -645,7 +680,7 @@ func (client IntClient) PutMax32Responder(resp *http.Response) (result autorest.
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -653,14 +688,16 @@ func (client IntClient) PutMax32Responder(resp *http.Response) (result autorest.
 
 // PutMax64 put max int64 value
 func (client IntClient) PutMax64(ctx context.Context, intBody int64) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/IntClient.PutMax64")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/IntClient.PutMax64", "PUT", "/int/max/64")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "IntClient.PutMax64", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutMax64Preparer(ctx, intBody)
@@ -677,7 +714,7 @@ func (client IntClient) PutMax64(ctx context.Context, intBody int64) (result aut
 	}
 
 	result, err = client.PutMax64Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "integergroup.IntClient", "PutMax64", resp, "Failure responding to request")
 	}
 
@@ -692,14 +729,15 @@ func (client IntClient) PutMax64Preparer(ctx context.Context, intBody int64) (*h
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/int/max/64"),
 		autorest.WithJSON(intBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutMax64Sender sends the PutMax64 request. The method will close the
 // http.Response Body if it receives an error.
 func (client IntClient) PutMax64Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutMax64Responder handles the response to the PutMax64 request. The method always
@@ -708,7 +746,7 @@ func (client IntClient) PutMax64Responder(resp *http.Response) (result autorest.
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -716,14 +754,16 @@ func (client IntClient) PutMax64Responder(resp *http.Response) (result autorest.
 
 // PutMin32 put min int32 value
 func (client IntClient) PutMin32(ctx context.Context, intBody int32) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/IntClient.PutMin32")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/IntClient.PutMin32", "PUT", "/int/min/32")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "IntClient.PutMin32", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutMin32Preparer(ctx, intBody)
@@ -740,7 +780,7 @@ func (client IntClient) PutMin32(ctx context.Context, intBody int32) (result aut
 	}
 
 	result, err = client.PutMin32Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "integergroup.IntClient", "PutMin32", resp, "Failure responding to request")
 	}
 
@@ -755,14 +795,15 @@ func (client IntClient) PutMin32Preparer(ctx context.Context, intBody int32) (*h
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/int/min/32"),
 		autorest.WithJSON(intBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutMin32Sender sends the PutMin32 request. The method will close the
 // http.Response Body if it receives an error.
 func (client IntClient) PutMin32Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutMin32Responder handles the response to the PutMin32 request. The method always
@@ -771,7 +812,7 @@ func (client IntClient) PutMin32Responder(resp *http.Response) (result autorest.
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -779,14 +820,16 @@ func (client IntClient) PutMin32Responder(resp *http.Response) (result autorest.
 
 // PutMin64 put min int64 value
 func (client IntClient) PutMin64(ctx context.Context, intBody int64) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/IntClient.PutMin64")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/IntClient.PutMin64", "PUT", "/int/min/64")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "IntClient.PutMin64", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutMin64Preparer(ctx, intBody)
@@ -803,7 +846,7 @@ func (client IntClient) PutMin64(ctx context.Context, intBody int64) (result aut
 	}
 
 	result, err = client.PutMin64Responder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "integergroup.IntClient", "PutMin64", resp, "Failure responding to request")
 	}
 
@@ -818,14 +861,15 @@ func (client IntClient) PutMin64Preparer(ctx context.Context, intBody int64) (*h
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/int/min/64"),
 		autorest.WithJSON(intBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutMin64Sender sends the PutMin64 request. The method will close the
 // http.Response Body if it receives an error.
 func (client IntClient) PutMin64Sender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutMin64Responder handles the response to the PutMin64 request. The method always
@@ -834,7 +878,7 @@ func (client IntClient) PutMin64Responder(resp *http.Response) (result autorest.
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -842,14 +886,16 @@ func (client IntClient) PutMin64Responder(resp *http.Response) (result autorest.
 
 // PutUnixTimeDate put datetime encoded as Unix time
 func (client IntClient) PutUnixTimeDate(ctx context.Context, intBody date.UnixTime) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/IntClient.PutUnixTimeDate")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/IntClient.PutUnixTimeDate", "PUT", "/int/unixtime")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "IntClient.PutUnixTimeDate", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutUnixTimeDatePreparer(ctx, intBody)
@@ -866,7 +912,7 @@ func (client IntClient) PutUnixTimeDate(ctx context.Context, intBody date.UnixTi
 	}
 
 	result, err = client.PutUnixTimeDateResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "integergroup.IntClient", "PutUnixTimeDate", resp, "Failure responding to request")
 	}
 
@@ -881,14 +927,15 @@ func (client IntClient) PutUnixTimeDatePreparer(ctx context.Context, intBody dat
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/int/unixtime"),
 		autorest.WithJSON(intBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutUnixTimeDateSender sends the PutUnixTimeDate request. The method will close the
 // http.Response Body if it receives an error.
 func (client IntClient) PutUnixTimeDateSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutUnixTimeDateResponder handles the response to the PutUnixTimeDate request. The method always
@@ -897,7 +944,7 @@ func (client IntClient) PutUnixTimeDateResponder(resp *http.Response) (result au
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -10,7 +10,6 @@ import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -29,17 +28,26 @@ func NewLRORetrysClientWithBaseURI(baseURI string) LRORetrysClient {
 	return LRORetrysClient{NewWithBaseURI(baseURI)}
 }
 
+// NewLRORetrysClientWithOptions creates an instance of the LRORetrysClient client with the specified options.  It
+// returns an error if the options are invalid.
+func NewLRORetrysClientWithOptions(opts ClientOptions) (LRORetrysClient, error) {
+	client, err := NewWithOptions(opts)
+	return LRORetrysClient{client}, err
+}
+
 // Delete202Retry200 long running delete request, service returns a 500, then a 202 to the initial request. Polls
 // return this value until the last poll returns a ‘200’ with ProvisioningState=’Succeeded’
 func (client LRORetrysClient) Delete202Retry200(ctx context.Context) (result LRORetrysDelete202Retry200Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LRORetrysClient.Delete202Retry200")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LRORetrysClient.Delete202Retry200", "DELETE", "/lro/retryerror/delete/202/retry/200")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LRORetrysClient.Delete202Retry200", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Delete202Retry200Preparer(ctx)
@@ -63,15 +71,16 @@ func (client LRORetrysClient) Delete202Retry200Preparer(ctx context.Context) (*h
 		autorest.AsDelete(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/retryerror/delete/202/retry/200"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Delete202Retry200Sender sends the Delete202Retry200 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LRORetrysClient) Delete202Retry200Sender(req *http.Request) (future LRORetrysDelete202Retry200Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -79,13 +88,29 @@ func (client LRORetrysClient) Delete202Retry200Sender(req *http.Request) (future
 	return
 }
 
+// ResumeDelete202Retry200 rebuilds the future returned from Delete202Retry200 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LRORetrysClient) ResumeDelete202Retry200(ctx context.Context, token string) (result LRORetrysDelete202Retry200Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LRORetrysClient.ResumeDelete202Retry200", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LRORetrysDelete202Retry200Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysClient", "ResumeDelete202Retry200", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Delete202Retry200Responder handles the response to the Delete202Retry200 request. The method always
 // closes the http.Response Body.
 func (client LRORetrysClient) Delete202Retry200Responder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -94,14 +119,16 @@ func (client LRORetrysClient) Delete202Retry200Responder(resp *http.Response) (r
 // DeleteAsyncRelativeRetrySucceeded long running delete request, service returns a 500, then a 202 to the initial
 // request. Poll the endpoint indicated in the Azure-AsyncOperation header for operation status
 func (client LRORetrysClient) DeleteAsyncRelativeRetrySucceeded(ctx context.Context) (result LRORetrysDeleteAsyncRelativeRetrySucceededFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LRORetrysClient.DeleteAsyncRelativeRetrySucceeded")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LRORetrysClient.DeleteAsyncRelativeRetrySucceeded", "DELETE", "/lro/retryerror/deleteasync/retry/succeeded")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LRORetrysClient.DeleteAsyncRelativeRetrySucceeded", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.DeleteAsyncRelativeRetrySucceededPreparer(ctx)
@@ -125,15 +152,16 @@ func (client LRORetrysClient) DeleteAsyncRelativeRetrySucceededPreparer(ctx cont
 		autorest.AsDelete(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/retryerror/deleteasync/retry/succeeded"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DeleteAsyncRelativeRetrySucceededSender sends the DeleteAsyncRelativeRetrySucceeded request. The method will close the
 // http.Response Body if it receives an error.
 func (client LRORetrysClient) DeleteAsyncRelativeRetrySucceededSender(req *http.Request) (future LRORetrysDeleteAsyncRelativeRetrySucceededFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -141,13 +169,29 @@ func (client LRORetrysClient) DeleteAsyncRelativeRetrySucceededSender(req *http.
 	return
 }
 
+// ResumeDeleteAsyncRelativeRetrySucceeded rebuilds the future returned from DeleteAsyncRelativeRetrySucceeded using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LRORetrysClient) ResumeDeleteAsyncRelativeRetrySucceeded(ctx context.Context, token string) (result LRORetrysDeleteAsyncRelativeRetrySucceededFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LRORetrysClient.ResumeDeleteAsyncRelativeRetrySucceeded", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LRORetrysDeleteAsyncRelativeRetrySucceededFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysClient", "ResumeDeleteAsyncRelativeRetrySucceeded", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // DeleteAsyncRelativeRetrySucceededResponder handles the response to the DeleteAsyncRelativeRetrySucceeded request. The method always
 // closes the http.Response Body.
 func (client LRORetrysClient) DeleteAsyncRelativeRetrySucceededResponder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -157,14 +201,16 @@ func (client LRORetrysClient) DeleteAsyncRelativeRetrySucceededResponder(resp *h
 // initial request, with an entity that contains ProvisioningState=’Accepted’.  Polls return this value until the last
 // poll returns a ‘200’ with ProvisioningState=’Succeeded’
 func (client LRORetrysClient) DeleteProvisioning202Accepted200Succeeded(ctx context.Context) (result LRORetrysDeleteProvisioning202Accepted200SucceededFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LRORetrysClient.DeleteProvisioning202Accepted200Succeeded")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LRORetrysClient.DeleteProvisioning202Accepted200Succeeded", "DELETE", "/lro/retryerror/delete/provisioning/202/accepted/200/succeeded")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LRORetrysClient.DeleteProvisioning202Accepted200Succeeded", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.DeleteProvisioning202Accepted200SucceededPreparer(ctx)
@@ -188,15 +234,16 @@ func (client LRORetrysClient) DeleteProvisioning202Accepted200SucceededPreparer(
 		autorest.AsDelete(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/retryerror/delete/provisioning/202/accepted/200/succeeded"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DeleteProvisioning202Accepted200SucceededSender sends the DeleteProvisioning202Accepted200Succeeded request. The method will close the
 // http.Response Body if it receives an error.
 func (client LRORetrysClient) DeleteProvisioning202Accepted200SucceededSender(req *http.Request) (future LRORetrysDeleteProvisioning202Accepted200SucceededFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -204,13 +251,29 @@ func (client LRORetrysClient) DeleteProvisioning202Accepted200SucceededSender(re
 	return
 }
 
+// ResumeDeleteProvisioning202Accepted200Succeeded rebuilds the future returned from DeleteProvisioning202Accepted200Succeeded using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LRORetrysClient) ResumeDeleteProvisioning202Accepted200Succeeded(ctx context.Context, token string) (result LRORetrysDeleteProvisioning202Accepted200SucceededFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LRORetrysClient.ResumeDeleteProvisioning202Accepted200Succeeded", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LRORetrysDeleteProvisioning202Accepted200SucceededFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysClient", "ResumeDeleteProvisioning202Accepted200Succeeded", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // DeleteProvisioning202Accepted200SucceededResponder handles the response to the DeleteProvisioning202Accepted200Succeeded request. The method always
 // closes the http.Response Body.
 func (client LRORetrysClient) DeleteProvisioning202Accepted200SucceededResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -222,14 +285,16 @@ func (client LRORetrysClient) DeleteProvisioning202Accepted200SucceededResponder
 // Parameters:
 // product - product to put
 func (client LRORetrysClient) Post202Retry200(ctx context.Context, product *Product) (result LRORetrysPost202Retry200Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LRORetrysClient.Post202Retry200")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LRORetrysClient.Post202Retry200", "POST", "/lro/retryerror/post/202/retry/200")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LRORetrysClient.Post202Retry200", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Post202Retry200Preparer(ctx, product)
@@ -258,15 +323,16 @@ func (client LRORetrysClient) Post202Retry200Preparer(ctx context.Context, produ
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Post202Retry200Sender sends the Post202Retry200 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LRORetrysClient) Post202Retry200Sender(req *http.Request) (future LRORetrysPost202Retry200Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, true))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -274,13 +340,29 @@ func (client LRORetrysClient) Post202Retry200Sender(req *http.Request) (future L
 	return
 }
 
+// ResumePost202Retry200 rebuilds the future returned from Post202Retry200 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LRORetrysClient) ResumePost202Retry200(ctx context.Context, token string) (result LRORetrysPost202Retry200Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LRORetrysClient.ResumePost202Retry200", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LRORetrysPost202Retry200Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysClient", "ResumePost202Retry200", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Post202Retry200Responder handles the response to the Post202Retry200 request. The method always
 // closes the http.Response Body.
 func (client LRORetrysClient) Post202Retry200Responder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -292,14 +374,16 @@ func (client LRORetrysClient) Post202Retry200Responder(resp *http.Response) (res
 // Parameters:
 // product - product to put
 func (client LRORetrysClient) PostAsyncRelativeRetrySucceeded(ctx context.Context, product *Product) (result LRORetrysPostAsyncRelativeRetrySucceededFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LRORetrysClient.PostAsyncRelativeRetrySucceeded")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LRORetrysClient.PostAsyncRelativeRetrySucceeded", "POST", "/lro/retryerror/postasync/retry/succeeded")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LRORetrysClient.PostAsyncRelativeRetrySucceeded", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PostAsyncRelativeRetrySucceededPreparer(ctx, product)
@@ -328,15 +412,16 @@ func (client LRORetrysClient) PostAsyncRelativeRetrySucceededPreparer(ctx contex
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostAsyncRelativeRetrySucceededSender sends the PostAsyncRelativeRetrySucceeded request. The method will close the
 // http.Response Body if it receives an error.
 func (client LRORetrysClient) PostAsyncRelativeRetrySucceededSender(req *http.Request) (future LRORetrysPostAsyncRelativeRetrySucceededFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, true))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -344,13 +429,29 @@ func (client LRORetrysClient) PostAsyncRelativeRetrySucceededSender(req *http.Re
 	return
 }
 
+// ResumePostAsyncRelativeRetrySucceeded rebuilds the future returned from PostAsyncRelativeRetrySucceeded using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LRORetrysClient) ResumePostAsyncRelativeRetrySucceeded(ctx context.Context, token string) (result LRORetrysPostAsyncRelativeRetrySucceededFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LRORetrysClient.ResumePostAsyncRelativeRetrySucceeded", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LRORetrysPostAsyncRelativeRetrySucceededFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysClient", "ResumePostAsyncRelativeRetrySucceeded", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PostAsyncRelativeRetrySucceededResponder handles the response to the PostAsyncRelativeRetrySucceeded request. The method always
 // closes the http.Response Body.
 func (client LRORetrysClient) PostAsyncRelativeRetrySucceededResponder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -362,14 +463,16 @@ func (client LRORetrysClient) PostAsyncRelativeRetrySucceededResponder(resp *htt
 // Parameters:
 // product - product to put
 func (client LRORetrysClient) Put201CreatingSucceeded200(ctx context.Context, product *Product) (result LRORetrysPut201CreatingSucceeded200Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LRORetrysClient.Put201CreatingSucceeded200")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LRORetrysClient.Put201CreatingSucceeded200", "PUT", "/lro/retryerror/put/201/creating/succeeded/200")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LRORetrysClient.Put201CreatingSucceeded200", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Put201CreatingSucceeded200Preparer(ctx, product)
@@ -398,15 +501,16 @@ func (client LRORetrysClient) Put201CreatingSucceeded200Preparer(ctx context.Con
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Put201CreatingSucceeded200Sender sends the Put201CreatingSucceeded200 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LRORetrysClient) Put201CreatingSucceeded200Sender(req *http.Request) (future LRORetrysPut201CreatingSucceeded200Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -414,13 +518,29 @@ func (client LRORetrysClient) Put201CreatingSucceeded200Sender(req *http.Request
 	return
 }
 
+// ResumePut201CreatingSucceeded200 rebuilds the future returned from Put201CreatingSucceeded200 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LRORetrysClient) ResumePut201CreatingSucceeded200(ctx context.Context, token string) (result LRORetrysPut201CreatingSucceeded200Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LRORetrysClient.ResumePut201CreatingSucceeded200", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LRORetrysPut201CreatingSucceeded200Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysClient", "ResumePut201CreatingSucceeded200", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Put201CreatingSucceeded200Responder handles the response to the Put201CreatingSucceeded200 request. The method always
 // closes the http.Response Body.
 func (client LRORetrysClient) Put201CreatingSucceeded200Responder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusCreated),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -433,14 +553,16 @@ func (client LRORetrysClient) Put201CreatingSucceeded200Responder(resp *http.Res
 // Parameters:
 // product - product to put
 func (client LRORetrysClient) PutAsyncRelativeRetrySucceeded(ctx context.Context, product *Product) (result LRORetrysPutAsyncRelativeRetrySucceededFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LRORetrysClient.PutAsyncRelativeRetrySucceeded")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LRORetrysClient.PutAsyncRelativeRetrySucceeded", "PUT", "/lro/retryerror/putasync/retry/succeeded")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LRORetrysClient.PutAsyncRelativeRetrySucceeded", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PutAsyncRelativeRetrySucceededPreparer(ctx, product)
@@ -469,15 +591,16 @@ func (client LRORetrysClient) PutAsyncRelativeRetrySucceededPreparer(ctx context
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutAsyncRelativeRetrySucceededSender sends the PutAsyncRelativeRetrySucceeded request. The method will close the
 // http.Response Body if it receives an error.
 func (client LRORetrysClient) PutAsyncRelativeRetrySucceededSender(req *http.Request) (future LRORetrysPutAsyncRelativeRetrySucceededFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -485,13 +608,29 @@ func (client LRORetrysClient) PutAsyncRelativeRetrySucceededSender(req *http.Req
 	return
 }
 
+// ResumePutAsyncRelativeRetrySucceeded rebuilds the future returned from PutAsyncRelativeRetrySucceeded using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LRORetrysClient) ResumePutAsyncRelativeRetrySucceeded(ctx context.Context, token string) (result LRORetrysPutAsyncRelativeRetrySucceededFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LRORetrysClient.ResumePutAsyncRelativeRetrySucceeded", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LRORetrysPutAsyncRelativeRetrySucceededFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysClient", "ResumePutAsyncRelativeRetrySucceeded", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PutAsyncRelativeRetrySucceededResponder handles the response to the PutAsyncRelativeRetrySucceeded request. The method always
 // closes the http.Response Body.
 func (client LRORetrysClient) PutAsyncRelativeRetrySucceededResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -10,7 +10,6 @@ import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -29,17 +28,26 @@ func NewLROsClientWithBaseURI(baseURI string) LROsClient {
 	return LROsClient{NewWithBaseURI(baseURI)}
 }
 
+// NewLROsClientWithOptions creates an instance of the LROsClient client with the specified options.  It returns an
+// error if the options are invalid.
+func NewLROsClientWithOptions(opts ClientOptions) (LROsClient, error) {
+	client, err := NewWithOptions(opts)
+	return LROsClient{client}, err
+}
+
 // Delete202NoRetry204 long running delete request, service returns a 202 to the initial request. Polls return this
 // value until the last poll returns a ‘200’ with ProvisioningState=’Succeeded’
 func (client LROsClient) Delete202NoRetry204(ctx context.Context) (result LROsDelete202NoRetry204Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.Delete202NoRetry204")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.Delete202NoRetry204", "DELETE", "/lro/delete/202/noretry/204")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.Delete202NoRetry204", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Delete202NoRetry204Preparer(ctx)
@@ -63,15 +71,16 @@ func (client LROsClient) Delete202NoRetry204Preparer(ctx context.Context) (*http
 		autorest.AsDelete(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/delete/202/noretry/204"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Delete202NoRetry204Sender sends the Delete202NoRetry204 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) Delete202NoRetry204Sender(req *http.Request) (future LROsDelete202NoRetry204Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -79,13 +88,29 @@ func (client LROsClient) Delete202NoRetry204Sender(req *http.Request) (future LR
 	return
 }
 
+// ResumeDelete202NoRetry204 rebuilds the future returned from Delete202NoRetry204 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumeDelete202NoRetry204(ctx context.Context, token string) (result LROsDelete202NoRetry204Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumeDelete202NoRetry204", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsDelete202NoRetry204Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumeDelete202NoRetry204", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Delete202NoRetry204Responder handles the response to the Delete202NoRetry204 request. The method always
 // closes the http.Response Body.
 func (client LROsClient) Delete202NoRetry204Responder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -95,14 +120,16 @@ func (client LROsClient) Delete202NoRetry204Responder(resp *http.Response) (resu
 // Delete202Retry200 long running delete request, service returns a 202 to the initial request. Polls return this value
 // until the last poll returns a ‘200’ with ProvisioningState=’Succeeded’
 func (client LROsClient) Delete202Retry200(ctx context.Context) (result LROsDelete202Retry200Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.Delete202Retry200")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.Delete202Retry200", "DELETE", "/lro/delete/202/retry/200")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.Delete202Retry200", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Delete202Retry200Preparer(ctx)
@@ -126,15 +153,16 @@ func (client LROsClient) Delete202Retry200Preparer(ctx context.Context) (*http.R
 		autorest.AsDelete(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/delete/202/retry/200"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Delete202Retry200Sender sends the Delete202Retry200 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) Delete202Retry200Sender(req *http.Request) (future LROsDelete202Retry200Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -142,13 +170,29 @@ func (client LROsClient) Delete202Retry200Sender(req *http.Request) (future LROs
 	return
 }
 
+// ResumeDelete202Retry200 rebuilds the future returned from Delete202Retry200 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumeDelete202Retry200(ctx context.Context, token string) (result LROsDelete202Retry200Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumeDelete202Retry200", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsDelete202Retry200Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumeDelete202Retry200", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Delete202Retry200Responder handles the response to the Delete202Retry200 request. The method always
 // closes the http.Response Body.
 func (client LROsClient) Delete202Retry200Responder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -157,14 +201,16 @@ func (client LROsClient) Delete202Retry200Responder(resp *http.Response) (result
 
 // Delete204Succeeded long running delete succeeds and returns right away
 func (client LROsClient) Delete204Succeeded(ctx context.Context) (result LROsDelete204SucceededFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.Delete204Succeeded")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.Delete204Succeeded", "DELETE", "/lro/delete/204/succeeded")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.Delete204Succeeded", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Delete204SucceededPreparer(ctx)
@@ -188,15 +234,16 @@ func (client LROsClient) Delete204SucceededPreparer(ctx context.Context) (*http.
 		autorest.AsDelete(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/delete/204/succeeded"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Delete204SucceededSender sends the Delete204Succeeded request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) Delete204SucceededSender(req *http.Request) (future LROsDelete204SucceededFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -204,13 +251,29 @@ func (client LROsClient) Delete204SucceededSender(req *http.Request) (future LRO
 	return
 }
 
+// ResumeDelete204Succeeded rebuilds the future returned from Delete204Succeeded using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumeDelete204Succeeded(ctx context.Context, token string) (result LROsDelete204SucceededFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumeDelete204Succeeded", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsDelete204SucceededFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumeDelete204Succeeded", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Delete204SucceededResponder handles the response to the Delete204Succeeded request. The method always
 // closes the http.Response Body.
 func (client LROsClient) Delete204SucceededResponder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusNoContent),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -219,14 +282,16 @@ func (client LROsClient) Delete204SucceededResponder(resp *http.Response) (resul
 // DeleteAsyncNoHeaderInRetry long running delete request, service returns an Azure-AsyncOperation header in the
 // initial request. Subsequent calls to operation status do not contain Azure-AsyncOperation header.
 func (client LROsClient) DeleteAsyncNoHeaderInRetry(ctx context.Context) (result LROsDeleteAsyncNoHeaderInRetryFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.DeleteAsyncNoHeaderInRetry")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.DeleteAsyncNoHeaderInRetry", "DELETE", "/lro/deleteasync/noheader/202/204")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.DeleteAsyncNoHeaderInRetry", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.DeleteAsyncNoHeaderInRetryPreparer(ctx)
@@ -250,15 +315,16 @@ func (client LROsClient) DeleteAsyncNoHeaderInRetryPreparer(ctx context.Context)
 		autorest.AsDelete(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/deleteasync/noheader/202/204"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DeleteAsyncNoHeaderInRetrySender sends the DeleteAsyncNoHeaderInRetry request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) DeleteAsyncNoHeaderInRetrySender(req *http.Request) (future LROsDeleteAsyncNoHeaderInRetryFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -266,13 +332,29 @@ func (client LROsClient) DeleteAsyncNoHeaderInRetrySender(req *http.Request) (fu
 	return
 }
 
+// ResumeDeleteAsyncNoHeaderInRetry rebuilds the future returned from DeleteAsyncNoHeaderInRetry using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumeDeleteAsyncNoHeaderInRetry(ctx context.Context, token string) (result LROsDeleteAsyncNoHeaderInRetryFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumeDeleteAsyncNoHeaderInRetry", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsDeleteAsyncNoHeaderInRetryFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumeDeleteAsyncNoHeaderInRetry", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // DeleteAsyncNoHeaderInRetryResponder handles the response to the DeleteAsyncNoHeaderInRetry request. The method always
 // closes the http.Response Body.
 func (client LROsClient) DeleteAsyncNoHeaderInRetryResponder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted, http.StatusNoContent),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted, http.StatusNoContent),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -281,14 +363,16 @@ func (client LROsClient) DeleteAsyncNoHeaderInRetryResponder(resp *http.Response
 // DeleteAsyncNoRetrySucceeded long running delete request, service returns a 202 to the initial request. Poll the
 // endpoint indicated in the Azure-AsyncOperation header for operation status
 func (client LROsClient) DeleteAsyncNoRetrySucceeded(ctx context.Context) (result LROsDeleteAsyncNoRetrySucceededFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.DeleteAsyncNoRetrySucceeded")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.DeleteAsyncNoRetrySucceeded", "DELETE", "/lro/deleteasync/noretry/succeeded")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.DeleteAsyncNoRetrySucceeded", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.DeleteAsyncNoRetrySucceededPreparer(ctx)
@@ -312,15 +396,16 @@ func (client LROsClient) DeleteAsyncNoRetrySucceededPreparer(ctx context.Context
 		autorest.AsDelete(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/deleteasync/noretry/succeeded"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DeleteAsyncNoRetrySucceededSender sends the DeleteAsyncNoRetrySucceeded request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) DeleteAsyncNoRetrySucceededSender(req *http.Request) (future LROsDeleteAsyncNoRetrySucceededFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -328,13 +413,29 @@ func (client LROsClient) DeleteAsyncNoRetrySucceededSender(req *http.Request) (f
 	return
 }
 
+// ResumeDeleteAsyncNoRetrySucceeded rebuilds the future returned from DeleteAsyncNoRetrySucceeded using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumeDeleteAsyncNoRetrySucceeded(ctx context.Context, token string) (result LROsDeleteAsyncNoRetrySucceededFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumeDeleteAsyncNoRetrySucceeded", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsDeleteAsyncNoRetrySucceededFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumeDeleteAsyncNoRetrySucceeded", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // DeleteAsyncNoRetrySucceededResponder handles the response to the DeleteAsyncNoRetrySucceeded request. The method always
 // closes the http.Response Body.
 func (client LROsClient) DeleteAsyncNoRetrySucceededResponder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -343,14 +444,16 @@ func (client LROsClient) DeleteAsyncNoRetrySucceededResponder(resp *http.Respons
 // DeleteAsyncRetrycanceled long running delete request, service returns a 202 to the initial request. Poll the
 // endpoint indicated in the Azure-AsyncOperation header for operation status
 func (client LROsClient) DeleteAsyncRetrycanceled(ctx context.Context) (result LROsDeleteAsyncRetrycanceledFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.DeleteAsyncRetrycanceled")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.DeleteAsyncRetrycanceled", "DELETE", "/lro/deleteasync/retry/canceled")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.DeleteAsyncRetrycanceled", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.DeleteAsyncRetrycanceledPreparer(ctx)
@@ -374,15 +477,16 @@ func (client LROsClient) DeleteAsyncRetrycanceledPreparer(ctx context.Context) (
 		autorest.AsDelete(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/deleteasync/retry/canceled"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DeleteAsyncRetrycanceledSender sends the DeleteAsyncRetrycanceled request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) DeleteAsyncRetrycanceledSender(req *http.Request) (future LROsDeleteAsyncRetrycanceledFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -390,13 +494,29 @@ func (client LROsClient) DeleteAsyncRetrycanceledSender(req *http.Request) (futu
 	return
 }
 
+// ResumeDeleteAsyncRetrycanceled rebuilds the future returned from DeleteAsyncRetrycanceled using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumeDeleteAsyncRetrycanceled(ctx context.Context, token string) (result LROsDeleteAsyncRetrycanceledFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumeDeleteAsyncRetrycanceled", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsDeleteAsyncRetrycanceledFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumeDeleteAsyncRetrycanceled", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // DeleteAsyncRetrycanceledResponder handles the response to the DeleteAsyncRetrycanceled request. The method always
 // closes the http.Response Body.
 func (client LROsClient) DeleteAsyncRetrycanceledResponder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -405,14 +525,16 @@ func (client LROsClient) DeleteAsyncRetrycanceledResponder(resp *http.Response)
 // DeleteAsyncRetryFailed long running delete request, service returns a 202 to the initial request. Poll the endpoint
 // indicated in the Azure-AsyncOperation header for operation status
 func (client LROsClient) DeleteAsyncRetryFailed(ctx context.Context) (result LROsDeleteAsyncRetryFailedFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.DeleteAsyncRetryFailed")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.DeleteAsyncRetryFailed", "DELETE", "/lro/deleteasync/retry/failed")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.DeleteAsyncRetryFailed", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.DeleteAsyncRetryFailedPreparer(ctx)
@@ -436,15 +558,16 @@ func (client LROsClient) DeleteAsyncRetryFailedPreparer(ctx context.Context) (*h
 		autorest.AsDelete(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/deleteasync/retry/failed"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DeleteAsyncRetryFailedSender sends the DeleteAsyncRetryFailed request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) DeleteAsyncRetryFailedSender(req *http.Request) (future LROsDeleteAsyncRetryFailedFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -452,13 +575,29 @@ func (client LROsClient) DeleteAsyncRetryFailedSender(req *http.Request) (future
 	return
 }
 
+// ResumeDeleteAsyncRetryFailed rebuilds the future returned from DeleteAsyncRetryFailed using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumeDeleteAsyncRetryFailed(ctx context.Context, token string) (result LROsDeleteAsyncRetryFailedFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumeDeleteAsyncRetryFailed", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsDeleteAsyncRetryFailedFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumeDeleteAsyncRetryFailed", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // DeleteAsyncRetryFailedResponder handles the response to the DeleteAsyncRetryFailed request. The method always
 // closes the http.Response Body.
 func (client LROsClient) DeleteAsyncRetryFailedResponder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -467,14 +606,16 @@ func (client LROsClient) DeleteAsyncRetryFailedResponder(resp *http.Response) (r
 // DeleteAsyncRetrySucceeded long running delete request, service returns a 202 to the initial request. Poll the
 // endpoint indicated in the Azure-AsyncOperation header for operation status
 func (client LROsClient) DeleteAsyncRetrySucceeded(ctx context.Context) (result LROsDeleteAsyncRetrySucceededFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.DeleteAsyncRetrySucceeded")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.DeleteAsyncRetrySucceeded", "DELETE", "/lro/deleteasync/retry/succeeded")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.DeleteAsyncRetrySucceeded", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.DeleteAsyncRetrySucceededPreparer(ctx)
@@ -498,15 +639,16 @@ func (client LROsClient) DeleteAsyncRetrySucceededPreparer(ctx context.Context)
 		autorest.AsDelete(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/deleteasync/retry/succeeded"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DeleteAsyncRetrySucceededSender sends the DeleteAsyncRetrySucceeded request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) DeleteAsyncRetrySucceededSender(req *http.Request) (future LROsDeleteAsyncRetrySucceededFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -514,13 +656,29 @@ func (client LROsClient) DeleteAsyncRetrySucceededSender(req *http.Request) (fut
 	return
 }
 
+// ResumeDeleteAsyncRetrySucceeded rebuilds the future returned from DeleteAsyncRetrySucceeded using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumeDeleteAsyncRetrySucceeded(ctx context.Context, token string) (result LROsDeleteAsyncRetrySucceededFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumeDeleteAsyncRetrySucceeded", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsDeleteAsyncRetrySucceededFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumeDeleteAsyncRetrySucceeded", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // DeleteAsyncRetrySucceededResponder handles the response to the DeleteAsyncRetrySucceeded request. The method always
 // closes the http.Response Body.
 func (client LROsClient) DeleteAsyncRetrySucceededResponder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -529,14 +687,16 @@ func (client LROsClient) DeleteAsyncRetrySucceededResponder(resp *http.Response)
 // DeleteNoHeaderInRetry long running delete request, service returns a location header in the initial request.
 // Subsequent calls to operation status do not contain location header.
 func (client LROsClient) DeleteNoHeaderInRetry(ctx context.Context) (result LROsDeleteNoHeaderInRetryFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.DeleteNoHeaderInRetry")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.DeleteNoHeaderInRetry", "DELETE", "/lro/delete/noheader")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.DeleteNoHeaderInRetry", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.DeleteNoHeaderInRetryPreparer(ctx)
@@ -560,15 +720,16 @@ func (client LROsClient) DeleteNoHeaderInRetryPreparer(ctx context.Context) (*ht
 		autorest.AsDelete(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/delete/noheader"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DeleteNoHeaderInRetrySender sends the DeleteNoHeaderInRetry request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) DeleteNoHeaderInRetrySender(req *http.Request) (future LROsDeleteNoHeaderInRetryFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -576,13 +737,29 @@ func (client LROsClient) DeleteNoHeaderInRetrySender(req *http.Request) (future
 	return
 }
 
+// ResumeDeleteNoHeaderInRetry rebuilds the future returned from DeleteNoHeaderInRetry using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumeDeleteNoHeaderInRetry(ctx context.Context, token string) (result LROsDeleteNoHeaderInRetryFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumeDeleteNoHeaderInRetry", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsDeleteNoHeaderInRetryFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumeDeleteNoHeaderInRetry", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // DeleteNoHeaderInRetryResponder handles the response to the DeleteNoHeaderInRetry request. The method always
 // closes the http.Response Body.
 func (client LROsClient) DeleteNoHeaderInRetryResponder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted, http.StatusNoContent),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted, http.StatusNoContent),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -592,14 +769,16 @@ func (client LROsClient) DeleteNoHeaderInRetryResponder(resp *http.Response) (re
 // with an entity that contains ProvisioningState=’Accepted’.  Polls return this value until the last poll returns a
 // ‘200’ with ProvisioningState=’Succeeded’
 func (client LROsClient) DeleteProvisioning202Accepted200Succeeded(ctx context.Context) (result LROsDeleteProvisioning202Accepted200SucceededFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.DeleteProvisioning202Accepted200Succeeded")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.DeleteProvisioning202Accepted200Succeeded", "DELETE", "/lro/delete/provisioning/202/accepted/200/succeeded")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.DeleteProvisioning202Accepted200Succeeded", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.DeleteProvisioning202Accepted200SucceededPreparer(ctx)
@@ -623,15 +802,16 @@ func (client LROsClient) DeleteProvisioning202Accepted200SucceededPreparer(ctx c
 		autorest.AsDelete(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/delete/provisioning/202/accepted/200/succeeded"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DeleteProvisioning202Accepted200SucceededSender sends the DeleteProvisioning202Accepted200Succeeded request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) DeleteProvisioning202Accepted200SucceededSender(req *http.Request) (future LROsDeleteProvisioning202Accepted200SucceededFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -639,13 +819,29 @@ func (client LROsClient) DeleteProvisioning202Accepted200SucceededSender(req *ht
 	return
 }
 
+// ResumeDeleteProvisioning202Accepted200Succeeded rebuilds the future returned from DeleteProvisioning202Accepted200Succeeded using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumeDeleteProvisioning202Accepted200Succeeded(ctx context.Context, token string) (result LROsDeleteProvisioning202Accepted200SucceededFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumeDeleteProvisioning202Accepted200Succeeded", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsDeleteProvisioning202Accepted200SucceededFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumeDeleteProvisioning202Accepted200Succeeded", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // DeleteProvisioning202Accepted200SucceededResponder handles the response to the DeleteProvisioning202Accepted200Succeeded request. The method always
 // closes the http.Response Body.
 func (client LROsClient) DeleteProvisioning202Accepted200SucceededResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -656,14 +852,16 @@ func (client LROsClient) DeleteProvisioning202Accepted200SucceededResponder(resp
 // with an entity that contains ProvisioningState=’Creating’.  Polls return this value until the last poll returns a
 // ‘200’ with ProvisioningState=’Canceled’
 func (client LROsClient) DeleteProvisioning202Deletingcanceled200(ctx context.Context) (result LROsDeleteProvisioning202Deletingcanceled200Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.DeleteProvisioning202Deletingcanceled200")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.DeleteProvisioning202Deletingcanceled200", "DELETE", "/lro/delete/provisioning/202/deleting/200/canceled")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.DeleteProvisioning202Deletingcanceled200", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.DeleteProvisioning202Deletingcanceled200Preparer(ctx)
@@ -687,15 +885,16 @@ func (client LROsClient) DeleteProvisioning202Deletingcanceled200Preparer(ctx co
 		autorest.AsDelete(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/delete/provisioning/202/deleting/200/canceled"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DeleteProvisioning202Deletingcanceled200Sender sends the DeleteProvisioning202Deletingcanceled200 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) DeleteProvisioning202Deletingcanceled200Sender(req *http.Request) (future LROsDeleteProvisioning202Deletingcanceled200Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -703,13 +902,29 @@ func (client LROsClient) DeleteProvisioning202Deletingcanceled200Sender(req *htt
 	return
 }
 
+// ResumeDeleteProvisioning202Deletingcanceled200 rebuilds the future returned from DeleteProvisioning202Deletingcanceled200 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumeDeleteProvisioning202Deletingcanceled200(ctx context.Context, token string) (result LROsDeleteProvisioning202Deletingcanceled200Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumeDeleteProvisioning202Deletingcanceled200", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsDeleteProvisioning202Deletingcanceled200Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumeDeleteProvisioning202Deletingcanceled200", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // DeleteProvisioning202Deletingcanceled200Responder handles the response to the DeleteProvisioning202Deletingcanceled200 request. The method always
 // closes the http.Response Body.
 func (client LROsClient) DeleteProvisioning202Deletingcanceled200Responder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -720,14 +935,16 @@ func (client LROsClient) DeleteProvisioning202Deletingcanceled200Responder(resp
 // with an entity that contains ProvisioningState=’Creating’.  Polls return this value until the last poll returns a
 // ‘200’ with ProvisioningState=’Failed’
 func (client LROsClient) DeleteProvisioning202DeletingFailed200(ctx context.Context) (result LROsDeleteProvisioning202DeletingFailed200Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.DeleteProvisioning202DeletingFailed200")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.DeleteProvisioning202DeletingFailed200", "DELETE", "/lro/delete/provisioning/202/deleting/200/failed")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.DeleteProvisioning202DeletingFailed200", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.DeleteProvisioning202DeletingFailed200Preparer(ctx)
@@ -751,15 +968,16 @@ func (client LROsClient) DeleteProvisioning202DeletingFailed200Preparer(ctx cont
 		autorest.AsDelete(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/delete/provisioning/202/deleting/200/failed"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DeleteProvisioning202DeletingFailed200Sender sends the DeleteProvisioning202DeletingFailed200 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) DeleteProvisioning202DeletingFailed200Sender(req *http.Request) (future LROsDeleteProvisioning202DeletingFailed200Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -767,13 +985,29 @@ func (client LROsClient) DeleteProvisioning202DeletingFailed200Sender(req *http.
 	return
 }
 
+// ResumeDeleteProvisioning202DeletingFailed200 rebuilds the future returned from DeleteProvisioning202DeletingFailed200 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumeDeleteProvisioning202DeletingFailed200(ctx context.Context, token string) (result LROsDeleteProvisioning202DeletingFailed200Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumeDeleteProvisioning202DeletingFailed200", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsDeleteProvisioning202DeletingFailed200Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumeDeleteProvisioning202DeletingFailed200", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // DeleteProvisioning202DeletingFailed200Responder handles the response to the DeleteProvisioning202DeletingFailed200 request. The method always
 // closes the http.Response Body.
 func (client LROsClient) DeleteProvisioning202DeletingFailed200Responder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -783,14 +1017,16 @@ func (client LROsClient) DeleteProvisioning202DeletingFailed200Responder(resp *h
 // Post200WithPayload long running post request, service returns a 202 to the initial request, with 'Location' header.
 // Poll returns a 200 with a response body after success.
 func (client LROsClient) Post200WithPayload(ctx context.Context) (result LROsPost200WithPayloadFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.Post200WithPayload")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.Post200WithPayload", "POST", "/lro/post/payload/200")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.Post200WithPayload", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Post200WithPayloadPreparer(ctx)
@@ -814,15 +1050,16 @@ func (client LROsClient) Post200WithPayloadPreparer(ctx context.Context) (*http.
 		autorest.AsPost(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/post/payload/200"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Post200WithPayloadSender sends the Post200WithPayload request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) Post200WithPayloadSender(req *http.Request) (future LROsPost200WithPayloadFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -830,13 +1067,29 @@ func (client LROsClient) Post200WithPayloadSender(req *http.Request) (future LRO
 	return
 }
 
+// ResumePost200WithPayload rebuilds the future returned from Post200WithPayload using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePost200WithPayload(ctx context.Context, token string) (result LROsPost200WithPayloadFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePost200WithPayload", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPost200WithPayloadFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePost200WithPayload", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Post200WithPayloadResponder handles the response to the Post200WithPayload request. The method always
 // closes the http.Response Body.
 func (client LROsClient) Post200WithPayloadResponder(resp *http.Response) (result Sku, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -848,14 +1101,16 @@ func (client LROsClient) Post200WithPayloadResponder(resp *http.Response) (resul
 // Parameters:
 // product - product to put
 func (client LROsClient) Post202NoRetry204(ctx context.Context, product *Product) (result LROsPost202NoRetry204Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.Post202NoRetry204")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.Post202NoRetry204", "POST", "/lro/post/202/noretry/204")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.Post202NoRetry204", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Post202NoRetry204Preparer(ctx, product)
@@ -884,15 +1139,16 @@ func (client LROsClient) Post202NoRetry204Preparer(ctx context.Context, product
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Post202NoRetry204Sender sends the Post202NoRetry204 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) Post202NoRetry204Sender(req *http.Request) (future LROsPost202NoRetry204Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -900,13 +1156,29 @@ func (client LROsClient) Post202NoRetry204Sender(req *http.Request) (future LROs
 	return
 }
 
+// ResumePost202NoRetry204 rebuilds the future returned from Post202NoRetry204 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePost202NoRetry204(ctx context.Context, token string) (result LROsPost202NoRetry204Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePost202NoRetry204", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPost202NoRetry204Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePost202NoRetry204", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Post202NoRetry204Responder handles the response to the Post202NoRetry204 request. The method always
 // closes the http.Response Body.
 func (client LROsClient) Post202NoRetry204Responder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -918,14 +1190,16 @@ func (client LROsClient) Post202NoRetry204Responder(resp *http.Response) (result
 // Parameters:
 // product - product to put
 func (client LROsClient) Post202Retry200(ctx context.Context, product *Product) (result LROsPost202Retry200Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.Post202Retry200")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.Post202Retry200", "POST", "/lro/post/202/retry/200")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.Post202Retry200", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Post202Retry200Preparer(ctx, product)
@@ -954,15 +1228,16 @@ func (client LROsClient) Post202Retry200Preparer(ctx context.Context, product *P
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Post202Retry200Sender sends the Post202Retry200 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) Post202Retry200Sender(req *http.Request) (future LROsPost202Retry200Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -970,13 +1245,29 @@ func (client LROsClient) Post202Retry200Sender(req *http.Request) (future LROsPo
 	return
 }
 
+// ResumePost202Retry200 rebuilds the future returned from Post202Retry200 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePost202Retry200(ctx context.Context, token string) (result LROsPost202Retry200Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePost202Retry200", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPost202Retry200Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePost202Retry200", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Post202Retry200Responder handles the response to the Post202Retry200 request. The method always
 // closes the http.Response Body.
 func (client LROsClient) Post202Retry200Responder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -988,14 +1279,16 @@ func (client LROsClient) Post202Retry200Responder(resp *http.Response) (result a
 // Parameters:
 // product - product to put
 func (client LROsClient) PostAsyncNoRetrySucceeded(ctx context.Context, product *Product) (result LROsPostAsyncNoRetrySucceededFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.PostAsyncNoRetrySucceeded")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.PostAsyncNoRetrySucceeded", "POST", "/lro/postasync/noretry/succeeded")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.PostAsyncNoRetrySucceeded", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PostAsyncNoRetrySucceededPreparer(ctx, product)
@@ -1024,15 +1317,16 @@ func (client LROsClient) PostAsyncNoRetrySucceededPreparer(ctx context.Context,
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostAsyncNoRetrySucceededSender sends the PostAsyncNoRetrySucceeded request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) PostAsyncNoRetrySucceededSender(req *http.Request) (future LROsPostAsyncNoRetrySucceededFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1040,13 +1334,29 @@ func (client LROsClient) PostAsyncNoRetrySucceededSender(req *http.Request) (fut
 	return
 }
 
+// ResumePostAsyncNoRetrySucceeded rebuilds the future returned from PostAsyncNoRetrySucceeded using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePostAsyncNoRetrySucceeded(ctx context.Context, token string) (result LROsPostAsyncNoRetrySucceededFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePostAsyncNoRetrySucceeded", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPostAsyncNoRetrySucceededFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePostAsyncNoRetrySucceeded", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PostAsyncNoRetrySucceededResponder handles the response to the PostAsyncNoRetrySucceeded request. The method always
 // closes the http.Response Body.
 func (client LROsClient) PostAsyncNoRetrySucceededResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1059,14 +1369,16 @@ func (client LROsClient) PostAsyncNoRetrySucceededResponder(resp *http.Response)
 // Parameters:
 // product - product to put
 func (client LROsClient) PostAsyncRetrycanceled(ctx context.Context, product *Product) (result LROsPostAsyncRetrycanceledFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.PostAsyncRetrycanceled")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.PostAsyncRetrycanceled", "POST", "/lro/postasync/retry/canceled")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.PostAsyncRetrycanceled", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PostAsyncRetrycanceledPreparer(ctx, product)
@@ -1095,15 +1407,16 @@ func (client LROsClient) PostAsyncRetrycanceledPreparer(ctx context.Context, pro
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostAsyncRetrycanceledSender sends the PostAsyncRetrycanceled request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) PostAsyncRetrycanceledSender(req *http.Request) (future LROsPostAsyncRetrycanceledFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1111,13 +1424,29 @@ func (client LROsClient) PostAsyncRetrycanceledSender(req *http.Request) (future
 	return
 }
 
+// ResumePostAsyncRetrycanceled rebuilds the future returned from PostAsyncRetrycanceled using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePostAsyncRetrycanceled(ctx context.Context, token string) (result LROsPostAsyncRetrycanceledFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePostAsyncRetrycanceled", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPostAsyncRetrycanceledFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePostAsyncRetrycanceled", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PostAsyncRetrycanceledResponder handles the response to the PostAsyncRetrycanceled request. The method always
 // closes the http.Response Body.
 func (client LROsClient) PostAsyncRetrycanceledResponder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1129,14 +1458,16 @@ func (client LROsClient) PostAsyncRetrycanceledResponder(resp *http.Response) (r
 // Parameters:
 // product - product to put
 func (client LROsClient) PostAsyncRetryFailed(ctx context.Context, product *Product) (result LROsPostAsyncRetryFailedFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.PostAsyncRetryFailed")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.PostAsyncRetryFailed", "POST", "/lro/postasync/retry/failed")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.PostAsyncRetryFailed", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PostAsyncRetryFailedPreparer(ctx, product)
@@ -1165,15 +1496,16 @@ func (client LROsClient) PostAsyncRetryFailedPreparer(ctx context.Context, produ
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostAsyncRetryFailedSender sends the PostAsyncRetryFailed request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) PostAsyncRetryFailedSender(req *http.Request) (future LROsPostAsyncRetryFailedFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1181,13 +1513,29 @@ func (client LROsClient) PostAsyncRetryFailedSender(req *http.Request) (future L
 	return
 }
 
+// ResumePostAsyncRetryFailed rebuilds the future returned from PostAsyncRetryFailed using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePostAsyncRetryFailed(ctx context.Context, token string) (result LROsPostAsyncRetryFailedFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePostAsyncRetryFailed", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPostAsyncRetryFailedFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePostAsyncRetryFailed", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PostAsyncRetryFailedResponder handles the response to the PostAsyncRetryFailed request. The method always
 // closes the http.Response Body.
 func (client LROsClient) PostAsyncRetryFailedResponder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1199,14 +1547,16 @@ func (client LROsClient) PostAsyncRetryFailedResponder(resp *http.Response) (res
 // Parameters:
 // product - product to put
 func (client LROsClient) PostAsyncRetrySucceeded(ctx context.Context, product *Product) (result LROsPostAsyncRetrySucceededFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.PostAsyncRetrySucceeded")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.PostAsyncRetrySucceeded", "POST", "/lro/postasync/retry/succeeded")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.PostAsyncRetrySucceeded", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PostAsyncRetrySucceededPreparer(ctx, product)
@@ -1235,15 +1585,16 @@ func (client LROsClient) PostAsyncRetrySucceededPreparer(ctx context.Context, pr
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostAsyncRetrySucceededSender sends the PostAsyncRetrySucceeded request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) PostAsyncRetrySucceededSender(req *http.Request) (future LROsPostAsyncRetrySucceededFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1251,13 +1602,29 @@ func (client LROsClient) PostAsyncRetrySucceededSender(req *http.Request) (futur
 	return
 }
 
+// ResumePostAsyncRetrySucceeded rebuilds the future returned from PostAsyncRetrySucceeded using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePostAsyncRetrySucceeded(ctx context.Context, token string) (result LROsPostAsyncRetrySucceededFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePostAsyncRetrySucceeded", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPostAsyncRetrySucceededFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePostAsyncRetrySucceeded", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PostAsyncRetrySucceededResponder handles the response to the PostAsyncRetrySucceeded request. The method always
 // closes the http.Response Body.
 func (client LROsClient) PostAsyncRetrySucceededResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1268,14 +1635,16 @@ func (client LROsClient) PostAsyncRetrySucceededResponder(resp *http.Response) (
 // both Location and Azure-Async header. Poll Azure-Async and it's success. Should NOT poll Location to get the final
 // object
 func (client LROsClient) PostDoubleHeadersFinalAzureHeaderGet(ctx context.Context) (result LROsPostDoubleHeadersFinalAzureHeaderGetFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.PostDoubleHeadersFinalAzureHeaderGet")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.PostDoubleHeadersFinalAzureHeaderGet", "POST", "/lro/LROPostDoubleHeadersFinalAzureHeaderGet")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.PostDoubleHeadersFinalAzureHeaderGet", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PostDoubleHeadersFinalAzureHeaderGetPreparer(ctx)
@@ -1299,15 +1668,16 @@ func (client LROsClient) PostDoubleHeadersFinalAzureHeaderGetPreparer(ctx contex
 		autorest.AsPost(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/LROPostDoubleHeadersFinalAzureHeaderGet"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostDoubleHeadersFinalAzureHeaderGetSender sends the PostDoubleHeadersFinalAzureHeaderGet request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) PostDoubleHeadersFinalAzureHeaderGetSender(req *http.Request) (future LROsPostDoubleHeadersFinalAzureHeaderGetFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1315,13 +1685,29 @@ func (client LROsClient) PostDoubleHeadersFinalAzureHeaderGetSender(req *http.Re
 	return
 }
 
+// ResumePostDoubleHeadersFinalAzureHeaderGet rebuilds the future returned from PostDoubleHeadersFinalAzureHeaderGet using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePostDoubleHeadersFinalAzureHeaderGet(ctx context.Context, token string) (result LROsPostDoubleHeadersFinalAzureHeaderGetFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePostDoubleHeadersFinalAzureHeaderGet", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPostDoubleHeadersFinalAzureHeaderGetFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePostDoubleHeadersFinalAzureHeaderGet", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PostDoubleHeadersFinalAzureHeaderGetResponder handles the response to the PostDoubleHeadersFinalAzureHeaderGet request. The method always
 // closes the http.Response Body.
 func (client LROsClient) PostDoubleHeadersFinalAzureHeaderGetResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1332,14 +1718,16 @@ func (client LROsClient) PostDoubleHeadersFinalAzureHeaderGetResponder(resp *htt
 // with both Location and Azure-Async header. Poll Azure-Async and it's success. Should NOT poll Location to get the
 // final object if you support initial Autorest behavior.
 func (client LROsClient) PostDoubleHeadersFinalAzureHeaderGetDefault(ctx context.Context) (result LROsPostDoubleHeadersFinalAzureHeaderGetDefaultFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.PostDoubleHeadersFinalAzureHeaderGetDefault")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.PostDoubleHeadersFinalAzureHeaderGetDefault", "POST", "/lro/LROPostDoubleHeadersFinalAzureHeaderGetDefault")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.PostDoubleHeadersFinalAzureHeaderGetDefault", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PostDoubleHeadersFinalAzureHeaderGetDefaultPreparer(ctx)
@@ -1363,15 +1751,16 @@ func (client LROsClient) PostDoubleHeadersFinalAzureHeaderGetDefaultPreparer(ctx
 		autorest.AsPost(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/LROPostDoubleHeadersFinalAzureHeaderGetDefault"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostDoubleHeadersFinalAzureHeaderGetDefaultSender sends the PostDoubleHeadersFinalAzureHeaderGetDefault request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) PostDoubleHeadersFinalAzureHeaderGetDefaultSender(req *http.Request) (future LROsPostDoubleHeadersFinalAzureHeaderGetDefaultFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1379,13 +1768,29 @@ func (client LROsClient) PostDoubleHeadersFinalAzureHeaderGetDefaultSender(req *
 	return
 }
 
+// ResumePostDoubleHeadersFinalAzureHeaderGetDefault rebuilds the future returned from PostDoubleHeadersFinalAzureHeaderGetDefault using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePostDoubleHeadersFinalAzureHeaderGetDefault(ctx context.Context, token string) (result LROsPostDoubleHeadersFinalAzureHeaderGetDefaultFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePostDoubleHeadersFinalAzureHeaderGetDefault", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPostDoubleHeadersFinalAzureHeaderGetDefaultFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePostDoubleHeadersFinalAzureHeaderGetDefault", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PostDoubleHeadersFinalAzureHeaderGetDefaultResponder handles the response to the PostDoubleHeadersFinalAzureHeaderGetDefault request. The method always
 // closes the http.Response Body.
 func (client LROsClient) PostDoubleHeadersFinalAzureHeaderGetDefaultResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1395,14 +1800,16 @@ func (client LROsClient) PostDoubleHeadersFinalAzureHeaderGetDefaultResponder(re
 // PostDoubleHeadersFinalLocationGet long running post request, service returns a 202 to the initial request with both
 // Location and Azure-Async header. Poll Azure-Async and it's success. Should poll Location to get the final object
 func (client LROsClient) PostDoubleHeadersFinalLocationGet(ctx context.Context) (result LROsPostDoubleHeadersFinalLocationGetFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.PostDoubleHeadersFinalLocationGet")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.PostDoubleHeadersFinalLocationGet", "POST", "/lro/LROPostDoubleHeadersFinalLocationGet")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.PostDoubleHeadersFinalLocationGet", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PostDoubleHeadersFinalLocationGetPreparer(ctx)
@@ -1426,15 +1833,16 @@ func (client LROsClient) PostDoubleHeadersFinalLocationGetPreparer(ctx context.C
 		autorest.AsPost(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/LROPostDoubleHeadersFinalLocationGet"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostDoubleHeadersFinalLocationGetSender sends the PostDoubleHeadersFinalLocationGet request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) PostDoubleHeadersFinalLocationGetSender(req *http.Request) (future LROsPostDoubleHeadersFinalLocationGetFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1442,13 +1850,29 @@ func (client LROsClient) PostDoubleHeadersFinalLocationGetSender(req *http.Reque
 	return
 }
 
+// ResumePostDoubleHeadersFinalLocationGet rebuilds the future returned from PostDoubleHeadersFinalLocationGet using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePostDoubleHeadersFinalLocationGet(ctx context.Context, token string) (result LROsPostDoubleHeadersFinalLocationGetFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePostDoubleHeadersFinalLocationGet", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPostDoubleHeadersFinalLocationGetFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePostDoubleHeadersFinalLocationGet", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PostDoubleHeadersFinalLocationGetResponder handles the response to the PostDoubleHeadersFinalLocationGet request. The method always
 // closes the http.Response Body.
 func (client LROsClient) PostDoubleHeadersFinalLocationGetResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1461,14 +1885,16 @@ func (client LROsClient) PostDoubleHeadersFinalLocationGetResponder(resp *http.R
 // Parameters:
 // product - product to put
 func (client LROsClient) Put200Acceptedcanceled200(ctx context.Context, product *Product) (result LROsPut200Acceptedcanceled200Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.Put200Acceptedcanceled200")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.Put200Acceptedcanceled200", "PUT", "/lro/put/200/accepted/canceled/200")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.Put200Acceptedcanceled200", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Put200Acceptedcanceled200Preparer(ctx, product)
@@ -1497,15 +1923,16 @@ func (client LROsClient) Put200Acceptedcanceled200Preparer(ctx context.Context,
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Put200Acceptedcanceled200Sender sends the Put200Acceptedcanceled200 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) Put200Acceptedcanceled200Sender(req *http.Request) (future LROsPut200Acceptedcanceled200Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1513,13 +1940,29 @@ func (client LROsClient) Put200Acceptedcanceled200Sender(req *http.Request) (fut
 	return
 }
 
+// ResumePut200Acceptedcanceled200 rebuilds the future returned from Put200Acceptedcanceled200 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePut200Acceptedcanceled200(ctx context.Context, token string) (result LROsPut200Acceptedcanceled200Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePut200Acceptedcanceled200", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPut200Acceptedcanceled200Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePut200Acceptedcanceled200", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Put200Acceptedcanceled200Responder handles the response to the Put200Acceptedcanceled200 request. The method always
 // closes the http.Response Body.
 func (client LROsClient) Put200Acceptedcanceled200Responder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1531,14 +1974,16 @@ func (client LROsClient) Put200Acceptedcanceled200Responder(resp *http.Response)
 // Parameters:
 // product - product to put
 func (client LROsClient) Put200Succeeded(ctx context.Context, product *Product) (result LROsPut200SucceededFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.Put200Succeeded")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.Put200Succeeded", "PUT", "/lro/put/200/succeeded")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.Put200Succeeded", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Put200SucceededPreparer(ctx, product)
@@ -1567,15 +2012,16 @@ func (client LROsClient) Put200SucceededPreparer(ctx context.Context, product *P
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Put200SucceededSender sends the Put200Succeeded request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) Put200SucceededSender(req *http.Request) (future LROsPut200SucceededFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1583,13 +2029,29 @@ func (client LROsClient) Put200SucceededSender(req *http.Request) (future LROsPu
 	return
 }
 
+// ResumePut200Succeeded rebuilds the future returned from Put200Succeeded using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePut200Succeeded(ctx context.Context, token string) (result LROsPut200SucceededFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePut200Succeeded", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPut200SucceededFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePut200Succeeded", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Put200SucceededResponder handles the response to the Put200Succeeded request. The method always
 // closes the http.Response Body.
 func (client LROsClient) Put200SucceededResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusNoContent),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1601,14 +2063,16 @@ func (client LROsClient) Put200SucceededResponder(resp *http.Response) (result P
 // Parameters:
 // product - product to put
 func (client LROsClient) Put200SucceededNoState(ctx context.Context, product *Product) (result LROsPut200SucceededNoStateFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.Put200SucceededNoState")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.Put200SucceededNoState", "PUT", "/lro/put/200/succeeded/nostate")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.Put200SucceededNoState", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Put200SucceededNoStatePreparer(ctx, product)
@@ -1637,15 +2101,16 @@ func (client LROsClient) Put200SucceededNoStatePreparer(ctx context.Context, pro
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Put200SucceededNoStateSender sends the Put200SucceededNoState request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) Put200SucceededNoStateSender(req *http.Request) (future LROsPut200SucceededNoStateFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1653,13 +2118,29 @@ func (client LROsClient) Put200SucceededNoStateSender(req *http.Request) (future
 	return
 }
 
+// ResumePut200SucceededNoState rebuilds the future returned from Put200SucceededNoState using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePut200SucceededNoState(ctx context.Context, token string) (result LROsPut200SucceededNoStateFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePut200SucceededNoState", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPut200SucceededNoStateFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePut200SucceededNoState", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Put200SucceededNoStateResponder handles the response to the Put200SucceededNoState request. The method always
 // closes the http.Response Body.
 func (client LROsClient) Put200SucceededNoStateResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1672,14 +2153,16 @@ func (client LROsClient) Put200SucceededNoStateResponder(resp *http.Response) (r
 // Parameters:
 // product - product to put
 func (client LROsClient) Put200UpdatingSucceeded204(ctx context.Context, product *Product) (result LROsPut200UpdatingSucceeded204Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.Put200UpdatingSucceeded204")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.Put200UpdatingSucceeded204", "PUT", "/lro/put/200/updating/succeeded/200")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.Put200UpdatingSucceeded204", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Put200UpdatingSucceeded204Preparer(ctx, product)
@@ -1708,15 +2191,16 @@ func (client LROsClient) Put200UpdatingSucceeded204Preparer(ctx context.Context,
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Put200UpdatingSucceeded204Sender sends the Put200UpdatingSucceeded204 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) Put200UpdatingSucceeded204Sender(req *http.Request) (future LROsPut200UpdatingSucceeded204Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1724,13 +2208,29 @@ func (client LROsClient) Put200UpdatingSucceeded204Sender(req *http.Request) (fu
 	return
 }
 
+// ResumePut200UpdatingSucceeded204 rebuilds the future returned from Put200UpdatingSucceeded204 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePut200UpdatingSucceeded204(ctx context.Context, token string) (result LROsPut200UpdatingSucceeded204Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePut200UpdatingSucceeded204", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPut200UpdatingSucceeded204Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePut200UpdatingSucceeded204", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Put200UpdatingSucceeded204Responder handles the response to the Put200UpdatingSucceeded204 request. The method always
 // closes the http.Response Body.
 func (client LROsClient) Put200UpdatingSucceeded204Responder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1743,14 +2243,16 @@ func (client LROsClient) Put200UpdatingSucceeded204Responder(resp *http.Response
 // Parameters:
 // product - product to put
 func (client LROsClient) Put201CreatingFailed200(ctx context.Context, product *Product) (result LROsPut201CreatingFailed200Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.Put201CreatingFailed200")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.Put201CreatingFailed200", "PUT", "/lro/put/201/created/failed/200")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.Put201CreatingFailed200", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Put201CreatingFailed200Preparer(ctx, product)
@@ -1779,15 +2281,16 @@ func (client LROsClient) Put201CreatingFailed200Preparer(ctx context.Context, pr
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Put201CreatingFailed200Sender sends the Put201CreatingFailed200 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) Put201CreatingFailed200Sender(req *http.Request) (future LROsPut201CreatingFailed200Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1795,13 +2298,29 @@ func (client LROsClient) Put201CreatingFailed200Sender(req *http.Request) (futur
 	return
 }
 
+// ResumePut201CreatingFailed200 rebuilds the future returned from Put201CreatingFailed200 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePut201CreatingFailed200(ctx context.Context, token string) (result LROsPut201CreatingFailed200Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePut201CreatingFailed200", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPut201CreatingFailed200Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePut201CreatingFailed200", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Put201CreatingFailed200Responder handles the response to the Put201CreatingFailed200 request. The method always
 // closes the http.Response Body.
 func (client LROsClient) Put201CreatingFailed200Responder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusCreated),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1814,14 +2333,16 @@ func (client LROsClient) Put201CreatingFailed200Responder(resp *http.Response) (
 // Parameters:
 // product - product to put
 func (client LROsClient) Put201CreatingSucceeded200(ctx context.Context, product *Product) (result LROsPut201CreatingSucceeded200Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.Put201CreatingSucceeded200")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.Put201CreatingSucceeded200", "PUT", "/lro/put/201/creating/succeeded/200")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.Put201CreatingSucceeded200", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Put201CreatingSucceeded200Preparer(ctx, product)
@@ -1850,15 +2371,16 @@ func (client LROsClient) Put201CreatingSucceeded200Preparer(ctx context.Context,
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Put201CreatingSucceeded200Sender sends the Put201CreatingSucceeded200 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) Put201CreatingSucceeded200Sender(req *http.Request) (future LROsPut201CreatingSucceeded200Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1866,13 +2388,29 @@ func (client LROsClient) Put201CreatingSucceeded200Sender(req *http.Request) (fu
 	return
 }
 
+// ResumePut201CreatingSucceeded200 rebuilds the future returned from Put201CreatingSucceeded200 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePut201CreatingSucceeded200(ctx context.Context, token string) (result LROsPut201CreatingSucceeded200Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePut201CreatingSucceeded200", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPut201CreatingSucceeded200Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePut201CreatingSucceeded200", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Put201CreatingSucceeded200Responder handles the response to the Put201CreatingSucceeded200 request. The method always
 // closes the http.Response Body.
 func (client LROsClient) Put201CreatingSucceeded200Responder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusCreated),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1884,14 +2422,16 @@ func (client LROsClient) Put201CreatingSucceeded200Responder(resp *http.Response
 // Parameters:
 // product - product to put
 func (client LROsClient) Put202Retry200(ctx context.Context, product *Product) (result LROsPut202Retry200Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.Put202Retry200")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.Put202Retry200", "PUT", "/lro/put/202/retry/200")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.Put202Retry200", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Put202Retry200Preparer(ctx, product)
@@ -1920,15 +2460,16 @@ func (client LROsClient) Put202Retry200Preparer(ctx context.Context, product *Pr
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Put202Retry200Sender sends the Put202Retry200 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) Put202Retry200Sender(req *http.Request) (future LROsPut202Retry200Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1936,13 +2477,29 @@ func (client LROsClient) Put202Retry200Sender(req *http.Request) (future LROsPut
 	return
 }
 
+// ResumePut202Retry200 rebuilds the future returned from Put202Retry200 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePut202Retry200(ctx context.Context, token string) (result LROsPut202Retry200Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePut202Retry200", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPut202Retry200Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePut202Retry200", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Put202Retry200Responder handles the response to the Put202Retry200 request. The method always
 // closes the http.Response Body.
 func (client LROsClient) Put202Retry200Responder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1954,14 +2511,16 @@ func (client LROsClient) Put202Retry200Responder(resp *http.Response) (result Pr
 // Parameters:
 // product - product to put
 func (client LROsClient) PutAsyncNoHeaderInRetry(ctx context.Context, product *Product) (result LROsPutAsyncNoHeaderInRetryFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.PutAsyncNoHeaderInRetry")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.PutAsyncNoHeaderInRetry", "PUT", "/lro/putasync/noheader/201/200")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.PutAsyncNoHeaderInRetry", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PutAsyncNoHeaderInRetryPreparer(ctx, product)
@@ -1990,15 +2549,16 @@ func (client LROsClient) PutAsyncNoHeaderInRetryPreparer(ctx context.Context, pr
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutAsyncNoHeaderInRetrySender sends the PutAsyncNoHeaderInRetry request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) PutAsyncNoHeaderInRetrySender(req *http.Request) (future LROsPutAsyncNoHeaderInRetryFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -2006,13 +2566,29 @@ func (client LROsClient) PutAsyncNoHeaderInRetrySender(req *http.Request) (futur
 	return
 }
 
+// ResumePutAsyncNoHeaderInRetry rebuilds the future returned from PutAsyncNoHeaderInRetry using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePutAsyncNoHeaderInRetry(ctx context.Context, token string) (result LROsPutAsyncNoHeaderInRetryFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePutAsyncNoHeaderInRetry", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPutAsyncNoHeaderInRetryFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePutAsyncNoHeaderInRetry", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PutAsyncNoHeaderInRetryResponder handles the response to the PutAsyncNoHeaderInRetry request. The method always
 // closes the http.Response Body.
 func (client LROsClient) PutAsyncNoHeaderInRetryResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusCreated),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2023,14 +2599,16 @@ func (client LROsClient) PutAsyncNoHeaderInRetryResponder(resp *http.Response) (
 // Parameters:
 // sku - sku to put
 func (client LROsClient) PutAsyncNonResource(ctx context.Context, sku *Sku) (result LROsPutAsyncNonResourceFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.PutAsyncNonResource")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.PutAsyncNonResource", "PUT", "/lro/putnonresourceasync/202/200")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.PutAsyncNonResource", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PutAsyncNonResourcePreparer(ctx, sku)
@@ -2059,15 +2637,16 @@ func (client LROsClient) PutAsyncNonResourcePreparer(ctx context.Context, sku *S
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(sku))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutAsyncNonResourceSender sends the PutAsyncNonResource request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) PutAsyncNonResourceSender(req *http.Request) (future LROsPutAsyncNonResourceFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -2075,13 +2654,29 @@ func (client LROsClient) PutAsyncNonResourceSender(req *http.Request) (future LR
 	return
 }
 
+// ResumePutAsyncNonResource rebuilds the future returned from PutAsyncNonResource using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePutAsyncNonResource(ctx context.Context, token string) (result LROsPutAsyncNonResourceFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePutAsyncNonResource", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPutAsyncNonResourceFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePutAsyncNonResource", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PutAsyncNonResourceResponder handles the response to the PutAsyncNonResource request. The method always
 // closes the http.Response Body.
 func (client LROsClient) PutAsyncNonResourceResponder(resp *http.Response) (result Sku, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2094,14 +2689,16 @@ func (client LROsClient) PutAsyncNonResourceResponder(resp *http.Response) (resu
 // Parameters:
 // product - product to put
 func (client LROsClient) PutAsyncNoRetrycanceled(ctx context.Context, product *Product) (result LROsPutAsyncNoRetrycanceledFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.PutAsyncNoRetrycanceled")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.PutAsyncNoRetrycanceled", "PUT", "/lro/putasync/noretry/canceled")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.PutAsyncNoRetrycanceled", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PutAsyncNoRetrycanceledPreparer(ctx, product)
@@ -2130,15 +2727,16 @@ func (client LROsClient) PutAsyncNoRetrycanceledPreparer(ctx context.Context, pr
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutAsyncNoRetrycanceledSender sends the PutAsyncNoRetrycanceled request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) PutAsyncNoRetrycanceledSender(req *http.Request) (future LROsPutAsyncNoRetrycanceledFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -2146,13 +2744,29 @@ func (client LROsClient) PutAsyncNoRetrycanceledSender(req *http.Request) (futur
 	return
 }
 
+// ResumePutAsyncNoRetrycanceled rebuilds the future returned from PutAsyncNoRetrycanceled using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePutAsyncNoRetrycanceled(ctx context.Context, token string) (result LROsPutAsyncNoRetrycanceledFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePutAsyncNoRetrycanceled", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPutAsyncNoRetrycanceledFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePutAsyncNoRetrycanceled", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PutAsyncNoRetrycanceledResponder handles the response to the PutAsyncNoRetrycanceled request. The method always
 // closes the http.Response Body.
 func (client LROsClient) PutAsyncNoRetrycanceledResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2165,14 +2779,16 @@ func (client LROsClient) PutAsyncNoRetrycanceledResponder(resp *http.Response) (
 // Parameters:
 // product - product to put
 func (client LROsClient) PutAsyncNoRetrySucceeded(ctx context.Context, product *Product) (result LROsPutAsyncNoRetrySucceededFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.PutAsyncNoRetrySucceeded")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.PutAsyncNoRetrySucceeded", "PUT", "/lro/putasync/noretry/succeeded")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.PutAsyncNoRetrySucceeded", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PutAsyncNoRetrySucceededPreparer(ctx, product)
@@ -2201,15 +2817,16 @@ func (client LROsClient) PutAsyncNoRetrySucceededPreparer(ctx context.Context, p
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutAsyncNoRetrySucceededSender sends the PutAsyncNoRetrySucceeded request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) PutAsyncNoRetrySucceededSender(req *http.Request) (future LROsPutAsyncNoRetrySucceededFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -2217,13 +2834,29 @@ func (client LROsClient) PutAsyncNoRetrySucceededSender(req *http.Request) (futu
 	return
 }
 
+// ResumePutAsyncNoRetrySucceeded rebuilds the future returned from PutAsyncNoRetrySucceeded using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePutAsyncNoRetrySucceeded(ctx context.Context, token string) (result LROsPutAsyncNoRetrySucceededFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePutAsyncNoRetrySucceeded", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPutAsyncNoRetrySucceededFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePutAsyncNoRetrySucceeded", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PutAsyncNoRetrySucceededResponder handles the response to the PutAsyncNoRetrySucceeded request. The method always
 // closes the http.Response Body.
 func (client LROsClient) PutAsyncNoRetrySucceededResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2236,14 +2869,16 @@ func (client LROsClient) PutAsyncNoRetrySucceededResponder(resp *http.Response)
 // Parameters:
 // product - product to put
 func (client LROsClient) PutAsyncRetryFailed(ctx context.Context, product *Product) (result LROsPutAsyncRetryFailedFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.PutAsyncRetryFailed")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.PutAsyncRetryFailed", "PUT", "/lro/putasync/retry/failed")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.PutAsyncRetryFailed", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PutAsyncRetryFailedPreparer(ctx, product)
@@ -2272,15 +2907,16 @@ func (client LROsClient) PutAsyncRetryFailedPreparer(ctx context.Context, produc
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutAsyncRetryFailedSender sends the PutAsyncRetryFailed request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) PutAsyncRetryFailedSender(req *http.Request) (future LROsPutAsyncRetryFailedFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -2288,13 +2924,29 @@ func (client LROsClient) PutAsyncRetryFailedSender(req *http.Request) (future LR
 	return
 }
 
+// ResumePutAsyncRetryFailed rebuilds the future returned from PutAsyncRetryFailed using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePutAsyncRetryFailed(ctx context.Context, token string) (result LROsPutAsyncRetryFailedFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePutAsyncRetryFailed", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPutAsyncRetryFailedFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePutAsyncRetryFailed", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PutAsyncRetryFailedResponder handles the response to the PutAsyncRetryFailed request. The method always
 // closes the http.Response Body.
 func (client LROsClient) PutAsyncRetryFailedResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2307,14 +2959,16 @@ func (client LROsClient) PutAsyncRetryFailedResponder(resp *http.Response) (resu
 // Parameters:
 // product - product to put
 func (client LROsClient) PutAsyncRetrySucceeded(ctx context.Context, product *Product) (result LROsPutAsyncRetrySucceededFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.PutAsyncRetrySucceeded")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.PutAsyncRetrySucceeded", "PUT", "/lro/putasync/retry/succeeded")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.PutAsyncRetrySucceeded", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PutAsyncRetrySucceededPreparer(ctx, product)
@@ -2343,15 +2997,16 @@ func (client LROsClient) PutAsyncRetrySucceededPreparer(ctx context.Context, pro
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutAsyncRetrySucceededSender sends the PutAsyncRetrySucceeded request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) PutAsyncRetrySucceededSender(req *http.Request) (future LROsPutAsyncRetrySucceededFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -2359,13 +3014,29 @@ func (client LROsClient) PutAsyncRetrySucceededSender(req *http.Request) (future
 	return
 }
 
+// ResumePutAsyncRetrySucceeded rebuilds the future returned from PutAsyncRetrySucceeded using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePutAsyncRetrySucceeded(ctx context.Context, token string) (result LROsPutAsyncRetrySucceededFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePutAsyncRetrySucceeded", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPutAsyncRetrySucceededFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePutAsyncRetrySucceeded", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PutAsyncRetrySucceededResponder handles the response to the PutAsyncRetrySucceeded request. The method always
 // closes the http.Response Body.
 func (client LROsClient) PutAsyncRetrySucceededResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2376,14 +3047,16 @@ func (client LROsClient) PutAsyncRetrySucceededResponder(resp *http.Response) (r
 // Parameters:
 // product - sub Product to put
 func (client LROsClient) PutAsyncSubResource(ctx context.Context, product *SubProduct) (result LROsPutAsyncSubResourceFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.PutAsyncSubResource")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.PutAsyncSubResource", "PUT", "/lro/putsubresourceasync/202/200")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.PutAsyncSubResource", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PutAsyncSubResourcePreparer(ctx, product)
@@ -2412,15 +3085,16 @@ func (client LROsClient) PutAsyncSubResourcePreparer(ctx context.Context, produc
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutAsyncSubResourceSender sends the PutAsyncSubResource request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) PutAsyncSubResourceSender(req *http.Request) (future LROsPutAsyncSubResourceFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -2428,13 +3102,29 @@ func (client LROsClient) PutAsyncSubResourceSender(req *http.Request) (future LR
 	return
 }
 
+// ResumePutAsyncSubResource rebuilds the future returned from PutAsyncSubResource using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePutAsyncSubResource(ctx context.Context, token string) (result LROsPutAsyncSubResourceFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePutAsyncSubResource", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPutAsyncSubResourceFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePutAsyncSubResource", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PutAsyncSubResourceResponder handles the response to the PutAsyncSubResource request. The method always
 // closes the http.Response Body.
 func (client LROsClient) PutAsyncSubResourceResponder(resp *http.Response) (result SubProduct, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2446,14 +3136,16 @@ func (client LROsClient) PutAsyncSubResourceResponder(resp *http.Response) (resu
 // Parameters:
 // product - product to put
 func (client LROsClient) PutNoHeaderInRetry(ctx context.Context, product *Product) (result LROsPutNoHeaderInRetryFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.PutNoHeaderInRetry")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.PutNoHeaderInRetry", "PUT", "/lro/put/noheader/202/200")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.PutNoHeaderInRetry", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PutNoHeaderInRetryPreparer(ctx, product)
@@ -2482,15 +3174,16 @@ func (client LROsClient) PutNoHeaderInRetryPreparer(ctx context.Context, product
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutNoHeaderInRetrySender sends the PutNoHeaderInRetry request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) PutNoHeaderInRetrySender(req *http.Request) (future LROsPutNoHeaderInRetryFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -2498,13 +3191,29 @@ func (client LROsClient) PutNoHeaderInRetrySender(req *http.Request) (future LRO
 	return
 }
 
+// ResumePutNoHeaderInRetry rebuilds the future returned from PutNoHeaderInRetry using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePutNoHeaderInRetry(ctx context.Context, token string) (result LROsPutNoHeaderInRetryFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePutNoHeaderInRetry", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPutNoHeaderInRetryFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePutNoHeaderInRetry", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PutNoHeaderInRetryResponder handles the response to the PutNoHeaderInRetry request. The method always
 // closes the http.Response Body.
 func (client LROsClient) PutNoHeaderInRetryResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2515,14 +3224,16 @@ func (client LROsClient) PutNoHeaderInRetryResponder(resp *http.Response) (resul
 // Parameters:
 // sku - sku to put
 func (client LROsClient) PutNonResource(ctx context.Context, sku *Sku) (result LROsPutNonResourceFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.PutNonResource")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.PutNonResource", "PUT", "/lro/putnonresource/202/200")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.PutNonResource", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PutNonResourcePreparer(ctx, sku)
@@ -2551,15 +3262,16 @@ func (client LROsClient) PutNonResourcePreparer(ctx context.Context, sku *Sku) (
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(sku))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutNonResourceSender sends the PutNonResource request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) PutNonResourceSender(req *http.Request) (future LROsPutNonResourceFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -2567,13 +3279,29 @@ func (client LROsClient) PutNonResourceSender(req *http.Request) (future LROsPut
 	return
 }
 
+// ResumePutNonResource rebuilds the future returned from PutNonResource using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePutNonResource(ctx context.Context, token string) (result LROsPutNonResourceFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePutNonResource", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPutNonResourceFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePutNonResource", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PutNonResourceResponder handles the response to the PutNonResource request. The method always
 // closes the http.Response Body.
 func (client LROsClient) PutNonResourceResponder(resp *http.Response) (result Sku, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -2584,14 +3312,16 @@ func (client LROsClient) PutNonResourceResponder(resp *http.Response) (result Sk
 // Parameters:
 // product - sub Product to put
 func (client LROsClient) PutSubResource(ctx context.Context, product *SubProduct) (result LROsPutSubResourceFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsClient.PutSubResource")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.PutSubResource", "PUT", "/lro/putsubresource/202/200")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsClient.PutSubResource", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PutSubResourcePreparer(ctx, product)
@@ -2620,15 +3350,16 @@ func (client LROsClient) PutSubResourcePreparer(ctx context.Context, product *Su
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutSubResourceSender sends the PutSubResource request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsClient) PutSubResourceSender(req *http.Request) (future LROsPutSubResourceFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -2636,13 +3367,29 @@ func (client LROsClient) PutSubResourceSender(req *http.Request) (future LROsPut
 	return
 }
 
+// ResumePutSubResource rebuilds the future returned from PutSubResource using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsClient) ResumePutSubResource(ctx context.Context, token string) (result LROsPutSubResourceFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsClient.ResumePutSubResource", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsPutSubResourceFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsClient", "ResumePutSubResource", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PutSubResourceResponder handles the response to the PutSubResource request. The method always
 // closes the http.Response Body.
 func (client LROsClient) PutSubResourceResponder(resp *http.Response) (result SubProduct, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -10,7 +10,6 @@ import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -29,16 +28,25 @@ func NewLROSADsClientWithBaseURI(baseURI string) LROSADsClient {
 	return LROSADsClient{NewWithBaseURI(baseURI)}
 }
 
+// NewLROSADsClientWithOptions creates an instance of the LROSADsClient client with the specified options.  It returns
+// an error if the options are invalid.
+func NewLROSADsClientWithOptions(opts ClientOptions) (LROSADsClient, error) {
+	client, err := NewWithOptions(opts)
+	return LROSADsClient{client}, err
+}
+
 // Delete202NonRetry400 long running delete request, service returns a 202 with a location header
 func (client LROSADsClient) Delete202NonRetry400(ctx context.Context) (result LROSADsDelete202NonRetry400Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.Delete202NonRetry400")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.Delete202NonRetry400", "DELETE", "/lro/nonretryerror/delete/202/retry/400")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.Delete202NonRetry400", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Delete202NonRetry400Preparer(ctx)
@@ -62,15 +70,16 @@ func (client LROSADsClient) Delete202NonRetry400Preparer(ctx context.Context) (*
 		autorest.AsDelete(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/nonretryerror/delete/202/retry/400"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Delete202NonRetry400Sender sends the Delete202NonRetry400 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) Delete202NonRetry400Sender(req *http.Request) (future LROSADsDelete202NonRetry400Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -78,13 +87,29 @@ func (client LROSADsClient) Delete202NonRetry400Sender(req *http.Request) (futur
 	return
 }
 
+// ResumeDelete202NonRetry400 rebuilds the future returned from Delete202NonRetry400 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumeDelete202NonRetry400(ctx context.Context, token string) (result LROSADsDelete202NonRetry400Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumeDelete202NonRetry400", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsDelete202NonRetry400Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumeDelete202NonRetry400", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Delete202NonRetry400Responder handles the response to the Delete202NonRetry400 request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) Delete202NonRetry400Responder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -93,14 +118,16 @@ func (client LROSADsClient) Delete202NonRetry400Responder(resp *http.Response) (
 // Delete202RetryInvalidHeader long running delete request, service returns a 202 to the initial request receing a
 // reponse with an invalid 'Location' and 'Retry-After' headers
 func (client LROSADsClient) Delete202RetryInvalidHeader(ctx context.Context) (result LROSADsDelete202RetryInvalidHeaderFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.Delete202RetryInvalidHeader")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.Delete202RetryInvalidHeader", "DELETE", "/lro/error/delete/202/retry/invalidheader")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.Delete202RetryInvalidHeader", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Delete202RetryInvalidHeaderPreparer(ctx)
@@ -124,15 +151,16 @@ func (client LROSADsClient) Delete202RetryInvalidHeaderPreparer(ctx context.Cont
 		autorest.AsDelete(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/error/delete/202/retry/invalidheader"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Delete202RetryInvalidHeaderSender sends the Delete202RetryInvalidHeader request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) Delete202RetryInvalidHeaderSender(req *http.Request) (future LROSADsDelete202RetryInvalidHeaderFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -140,13 +168,29 @@ func (client LROSADsClient) Delete202RetryInvalidHeaderSender(req *http.Request)
 	return
 }
 
+// ResumeDelete202RetryInvalidHeader rebuilds the future returned from Delete202RetryInvalidHeader using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumeDelete202RetryInvalidHeader(ctx context.Context, token string) (result LROSADsDelete202RetryInvalidHeaderFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumeDelete202RetryInvalidHeader", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsDelete202RetryInvalidHeaderFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumeDelete202RetryInvalidHeader", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Delete202RetryInvalidHeaderResponder handles the response to the Delete202RetryInvalidHeader request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) Delete202RetryInvalidHeaderResponder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -154,14 +198,16 @@ func (client LROSADsClient) Delete202RetryInvalidHeaderResponder(resp *http.Resp
 
 // Delete204Succeeded long running delete request, service returns a 204 to the initial request, indicating success.
 func (client LROSADsClient) Delete204Succeeded(ctx context.Context) (result LROSADsDelete204SucceededFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.Delete204Succeeded")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.Delete204Succeeded", "DELETE", "/lro/error/delete/204/nolocation")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.Delete204Succeeded", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Delete204SucceededPreparer(ctx)
@@ -185,15 +231,16 @@ func (client LROSADsClient) Delete204SucceededPreparer(ctx context.Context) (*ht
 		autorest.AsDelete(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/error/delete/204/nolocation"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Delete204SucceededSender sends the Delete204Succeeded request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) Delete204SucceededSender(req *http.Request) (future LROSADsDelete204SucceededFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -201,13 +248,29 @@ func (client LROSADsClient) Delete204SucceededSender(req *http.Request) (future
 	return
 }
 
+// ResumeDelete204Succeeded rebuilds the future returned from Delete204Succeeded using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumeDelete204Succeeded(ctx context.Context, token string) (result LROSADsDelete204SucceededFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumeDelete204Succeeded", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsDelete204SucceededFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumeDelete204Succeeded", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Delete204SucceededResponder handles the response to the Delete204Succeeded request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) Delete204SucceededResponder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusNoContent),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -216,14 +279,16 @@ func (client LROSADsClient) Delete204SucceededResponder(resp *http.Response) (re
 // DeleteAsyncRelativeRetry400 long running delete request, service returns a 202 to the initial request. Poll the
 // endpoint indicated in the Azure-AsyncOperation header for operation status
 func (client LROSADsClient) DeleteAsyncRelativeRetry400(ctx context.Context) (result LROSADsDeleteAsyncRelativeRetry400Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.DeleteAsyncRelativeRetry400")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.DeleteAsyncRelativeRetry400", "DELETE", "/lro/nonretryerror/deleteasync/retry/400")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.DeleteAsyncRelativeRetry400", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.DeleteAsyncRelativeRetry400Preparer(ctx)
@@ -247,15 +312,16 @@ func (client LROSADsClient) DeleteAsyncRelativeRetry400Preparer(ctx context.Cont
 		autorest.AsDelete(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/nonretryerror/deleteasync/retry/400"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DeleteAsyncRelativeRetry400Sender sends the DeleteAsyncRelativeRetry400 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) DeleteAsyncRelativeRetry400Sender(req *http.Request) (future LROSADsDeleteAsyncRelativeRetry400Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -263,13 +329,29 @@ func (client LROSADsClient) DeleteAsyncRelativeRetry400Sender(req *http.Request)
 	return
 }
 
+// ResumeDeleteAsyncRelativeRetry400 rebuilds the future returned from DeleteAsyncRelativeRetry400 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumeDeleteAsyncRelativeRetry400(ctx context.Context, token string) (result LROSADsDeleteAsyncRelativeRetry400Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumeDeleteAsyncRelativeRetry400", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsDeleteAsyncRelativeRetry400Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumeDeleteAsyncRelativeRetry400", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // DeleteAsyncRelativeRetry400Responder handles the response to the DeleteAsyncRelativeRetry400 request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) DeleteAsyncRelativeRetry400Responder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -278,14 +360,16 @@ func (client LROSADsClient) DeleteAsyncRelativeRetry400Responder(resp *http.Resp
 // DeleteAsyncRelativeRetryInvalidHeader long running delete request, service returns a 202 to the initial request. The
 // endpoint indicated in the Azure-AsyncOperation header is invalid
 func (client LROSADsClient) DeleteAsyncRelativeRetryInvalidHeader(ctx context.Context) (result LROSADsDeleteAsyncRelativeRetryInvalidHeaderFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.DeleteAsyncRelativeRetryInvalidHeader")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.DeleteAsyncRelativeRetryInvalidHeader", "DELETE", "/lro/error/deleteasync/retry/invalidheader")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.DeleteAsyncRelativeRetryInvalidHeader", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.DeleteAsyncRelativeRetryInvalidHeaderPreparer(ctx)
@@ -309,15 +393,16 @@ func (client LROSADsClient) DeleteAsyncRelativeRetryInvalidHeaderPreparer(ctx co
 		autorest.AsDelete(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/error/deleteasync/retry/invalidheader"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DeleteAsyncRelativeRetryInvalidHeaderSender sends the DeleteAsyncRelativeRetryInvalidHeader request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) DeleteAsyncRelativeRetryInvalidHeaderSender(req *http.Request) (future LROSADsDeleteAsyncRelativeRetryInvalidHeaderFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -325,13 +410,29 @@ func (client LROSADsClient) DeleteAsyncRelativeRetryInvalidHeaderSender(req *htt
 	return
 }
 
+// ResumeDeleteAsyncRelativeRetryInvalidHeader rebuilds the future returned from DeleteAsyncRelativeRetryInvalidHeader using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumeDeleteAsyncRelativeRetryInvalidHeader(ctx context.Context, token string) (result LROSADsDeleteAsyncRelativeRetryInvalidHeaderFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumeDeleteAsyncRelativeRetryInvalidHeader", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsDeleteAsyncRelativeRetryInvalidHeaderFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumeDeleteAsyncRelativeRetryInvalidHeader", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // DeleteAsyncRelativeRetryInvalidHeaderResponder handles the response to the DeleteAsyncRelativeRetryInvalidHeader request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) DeleteAsyncRelativeRetryInvalidHeaderResponder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -340,14 +441,16 @@ func (client LROSADsClient) DeleteAsyncRelativeRetryInvalidHeaderResponder(resp
 // DeleteAsyncRelativeRetryInvalidJSONPolling long running delete request, service returns a 202 to the initial
 // request. Poll the endpoint indicated in the Azure-AsyncOperation header for operation status
 func (client LROSADsClient) DeleteAsyncRelativeRetryInvalidJSONPolling(ctx context.Context) (result LROSADsDeleteAsyncRelativeRetryInvalidJSONPollingFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.DeleteAsyncRelativeRetryInvalidJSONPolling")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.DeleteAsyncRelativeRetryInvalidJSONPolling", "DELETE", "/lro/error/deleteasync/retry/invalidjsonpolling")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.DeleteAsyncRelativeRetryInvalidJSONPolling", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.DeleteAsyncRelativeRetryInvalidJSONPollingPreparer(ctx)
@@ -371,15 +474,16 @@ func (client LROSADsClient) DeleteAsyncRelativeRetryInvalidJSONPollingPreparer(c
 		autorest.AsDelete(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/error/deleteasync/retry/invalidjsonpolling"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DeleteAsyncRelativeRetryInvalidJSONPollingSender sends the DeleteAsyncRelativeRetryInvalidJSONPolling request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) DeleteAsyncRelativeRetryInvalidJSONPollingSender(req *http.Request) (future LROSADsDeleteAsyncRelativeRetryInvalidJSONPollingFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -387,13 +491,29 @@ func (client LROSADsClient) DeleteAsyncRelativeRetryInvalidJSONPollingSender(req
 	return
 }
 
+// ResumeDeleteAsyncRelativeRetryInvalidJSONPolling rebuilds the future returned from DeleteAsyncRelativeRetryInvalidJSONPolling using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumeDeleteAsyncRelativeRetryInvalidJSONPolling(ctx context.Context, token string) (result LROSADsDeleteAsyncRelativeRetryInvalidJSONPollingFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumeDeleteAsyncRelativeRetryInvalidJSONPolling", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsDeleteAsyncRelativeRetryInvalidJSONPollingFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumeDeleteAsyncRelativeRetryInvalidJSONPolling", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // DeleteAsyncRelativeRetryInvalidJSONPollingResponder handles the response to the DeleteAsyncRelativeRetryInvalidJSONPolling request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) DeleteAsyncRelativeRetryInvalidJSONPollingResponder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -402,14 +522,16 @@ func (client LROSADsClient) DeleteAsyncRelativeRetryInvalidJSONPollingResponder(
 // DeleteAsyncRelativeRetryNoStatus long running delete request, service returns a 202 to the initial request. Poll the
 // endpoint indicated in the Azure-AsyncOperation header for operation status
 func (client LROSADsClient) DeleteAsyncRelativeRetryNoStatus(ctx context.Context) (result LROSADsDeleteAsyncRelativeRetryNoStatusFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.DeleteAsyncRelativeRetryNoStatus")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.DeleteAsyncRelativeRetryNoStatus", "DELETE", "/lro/error/deleteasync/retry/nostatus")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.DeleteAsyncRelativeRetryNoStatus", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.DeleteAsyncRelativeRetryNoStatusPreparer(ctx)
@@ -433,15 +555,16 @@ func (client LROSADsClient) DeleteAsyncRelativeRetryNoStatusPreparer(ctx context
 		autorest.AsDelete(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/error/deleteasync/retry/nostatus"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DeleteAsyncRelativeRetryNoStatusSender sends the DeleteAsyncRelativeRetryNoStatus request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) DeleteAsyncRelativeRetryNoStatusSender(req *http.Request) (future LROSADsDeleteAsyncRelativeRetryNoStatusFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -449,13 +572,29 @@ func (client LROSADsClient) DeleteAsyncRelativeRetryNoStatusSender(req *http.Req
 	return
 }
 
+// ResumeDeleteAsyncRelativeRetryNoStatus rebuilds the future returned from DeleteAsyncRelativeRetryNoStatus using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumeDeleteAsyncRelativeRetryNoStatus(ctx context.Context, token string) (result LROSADsDeleteAsyncRelativeRetryNoStatusFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumeDeleteAsyncRelativeRetryNoStatus", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsDeleteAsyncRelativeRetryNoStatusFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumeDeleteAsyncRelativeRetryNoStatus", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // DeleteAsyncRelativeRetryNoStatusResponder handles the response to the DeleteAsyncRelativeRetryNoStatus request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) DeleteAsyncRelativeRetryNoStatusResponder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -463,14 +602,16 @@ func (client LROSADsClient) DeleteAsyncRelativeRetryNoStatusResponder(resp *http
 
 // DeleteNonRetry400 long running delete request, service returns a 400 with an error body
 func (client LROSADsClient) DeleteNonRetry400(ctx context.Context) (result LROSADsDeleteNonRetry400Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.DeleteNonRetry400")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.DeleteNonRetry400", "DELETE", "/lro/nonretryerror/delete/400")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.DeleteNonRetry400", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.DeleteNonRetry400Preparer(ctx)
@@ -494,15 +635,16 @@ func (client LROSADsClient) DeleteNonRetry400Preparer(ctx context.Context) (*htt
 		autorest.AsDelete(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/lro/nonretryerror/delete/400"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DeleteNonRetry400Sender sends the DeleteNonRetry400 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) DeleteNonRetry400Sender(req *http.Request) (future LROSADsDeleteNonRetry400Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -510,13 +652,29 @@ func (client LROSADsClient) DeleteNonRetry400Sender(req *http.Request) (future L
 	return
 }
 
+// ResumeDeleteNonRetry400 rebuilds the future returned from DeleteNonRetry400 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumeDeleteNonRetry400(ctx context.Context, token string) (result LROSADsDeleteNonRetry400Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumeDeleteNonRetry400", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsDeleteNonRetry400Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumeDeleteNonRetry400", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // DeleteNonRetry400Responder handles the response to the DeleteNonRetry400 request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) DeleteNonRetry400Responder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -527,14 +685,16 @@ func (client LROSADsClient) DeleteNonRetry400Responder(resp *http.Response) (res
 // Parameters:
 // product - product to put
 func (client LROSADsClient) Post202NoLocation(ctx context.Context, product *Product) (result LROSADsPost202NoLocationFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.Post202NoLocation")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.Post202NoLocation", "POST", "/lro/error/post/202/nolocation")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.Post202NoLocation", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Post202NoLocationPreparer(ctx, product)
@@ -563,15 +723,16 @@ func (client LROSADsClient) Post202NoLocationPreparer(ctx context.Context, produ
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Post202NoLocationSender sends the Post202NoLocation request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) Post202NoLocationSender(req *http.Request) (future LROSADsPost202NoLocationFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -579,13 +740,29 @@ func (client LROSADsClient) Post202NoLocationSender(req *http.Request) (future L
 	return
 }
 
+// ResumePost202NoLocation rebuilds the future returned from Post202NoLocation using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumePost202NoLocation(ctx context.Context, token string) (result LROSADsPost202NoLocationFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumePost202NoLocation", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsPost202NoLocationFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumePost202NoLocation", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Post202NoLocationResponder handles the response to the Post202NoLocation request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) Post202NoLocationResponder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -595,14 +772,16 @@ func (client LROSADsClient) Post202NoLocationResponder(resp *http.Response) (res
 // Parameters:
 // product - product to put
 func (client LROSADsClient) Post202NonRetry400(ctx context.Context, product *Product) (result LROSADsPost202NonRetry400Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.Post202NonRetry400")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.Post202NonRetry400", "POST", "/lro/nonretryerror/post/202/retry/400")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.Post202NonRetry400", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Post202NonRetry400Preparer(ctx, product)
@@ -631,15 +810,16 @@ func (client LROSADsClient) Post202NonRetry400Preparer(ctx context.Context, prod
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Post202NonRetry400Sender sends the Post202NonRetry400 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) Post202NonRetry400Sender(req *http.Request) (future LROSADsPost202NonRetry400Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -647,13 +827,29 @@ func (client LROSADsClient) Post202NonRetry400Sender(req *http.Request) (future
 	return
 }
 
+// ResumePost202NonRetry400 rebuilds the future returned from Post202NonRetry400 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumePost202NonRetry400(ctx context.Context, token string) (result LROSADsPost202NonRetry400Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumePost202NonRetry400", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsPost202NonRetry400Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumePost202NonRetry400", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Post202NonRetry400Responder handles the response to the Post202NonRetry400 request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) Post202NonRetry400Responder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -664,14 +860,16 @@ func (client LROSADsClient) Post202NonRetry400Responder(resp *http.Response) (re
 // Parameters:
 // product - product to put
 func (client LROSADsClient) Post202RetryInvalidHeader(ctx context.Context, product *Product) (result LROSADsPost202RetryInvalidHeaderFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.Post202RetryInvalidHeader")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.Post202RetryInvalidHeader", "POST", "/lro/error/post/202/retry/invalidheader")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.Post202RetryInvalidHeader", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Post202RetryInvalidHeaderPreparer(ctx, product)
@@ -700,15 +898,16 @@ func (client LROSADsClient) Post202RetryInvalidHeaderPreparer(ctx context.Contex
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Post202RetryInvalidHeaderSender sends the Post202RetryInvalidHeader request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) Post202RetryInvalidHeaderSender(req *http.Request) (future LROSADsPost202RetryInvalidHeaderFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -716,13 +915,29 @@ func (client LROSADsClient) Post202RetryInvalidHeaderSender(req *http.Request) (
 	return
 }
 
+// ResumePost202RetryInvalidHeader rebuilds the future returned from Post202RetryInvalidHeader using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumePost202RetryInvalidHeader(ctx context.Context, token string) (result LROSADsPost202RetryInvalidHeaderFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumePost202RetryInvalidHeader", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsPost202RetryInvalidHeaderFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumePost202RetryInvalidHeader", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Post202RetryInvalidHeaderResponder handles the response to the Post202RetryInvalidHeader request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) Post202RetryInvalidHeaderResponder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -733,14 +948,16 @@ func (client LROSADsClient) Post202RetryInvalidHeaderResponder(resp *http.Respon
 // Parameters:
 // product - product to put
 func (client LROSADsClient) PostAsyncRelativeRetry400(ctx context.Context, product *Product) (result LROSADsPostAsyncRelativeRetry400Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.PostAsyncRelativeRetry400")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.PostAsyncRelativeRetry400", "POST", "/lro/nonretryerror/postasync/retry/400")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.PostAsyncRelativeRetry400", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PostAsyncRelativeRetry400Preparer(ctx, product)
@@ -769,15 +986,16 @@ func (client LROSADsClient) PostAsyncRelativeRetry400Preparer(ctx context.Contex
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostAsyncRelativeRetry400Sender sends the PostAsyncRelativeRetry400 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) PostAsyncRelativeRetry400Sender(req *http.Request) (future LROSADsPostAsyncRelativeRetry400Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -785,13 +1003,29 @@ func (client LROSADsClient) PostAsyncRelativeRetry400Sender(req *http.Request) (
 	return
 }
 
+// ResumePostAsyncRelativeRetry400 rebuilds the future returned from PostAsyncRelativeRetry400 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumePostAsyncRelativeRetry400(ctx context.Context, token string) (result LROSADsPostAsyncRelativeRetry400Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumePostAsyncRelativeRetry400", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsPostAsyncRelativeRetry400Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumePostAsyncRelativeRetry400", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PostAsyncRelativeRetry400Responder handles the response to the PostAsyncRelativeRetry400 request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) PostAsyncRelativeRetry400Responder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -803,14 +1037,16 @@ func (client LROSADsClient) PostAsyncRelativeRetry400Responder(resp *http.Respon
 // Parameters:
 // product - product to put
 func (client LROSADsClient) PostAsyncRelativeRetryInvalidHeader(ctx context.Context, product *Product) (result LROSADsPostAsyncRelativeRetryInvalidHeaderFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.PostAsyncRelativeRetryInvalidHeader")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.PostAsyncRelativeRetryInvalidHeader", "POST", "/lro/error/postasync/retry/invalidheader")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.PostAsyncRelativeRetryInvalidHeader", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PostAsyncRelativeRetryInvalidHeaderPreparer(ctx, product)
@@ -839,15 +1075,16 @@ func (client LROSADsClient) PostAsyncRelativeRetryInvalidHeaderPreparer(ctx cont
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostAsyncRelativeRetryInvalidHeaderSender sends the PostAsyncRelativeRetryInvalidHeader request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) PostAsyncRelativeRetryInvalidHeaderSender(req *http.Request) (future LROSADsPostAsyncRelativeRetryInvalidHeaderFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -855,13 +1092,29 @@ func (client LROSADsClient) PostAsyncRelativeRetryInvalidHeaderSender(req *http.
 	return
 }
 
+// ResumePostAsyncRelativeRetryInvalidHeader rebuilds the future returned from PostAsyncRelativeRetryInvalidHeader using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumePostAsyncRelativeRetryInvalidHeader(ctx context.Context, token string) (result LROSADsPostAsyncRelativeRetryInvalidHeaderFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumePostAsyncRelativeRetryInvalidHeader", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsPostAsyncRelativeRetryInvalidHeaderFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumePostAsyncRelativeRetryInvalidHeader", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PostAsyncRelativeRetryInvalidHeaderResponder handles the response to the PostAsyncRelativeRetryInvalidHeader request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) PostAsyncRelativeRetryInvalidHeaderResponder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -873,14 +1126,16 @@ func (client LROSADsClient) PostAsyncRelativeRetryInvalidHeaderResponder(resp *h
 // Parameters:
 // product - product to put
 func (client LROSADsClient) PostAsyncRelativeRetryInvalidJSONPolling(ctx context.Context, product *Product) (result LROSADsPostAsyncRelativeRetryInvalidJSONPollingFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.PostAsyncRelativeRetryInvalidJSONPolling")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.PostAsyncRelativeRetryInvalidJSONPolling", "POST", "/lro/error/postasync/retry/invalidjsonpolling")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.PostAsyncRelativeRetryInvalidJSONPolling", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PostAsyncRelativeRetryInvalidJSONPollingPreparer(ctx, product)
@@ -909,15 +1164,16 @@ func (client LROSADsClient) PostAsyncRelativeRetryInvalidJSONPollingPreparer(ctx
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostAsyncRelativeRetryInvalidJSONPollingSender sends the PostAsyncRelativeRetryInvalidJSONPolling request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) PostAsyncRelativeRetryInvalidJSONPollingSender(req *http.Request) (future LROSADsPostAsyncRelativeRetryInvalidJSONPollingFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -925,13 +1181,29 @@ func (client LROSADsClient) PostAsyncRelativeRetryInvalidJSONPollingSender(req *
 	return
 }
 
+// ResumePostAsyncRelativeRetryInvalidJSONPolling rebuilds the future returned from PostAsyncRelativeRetryInvalidJSONPolling using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumePostAsyncRelativeRetryInvalidJSONPolling(ctx context.Context, token string) (result LROSADsPostAsyncRelativeRetryInvalidJSONPollingFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumePostAsyncRelativeRetryInvalidJSONPolling", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsPostAsyncRelativeRetryInvalidJSONPollingFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumePostAsyncRelativeRetryInvalidJSONPolling", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PostAsyncRelativeRetryInvalidJSONPollingResponder handles the response to the PostAsyncRelativeRetryInvalidJSONPolling request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) PostAsyncRelativeRetryInvalidJSONPollingResponder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -943,14 +1215,16 @@ func (client LROSADsClient) PostAsyncRelativeRetryInvalidJSONPollingResponder(re
 // Parameters:
 // product - product to put
 func (client LROSADsClient) PostAsyncRelativeRetryNoPayload(ctx context.Context, product *Product) (result LROSADsPostAsyncRelativeRetryNoPayloadFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.PostAsyncRelativeRetryNoPayload")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.PostAsyncRelativeRetryNoPayload", "POST", "/lro/error/postasync/retry/nopayload")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.PostAsyncRelativeRetryNoPayload", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PostAsyncRelativeRetryNoPayloadPreparer(ctx, product)
@@ -979,15 +1253,16 @@ func (client LROSADsClient) PostAsyncRelativeRetryNoPayloadPreparer(ctx context.
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostAsyncRelativeRetryNoPayloadSender sends the PostAsyncRelativeRetryNoPayload request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) PostAsyncRelativeRetryNoPayloadSender(req *http.Request) (future LROSADsPostAsyncRelativeRetryNoPayloadFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -995,13 +1270,29 @@ func (client LROSADsClient) PostAsyncRelativeRetryNoPayloadSender(req *http.Requ
 	return
 }
 
+// ResumePostAsyncRelativeRetryNoPayload rebuilds the future returned from PostAsyncRelativeRetryNoPayload using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumePostAsyncRelativeRetryNoPayload(ctx context.Context, token string) (result LROSADsPostAsyncRelativeRetryNoPayloadFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumePostAsyncRelativeRetryNoPayload", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsPostAsyncRelativeRetryNoPayloadFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumePostAsyncRelativeRetryNoPayload", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PostAsyncRelativeRetryNoPayloadResponder handles the response to the PostAsyncRelativeRetryNoPayload request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) PostAsyncRelativeRetryNoPayloadResponder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1011,14 +1302,16 @@ func (client LROSADsClient) PostAsyncRelativeRetryNoPayloadResponder(resp *http.
 // Parameters:
 // product - product to put
 func (client LROSADsClient) PostNonRetry400(ctx context.Context, product *Product) (result LROSADsPostNonRetry400Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.PostNonRetry400")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.PostNonRetry400", "POST", "/lro/nonretryerror/post/400")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.PostNonRetry400", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PostNonRetry400Preparer(ctx, product)
@@ -1047,15 +1340,16 @@ func (client LROSADsClient) PostNonRetry400Preparer(ctx context.Context, product
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostNonRetry400Sender sends the PostNonRetry400 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) PostNonRetry400Sender(req *http.Request) (future LROSADsPostNonRetry400Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1063,13 +1357,29 @@ func (client LROSADsClient) PostNonRetry400Sender(req *http.Request) (future LRO
 	return
 }
 
+// ResumePostNonRetry400 rebuilds the future returned from PostNonRetry400 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumePostNonRetry400(ctx context.Context, token string) (result LROSADsPostNonRetry400Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumePostNonRetry400", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsPostNonRetry400Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumePostNonRetry400", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PostNonRetry400Responder handles the response to the PostNonRetry400 request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) PostNonRetry400Responder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1080,14 +1390,16 @@ func (client LROSADsClient) PostNonRetry400Responder(resp *http.Response) (resul
 // Parameters:
 // product - product to put
 func (client LROSADsClient) Put200InvalidJSON(ctx context.Context, product *Product) (result LROSADsPut200InvalidJSONFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.Put200InvalidJSON")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.Put200InvalidJSON", "PUT", "/lro/error/put/200/invalidjson")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.Put200InvalidJSON", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Put200InvalidJSONPreparer(ctx, product)
@@ -1116,15 +1428,16 @@ func (client LROSADsClient) Put200InvalidJSONPreparer(ctx context.Context, produ
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Put200InvalidJSONSender sends the Put200InvalidJSON request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) Put200InvalidJSONSender(req *http.Request) (future LROSADsPut200InvalidJSONFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1132,13 +1445,29 @@ func (client LROSADsClient) Put200InvalidJSONSender(req *http.Request) (future L
 	return
 }
 
+// ResumePut200InvalidJSON rebuilds the future returned from Put200InvalidJSON using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumePut200InvalidJSON(ctx context.Context, token string) (result LROSADsPut200InvalidJSONFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumePut200InvalidJSON", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsPut200InvalidJSONFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumePut200InvalidJSON", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Put200InvalidJSONResponder handles the response to the Put200InvalidJSON request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) Put200InvalidJSONResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusNoContent),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1150,14 +1479,16 @@ func (client LROSADsClient) Put200InvalidJSONResponder(resp *http.Response) (res
 // Parameters:
 // product - product to put
 func (client LROSADsClient) PutAsyncRelativeRetry400(ctx context.Context, product *Product) (result LROSADsPutAsyncRelativeRetry400Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.PutAsyncRelativeRetry400")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.PutAsyncRelativeRetry400", "PUT", "/lro/nonretryerror/putasync/retry/400")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.PutAsyncRelativeRetry400", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PutAsyncRelativeRetry400Preparer(ctx, product)
@@ -1186,15 +1517,16 @@ func (client LROSADsClient) PutAsyncRelativeRetry400Preparer(ctx context.Context
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutAsyncRelativeRetry400Sender sends the PutAsyncRelativeRetry400 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) PutAsyncRelativeRetry400Sender(req *http.Request) (future LROSADsPutAsyncRelativeRetry400Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1202,13 +1534,29 @@ func (client LROSADsClient) PutAsyncRelativeRetry400Sender(req *http.Request) (f
 	return
 }
 
+// ResumePutAsyncRelativeRetry400 rebuilds the future returned from PutAsyncRelativeRetry400 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumePutAsyncRelativeRetry400(ctx context.Context, token string) (result LROSADsPutAsyncRelativeRetry400Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumePutAsyncRelativeRetry400", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsPutAsyncRelativeRetry400Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumePutAsyncRelativeRetry400", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PutAsyncRelativeRetry400Responder handles the response to the PutAsyncRelativeRetry400 request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) PutAsyncRelativeRetry400Responder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1221,14 +1569,16 @@ func (client LROSADsClient) PutAsyncRelativeRetry400Responder(resp *http.Respons
 // Parameters:
 // product - product to put
 func (client LROSADsClient) PutAsyncRelativeRetryInvalidHeader(ctx context.Context, product *Product) (result LROSADsPutAsyncRelativeRetryInvalidHeaderFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.PutAsyncRelativeRetryInvalidHeader")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.PutAsyncRelativeRetryInvalidHeader", "PUT", "/lro/error/putasync/retry/invalidheader")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.PutAsyncRelativeRetryInvalidHeader", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PutAsyncRelativeRetryInvalidHeaderPreparer(ctx, product)
@@ -1257,15 +1607,16 @@ func (client LROSADsClient) PutAsyncRelativeRetryInvalidHeaderPreparer(ctx conte
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutAsyncRelativeRetryInvalidHeaderSender sends the PutAsyncRelativeRetryInvalidHeader request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) PutAsyncRelativeRetryInvalidHeaderSender(req *http.Request) (future LROSADsPutAsyncRelativeRetryInvalidHeaderFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1273,13 +1624,29 @@ func (client LROSADsClient) PutAsyncRelativeRetryInvalidHeaderSender(req *http.R
 	return
 }
 
+// ResumePutAsyncRelativeRetryInvalidHeader rebuilds the future returned from PutAsyncRelativeRetryInvalidHeader using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumePutAsyncRelativeRetryInvalidHeader(ctx context.Context, token string) (result LROSADsPutAsyncRelativeRetryInvalidHeaderFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumePutAsyncRelativeRetryInvalidHeader", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsPutAsyncRelativeRetryInvalidHeaderFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumePutAsyncRelativeRetryInvalidHeader", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PutAsyncRelativeRetryInvalidHeaderResponder handles the response to the PutAsyncRelativeRetryInvalidHeader request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) PutAsyncRelativeRetryInvalidHeaderResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1292,14 +1659,16 @@ func (client LROSADsClient) PutAsyncRelativeRetryInvalidHeaderResponder(resp *ht
 // Parameters:
 // product - product to put
 func (client LROSADsClient) PutAsyncRelativeRetryInvalidJSONPolling(ctx context.Context, product *Product) (result LROSADsPutAsyncRelativeRetryInvalidJSONPollingFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.PutAsyncRelativeRetryInvalidJSONPolling")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.PutAsyncRelativeRetryInvalidJSONPolling", "PUT", "/lro/error/putasync/retry/invalidjsonpolling")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.PutAsyncRelativeRetryInvalidJSONPolling", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PutAsyncRelativeRetryInvalidJSONPollingPreparer(ctx, product)
@@ -1328,15 +1697,16 @@ func (client LROSADsClient) PutAsyncRelativeRetryInvalidJSONPollingPreparer(ctx
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutAsyncRelativeRetryInvalidJSONPollingSender sends the PutAsyncRelativeRetryInvalidJSONPolling request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) PutAsyncRelativeRetryInvalidJSONPollingSender(req *http.Request) (future LROSADsPutAsyncRelativeRetryInvalidJSONPollingFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1344,13 +1714,29 @@ func (client LROSADsClient) PutAsyncRelativeRetryInvalidJSONPollingSender(req *h
 	return
 }
 
+// ResumePutAsyncRelativeRetryInvalidJSONPolling rebuilds the future returned from PutAsyncRelativeRetryInvalidJSONPolling using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumePutAsyncRelativeRetryInvalidJSONPolling(ctx context.Context, token string) (result LROSADsPutAsyncRelativeRetryInvalidJSONPollingFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumePutAsyncRelativeRetryInvalidJSONPolling", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsPutAsyncRelativeRetryInvalidJSONPollingFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumePutAsyncRelativeRetryInvalidJSONPolling", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PutAsyncRelativeRetryInvalidJSONPollingResponder handles the response to the PutAsyncRelativeRetryInvalidJSONPolling request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) PutAsyncRelativeRetryInvalidJSONPollingResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1363,14 +1749,16 @@ func (client LROSADsClient) PutAsyncRelativeRetryInvalidJSONPollingResponder(res
 // Parameters:
 // product - product to put
 func (client LROSADsClient) PutAsyncRelativeRetryNoStatus(ctx context.Context, product *Product) (result LROSADsPutAsyncRelativeRetryNoStatusFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.PutAsyncRelativeRetryNoStatus")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.PutAsyncRelativeRetryNoStatus", "PUT", "/lro/error/putasync/retry/nostatus")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.PutAsyncRelativeRetryNoStatus", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PutAsyncRelativeRetryNoStatusPreparer(ctx, product)
@@ -1399,15 +1787,16 @@ func (client LROSADsClient) PutAsyncRelativeRetryNoStatusPreparer(ctx context.Co
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutAsyncRelativeRetryNoStatusSender sends the PutAsyncRelativeRetryNoStatus request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) PutAsyncRelativeRetryNoStatusSender(req *http.Request) (future LROSADsPutAsyncRelativeRetryNoStatusFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1415,13 +1804,29 @@ func (client LROSADsClient) PutAsyncRelativeRetryNoStatusSender(req *http.Reques
 	return
 }
 
+// ResumePutAsyncRelativeRetryNoStatus rebuilds the future returned from PutAsyncRelativeRetryNoStatus using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumePutAsyncRelativeRetryNoStatus(ctx context.Context, token string) (result LROSADsPutAsyncRelativeRetryNoStatusFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumePutAsyncRelativeRetryNoStatus", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsPutAsyncRelativeRetryNoStatusFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumePutAsyncRelativeRetryNoStatus", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PutAsyncRelativeRetryNoStatusResponder handles the response to the PutAsyncRelativeRetryNoStatus request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) PutAsyncRelativeRetryNoStatusResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1434,14 +1839,16 @@ func (client LROSADsClient) PutAsyncRelativeRetryNoStatusResponder(resp *http.Re
 // Parameters:
 // product - product to put
 func (client LROSADsClient) PutAsyncRelativeRetryNoStatusPayload(ctx context.Context, product *Product) (result LROSADsPutAsyncRelativeRetryNoStatusPayloadFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.PutAsyncRelativeRetryNoStatusPayload")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.PutAsyncRelativeRetryNoStatusPayload", "PUT", "/lro/error/putasync/retry/nostatuspayload")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.PutAsyncRelativeRetryNoStatusPayload", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PutAsyncRelativeRetryNoStatusPayloadPreparer(ctx, product)
@@ -1470,15 +1877,16 @@ func (client LROSADsClient) PutAsyncRelativeRetryNoStatusPayloadPreparer(ctx con
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutAsyncRelativeRetryNoStatusPayloadSender sends the PutAsyncRelativeRetryNoStatusPayload request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) PutAsyncRelativeRetryNoStatusPayloadSender(req *http.Request) (future LROSADsPutAsyncRelativeRetryNoStatusPayloadFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1486,13 +1894,29 @@ func (client LROSADsClient) PutAsyncRelativeRetryNoStatusPayloadSender(req *http
 	return
 }
 
+// ResumePutAsyncRelativeRetryNoStatusPayload rebuilds the future returned from PutAsyncRelativeRetryNoStatusPayload using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumePutAsyncRelativeRetryNoStatusPayload(ctx context.Context, token string) (result LROSADsPutAsyncRelativeRetryNoStatusPayloadFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumePutAsyncRelativeRetryNoStatusPayload", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsPutAsyncRelativeRetryNoStatusPayloadFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumePutAsyncRelativeRetryNoStatusPayload", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PutAsyncRelativeRetryNoStatusPayloadResponder handles the response to the PutAsyncRelativeRetryNoStatusPayload request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) PutAsyncRelativeRetryNoStatusPayloadResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1504,14 +1928,16 @@ func (client LROSADsClient) PutAsyncRelativeRetryNoStatusPayloadResponder(resp *
 // Parameters:
 // product - product to put
 func (client LROSADsClient) PutError201NoProvisioningStatePayload(ctx context.Context, product *Product) (result LROSADsPutError201NoProvisioningStatePayloadFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.PutError201NoProvisioningStatePayload")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.PutError201NoProvisioningStatePayload", "PUT", "/lro/error/put/201/noprovisioningstatepayload")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.PutError201NoProvisioningStatePayload", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PutError201NoProvisioningStatePayloadPreparer(ctx, product)
@@ -1540,15 +1966,16 @@ func (client LROSADsClient) PutError201NoProvisioningStatePayloadPreparer(ctx co
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutError201NoProvisioningStatePayloadSender sends the PutError201NoProvisioningStatePayload request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) PutError201NoProvisioningStatePayloadSender(req *http.Request) (future LROSADsPutError201NoProvisioningStatePayloadFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1556,13 +1983,29 @@ func (client LROSADsClient) PutError201NoProvisioningStatePayloadSender(req *htt
 	return
 }
 
+// ResumePutError201NoProvisioningStatePayload rebuilds the future returned from PutError201NoProvisioningStatePayload using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumePutError201NoProvisioningStatePayload(ctx context.Context, token string) (result LROSADsPutError201NoProvisioningStatePayloadFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumePutError201NoProvisioningStatePayload", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsPutError201NoProvisioningStatePayloadFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumePutError201NoProvisioningStatePayload", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PutError201NoProvisioningStatePayloadResponder handles the response to the PutError201NoProvisioningStatePayload request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) PutError201NoProvisioningStatePayloadResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusCreated),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1574,14 +2017,16 @@ func (client LROSADsClient) PutError201NoProvisioningStatePayloadResponder(resp
 // Parameters:
 // product - product to put
 func (client LROSADsClient) PutNonRetry201Creating400(ctx context.Context, product *Product) (result LROSADsPutNonRetry201Creating400Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.PutNonRetry201Creating400")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.PutNonRetry201Creating400", "PUT", "/lro/nonretryerror/put/201/creating/400")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.PutNonRetry201Creating400", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PutNonRetry201Creating400Preparer(ctx, product)
@@ -1610,15 +2055,16 @@ func (client LROSADsClient) PutNonRetry201Creating400Preparer(ctx context.Contex
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutNonRetry201Creating400Sender sends the PutNonRetry201Creating400 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) PutNonRetry201Creating400Sender(req *http.Request) (future LROSADsPutNonRetry201Creating400Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1626,13 +2072,29 @@ func (client LROSADsClient) PutNonRetry201Creating400Sender(req *http.Request) (
 	return
 }
 
+// ResumePutNonRetry201Creating400 rebuilds the future returned from PutNonRetry201Creating400 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumePutNonRetry201Creating400(ctx context.Context, token string) (result LROSADsPutNonRetry201Creating400Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumePutNonRetry201Creating400", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsPutNonRetry201Creating400Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumePutNonRetry201Creating400", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PutNonRetry201Creating400Responder handles the response to the PutNonRetry201Creating400 request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) PutNonRetry201Creating400Responder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusCreated),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1644,14 +2106,16 @@ func (client LROSADsClient) PutNonRetry201Creating400Responder(resp *http.Respon
 // Parameters:
 // product - product to put
 func (client LROSADsClient) PutNonRetry201Creating400InvalidJSON(ctx context.Context, product *Product) (result LROSADsPutNonRetry201Creating400InvalidJSONFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.PutNonRetry201Creating400InvalidJSON")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.PutNonRetry201Creating400InvalidJSON", "PUT", "/lro/nonretryerror/put/201/creating/400/invalidjson")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.PutNonRetry201Creating400InvalidJSON", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PutNonRetry201Creating400InvalidJSONPreparer(ctx, product)
@@ -1680,15 +2144,16 @@ func (client LROSADsClient) PutNonRetry201Creating400InvalidJSONPreparer(ctx con
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutNonRetry201Creating400InvalidJSONSender sends the PutNonRetry201Creating400InvalidJSON request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) PutNonRetry201Creating400InvalidJSONSender(req *http.Request) (future LROSADsPutNonRetry201Creating400InvalidJSONFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1696,13 +2161,29 @@ func (client LROSADsClient) PutNonRetry201Creating400InvalidJSONSender(req *http
 	return
 }
 
+// ResumePutNonRetry201Creating400InvalidJSON rebuilds the future returned from PutNonRetry201Creating400InvalidJSON using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumePutNonRetry201Creating400InvalidJSON(ctx context.Context, token string) (result LROSADsPutNonRetry201Creating400InvalidJSONFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumePutNonRetry201Creating400InvalidJSON", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsPutNonRetry201Creating400InvalidJSONFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumePutNonRetry201Creating400InvalidJSON", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PutNonRetry201Creating400InvalidJSONResponder handles the response to the PutNonRetry201Creating400InvalidJSON request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) PutNonRetry201Creating400InvalidJSONResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusCreated),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1713,14 +2194,16 @@ func (client LROSADsClient) PutNonRetry201Creating400InvalidJSONResponder(resp *
 // Parameters:
 // product - product to put
 func (client LROSADsClient) PutNonRetry400(ctx context.Context, product *Product) (result LROSADsPutNonRetry400Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROSADsClient.PutNonRetry400")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.PutNonRetry400", "PUT", "/lro/nonretryerror/put/400")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROSADsClient.PutNonRetry400", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PutNonRetry400Preparer(ctx, product)
@@ -1749,15 +2232,16 @@ func (client LROSADsClient) PutNonRetry400Preparer(ctx context.Context, product
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutNonRetry400Sender sends the PutNonRetry400 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROSADsClient) PutNonRetry400Sender(req *http.Request) (future LROSADsPutNonRetry400Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -1765,13 +2249,29 @@ func (client LROSADsClient) PutNonRetry400Sender(req *http.Request) (future LROS
 	return
 }
 
+// ResumePutNonRetry400 rebuilds the future returned from PutNonRetry400 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROSADsClient) ResumePutNonRetry400(ctx context.Context, token string) (result LROSADsPutNonRetry400Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsClient.ResumePutNonRetry400", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROSADsPutNonRetry400Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsClient", "ResumePutNonRetry400", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PutNonRetry400Responder handles the response to the PutNonRetry400 request. The method always
 // closes the http.Response Body.
 func (client LROSADsClient) PutNonRetry400Responder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusCreated),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -10,7 +10,6 @@ import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -29,20 +28,29 @@ func NewLROsCustomHeaderClientWithBaseURI(baseURI string) LROsCustomHeaderClient
 	return LROsCustomHeaderClient{NewWithBaseURI(baseURI)}
 }
 
+// NewLROsCustomHeaderClientWithOptions creates an instance of the LROsCustomHeaderClient client with the specified
+// options.  It returns an error if the options are invalid.
+func NewLROsCustomHeaderClientWithOptions(opts ClientOptions) (LROsCustomHeaderClient, error) {
+	client, err := NewWithOptions(opts)
+	return LROsCustomHeaderClient{client}, err
+}
+
 // Post202Retry200 x-ms-client-request-id = 9C4D50EE-2D56-4CD3-8152-34347DC9F2B0 is required message header for all
 // requests. Long running post request, service returns a 202 to the initial request, with 'Location' and 'Retry-After'
 // headers, Polls return a 200 with a response body after success
 // Parameters:
 // product - product to put
 func (client LROsCustomHeaderClient) Post202Retry200(ctx context.Context, product *Product) (result LROsCustomHeaderPost202Retry200Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsCustomHeaderClient.Post202Retry200")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsCustomHeaderClient.Post202Retry200", "POST", "/lro/customheader/post/202/retry/200")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsCustomHeaderClient.Post202Retry200", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Post202Retry200Preparer(ctx, product)
@@ -71,15 +79,16 @@ func (client LROsCustomHeaderClient) Post202Retry200Preparer(ctx context.Context
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Post202Retry200Sender sends the Post202Retry200 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsCustomHeaderClient) Post202Retry200Sender(req *http.Request) (future LROsCustomHeaderPost202Retry200Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -87,13 +96,29 @@ func (client LROsCustomHeaderClient) Post202Retry200Sender(req *http.Request) (f
 	return
 }
 
+// ResumePost202Retry200 rebuilds the future returned from Post202Retry200 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsCustomHeaderClient) ResumePost202Retry200(ctx context.Context, token string) (result LROsCustomHeaderPost202Retry200Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsCustomHeaderClient.ResumePost202Retry200", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsCustomHeaderPost202Retry200Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsCustomHeaderClient", "ResumePost202Retry200", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Post202Retry200Responder handles the response to the Post202Retry200 request. The method always
 // closes the http.Response Body.
 func (client LROsCustomHeaderClient) Post202Retry200Responder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -105,14 +130,16 @@ func (client LROsCustomHeaderClient) Post202Retry200Responder(resp *http.Respons
 // Parameters:
 // product - product to put
 func (client LROsCustomHeaderClient) PostAsyncRetrySucceeded(ctx context.Context, product *Product) (result LROsCustomHeaderPostAsyncRetrySucceededFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsCustomHeaderClient.PostAsyncRetrySucceeded")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsCustomHeaderClient.PostAsyncRetrySucceeded", "POST", "/lro/customheader/postasync/retry/succeeded")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsCustomHeaderClient.PostAsyncRetrySucceeded", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PostAsyncRetrySucceededPreparer(ctx, product)
@@ -141,15 +168,16 @@ func (client LROsCustomHeaderClient) PostAsyncRetrySucceededPreparer(ctx context
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostAsyncRetrySucceededSender sends the PostAsyncRetrySucceeded request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsCustomHeaderClient) PostAsyncRetrySucceededSender(req *http.Request) (future LROsCustomHeaderPostAsyncRetrySucceededFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -157,13 +185,29 @@ func (client LROsCustomHeaderClient) PostAsyncRetrySucceededSender(req *http.Req
 	return
 }
 
+// ResumePostAsyncRetrySucceeded rebuilds the future returned from PostAsyncRetrySucceeded using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsCustomHeaderClient) ResumePostAsyncRetrySucceeded(ctx context.Context, token string) (result LROsCustomHeaderPostAsyncRetrySucceededFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsCustomHeaderClient.ResumePostAsyncRetrySucceeded", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsCustomHeaderPostAsyncRetrySucceededFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsCustomHeaderClient", "ResumePostAsyncRetrySucceeded", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PostAsyncRetrySucceededResponder handles the response to the PostAsyncRetrySucceeded request. The method always
 // closes the http.Response Body.
 func (client LROsCustomHeaderClient) PostAsyncRetrySucceededResponder(resp *http.Response) (result autorest.Response, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusAccepted),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -176,14 +220,16 @@ func (client LROsCustomHeaderClient) PostAsyncRetrySucceededResponder(resp *http
 // Parameters:
 // product - product to put
 func (client LROsCustomHeaderClient) Put201CreatingSucceeded200(ctx context.Context, product *Product) (result LROsCustomHeaderPut201CreatingSucceeded200Future, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsCustomHeaderClient.Put201CreatingSucceeded200")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsCustomHeaderClient.Put201CreatingSucceeded200", "PUT", "/lro/customheader/put/201/creating/succeeded/200")
+		defer func() {
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsCustomHeaderClient.Put201CreatingSucceeded200", nil)
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.Put201CreatingSucceeded200Preparer(ctx, product)
@@ -212,15 +258,16 @@ func (client LROsCustomHeaderClient) Put201CreatingSucceeded200Preparer(ctx cont
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Put201CreatingSucceeded200Sender sends the Put201CreatingSucceeded200 request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsCustomHeaderClient) Put201CreatingSucceeded200Sender(req *http.Request) (future LROsCustomHeaderPut201CreatingSucceeded200Future, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -228,13 +275,29 @@ func (client LROsCustomHeaderClient) Put201CreatingSucceeded200Sender(req *http.
 	return
 }
 
+// ResumePut201CreatingSucceeded200 rebuilds the future returned from Put201CreatingSucceeded200 using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsCustomHeaderClient) ResumePut201CreatingSucceeded200(ctx context.Context, token string) (result LROsCustomHeaderPut201CreatingSucceeded200Future, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsCustomHeaderClient.ResumePut201CreatingSucceeded200", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsCustomHeaderPut201CreatingSucceeded200Future", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsCustomHeaderClient", "ResumePut201CreatingSucceeded200", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // Put201CreatingSucceeded200Responder handles the response to the Put201CreatingSucceeded200 request. The method always
 // closes the http.Response Body.
 func (client LROsCustomHeaderClient) Put201CreatingSucceeded200Responder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusCreated),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -247,14 +310,16 @@ func (client LROsCustomHeaderClient) Put201CreatingSucceeded200Responder(resp *h
 // Parameters:
 // product - product to put
 func (client LROsCustomHeaderClient) PutAsyncRetrySucceeded(ctx context.Context, product *Product) (result LROsCustomHeaderPutAsyncRetrySucceededFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/LROsCustomHeaderClient.PutAsyncRetrySucceeded")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsCustomHeaderClient.PutAsyncRetrySucceeded", "PUT", "/lro/customheader/putasync/retry/succeeded")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "LROsCustomHeaderClient.PutAsyncRetrySucceeded", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
 		}()
 	}
 	req, err := client.PutAsyncRetrySucceededPreparer(ctx, product)
@@ -283,15 +348,16 @@ func (client LROsCustomHeaderClient) PutAsyncRetrySucceededPreparer(ctx context.
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(product))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutAsyncRetrySucceededSender sends the PutAsyncRetrySucceeded request. The method will close the
 // http.Response Body if it receives an error.
 func (client LROsCustomHeaderClient) PutAsyncRetrySucceededSender(req *http.Request) (future LROsCustomHeaderPutAsyncRetrySucceededFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -299,13 +365,29 @@ func (client LROsCustomHeaderClient) PutAsyncRetrySucceededSender(req *http.Requ
 	return
 }
 
+// ResumePutAsyncRetrySucceeded rebuilds the future returned from PutAsyncRetrySucceeded using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client LROsCustomHeaderClient) ResumePutAsyncRetrySucceeded(ctx context.Context, token string) (result LROsCustomHeaderPutAsyncRetrySucceededFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsCustomHeaderClient.ResumePutAsyncRetrySucceeded", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("lrogroup.LROsCustomHeaderPutAsyncRetrySucceededFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsCustomHeaderClient", "ResumePutAsyncRetrySucceeded", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // PutAsyncRetrySucceededResponder handles the response to the PutAsyncRetrySucceeded request. The method always
 // closes the http.Response Body.
 func (client LROsCustomHeaderClient) PutAsyncRetrySucceededResponder(resp *http.Response) (result Product, err error) {
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -7,11 +7,24 @@ package lrogroup
 // Changes may cause incorrect behavior and will be lost if the code is regenerated.
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
 	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // The package's fully qualified name.
@@ -50,6 +63,42 @@ func PossibleProvisioningStateValuesValues() []ProvisioningStateValues {
 	return []ProvisioningStateValues{Accepted, Canceled, Created, Creating, Deleted, Deleting, Failed, OK, Succeeded, Updated, Updating}
 }
 
+// knownProvisioningStateValuesValues is the set of the possible values for the ProvisioningStateValues const type.
+var knownProvisioningStateValuesValues = map[ProvisioningStateValues]struct{}{Accepted: {}, Canceled: {}, Created: {}, Creating: {}, Deleted: {}, Deleting: {}, Failed: {}, OK: {}, Succeeded: {}, Updated: {}, Updating: {}}
+
+// ParseProvisioningStateValues returns the ProvisioningStateValues whose value is s, or an error if s isn't one of its possible values.
+func ParseProvisioningStateValues(s string) (ProvisioningStateValues, error) {
+	if _, ok := knownProvisioningStateValuesValues[ProvisioningStateValues(s)]; ok {
+		return ProvisioningStateValues(s), nil
+	}
+	return "", fmt.Errorf("%q isn't a possible value for ProvisioningStateValues", s)
+}
+
+// ParseProvisioningStateValuesIgnoreCase returns the ProvisioningStateValues whose value equals s without regard to
+// case, or an error if s isn't one of its possible values.  Exact matches are preferred.
+func ParseProvisioningStateValuesIgnoreCase(s string) (ProvisioningStateValues, error) {
+	if value, err := ParseProvisioningStateValues(s); err == nil {
+		return value, nil
+	}
+	for _, value := range PossibleProvisioningStateValuesValues() {
+		if strings.EqualFold(string(value), s) {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("%q isn't a possible value for ProvisioningStateValues", s)
+}
+
+// IsKnown returns true if psv is one of the possible values for the ProvisioningStateValues const type.
+func (psv ProvisioningStateValues) IsKnown() bool {
+	_, ok := knownProvisioningStateValuesValues[psv]
+	return ok
+}
+
+// String returns the value of psv.
+func (psv ProvisioningStateValues) String() string {
+	return string(psv)
+}
+
 // ProvisioningStateValues1 enumerates the values for provisioning state values 1.
 type ProvisioningStateValues1 string
 
@@ -83,6 +132,42 @@ func PossibleProvisioningStateValues1Values() []ProvisioningStateValues1 {
 	return []ProvisioningStateValues1{ProvisioningStateValues1Accepted, ProvisioningStateValues1Canceled, ProvisioningStateValues1Created, ProvisioningStateValues1Creating, ProvisioningStateValues1Deleted, ProvisioningStateValues1Deleting, ProvisioningStateValues1Failed, ProvisioningStateValues1OK, ProvisioningStateValues1Succeeded, ProvisioningStateValues1Updated, ProvisioningStateValues1Updating}
 }
 
+// knownProvisioningStateValues1Values is the set of the possible values for the ProvisioningStateValues1 const type.
+var knownProvisioningStateValues1Values = map[ProvisioningStateValues1]struct{}{ProvisioningStateValues1Accepted: {}, ProvisioningStateValues1Canceled: {}, ProvisioningStateValues1Created: {}, ProvisioningStateValues1Creating: {}, ProvisioningStateValues1Deleted: {}, ProvisioningStateValues1Deleting: {}, ProvisioningStateValues1Failed: {}, ProvisioningStateValues1OK: {}, ProvisioningStateValues1Succeeded: {}, ProvisioningStateValues1Updated: {}, ProvisioningStateValues1Updating: {}}
+
+// ParseProvisioningStateValues1 returns the ProvisioningStateValues1 whose value is s, or an error if s isn't one of its possible values.
+func ParseProvisioningStateValues1(s string) (ProvisioningStateValues1, error) {
+	if _, ok := knownProvisioningStateValues1Values[ProvisioningStateValues1(s)]; ok {
+		return ProvisioningStateValues1(s), nil
+	}
+	return "", fmt.Errorf("%q isn't a possible value for ProvisioningStateValues1", s)
+}
+
+// ParseProvisioningStateValues1IgnoreCase returns the ProvisioningStateValues1 whose value equals s without regard to
+// case, or an error if s isn't one of its possible values.  Exact matches are preferred.
+func ParseProvisioningStateValues1IgnoreCase(s string) (ProvisioningStateValues1, error) {
+	if value, err := ParseProvisioningStateValues1(s); err == nil {
+		return value, nil
+	}
+	for _, value := range PossibleProvisioningStateValues1Values() {
+		if strings.EqualFold(string(value), s) {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("%q isn't a possible value for ProvisioningStateValues1", s)
+}
+
+// IsKnown returns true if psv1 is one of the possible values for the ProvisioningStateValues1 const type.
+func (psv1 ProvisioningStateValues1) IsKnown() bool {
+	_, ok := knownProvisioningStateValues1Values[psv1]
+	return ok
+}
+
+// String returns the value of psv1.
+func (psv1 ProvisioningStateValues1) String() string {
+	return string(psv1)
+}
+
 // Status enumerates the values for status.
 type Status string
 
@@ -116,12 +201,480 @@ func PossibleStatusValues() []Status {
 	return []Status{StatusAccepted, StatusCanceled, StatusCreated, StatusCreating, StatusDeleted, StatusDeleting, StatusFailed, StatusOK, StatusSucceeded, StatusUpdated, StatusUpdating}
 }
 
+// knownStatusValues is the set of the possible values for the Status const type.
+var knownStatusValues = map[Status]struct{}{StatusAccepted: {}, StatusCanceled: {}, StatusCreated: {}, StatusCreating: {}, StatusDeleted: {}, StatusDeleting: {}, StatusFailed: {}, StatusOK: {}, StatusSucceeded: {}, StatusUpdated: {}, StatusUpdating: {}}
+
+// ParseStatus returns the Status whose value is s, or an error if s isn't one of its possible values.
+func ParseStatus(s string) (Status, error) {
+	if _, ok := knownStatusValues[Status(s)]; ok {
+		return Status(s), nil
+	}
+	return "", fmt.Errorf("%q isn't a possible value for Status", s)
+}
+
+// ParseStatusIgnoreCase returns the Status whose value equals s without regard to case, or an error if s isn't one of
+// its possible values.  Exact matches are preferred.
+func ParseStatusIgnoreCase(s string) (Status, error) {
+	if value, err := ParseStatus(s); err == nil {
+		return value, nil
+	}
+	for _, value := range PossibleStatusValues() {
+		if strings.EqualFold(string(value), s) {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("%q isn't a possible value for Status", s)
+}
+
+// IsKnown returns true if s is one of the possible values for the Status const type.
+func (s Status) IsKnown() bool {
+	_, ok := knownStatusValues[s]
+	return ok
+}
+
+// String returns the value of s.
+func (s Status) String() string {
+	return string(s)
+}
+
 // CloudError ...
 type CloudError struct {
 	Status  *int32  `json:"status,omitempty"`
 	Message *string `json:"message,omitempty"`
 }
 
+// DeepCopy returns a copy of the CloudError that doesn't share any pointers, slices or maps with it.
+func (ce CloudError) DeepCopy() CloudError {
+	result := ce
+	if ce.Status != nil {
+		v := *ce.Status
+		result.Status = &v
+	}
+	if ce.Message != nil {
+		v := *ce.Message
+		result.Message = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the CloudError and other are equal.  Times are equal if they represent the same
+// instant.
+func (ce CloudError) Equal(other CloudError) bool {
+	return ce.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (ce CloudError) EqualIgnoreReadOnly(other CloudError) bool {
+	return ce.equal(other, true)
+}
+
+// equal compares the fields of the CloudError and other, skipping the read-only ones if ignoreReadOnly is true.
+func (ce CloudError) equal(other CloudError, ignoreReadOnly bool) bool {
+	if (ce.Status == nil) != (other.Status == nil) || (ce.Status != nil && *ce.Status != *other.Status) {
+		return false
+	}
+	if (ce.Message == nil) != (other.Message == nil) || (ce.Message != nil && *ce.Message != *other.Message) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the CloudError to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields aren't changed.
+func (ce *CloudError) MergeFrom(patch CloudError) {
+	if patch.Status != nil {
+		v := *patch.Status
+		ce.Status = &v
+	}
+	if patch.Message != nil {
+		v := *patch.Message
+		ce.Message = &v
+	}
+}
+
+// ErrorResponse is returned when an operation fails with a response containing the CloudError model.  Unlike the other
+// errors of the operations it isn't wrapped in an autorest.DetailedError.
+type ErrorResponse struct {
+	// Model - the error model unmarshalled from the response body.
+	Model CloudError
+	// StatusCode - the HTTP status code of the response.
+	StatusCode int
+	// Body - the raw response body.
+	Body []byte
+	// Err - the error encountered reading or unmarshalling the response body, if any.
+	Err error
+}
+
+// Error implements the error interface for type ErrorResponse.
+func (er ErrorResponse) Error() string {
+	if er.Err != nil {
+		return fmt.Sprintf("lrogroup: StatusCode=%d Body=%s Err=%v", er.StatusCode, string(er.Body), er.Err)
+	}
+	return fmt.Sprintf("lrogroup: StatusCode=%d Body=%s", er.StatusCode, string(er.Body))
+}
+
+// Unwrap returns the error encountered reading or unmarshalling the response body, if any.
+func (er ErrorResponse) Unwrap() error {
+	return er.Err
+}
+
+// withErrorResponseUnlessStatusCode returns a RespondDecorator that returns an ErrorResponse if the
+// response's status code isn't one of the specified codes.  The response body is left available to the caller.
+// When the body can't be read or unmarshalled the returned ErrorResponse wraps the error in its Err field.
+func withErrorResponseUnlessStatusCode(codes ...int) autorest.RespondDecorator {
+	return func(r autorest.Responder) autorest.Responder {
+		return autorest.ResponderFunc(func(resp *http.Response) error {
+			err := r.Respond(resp)
+			if err != nil || autorest.ResponseHasStatusCode(resp, codes...) {
+				return err
+			}
+			defer resp.Body.Close()
+			er := ErrorResponse{StatusCode: resp.StatusCode}
+			er.Body, er.Err = ioutil.ReadAll(resp.Body)
+			resp.Body = ioutil.NopCloser(bytes.NewReader(er.Body))
+			if er.Err == nil && len(bytes.TrimSpace(er.Body)) > 0 {
+				er.Err = json.Unmarshal(er.Body, &er.Model)
+			}
+			return er
+		})
+	}
+}
+
+// ExponentialRetry is a RetryPolicy that retries failed requests with exponentially increasing delays.  When the
+// RetryPolicy of a client is nil one is created from its RetryAttempts and RetryDuration.  Unlike
+// autorest.DoRetryForStatusCodes it only retries idempotent requests unless RetryNonIdempotent is true, so POST and
+// PATCH requests, including the initial requests of long-running operations, aren't retried unless the service marks
+// their operation x-ms-idempotent.  Responses with status code 429 count against MaxRetries.
+type ExponentialRetry struct {
+	// MaxRetries - the maximum number of times a request is retried.
+	MaxRetries int
+	// Delay - the delay before the first retry.  The delay is doubled for each following retry.
+	Delay time.Duration
+	// MaxDelay - the maximum delay between retries.  Zero means no limit.
+	MaxDelay time.Duration
+	// MaxElapsed - requests aren't retried once the delay would exceed this much time since the first attempt.  Zero means no limit.
+	MaxElapsed time.Duration
+	// Jitter - the fraction, from 0 to 1, of each delay that's randomized to spread out the retries of concurrent requests.
+	Jitter float64
+	// StatusCodes - the status codes of the responses that are retried.  Defaults to autorest.StatusCodesForRetry.
+	StatusCodes []int
+	// RetryNonIdempotent - if true, requests that aren't idempotent, e.g. POST requests of operations the service doesn't mark as idempotent, are retried too.
+	RetryNonIdempotent bool
+}
+
+// defaultRetryJitter is the Jitter of the ExponentialRetry used when the RetryPolicy of a client is nil.
+const defaultRetryJitter = 0.2
+
+// RetryPolicy decides if and when a failed attempt to send a request is retried.
+type RetryPolicy interface {
+	// ShouldRetry returns the delay before the request is sent again, or false if it isn't retried.
+	ShouldRetry(attempt RetryAttempt) (time.Duration, bool)
+}
+
+// LogEvent is an event logged by the operations of a client when its Logger isn't nil.  The URLs and headers of the
+// requests aren't included as they can contain secrets.
+type LogEvent struct {
+	// Kind - the kind of the event.
+	Kind LogEventKind
+	// Operation - the name of the operation, qualified by the name of its client.
+	Operation string
+	// Fields - the parameters of the operation keyed by their names in the request, for LogOperationStart events.  Body parameters aren't included and the values of secret parameters are replaced with REDACTED.
+	Fields map[string]interface{}
+	// Attempt - the number of the failed attempt, starting at one, for LogRetry events.
+	Attempt int
+	// Delay - the delay before the request is sent again, for LogRetry events.
+	Delay time.Duration
+	// State - the status of the long-running operation, for LogPoll events.
+	State string
+	// StatusCode - the status code of the response, or zero if none was received.
+	StatusCode int
+	// Elapsed - the time elapsed since the operation, the page fetch or the polling started.
+	Elapsed time.Duration
+	// Err - the error the operation, the attempt or the poll failed with, if any.
+	Err error
+}
+
+// redactedLogValue replaces the values of secret parameters in the Fields of a LogEvent.
+const redactedLogValue = "REDACTED"
+
+// LogEventKind is the kind of a LogEvent.
+type LogEventKind string
+
+const (
+	// LogOperationStart is logged when an operation starts, with its parameters.
+	LogOperationStart LogEventKind = "OperationStart"
+	// LogOperationFinish is logged when an operation finishes.
+	LogOperationFinish LogEventKind = "OperationFinish"
+	// LogRetry is logged when a failed attempt to send a request is retried.
+	LogRetry LogEventKind = "Retry"
+	// LogPageFetch is logged when the next page of the results of an operation has been fetched.
+	LogPageFetch LogEventKind = "PageFetch"
+	// LogPoll is logged each time PollUntilDone has polled the status of a long-running operation.
+	LogPoll LogEventKind = "Poll"
+)
+
+// Logger receives the events logged by the operations of a client.
+type Logger interface {
+	// Log is called synchronously for each event.
+	Log(event LogEvent)
+}
+
+// LoggerFunc is a function implementing the Logger interface.
+type LoggerFunc func(event LogEvent)
+
+// Log implements the Logger interface for LoggerFunc.
+func (lf LoggerFunc) Log(event LogEvent) {
+	lf(event)
+}
+
+// String formats the event as a line of key=value pairs, e.g. to pass to log.Print.
+func (le LogEvent) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "event=%s operation=%s", le.Kind, le.Operation)
+	names := make([]string, 0, len(le.Fields))
+	for name := range le.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&b, " %s=%v", name, le.Fields[name])
+	}
+	if le.Attempt > 0 {
+		fmt.Fprintf(&b, " attempt=%d", le.Attempt)
+	}
+	if le.State != "" {
+		fmt.Fprintf(&b, " state=%s", le.State)
+	}
+	if le.StatusCode > 0 {
+		fmt.Fprintf(&b, " status=%d", le.StatusCode)
+	}
+	if le.Delay > 0 {
+		fmt.Fprintf(&b, " delay=%v", le.Delay)
+	}
+	if le.Kind != LogOperationStart {
+		fmt.Fprintf(&b, " elapsed=%v", le.Elapsed)
+	}
+	if le.Err != nil {
+		fmt.Fprintf(&b, " error=%q", le.Err.Error())
+	}
+	return b.String()
+}
+
+// operationLog is the state used to log the events of an operation, carried by the contexts of its requests.
+type operationLog struct {
+	logger    Logger
+	operation string
+	start     time.Time
+}
+
+// operationLogKey is the key of the operationLog in a context.
+type operationLogKey struct{}
+
+// withOperationLog returns a context carrying the state used to log the events of operation to logger.
+func withOperationLog(ctx context.Context, logger Logger, operation string) context.Context {
+	return context.WithValue(ctx, operationLogKey{}, &operationLog{logger: logger, operation: operation, start: time.Now()})
+}
+
+// startOperationLog logs the start of operation with its parameters, then returns a context carrying the state used to
+// log its other events.
+func startOperationLog(ctx context.Context, logger Logger, operation string, fields map[string]interface{}) context.Context {
+	for name, value := range fields {
+		// log the values of optional parameters rather than their addresses
+		v := reflect.ValueOf(value)
+		for v.Kind() == reflect.Ptr && !v.IsNil() {
+			v = v.Elem()
+		}
+		if v.Kind() == reflect.Ptr {
+			fields[name] = nil
+		} else if v.IsValid() {
+			fields[name] = v.Interface()
+		}
+	}
+	ctx = withOperationLog(ctx, logger, operation)
+	logger.Log(LogEvent{Kind: LogOperationStart, Operation: operation, Fields: fields})
+	return ctx
+}
+
+// logResponse logs event for the operation whose state is carried by ctx, if any, along with the status code of resp
+// if it isn't nil.
+func logResponse(ctx context.Context, event LogEvent, resp *http.Response) {
+	ol, ok := ctx.Value(operationLogKey{}).(*operationLog)
+	if !ok {
+		return
+	}
+	event.Operation = ol.operation
+	event.Elapsed = time.Since(ol.start)
+	if resp != nil {
+		event.StatusCode = resp.StatusCode
+	}
+	ol.logger.Log(event)
+}
+
+// RetryAttempt describes a failed attempt to send a request.
+type RetryAttempt struct {
+	// Request - the request that was sent.
+	Request *http.Request
+	// Response - the response received, or nil if sending the request failed.
+	Response *http.Response
+	// Err - the error returned when sending the request, if any.
+	Err error
+	// Attempt - the number of the attempt, starting at one.
+	Attempt int
+	// Elapsed - the time elapsed since the first attempt was sent.
+	Elapsed time.Duration
+	// Idempotent - true if the request can safely be sent more than once, either because its HTTP method is
+	// idempotent or because the service marks the operation as idempotent.
+	Idempotent bool
+}
+
+// ShouldRetry implements the RetryPolicy interface for ExponentialRetry.  A delay requested by the Retry-After header
+// of the response takes precedence over the computed one.
+func (er ExponentialRetry) ShouldRetry(attempt RetryAttempt) (time.Duration, bool) {
+	if attempt.Attempt > er.MaxRetries || !(attempt.Idempotent || er.RetryNonIdempotent) {
+		return 0, false
+	}
+	if attempt.Err != nil {
+		if !autorest.IsTemporaryNetworkError(attempt.Err) || autorest.IsTokenRefreshError(attempt.Err) {
+			return 0, false
+		}
+	} else {
+		codes := er.StatusCodes
+		if codes == nil {
+			codes = autorest.StatusCodesForRetry
+		}
+		if !autorest.ResponseHasStatusCode(attempt.Response, codes...) {
+			return 0, false
+		}
+	}
+	delay, ok := retryAfter(attempt.Response)
+	if !ok {
+		delay = er.Delay
+		for i := 1; i < attempt.Attempt && delay < math.MaxInt64/2; i++ {
+			delay *= 2
+		}
+		if er.MaxDelay > 0 && delay > er.MaxDelay {
+			delay = er.MaxDelay
+		}
+		if er.Jitter > 0 {
+			delay -= time.Duration(math.Min(er.Jitter, 1) * rand.Float64() * float64(delay))
+		}
+	}
+	if er.MaxElapsed > 0 && attempt.Elapsed+delay > er.MaxElapsed {
+		return 0, false
+	}
+	return delay, true
+}
+
+// retryAfter returns the delay requested by the Retry-After header of resp, and false if it doesn't have one.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	ra := resp.Header.Get("Retry-After")
+	if ra == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(ra); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(ra); err == nil {
+		if delay := time.Until(t); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// isIdempotentMethod returns true if requests using the HTTP method can safely be sent more than once.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodTrace:
+		return true
+	}
+	return false
+}
+
+// withRetryPolicy returns a SendDecorator that sends a request again after each failed attempt policy decides to retry.
+// If policy is nil an ExponentialRetry created from the RetryAttempts and RetryDuration of c is used.  idempotent is
+// true for operations the service marks as idempotent.
+func withRetryPolicy(policy RetryPolicy, c autorest.Client, idempotent bool) autorest.SendDecorator {
+	if policy == nil {
+		policy = ExponentialRetry{MaxRetries: c.RetryAttempts, Delay: c.RetryDuration, Jitter: defaultRetryJitter}
+	}
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+			idempotent := idempotent || isIdempotentMethod(r.Method)
+			start := time.Now()
+			for attempt := 1; ; attempt++ {
+				if err := rr.Prepare(); err != nil {
+					return nil, err
+				}
+				resp, err := s.Do(rr.Request())
+				if r.Context().Err() != nil {
+					return resp, err
+				}
+				delay, retry := policy.ShouldRetry(RetryAttempt{
+					Request:    rr.Request(),
+					Response:   resp,
+					Err:        err,
+					Attempt:    attempt,
+					Elapsed:    time.Since(start),
+					Idempotent: idempotent,
+				})
+				if !retry {
+					return resp, err
+				}
+				logResponse(r.Context(), LogEvent{Kind: LogRetry, Attempt: attempt, Delay: delay, Err: err}, resp)
+				setSpanAttribute(r.Context(), AttributeRetryCount, attempt)
+				if resp != nil {
+					autorest.Respond(resp, autorest.ByDiscardingBody(), autorest.ByClosing())
+				}
+				timer := time.NewTimer(delay)
+				select {
+				case <-timer.C:
+				case <-r.Context().Done():
+					timer.Stop()
+					return nil, r.Context().Err()
+				}
+			}
+		})
+	}
+}
+
+// futureResumeToken contains the state of a future and the operation that created it.
+type futureResumeToken struct {
+	Operation string       `json:"operation"`
+	Future    azure.Future `json:"future"`
+}
+
+// newResumeToken returns an opaque token containing the state of future, which was created by the specified operation.
+func newResumeToken(operation string, future azure.Future) (string, error) {
+	b, err := json.Marshal(futureResumeToken{Operation: operation, Future: future})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// parseResumeToken returns the future contained in token.
+// It returns an error if token wasn't created by the specified operation.
+func parseResumeToken(operation, token string) (azure.Future, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return azure.Future{}, err
+	}
+	rt := futureResumeToken{}
+	if err = json.Unmarshal(b, &rt); err != nil {
+		return azure.Future{}, err
+	}
+	if rt.Operation != operation {
+		return azure.Future{}, fmt.Errorf("resume token was created by operation %s, not %s", rt.Operation, operation)
+	}
+	return rt.Future, nil
+}
+
 // LRORetrysDelete202Retry200Future an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LRORetrysDelete202Retry200Future struct {
@@ -131,8 +684,36 @@ type LRORetrysDelete202Retry200Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LRORetrysDelete202Retry200Future) Result(client LRORetrysClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LRORetrysDelete202Retry200Future) PollUntilDone(ctx context.Context, client LRORetrysClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LRORetrysClient.Delete202Retry200")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LRORetrysDelete202Retry200Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysDelete202Retry200Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LRORetrysDelete202Retry200Future) ResultWithContext(ctx context.Context, client LRORetrysClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysDelete202Retry200Future", "Result", future.Response(), "Polling failure")
 		return
@@ -145,6 +726,12 @@ func (future *LRORetrysDelete202Retry200Future) Result(client LRORetrysClient) (
 	return
 }
 
+// ResumeToken returns a token that can be passed to LRORetrysClient.ResumeDelete202Retry200 to rebuild
+// this future, e.g. after a process restart.
+func (future *LRORetrysDelete202Retry200Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LRORetrysDelete202Retry200Future", future.Future)
+}
+
 // LRORetrysDeleteAsyncRelativeRetrySucceededFuture an abstraction for monitoring and retrieving the
 // results of a long-running operation.
 type LRORetrysDeleteAsyncRelativeRetrySucceededFuture struct {
@@ -154,8 +741,36 @@ type LRORetrysDeleteAsyncRelativeRetrySucceededFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LRORetrysDeleteAsyncRelativeRetrySucceededFuture) Result(client LRORetrysClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LRORetrysDeleteAsyncRelativeRetrySucceededFuture) PollUntilDone(ctx context.Context, client LRORetrysClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LRORetrysClient.DeleteAsyncRelativeRetrySucceeded")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LRORetrysDeleteAsyncRelativeRetrySucceededFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysDeleteAsyncRelativeRetrySucceededFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LRORetrysDeleteAsyncRelativeRetrySucceededFuture) ResultWithContext(ctx context.Context, client LRORetrysClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysDeleteAsyncRelativeRetrySucceededFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -168,6 +783,12 @@ func (future *LRORetrysDeleteAsyncRelativeRetrySucceededFuture) Result(client LR
 	return
 }
 
+// ResumeToken returns a token that can be passed to LRORetrysClient.ResumeDeleteAsyncRelativeRetrySucceeded to rebuild
+// this future, e.g. after a process restart.
+func (future *LRORetrysDeleteAsyncRelativeRetrySucceededFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LRORetrysDeleteAsyncRelativeRetrySucceededFuture", future.Future)
+}
+
 // LRORetrysDeleteProvisioning202Accepted200SucceededFuture an abstraction for monitoring and retrieving
 // the results of a long-running operation.
 type LRORetrysDeleteProvisioning202Accepted200SucceededFuture struct {
@@ -177,8 +798,36 @@ type LRORetrysDeleteProvisioning202Accepted200SucceededFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LRORetrysDeleteProvisioning202Accepted200SucceededFuture) Result(client LRORetrysClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LRORetrysDeleteProvisioning202Accepted200SucceededFuture) PollUntilDone(ctx context.Context, client LRORetrysClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LRORetrysClient.DeleteProvisioning202Accepted200Succeeded")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LRORetrysDeleteProvisioning202Accepted200SucceededFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysDeleteProvisioning202Accepted200SucceededFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LRORetrysDeleteProvisioning202Accepted200SucceededFuture) ResultWithContext(ctx context.Context, client LRORetrysClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysDeleteProvisioning202Accepted200SucceededFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -187,16 +836,21 @@ func (future *LRORetrysDeleteProvisioning202Accepted200SucceededFuture) Result(c
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LRORetrysDeleteProvisioning202Accepted200SucceededFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.DeleteProvisioning202Accepted200SucceededResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysDeleteProvisioning202Accepted200SucceededFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LRORetrysClient.ResumeDeleteProvisioning202Accepted200Succeeded to rebuild
+// this future, e.g. after a process restart.
+func (future *LRORetrysDeleteProvisioning202Accepted200SucceededFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LRORetrysDeleteProvisioning202Accepted200SucceededFuture", future.Future)
+}
+
 // LRORetrysPost202Retry200Future an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LRORetrysPost202Retry200Future struct {
@@ -206,8 +860,36 @@ type LRORetrysPost202Retry200Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LRORetrysPost202Retry200Future) Result(client LRORetrysClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LRORetrysPost202Retry200Future) PollUntilDone(ctx context.Context, client LRORetrysClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LRORetrysClient.Post202Retry200")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LRORetrysPost202Retry200Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysPost202Retry200Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LRORetrysPost202Retry200Future) ResultWithContext(ctx context.Context, client LRORetrysClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysPost202Retry200Future", "Result", future.Response(), "Polling failure")
 		return
@@ -220,6 +902,12 @@ func (future *LRORetrysPost202Retry200Future) Result(client LRORetrysClient) (ar
 	return
 }
 
+// ResumeToken returns a token that can be passed to LRORetrysClient.ResumePost202Retry200 to rebuild
+// this future, e.g. after a process restart.
+func (future *LRORetrysPost202Retry200Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LRORetrysPost202Retry200Future", future.Future)
+}
+
 // LRORetrysPostAsyncRelativeRetrySucceededFuture an abstraction for monitoring and retrieving the results
 // of a long-running operation.
 type LRORetrysPostAsyncRelativeRetrySucceededFuture struct {
@@ -229,8 +917,36 @@ type LRORetrysPostAsyncRelativeRetrySucceededFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LRORetrysPostAsyncRelativeRetrySucceededFuture) Result(client LRORetrysClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LRORetrysPostAsyncRelativeRetrySucceededFuture) PollUntilDone(ctx context.Context, client LRORetrysClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LRORetrysClient.PostAsyncRelativeRetrySucceeded")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LRORetrysPostAsyncRelativeRetrySucceededFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysPostAsyncRelativeRetrySucceededFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LRORetrysPostAsyncRelativeRetrySucceededFuture) ResultWithContext(ctx context.Context, client LRORetrysClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysPostAsyncRelativeRetrySucceededFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -243,6 +959,12 @@ func (future *LRORetrysPostAsyncRelativeRetrySucceededFuture) Result(client LROR
 	return
 }
 
+// ResumeToken returns a token that can be passed to LRORetrysClient.ResumePostAsyncRelativeRetrySucceeded to rebuild
+// this future, e.g. after a process restart.
+func (future *LRORetrysPostAsyncRelativeRetrySucceededFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LRORetrysPostAsyncRelativeRetrySucceededFuture", future.Future)
+}
+
 // LRORetrysPut201CreatingSucceeded200Future an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LRORetrysPut201CreatingSucceeded200Future struct {
@@ -252,8 +974,36 @@ type LRORetrysPut201CreatingSucceeded200Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LRORetrysPut201CreatingSucceeded200Future) Result(client LRORetrysClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LRORetrysPut201CreatingSucceeded200Future) PollUntilDone(ctx context.Context, client LRORetrysClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LRORetrysClient.Put201CreatingSucceeded200")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LRORetrysPut201CreatingSucceeded200Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysPut201CreatingSucceeded200Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LRORetrysPut201CreatingSucceeded200Future) ResultWithContext(ctx context.Context, client LRORetrysClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysPut201CreatingSucceeded200Future", "Result", future.Response(), "Polling failure")
 		return
@@ -262,16 +1012,21 @@ func (future *LRORetrysPut201CreatingSucceeded200Future) Result(client LRORetrys
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LRORetrysPut201CreatingSucceeded200Future")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.Put201CreatingSucceeded200Responder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysPut201CreatingSucceeded200Future", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LRORetrysClient.ResumePut201CreatingSucceeded200 to rebuild
+// this future, e.g. after a process restart.
+func (future *LRORetrysPut201CreatingSucceeded200Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LRORetrysPut201CreatingSucceeded200Future", future.Future)
+}
+
 // LRORetrysPutAsyncRelativeRetrySucceededFuture an abstraction for monitoring and retrieving the results
 // of a long-running operation.
 type LRORetrysPutAsyncRelativeRetrySucceededFuture struct {
@@ -281,8 +1036,36 @@ type LRORetrysPutAsyncRelativeRetrySucceededFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LRORetrysPutAsyncRelativeRetrySucceededFuture) Result(client LRORetrysClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LRORetrysPutAsyncRelativeRetrySucceededFuture) PollUntilDone(ctx context.Context, client LRORetrysClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LRORetrysClient.PutAsyncRelativeRetrySucceeded")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LRORetrysPutAsyncRelativeRetrySucceededFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysPutAsyncRelativeRetrySucceededFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LRORetrysPutAsyncRelativeRetrySucceededFuture) ResultWithContext(ctx context.Context, client LRORetrysClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysPutAsyncRelativeRetrySucceededFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -291,16 +1074,21 @@ func (future *LRORetrysPutAsyncRelativeRetrySucceededFuture) Result(client LRORe
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LRORetrysPutAsyncRelativeRetrySucceededFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.PutAsyncRelativeRetrySucceededResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LRORetrysPutAsyncRelativeRetrySucceededFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LRORetrysClient.ResumePutAsyncRelativeRetrySucceeded to rebuild
+// this future, e.g. after a process restart.
+func (future *LRORetrysPutAsyncRelativeRetrySucceededFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LRORetrysPutAsyncRelativeRetrySucceededFuture", future.Future)
+}
+
 // LROSADsDelete202NonRetry400Future an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROSADsDelete202NonRetry400Future struct {
@@ -310,8 +1098,36 @@ type LROSADsDelete202NonRetry400Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsDelete202NonRetry400Future) Result(client LROSADsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsDelete202NonRetry400Future) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.Delete202NonRetry400")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsDelete202NonRetry400Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsDelete202NonRetry400Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsDelete202NonRetry400Future) ResultWithContext(ctx context.Context, client LROSADsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsDelete202NonRetry400Future", "Result", future.Response(), "Polling failure")
 		return
@@ -324,6 +1140,12 @@ func (future *LROSADsDelete202NonRetry400Future) Result(client LROSADsClient) (a
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumeDelete202NonRetry400 to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsDelete202NonRetry400Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsDelete202NonRetry400Future", future.Future)
+}
+
 // LROSADsDelete202RetryInvalidHeaderFuture an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROSADsDelete202RetryInvalidHeaderFuture struct {
@@ -333,8 +1155,36 @@ type LROSADsDelete202RetryInvalidHeaderFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsDelete202RetryInvalidHeaderFuture) Result(client LROSADsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsDelete202RetryInvalidHeaderFuture) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.Delete202RetryInvalidHeader")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsDelete202RetryInvalidHeaderFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsDelete202RetryInvalidHeaderFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsDelete202RetryInvalidHeaderFuture) ResultWithContext(ctx context.Context, client LROSADsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsDelete202RetryInvalidHeaderFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -347,6 +1197,12 @@ func (future *LROSADsDelete202RetryInvalidHeaderFuture) Result(client LROSADsCli
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumeDelete202RetryInvalidHeader to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsDelete202RetryInvalidHeaderFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsDelete202RetryInvalidHeaderFuture", future.Future)
+}
+
 // LROSADsDelete204SucceededFuture an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROSADsDelete204SucceededFuture struct {
@@ -356,8 +1212,36 @@ type LROSADsDelete204SucceededFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsDelete204SucceededFuture) Result(client LROSADsClient) (ar autorest.Response, err error) {
-	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsDelete204SucceededFuture) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.Delete204Succeeded")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsDelete204SucceededFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsDelete204SucceededFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsDelete204SucceededFuture) ResultWithContext(ctx context.Context, client LROSADsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
+	var done bool
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsDelete204SucceededFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -370,6 +1254,12 @@ func (future *LROSADsDelete204SucceededFuture) Result(client LROSADsClient) (ar
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumeDelete204Succeeded to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsDelete204SucceededFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsDelete204SucceededFuture", future.Future)
+}
+
 // LROSADsDeleteAsyncRelativeRetry400Future an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROSADsDeleteAsyncRelativeRetry400Future struct {
@@ -379,8 +1269,36 @@ type LROSADsDeleteAsyncRelativeRetry400Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsDeleteAsyncRelativeRetry400Future) Result(client LROSADsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsDeleteAsyncRelativeRetry400Future) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.DeleteAsyncRelativeRetry400")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsDeleteAsyncRelativeRetry400Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsDeleteAsyncRelativeRetry400Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsDeleteAsyncRelativeRetry400Future) ResultWithContext(ctx context.Context, client LROSADsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsDeleteAsyncRelativeRetry400Future", "Result", future.Response(), "Polling failure")
 		return
@@ -393,6 +1311,12 @@ func (future *LROSADsDeleteAsyncRelativeRetry400Future) Result(client LROSADsCli
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumeDeleteAsyncRelativeRetry400 to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsDeleteAsyncRelativeRetry400Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsDeleteAsyncRelativeRetry400Future", future.Future)
+}
+
 // LROSADsDeleteAsyncRelativeRetryInvalidHeaderFuture an abstraction for monitoring and retrieving the
 // results of a long-running operation.
 type LROSADsDeleteAsyncRelativeRetryInvalidHeaderFuture struct {
@@ -402,8 +1326,36 @@ type LROSADsDeleteAsyncRelativeRetryInvalidHeaderFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsDeleteAsyncRelativeRetryInvalidHeaderFuture) Result(client LROSADsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsDeleteAsyncRelativeRetryInvalidHeaderFuture) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.DeleteAsyncRelativeRetryInvalidHeader")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsDeleteAsyncRelativeRetryInvalidHeaderFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsDeleteAsyncRelativeRetryInvalidHeaderFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsDeleteAsyncRelativeRetryInvalidHeaderFuture) ResultWithContext(ctx context.Context, client LROSADsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsDeleteAsyncRelativeRetryInvalidHeaderFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -416,6 +1368,12 @@ func (future *LROSADsDeleteAsyncRelativeRetryInvalidHeaderFuture) Result(client
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumeDeleteAsyncRelativeRetryInvalidHeader to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsDeleteAsyncRelativeRetryInvalidHeaderFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsDeleteAsyncRelativeRetryInvalidHeaderFuture", future.Future)
+}
+
 // LROSADsDeleteAsyncRelativeRetryInvalidJSONPollingFuture an abstraction for monitoring and retrieving the
 // results of a long-running operation.
 type LROSADsDeleteAsyncRelativeRetryInvalidJSONPollingFuture struct {
@@ -425,8 +1383,36 @@ type LROSADsDeleteAsyncRelativeRetryInvalidJSONPollingFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsDeleteAsyncRelativeRetryInvalidJSONPollingFuture) Result(client LROSADsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsDeleteAsyncRelativeRetryInvalidJSONPollingFuture) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.DeleteAsyncRelativeRetryInvalidJSONPolling")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsDeleteAsyncRelativeRetryInvalidJSONPollingFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsDeleteAsyncRelativeRetryInvalidJSONPollingFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsDeleteAsyncRelativeRetryInvalidJSONPollingFuture) ResultWithContext(ctx context.Context, client LROSADsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsDeleteAsyncRelativeRetryInvalidJSONPollingFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -439,6 +1425,12 @@ func (future *LROSADsDeleteAsyncRelativeRetryInvalidJSONPollingFuture) Result(cl
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumeDeleteAsyncRelativeRetryInvalidJSONPolling to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsDeleteAsyncRelativeRetryInvalidJSONPollingFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsDeleteAsyncRelativeRetryInvalidJSONPollingFuture", future.Future)
+}
+
 // LROSADsDeleteAsyncRelativeRetryNoStatusFuture an abstraction for monitoring and retrieving the results
 // of a long-running operation.
 type LROSADsDeleteAsyncRelativeRetryNoStatusFuture struct {
@@ -448,8 +1440,36 @@ type LROSADsDeleteAsyncRelativeRetryNoStatusFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsDeleteAsyncRelativeRetryNoStatusFuture) Result(client LROSADsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsDeleteAsyncRelativeRetryNoStatusFuture) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.DeleteAsyncRelativeRetryNoStatus")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsDeleteAsyncRelativeRetryNoStatusFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsDeleteAsyncRelativeRetryNoStatusFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsDeleteAsyncRelativeRetryNoStatusFuture) ResultWithContext(ctx context.Context, client LROSADsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsDeleteAsyncRelativeRetryNoStatusFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -462,6 +1482,12 @@ func (future *LROSADsDeleteAsyncRelativeRetryNoStatusFuture) Result(client LROSA
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumeDeleteAsyncRelativeRetryNoStatus to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsDeleteAsyncRelativeRetryNoStatusFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsDeleteAsyncRelativeRetryNoStatusFuture", future.Future)
+}
+
 // LROSADsDeleteNonRetry400Future an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROSADsDeleteNonRetry400Future struct {
@@ -471,8 +1497,36 @@ type LROSADsDeleteNonRetry400Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsDeleteNonRetry400Future) Result(client LROSADsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsDeleteNonRetry400Future) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.DeleteNonRetry400")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsDeleteNonRetry400Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsDeleteNonRetry400Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsDeleteNonRetry400Future) ResultWithContext(ctx context.Context, client LROSADsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsDeleteNonRetry400Future", "Result", future.Response(), "Polling failure")
 		return
@@ -485,6 +1539,12 @@ func (future *LROSADsDeleteNonRetry400Future) Result(client LROSADsClient) (ar a
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumeDeleteNonRetry400 to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsDeleteNonRetry400Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsDeleteNonRetry400Future", future.Future)
+}
+
 // LROSADsPost202NoLocationFuture an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROSADsPost202NoLocationFuture struct {
@@ -494,8 +1554,36 @@ type LROSADsPost202NoLocationFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsPost202NoLocationFuture) Result(client LROSADsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsPost202NoLocationFuture) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.Post202NoLocation")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsPost202NoLocationFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPost202NoLocationFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsPost202NoLocationFuture) ResultWithContext(ctx context.Context, client LROSADsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPost202NoLocationFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -508,6 +1596,12 @@ func (future *LROSADsPost202NoLocationFuture) Result(client LROSADsClient) (ar a
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumePost202NoLocation to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsPost202NoLocationFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsPost202NoLocationFuture", future.Future)
+}
+
 // LROSADsPost202NonRetry400Future an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROSADsPost202NonRetry400Future struct {
@@ -517,8 +1611,36 @@ type LROSADsPost202NonRetry400Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsPost202NonRetry400Future) Result(client LROSADsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsPost202NonRetry400Future) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.Post202NonRetry400")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsPost202NonRetry400Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPost202NonRetry400Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsPost202NonRetry400Future) ResultWithContext(ctx context.Context, client LROSADsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPost202NonRetry400Future", "Result", future.Response(), "Polling failure")
 		return
@@ -531,6 +1653,12 @@ func (future *LROSADsPost202NonRetry400Future) Result(client LROSADsClient) (ar
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumePost202NonRetry400 to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsPost202NonRetry400Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsPost202NonRetry400Future", future.Future)
+}
+
 // LROSADsPost202RetryInvalidHeaderFuture an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROSADsPost202RetryInvalidHeaderFuture struct {
@@ -540,8 +1668,36 @@ type LROSADsPost202RetryInvalidHeaderFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsPost202RetryInvalidHeaderFuture) Result(client LROSADsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsPost202RetryInvalidHeaderFuture) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.Post202RetryInvalidHeader")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsPost202RetryInvalidHeaderFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPost202RetryInvalidHeaderFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsPost202RetryInvalidHeaderFuture) ResultWithContext(ctx context.Context, client LROSADsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPost202RetryInvalidHeaderFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -554,6 +1710,12 @@ func (future *LROSADsPost202RetryInvalidHeaderFuture) Result(client LROSADsClien
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumePost202RetryInvalidHeader to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsPost202RetryInvalidHeaderFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsPost202RetryInvalidHeaderFuture", future.Future)
+}
+
 // LROSADsPostAsyncRelativeRetry400Future an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROSADsPostAsyncRelativeRetry400Future struct {
@@ -563,8 +1725,36 @@ type LROSADsPostAsyncRelativeRetry400Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsPostAsyncRelativeRetry400Future) Result(client LROSADsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsPostAsyncRelativeRetry400Future) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.PostAsyncRelativeRetry400")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsPostAsyncRelativeRetry400Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPostAsyncRelativeRetry400Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsPostAsyncRelativeRetry400Future) ResultWithContext(ctx context.Context, client LROSADsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPostAsyncRelativeRetry400Future", "Result", future.Response(), "Polling failure")
 		return
@@ -577,6 +1767,12 @@ func (future *LROSADsPostAsyncRelativeRetry400Future) Result(client LROSADsClien
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumePostAsyncRelativeRetry400 to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsPostAsyncRelativeRetry400Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsPostAsyncRelativeRetry400Future", future.Future)
+}
+
 // LROSADsPostAsyncRelativeRetryInvalidHeaderFuture an abstraction for monitoring and retrieving the
 // results of a long-running operation.
 type LROSADsPostAsyncRelativeRetryInvalidHeaderFuture struct {
@@ -586,8 +1782,36 @@ type LROSADsPostAsyncRelativeRetryInvalidHeaderFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsPostAsyncRelativeRetryInvalidHeaderFuture) Result(client LROSADsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsPostAsyncRelativeRetryInvalidHeaderFuture) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.PostAsyncRelativeRetryInvalidHeader")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsPostAsyncRelativeRetryInvalidHeaderFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPostAsyncRelativeRetryInvalidHeaderFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsPostAsyncRelativeRetryInvalidHeaderFuture) ResultWithContext(ctx context.Context, client LROSADsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPostAsyncRelativeRetryInvalidHeaderFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -600,6 +1824,12 @@ func (future *LROSADsPostAsyncRelativeRetryInvalidHeaderFuture) Result(client LR
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumePostAsyncRelativeRetryInvalidHeader to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsPostAsyncRelativeRetryInvalidHeaderFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsPostAsyncRelativeRetryInvalidHeaderFuture", future.Future)
+}
+
 // LROSADsPostAsyncRelativeRetryInvalidJSONPollingFuture an abstraction for monitoring and retrieving the
 // results of a long-running operation.
 type LROSADsPostAsyncRelativeRetryInvalidJSONPollingFuture struct {
@@ -609,8 +1839,36 @@ type LROSADsPostAsyncRelativeRetryInvalidJSONPollingFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsPostAsyncRelativeRetryInvalidJSONPollingFuture) Result(client LROSADsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsPostAsyncRelativeRetryInvalidJSONPollingFuture) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.PostAsyncRelativeRetryInvalidJSONPolling")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsPostAsyncRelativeRetryInvalidJSONPollingFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPostAsyncRelativeRetryInvalidJSONPollingFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsPostAsyncRelativeRetryInvalidJSONPollingFuture) ResultWithContext(ctx context.Context, client LROSADsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPostAsyncRelativeRetryInvalidJSONPollingFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -623,6 +1881,12 @@ func (future *LROSADsPostAsyncRelativeRetryInvalidJSONPollingFuture) Result(clie
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumePostAsyncRelativeRetryInvalidJSONPolling to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsPostAsyncRelativeRetryInvalidJSONPollingFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsPostAsyncRelativeRetryInvalidJSONPollingFuture", future.Future)
+}
+
 // LROSADsPostAsyncRelativeRetryNoPayloadFuture an abstraction for monitoring and retrieving the results of
 // a long-running operation.
 type LROSADsPostAsyncRelativeRetryNoPayloadFuture struct {
@@ -632,8 +1896,36 @@ type LROSADsPostAsyncRelativeRetryNoPayloadFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsPostAsyncRelativeRetryNoPayloadFuture) Result(client LROSADsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsPostAsyncRelativeRetryNoPayloadFuture) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.PostAsyncRelativeRetryNoPayload")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsPostAsyncRelativeRetryNoPayloadFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPostAsyncRelativeRetryNoPayloadFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsPostAsyncRelativeRetryNoPayloadFuture) ResultWithContext(ctx context.Context, client LROSADsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPostAsyncRelativeRetryNoPayloadFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -646,6 +1938,12 @@ func (future *LROSADsPostAsyncRelativeRetryNoPayloadFuture) Result(client LROSAD
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumePostAsyncRelativeRetryNoPayload to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsPostAsyncRelativeRetryNoPayloadFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsPostAsyncRelativeRetryNoPayloadFuture", future.Future)
+}
+
 // LROSADsPostNonRetry400Future an abstraction for monitoring and retrieving the results of a long-running
 // operation.
 type LROSADsPostNonRetry400Future struct {
@@ -655,8 +1953,36 @@ type LROSADsPostNonRetry400Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsPostNonRetry400Future) Result(client LROSADsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsPostNonRetry400Future) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.PostNonRetry400")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsPostNonRetry400Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPostNonRetry400Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsPostNonRetry400Future) ResultWithContext(ctx context.Context, client LROSADsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPostNonRetry400Future", "Result", future.Response(), "Polling failure")
 		return
@@ -669,6 +1995,12 @@ func (future *LROSADsPostNonRetry400Future) Result(client LROSADsClient) (ar aut
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumePostNonRetry400 to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsPostNonRetry400Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsPostNonRetry400Future", future.Future)
+}
+
 // LROSADsPut200InvalidJSONFuture an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROSADsPut200InvalidJSONFuture struct {
@@ -678,8 +2010,36 @@ type LROSADsPut200InvalidJSONFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsPut200InvalidJSONFuture) Result(client LROSADsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsPut200InvalidJSONFuture) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.Put200InvalidJSON")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsPut200InvalidJSONFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPut200InvalidJSONFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsPut200InvalidJSONFuture) ResultWithContext(ctx context.Context, client LROSADsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPut200InvalidJSONFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -688,16 +2048,21 @@ func (future *LROSADsPut200InvalidJSONFuture) Result(client LROSADsClient) (p Pr
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROSADsPut200InvalidJSONFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.Put200InvalidJSONResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPut200InvalidJSONFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumePut200InvalidJSON to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsPut200InvalidJSONFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsPut200InvalidJSONFuture", future.Future)
+}
+
 // LROSADsPutAsyncRelativeRetry400Future an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROSADsPutAsyncRelativeRetry400Future struct {
@@ -707,8 +2072,36 @@ type LROSADsPutAsyncRelativeRetry400Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsPutAsyncRelativeRetry400Future) Result(client LROSADsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsPutAsyncRelativeRetry400Future) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.PutAsyncRelativeRetry400")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsPutAsyncRelativeRetry400Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutAsyncRelativeRetry400Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsPutAsyncRelativeRetry400Future) ResultWithContext(ctx context.Context, client LROSADsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutAsyncRelativeRetry400Future", "Result", future.Response(), "Polling failure")
 		return
@@ -717,16 +2110,21 @@ func (future *LROSADsPutAsyncRelativeRetry400Future) Result(client LROSADsClient
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROSADsPutAsyncRelativeRetry400Future")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.PutAsyncRelativeRetry400Responder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutAsyncRelativeRetry400Future", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumePutAsyncRelativeRetry400 to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsPutAsyncRelativeRetry400Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsPutAsyncRelativeRetry400Future", future.Future)
+}
+
 // LROSADsPutAsyncRelativeRetryInvalidHeaderFuture an abstraction for monitoring and retrieving the results
 // of a long-running operation.
 type LROSADsPutAsyncRelativeRetryInvalidHeaderFuture struct {
@@ -736,8 +2134,36 @@ type LROSADsPutAsyncRelativeRetryInvalidHeaderFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsPutAsyncRelativeRetryInvalidHeaderFuture) Result(client LROSADsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsPutAsyncRelativeRetryInvalidHeaderFuture) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.PutAsyncRelativeRetryInvalidHeader")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsPutAsyncRelativeRetryInvalidHeaderFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutAsyncRelativeRetryInvalidHeaderFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsPutAsyncRelativeRetryInvalidHeaderFuture) ResultWithContext(ctx context.Context, client LROSADsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutAsyncRelativeRetryInvalidHeaderFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -746,16 +2172,21 @@ func (future *LROSADsPutAsyncRelativeRetryInvalidHeaderFuture) Result(client LRO
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROSADsPutAsyncRelativeRetryInvalidHeaderFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.PutAsyncRelativeRetryInvalidHeaderResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutAsyncRelativeRetryInvalidHeaderFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumePutAsyncRelativeRetryInvalidHeader to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsPutAsyncRelativeRetryInvalidHeaderFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsPutAsyncRelativeRetryInvalidHeaderFuture", future.Future)
+}
+
 // LROSADsPutAsyncRelativeRetryInvalidJSONPollingFuture an abstraction for monitoring and retrieving the
 // results of a long-running operation.
 type LROSADsPutAsyncRelativeRetryInvalidJSONPollingFuture struct {
@@ -765,8 +2196,36 @@ type LROSADsPutAsyncRelativeRetryInvalidJSONPollingFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsPutAsyncRelativeRetryInvalidJSONPollingFuture) Result(client LROSADsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsPutAsyncRelativeRetryInvalidJSONPollingFuture) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.PutAsyncRelativeRetryInvalidJSONPolling")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsPutAsyncRelativeRetryInvalidJSONPollingFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutAsyncRelativeRetryInvalidJSONPollingFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsPutAsyncRelativeRetryInvalidJSONPollingFuture) ResultWithContext(ctx context.Context, client LROSADsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutAsyncRelativeRetryInvalidJSONPollingFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -775,16 +2234,21 @@ func (future *LROSADsPutAsyncRelativeRetryInvalidJSONPollingFuture) Result(clien
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROSADsPutAsyncRelativeRetryInvalidJSONPollingFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.PutAsyncRelativeRetryInvalidJSONPollingResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutAsyncRelativeRetryInvalidJSONPollingFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumePutAsyncRelativeRetryInvalidJSONPolling to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsPutAsyncRelativeRetryInvalidJSONPollingFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsPutAsyncRelativeRetryInvalidJSONPollingFuture", future.Future)
+}
+
 // LROSADsPutAsyncRelativeRetryNoStatusFuture an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROSADsPutAsyncRelativeRetryNoStatusFuture struct {
@@ -794,8 +2258,36 @@ type LROSADsPutAsyncRelativeRetryNoStatusFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsPutAsyncRelativeRetryNoStatusFuture) Result(client LROSADsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsPutAsyncRelativeRetryNoStatusFuture) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.PutAsyncRelativeRetryNoStatus")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsPutAsyncRelativeRetryNoStatusFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutAsyncRelativeRetryNoStatusFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsPutAsyncRelativeRetryNoStatusFuture) ResultWithContext(ctx context.Context, client LROSADsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutAsyncRelativeRetryNoStatusFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -804,16 +2296,21 @@ func (future *LROSADsPutAsyncRelativeRetryNoStatusFuture) Result(client LROSADsC
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROSADsPutAsyncRelativeRetryNoStatusFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.PutAsyncRelativeRetryNoStatusResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutAsyncRelativeRetryNoStatusFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumePutAsyncRelativeRetryNoStatus to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsPutAsyncRelativeRetryNoStatusFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsPutAsyncRelativeRetryNoStatusFuture", future.Future)
+}
+
 // LROSADsPutAsyncRelativeRetryNoStatusPayloadFuture an abstraction for monitoring and retrieving the
 // results of a long-running operation.
 type LROSADsPutAsyncRelativeRetryNoStatusPayloadFuture struct {
@@ -823,8 +2320,36 @@ type LROSADsPutAsyncRelativeRetryNoStatusPayloadFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsPutAsyncRelativeRetryNoStatusPayloadFuture) Result(client LROSADsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsPutAsyncRelativeRetryNoStatusPayloadFuture) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.PutAsyncRelativeRetryNoStatusPayload")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsPutAsyncRelativeRetryNoStatusPayloadFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutAsyncRelativeRetryNoStatusPayloadFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsPutAsyncRelativeRetryNoStatusPayloadFuture) ResultWithContext(ctx context.Context, client LROSADsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutAsyncRelativeRetryNoStatusPayloadFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -833,16 +2358,21 @@ func (future *LROSADsPutAsyncRelativeRetryNoStatusPayloadFuture) Result(client L
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROSADsPutAsyncRelativeRetryNoStatusPayloadFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.PutAsyncRelativeRetryNoStatusPayloadResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutAsyncRelativeRetryNoStatusPayloadFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumePutAsyncRelativeRetryNoStatusPayload to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsPutAsyncRelativeRetryNoStatusPayloadFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsPutAsyncRelativeRetryNoStatusPayloadFuture", future.Future)
+}
+
 // LROSADsPutError201NoProvisioningStatePayloadFuture an abstraction for monitoring and retrieving the
 // results of a long-running operation.
 type LROSADsPutError201NoProvisioningStatePayloadFuture struct {
@@ -852,26 +2382,59 @@ type LROSADsPutError201NoProvisioningStatePayloadFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsPutError201NoProvisioningStatePayloadFuture) Result(client LROSADsClient) (p Product, err error) {
-	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsPutError201NoProvisioningStatePayloadFuture) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.PutError201NoProvisioningStatePayload")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsPutError201NoProvisioningStatePayloadFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
 	if err != nil {
-		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutError201NoProvisioningStatePayloadFuture", "Result", future.Response(), "Polling failure")
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutError201NoProvisioningStatePayloadFuture", "PollUntilDone", future.Response(), "Polling failure")
 		return
 	}
-	if !done {
-		err = azure.NewAsyncOpIncompleteError("lrogroup.LROSADsPutError201NoProvisioningStatePayloadFuture")
-		return
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsPutError201NoProvisioningStatePayloadFuture) ResultWithContext(ctx context.Context, client LROSADsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
+	var done bool
+	done, err = future.DoneWithContext(ctx, sender)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutError201NoProvisioningStatePayloadFuture", "Result", future.Response(), "Polling failure")
+		return
+	}
+	if !done {
+		err = azure.NewAsyncOpIncompleteError("lrogroup.LROSADsPutError201NoProvisioningStatePayloadFuture")
+		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.PutError201NoProvisioningStatePayloadResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutError201NoProvisioningStatePayloadFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumePutError201NoProvisioningStatePayload to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsPutError201NoProvisioningStatePayloadFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsPutError201NoProvisioningStatePayloadFuture", future.Future)
+}
+
 // LROSADsPutNonRetry201Creating400Future an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROSADsPutNonRetry201Creating400Future struct {
@@ -881,8 +2444,36 @@ type LROSADsPutNonRetry201Creating400Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsPutNonRetry201Creating400Future) Result(client LROSADsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsPutNonRetry201Creating400Future) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.PutNonRetry201Creating400")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsPutNonRetry201Creating400Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutNonRetry201Creating400Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsPutNonRetry201Creating400Future) ResultWithContext(ctx context.Context, client LROSADsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutNonRetry201Creating400Future", "Result", future.Response(), "Polling failure")
 		return
@@ -891,16 +2482,21 @@ func (future *LROSADsPutNonRetry201Creating400Future) Result(client LROSADsClien
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROSADsPutNonRetry201Creating400Future")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.PutNonRetry201Creating400Responder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutNonRetry201Creating400Future", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumePutNonRetry201Creating400 to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsPutNonRetry201Creating400Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsPutNonRetry201Creating400Future", future.Future)
+}
+
 // LROSADsPutNonRetry201Creating400InvalidJSONFuture an abstraction for monitoring and retrieving the
 // results of a long-running operation.
 type LROSADsPutNonRetry201Creating400InvalidJSONFuture struct {
@@ -910,8 +2506,36 @@ type LROSADsPutNonRetry201Creating400InvalidJSONFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsPutNonRetry201Creating400InvalidJSONFuture) Result(client LROSADsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsPutNonRetry201Creating400InvalidJSONFuture) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.PutNonRetry201Creating400InvalidJSON")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsPutNonRetry201Creating400InvalidJSONFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutNonRetry201Creating400InvalidJSONFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsPutNonRetry201Creating400InvalidJSONFuture) ResultWithContext(ctx context.Context, client LROSADsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutNonRetry201Creating400InvalidJSONFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -920,16 +2544,21 @@ func (future *LROSADsPutNonRetry201Creating400InvalidJSONFuture) Result(client L
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROSADsPutNonRetry201Creating400InvalidJSONFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.PutNonRetry201Creating400InvalidJSONResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutNonRetry201Creating400InvalidJSONFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumePutNonRetry201Creating400InvalidJSON to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsPutNonRetry201Creating400InvalidJSONFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsPutNonRetry201Creating400InvalidJSONFuture", future.Future)
+}
+
 // LROSADsPutNonRetry400Future an abstraction for monitoring and retrieving the results of a long-running
 // operation.
 type LROSADsPutNonRetry400Future struct {
@@ -939,8 +2568,36 @@ type LROSADsPutNonRetry400Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROSADsPutNonRetry400Future) Result(client LROSADsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROSADsPutNonRetry400Future) PollUntilDone(ctx context.Context, client LROSADsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROSADsClient.PutNonRetry400")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROSADsPutNonRetry400Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutNonRetry400Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROSADsPutNonRetry400Future) ResultWithContext(ctx context.Context, client LROSADsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutNonRetry400Future", "Result", future.Response(), "Polling failure")
 		return
@@ -949,16 +2606,21 @@ func (future *LROSADsPutNonRetry400Future) Result(client LROSADsClient) (p Produ
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROSADsPutNonRetry400Future")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.PutNonRetry400Responder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROSADsPutNonRetry400Future", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROSADsClient.ResumePutNonRetry400 to rebuild
+// this future, e.g. after a process restart.
+func (future *LROSADsPutNonRetry400Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROSADsPutNonRetry400Future", future.Future)
+}
+
 // LROsCustomHeaderPost202Retry200Future an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROsCustomHeaderPost202Retry200Future struct {
@@ -968,8 +2630,36 @@ type LROsCustomHeaderPost202Retry200Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsCustomHeaderPost202Retry200Future) Result(client LROsCustomHeaderClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsCustomHeaderPost202Retry200Future) PollUntilDone(ctx context.Context, client LROsCustomHeaderClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsCustomHeaderClient.Post202Retry200")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsCustomHeaderPost202Retry200Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsCustomHeaderPost202Retry200Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsCustomHeaderPost202Retry200Future) ResultWithContext(ctx context.Context, client LROsCustomHeaderClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsCustomHeaderPost202Retry200Future", "Result", future.Response(), "Polling failure")
 		return
@@ -982,6 +2672,12 @@ func (future *LROsCustomHeaderPost202Retry200Future) Result(client LROsCustomHea
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsCustomHeaderClient.ResumePost202Retry200 to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsCustomHeaderPost202Retry200Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsCustomHeaderPost202Retry200Future", future.Future)
+}
+
 // LROsCustomHeaderPostAsyncRetrySucceededFuture an abstraction for monitoring and retrieving the results
 // of a long-running operation.
 type LROsCustomHeaderPostAsyncRetrySucceededFuture struct {
@@ -991,8 +2687,36 @@ type LROsCustomHeaderPostAsyncRetrySucceededFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsCustomHeaderPostAsyncRetrySucceededFuture) Result(client LROsCustomHeaderClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsCustomHeaderPostAsyncRetrySucceededFuture) PollUntilDone(ctx context.Context, client LROsCustomHeaderClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsCustomHeaderClient.PostAsyncRetrySucceeded")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsCustomHeaderPostAsyncRetrySucceededFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsCustomHeaderPostAsyncRetrySucceededFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsCustomHeaderPostAsyncRetrySucceededFuture) ResultWithContext(ctx context.Context, client LROsCustomHeaderClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsCustomHeaderPostAsyncRetrySucceededFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -1005,6 +2729,12 @@ func (future *LROsCustomHeaderPostAsyncRetrySucceededFuture) Result(client LROsC
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsCustomHeaderClient.ResumePostAsyncRetrySucceeded to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsCustomHeaderPostAsyncRetrySucceededFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsCustomHeaderPostAsyncRetrySucceededFuture", future.Future)
+}
+
 // LROsCustomHeaderPut201CreatingSucceeded200Future an abstraction for monitoring and retrieving the
 // results of a long-running operation.
 type LROsCustomHeaderPut201CreatingSucceeded200Future struct {
@@ -1014,8 +2744,36 @@ type LROsCustomHeaderPut201CreatingSucceeded200Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsCustomHeaderPut201CreatingSucceeded200Future) Result(client LROsCustomHeaderClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsCustomHeaderPut201CreatingSucceeded200Future) PollUntilDone(ctx context.Context, client LROsCustomHeaderClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsCustomHeaderClient.Put201CreatingSucceeded200")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsCustomHeaderPut201CreatingSucceeded200Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsCustomHeaderPut201CreatingSucceeded200Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsCustomHeaderPut201CreatingSucceeded200Future) ResultWithContext(ctx context.Context, client LROsCustomHeaderClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsCustomHeaderPut201CreatingSucceeded200Future", "Result", future.Response(), "Polling failure")
 		return
@@ -1024,16 +2782,21 @@ func (future *LROsCustomHeaderPut201CreatingSucceeded200Future) Result(client LR
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsCustomHeaderPut201CreatingSucceeded200Future")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.Put201CreatingSucceeded200Responder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsCustomHeaderPut201CreatingSucceeded200Future", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsCustomHeaderClient.ResumePut201CreatingSucceeded200 to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsCustomHeaderPut201CreatingSucceeded200Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsCustomHeaderPut201CreatingSucceeded200Future", future.Future)
+}
+
 // LROsCustomHeaderPutAsyncRetrySucceededFuture an abstraction for monitoring and retrieving the results of
 // a long-running operation.
 type LROsCustomHeaderPutAsyncRetrySucceededFuture struct {
@@ -1043,8 +2806,36 @@ type LROsCustomHeaderPutAsyncRetrySucceededFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsCustomHeaderPutAsyncRetrySucceededFuture) Result(client LROsCustomHeaderClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsCustomHeaderPutAsyncRetrySucceededFuture) PollUntilDone(ctx context.Context, client LROsCustomHeaderClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsCustomHeaderClient.PutAsyncRetrySucceeded")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsCustomHeaderPutAsyncRetrySucceededFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsCustomHeaderPutAsyncRetrySucceededFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsCustomHeaderPutAsyncRetrySucceededFuture) ResultWithContext(ctx context.Context, client LROsCustomHeaderClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsCustomHeaderPutAsyncRetrySucceededFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -1053,16 +2844,21 @@ func (future *LROsCustomHeaderPutAsyncRetrySucceededFuture) Result(client LROsCu
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsCustomHeaderPutAsyncRetrySucceededFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.PutAsyncRetrySucceededResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsCustomHeaderPutAsyncRetrySucceededFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsCustomHeaderClient.ResumePutAsyncRetrySucceeded to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsCustomHeaderPutAsyncRetrySucceededFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsCustomHeaderPutAsyncRetrySucceededFuture", future.Future)
+}
+
 // LROsDelete202NoRetry204Future an abstraction for monitoring and retrieving the results of a long-running
 // operation.
 type LROsDelete202NoRetry204Future struct {
@@ -1072,8 +2868,36 @@ type LROsDelete202NoRetry204Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsDelete202NoRetry204Future) Result(client LROsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsDelete202NoRetry204Future) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.Delete202NoRetry204")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsDelete202NoRetry204Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsDelete202NoRetry204Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsDelete202NoRetry204Future) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsDelete202NoRetry204Future", "Result", future.Response(), "Polling failure")
 		return
@@ -1082,16 +2906,21 @@ func (future *LROsDelete202NoRetry204Future) Result(client LROsClient) (p Produc
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsDelete202NoRetry204Future")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.Delete202NoRetry204Responder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsDelete202NoRetry204Future", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumeDelete202NoRetry204 to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsDelete202NoRetry204Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsDelete202NoRetry204Future", future.Future)
+}
+
 // LROsDelete202Retry200Future an abstraction for monitoring and retrieving the results of a long-running
 // operation.
 type LROsDelete202Retry200Future struct {
@@ -1101,8 +2930,36 @@ type LROsDelete202Retry200Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsDelete202Retry200Future) Result(client LROsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsDelete202Retry200Future) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.Delete202Retry200")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsDelete202Retry200Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsDelete202Retry200Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsDelete202Retry200Future) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsDelete202Retry200Future", "Result", future.Response(), "Polling failure")
 		return
@@ -1111,16 +2968,21 @@ func (future *LROsDelete202Retry200Future) Result(client LROsClient) (p Product,
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsDelete202Retry200Future")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.Delete202Retry200Responder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsDelete202Retry200Future", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumeDelete202Retry200 to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsDelete202Retry200Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsDelete202Retry200Future", future.Future)
+}
+
 // LROsDelete204SucceededFuture an abstraction for monitoring and retrieving the results of a long-running
 // operation.
 type LROsDelete204SucceededFuture struct {
@@ -1130,8 +2992,36 @@ type LROsDelete204SucceededFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsDelete204SucceededFuture) Result(client LROsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsDelete204SucceededFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.Delete204Succeeded")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsDelete204SucceededFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsDelete204SucceededFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsDelete204SucceededFuture) ResultWithContext(ctx context.Context, client LROsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsDelete204SucceededFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -1144,6 +3034,12 @@ func (future *LROsDelete204SucceededFuture) Result(client LROsClient) (ar autore
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumeDelete204Succeeded to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsDelete204SucceededFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsDelete204SucceededFuture", future.Future)
+}
+
 // LROsDeleteAsyncNoHeaderInRetryFuture an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROsDeleteAsyncNoHeaderInRetryFuture struct {
@@ -1153,8 +3049,36 @@ type LROsDeleteAsyncNoHeaderInRetryFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsDeleteAsyncNoHeaderInRetryFuture) Result(client LROsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsDeleteAsyncNoHeaderInRetryFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.DeleteAsyncNoHeaderInRetry")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsDeleteAsyncNoHeaderInRetryFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsDeleteAsyncNoHeaderInRetryFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsDeleteAsyncNoHeaderInRetryFuture) ResultWithContext(ctx context.Context, client LROsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsDeleteAsyncNoHeaderInRetryFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -1167,6 +3091,12 @@ func (future *LROsDeleteAsyncNoHeaderInRetryFuture) Result(client LROsClient) (a
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumeDeleteAsyncNoHeaderInRetry to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsDeleteAsyncNoHeaderInRetryFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsDeleteAsyncNoHeaderInRetryFuture", future.Future)
+}
+
 // LROsDeleteAsyncNoRetrySucceededFuture an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROsDeleteAsyncNoRetrySucceededFuture struct {
@@ -1176,8 +3106,36 @@ type LROsDeleteAsyncNoRetrySucceededFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsDeleteAsyncNoRetrySucceededFuture) Result(client LROsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsDeleteAsyncNoRetrySucceededFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.DeleteAsyncNoRetrySucceeded")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsDeleteAsyncNoRetrySucceededFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsDeleteAsyncNoRetrySucceededFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsDeleteAsyncNoRetrySucceededFuture) ResultWithContext(ctx context.Context, client LROsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsDeleteAsyncNoRetrySucceededFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -1190,6 +3148,12 @@ func (future *LROsDeleteAsyncNoRetrySucceededFuture) Result(client LROsClient) (
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumeDeleteAsyncNoRetrySucceeded to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsDeleteAsyncNoRetrySucceededFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsDeleteAsyncNoRetrySucceededFuture", future.Future)
+}
+
 // LROsDeleteAsyncRetrycanceledFuture an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROsDeleteAsyncRetrycanceledFuture struct {
@@ -1199,8 +3163,36 @@ type LROsDeleteAsyncRetrycanceledFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsDeleteAsyncRetrycanceledFuture) Result(client LROsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsDeleteAsyncRetrycanceledFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.DeleteAsyncRetrycanceled")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsDeleteAsyncRetrycanceledFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsDeleteAsyncRetrycanceledFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsDeleteAsyncRetrycanceledFuture) ResultWithContext(ctx context.Context, client LROsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsDeleteAsyncRetrycanceledFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -1213,6 +3205,12 @@ func (future *LROsDeleteAsyncRetrycanceledFuture) Result(client LROsClient) (ar
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumeDeleteAsyncRetrycanceled to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsDeleteAsyncRetrycanceledFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsDeleteAsyncRetrycanceledFuture", future.Future)
+}
+
 // LROsDeleteAsyncRetryFailedFuture an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROsDeleteAsyncRetryFailedFuture struct {
@@ -1222,8 +3220,36 @@ type LROsDeleteAsyncRetryFailedFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsDeleteAsyncRetryFailedFuture) Result(client LROsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsDeleteAsyncRetryFailedFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.DeleteAsyncRetryFailed")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsDeleteAsyncRetryFailedFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsDeleteAsyncRetryFailedFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsDeleteAsyncRetryFailedFuture) ResultWithContext(ctx context.Context, client LROsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsDeleteAsyncRetryFailedFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -1236,6 +3262,12 @@ func (future *LROsDeleteAsyncRetryFailedFuture) Result(client LROsClient) (ar au
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumeDeleteAsyncRetryFailed to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsDeleteAsyncRetryFailedFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsDeleteAsyncRetryFailedFuture", future.Future)
+}
+
 // LROsDeleteAsyncRetrySucceededFuture an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROsDeleteAsyncRetrySucceededFuture struct {
@@ -1245,8 +3277,36 @@ type LROsDeleteAsyncRetrySucceededFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsDeleteAsyncRetrySucceededFuture) Result(client LROsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsDeleteAsyncRetrySucceededFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.DeleteAsyncRetrySucceeded")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsDeleteAsyncRetrySucceededFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsDeleteAsyncRetrySucceededFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsDeleteAsyncRetrySucceededFuture) ResultWithContext(ctx context.Context, client LROsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsDeleteAsyncRetrySucceededFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -1259,6 +3319,12 @@ func (future *LROsDeleteAsyncRetrySucceededFuture) Result(client LROsClient) (ar
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumeDeleteAsyncRetrySucceeded to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsDeleteAsyncRetrySucceededFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsDeleteAsyncRetrySucceededFuture", future.Future)
+}
+
 // LROsDeleteNoHeaderInRetryFuture an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROsDeleteNoHeaderInRetryFuture struct {
@@ -1268,8 +3334,36 @@ type LROsDeleteNoHeaderInRetryFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsDeleteNoHeaderInRetryFuture) Result(client LROsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsDeleteNoHeaderInRetryFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.DeleteNoHeaderInRetry")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsDeleteNoHeaderInRetryFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsDeleteNoHeaderInRetryFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsDeleteNoHeaderInRetryFuture) ResultWithContext(ctx context.Context, client LROsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsDeleteNoHeaderInRetryFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -1282,6 +3376,12 @@ func (future *LROsDeleteNoHeaderInRetryFuture) Result(client LROsClient) (ar aut
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumeDeleteNoHeaderInRetry to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsDeleteNoHeaderInRetryFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsDeleteNoHeaderInRetryFuture", future.Future)
+}
+
 // LROsDeleteProvisioning202Accepted200SucceededFuture an abstraction for monitoring and retrieving the
 // results of a long-running operation.
 type LROsDeleteProvisioning202Accepted200SucceededFuture struct {
@@ -1291,8 +3391,36 @@ type LROsDeleteProvisioning202Accepted200SucceededFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsDeleteProvisioning202Accepted200SucceededFuture) Result(client LROsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsDeleteProvisioning202Accepted200SucceededFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.DeleteProvisioning202Accepted200Succeeded")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsDeleteProvisioning202Accepted200SucceededFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsDeleteProvisioning202Accepted200SucceededFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsDeleteProvisioning202Accepted200SucceededFuture) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsDeleteProvisioning202Accepted200SucceededFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -1301,16 +3429,21 @@ func (future *LROsDeleteProvisioning202Accepted200SucceededFuture) Result(client
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsDeleteProvisioning202Accepted200SucceededFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.DeleteProvisioning202Accepted200SucceededResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsDeleteProvisioning202Accepted200SucceededFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumeDeleteProvisioning202Accepted200Succeeded to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsDeleteProvisioning202Accepted200SucceededFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsDeleteProvisioning202Accepted200SucceededFuture", future.Future)
+}
+
 // LROsDeleteProvisioning202Deletingcanceled200Future an abstraction for monitoring and retrieving the
 // results of a long-running operation.
 type LROsDeleteProvisioning202Deletingcanceled200Future struct {
@@ -1320,8 +3453,36 @@ type LROsDeleteProvisioning202Deletingcanceled200Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsDeleteProvisioning202Deletingcanceled200Future) Result(client LROsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsDeleteProvisioning202Deletingcanceled200Future) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.DeleteProvisioning202Deletingcanceled200")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsDeleteProvisioning202Deletingcanceled200Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsDeleteProvisioning202Deletingcanceled200Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsDeleteProvisioning202Deletingcanceled200Future) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsDeleteProvisioning202Deletingcanceled200Future", "Result", future.Response(), "Polling failure")
 		return
@@ -1330,16 +3491,21 @@ func (future *LROsDeleteProvisioning202Deletingcanceled200Future) Result(client
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsDeleteProvisioning202Deletingcanceled200Future")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.DeleteProvisioning202Deletingcanceled200Responder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsDeleteProvisioning202Deletingcanceled200Future", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumeDeleteProvisioning202Deletingcanceled200 to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsDeleteProvisioning202Deletingcanceled200Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsDeleteProvisioning202Deletingcanceled200Future", future.Future)
+}
+
 // LROsDeleteProvisioning202DeletingFailed200Future an abstraction for monitoring and retrieving the
 // results of a long-running operation.
 type LROsDeleteProvisioning202DeletingFailed200Future struct {
@@ -1349,8 +3515,36 @@ type LROsDeleteProvisioning202DeletingFailed200Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsDeleteProvisioning202DeletingFailed200Future) Result(client LROsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsDeleteProvisioning202DeletingFailed200Future) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.DeleteProvisioning202DeletingFailed200")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsDeleteProvisioning202DeletingFailed200Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsDeleteProvisioning202DeletingFailed200Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsDeleteProvisioning202DeletingFailed200Future) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsDeleteProvisioning202DeletingFailed200Future", "Result", future.Response(), "Polling failure")
 		return
@@ -1359,16 +3553,21 @@ func (future *LROsDeleteProvisioning202DeletingFailed200Future) Result(client LR
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsDeleteProvisioning202DeletingFailed200Future")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.DeleteProvisioning202DeletingFailed200Responder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsDeleteProvisioning202DeletingFailed200Future", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumeDeleteProvisioning202DeletingFailed200 to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsDeleteProvisioning202DeletingFailed200Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsDeleteProvisioning202DeletingFailed200Future", future.Future)
+}
+
 // LROsPost200WithPayloadFuture an abstraction for monitoring and retrieving the results of a long-running
 // operation.
 type LROsPost200WithPayloadFuture struct {
@@ -1378,26 +3577,59 @@ type LROsPost200WithPayloadFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPost200WithPayloadFuture) Result(client LROsClient) (s Sku, err error) {
-	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
-	if err != nil {
-		err = autorest.NewErrorWithError(err, "lrogroup.LROsPost200WithPayloadFuture", "Result", future.Response(), "Polling failure")
-		return
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPost200WithPayloadFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (s Sku, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.Post200WithPayload")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPost200WithPayloadFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPost200WithPayloadFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPost200WithPayloadFuture) ResultWithContext(ctx context.Context, client LROsClient) (s Sku, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
+	var done bool
+	done, err = future.DoneWithContext(ctx, sender)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPost200WithPayloadFuture", "Result", future.Response(), "Polling failure")
+		return
 	}
 	if !done {
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPost200WithPayloadFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if s.Response.Response, err = future.GetResult(sender); err == nil && s.Response.Response.StatusCode != http.StatusNoContent {
 		s, err = client.Post200WithPayloadResponder(s.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPost200WithPayloadFuture", "Result", s.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePost200WithPayload to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPost200WithPayloadFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPost200WithPayloadFuture", future.Future)
+}
+
 // LROsPost202NoRetry204Future an abstraction for monitoring and retrieving the results of a long-running
 // operation.
 type LROsPost202NoRetry204Future struct {
@@ -1407,8 +3639,36 @@ type LROsPost202NoRetry204Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPost202NoRetry204Future) Result(client LROsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPost202NoRetry204Future) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.Post202NoRetry204")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPost202NoRetry204Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPost202NoRetry204Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPost202NoRetry204Future) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPost202NoRetry204Future", "Result", future.Response(), "Polling failure")
 		return
@@ -1417,16 +3677,21 @@ func (future *LROsPost202NoRetry204Future) Result(client LROsClient) (p Product,
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPost202NoRetry204Future")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.Post202NoRetry204Responder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPost202NoRetry204Future", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePost202NoRetry204 to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPost202NoRetry204Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPost202NoRetry204Future", future.Future)
+}
+
 // LROsPost202Retry200Future an abstraction for monitoring and retrieving the results of a long-running
 // operation.
 type LROsPost202Retry200Future struct {
@@ -1436,8 +3701,36 @@ type LROsPost202Retry200Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPost202Retry200Future) Result(client LROsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPost202Retry200Future) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.Post202Retry200")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPost202Retry200Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPost202Retry200Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPost202Retry200Future) ResultWithContext(ctx context.Context, client LROsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPost202Retry200Future", "Result", future.Response(), "Polling failure")
 		return
@@ -1450,6 +3743,12 @@ func (future *LROsPost202Retry200Future) Result(client LROsClient) (ar autorest.
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePost202Retry200 to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPost202Retry200Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPost202Retry200Future", future.Future)
+}
+
 // LROsPostAsyncNoRetrySucceededFuture an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROsPostAsyncNoRetrySucceededFuture struct {
@@ -1459,8 +3758,36 @@ type LROsPostAsyncNoRetrySucceededFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPostAsyncNoRetrySucceededFuture) Result(client LROsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPostAsyncNoRetrySucceededFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.PostAsyncNoRetrySucceeded")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPostAsyncNoRetrySucceededFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPostAsyncNoRetrySucceededFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPostAsyncNoRetrySucceededFuture) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPostAsyncNoRetrySucceededFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -1469,16 +3796,21 @@ func (future *LROsPostAsyncNoRetrySucceededFuture) Result(client LROsClient) (p
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPostAsyncNoRetrySucceededFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.PostAsyncNoRetrySucceededResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPostAsyncNoRetrySucceededFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePostAsyncNoRetrySucceeded to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPostAsyncNoRetrySucceededFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPostAsyncNoRetrySucceededFuture", future.Future)
+}
+
 // LROsPostAsyncRetrycanceledFuture an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROsPostAsyncRetrycanceledFuture struct {
@@ -1488,8 +3820,36 @@ type LROsPostAsyncRetrycanceledFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPostAsyncRetrycanceledFuture) Result(client LROsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPostAsyncRetrycanceledFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.PostAsyncRetrycanceled")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPostAsyncRetrycanceledFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPostAsyncRetrycanceledFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPostAsyncRetrycanceledFuture) ResultWithContext(ctx context.Context, client LROsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPostAsyncRetrycanceledFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -1502,6 +3862,12 @@ func (future *LROsPostAsyncRetrycanceledFuture) Result(client LROsClient) (ar au
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePostAsyncRetrycanceled to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPostAsyncRetrycanceledFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPostAsyncRetrycanceledFuture", future.Future)
+}
+
 // LROsPostAsyncRetryFailedFuture an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROsPostAsyncRetryFailedFuture struct {
@@ -1511,8 +3877,36 @@ type LROsPostAsyncRetryFailedFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPostAsyncRetryFailedFuture) Result(client LROsClient) (ar autorest.Response, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPostAsyncRetryFailedFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (ar autorest.Response, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.PostAsyncRetryFailed")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPostAsyncRetryFailedFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPostAsyncRetryFailedFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPostAsyncRetryFailedFuture) ResultWithContext(ctx context.Context, client LROsClient) (ar autorest.Response, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPostAsyncRetryFailedFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -1525,6 +3919,12 @@ func (future *LROsPostAsyncRetryFailedFuture) Result(client LROsClient) (ar auto
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePostAsyncRetryFailed to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPostAsyncRetryFailedFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPostAsyncRetryFailedFuture", future.Future)
+}
+
 // LROsPostAsyncRetrySucceededFuture an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROsPostAsyncRetrySucceededFuture struct {
@@ -1534,8 +3934,36 @@ type LROsPostAsyncRetrySucceededFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPostAsyncRetrySucceededFuture) Result(client LROsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPostAsyncRetrySucceededFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.PostAsyncRetrySucceeded")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPostAsyncRetrySucceededFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPostAsyncRetrySucceededFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPostAsyncRetrySucceededFuture) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPostAsyncRetrySucceededFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -1544,16 +3972,21 @@ func (future *LROsPostAsyncRetrySucceededFuture) Result(client LROsClient) (p Pr
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPostAsyncRetrySucceededFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.PostAsyncRetrySucceededResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPostAsyncRetrySucceededFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePostAsyncRetrySucceeded to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPostAsyncRetrySucceededFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPostAsyncRetrySucceededFuture", future.Future)
+}
+
 // LROsPostDoubleHeadersFinalAzureHeaderGetDefaultFuture an abstraction for monitoring and retrieving the
 // results of a long-running operation.
 type LROsPostDoubleHeadersFinalAzureHeaderGetDefaultFuture struct {
@@ -1563,8 +3996,36 @@ type LROsPostDoubleHeadersFinalAzureHeaderGetDefaultFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPostDoubleHeadersFinalAzureHeaderGetDefaultFuture) Result(client LROsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPostDoubleHeadersFinalAzureHeaderGetDefaultFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.PostDoubleHeadersFinalAzureHeaderGetDefault")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPostDoubleHeadersFinalAzureHeaderGetDefaultFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPostDoubleHeadersFinalAzureHeaderGetDefaultFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPostDoubleHeadersFinalAzureHeaderGetDefaultFuture) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPostDoubleHeadersFinalAzureHeaderGetDefaultFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -1573,16 +4034,21 @@ func (future *LROsPostDoubleHeadersFinalAzureHeaderGetDefaultFuture) Result(clie
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPostDoubleHeadersFinalAzureHeaderGetDefaultFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.PostDoubleHeadersFinalAzureHeaderGetDefaultResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPostDoubleHeadersFinalAzureHeaderGetDefaultFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePostDoubleHeadersFinalAzureHeaderGetDefault to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPostDoubleHeadersFinalAzureHeaderGetDefaultFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPostDoubleHeadersFinalAzureHeaderGetDefaultFuture", future.Future)
+}
+
 // LROsPostDoubleHeadersFinalAzureHeaderGetFuture an abstraction for monitoring and retrieving the results
 // of a long-running operation.
 type LROsPostDoubleHeadersFinalAzureHeaderGetFuture struct {
@@ -1592,8 +4058,36 @@ type LROsPostDoubleHeadersFinalAzureHeaderGetFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPostDoubleHeadersFinalAzureHeaderGetFuture) Result(client LROsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPostDoubleHeadersFinalAzureHeaderGetFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.PostDoubleHeadersFinalAzureHeaderGet")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPostDoubleHeadersFinalAzureHeaderGetFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPostDoubleHeadersFinalAzureHeaderGetFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPostDoubleHeadersFinalAzureHeaderGetFuture) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPostDoubleHeadersFinalAzureHeaderGetFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -1602,16 +4096,21 @@ func (future *LROsPostDoubleHeadersFinalAzureHeaderGetFuture) Result(client LROs
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPostDoubleHeadersFinalAzureHeaderGetFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.PostDoubleHeadersFinalAzureHeaderGetResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPostDoubleHeadersFinalAzureHeaderGetFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePostDoubleHeadersFinalAzureHeaderGet to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPostDoubleHeadersFinalAzureHeaderGetFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPostDoubleHeadersFinalAzureHeaderGetFuture", future.Future)
+}
+
 // LROsPostDoubleHeadersFinalLocationGetFuture an abstraction for monitoring and retrieving the results of
 // a long-running operation.
 type LROsPostDoubleHeadersFinalLocationGetFuture struct {
@@ -1621,8 +4120,36 @@ type LROsPostDoubleHeadersFinalLocationGetFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPostDoubleHeadersFinalLocationGetFuture) Result(client LROsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPostDoubleHeadersFinalLocationGetFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.PostDoubleHeadersFinalLocationGet")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPostDoubleHeadersFinalLocationGetFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPostDoubleHeadersFinalLocationGetFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPostDoubleHeadersFinalLocationGetFuture) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPostDoubleHeadersFinalLocationGetFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -1631,16 +4158,21 @@ func (future *LROsPostDoubleHeadersFinalLocationGetFuture) Result(client LROsCli
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPostDoubleHeadersFinalLocationGetFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.PostDoubleHeadersFinalLocationGetResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPostDoubleHeadersFinalLocationGetFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePostDoubleHeadersFinalLocationGet to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPostDoubleHeadersFinalLocationGetFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPostDoubleHeadersFinalLocationGetFuture", future.Future)
+}
+
 // LROsPut200Acceptedcanceled200Future an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROsPut200Acceptedcanceled200Future struct {
@@ -1650,8 +4182,36 @@ type LROsPut200Acceptedcanceled200Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPut200Acceptedcanceled200Future) Result(client LROsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPut200Acceptedcanceled200Future) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.Put200Acceptedcanceled200")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPut200Acceptedcanceled200Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPut200Acceptedcanceled200Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPut200Acceptedcanceled200Future) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPut200Acceptedcanceled200Future", "Result", future.Response(), "Polling failure")
 		return
@@ -1660,16 +4220,21 @@ func (future *LROsPut200Acceptedcanceled200Future) Result(client LROsClient) (p
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPut200Acceptedcanceled200Future")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.Put200Acceptedcanceled200Responder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPut200Acceptedcanceled200Future", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePut200Acceptedcanceled200 to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPut200Acceptedcanceled200Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPut200Acceptedcanceled200Future", future.Future)
+}
+
 // LROsPut200SucceededFuture an abstraction for monitoring and retrieving the results of a long-running
 // operation.
 type LROsPut200SucceededFuture struct {
@@ -1679,8 +4244,36 @@ type LROsPut200SucceededFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPut200SucceededFuture) Result(client LROsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPut200SucceededFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.Put200Succeeded")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPut200SucceededFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPut200SucceededFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPut200SucceededFuture) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPut200SucceededFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -1689,16 +4282,21 @@ func (future *LROsPut200SucceededFuture) Result(client LROsClient) (p Product, e
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPut200SucceededFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.Put200SucceededResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPut200SucceededFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePut200Succeeded to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPut200SucceededFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPut200SucceededFuture", future.Future)
+}
+
 // LROsPut200SucceededNoStateFuture an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROsPut200SucceededNoStateFuture struct {
@@ -1708,8 +4306,36 @@ type LROsPut200SucceededNoStateFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPut200SucceededNoStateFuture) Result(client LROsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPut200SucceededNoStateFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.Put200SucceededNoState")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPut200SucceededNoStateFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPut200SucceededNoStateFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPut200SucceededNoStateFuture) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPut200SucceededNoStateFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -1718,16 +4344,21 @@ func (future *LROsPut200SucceededNoStateFuture) Result(client LROsClient) (p Pro
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPut200SucceededNoStateFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.Put200SucceededNoStateResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPut200SucceededNoStateFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePut200SucceededNoState to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPut200SucceededNoStateFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPut200SucceededNoStateFuture", future.Future)
+}
+
 // LROsPut200UpdatingSucceeded204Future an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROsPut200UpdatingSucceeded204Future struct {
@@ -1737,8 +4368,36 @@ type LROsPut200UpdatingSucceeded204Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPut200UpdatingSucceeded204Future) Result(client LROsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPut200UpdatingSucceeded204Future) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.Put200UpdatingSucceeded204")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPut200UpdatingSucceeded204Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPut200UpdatingSucceeded204Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPut200UpdatingSucceeded204Future) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPut200UpdatingSucceeded204Future", "Result", future.Response(), "Polling failure")
 		return
@@ -1747,16 +4406,21 @@ func (future *LROsPut200UpdatingSucceeded204Future) Result(client LROsClient) (p
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPut200UpdatingSucceeded204Future")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.Put200UpdatingSucceeded204Responder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPut200UpdatingSucceeded204Future", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePut200UpdatingSucceeded204 to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPut200UpdatingSucceeded204Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPut200UpdatingSucceeded204Future", future.Future)
+}
+
 // LROsPut201CreatingFailed200Future an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROsPut201CreatingFailed200Future struct {
@@ -1766,8 +4430,36 @@ type LROsPut201CreatingFailed200Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPut201CreatingFailed200Future) Result(client LROsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPut201CreatingFailed200Future) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.Put201CreatingFailed200")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPut201CreatingFailed200Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPut201CreatingFailed200Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPut201CreatingFailed200Future) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPut201CreatingFailed200Future", "Result", future.Response(), "Polling failure")
 		return
@@ -1776,16 +4468,21 @@ func (future *LROsPut201CreatingFailed200Future) Result(client LROsClient) (p Pr
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPut201CreatingFailed200Future")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.Put201CreatingFailed200Responder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPut201CreatingFailed200Future", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePut201CreatingFailed200 to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPut201CreatingFailed200Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPut201CreatingFailed200Future", future.Future)
+}
+
 // LROsPut201CreatingSucceeded200Future an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROsPut201CreatingSucceeded200Future struct {
@@ -1795,8 +4492,36 @@ type LROsPut201CreatingSucceeded200Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPut201CreatingSucceeded200Future) Result(client LROsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPut201CreatingSucceeded200Future) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.Put201CreatingSucceeded200")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPut201CreatingSucceeded200Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPut201CreatingSucceeded200Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPut201CreatingSucceeded200Future) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPut201CreatingSucceeded200Future", "Result", future.Response(), "Polling failure")
 		return
@@ -1805,16 +4530,21 @@ func (future *LROsPut201CreatingSucceeded200Future) Result(client LROsClient) (p
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPut201CreatingSucceeded200Future")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.Put201CreatingSucceeded200Responder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPut201CreatingSucceeded200Future", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePut201CreatingSucceeded200 to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPut201CreatingSucceeded200Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPut201CreatingSucceeded200Future", future.Future)
+}
+
 // LROsPut202Retry200Future an abstraction for monitoring and retrieving the results of a long-running
 // operation.
 type LROsPut202Retry200Future struct {
@@ -1824,8 +4554,36 @@ type LROsPut202Retry200Future struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPut202Retry200Future) Result(client LROsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPut202Retry200Future) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.Put202Retry200")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPut202Retry200Future.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPut202Retry200Future", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPut202Retry200Future) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPut202Retry200Future", "Result", future.Response(), "Polling failure")
 		return
@@ -1834,16 +4592,21 @@ func (future *LROsPut202Retry200Future) Result(client LROsClient) (p Product, er
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPut202Retry200Future")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.Put202Retry200Responder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPut202Retry200Future", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePut202Retry200 to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPut202Retry200Future) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPut202Retry200Future", future.Future)
+}
+
 // LROsPutAsyncNoHeaderInRetryFuture an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROsPutAsyncNoHeaderInRetryFuture struct {
@@ -1853,8 +4616,36 @@ type LROsPutAsyncNoHeaderInRetryFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPutAsyncNoHeaderInRetryFuture) Result(client LROsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPutAsyncNoHeaderInRetryFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.PutAsyncNoHeaderInRetry")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPutAsyncNoHeaderInRetryFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPutAsyncNoHeaderInRetryFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPutAsyncNoHeaderInRetryFuture) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPutAsyncNoHeaderInRetryFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -1863,16 +4654,21 @@ func (future *LROsPutAsyncNoHeaderInRetryFuture) Result(client LROsClient) (p Pr
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPutAsyncNoHeaderInRetryFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.PutAsyncNoHeaderInRetryResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPutAsyncNoHeaderInRetryFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePutAsyncNoHeaderInRetry to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPutAsyncNoHeaderInRetryFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPutAsyncNoHeaderInRetryFuture", future.Future)
+}
+
 // LROsPutAsyncNonResourceFuture an abstraction for monitoring and retrieving the results of a long-running
 // operation.
 type LROsPutAsyncNonResourceFuture struct {
@@ -1882,8 +4678,36 @@ type LROsPutAsyncNonResourceFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPutAsyncNonResourceFuture) Result(client LROsClient) (s Sku, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPutAsyncNonResourceFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (s Sku, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.PutAsyncNonResource")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPutAsyncNonResourceFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPutAsyncNonResourceFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPutAsyncNonResourceFuture) ResultWithContext(ctx context.Context, client LROsClient) (s Sku, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPutAsyncNonResourceFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -1892,16 +4716,21 @@ func (future *LROsPutAsyncNonResourceFuture) Result(client LROsClient) (s Sku, e
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPutAsyncNonResourceFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if s.Response.Response, err = future.GetResult(sender); err == nil && s.Response.Response.StatusCode != http.StatusNoContent {
 		s, err = client.PutAsyncNonResourceResponder(s.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPutAsyncNonResourceFuture", "Result", s.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePutAsyncNonResource to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPutAsyncNonResourceFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPutAsyncNonResourceFuture", future.Future)
+}
+
 // LROsPutAsyncNoRetrycanceledFuture an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROsPutAsyncNoRetrycanceledFuture struct {
@@ -1911,26 +4740,59 @@ type LROsPutAsyncNoRetrycanceledFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPutAsyncNoRetrycanceledFuture) Result(client LROsClient) (p Product, err error) {
-	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPutAsyncNoRetrycanceledFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.PutAsyncNoRetrycanceled")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPutAsyncNoRetrycanceledFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
 	if err != nil {
-		err = autorest.NewErrorWithError(err, "lrogroup.LROsPutAsyncNoRetrycanceledFuture", "Result", future.Response(), "Polling failure")
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPutAsyncNoRetrycanceledFuture", "PollUntilDone", future.Response(), "Polling failure")
 		return
 	}
-	if !done {
-		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPutAsyncNoRetrycanceledFuture")
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPutAsyncNoRetrycanceledFuture) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
+	var done bool
+	done, err = future.DoneWithContext(ctx, sender)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPutAsyncNoRetrycanceledFuture", "Result", future.Response(), "Polling failure")
+		return
+	}
+	if !done {
+		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPutAsyncNoRetrycanceledFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.PutAsyncNoRetrycanceledResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPutAsyncNoRetrycanceledFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePutAsyncNoRetrycanceled to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPutAsyncNoRetrycanceledFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPutAsyncNoRetrycanceledFuture", future.Future)
+}
+
 // LROsPutAsyncNoRetrySucceededFuture an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROsPutAsyncNoRetrySucceededFuture struct {
@@ -1940,8 +4802,36 @@ type LROsPutAsyncNoRetrySucceededFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPutAsyncNoRetrySucceededFuture) Result(client LROsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPutAsyncNoRetrySucceededFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.PutAsyncNoRetrySucceeded")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPutAsyncNoRetrySucceededFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPutAsyncNoRetrySucceededFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPutAsyncNoRetrySucceededFuture) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPutAsyncNoRetrySucceededFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -1950,16 +4840,21 @@ func (future *LROsPutAsyncNoRetrySucceededFuture) Result(client LROsClient) (p P
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPutAsyncNoRetrySucceededFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.PutAsyncNoRetrySucceededResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPutAsyncNoRetrySucceededFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePutAsyncNoRetrySucceeded to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPutAsyncNoRetrySucceededFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPutAsyncNoRetrySucceededFuture", future.Future)
+}
+
 // LROsPutAsyncRetryFailedFuture an abstraction for monitoring and retrieving the results of a long-running
 // operation.
 type LROsPutAsyncRetryFailedFuture struct {
@@ -1969,8 +4864,36 @@ type LROsPutAsyncRetryFailedFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPutAsyncRetryFailedFuture) Result(client LROsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPutAsyncRetryFailedFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.PutAsyncRetryFailed")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPutAsyncRetryFailedFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPutAsyncRetryFailedFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPutAsyncRetryFailedFuture) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPutAsyncRetryFailedFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -1979,16 +4902,21 @@ func (future *LROsPutAsyncRetryFailedFuture) Result(client LROsClient) (p Produc
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPutAsyncRetryFailedFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.PutAsyncRetryFailedResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPutAsyncRetryFailedFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePutAsyncRetryFailed to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPutAsyncRetryFailedFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPutAsyncRetryFailedFuture", future.Future)
+}
+
 // LROsPutAsyncRetrySucceededFuture an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type LROsPutAsyncRetrySucceededFuture struct {
@@ -1998,8 +4926,36 @@ type LROsPutAsyncRetrySucceededFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPutAsyncRetrySucceededFuture) Result(client LROsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPutAsyncRetrySucceededFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.PutAsyncRetrySucceeded")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPutAsyncRetrySucceededFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPutAsyncRetrySucceededFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPutAsyncRetrySucceededFuture) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPutAsyncRetrySucceededFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -2008,16 +4964,21 @@ func (future *LROsPutAsyncRetrySucceededFuture) Result(client LROsClient) (p Pro
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPutAsyncRetrySucceededFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.PutAsyncRetrySucceededResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPutAsyncRetrySucceededFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePutAsyncRetrySucceeded to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPutAsyncRetrySucceededFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPutAsyncRetrySucceededFuture", future.Future)
+}
+
 // LROsPutAsyncSubResourceFuture an abstraction for monitoring and retrieving the results of a long-running
 // operation.
 type LROsPutAsyncSubResourceFuture struct {
@@ -2027,8 +4988,36 @@ type LROsPutAsyncSubResourceFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPutAsyncSubResourceFuture) Result(client LROsClient) (sp SubProduct, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPutAsyncSubResourceFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (sp SubProduct, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.PutAsyncSubResource")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPutAsyncSubResourceFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPutAsyncSubResourceFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPutAsyncSubResourceFuture) ResultWithContext(ctx context.Context, client LROsClient) (sp SubProduct, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPutAsyncSubResourceFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -2037,16 +5026,21 @@ func (future *LROsPutAsyncSubResourceFuture) Result(client LROsClient) (sp SubPr
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPutAsyncSubResourceFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if sp.Response.Response, err = future.GetResult(sender); err == nil && sp.Response.Response.StatusCode != http.StatusNoContent {
 		sp, err = client.PutAsyncSubResourceResponder(sp.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPutAsyncSubResourceFuture", "Result", sp.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePutAsyncSubResource to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPutAsyncSubResourceFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPutAsyncSubResourceFuture", future.Future)
+}
+
 // LROsPutNoHeaderInRetryFuture an abstraction for monitoring and retrieving the results of a long-running
 // operation.
 type LROsPutNoHeaderInRetryFuture struct {
@@ -2056,8 +5050,36 @@ type LROsPutNoHeaderInRetryFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPutNoHeaderInRetryFuture) Result(client LROsClient) (p Product, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPutNoHeaderInRetryFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (p Product, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.PutNoHeaderInRetry")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPutNoHeaderInRetryFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPutNoHeaderInRetryFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPutNoHeaderInRetryFuture) ResultWithContext(ctx context.Context, client LROsClient) (p Product, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPutNoHeaderInRetryFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -2066,16 +5088,21 @@ func (future *LROsPutNoHeaderInRetryFuture) Result(client LROsClient) (p Product
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPutNoHeaderInRetryFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if p.Response.Response, err = future.GetResult(sender); err == nil && p.Response.Response.StatusCode != http.StatusNoContent {
 		p, err = client.PutNoHeaderInRetryResponder(p.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPutNoHeaderInRetryFuture", "Result", p.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePutNoHeaderInRetry to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPutNoHeaderInRetryFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPutNoHeaderInRetryFuture", future.Future)
+}
+
 // LROsPutNonResourceFuture an abstraction for monitoring and retrieving the results of a long-running
 // operation.
 type LROsPutNonResourceFuture struct {
@@ -2085,8 +5112,36 @@ type LROsPutNonResourceFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPutNonResourceFuture) Result(client LROsClient) (s Sku, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPutNonResourceFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (s Sku, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.PutNonResource")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPutNonResourceFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPutNonResourceFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPutNonResourceFuture) ResultWithContext(ctx context.Context, client LROsClient) (s Sku, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPutNonResourceFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -2095,16 +5150,21 @@ func (future *LROsPutNonResourceFuture) Result(client LROsClient) (s Sku, err er
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPutNonResourceFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if s.Response.Response, err = future.GetResult(sender); err == nil && s.Response.Response.StatusCode != http.StatusNoContent {
 		s, err = client.PutNonResourceResponder(s.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPutNonResourceFuture", "Result", s.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePutNonResource to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPutNonResourceFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPutNonResourceFuture", future.Future)
+}
+
 // LROsPutSubResourceFuture an abstraction for monitoring and retrieving the results of a long-running
 // operation.
 type LROsPutSubResourceFuture struct {
@@ -2114,8 +5174,36 @@ type LROsPutSubResourceFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *LROsPutSubResourceFuture) Result(client LROsClient) (sp SubProduct, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *LROsPutSubResourceFuture) PollUntilDone(ctx context.Context, client LROsClient, opts PollUntilDoneOptions) (sp SubProduct, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "LROsClient.PutSubResource")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/LROsPutSubResourceFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "lrogroup.LROsPutSubResourceFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *LROsPutSubResourceFuture) ResultWithContext(ctx context.Context, client LROsClient) (sp SubProduct, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "lrogroup.LROsPutSubResourceFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -2124,16 +5212,21 @@ func (future *LROsPutSubResourceFuture) Result(client LROsClient) (sp SubProduct
 		err = azure.NewAsyncOpIncompleteError("lrogroup.LROsPutSubResourceFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if sp.Response.Response, err = future.GetResult(sender); err == nil && sp.Response.Response.StatusCode != http.StatusNoContent {
 		sp, err = client.PutSubResourceResponder(sp.Response.Response)
-		if err != nil {
+		if _, ok := err.(ErrorResponse); err != nil && !ok {
 			err = autorest.NewErrorWithError(err, "lrogroup.LROsPutSubResourceFuture", "Result", sp.Response.Response, "Failure responding to request")
 		}
 	}
 	return
 }
 
+// ResumeToken returns a token that can be passed to LROsClient.ResumePutSubResource to rebuild
+// this future, e.g. after a process restart.
+func (future *LROsPutSubResourceFuture) ResumeToken() (string, error) {
+	return newResumeToken("lrogroup.LROsPutSubResourceFuture", future.Future)
+}
+
 // OperationResult ...
 type OperationResult struct {
 	// Status - The status of the request. Possible values include: 'StatusSucceeded', 'StatusFailed', 'StatusCanceled', 'StatusAccepted', 'StatusCreating', 'StatusCreated', 'StatusUpdating', 'StatusUpdated', 'StatusDeleting', 'StatusDeleted', 'StatusOK'
@@ -2141,6 +5234,52 @@ type OperationResult struct {
 	Error  *OperationResultError `json:"error,omitempty"`
 }
 
+// DeepCopy returns a copy of the OperationResult that doesn't share any pointers, slices or maps with it.
+func (or OperationResult) DeepCopy() OperationResult {
+	result := or
+	if or.Error != nil {
+		v := or.Error.DeepCopy()
+		result.Error = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the OperationResult and other are equal.  Times are equal if they represent the
+// same instant.
+func (or OperationResult) Equal(other OperationResult) bool {
+	return or.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (or OperationResult) EqualIgnoreReadOnly(other OperationResult) bool {
+	return or.equal(other, true)
+}
+
+// equal compares the fields of the OperationResult and other, skipping the read-only ones if ignoreReadOnly is true.
+func (or OperationResult) equal(other OperationResult, ignoreReadOnly bool) bool {
+	if or.Status != other.Status {
+		return false
+	}
+	if (or.Error == nil) != (other.Error == nil) || (or.Error != nil && !or.Error.equal(*other.Error, ignoreReadOnly)) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the OperationResult to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (or *OperationResult) MergeFrom(patch OperationResult) {
+	if patch.Status != "" {
+		or.Status = patch.Status
+	}
+	if patch.Error != nil {
+		if or.Error == nil {
+			or.Error = &OperationResultError{}
+		}
+		or.Error.MergeFrom(*patch.Error)
+	}
+}
+
 // OperationResultError ...
 type OperationResultError struct {
 	// Code - The error code for an operation failure
@@ -2149,6 +5288,116 @@ type OperationResultError struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// DeepCopy returns a copy of the OperationResultError that doesn't share any pointers, slices or maps with it.
+func (ore OperationResultError) DeepCopy() OperationResultError {
+	result := ore
+	if ore.Code != nil {
+		v := *ore.Code
+		result.Code = &v
+	}
+	if ore.Message != nil {
+		v := *ore.Message
+		result.Message = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the OperationResultError and other are equal.  Times are equal if they represent
+// the same instant.
+func (ore OperationResultError) Equal(other OperationResultError) bool {
+	return ore.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (ore OperationResultError) EqualIgnoreReadOnly(other OperationResultError) bool {
+	return ore.equal(other, true)
+}
+
+// equal compares the fields of the OperationResultError and other, skipping the read-only ones if ignoreReadOnly is true.
+func (ore OperationResultError) equal(other OperationResultError, ignoreReadOnly bool) bool {
+	if (ore.Code == nil) != (other.Code == nil) || (ore.Code != nil && *ore.Code != *other.Code) {
+		return false
+	}
+	if (ore.Message == nil) != (other.Message == nil) || (ore.Message != nil && *ore.Message != *other.Message) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the OperationResultError to copies of the fields set in patch.  Nested models and maps
+// are merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (ore *OperationResultError) MergeFrom(patch OperationResultError) {
+	if patch.Code != nil {
+		v := *patch.Code
+		ore.Code = &v
+	}
+	if patch.Message != nil {
+		v := *patch.Message
+		ore.Message = &v
+	}
+}
+
+// PollUntilDoneOptions contains the optional parameters for the PollUntilDone methods of the futures.
+type PollUntilDoneOptions struct {
+	// Frequency - the delay between status checks.  If zero, the delay requested by the service or the client's PollingDelay is used.
+	Frequency time.Duration
+	// MaxWait - the maximum amount of time to wait for the operation to complete.  If zero, polling continues until the operation completes or the context is cancelled.
+	MaxWait time.Duration
+	// Progress - if not nil, it's called with the operation's status after each status check.
+	Progress func(status string)
+}
+
+// pollUntilDone polls the specified future with sender until its operation completes, ctx is cancelled or opts.MaxWait
+// elapses.  Polling errors are returned at once as the RetryPolicy of sender already retried the transient ones.
+func pollUntilDone(ctx context.Context, future *azure.Future, client autorest.Client, sender autorest.Sender, opts PollUntilDoneOptions) error {
+	if opts.MaxWait > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.MaxWait)
+		defer cancel()
+	}
+	for {
+		pctx := startChildSpan(ctx, "poll")
+		done, err := future.DoneWithContext(pctx, sender)
+		endSpan(pctx, future.Response(), err)
+		logResponse(ctx, LogEvent{Kind: LogPoll, State: future.Status(), Err: err}, future.Response())
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		if opts.Progress != nil {
+			opts.Progress(future.Status())
+		}
+		if done {
+			return nil
+		}
+		delay := opts.Frequency
+		if delay == 0 {
+			var ok bool
+			if delay, ok = future.GetPollingDelay(); !ok {
+				delay = client.PollingDelay
+			}
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// withRequestContext returns a SendDecorator that associates ctx with outgoing requests.
+func withRequestContext(ctx context.Context) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			return s.Do(r.WithContext(ctx))
+		})
+	}
+}
+
 // Product ...
 type Product struct {
 	autorest.Response  `json:"-"`
@@ -2248,6 +5497,116 @@ func (p *Product) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// DeepCopy returns a copy of the Product that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (p Product) DeepCopy() Product {
+	result := p
+	if p.ProductProperties != nil {
+		v := p.ProductProperties.DeepCopy()
+		result.ProductProperties = &v
+	}
+	if p.ID != nil {
+		v := *p.ID
+		result.ID = &v
+	}
+	if p.Type != nil {
+		v := *p.Type
+		result.Type = &v
+	}
+	if p.Tags != nil {
+		result.Tags = make(map[string]*string, len(p.Tags))
+		for k, e := range p.Tags {
+			if e != nil {
+				v := *e
+				e = &v
+			}
+			result.Tags[k] = e
+		}
+	}
+	if p.Location != nil {
+		v := *p.Location
+		result.Location = &v
+	}
+	if p.Name != nil {
+		v := *p.Name
+		result.Name = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Product and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (p Product) Equal(other Product) bool {
+	return p.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (p Product) EqualIgnoreReadOnly(other Product) bool {
+	return p.equal(other, true)
+}
+
+// equal compares the fields of the Product and other, skipping the read-only ones if ignoreReadOnly is true.
+func (p Product) equal(other Product, ignoreReadOnly bool) bool {
+	if (p.ProductProperties == nil) != (other.ProductProperties == nil) || (p.ProductProperties != nil && !p.ProductProperties.equal(*other.ProductProperties, ignoreReadOnly)) {
+		return false
+	}
+	if !ignoreReadOnly {
+		if (p.ID == nil) != (other.ID == nil) || (p.ID != nil && *p.ID != *other.ID) {
+			return false
+		}
+	}
+	if !ignoreReadOnly {
+		if (p.Type == nil) != (other.Type == nil) || (p.Type != nil && *p.Type != *other.Type) {
+			return false
+		}
+	}
+	if (p.Tags == nil) != (other.Tags == nil) || len(p.Tags) != len(other.Tags) {
+		return false
+	}
+	for k, e := range p.Tags {
+		f, ok := other.Tags[k]
+		if !ok || (e == nil) != (f == nil) || (e != nil && *e != *f) {
+			return false
+		}
+	}
+	if (p.Location == nil) != (other.Location == nil) || (p.Location != nil && *p.Location != *other.Location) {
+		return false
+	}
+	if !ignoreReadOnly {
+		if (p.Name == nil) != (other.Name == nil) || (p.Name != nil && *p.Name != *other.Name) {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Product to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (p *Product) MergeFrom(patch Product) {
+	if patch.ProductProperties != nil {
+		if p.ProductProperties == nil {
+			p.ProductProperties = &ProductProperties{}
+		}
+		p.ProductProperties.MergeFrom(*patch.ProductProperties)
+	}
+	if patch.Tags != nil {
+		if p.Tags == nil {
+			p.Tags = make(map[string]*string, len(patch.Tags))
+		}
+		for k, e := range patch.Tags {
+			if e != nil {
+				v := *e
+				e = &v
+			}
+			p.Tags[k] = e
+		}
+	}
+	if patch.Location != nil {
+		v := *patch.Location
+		p.Location = &v
+	}
+}
+
 // ProductProperties ...
 type ProductProperties struct {
 	ProvisioningState *string `json:"provisioningState,omitempty"`
@@ -2255,6 +5614,129 @@ type ProductProperties struct {
 	ProvisioningStateValues ProvisioningStateValues `json:"provisioningStateValues,omitempty"`
 }
 
+// DeepCopy returns a copy of the ProductProperties that doesn't share any pointers, slices or maps with it.
+func (pp ProductProperties) DeepCopy() ProductProperties {
+	result := pp
+	if pp.ProvisioningState != nil {
+		v := *pp.ProvisioningState
+		result.ProvisioningState = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ProductProperties and other are equal.  Times are equal if they represent the
+// same instant.
+func (pp ProductProperties) Equal(other ProductProperties) bool {
+	return pp.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (pp ProductProperties) EqualIgnoreReadOnly(other ProductProperties) bool {
+	return pp.equal(other, true)
+}
+
+// equal compares the fields of the ProductProperties and other, skipping the read-only ones if ignoreReadOnly is true.
+func (pp ProductProperties) equal(other ProductProperties, ignoreReadOnly bool) bool {
+	if (pp.ProvisioningState == nil) != (other.ProvisioningState == nil) || (pp.ProvisioningState != nil && *pp.ProvisioningState != *other.ProvisioningState) {
+		return false
+	}
+	if !ignoreReadOnly {
+		if pp.ProvisioningStateValues != other.ProvisioningStateValues {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ProductProperties to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (pp *ProductProperties) MergeFrom(patch ProductProperties) {
+	if patch.ProvisioningState != nil {
+		v := *patch.ProvisioningState
+		pp.ProvisioningState = &v
+	}
+}
+
+// RequestOptions contains the per-call options applied to the requests of operations whose context was created with WithRequestOptions.
+type RequestOptions struct {
+	// Header - additional headers to send.  They replace any values of the same headers set by the operation.
+	Header http.Header
+	// Query - additional query parameters to send.  They replace any values of the same parameters set by the operation.
+	Query url.Values
+	// Timeout - if greater than zero, the maximum amount of time allowed for sending the request, including retries, and reading its response.
+	Timeout time.Duration
+}
+
+type requestOptionsKey struct{}
+
+// WithRequestOptions returns a copy of ctx carrying opts.  Operations called with the returned
+// context apply opts to their requests; other calls aren't affected.
+func WithRequestOptions(ctx context.Context, opts RequestOptions) context.Context {
+	return context.WithValue(ctx, requestOptionsKey{}, opts)
+}
+
+// withRequestOptions returns a PrepareDecorator that merges the headers and query parameters
+// of the RequestOptions carried by the request's context into the request.
+func withRequestOptions() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+			opts, ok := r.Context().Value(requestOptionsKey{}).(RequestOptions)
+			if !ok {
+				return r, nil
+			}
+			if len(opts.Header) > 0 {
+				if r.Header == nil {
+					r.Header = make(http.Header)
+				}
+				for k, v := range opts.Header {
+					r.Header[http.CanonicalHeaderKey(k)] = v
+				}
+			}
+			if len(opts.Query) > 0 {
+				q := r.URL.Query()
+				for k, v := range opts.Query {
+					q[k] = v
+				}
+				r.URL.RawQuery = q.Encode()
+			}
+			return r, nil
+		})
+	}
+}
+
+// sendWithRequestOptions sends the request like autorest.SendWithSender.  If the request's context
+// carries a RequestOptions with a Timeout, the request is cancelled once the timeout elapses
+// or the response body is closed, whichever happens first.
+func sendWithRequestOptions(s autorest.Sender, r *http.Request, decorators ...autorest.SendDecorator) (*http.Response, error) {
+	opts, ok := r.Context().Value(requestOptionsKey{}).(RequestOptions)
+	if !ok || opts.Timeout <= 0 {
+		return autorest.SendWithSender(s, r, decorators...)
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), opts.Timeout)
+	resp, err := autorest.SendWithSender(s, r.WithContext(ctx), decorators...)
+	if err != nil || resp == nil || resp.Body == nil {
+		cancel()
+		return resp, err
+	}
+	resp.Body = requestOptionsBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// requestOptionsBody releases the timeout of a request once its response body is closed.
+type requestOptionsBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b requestOptionsBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
 // Resource ...
 type Resource struct {
 	// ID - READ-ONLY; Resource Id
@@ -2280,6 +5762,102 @@ func (r Resource) MarshalJSON() ([]byte, error) {
 	return json.Marshal(objectMap)
 }
 
+// DeepCopy returns a copy of the Resource that doesn't share any pointers, slices or maps with it.
+func (r Resource) DeepCopy() Resource {
+	result := r
+	if r.ID != nil {
+		v := *r.ID
+		result.ID = &v
+	}
+	if r.Type != nil {
+		v := *r.Type
+		result.Type = &v
+	}
+	if r.Tags != nil {
+		result.Tags = make(map[string]*string, len(r.Tags))
+		for k, e := range r.Tags {
+			if e != nil {
+				v := *e
+				e = &v
+			}
+			result.Tags[k] = e
+		}
+	}
+	if r.Location != nil {
+		v := *r.Location
+		result.Location = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		result.Name = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Resource and other are equal.  Times are equal if they represent the same
+// instant.
+func (r Resource) Equal(other Resource) bool {
+	return r.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (r Resource) EqualIgnoreReadOnly(other Resource) bool {
+	return r.equal(other, true)
+}
+
+// equal compares the fields of the Resource and other, skipping the read-only ones if ignoreReadOnly is true.
+func (r Resource) equal(other Resource, ignoreReadOnly bool) bool {
+	if !ignoreReadOnly {
+		if (r.ID == nil) != (other.ID == nil) || (r.ID != nil && *r.ID != *other.ID) {
+			return false
+		}
+	}
+	if !ignoreReadOnly {
+		if (r.Type == nil) != (other.Type == nil) || (r.Type != nil && *r.Type != *other.Type) {
+			return false
+		}
+	}
+	if (r.Tags == nil) != (other.Tags == nil) || len(r.Tags) != len(other.Tags) {
+		return false
+	}
+	for k, e := range r.Tags {
+		f, ok := other.Tags[k]
+		if !ok || (e == nil) != (f == nil) || (e != nil && *e != *f) {
+			return false
+		}
+	}
+	if (r.Location == nil) != (other.Location == nil) || (r.Location != nil && *r.Location != *other.Location) {
+		return false
+	}
+	if !ignoreReadOnly {
+		if (r.Name == nil) != (other.Name == nil) || (r.Name != nil && *r.Name != *other.Name) {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Resource to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields aren't changed.
+func (r *Resource) MergeFrom(patch Resource) {
+	if patch.Tags != nil {
+		if r.Tags == nil {
+			r.Tags = make(map[string]*string, len(patch.Tags))
+		}
+		for k, e := range patch.Tags {
+			if e != nil {
+				v := *e
+				e = &v
+			}
+			r.Tags[k] = e
+		}
+	}
+	if patch.Location != nil {
+		v := *patch.Location
+		r.Location = &v
+	}
+}
+
 // Sku ...
 type Sku struct {
 	autorest.Response `json:"-"`
@@ -2287,6 +5865,56 @@ type Sku struct {
 	ID                *string `json:"id,omitempty"`
 }
 
+// DeepCopy returns a copy of the Sku that doesn't share any pointers, slices or maps with it.  Its autorest.Response is
+// copied as is.
+func (s Sku) DeepCopy() Sku {
+	result := s
+	if s.Name != nil {
+		v := *s.Name
+		result.Name = &v
+	}
+	if s.ID != nil {
+		v := *s.ID
+		result.ID = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Sku and other are equal.  Times are equal if they represent the same instant.
+// Their autorest.Response isn't compared.
+func (s Sku) Equal(other Sku) bool {
+	return s.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (s Sku) EqualIgnoreReadOnly(other Sku) bool {
+	return s.equal(other, true)
+}
+
+// equal compares the fields of the Sku and other, skipping the read-only ones if ignoreReadOnly is true.
+func (s Sku) equal(other Sku, ignoreReadOnly bool) bool {
+	if (s.Name == nil) != (other.Name == nil) || (s.Name != nil && *s.Name != *other.Name) {
+		return false
+	}
+	if (s.ID == nil) != (other.ID == nil) || (s.ID != nil && *s.ID != *other.ID) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Sku to copies of the fields set in patch.  Nested models and maps are merged, the
+// values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (s *Sku) MergeFrom(patch Sku) {
+	if patch.Name != nil {
+		v := *patch.Name
+		s.Name = &v
+	}
+	if patch.ID != nil {
+		v := *patch.ID
+		s.ID = &v
+	}
+}
+
 // SubProduct ...
 type SubProduct struct {
 	autorest.Response     `json:"-"`
@@ -2337,6 +5965,56 @@ func (sp *SubProduct) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// DeepCopy returns a copy of the SubProduct that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (sp SubProduct) DeepCopy() SubProduct {
+	result := sp
+	if sp.SubProductProperties != nil {
+		v := sp.SubProductProperties.DeepCopy()
+		result.SubProductProperties = &v
+	}
+	if sp.ID != nil {
+		v := *sp.ID
+		result.ID = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the SubProduct and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (sp SubProduct) Equal(other SubProduct) bool {
+	return sp.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (sp SubProduct) EqualIgnoreReadOnly(other SubProduct) bool {
+	return sp.equal(other, true)
+}
+
+// equal compares the fields of the SubProduct and other, skipping the read-only ones if ignoreReadOnly is true.
+func (sp SubProduct) equal(other SubProduct, ignoreReadOnly bool) bool {
+	if (sp.SubProductProperties == nil) != (other.SubProductProperties == nil) || (sp.SubProductProperties != nil && !sp.SubProductProperties.equal(*other.SubProductProperties, ignoreReadOnly)) {
+		return false
+	}
+	if !ignoreReadOnly {
+		if (sp.ID == nil) != (other.ID == nil) || (sp.ID != nil && *sp.ID != *other.ID) {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the SubProduct to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (sp *SubProduct) MergeFrom(patch SubProduct) {
+	if patch.SubProductProperties != nil {
+		if sp.SubProductProperties == nil {
+			sp.SubProductProperties = &SubProductProperties{}
+		}
+		sp.SubProductProperties.MergeFrom(*patch.SubProductProperties)
+	}
+}
+
 // SubProductProperties ...
 type SubProductProperties struct {
 	ProvisioningState *string `json:"provisioningState,omitempty"`
@@ -2344,8 +6022,87 @@ type SubProductProperties struct {
 	ProvisioningStateValues ProvisioningStateValues1 `json:"provisioningStateValues,omitempty"`
 }
 
+// DeepCopy returns a copy of the SubProductProperties that doesn't share any pointers, slices or maps with it.
+func (spp SubProductProperties) DeepCopy() SubProductProperties {
+	result := spp
+	if spp.ProvisioningState != nil {
+		v := *spp.ProvisioningState
+		result.ProvisioningState = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the SubProductProperties and other are equal.  Times are equal if they represent
+// the same instant.
+func (spp SubProductProperties) Equal(other SubProductProperties) bool {
+	return spp.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (spp SubProductProperties) EqualIgnoreReadOnly(other SubProductProperties) bool {
+	return spp.equal(other, true)
+}
+
+// equal compares the fields of the SubProductProperties and other, skipping the read-only ones if ignoreReadOnly is true.
+func (spp SubProductProperties) equal(other SubProductProperties, ignoreReadOnly bool) bool {
+	if (spp.ProvisioningState == nil) != (other.ProvisioningState == nil) || (spp.ProvisioningState != nil && *spp.ProvisioningState != *other.ProvisioningState) {
+		return false
+	}
+	if !ignoreReadOnly {
+		if spp.ProvisioningStateValues != other.ProvisioningStateValues {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the SubProductProperties to copies of the fields set in patch.  Nested models and maps
+// are merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (spp *SubProductProperties) MergeFrom(patch SubProductProperties) {
+	if patch.ProvisioningState != nil {
+		v := *patch.ProvisioningState
+		spp.ProvisioningState = &v
+	}
+}
+
 // SubResource ...
 type SubResource struct {
 	// ID - READ-ONLY; Sub Resource Id
 	ID *string `json:"id,omitempty"`
 }
+
+// DeepCopy returns a copy of the SubResource that doesn't share any pointers, slices or maps with it.
+func (sr SubResource) DeepCopy() SubResource {
+	result := sr
+	if sr.ID != nil {
+		v := *sr.ID
+		result.ID = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the SubResource and other are equal.  Times are equal if they represent the same
+// instant.
+func (sr SubResource) Equal(other SubResource) bool {
+	return sr.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (sr SubResource) EqualIgnoreReadOnly(other SubResource) bool {
+	return sr.equal(other, true)
+}
+
+// equal compares the fields of the SubResource and other, skipping the read-only ones if ignoreReadOnly is true.
+func (sr SubResource) equal(other SubResource, ignoreReadOnly bool) bool {
+	if !ignoreReadOnly {
+		if (sr.ID == nil) != (other.ID == nil) || (sr.ID != nil && *sr.ID != *other.ID) {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the SubResource to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (sr *SubResource) MergeFrom(patch SubResource) {
+}
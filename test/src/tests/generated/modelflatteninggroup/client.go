@@ -11,11 +11,15 @@ package modelflatteninggroup
 
 import (
 	"context"
+	"crypto/rand"
+	"fmt"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/validation"
 	"github.com/Azure/go-autorest/tracing"
 	"net/http"
+	"net/url"
+	"sync"
+	"time"
 )
 
 const (
@@ -26,7 +30,11 @@ const (
 // BaseClient is the base client for Modelflatteninggroup.
 type BaseClient struct {
 	autorest.Client
-	BaseURI string
+	BaseURI     string
+	RetryPolicy RetryPolicy
+	Pipeline    []PipelinePolicy
+	Logger      Logger
+	Tracer      Tracer
 }
 
 // New creates an instance of the BaseClient client.
@@ -42,16 +50,287 @@ func NewWithBaseURI(baseURI string) BaseClient {
 	}
 }
 
+// ClientOptions contains the options used to create a BaseClient with NewWithOptions.
+type ClientOptions struct {
+	// BaseURI - the endpoint of the service.  Defaults to DefaultBaseURI.
+	BaseURI string
+	// Authorizer - authorizes the requests sent by the client.  Requests aren't authorized if it's nil.
+	Authorizer autorest.Authorizer
+	// RetryAttempts - the number of times a request is retried after a retriable failure.  Zero uses autorest.DefaultRetryAttempts
+	// and a negative value disables retries.
+	RetryAttempts int
+	// RetryDuration - the delay between retries.  Zero uses autorest.DefaultRetryDuration.
+	RetryDuration time.Duration
+	// RetryPolicy - decides if and when failed requests are retried.  If nil, an ExponentialRetry created from
+	// RetryAttempts and RetryDuration is used.
+	RetryPolicy RetryPolicy
+	// HTTPClient - sends the requests, e.g. an *http.Client.  Defaults to the sender created by autorest.
+	HTTPClient autorest.Sender
+	// Pipeline - the policies the requests go through, in order, before being sent.
+	Pipeline []PipelinePolicy
+	// Logger - receives the events logged by the operations.  Nothing is logged if it's nil.
+	Logger Logger
+	// Tracer - starts the spans tracing the operations.  If nil, the Tracer passed to RegisterTracer is used.  If
+	// that's nil too, the operations are traced by github.com/Azure/go-autorest/tracing when it's enabled.
+	Tracer Tracer
+	// UserAgentSuffix - appended to the User-Agent header of the requests.
+	UserAgentSuffix string
+}
+
+// NewWithOptions creates an instance of the BaseClient client with the specified options.  It returns an error if the
+// options are invalid.
+func NewWithOptions(opts ClientOptions) (BaseClient, error) {
+	if opts.BaseURI == "" {
+		opts.BaseURI = DefaultBaseURI
+	} else if u, err := url.Parse(opts.BaseURI); err != nil || !u.IsAbs() || u.Host == "" {
+		return BaseClient{}, validation.NewError("modelflatteninggroup.BaseClient", "NewWithOptions", "BaseURI %q isn't an absolute URI", opts.BaseURI)
+	}
+	if opts.RetryDuration < 0 {
+		return BaseClient{}, validation.NewError("modelflatteninggroup.BaseClient", "NewWithOptions", "RetryDuration can't be negative")
+	}
+	client := BaseClient{
+		Client:  autorest.NewClientWithUserAgent(UserAgent()),
+		BaseURI: opts.BaseURI,
+	}
+	if opts.Authorizer != nil {
+		client.Authorizer = opts.Authorizer
+	}
+	if opts.RetryAttempts > 0 {
+		client.RetryAttempts = opts.RetryAttempts
+	} else if opts.RetryAttempts < 0 {
+		client.RetryAttempts = 0
+	}
+	if opts.RetryDuration > 0 {
+		client.RetryDuration = opts.RetryDuration
+	}
+	client.RetryPolicy = opts.RetryPolicy
+	if opts.HTTPClient != nil {
+		client.Sender = opts.HTTPClient
+	}
+	client.Pipeline = opts.Pipeline
+	client.Logger = opts.Logger
+	client.Tracer = opts.Tracer
+	if opts.UserAgentSuffix != "" {
+		client.AddToUserAgent(opts.UserAgentSuffix)
+	}
+	return client, nil
+}
+
+// PipelinePolicy is a step of the pipeline the requests sent by a client go through.  It can modify the request, pass it
+// to next to send it, and inspect or replace the response.  The policies run for each attempt to send a request.
+type PipelinePolicy func(req *http.Request, next autorest.Sender) (*http.Response, error)
+
+// pipeline returns a Sender that runs requests through the Pipeline of the client, in order, before sending them.
+func (client BaseClient) pipeline() autorest.Sender {
+	var s autorest.Sender = client.Client
+	for i := len(client.Pipeline) - 1; i >= 0; i-- {
+		policy, next := client.Pipeline[i], s
+		s = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			return policy(r, next)
+		})
+	}
+	return s
+}
+
+// NewTelemetryPolicy returns a PipelinePolicy that passes each request, its outcome and the time it took to record, e.g.
+// to update metrics.
+func NewTelemetryPolicy(record func(req *http.Request, resp *http.Response, err error, elapsed time.Duration)) PipelinePolicy {
+	return func(req *http.Request, next autorest.Sender) (*http.Response, error) {
+		start := time.Now()
+		resp, err := next.Do(req)
+		record(req, resp, err, time.Since(start))
+		return resp, err
+	}
+}
+
+// NewLoggingPolicy returns a PipelinePolicy that passes a line describing each request and its outcome to logf, e.g.
+// log.Printf.  The user information and the query of the URL aren't logged as they can contain secrets.
+func NewLoggingPolicy(logf func(format string, v ...interface{})) PipelinePolicy {
+	return NewTelemetryPolicy(func(req *http.Request, resp *http.Response, err error, elapsed time.Duration) {
+		u := *req.URL
+		u.User = nil
+		u.RawQuery = ""
+		if err != nil {
+			logf("%s %s failed after %v: %v", req.Method, u.String(), elapsed, err)
+		} else {
+			logf("%s %s returned %d after %v", req.Method, u.String(), resp.StatusCode, elapsed)
+		}
+	})
+}
+
+// NewRequestIDPolicy returns a PipelinePolicy that stamps each request with a unique ID in the specified header, e.g.
+// x-ms-client-request-id, unless the request already has one.  Retries of a request send the same ID.
+func NewRequestIDPolicy(header string) PipelinePolicy {
+	return func(req *http.Request, next autorest.Sender) (*http.Response, error) {
+		if req.Header.Get(header) == "" {
+			if req.Header == nil {
+				req.Header = http.Header{}
+			}
+			var id [16]byte
+			if _, err := rand.Read(id[:]); err != nil {
+				return nil, err
+			}
+			// format the ID as a version 4 UUID
+			id[6] = id[6]&0x0f | 0x40
+			id[8] = id[8]&0x3f | 0x80
+			req.Header.Set(header, fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:]))
+		}
+		return next.Do(req)
+	}
+}
+
+// Attributes set on the spans of operations.
+const (
+	// AttributeHTTPMethod is the HTTP method of the operation.
+	AttributeHTTPMethod = "http.method"
+	// AttributeURLTemplate is the template of the URL path of the operation.
+	AttributeURLTemplate = "http.url_template"
+	// AttributeStatusCode is the status code of the last response received.
+	AttributeStatusCode = "http.status_code"
+	// AttributeRetryCount is the number of times the request was retried.
+	AttributeRetryCount = "http.retry_count"
+)
+
+// Tracer starts the spans tracing the operations of clients, e.g. an adapter to OpenTelemetry.
+type Tracer interface {
+	// StartSpan starts a span named name as a child of the span in ctx, if any, and returns a context containing the
+	// new span.
+	StartSpan(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a span started by a Tracer.
+type Span interface {
+	// SetAttribute sets the value of an attribute of the span.
+	SetAttribute(key string, value interface{})
+	// End ends the span.  err is the error the traced call failed with, if any.
+	End(err error)
+}
+
+// registeredTracer is the Tracer used by the clients whose Tracer is nil, guarded by registeredTracerMu.
+var (
+	registeredTracerMu sync.RWMutex
+	registeredTracer   Tracer
+)
+
+// RegisterTracer sets the Tracer used by the clients whose Tracer is nil.  Pass nil to trace them with
+// github.com/Azure/go-autorest/tracing, which only traces them when it's enabled.  It's safe to call RegisterTracer
+// while operations are running: the operations started after it returns use tracer.
+func RegisterTracer(tracer Tracer) {
+	registeredTracerMu.Lock()
+	defer registeredTracerMu.Unlock()
+	registeredTracer = tracer
+}
+
+// tracer returns the Tracer of the client, or the registered one if it's nil.  If neither is set it returns an
+// adapter to github.com/Azure/go-autorest/tracing when it's enabled, and nil otherwise.
+func (client BaseClient) tracer() Tracer {
+	if client.Tracer != nil {
+		return client.Tracer
+	}
+	registeredTracerMu.RLock()
+	tracer := registeredTracer
+	registeredTracerMu.RUnlock()
+	if tracer != nil {
+		return tracer
+	}
+	if tracing.IsEnabled() {
+		return autorestTracer{}
+	}
+	return nil
+}
+
+// autorestTracer is a Tracer starting the OpenCensus spans of github.com/Azure/go-autorest/tracing.
+type autorestTracer struct{}
+
+// StartSpan implements the Tracer interface for autorestTracer.
+func (autorestTracer) StartSpan(ctx context.Context, name string) (context.Context, Span) {
+	ctx = tracing.StartSpan(ctx, name)
+	return ctx, &autorestSpan{ctx: ctx, statusCode: -1}
+}
+
+// autorestSpan is a span started by autorestTracer.  go-autorest/tracing only records the status code of a span, the
+// other attributes are ignored.
+type autorestSpan struct {
+	ctx        context.Context
+	statusCode int
+}
+
+// SetAttribute implements the Span interface for autorestSpan.
+func (as *autorestSpan) SetAttribute(key string, value interface{}) {
+	if sc, ok := value.(int); ok && key == AttributeStatusCode {
+		as.statusCode = sc
+	}
+}
+
+// End implements the Span interface for autorestSpan.
+func (as *autorestSpan) End(err error) {
+	tracing.EndSpan(as.ctx, as.statusCode, err)
+}
+
+// traceSpan is a span started by startSpan, carried by the contexts of the requests of the traced call.
+type traceSpan struct {
+	tracer Tracer
+	name   string
+	span   Span
+}
+
+// traceSpanKey is the key of the traceSpan in a context.
+type traceSpanKey struct{}
+
+// startSpan starts a span named name with tracer and returns a context carrying it.  The HTTP method and the URL
+// template of the operation are set on the span unless they're empty.
+func startSpan(ctx context.Context, tracer Tracer, name, method, urlTemplate string) context.Context {
+	ctx, span := tracer.StartSpan(ctx, name)
+	if method != "" {
+		span.SetAttribute(AttributeHTTPMethod, method)
+	}
+	if urlTemplate != "" {
+		span.SetAttribute(AttributeURLTemplate, urlTemplate)
+	}
+	return context.WithValue(ctx, traceSpanKey{}, &traceSpan{tracer: tracer, name: name, span: span})
+}
+
+// startChildSpan starts a child of the span carried by ctx, named after it with suffix appended, and returns a context
+// carrying it.  It returns ctx if it doesn't carry a span.
+func startChildSpan(ctx context.Context, suffix string) context.Context {
+	ts, ok := ctx.Value(traceSpanKey{}).(*traceSpan)
+	if !ok {
+		return ctx
+	}
+	return startSpan(ctx, ts.tracer, ts.name+"/"+suffix, "", "")
+}
+
+// setSpanAttribute sets an attribute of the span carried by ctx, if any.
+func setSpanAttribute(ctx context.Context, key string, value interface{}) {
+	if ts, ok := ctx.Value(traceSpanKey{}).(*traceSpan); ok {
+		ts.span.SetAttribute(key, value)
+	}
+}
+
+// endSpan ends the span carried by ctx, if any, setting the status code of resp on it if resp isn't nil.
+func endSpan(ctx context.Context, resp *http.Response, err error) {
+	ts, ok := ctx.Value(traceSpanKey{}).(*traceSpan)
+	if !ok {
+		return
+	}
+	if resp != nil {
+		ts.span.SetAttribute(AttributeStatusCode, resp.StatusCode)
+	}
+	ts.span.End(err)
+}
+
 // GetArray get External Resource as an Array
 func (client BaseClient) GetArray(ctx context.Context) (result ListFlattenedProduct, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.GetArray")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BaseClient.GetArray", "GET", "/model-flatten/array")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BaseClient.GetArray", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetArrayPreparer(ctx)
@@ -68,7 +347,7 @@ func (client BaseClient) GetArray(ctx context.Context) (result ListFlattenedProd
 	}
 
 	result, err = client.GetArrayResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "modelflatteninggroup.BaseClient", "GetArray", resp, "Failure responding to request")
 	}
 
@@ -81,14 +360,15 @@ func (client BaseClient) GetArrayPreparer(ctx context.Context) (*http.Request, e
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/model-flatten/array"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetArraySender sends the GetArray request. The method will close the
 // http.Response Body if it receives an error.
 func (client BaseClient) GetArraySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetArrayResponder handles the response to the GetArray request. The method always
@@ -97,7 +377,7 @@ func (client BaseClient) GetArrayResponder(resp *http.Response) (result ListFlat
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -106,14 +386,16 @@ func (client BaseClient) GetArrayResponder(resp *http.Response) (result ListFlat
 
 // GetDictionary get External Resource as a Dictionary
 func (client BaseClient) GetDictionary(ctx context.Context) (result SetFlattenedProduct, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.GetDictionary")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BaseClient.GetDictionary", "GET", "/model-flatten/dictionary")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BaseClient.GetDictionary", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetDictionaryPreparer(ctx)
@@ -130,7 +412,7 @@ func (client BaseClient) GetDictionary(ctx context.Context) (result SetFlattened
 	}
 
 	result, err = client.GetDictionaryResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "modelflatteninggroup.BaseClient", "GetDictionary", resp, "Failure responding to request")
 	}
 
@@ -143,14 +425,15 @@ func (client BaseClient) GetDictionaryPreparer(ctx context.Context) (*http.Reque
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/model-flatten/dictionary"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetDictionarySender sends the GetDictionary request. The method will close the
 // http.Response Body if it receives an error.
 func (client BaseClient) GetDictionarySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetDictionaryResponder handles the response to the GetDictionary request. The method always
@@ -159,7 +442,7 @@ func (client BaseClient) GetDictionaryResponder(resp *http.Response) (result Set
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -168,14 +451,16 @@ func (client BaseClient) GetDictionaryResponder(resp *http.Response) (result Set
 
 // GetResourceCollection get External Resource as a ResourceCollection
 func (client BaseClient) GetResourceCollection(ctx context.Context) (result ResourceCollection, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.GetResourceCollection")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BaseClient.GetResourceCollection", "GET", "/model-flatten/resourcecollection")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BaseClient.GetResourceCollection", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetResourceCollectionPreparer(ctx)
@@ -192,7 +477,7 @@ func (client BaseClient) GetResourceCollection(ctx context.Context) (result Reso
 	}
 
 	result, err = client.GetResourceCollectionResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "modelflatteninggroup.BaseClient", "GetResourceCollection", resp, "Failure responding to request")
 	}
 
@@ -205,14 +490,15 @@ func (client BaseClient) GetResourceCollectionPreparer(ctx context.Context) (*ht
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/model-flatten/resourcecollection"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetResourceCollectionSender sends the GetResourceCollection request. The method will close the
 // http.Response Body if it receives an error.
 func (client BaseClient) GetResourceCollectionSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetResourceCollectionResponder handles the response to the GetResourceCollection request. The method always
@@ -221,7 +507,7 @@ func (client BaseClient) GetResourceCollectionResponder(resp *http.Response) (re
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -231,14 +517,16 @@ func (client BaseClient) GetResourceCollectionResponder(resp *http.Response) (re
 // GetWrappedArray no need to have a route in Express server for this operation. Used to verify the type flattened is
 // not removed if it's referenced in an array
 func (client BaseClient) GetWrappedArray(ctx context.Context) (result ListProductWrapper, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.GetWrappedArray")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BaseClient.GetWrappedArray", "GET", "/model-flatten/wrappedarray")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BaseClient.GetWrappedArray", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetWrappedArrayPreparer(ctx)
@@ -255,7 +543,7 @@ func (client BaseClient) GetWrappedArray(ctx context.Context) (result ListProduc
 	}
 
 	result, err = client.GetWrappedArrayResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "modelflatteninggroup.BaseClient", "GetWrappedArray", resp, "Failure responding to request")
 	}
 
@@ -268,14 +556,15 @@ func (client BaseClient) GetWrappedArrayPreparer(ctx context.Context) (*http.Req
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/model-flatten/wrappedarray"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetWrappedArraySender sends the GetWrappedArray request. The method will close the
 // http.Response Body if it receives an error.
 func (client BaseClient) GetWrappedArraySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetWrappedArrayResponder handles the response to the GetWrappedArray request. The method always
@@ -284,7 +573,7 @@ func (client BaseClient) GetWrappedArrayResponder(resp *http.Response) (result L
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -295,25 +584,24 @@ func (client BaseClient) GetWrappedArrayResponder(resp *http.Response) (result L
 // Parameters:
 // simpleBodyProduct - simple body product to post
 func (client BaseClient) PostFlattenedSimpleProduct(ctx context.Context, simpleBodyProduct *SimpleProduct) (result SimpleProduct, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.PostFlattenedSimpleProduct")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BaseClient.PostFlattenedSimpleProduct", "POST", "/model-flatten/customFlattening")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BaseClient.PostFlattenedSimpleProduct", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: simpleBodyProduct,
-			Constraints: []validation.Constraint{{Target: "simpleBodyProduct", Name: validation.Null, Rule: false,
-				Chain: []validation.Constraint{{Target: "simpleBodyProduct.SimpleProductProperties", Name: validation.Null, Rule: false,
-					Chain: []validation.Constraint{{Target: "simpleBodyProduct.SimpleProductProperties.MaxProductDisplayName", Name: validation.Null, Rule: true, Chain: nil},
-						{Target: "simpleBodyProduct.SimpleProductProperties.Capacity", Name: validation.Null, Rule: true, Chain: nil},
-					}},
-				}}}}}); err != nil {
-		return result, validation.NewError("modelflatteninggroup.BaseClient", "PostFlattenedSimpleProduct", err.Error())
+	var errs ValidationErrors
+	if simpleBodyProduct != nil {
+		errs = errs.addNested("simpleBodyProduct", simpleBodyProduct.Validate())
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("modelflatteninggroup.BaseClient", "PostFlattenedSimpleProduct", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PostFlattenedSimpleProductPreparer(ctx, simpleBodyProduct)
@@ -330,7 +618,7 @@ func (client BaseClient) PostFlattenedSimpleProduct(ctx context.Context, simpleB
 	}
 
 	result, err = client.PostFlattenedSimpleProductResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "modelflatteninggroup.BaseClient", "PostFlattenedSimpleProduct", resp, "Failure responding to request")
 	}
 
@@ -348,14 +636,15 @@ func (client BaseClient) PostFlattenedSimpleProductPreparer(ctx context.Context,
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(simpleBodyProduct))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostFlattenedSimpleProductSender sends the PostFlattenedSimpleProduct request. The method will close the
 // http.Response Body if it receives an error.
 func (client BaseClient) PostFlattenedSimpleProductSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostFlattenedSimpleProductResponder handles the response to the PostFlattenedSimpleProduct request. The method always
@@ -364,7 +653,7 @@ func (client BaseClient) PostFlattenedSimpleProductResponder(resp *http.Response
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -375,14 +664,16 @@ func (client BaseClient) PostFlattenedSimpleProductResponder(resp *http.Response
 // Parameters:
 // resourceArray - external Resource as an Array to put
 func (client BaseClient) PutArray(ctx context.Context, resourceArray []Resource) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.PutArray")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BaseClient.PutArray", "PUT", "/model-flatten/array")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BaseClient.PutArray", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutArrayPreparer(ctx, resourceArray)
@@ -399,7 +690,7 @@ func (client BaseClient) PutArray(ctx context.Context, resourceArray []Resource)
 	}
 
 	result, err = client.PutArrayResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "modelflatteninggroup.BaseClient", "PutArray", resp, "Failure responding to request")
 	}
 
@@ -417,14 +708,15 @@ func (client BaseClient) PutArrayPreparer(ctx context.Context, resourceArray []R
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(resourceArray))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutArraySender sends the PutArray request. The method will close the
 // http.Response Body if it receives an error.
 func (client BaseClient) PutArraySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutArrayResponder handles the response to the PutArray request. The method always
@@ -433,7 +725,7 @@ func (client BaseClient) PutArrayResponder(resp *http.Response) (result autorest
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -443,14 +735,16 @@ func (client BaseClient) PutArrayResponder(resp *http.Response) (result autorest
 // Parameters:
 // resourceDictionary - external Resource as a Dictionary to put
 func (client BaseClient) PutDictionary(ctx context.Context, resourceDictionary map[string]*FlattenedProduct) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.PutDictionary")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BaseClient.PutDictionary", "PUT", "/model-flatten/dictionary")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BaseClient.PutDictionary", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutDictionaryPreparer(ctx, resourceDictionary)
@@ -467,7 +761,7 @@ func (client BaseClient) PutDictionary(ctx context.Context, resourceDictionary m
 	}
 
 	result, err = client.PutDictionaryResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "modelflatteninggroup.BaseClient", "PutDictionary", resp, "Failure responding to request")
 	}
 
@@ -485,14 +779,15 @@ func (client BaseClient) PutDictionaryPreparer(ctx context.Context, resourceDict
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(resourceDictionary))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutDictionarySender sends the PutDictionary request. The method will close the
 // http.Response Body if it receives an error.
 func (client BaseClient) PutDictionarySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutDictionaryResponder handles the response to the PutDictionary request. The method always
@@ -501,7 +796,7 @@ func (client BaseClient) PutDictionaryResponder(resp *http.Response) (result aut
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -511,14 +806,16 @@ func (client BaseClient) PutDictionaryResponder(resp *http.Response) (result aut
 // Parameters:
 // resourceComplexObject - external Resource as a ResourceCollection to put
 func (client BaseClient) PutResourceCollection(ctx context.Context, resourceComplexObject *ResourceCollection) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.PutResourceCollection")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BaseClient.PutResourceCollection", "PUT", "/model-flatten/resourcecollection")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BaseClient.PutResourceCollection", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutResourceCollectionPreparer(ctx, resourceComplexObject)
@@ -535,7 +832,7 @@ func (client BaseClient) PutResourceCollection(ctx context.Context, resourceComp
 	}
 
 	result, err = client.PutResourceCollectionResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "modelflatteninggroup.BaseClient", "PutResourceCollection", resp, "Failure responding to request")
 	}
 
@@ -553,14 +850,15 @@ func (client BaseClient) PutResourceCollectionPreparer(ctx context.Context, reso
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(resourceComplexObject))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutResourceCollectionSender sends the PutResourceCollection request. The method will close the
 // http.Response Body if it receives an error.
 func (client BaseClient) PutResourceCollectionSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutResourceCollectionResponder handles the response to the PutResourceCollection request. The method always
@@ -569,7 +867,7 @@ func (client BaseClient) PutResourceCollectionResponder(resp *http.Response) (re
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -579,25 +877,24 @@ func (client BaseClient) PutResourceCollectionResponder(resp *http.Response) (re
 // Parameters:
 // simpleBodyProduct - simple body product to put
 func (client BaseClient) PutSimpleProduct(ctx context.Context, simpleBodyProduct *SimpleProduct) (result SimpleProduct, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.PutSimpleProduct")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BaseClient.PutSimpleProduct", "PUT", "/model-flatten/customFlattening")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BaseClient.PutSimpleProduct", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: simpleBodyProduct,
-			Constraints: []validation.Constraint{{Target: "simpleBodyProduct", Name: validation.Null, Rule: false,
-				Chain: []validation.Constraint{{Target: "simpleBodyProduct.SimpleProductProperties", Name: validation.Null, Rule: false,
-					Chain: []validation.Constraint{{Target: "simpleBodyProduct.SimpleProductProperties.MaxProductDisplayName", Name: validation.Null, Rule: true, Chain: nil},
-						{Target: "simpleBodyProduct.SimpleProductProperties.Capacity", Name: validation.Null, Rule: true, Chain: nil},
-					}},
-				}}}}}); err != nil {
-		return result, validation.NewError("modelflatteninggroup.BaseClient", "PutSimpleProduct", err.Error())
+	var errs ValidationErrors
+	if simpleBodyProduct != nil {
+		errs = errs.addNested("simpleBodyProduct", simpleBodyProduct.Validate())
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("modelflatteninggroup.BaseClient", "PutSimpleProduct", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutSimpleProductPreparer(ctx, simpleBodyProduct)
@@ -614,7 +911,7 @@ func (client BaseClient) PutSimpleProduct(ctx context.Context, simpleBodyProduct
 	}
 
 	result, err = client.PutSimpleProductResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "modelflatteninggroup.BaseClient", "PutSimpleProduct", resp, "Failure responding to request")
 	}
 
@@ -632,14 +929,15 @@ func (client BaseClient) PutSimpleProductPreparer(ctx context.Context, simpleBod
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(simpleBodyProduct))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutSimpleProductSender sends the PutSimpleProduct request. The method will close the
 // http.Response Body if it receives an error.
 func (client BaseClient) PutSimpleProductSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutSimpleProductResponder handles the response to the PutSimpleProduct request. The method always
@@ -648,7 +946,7 @@ func (client BaseClient) PutSimpleProductResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -660,25 +958,26 @@ func (client BaseClient) PutSimpleProductResponder(resp *http.Response) (result
 // name - product name with value 'groupproduct'
 // simpleBodyProduct - simple body product to put
 func (client BaseClient) PutSimpleProductWithGrouping(ctx context.Context, name string, simpleBodyProduct *SimpleProduct) (result SimpleProduct, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.PutSimpleProductWithGrouping")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BaseClient.PutSimpleProductWithGrouping", "PUT", "/model-flatten/customFlattening/parametergrouping/{name}/")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BaseClient.PutSimpleProductWithGrouping", map[string]interface{}{
+			"name": name,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: simpleBodyProduct,
-			Constraints: []validation.Constraint{{Target: "simpleBodyProduct", Name: validation.Null, Rule: false,
-				Chain: []validation.Constraint{{Target: "simpleBodyProduct.SimpleProductProperties", Name: validation.Null, Rule: false,
-					Chain: []validation.Constraint{{Target: "simpleBodyProduct.SimpleProductProperties.MaxProductDisplayName", Name: validation.Null, Rule: true, Chain: nil},
-						{Target: "simpleBodyProduct.SimpleProductProperties.Capacity", Name: validation.Null, Rule: true, Chain: nil},
-					}},
-				}}}}}); err != nil {
-		return result, validation.NewError("modelflatteninggroup.BaseClient", "PutSimpleProductWithGrouping", err.Error())
+	var errs ValidationErrors
+	if simpleBodyProduct != nil {
+		errs = errs.addNested("simpleBodyProduct", simpleBodyProduct.Validate())
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("modelflatteninggroup.BaseClient", "PutSimpleProductWithGrouping", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutSimpleProductWithGroupingPreparer(ctx, name, simpleBodyProduct)
@@ -695,7 +994,7 @@ func (client BaseClient) PutSimpleProductWithGrouping(ctx context.Context, name
 	}
 
 	result, err = client.PutSimpleProductWithGroupingResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "modelflatteninggroup.BaseClient", "PutSimpleProductWithGrouping", resp, "Failure responding to request")
 	}
 
@@ -717,14 +1016,15 @@ func (client BaseClient) PutSimpleProductWithGroupingPreparer(ctx context.Contex
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(simpleBodyProduct))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutSimpleProductWithGroupingSender sends the PutSimpleProductWithGrouping request. The method will close the
 // http.Response Body if it receives an error.
 func (client BaseClient) PutSimpleProductWithGroupingSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutSimpleProductWithGroupingResponder handles the response to the PutSimpleProductWithGrouping request. The method always
@@ -733,7 +1033,7 @@ func (client BaseClient) PutSimpleProductWithGroupingResponder(resp *http.Respon
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -745,14 +1045,16 @@ func (client BaseClient) PutSimpleProductWithGroupingResponder(resp *http.Respon
 // Parameters:
 // resourceArray - external Resource as an Array to put
 func (client BaseClient) PutWrappedArray(ctx context.Context, resourceArray []WrappedProduct) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.PutWrappedArray")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BaseClient.PutWrappedArray", "PUT", "/model-flatten/wrappedarray")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BaseClient.PutWrappedArray", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutWrappedArrayPreparer(ctx, resourceArray)
@@ -769,7 +1071,7 @@ func (client BaseClient) PutWrappedArray(ctx context.Context, resourceArray []Wr
 	}
 
 	result, err = client.PutWrappedArrayResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "modelflatteninggroup.BaseClient", "PutWrappedArray", resp, "Failure responding to request")
 	}
 
@@ -787,14 +1089,15 @@ func (client BaseClient) PutWrappedArrayPreparer(ctx context.Context, resourceAr
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(resourceArray))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutWrappedArraySender sends the PutWrappedArray request. The method will close the
 // http.Response Body if it receives an error.
 func (client BaseClient) PutWrappedArraySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutWrappedArrayResponder handles the response to the PutWrappedArray request. The method always
@@ -803,7 +1106,7 @@ func (client BaseClient) PutWrappedArrayResponder(resp *http.Response) (result a
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -7,8 +7,23 @@ package modelflatteninggroup
 // Changes may cause incorrect behavior and will be lost if the code is regenerated.
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/validation"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // The package's fully qualified name.
@@ -47,6 +62,42 @@ func PossibleProvisioningStateValuesValues() []ProvisioningStateValues {
 	return []ProvisioningStateValues{Accepted, Canceled, Created, Creating, Deleted, Deleting, Failed, OK, Succeeded, Updated, Updating}
 }
 
+// knownProvisioningStateValuesValues is the set of the possible values for the ProvisioningStateValues const type.
+var knownProvisioningStateValuesValues = map[ProvisioningStateValues]struct{}{Accepted: {}, Canceled: {}, Created: {}, Creating: {}, Deleted: {}, Deleting: {}, Failed: {}, OK: {}, Succeeded: {}, Updated: {}, Updating: {}}
+
+// ParseProvisioningStateValues returns the ProvisioningStateValues whose value is s, or an error if s isn't one of its possible values.
+func ParseProvisioningStateValues(s string) (ProvisioningStateValues, error) {
+	if _, ok := knownProvisioningStateValuesValues[ProvisioningStateValues(s)]; ok {
+		return ProvisioningStateValues(s), nil
+	}
+	return "", fmt.Errorf("%q isn't a possible value for ProvisioningStateValues", s)
+}
+
+// ParseProvisioningStateValuesIgnoreCase returns the ProvisioningStateValues whose value equals s without regard to
+// case, or an error if s isn't one of its possible values.  Exact matches are preferred.
+func ParseProvisioningStateValuesIgnoreCase(s string) (ProvisioningStateValues, error) {
+	if value, err := ParseProvisioningStateValues(s); err == nil {
+		return value, nil
+	}
+	for _, value := range PossibleProvisioningStateValuesValues() {
+		if strings.EqualFold(string(value), s) {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("%q isn't a possible value for ProvisioningStateValues", s)
+}
+
+// IsKnown returns true if psv is one of the possible values for the ProvisioningStateValues const type.
+func (psv ProvisioningStateValues) IsKnown() bool {
+	_, ok := knownProvisioningStateValuesValues[psv]
+	return ok
+}
+
+// String returns the value of psv.
+func (psv ProvisioningStateValues) String() string {
+	return string(psv)
+}
+
 // BaseProduct the product documentation.
 type BaseProduct struct {
 	// ProductID - Unique identifier representing a specific product for a given latitude & longitude. For example, uberX in San Francisco will have a different product_id than uberX in Los Angeles.
@@ -55,6 +106,68 @@ type BaseProduct struct {
 	Description *string `json:"base_product_description,omitempty"`
 }
 
+// Validate checks that the BaseProduct satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (bp BaseProduct) Validate() error {
+	var errs ValidationErrors
+	if bp.ProductID == nil {
+		errs = append(errs, ValidationError{Path: "base_product_id", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// DeepCopy returns a copy of the BaseProduct that doesn't share any pointers, slices or maps with it.
+func (bp BaseProduct) DeepCopy() BaseProduct {
+	result := bp
+	if bp.ProductID != nil {
+		v := *bp.ProductID
+		result.ProductID = &v
+	}
+	if bp.Description != nil {
+		v := *bp.Description
+		result.Description = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the BaseProduct and other are equal.  Times are equal if they represent the same
+// instant.
+func (bp BaseProduct) Equal(other BaseProduct) bool {
+	return bp.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (bp BaseProduct) EqualIgnoreReadOnly(other BaseProduct) bool {
+	return bp.equal(other, true)
+}
+
+// equal compares the fields of the BaseProduct and other, skipping the read-only ones if ignoreReadOnly is true.
+func (bp BaseProduct) equal(other BaseProduct, ignoreReadOnly bool) bool {
+	if (bp.ProductID == nil) != (other.ProductID == nil) || (bp.ProductID != nil && *bp.ProductID != *other.ProductID) {
+		return false
+	}
+	if (bp.Description == nil) != (other.Description == nil) || (bp.Description != nil && *bp.Description != *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the BaseProduct to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (bp *BaseProduct) MergeFrom(patch BaseProduct) {
+	if patch.ProductID != nil {
+		v := *patch.ProductID
+		bp.ProductID = &v
+	}
+	if patch.Description != nil {
+		v := *patch.Description
+		bp.Description = &v
+	}
+}
+
 // Error ...
 type Error struct {
 	Status  *int32  `json:"status,omitempty"`
@@ -119,6 +232,419 @@ func (e *Error) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// DeepCopy returns a copy of the Error that doesn't share any pointers, slices or maps with it.
+func (e Error) DeepCopy() Error {
+	result := e
+	if e.Status != nil {
+		v := *e.Status
+		result.Status = &v
+	}
+	if e.Message != nil {
+		v := *e.Message
+		result.Message = &v
+	}
+	if e.Error != nil {
+		v := e.Error.DeepCopy()
+		result.Error = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Error and other are equal.  Times are equal if they represent the same
+// instant.
+func (e Error) Equal(other Error) bool {
+	return e.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (e Error) EqualIgnoreReadOnly(other Error) bool {
+	return e.equal(other, true)
+}
+
+// equal compares the fields of the Error and other, skipping the read-only ones if ignoreReadOnly is true.
+func (e Error) equal(other Error, ignoreReadOnly bool) bool {
+	if (e.Status == nil) != (other.Status == nil) || (e.Status != nil && *e.Status != *other.Status) {
+		return false
+	}
+	if (e.Message == nil) != (other.Message == nil) || (e.Message != nil && *e.Message != *other.Message) {
+		return false
+	}
+	if (e.Error == nil) != (other.Error == nil) || (e.Error != nil && !e.Error.equal(*other.Error, ignoreReadOnly)) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Error to copies of the fields set in patch.  Nested models and maps are merged, the
+// values of the other fields are replaced.  The read-only fields aren't changed.
+func (e *Error) MergeFrom(patch Error) {
+	if patch.Status != nil {
+		v := *patch.Status
+		e.Status = &v
+	}
+	if patch.Message != nil {
+		v := *patch.Message
+		e.Message = &v
+	}
+	if patch.Error != nil {
+		if e.Error == nil {
+			e.Error = &Error{}
+		}
+		e.Error.MergeFrom(*patch.Error)
+	}
+}
+
+// ErrorResponse is returned when an operation fails with a response containing the Error model.  Unlike the other
+// errors of the operations it isn't wrapped in an autorest.DetailedError.
+type ErrorResponse struct {
+	// Model - the error model unmarshalled from the response body.
+	Model Error
+	// StatusCode - the HTTP status code of the response.
+	StatusCode int
+	// Body - the raw response body.
+	Body []byte
+	// Err - the error encountered reading or unmarshalling the response body, if any.
+	Err error
+}
+
+// Error implements the error interface for type ErrorResponse.
+func (er ErrorResponse) Error() string {
+	if er.Err != nil {
+		return fmt.Sprintf("modelflatteninggroup: StatusCode=%d Body=%s Err=%v", er.StatusCode, string(er.Body), er.Err)
+	}
+	return fmt.Sprintf("modelflatteninggroup: StatusCode=%d Body=%s", er.StatusCode, string(er.Body))
+}
+
+// Unwrap returns the error encountered reading or unmarshalling the response body, if any.
+func (er ErrorResponse) Unwrap() error {
+	return er.Err
+}
+
+// withErrorResponseUnlessStatusCode returns a RespondDecorator that returns an ErrorResponse if the
+// response's status code isn't one of the specified codes.  The response body is left available to the caller.
+// When the body can't be read or unmarshalled the returned ErrorResponse wraps the error in its Err field.
+func withErrorResponseUnlessStatusCode(codes ...int) autorest.RespondDecorator {
+	return func(r autorest.Responder) autorest.Responder {
+		return autorest.ResponderFunc(func(resp *http.Response) error {
+			err := r.Respond(resp)
+			if err != nil || autorest.ResponseHasStatusCode(resp, codes...) {
+				return err
+			}
+			defer resp.Body.Close()
+			er := ErrorResponse{StatusCode: resp.StatusCode}
+			er.Body, er.Err = ioutil.ReadAll(resp.Body)
+			resp.Body = ioutil.NopCloser(bytes.NewReader(er.Body))
+			if er.Err == nil && len(bytes.TrimSpace(er.Body)) > 0 {
+				er.Err = json.Unmarshal(er.Body, &er.Model)
+			}
+			return er
+		})
+	}
+}
+
+// ExponentialRetry is a RetryPolicy that retries failed requests with exponentially increasing delays.  When the
+// RetryPolicy of a client is nil one is created from its RetryAttempts and RetryDuration.  Unlike
+// autorest.DoRetryForStatusCodes it only retries idempotent requests unless RetryNonIdempotent is true, so POST and
+// PATCH requests, including the initial requests of long-running operations, aren't retried unless the service marks
+// their operation x-ms-idempotent.  Responses with status code 429 count against MaxRetries.
+type ExponentialRetry struct {
+	// MaxRetries - the maximum number of times a request is retried.
+	MaxRetries int
+	// Delay - the delay before the first retry.  The delay is doubled for each following retry.
+	Delay time.Duration
+	// MaxDelay - the maximum delay between retries.  Zero means no limit.
+	MaxDelay time.Duration
+	// MaxElapsed - requests aren't retried once the delay would exceed this much time since the first attempt.  Zero means no limit.
+	MaxElapsed time.Duration
+	// Jitter - the fraction, from 0 to 1, of each delay that's randomized to spread out the retries of concurrent requests.
+	Jitter float64
+	// StatusCodes - the status codes of the responses that are retried.  Defaults to autorest.StatusCodesForRetry.
+	StatusCodes []int
+	// RetryNonIdempotent - if true, requests that aren't idempotent, e.g. POST requests of operations the service doesn't mark as idempotent, are retried too.
+	RetryNonIdempotent bool
+}
+
+// defaultRetryJitter is the Jitter of the ExponentialRetry used when the RetryPolicy of a client is nil.
+const defaultRetryJitter = 0.2
+
+// RetryPolicy decides if and when a failed attempt to send a request is retried.
+type RetryPolicy interface {
+	// ShouldRetry returns the delay before the request is sent again, or false if it isn't retried.
+	ShouldRetry(attempt RetryAttempt) (time.Duration, bool)
+}
+
+// LogEvent is an event logged by the operations of a client when its Logger isn't nil.  The URLs and headers of the
+// requests aren't included as they can contain secrets.
+type LogEvent struct {
+	// Kind - the kind of the event.
+	Kind LogEventKind
+	// Operation - the name of the operation, qualified by the name of its client.
+	Operation string
+	// Fields - the parameters of the operation keyed by their names in the request, for LogOperationStart events.  Body parameters aren't included and the values of secret parameters are replaced with REDACTED.
+	Fields map[string]interface{}
+	// Attempt - the number of the failed attempt, starting at one, for LogRetry events.
+	Attempt int
+	// Delay - the delay before the request is sent again, for LogRetry events.
+	Delay time.Duration
+	// State - the status of the long-running operation, for LogPoll events.
+	State string
+	// StatusCode - the status code of the response, or zero if none was received.
+	StatusCode int
+	// Elapsed - the time elapsed since the operation, the page fetch or the polling started.
+	Elapsed time.Duration
+	// Err - the error the operation, the attempt or the poll failed with, if any.
+	Err error
+}
+
+// redactedLogValue replaces the values of secret parameters in the Fields of a LogEvent.
+const redactedLogValue = "REDACTED"
+
+// LogEventKind is the kind of a LogEvent.
+type LogEventKind string
+
+const (
+	// LogOperationStart is logged when an operation starts, with its parameters.
+	LogOperationStart LogEventKind = "OperationStart"
+	// LogOperationFinish is logged when an operation finishes.
+	LogOperationFinish LogEventKind = "OperationFinish"
+	// LogRetry is logged when a failed attempt to send a request is retried.
+	LogRetry LogEventKind = "Retry"
+	// LogPageFetch is logged when the next page of the results of an operation has been fetched.
+	LogPageFetch LogEventKind = "PageFetch"
+	// LogPoll is logged each time PollUntilDone has polled the status of a long-running operation.
+	LogPoll LogEventKind = "Poll"
+)
+
+// Logger receives the events logged by the operations of a client.
+type Logger interface {
+	// Log is called synchronously for each event.
+	Log(event LogEvent)
+}
+
+// LoggerFunc is a function implementing the Logger interface.
+type LoggerFunc func(event LogEvent)
+
+// Log implements the Logger interface for LoggerFunc.
+func (lf LoggerFunc) Log(event LogEvent) {
+	lf(event)
+}
+
+// String formats the event as a line of key=value pairs, e.g. to pass to log.Print.
+func (le LogEvent) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "event=%s operation=%s", le.Kind, le.Operation)
+	names := make([]string, 0, len(le.Fields))
+	for name := range le.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&b, " %s=%v", name, le.Fields[name])
+	}
+	if le.Attempt > 0 {
+		fmt.Fprintf(&b, " attempt=%d", le.Attempt)
+	}
+	if le.State != "" {
+		fmt.Fprintf(&b, " state=%s", le.State)
+	}
+	if le.StatusCode > 0 {
+		fmt.Fprintf(&b, " status=%d", le.StatusCode)
+	}
+	if le.Delay > 0 {
+		fmt.Fprintf(&b, " delay=%v", le.Delay)
+	}
+	if le.Kind != LogOperationStart {
+		fmt.Fprintf(&b, " elapsed=%v", le.Elapsed)
+	}
+	if le.Err != nil {
+		fmt.Fprintf(&b, " error=%q", le.Err.Error())
+	}
+	return b.String()
+}
+
+// operationLog is the state used to log the events of an operation, carried by the contexts of its requests.
+type operationLog struct {
+	logger    Logger
+	operation string
+	start     time.Time
+}
+
+// operationLogKey is the key of the operationLog in a context.
+type operationLogKey struct{}
+
+// withOperationLog returns a context carrying the state used to log the events of operation to logger.
+func withOperationLog(ctx context.Context, logger Logger, operation string) context.Context {
+	return context.WithValue(ctx, operationLogKey{}, &operationLog{logger: logger, operation: operation, start: time.Now()})
+}
+
+// startOperationLog logs the start of operation with its parameters, then returns a context carrying the state used to
+// log its other events.
+func startOperationLog(ctx context.Context, logger Logger, operation string, fields map[string]interface{}) context.Context {
+	for name, value := range fields {
+		// log the values of optional parameters rather than their addresses
+		v := reflect.ValueOf(value)
+		for v.Kind() == reflect.Ptr && !v.IsNil() {
+			v = v.Elem()
+		}
+		if v.Kind() == reflect.Ptr {
+			fields[name] = nil
+		} else if v.IsValid() {
+			fields[name] = v.Interface()
+		}
+	}
+	ctx = withOperationLog(ctx, logger, operation)
+	logger.Log(LogEvent{Kind: LogOperationStart, Operation: operation, Fields: fields})
+	return ctx
+}
+
+// logResponse logs event for the operation whose state is carried by ctx, if any, along with the status code of resp
+// if it isn't nil.
+func logResponse(ctx context.Context, event LogEvent, resp *http.Response) {
+	ol, ok := ctx.Value(operationLogKey{}).(*operationLog)
+	if !ok {
+		return
+	}
+	event.Operation = ol.operation
+	event.Elapsed = time.Since(ol.start)
+	if resp != nil {
+		event.StatusCode = resp.StatusCode
+	}
+	ol.logger.Log(event)
+}
+
+// RetryAttempt describes a failed attempt to send a request.
+type RetryAttempt struct {
+	// Request - the request that was sent.
+	Request *http.Request
+	// Response - the response received, or nil if sending the request failed.
+	Response *http.Response
+	// Err - the error returned when sending the request, if any.
+	Err error
+	// Attempt - the number of the attempt, starting at one.
+	Attempt int
+	// Elapsed - the time elapsed since the first attempt was sent.
+	Elapsed time.Duration
+	// Idempotent - true if the request can safely be sent more than once, either because its HTTP method is
+	// idempotent or because the service marks the operation as idempotent.
+	Idempotent bool
+}
+
+// ShouldRetry implements the RetryPolicy interface for ExponentialRetry.  A delay requested by the Retry-After header
+// of the response takes precedence over the computed one.
+func (er ExponentialRetry) ShouldRetry(attempt RetryAttempt) (time.Duration, bool) {
+	if attempt.Attempt > er.MaxRetries || !(attempt.Idempotent || er.RetryNonIdempotent) {
+		return 0, false
+	}
+	if attempt.Err != nil {
+		if !autorest.IsTemporaryNetworkError(attempt.Err) || autorest.IsTokenRefreshError(attempt.Err) {
+			return 0, false
+		}
+	} else {
+		codes := er.StatusCodes
+		if codes == nil {
+			codes = autorest.StatusCodesForRetry
+		}
+		if !autorest.ResponseHasStatusCode(attempt.Response, codes...) {
+			return 0, false
+		}
+	}
+	delay, ok := retryAfter(attempt.Response)
+	if !ok {
+		delay = er.Delay
+		for i := 1; i < attempt.Attempt && delay < math.MaxInt64/2; i++ {
+			delay *= 2
+		}
+		if er.MaxDelay > 0 && delay > er.MaxDelay {
+			delay = er.MaxDelay
+		}
+		if er.Jitter > 0 {
+			delay -= time.Duration(math.Min(er.Jitter, 1) * rand.Float64() * float64(delay))
+		}
+	}
+	if er.MaxElapsed > 0 && attempt.Elapsed+delay > er.MaxElapsed {
+		return 0, false
+	}
+	return delay, true
+}
+
+// retryAfter returns the delay requested by the Retry-After header of resp, and false if it doesn't have one.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	ra := resp.Header.Get("Retry-After")
+	if ra == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(ra); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(ra); err == nil {
+		if delay := time.Until(t); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// isIdempotentMethod returns true if requests using the HTTP method can safely be sent more than once.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodTrace:
+		return true
+	}
+	return false
+}
+
+// withRetryPolicy returns a SendDecorator that sends a request again after each failed attempt policy decides to retry.
+// If policy is nil an ExponentialRetry created from the RetryAttempts and RetryDuration of c is used.  idempotent is
+// true for operations the service marks as idempotent.
+func withRetryPolicy(policy RetryPolicy, c autorest.Client, idempotent bool) autorest.SendDecorator {
+	if policy == nil {
+		policy = ExponentialRetry{MaxRetries: c.RetryAttempts, Delay: c.RetryDuration, Jitter: defaultRetryJitter}
+	}
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+			idempotent := idempotent || isIdempotentMethod(r.Method)
+			start := time.Now()
+			for attempt := 1; ; attempt++ {
+				if err := rr.Prepare(); err != nil {
+					return nil, err
+				}
+				resp, err := s.Do(rr.Request())
+				if r.Context().Err() != nil {
+					return resp, err
+				}
+				delay, retry := policy.ShouldRetry(RetryAttempt{
+					Request:    rr.Request(),
+					Response:   resp,
+					Err:        err,
+					Attempt:    attempt,
+					Elapsed:    time.Since(start),
+					Idempotent: idempotent,
+				})
+				if !retry {
+					return resp, err
+				}
+				logResponse(r.Context(), LogEvent{Kind: LogRetry, Attempt: attempt, Delay: delay, Err: err}, resp)
+				setSpanAttribute(r.Context(), AttributeRetryCount, attempt)
+				if resp != nil {
+					autorest.Respond(resp, autorest.ByDiscardingBody(), autorest.ByClosing())
+				}
+				timer := time.NewTimer(delay)
+				select {
+				case <-timer.C:
+				case <-r.Context().Done():
+					timer.Stop()
+					return nil, r.Context().Err()
+				}
+			}
+		})
+	}
+}
+
 // FlattenedProduct flattened product.
 type FlattenedProduct struct {
 	*FlattenedProductProperties `json:"properties,omitempty"`
@@ -217,6 +743,115 @@ func (fp *FlattenedProduct) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// DeepCopy returns a copy of the FlattenedProduct that doesn't share any pointers, slices or maps with it.
+func (fp FlattenedProduct) DeepCopy() FlattenedProduct {
+	result := fp
+	if fp.FlattenedProductProperties != nil {
+		v := fp.FlattenedProductProperties.DeepCopy()
+		result.FlattenedProductProperties = &v
+	}
+	if fp.ID != nil {
+		v := *fp.ID
+		result.ID = &v
+	}
+	if fp.Type != nil {
+		v := *fp.Type
+		result.Type = &v
+	}
+	if fp.Tags != nil {
+		result.Tags = make(map[string]*string, len(fp.Tags))
+		for k, e := range fp.Tags {
+			if e != nil {
+				v := *e
+				e = &v
+			}
+			result.Tags[k] = e
+		}
+	}
+	if fp.Location != nil {
+		v := *fp.Location
+		result.Location = &v
+	}
+	if fp.Name != nil {
+		v := *fp.Name
+		result.Name = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the FlattenedProduct and other are equal.  Times are equal if they represent the
+// same instant.
+func (fp FlattenedProduct) Equal(other FlattenedProduct) bool {
+	return fp.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (fp FlattenedProduct) EqualIgnoreReadOnly(other FlattenedProduct) bool {
+	return fp.equal(other, true)
+}
+
+// equal compares the fields of the FlattenedProduct and other, skipping the read-only ones if ignoreReadOnly is true.
+func (fp FlattenedProduct) equal(other FlattenedProduct, ignoreReadOnly bool) bool {
+	if (fp.FlattenedProductProperties == nil) != (other.FlattenedProductProperties == nil) || (fp.FlattenedProductProperties != nil && !fp.FlattenedProductProperties.equal(*other.FlattenedProductProperties, ignoreReadOnly)) {
+		return false
+	}
+	if !ignoreReadOnly {
+		if (fp.ID == nil) != (other.ID == nil) || (fp.ID != nil && *fp.ID != *other.ID) {
+			return false
+		}
+	}
+	if !ignoreReadOnly {
+		if (fp.Type == nil) != (other.Type == nil) || (fp.Type != nil && *fp.Type != *other.Type) {
+			return false
+		}
+	}
+	if (fp.Tags == nil) != (other.Tags == nil) || len(fp.Tags) != len(other.Tags) {
+		return false
+	}
+	for k, e := range fp.Tags {
+		f, ok := other.Tags[k]
+		if !ok || (e == nil) != (f == nil) || (e != nil && *e != *f) {
+			return false
+		}
+	}
+	if (fp.Location == nil) != (other.Location == nil) || (fp.Location != nil && *fp.Location != *other.Location) {
+		return false
+	}
+	if !ignoreReadOnly {
+		if (fp.Name == nil) != (other.Name == nil) || (fp.Name != nil && *fp.Name != *other.Name) {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the FlattenedProduct to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (fp *FlattenedProduct) MergeFrom(patch FlattenedProduct) {
+	if patch.FlattenedProductProperties != nil {
+		if fp.FlattenedProductProperties == nil {
+			fp.FlattenedProductProperties = &FlattenedProductProperties{}
+		}
+		fp.FlattenedProductProperties.MergeFrom(*patch.FlattenedProductProperties)
+	}
+	if patch.Tags != nil {
+		if fp.Tags == nil {
+			fp.Tags = make(map[string]*string, len(patch.Tags))
+		}
+		for k, e := range patch.Tags {
+			if e != nil {
+				v := *e
+				e = &v
+			}
+			fp.Tags[k] = e
+		}
+	}
+	if patch.Location != nil {
+		v := *patch.Location
+		fp.Location = &v
+	}
+}
+
 // FlattenedProductProperties ...
 type FlattenedProductProperties struct {
 	PName *string `json:"p.name,omitempty"`
@@ -226,24 +861,250 @@ type FlattenedProductProperties struct {
 	ProvisioningState       *string                 `json:"provisioningState,omitempty"`
 }
 
+// DeepCopy returns a copy of the FlattenedProductProperties that doesn't share any pointers, slices or maps with it.
+func (fpp FlattenedProductProperties) DeepCopy() FlattenedProductProperties {
+	result := fpp
+	if fpp.PName != nil {
+		v := *fpp.PName
+		result.PName = &v
+	}
+	if fpp.Type != nil {
+		v := *fpp.Type
+		result.Type = &v
+	}
+	if fpp.ProvisioningState != nil {
+		v := *fpp.ProvisioningState
+		result.ProvisioningState = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the FlattenedProductProperties and other are equal.  Times are equal if they
+// represent the same instant.
+func (fpp FlattenedProductProperties) Equal(other FlattenedProductProperties) bool {
+	return fpp.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (fpp FlattenedProductProperties) EqualIgnoreReadOnly(other FlattenedProductProperties) bool {
+	return fpp.equal(other, true)
+}
+
+// equal compares the fields of the FlattenedProductProperties and other, skipping the read-only ones if ignoreReadOnly is true.
+func (fpp FlattenedProductProperties) equal(other FlattenedProductProperties, ignoreReadOnly bool) bool {
+	if (fpp.PName == nil) != (other.PName == nil) || (fpp.PName != nil && *fpp.PName != *other.PName) {
+		return false
+	}
+	if (fpp.Type == nil) != (other.Type == nil) || (fpp.Type != nil && *fpp.Type != *other.Type) {
+		return false
+	}
+	if !ignoreReadOnly {
+		if fpp.ProvisioningStateValues != other.ProvisioningStateValues {
+			return false
+		}
+	}
+	if (fpp.ProvisioningState == nil) != (other.ProvisioningState == nil) || (fpp.ProvisioningState != nil && *fpp.ProvisioningState != *other.ProvisioningState) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the FlattenedProductProperties to copies of the fields set in patch.  Nested models and
+// maps are merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (fpp *FlattenedProductProperties) MergeFrom(patch FlattenedProductProperties) {
+	if patch.PName != nil {
+		v := *patch.PName
+		fpp.PName = &v
+	}
+	if patch.Type != nil {
+		v := *patch.Type
+		fpp.Type = &v
+	}
+	if patch.ProvisioningState != nil {
+		v := *patch.ProvisioningState
+		fpp.ProvisioningState = &v
+	}
+}
+
 // GenericURL the Generic URL.
 type GenericURL struct {
 	// GenericValue - Generic URL value.
 	GenericValue *string `json:"generic_value,omitempty"`
 }
 
+// DeepCopy returns a copy of the GenericURL that doesn't share any pointers, slices or maps with it.
+func (gu GenericURL) DeepCopy() GenericURL {
+	result := gu
+	if gu.GenericValue != nil {
+		v := *gu.GenericValue
+		result.GenericValue = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the GenericURL and other are equal.  Times are equal if they represent the same
+// instant.
+func (gu GenericURL) Equal(other GenericURL) bool {
+	return gu.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (gu GenericURL) EqualIgnoreReadOnly(other GenericURL) bool {
+	return gu.equal(other, true)
+}
+
+// equal compares the fields of the GenericURL and other, skipping the read-only ones if ignoreReadOnly is true.
+func (gu GenericURL) equal(other GenericURL, ignoreReadOnly bool) bool {
+	if (gu.GenericValue == nil) != (other.GenericValue == nil) || (gu.GenericValue != nil && *gu.GenericValue != *other.GenericValue) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the GenericURL to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields aren't changed.
+func (gu *GenericURL) MergeFrom(patch GenericURL) {
+	if patch.GenericValue != nil {
+		v := *patch.GenericValue
+		gu.GenericValue = &v
+	}
+}
+
 // ListFlattenedProduct ...
 type ListFlattenedProduct struct {
 	autorest.Response `json:"-"`
 	Value             *[]FlattenedProduct `json:"value,omitempty"`
 }
 
+// DeepCopy returns a copy of the ListFlattenedProduct that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (lfp ListFlattenedProduct) DeepCopy() ListFlattenedProduct {
+	result := lfp
+	if lfp.Value != nil {
+		var v []FlattenedProduct
+		if *lfp.Value != nil {
+			v = make([]FlattenedProduct, len(*lfp.Value))
+			for i := range *lfp.Value {
+				v[i] = (*lfp.Value)[i].DeepCopy()
+			}
+		}
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ListFlattenedProduct and other are equal.  Times are equal if they represent
+// the same instant.  Their autorest.Response isn't compared.
+func (lfp ListFlattenedProduct) Equal(other ListFlattenedProduct) bool {
+	return lfp.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (lfp ListFlattenedProduct) EqualIgnoreReadOnly(other ListFlattenedProduct) bool {
+	return lfp.equal(other, true)
+}
+
+// equal compares the fields of the ListFlattenedProduct and other, skipping the read-only ones if ignoreReadOnly is true.
+func (lfp ListFlattenedProduct) equal(other ListFlattenedProduct, ignoreReadOnly bool) bool {
+	if (lfp.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if lfp.Value != nil {
+		if (*lfp.Value == nil) != (*other.Value == nil) || len(*lfp.Value) != len(*other.Value) {
+			return false
+		}
+		for i := range *lfp.Value {
+			if !(*lfp.Value)[i].equal((*other.Value)[i], ignoreReadOnly) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ListFlattenedProduct to copies of the fields set in patch.  Nested models and maps
+// are merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't
+// changed.
+func (lfp *ListFlattenedProduct) MergeFrom(patch ListFlattenedProduct) {
+	if patch.Value != nil {
+		var v []FlattenedProduct
+		if *patch.Value != nil {
+			v = make([]FlattenedProduct, len(*patch.Value))
+			for i := range *patch.Value {
+				v[i] = (*patch.Value)[i].DeepCopy()
+			}
+		}
+		lfp.Value = &v
+	}
+}
+
 // ListProductWrapper ...
 type ListProductWrapper struct {
 	autorest.Response `json:"-"`
 	Value             *[]ProductWrapper `json:"value,omitempty"`
 }
 
+// DeepCopy returns a copy of the ListProductWrapper that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (lpw ListProductWrapper) DeepCopy() ListProductWrapper {
+	result := lpw
+	if lpw.Value != nil {
+		var v []ProductWrapper
+		if *lpw.Value != nil {
+			v = make([]ProductWrapper, len(*lpw.Value))
+			for i := range *lpw.Value {
+				v[i] = (*lpw.Value)[i].DeepCopy()
+			}
+		}
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ListProductWrapper and other are equal.  Times are equal if they represent
+// the same instant.  Their autorest.Response isn't compared.
+func (lpw ListProductWrapper) Equal(other ListProductWrapper) bool {
+	return lpw.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (lpw ListProductWrapper) EqualIgnoreReadOnly(other ListProductWrapper) bool {
+	return lpw.equal(other, true)
+}
+
+// equal compares the fields of the ListProductWrapper and other, skipping the read-only ones if ignoreReadOnly is true.
+func (lpw ListProductWrapper) equal(other ListProductWrapper, ignoreReadOnly bool) bool {
+	if (lpw.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if lpw.Value != nil {
+		if (*lpw.Value == nil) != (*other.Value == nil) || len(*lpw.Value) != len(*other.Value) {
+			return false
+		}
+		for i := range *lpw.Value {
+			if !(*lpw.Value)[i].equal((*other.Value)[i], ignoreReadOnly) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ListProductWrapper to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (lpw *ListProductWrapper) MergeFrom(patch ListProductWrapper) {
+	if patch.Value != nil {
+		var v []ProductWrapper
+		if *patch.Value != nil {
+			v = make([]ProductWrapper, len(*patch.Value))
+			for i := range *patch.Value {
+				v[i] = (*patch.Value)[i].DeepCopy()
+			}
+		}
+		lpw.Value = &v
+	}
+}
+
 // ProductURL the product URL.
 type ProductURL struct {
 	// OdataValue - URL value.
@@ -252,6 +1113,55 @@ type ProductURL struct {
 	GenericValue *string `json:"generic_value,omitempty"`
 }
 
+// DeepCopy returns a copy of the ProductURL that doesn't share any pointers, slices or maps with it.
+func (pu ProductURL) DeepCopy() ProductURL {
+	result := pu
+	if pu.OdataValue != nil {
+		v := *pu.OdataValue
+		result.OdataValue = &v
+	}
+	if pu.GenericValue != nil {
+		v := *pu.GenericValue
+		result.GenericValue = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ProductURL and other are equal.  Times are equal if they represent the same
+// instant.
+func (pu ProductURL) Equal(other ProductURL) bool {
+	return pu.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (pu ProductURL) EqualIgnoreReadOnly(other ProductURL) bool {
+	return pu.equal(other, true)
+}
+
+// equal compares the fields of the ProductURL and other, skipping the read-only ones if ignoreReadOnly is true.
+func (pu ProductURL) equal(other ProductURL, ignoreReadOnly bool) bool {
+	if (pu.OdataValue == nil) != (other.OdataValue == nil) || (pu.OdataValue != nil && *pu.OdataValue != *other.OdataValue) {
+		return false
+	}
+	if (pu.GenericValue == nil) != (other.GenericValue == nil) || (pu.GenericValue != nil && *pu.GenericValue != *other.GenericValue) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ProductURL to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields aren't changed.
+func (pu *ProductURL) MergeFrom(patch ProductURL) {
+	if patch.OdataValue != nil {
+		v := *patch.OdataValue
+		pu.OdataValue = &v
+	}
+	if patch.GenericValue != nil {
+		v := *patch.GenericValue
+		pu.GenericValue = &v
+	}
+}
+
 // ProductWrapper the wrapped produc.
 type ProductWrapper struct {
 	*WrappedProduct `json:"property,omitempty"`
@@ -290,6 +1200,126 @@ func (pw *ProductWrapper) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// DeepCopy returns a copy of the ProductWrapper that doesn't share any pointers, slices or maps with it.
+func (pw ProductWrapper) DeepCopy() ProductWrapper {
+	result := pw
+	if pw.WrappedProduct != nil {
+		v := pw.WrappedProduct.DeepCopy()
+		result.WrappedProduct = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ProductWrapper and other are equal.  Times are equal if they represent the
+// same instant.
+func (pw ProductWrapper) Equal(other ProductWrapper) bool {
+	return pw.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (pw ProductWrapper) EqualIgnoreReadOnly(other ProductWrapper) bool {
+	return pw.equal(other, true)
+}
+
+// equal compares the fields of the ProductWrapper and other, skipping the read-only ones if ignoreReadOnly is true.
+func (pw ProductWrapper) equal(other ProductWrapper, ignoreReadOnly bool) bool {
+	if (pw.WrappedProduct == nil) != (other.WrappedProduct == nil) || (pw.WrappedProduct != nil && !pw.WrappedProduct.equal(*other.WrappedProduct, ignoreReadOnly)) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ProductWrapper to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (pw *ProductWrapper) MergeFrom(patch ProductWrapper) {
+	if patch.WrappedProduct != nil {
+		if pw.WrappedProduct == nil {
+			pw.WrappedProduct = &WrappedProduct{}
+		}
+		pw.WrappedProduct.MergeFrom(*patch.WrappedProduct)
+	}
+}
+
+// RequestOptions contains the per-call options applied to the requests of operations whose context was created with WithRequestOptions.
+type RequestOptions struct {
+	// Header - additional headers to send.  They replace any values of the same headers set by the operation.
+	Header http.Header
+	// Query - additional query parameters to send.  They replace any values of the same parameters set by the operation.
+	Query url.Values
+	// Timeout - if greater than zero, the maximum amount of time allowed for sending the request, including retries, and reading its response.
+	Timeout time.Duration
+}
+
+type requestOptionsKey struct{}
+
+// WithRequestOptions returns a copy of ctx carrying opts.  Operations called with the returned
+// context apply opts to their requests; other calls aren't affected.
+func WithRequestOptions(ctx context.Context, opts RequestOptions) context.Context {
+	return context.WithValue(ctx, requestOptionsKey{}, opts)
+}
+
+// withRequestOptions returns a PrepareDecorator that merges the headers and query parameters
+// of the RequestOptions carried by the request's context into the request.
+func withRequestOptions() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+			opts, ok := r.Context().Value(requestOptionsKey{}).(RequestOptions)
+			if !ok {
+				return r, nil
+			}
+			if len(opts.Header) > 0 {
+				if r.Header == nil {
+					r.Header = make(http.Header)
+				}
+				for k, v := range opts.Header {
+					r.Header[http.CanonicalHeaderKey(k)] = v
+				}
+			}
+			if len(opts.Query) > 0 {
+				q := r.URL.Query()
+				for k, v := range opts.Query {
+					q[k] = v
+				}
+				r.URL.RawQuery = q.Encode()
+			}
+			return r, nil
+		})
+	}
+}
+
+// sendWithRequestOptions sends the request like autorest.SendWithSender.  If the request's context
+// carries a RequestOptions with a Timeout, the request is cancelled once the timeout elapses
+// or the response body is closed, whichever happens first.
+func sendWithRequestOptions(s autorest.Sender, r *http.Request, decorators ...autorest.SendDecorator) (*http.Response, error) {
+	opts, ok := r.Context().Value(requestOptionsKey{}).(RequestOptions)
+	if !ok || opts.Timeout <= 0 {
+		return autorest.SendWithSender(s, r, decorators...)
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), opts.Timeout)
+	resp, err := autorest.SendWithSender(s, r.WithContext(ctx), decorators...)
+	if err != nil || resp == nil || resp.Body == nil {
+		cancel()
+		return resp, err
+	}
+	resp.Body = requestOptionsBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// requestOptionsBody releases the timeout of a request once its response body is closed.
+type requestOptionsBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b requestOptionsBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
 // Resource ...
 type Resource struct {
 	// ID - READ-ONLY; Resource Id
@@ -315,6 +1345,102 @@ func (r Resource) MarshalJSON() ([]byte, error) {
 	return json.Marshal(objectMap)
 }
 
+// DeepCopy returns a copy of the Resource that doesn't share any pointers, slices or maps with it.
+func (r Resource) DeepCopy() Resource {
+	result := r
+	if r.ID != nil {
+		v := *r.ID
+		result.ID = &v
+	}
+	if r.Type != nil {
+		v := *r.Type
+		result.Type = &v
+	}
+	if r.Tags != nil {
+		result.Tags = make(map[string]*string, len(r.Tags))
+		for k, e := range r.Tags {
+			if e != nil {
+				v := *e
+				e = &v
+			}
+			result.Tags[k] = e
+		}
+	}
+	if r.Location != nil {
+		v := *r.Location
+		result.Location = &v
+	}
+	if r.Name != nil {
+		v := *r.Name
+		result.Name = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Resource and other are equal.  Times are equal if they represent the same
+// instant.
+func (r Resource) Equal(other Resource) bool {
+	return r.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (r Resource) EqualIgnoreReadOnly(other Resource) bool {
+	return r.equal(other, true)
+}
+
+// equal compares the fields of the Resource and other, skipping the read-only ones if ignoreReadOnly is true.
+func (r Resource) equal(other Resource, ignoreReadOnly bool) bool {
+	if !ignoreReadOnly {
+		if (r.ID == nil) != (other.ID == nil) || (r.ID != nil && *r.ID != *other.ID) {
+			return false
+		}
+	}
+	if !ignoreReadOnly {
+		if (r.Type == nil) != (other.Type == nil) || (r.Type != nil && *r.Type != *other.Type) {
+			return false
+		}
+	}
+	if (r.Tags == nil) != (other.Tags == nil) || len(r.Tags) != len(other.Tags) {
+		return false
+	}
+	for k, e := range r.Tags {
+		f, ok := other.Tags[k]
+		if !ok || (e == nil) != (f == nil) || (e != nil && *e != *f) {
+			return false
+		}
+	}
+	if (r.Location == nil) != (other.Location == nil) || (r.Location != nil && *r.Location != *other.Location) {
+		return false
+	}
+	if !ignoreReadOnly {
+		if (r.Name == nil) != (other.Name == nil) || (r.Name != nil && *r.Name != *other.Name) {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Resource to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields aren't changed.
+func (r *Resource) MergeFrom(patch Resource) {
+	if patch.Tags != nil {
+		if r.Tags == nil {
+			r.Tags = make(map[string]*string, len(patch.Tags))
+		}
+		for k, e := range patch.Tags {
+			if e != nil {
+				v := *e
+				e = &v
+			}
+			r.Tags[k] = e
+		}
+	}
+	if patch.Location != nil {
+		v := *patch.Location
+		r.Location = &v
+	}
+}
+
 // ResourceCollection ...
 type ResourceCollection struct {
 	autorest.Response     `json:"-"`
@@ -338,6 +1464,111 @@ func (rc ResourceCollection) MarshalJSON() ([]byte, error) {
 	return json.Marshal(objectMap)
 }
 
+// DeepCopy returns a copy of the ResourceCollection that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (rc ResourceCollection) DeepCopy() ResourceCollection {
+	result := rc
+	if rc.Productresource != nil {
+		v := rc.Productresource.DeepCopy()
+		result.Productresource = &v
+	}
+	if rc.Arrayofresources != nil {
+		var v []FlattenedProduct
+		if *rc.Arrayofresources != nil {
+			v = make([]FlattenedProduct, len(*rc.Arrayofresources))
+			for i := range *rc.Arrayofresources {
+				v[i] = (*rc.Arrayofresources)[i].DeepCopy()
+			}
+		}
+		result.Arrayofresources = &v
+	}
+	if rc.Dictionaryofresources != nil {
+		result.Dictionaryofresources = make(map[string]*FlattenedProduct, len(rc.Dictionaryofresources))
+		for k, e := range rc.Dictionaryofresources {
+			if e != nil {
+				v := e.DeepCopy()
+				e = &v
+			}
+			result.Dictionaryofresources[k] = e
+		}
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ResourceCollection and other are equal.  Times are equal if they represent
+// the same instant.  Their autorest.Response isn't compared.
+func (rc ResourceCollection) Equal(other ResourceCollection) bool {
+	return rc.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (rc ResourceCollection) EqualIgnoreReadOnly(other ResourceCollection) bool {
+	return rc.equal(other, true)
+}
+
+// equal compares the fields of the ResourceCollection and other, skipping the read-only ones if ignoreReadOnly is true.
+func (rc ResourceCollection) equal(other ResourceCollection, ignoreReadOnly bool) bool {
+	if (rc.Productresource == nil) != (other.Productresource == nil) || (rc.Productresource != nil && !rc.Productresource.equal(*other.Productresource, ignoreReadOnly)) {
+		return false
+	}
+	if (rc.Arrayofresources == nil) != (other.Arrayofresources == nil) {
+		return false
+	}
+	if rc.Arrayofresources != nil {
+		if (*rc.Arrayofresources == nil) != (*other.Arrayofresources == nil) || len(*rc.Arrayofresources) != len(*other.Arrayofresources) {
+			return false
+		}
+		for i := range *rc.Arrayofresources {
+			if !(*rc.Arrayofresources)[i].equal((*other.Arrayofresources)[i], ignoreReadOnly) {
+				return false
+			}
+		}
+	}
+	if (rc.Dictionaryofresources == nil) != (other.Dictionaryofresources == nil) || len(rc.Dictionaryofresources) != len(other.Dictionaryofresources) {
+		return false
+	}
+	for k, e := range rc.Dictionaryofresources {
+		f, ok := other.Dictionaryofresources[k]
+		if !ok || (e == nil) != (f == nil) || (e != nil && !e.equal(*f, ignoreReadOnly)) {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ResourceCollection to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (rc *ResourceCollection) MergeFrom(patch ResourceCollection) {
+	if patch.Productresource != nil {
+		if rc.Productresource == nil {
+			rc.Productresource = &FlattenedProduct{}
+		}
+		rc.Productresource.MergeFrom(*patch.Productresource)
+	}
+	if patch.Arrayofresources != nil {
+		var v []FlattenedProduct
+		if *patch.Arrayofresources != nil {
+			v = make([]FlattenedProduct, len(*patch.Arrayofresources))
+			for i := range *patch.Arrayofresources {
+				v[i] = (*patch.Arrayofresources)[i].DeepCopy()
+			}
+		}
+		rc.Arrayofresources = &v
+	}
+	if patch.Dictionaryofresources != nil {
+		if rc.Dictionaryofresources == nil {
+			rc.Dictionaryofresources = make(map[string]*FlattenedProduct, len(patch.Dictionaryofresources))
+		}
+		for k, e := range patch.Dictionaryofresources {
+			if e != nil {
+				v := e.DeepCopy()
+				e = &v
+			}
+			rc.Dictionaryofresources[k] = e
+		}
+	}
+}
+
 // SetFlattenedProduct ...
 type SetFlattenedProduct struct {
 	autorest.Response `json:"-"`
@@ -353,6 +1584,66 @@ func (sfp SetFlattenedProduct) MarshalJSON() ([]byte, error) {
 	return json.Marshal(objectMap)
 }
 
+// DeepCopy returns a copy of the SetFlattenedProduct that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (sfp SetFlattenedProduct) DeepCopy() SetFlattenedProduct {
+	result := sfp
+	if sfp.Value != nil {
+		result.Value = make(map[string]*FlattenedProduct, len(sfp.Value))
+		for k, e := range sfp.Value {
+			if e != nil {
+				v := e.DeepCopy()
+				e = &v
+			}
+			result.Value[k] = e
+		}
+	}
+	return result
+}
+
+// Equal returns true if the fields of the SetFlattenedProduct and other are equal.  Times are equal if they represent
+// the same instant.  Their autorest.Response isn't compared.
+func (sfp SetFlattenedProduct) Equal(other SetFlattenedProduct) bool {
+	return sfp.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (sfp SetFlattenedProduct) EqualIgnoreReadOnly(other SetFlattenedProduct) bool {
+	return sfp.equal(other, true)
+}
+
+// equal compares the fields of the SetFlattenedProduct and other, skipping the read-only ones if ignoreReadOnly is true.
+func (sfp SetFlattenedProduct) equal(other SetFlattenedProduct, ignoreReadOnly bool) bool {
+	if (sfp.Value == nil) != (other.Value == nil) || len(sfp.Value) != len(other.Value) {
+		return false
+	}
+	for k, e := range sfp.Value {
+		f, ok := other.Value[k]
+		if !ok || (e == nil) != (f == nil) || (e != nil && !e.equal(*f, ignoreReadOnly)) {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the SetFlattenedProduct to copies of the fields set in patch.  Nested models and maps
+// are merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't
+// changed.
+func (sfp *SetFlattenedProduct) MergeFrom(patch SetFlattenedProduct) {
+	if patch.Value != nil {
+		if sfp.Value == nil {
+			sfp.Value = make(map[string]*FlattenedProduct, len(patch.Value))
+		}
+		for k, e := range patch.Value {
+			if e != nil {
+				v := e.DeepCopy()
+				e = &v
+			}
+			sfp.Value[k] = e
+		}
+	}
+}
+
 // SimpleProduct the product documentation.
 type SimpleProduct struct {
 	autorest.Response        `json:"-"`
@@ -420,6 +1711,85 @@ func (sp *SimpleProduct) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// Validate checks that the SimpleProduct satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (sp SimpleProduct) Validate() error {
+	var errs ValidationErrors
+	if sp.SimpleProductProperties != nil {
+		errs = errs.addNested("details", sp.SimpleProductProperties.Validate())
+	}
+	if sp.ProductID == nil {
+		errs = append(errs, ValidationError{Path: "base_product_id", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// DeepCopy returns a copy of the SimpleProduct that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (sp SimpleProduct) DeepCopy() SimpleProduct {
+	result := sp
+	if sp.SimpleProductProperties != nil {
+		v := sp.SimpleProductProperties.DeepCopy()
+		result.SimpleProductProperties = &v
+	}
+	if sp.ProductID != nil {
+		v := *sp.ProductID
+		result.ProductID = &v
+	}
+	if sp.Description != nil {
+		v := *sp.Description
+		result.Description = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the SimpleProduct and other are equal.  Times are equal if they represent the
+// same instant.  Their autorest.Response isn't compared.
+func (sp SimpleProduct) Equal(other SimpleProduct) bool {
+	return sp.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (sp SimpleProduct) EqualIgnoreReadOnly(other SimpleProduct) bool {
+	return sp.equal(other, true)
+}
+
+// equal compares the fields of the SimpleProduct and other, skipping the read-only ones if ignoreReadOnly is true.
+func (sp SimpleProduct) equal(other SimpleProduct, ignoreReadOnly bool) bool {
+	if (sp.SimpleProductProperties == nil) != (other.SimpleProductProperties == nil) || (sp.SimpleProductProperties != nil && !sp.SimpleProductProperties.equal(*other.SimpleProductProperties, ignoreReadOnly)) {
+		return false
+	}
+	if (sp.ProductID == nil) != (other.ProductID == nil) || (sp.ProductID != nil && *sp.ProductID != *other.ProductID) {
+		return false
+	}
+	if (sp.Description == nil) != (other.Description == nil) || (sp.Description != nil && *sp.Description != *other.Description) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the SimpleProduct to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (sp *SimpleProduct) MergeFrom(patch SimpleProduct) {
+	if patch.SimpleProductProperties != nil {
+		if sp.SimpleProductProperties == nil {
+			sp.SimpleProductProperties = &SimpleProductProperties{}
+		}
+		sp.SimpleProductProperties.MergeFrom(*patch.SimpleProductProperties)
+	}
+	if patch.ProductID != nil {
+		v := *patch.ProductID
+		sp.ProductID = &v
+	}
+	if patch.Description != nil {
+		v := *patch.Description
+		sp.Description = &v
+	}
+}
+
 // SimpleProductProperties the product documentation.
 type SimpleProductProperties struct {
 	// MaxProductDisplayName - Display name of product.
@@ -486,8 +1856,184 @@ func (spp *SimpleProductProperties) UnmarshalJSON(body []byte) error {
 	return nil
 }
 
+// Validate checks that the SimpleProductProperties satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (spp SimpleProductProperties) Validate() error {
+	var errs ValidationErrors
+	if spp.MaxProductDisplayName == nil {
+		errs = append(errs, ValidationError{Path: "max_product_display_name", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if spp.Capacity == nil {
+		errs = append(errs, ValidationError{Path: "max_product_capacity", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// DeepCopy returns a copy of the SimpleProductProperties that doesn't share any pointers, slices or maps with it.
+func (spp SimpleProductProperties) DeepCopy() SimpleProductProperties {
+	result := spp
+	if spp.MaxProductDisplayName != nil {
+		v := *spp.MaxProductDisplayName
+		result.MaxProductDisplayName = &v
+	}
+	if spp.Capacity != nil {
+		v := *spp.Capacity
+		result.Capacity = &v
+	}
+	if spp.ProductURL != nil {
+		v := spp.ProductURL.DeepCopy()
+		result.ProductURL = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the SimpleProductProperties and other are equal.  Times are equal if they
+// represent the same instant.
+func (spp SimpleProductProperties) Equal(other SimpleProductProperties) bool {
+	return spp.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (spp SimpleProductProperties) EqualIgnoreReadOnly(other SimpleProductProperties) bool {
+	return spp.equal(other, true)
+}
+
+// equal compares the fields of the SimpleProductProperties and other, skipping the read-only ones if ignoreReadOnly is true.
+func (spp SimpleProductProperties) equal(other SimpleProductProperties, ignoreReadOnly bool) bool {
+	if (spp.MaxProductDisplayName == nil) != (other.MaxProductDisplayName == nil) || (spp.MaxProductDisplayName != nil && *spp.MaxProductDisplayName != *other.MaxProductDisplayName) {
+		return false
+	}
+	if (spp.Capacity == nil) != (other.Capacity == nil) || (spp.Capacity != nil && *spp.Capacity != *other.Capacity) {
+		return false
+	}
+	if (spp.ProductURL == nil) != (other.ProductURL == nil) || (spp.ProductURL != nil && !spp.ProductURL.equal(*other.ProductURL, ignoreReadOnly)) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the SimpleProductProperties to copies of the fields set in patch.  Nested models and
+// maps are merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (spp *SimpleProductProperties) MergeFrom(patch SimpleProductProperties) {
+	if patch.MaxProductDisplayName != nil {
+		v := *patch.MaxProductDisplayName
+		spp.MaxProductDisplayName = &v
+	}
+	if patch.Capacity != nil {
+		v := *patch.Capacity
+		spp.Capacity = &v
+	}
+	if patch.ProductURL != nil {
+		if spp.ProductURL == nil {
+			spp.ProductURL = &ProductURL{}
+		}
+		spp.ProductURL.MergeFrom(*patch.ProductURL)
+	}
+}
+
+// ValidationError describes a value that violates one of the constraints defined by the service.
+type ValidationError struct {
+	// Path - the JSON path of the value, relative to the value being validated.
+	Path string
+	// Constraint - the name of the violated constraint, e.g. MaxLength.
+	Constraint string
+	// Details - describes the violation.
+	Details string
+}
+
+// Error implements the error interface for type ValidationError.
+func (ve ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s (%s)", ve.Path, ve.Details, ve.Constraint)
+}
+
+// ValidationErrors contains every constraint violation found when validating a value.
+type ValidationErrors []ValidationError
+
+// Error implements the error interface for type ValidationErrors.
+func (ve ValidationErrors) Error() string {
+	msgs := make([]string, len(ve))
+	for i := range ve {
+		msgs[i] = ve[i].Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// addNested appends the violations in err, as returned by the Validate method
+// of the value at path, to ve after prefixing their paths with path.
+func (ve ValidationErrors) addNested(path string, err error) ValidationErrors {
+	if nested, ok := err.(ValidationErrors); ok {
+		for _, e := range nested {
+			e.Path = path + "." + e.Path
+			ve = append(ve, e)
+		}
+	} else if err != nil {
+		ve = append(ve, ValidationError{Path: path, Details: err.Error()})
+	}
+	return ve
+}
+
+// ParameterValidationError is returned by an operation whose parameters violate the constraints defined
+// by the service.  Use errors.As to retrieve the validation.Error or the ValidationErrors it wraps.
+type ParameterValidationError struct {
+	// Err - identifies the operation, its message lists every violation.
+	Err validation.Error
+	// Errors - contains every violation.
+	Errors ValidationErrors
+}
+
+// Error implements the error interface for type ParameterValidationError.
+func (pve ParameterValidationError) Error() string {
+	return pve.Err.Error()
+}
+
+// Unwrap returns the validation.Error and the ValidationErrors wrapped by the ParameterValidationError.
+func (pve ParameterValidationError) Unwrap() []error {
+	return []error{pve.Err, pve.Errors}
+}
+
 // WrappedProduct the wrapped produc.
 type WrappedProduct struct {
 	// Value - the product value
 	Value *string `json:"value,omitempty"`
 }
+
+// DeepCopy returns a copy of the WrappedProduct that doesn't share any pointers, slices or maps with it.
+func (wp WrappedProduct) DeepCopy() WrappedProduct {
+	result := wp
+	if wp.Value != nil {
+		v := *wp.Value
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the WrappedProduct and other are equal.  Times are equal if they represent the
+// same instant.
+func (wp WrappedProduct) Equal(other WrappedProduct) bool {
+	return wp.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (wp WrappedProduct) EqualIgnoreReadOnly(other WrappedProduct) bool {
+	return wp.equal(other, true)
+}
+
+// equal compares the fields of the WrappedProduct and other, skipping the read-only ones if ignoreReadOnly is true.
+func (wp WrappedProduct) equal(other WrappedProduct, ignoreReadOnly bool) bool {
+	if (wp.Value == nil) != (other.Value == nil) || (wp.Value != nil && *wp.Value != *other.Value) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the WrappedProduct to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (wp *WrappedProduct) MergeFrom(patch WrappedProduct) {
+	if patch.Value != nil {
+		v := *patch.Value
+		wp.Value = &v
+	}
+}
@@ -9,8 +9,6 @@ package morecustombaseurigroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -24,6 +22,13 @@ func NewPathsClient(subscriptionID string) PathsClient {
 	return PathsClient{New(subscriptionID)}
 }
 
+// NewPathsClientWithOptions creates an instance of the PathsClient client with the specified options.  It returns an
+// error if the options are invalid.
+func NewPathsClientWithOptions(opts ClientOptions) (PathsClient, error) {
+	client, err := NewWithOptions(opts)
+	return PathsClient{client}, err
+}
+
 // GetEmpty get a 200 to test a valid base uri
 // Parameters:
 // vault - the vault name, e.g. https://myvault
@@ -31,14 +36,21 @@ func NewPathsClient(subscriptionID string) PathsClient {
 // keyName - the key name with value 'key1'.
 // keyVersion - the key version. Default value 'v1'.
 func (client PathsClient) GetEmpty(ctx context.Context, vault string, secret string, keyName string, keyVersion string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.GetEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.GetEmpty", "GET", "/customuri/{subscriptionId}/{keyName}")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.GetEmpty", map[string]interface{}{
+			"vault":      vault,
+			"secret":     secret,
+			"keyName":    keyName,
+			"keyVersion": keyVersion,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetEmptyPreparer(ctx, vault, secret, keyName, keyVersion)
@@ -55,7 +67,7 @@ func (client PathsClient) GetEmpty(ctx context.Context, vault string, secret str
 	}
 
 	result, err = client.GetEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "morecustombaseurigroup.PathsClient", "GetEmpty", resp, "Failure responding to request")
 	}
 
@@ -87,14 +99,15 @@ func (client PathsClient) GetEmptyPreparer(ctx context.Context, vault string, se
 		autorest.WithCustomBaseURL("{vault}{secret}{dnsSuffix}", urlParameters),
 		autorest.WithPathParameters("/customuri/{subscriptionId}/{keyName}", pathParameters),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetEmptySender sends the GetEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) GetEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetEmptyResponder handles the response to the GetEmpty request. The method always
@@ -103,7 +116,7 @@ func (client PathsClient) GetEmptyResponder(resp *http.Response) (result autores
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -9,8 +9,6 @@ package numbergroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"github.com/shopspring/decimal"
 	"net/http"
 )
@@ -30,16 +28,25 @@ func NewNumberClientWithBaseURI(baseURI string) NumberClient {
 	return NumberClient{NewWithBaseURI(baseURI)}
 }
 
+// NewNumberClientWithOptions creates an instance of the NumberClient client with the specified options.  It returns an
+// error if the options are invalid.
+func NewNumberClientWithOptions(opts ClientOptions) (NumberClient, error) {
+	client, err := NewWithOptions(opts)
+	return NumberClient{client}, err
+}
+
 // GetBigDecimal get big decimal value 2.5976931e+101
 func (client NumberClient) GetBigDecimal(ctx context.Context) (result Decimal, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.GetBigDecimal")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.GetBigDecimal", "GET", "/number/big/decimal/2.5976931e+101")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.GetBigDecimal", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetBigDecimalPreparer(ctx)
@@ -56,7 +63,7 @@ func (client NumberClient) GetBigDecimal(ctx context.Context) (result Decimal, e
 	}
 
 	result, err = client.GetBigDecimalResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "GetBigDecimal", resp, "Failure responding to request")
 	}
 
@@ -69,14 +76,15 @@ func (client NumberClient) GetBigDecimalPreparer(ctx context.Context) (*http.Req
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/big/decimal/2.5976931e+101"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetBigDecimalSender sends the GetBigDecimal request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) GetBigDecimalSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetBigDecimalResponder handles the response to the GetBigDecimal request. The method always
@@ -85,7 +93,7 @@ func (client NumberClient) GetBigDecimalResponder(resp *http.Response) (result D
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -94,14 +102,16 @@ func (client NumberClient) GetBigDecimalResponder(resp *http.Response) (result D
 
 // GetBigDecimalNegativeDecimal get big decimal value -99999999.99
 func (client NumberClient) GetBigDecimalNegativeDecimal(ctx context.Context) (result Decimal, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.GetBigDecimalNegativeDecimal")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.GetBigDecimalNegativeDecimal", "GET", "/number/big/decimal/-99999999.99")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.GetBigDecimalNegativeDecimal", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetBigDecimalNegativeDecimalPreparer(ctx)
@@ -118,7 +128,7 @@ func (client NumberClient) GetBigDecimalNegativeDecimal(ctx context.Context) (re
 	}
 
 	result, err = client.GetBigDecimalNegativeDecimalResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "GetBigDecimalNegativeDecimal", resp, "Failure responding to request")
 	}
 
@@ -131,14 +141,15 @@ func (client NumberClient) GetBigDecimalNegativeDecimalPreparer(ctx context.Cont
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/big/decimal/-99999999.99"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetBigDecimalNegativeDecimalSender sends the GetBigDecimalNegativeDecimal request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) GetBigDecimalNegativeDecimalSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetBigDecimalNegativeDecimalResponder handles the response to the GetBigDecimalNegativeDecimal request. The method always
@@ -147,7 +158,7 @@ func (client NumberClient) GetBigDecimalNegativeDecimalResponder(resp *http.Resp
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -156,14 +167,16 @@ func (client NumberClient) GetBigDecimalNegativeDecimalResponder(resp *http.Resp
 
 // GetBigDecimalPositiveDecimal get big decimal value 99999999.99
 func (client NumberClient) GetBigDecimalPositiveDecimal(ctx context.Context) (result Decimal, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.GetBigDecimalPositiveDecimal")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.GetBigDecimalPositiveDecimal", "GET", "/number/big/decimal/99999999.99")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.GetBigDecimalPositiveDecimal", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetBigDecimalPositiveDecimalPreparer(ctx)
@@ -180,7 +193,7 @@ func (client NumberClient) GetBigDecimalPositiveDecimal(ctx context.Context) (re
 	}
 
 	result, err = client.GetBigDecimalPositiveDecimalResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "GetBigDecimalPositiveDecimal", resp, "Failure responding to request")
 	}
 
@@ -193,14 +206,15 @@ func (client NumberClient) GetBigDecimalPositiveDecimalPreparer(ctx context.Cont
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/big/decimal/99999999.99"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetBigDecimalPositiveDecimalSender sends the GetBigDecimalPositiveDecimal request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) GetBigDecimalPositiveDecimalSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetBigDecimalPositiveDecimalResponder handles the response to the GetBigDecimalPositiveDecimal request. The method always
@@ -209,7 +223,7 @@ func (client NumberClient) GetBigDecimalPositiveDecimalResponder(resp *http.Resp
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -218,14 +232,16 @@ func (client NumberClient) GetBigDecimalPositiveDecimalResponder(resp *http.Resp
 
 // GetBigDouble get big double value 2.5976931e+101
 func (client NumberClient) GetBigDouble(ctx context.Context) (result Float64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.GetBigDouble")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.GetBigDouble", "GET", "/number/big/double/2.5976931e+101")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.GetBigDouble", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetBigDoublePreparer(ctx)
@@ -242,7 +258,7 @@ func (client NumberClient) GetBigDouble(ctx context.Context) (result Float64, er
 	}
 
 	result, err = client.GetBigDoubleResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "GetBigDouble", resp, "Failure responding to request")
 	}
 
@@ -255,14 +271,15 @@ func (client NumberClient) GetBigDoublePreparer(ctx context.Context) (*http.Requ
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/big/double/2.5976931e+101"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetBigDoubleSender sends the GetBigDouble request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) GetBigDoubleSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetBigDoubleResponder handles the response to the GetBigDouble request. The method always
@@ -271,7 +288,7 @@ func (client NumberClient) GetBigDoubleResponder(resp *http.Response) (result Fl
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -280,14 +297,16 @@ func (client NumberClient) GetBigDoubleResponder(resp *http.Response) (result Fl
 
 // GetBigDoubleNegativeDecimal get big double value -99999999.99
 func (client NumberClient) GetBigDoubleNegativeDecimal(ctx context.Context) (result Float64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.GetBigDoubleNegativeDecimal")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.GetBigDoubleNegativeDecimal", "GET", "/number/big/double/-99999999.99")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.GetBigDoubleNegativeDecimal", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetBigDoubleNegativeDecimalPreparer(ctx)
@@ -304,7 +323,7 @@ func (client NumberClient) GetBigDoubleNegativeDecimal(ctx context.Context) (res
 	}
 
 	result, err = client.GetBigDoubleNegativeDecimalResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "GetBigDoubleNegativeDecimal", resp, "Failure responding to request")
 	}
 
@@ -317,14 +336,15 @@ func (client NumberClient) GetBigDoubleNegativeDecimalPreparer(ctx context.Conte
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/big/double/-99999999.99"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetBigDoubleNegativeDecimalSender sends the GetBigDoubleNegativeDecimal request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) GetBigDoubleNegativeDecimalSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetBigDoubleNegativeDecimalResponder handles the response to the GetBigDoubleNegativeDecimal request. The method always
@@ -333,7 +353,7 @@ func (client NumberClient) GetBigDoubleNegativeDecimalResponder(resp *http.Respo
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -342,14 +362,16 @@ func (client NumberClient) GetBigDoubleNegativeDecimalResponder(resp *http.Respo
 
 // GetBigDoublePositiveDecimal get big double value 99999999.99
 func (client NumberClient) GetBigDoublePositiveDecimal(ctx context.Context) (result Float64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.GetBigDoublePositiveDecimal")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.GetBigDoublePositiveDecimal", "GET", "/number/big/double/99999999.99")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.GetBigDoublePositiveDecimal", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetBigDoublePositiveDecimalPreparer(ctx)
@@ -366,7 +388,7 @@ func (client NumberClient) GetBigDoublePositiveDecimal(ctx context.Context) (res
 	}
 
 	result, err = client.GetBigDoublePositiveDecimalResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "GetBigDoublePositiveDecimal", resp, "Failure responding to request")
 	}
 
@@ -379,14 +401,15 @@ func (client NumberClient) GetBigDoublePositiveDecimalPreparer(ctx context.Conte
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/big/double/99999999.99"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetBigDoublePositiveDecimalSender sends the GetBigDoublePositiveDecimal request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) GetBigDoublePositiveDecimalSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetBigDoublePositiveDecimalResponder handles the response to the GetBigDoublePositiveDecimal request. The method always
@@ -395,7 +418,7 @@ func (client NumberClient) GetBigDoublePositiveDecimalResponder(resp *http.Respo
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -404,14 +427,16 @@ func (client NumberClient) GetBigDoublePositiveDecimalResponder(resp *http.Respo
 
 // GetBigFloat get big float value 3.402823e+20
 func (client NumberClient) GetBigFloat(ctx context.Context) (result Float64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.GetBigFloat")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.GetBigFloat", "GET", "/number/big/float/3.402823e+20")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.GetBigFloat", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetBigFloatPreparer(ctx)
@@ -428,7 +453,7 @@ func (client NumberClient) GetBigFloat(ctx context.Context) (result Float64, err
 	}
 
 	result, err = client.GetBigFloatResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "GetBigFloat", resp, "Failure responding to request")
 	}
 
@@ -441,14 +466,15 @@ func (client NumberClient) GetBigFloatPreparer(ctx context.Context) (*http.Reque
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/big/float/3.402823e+20"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetBigFloatSender sends the GetBigFloat request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) GetBigFloatSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetBigFloatResponder handles the response to the GetBigFloat request. The method always
@@ -457,7 +483,7 @@ func (client NumberClient) GetBigFloatResponder(resp *http.Response) (result Flo
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -466,14 +492,16 @@ func (client NumberClient) GetBigFloatResponder(resp *http.Response) (result Flo
 
 // GetInvalidDecimal get invalid decimal Number value
 func (client NumberClient) GetInvalidDecimal(ctx context.Context) (result Decimal, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.GetInvalidDecimal")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.GetInvalidDecimal", "GET", "/number/invaliddecimal")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.GetInvalidDecimal", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetInvalidDecimalPreparer(ctx)
@@ -490,7 +518,7 @@ func (client NumberClient) GetInvalidDecimal(ctx context.Context) (result Decima
 	}
 
 	result, err = client.GetInvalidDecimalResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "GetInvalidDecimal", resp, "Failure responding to request")
 	}
 
@@ -503,14 +531,15 @@ func (client NumberClient) GetInvalidDecimalPreparer(ctx context.Context) (*http
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/invaliddecimal"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetInvalidDecimalSender sends the GetInvalidDecimal request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) GetInvalidDecimalSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetInvalidDecimalResponder handles the response to the GetInvalidDecimal request. The method always
@@ -519,7 +548,7 @@ func (client NumberClient) GetInvalidDecimalResponder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -528,14 +557,16 @@ func (client NumberClient) GetInvalidDecimalResponder(resp *http.Response) (resu
 
 // GetInvalidDouble get invalid double Number value
 func (client NumberClient) GetInvalidDouble(ctx context.Context) (result Float64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.GetInvalidDouble")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.GetInvalidDouble", "GET", "/number/invaliddouble")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.GetInvalidDouble", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetInvalidDoublePreparer(ctx)
@@ -552,7 +583,7 @@ func (client NumberClient) GetInvalidDouble(ctx context.Context) (result Float64
 	}
 
 	result, err = client.GetInvalidDoubleResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "GetInvalidDouble", resp, "Failure responding to request")
 	}
 
@@ -565,14 +596,15 @@ func (client NumberClient) GetInvalidDoublePreparer(ctx context.Context) (*http.
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/invaliddouble"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetInvalidDoubleSender sends the GetInvalidDouble request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) GetInvalidDoubleSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetInvalidDoubleResponder handles the response to the GetInvalidDouble request. The method always
@@ -581,7 +613,7 @@ func (client NumberClient) GetInvalidDoubleResponder(resp *http.Response) (resul
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -590,14 +622,16 @@ func (client NumberClient) GetInvalidDoubleResponder(resp *http.Response) (resul
 
 // GetInvalidFloat get invalid float Number value
 func (client NumberClient) GetInvalidFloat(ctx context.Context) (result Float64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.GetInvalidFloat")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.GetInvalidFloat", "GET", "/number/invalidfloat")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.GetInvalidFloat", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetInvalidFloatPreparer(ctx)
@@ -614,7 +648,7 @@ func (client NumberClient) GetInvalidFloat(ctx context.Context) (result Float64,
 	}
 
 	result, err = client.GetInvalidFloatResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "GetInvalidFloat", resp, "Failure responding to request")
 	}
 
@@ -627,14 +661,15 @@ func (client NumberClient) GetInvalidFloatPreparer(ctx context.Context) (*http.R
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/invalidfloat"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetInvalidFloatSender sends the GetInvalidFloat request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) GetInvalidFloatSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetInvalidFloatResponder handles the response to the GetInvalidFloat request. The method always
@@ -643,7 +678,7 @@ func (client NumberClient) GetInvalidFloatResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -652,14 +687,16 @@ func (client NumberClient) GetInvalidFloatResponder(resp *http.Response) (result
 
 // GetNull get null Number value
 func (client NumberClient) GetNull(ctx context.Context) (result Float64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.GetNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.GetNull", "GET", "/number/null")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.GetNull", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetNullPreparer(ctx)
@@ -676,7 +713,7 @@ func (client NumberClient) GetNull(ctx context.Context) (result Float64, err err
 	}
 
 	result, err = client.GetNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "GetNull", resp, "Failure responding to request")
 	}
 
@@ -689,14 +726,15 @@ func (client NumberClient) GetNullPreparer(ctx context.Context) (*http.Request,
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/null"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetNullSender sends the GetNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) GetNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetNullResponder handles the response to the GetNull request. The method always
@@ -705,7 +743,7 @@ func (client NumberClient) GetNullResponder(resp *http.Response) (result Float64
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -714,14 +752,16 @@ func (client NumberClient) GetNullResponder(resp *http.Response) (result Float64
 
 // GetSmallDecimal get small decimal value 2.5976931e-101
 func (client NumberClient) GetSmallDecimal(ctx context.Context) (result Decimal, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.GetSmallDecimal")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.GetSmallDecimal", "GET", "/number/small/decimal/2.5976931e-101")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.GetSmallDecimal", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetSmallDecimalPreparer(ctx)
@@ -738,7 +778,7 @@ func (client NumberClient) GetSmallDecimal(ctx context.Context) (result Decimal,
 	}
 
 	result, err = client.GetSmallDecimalResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "GetSmallDecimal", resp, "Failure responding to request")
 	}
 
@@ -751,14 +791,15 @@ func (client NumberClient) GetSmallDecimalPreparer(ctx context.Context) (*http.R
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/small/decimal/2.5976931e-101"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetSmallDecimalSender sends the GetSmallDecimal request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) GetSmallDecimalSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetSmallDecimalResponder handles the response to the GetSmallDecimal request. The method always
@@ -767,7 +808,7 @@ func (client NumberClient) GetSmallDecimalResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -776,14 +817,16 @@ func (client NumberClient) GetSmallDecimalResponder(resp *http.Response) (result
 
 // GetSmallDouble get big double value 2.5976931e-101
 func (client NumberClient) GetSmallDouble(ctx context.Context) (result Float64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.GetSmallDouble")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.GetSmallDouble", "GET", "/number/small/double/2.5976931e-101")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.GetSmallDouble", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetSmallDoublePreparer(ctx)
@@ -800,7 +843,7 @@ func (client NumberClient) GetSmallDouble(ctx context.Context) (result Float64,
 	}
 
 	result, err = client.GetSmallDoubleResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "GetSmallDouble", resp, "Failure responding to request")
 	}
 
@@ -813,14 +856,15 @@ func (client NumberClient) GetSmallDoublePreparer(ctx context.Context) (*http.Re
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/small/double/2.5976931e-101"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetSmallDoubleSender sends the GetSmallDouble request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) GetSmallDoubleSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetSmallDoubleResponder handles the response to the GetSmallDouble request. The method always
@@ -829,7 +873,7 @@ func (client NumberClient) GetSmallDoubleResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -838,14 +882,16 @@ func (client NumberClient) GetSmallDoubleResponder(resp *http.Response) (result
 
 // GetSmallFloat get big double value 3.402823e-20
 func (client NumberClient) GetSmallFloat(ctx context.Context) (result Float64, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.GetSmallFloat")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.GetSmallFloat", "GET", "/number/small/float/3.402823e-20")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.GetSmallFloat", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetSmallFloatPreparer(ctx)
@@ -862,7 +908,7 @@ func (client NumberClient) GetSmallFloat(ctx context.Context) (result Float64, e
 	}
 
 	result, err = client.GetSmallFloatResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "GetSmallFloat", resp, "Failure responding to request")
 	}
 
@@ -875,14 +921,15 @@ func (client NumberClient) GetSmallFloatPreparer(ctx context.Context) (*http.Req
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/small/float/3.402823e-20"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetSmallFloatSender sends the GetSmallFloat request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) GetSmallFloatSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetSmallFloatResponder handles the response to the GetSmallFloat request. The method always
@@ -891,7 +938,7 @@ func (client NumberClient) GetSmallFloatResponder(resp *http.Response) (result F
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -900,14 +947,16 @@ func (client NumberClient) GetSmallFloatResponder(resp *http.Response) (result F
 
 // PutBigDecimal put big decimal value 2.5976931e+101
 func (client NumberClient) PutBigDecimal(ctx context.Context, numberBody decimal.Decimal) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.PutBigDecimal")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.PutBigDecimal", "PUT", "/number/big/decimal/2.5976931e+101")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.PutBigDecimal", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutBigDecimalPreparer(ctx, numberBody)
@@ -924,7 +973,7 @@ func (client NumberClient) PutBigDecimal(ctx context.Context, numberBody decimal
 	}
 
 	result, err = client.PutBigDecimalResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "PutBigDecimal", resp, "Failure responding to request")
 	}
 
@@ -939,14 +988,15 @@ func (client NumberClient) PutBigDecimalPreparer(ctx context.Context, numberBody
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/big/decimal/2.5976931e+101"),
 		autorest.WithJSON(numberBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutBigDecimalSender sends the PutBigDecimal request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) PutBigDecimalSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutBigDecimalResponder handles the response to the PutBigDecimal request. The method always
@@ -955,7 +1005,7 @@ func (client NumberClient) PutBigDecimalResponder(resp *http.Response) (result a
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -963,14 +1013,16 @@ func (client NumberClient) PutBigDecimalResponder(resp *http.Response) (result a
 
 // PutBigDecimalNegativeDecimal put big decimal value -99999999.99
 func (client NumberClient) PutBigDecimalNegativeDecimal(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.PutBigDecimalNegativeDecimal")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.PutBigDecimalNegativeDecimal", "PUT", "/number/big/decimal/-99999999.99")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.PutBigDecimalNegativeDecimal", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutBigDecimalNegativeDecimalPreparer(ctx)
@@ -987,7 +1039,7 @@ func (client NumberClient) PutBigDecimalNegativeDecimal(ctx context.Context) (re
 	}
 
 	result, err = client.PutBigDecimalNegativeDecimalResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "PutBigDecimalNegativeDecimal", resp, "Failure responding to request")
 	}
 
@@ -1002,14 +1054,15 @@ func (client NumberClient) PutBigDecimalNegativeDecimalPreparer(ctx context.Cont
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/big/decimal/-99999999.99"),
 		autorest.WithJSON(-99999999.99))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutBigDecimalNegativeDecimalSender sends the PutBigDecimalNegativeDecimal request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) PutBigDecimalNegativeDecimalSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutBigDecimalNegativeDecimalResponder handles the response to the PutBigDecimalNegativeDecimal request. The method always
@@ -1018,7 +1071,7 @@ func (client NumberClient) PutBigDecimalNegativeDecimalResponder(resp *http.Resp
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1026,14 +1079,16 @@ func (client NumberClient) PutBigDecimalNegativeDecimalResponder(resp *http.Resp
 
 // PutBigDecimalPositiveDecimal put big decimal value 99999999.99
 func (client NumberClient) PutBigDecimalPositiveDecimal(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.PutBigDecimalPositiveDecimal")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.PutBigDecimalPositiveDecimal", "PUT", "/number/big/decimal/99999999.99")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.PutBigDecimalPositiveDecimal", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutBigDecimalPositiveDecimalPreparer(ctx)
@@ -1050,7 +1105,7 @@ func (client NumberClient) PutBigDecimalPositiveDecimal(ctx context.Context) (re
 	}
 
 	result, err = client.PutBigDecimalPositiveDecimalResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "PutBigDecimalPositiveDecimal", resp, "Failure responding to request")
 	}
 
@@ -1065,14 +1120,15 @@ func (client NumberClient) PutBigDecimalPositiveDecimalPreparer(ctx context.Cont
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/big/decimal/99999999.99"),
 		autorest.WithJSON(99999999.99))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutBigDecimalPositiveDecimalSender sends the PutBigDecimalPositiveDecimal request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) PutBigDecimalPositiveDecimalSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutBigDecimalPositiveDecimalResponder handles the response to the PutBigDecimalPositiveDecimal request. The method always
@@ -1081,7 +1137,7 @@ func (client NumberClient) PutBigDecimalPositiveDecimalResponder(resp *http.Resp
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1089,14 +1145,16 @@ func (client NumberClient) PutBigDecimalPositiveDecimalResponder(resp *http.Resp
 
 // PutBigDouble put big double value 2.5976931e+101
 func (client NumberClient) PutBigDouble(ctx context.Context, numberBody float64) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.PutBigDouble")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.PutBigDouble", "PUT", "/number/big/double/2.5976931e+101")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.PutBigDouble", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutBigDoublePreparer(ctx, numberBody)
@@ -1113,7 +1171,7 @@ func (client NumberClient) PutBigDouble(ctx context.Context, numberBody float64)
 	}
 
 	result, err = client.PutBigDoubleResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "PutBigDouble", resp, "Failure responding to request")
 	}
 
@@ -1128,14 +1186,15 @@ func (client NumberClient) PutBigDoublePreparer(ctx context.Context, numberBody
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/big/double/2.5976931e+101"),
 		autorest.WithJSON(numberBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutBigDoubleSender sends the PutBigDouble request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) PutBigDoubleSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutBigDoubleResponder handles the response to the PutBigDouble request. The method always
@@ -1144,7 +1203,7 @@ func (client NumberClient) PutBigDoubleResponder(resp *http.Response) (result au
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1152,14 +1211,16 @@ func (client NumberClient) PutBigDoubleResponder(resp *http.Response) (result au
 
 // PutBigDoubleNegativeDecimal put big double value -99999999.99
 func (client NumberClient) PutBigDoubleNegativeDecimal(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.PutBigDoubleNegativeDecimal")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.PutBigDoubleNegativeDecimal", "PUT", "/number/big/double/-99999999.99")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.PutBigDoubleNegativeDecimal", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutBigDoubleNegativeDecimalPreparer(ctx)
@@ -1176,7 +1237,7 @@ func (client NumberClient) PutBigDoubleNegativeDecimal(ctx context.Context) (res
 	}
 
 	result, err = client.PutBigDoubleNegativeDecimalResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "PutBigDoubleNegativeDecimal", resp, "Failure responding to request")
 	}
 
@@ -1191,14 +1252,15 @@ func (client NumberClient) PutBigDoubleNegativeDecimalPreparer(ctx context.Conte
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/big/double/-99999999.99"),
 		autorest.WithJSON(-99999999.99))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutBigDoubleNegativeDecimalSender sends the PutBigDoubleNegativeDecimal request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) PutBigDoubleNegativeDecimalSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutBigDoubleNegativeDecimalResponder handles the response to the PutBigDoubleNegativeDecimal request. The method always
@@ -1207,7 +1269,7 @@ func (client NumberClient) PutBigDoubleNegativeDecimalResponder(resp *http.Respo
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1215,14 +1277,16 @@ func (client NumberClient) PutBigDoubleNegativeDecimalResponder(resp *http.Respo
 
 // PutBigDoublePositiveDecimal put big double value 99999999.99
 func (client NumberClient) PutBigDoublePositiveDecimal(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.PutBigDoublePositiveDecimal")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.PutBigDoublePositiveDecimal", "PUT", "/number/big/double/99999999.99")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.PutBigDoublePositiveDecimal", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutBigDoublePositiveDecimalPreparer(ctx)
@@ -1239,7 +1303,7 @@ func (client NumberClient) PutBigDoublePositiveDecimal(ctx context.Context) (res
 	}
 
 	result, err = client.PutBigDoublePositiveDecimalResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "PutBigDoublePositiveDecimal", resp, "Failure responding to request")
 	}
 
@@ -1254,14 +1318,15 @@ func (client NumberClient) PutBigDoublePositiveDecimalPreparer(ctx context.Conte
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/big/double/99999999.99"),
 		autorest.WithJSON(99999999.99))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutBigDoublePositiveDecimalSender sends the PutBigDoublePositiveDecimal request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) PutBigDoublePositiveDecimalSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutBigDoublePositiveDecimalResponder handles the response to the PutBigDoublePositiveDecimal request. The method always
@@ -1270,7 +1335,7 @@ func (client NumberClient) PutBigDoublePositiveDecimalResponder(resp *http.Respo
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1278,14 +1343,16 @@ func (client NumberClient) PutBigDoublePositiveDecimalResponder(resp *http.Respo
 
 // PutBigFloat put big float value 3.402823e+20
 func (client NumberClient) PutBigFloat(ctx context.Context, numberBody float64) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.PutBigFloat")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.PutBigFloat", "PUT", "/number/big/float/3.402823e+20")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.PutBigFloat", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutBigFloatPreparer(ctx, numberBody)
@@ -1302,7 +1369,7 @@ func (client NumberClient) PutBigFloat(ctx context.Context, numberBody float64)
 	}
 
 	result, err = client.PutBigFloatResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "PutBigFloat", resp, "Failure responding to request")
 	}
 
@@ -1317,14 +1384,15 @@ func (client NumberClient) PutBigFloatPreparer(ctx context.Context, numberBody f
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/big/float/3.402823e+20"),
 		autorest.WithJSON(numberBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutBigFloatSender sends the PutBigFloat request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) PutBigFloatSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutBigFloatResponder handles the response to the PutBigFloat request. The method always
@@ -1333,7 +1401,7 @@ func (client NumberClient) PutBigFloatResponder(resp *http.Response) (result aut
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1341,14 +1409,16 @@ func (client NumberClient) PutBigFloatResponder(resp *http.Response) (result aut
 
 // PutSmallDecimal put small decimal value 2.5976931e-101
 func (client NumberClient) PutSmallDecimal(ctx context.Context, numberBody decimal.Decimal) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.PutSmallDecimal")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.PutSmallDecimal", "PUT", "/number/small/decimal/2.5976931e-101")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.PutSmallDecimal", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutSmallDecimalPreparer(ctx, numberBody)
@@ -1365,7 +1435,7 @@ func (client NumberClient) PutSmallDecimal(ctx context.Context, numberBody decim
 	}
 
 	result, err = client.PutSmallDecimalResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "PutSmallDecimal", resp, "Failure responding to request")
 	}
 
@@ -1380,14 +1450,15 @@ func (client NumberClient) PutSmallDecimalPreparer(ctx context.Context, numberBo
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/small/decimal/2.5976931e-101"),
 		autorest.WithJSON(numberBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutSmallDecimalSender sends the PutSmallDecimal request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) PutSmallDecimalSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutSmallDecimalResponder handles the response to the PutSmallDecimal request. The method always
@@ -1396,7 +1467,7 @@ func (client NumberClient) PutSmallDecimalResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1404,14 +1475,16 @@ func (client NumberClient) PutSmallDecimalResponder(resp *http.Response) (result
 
 // PutSmallDouble put small double value 2.5976931e-101
 func (client NumberClient) PutSmallDouble(ctx context.Context, numberBody float64) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.PutSmallDouble")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.PutSmallDouble", "PUT", "/number/small/double/2.5976931e-101")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.PutSmallDouble", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutSmallDoublePreparer(ctx, numberBody)
@@ -1428,7 +1501,7 @@ func (client NumberClient) PutSmallDouble(ctx context.Context, numberBody float6
 	}
 
 	result, err = client.PutSmallDoubleResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "PutSmallDouble", resp, "Failure responding to request")
 	}
 
@@ -1443,14 +1516,15 @@ func (client NumberClient) PutSmallDoublePreparer(ctx context.Context, numberBod
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/small/double/2.5976931e-101"),
 		autorest.WithJSON(numberBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutSmallDoubleSender sends the PutSmallDouble request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) PutSmallDoubleSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutSmallDoubleResponder handles the response to the PutSmallDouble request. The method always
@@ -1459,7 +1533,7 @@ func (client NumberClient) PutSmallDoubleResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1467,14 +1541,16 @@ func (client NumberClient) PutSmallDoubleResponder(resp *http.Response) (result
 
 // PutSmallFloat put small float value 3.402823e-20
 func (client NumberClient) PutSmallFloat(ctx context.Context, numberBody float64) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/NumberClient.PutSmallFloat")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/NumberClient.PutSmallFloat", "PUT", "/number/small/float/3.402823e-20")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "NumberClient.PutSmallFloat", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutSmallFloatPreparer(ctx, numberBody)
@@ -1491,7 +1567,7 @@ func (client NumberClient) PutSmallFloat(ctx context.Context, numberBody float64
 	}
 
 	result, err = client.PutSmallFloatResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "numbergroup.NumberClient", "PutSmallFloat", resp, "Failure responding to request")
 	}
 
@@ -1506,14 +1582,15 @@ func (client NumberClient) PutSmallFloatPreparer(ctx context.Context, numberBody
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/number/small/float/3.402823e-20"),
 		autorest.WithJSON(numberBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutSmallFloatSender sends the PutSmallFloat request. The method will close the
 // http.Response Body if it receives an error.
 func (client NumberClient) PutSmallFloatSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutSmallFloatResponder handles the response to the PutSmallFloat request. The method always
@@ -1522,7 +1599,7 @@ func (client NumberClient) PutSmallFloatResponder(resp *http.Response) (result a
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
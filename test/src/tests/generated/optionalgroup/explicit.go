@@ -9,9 +9,7 @@ package optionalgroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/validation"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -30,19 +28,33 @@ func NewExplicitClientWithBaseURI(baseURI string, requiredGlobalPath string, req
 	return ExplicitClient{NewWithBaseURI(baseURI, requiredGlobalPath, requiredGlobalQuery, optionalGlobalQuery)}
 }
 
+// NewExplicitClientWithOptions creates an instance of the ExplicitClient client with the specified options.  It returns
+// an error if the options are invalid.
+func NewExplicitClientWithOptions(opts ClientOptions) (ExplicitClient, error) {
+	client, err := NewWithOptions(opts)
+	return ExplicitClient{client}, err
+}
+
 // PostOptionalArrayHeader test explicitly optional integer. Please put a header 'headerParameter' => null.
-func (client ExplicitClient) PostOptionalArrayHeader(ctx context.Context, headerParameter []string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ExplicitClient.PostOptionalArrayHeader")
+func (client ExplicitClient) PostOptionalArrayHeader(ctx context.Context, options *ExplicitPostOptionalArrayHeaderOptions) (result autorest.Response, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ExplicitClient.PostOptionalArrayHeader", "POST", "/reqopt/optional/array/header")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if options == nil {
+		options = &ExplicitPostOptionalArrayHeaderOptions{}
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ExplicitClient.PostOptionalArrayHeader", map[string]interface{}{
+			"headerParameter": options.HeaderParameter,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	req, err := client.PostOptionalArrayHeaderPreparer(ctx, headerParameter)
+	req, err := client.PostOptionalArrayHeaderPreparer(ctx, options)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostOptionalArrayHeader", nil, "Failure preparing request")
 		return
@@ -56,7 +68,7 @@ func (client ExplicitClient) PostOptionalArrayHeader(ctx context.Context, header
 	}
 
 	result, err = client.PostOptionalArrayHeaderResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostOptionalArrayHeader", resp, "Failure responding to request")
 	}
 
@@ -64,23 +76,27 @@ func (client ExplicitClient) PostOptionalArrayHeader(ctx context.Context, header
 }
 
 // PostOptionalArrayHeaderPreparer prepares the PostOptionalArrayHeader request.
-func (client ExplicitClient) PostOptionalArrayHeaderPreparer(ctx context.Context, headerParameter []string) (*http.Request, error) {
+func (client ExplicitClient) PostOptionalArrayHeaderPreparer(ctx context.Context, options *ExplicitPostOptionalArrayHeaderOptions) (*http.Request, error) {
+	if options == nil {
+		options = &ExplicitPostOptionalArrayHeaderOptions{}
+	}
 	preparer := autorest.CreatePreparer(
 		autorest.AsPost(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/optional/array/header"))
-	if headerParameter != nil && len(headerParameter) > 0 {
+	if options.HeaderParameter != nil && len(options.HeaderParameter) > 0 {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithHeader("headerParameter", autorest.String(headerParameter)))
+			autorest.WithHeader("headerParameter", autorest.String(options.HeaderParameter)))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostOptionalArrayHeaderSender sends the PostOptionalArrayHeader request. The method will close the
 // http.Response Body if it receives an error.
 func (client ExplicitClient) PostOptionalArrayHeaderSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostOptionalArrayHeaderResponder handles the response to the PostOptionalArrayHeader request. The method always
@@ -89,25 +105,30 @@ func (client ExplicitClient) PostOptionalArrayHeaderResponder(resp *http.Respons
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
 }
 
 // PostOptionalArrayParameter test explicitly optional array. Please put null.
-func (client ExplicitClient) PostOptionalArrayParameter(ctx context.Context, bodyParameter []string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ExplicitClient.PostOptionalArrayParameter")
+func (client ExplicitClient) PostOptionalArrayParameter(ctx context.Context, options *ExplicitPostOptionalArrayParameterOptions) (result autorest.Response, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ExplicitClient.PostOptionalArrayParameter", "POST", "/reqopt/optional/array/parameter")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	req, err := client.PostOptionalArrayParameterPreparer(ctx, bodyParameter)
+	if options == nil {
+		options = &ExplicitPostOptionalArrayParameterOptions{}
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ExplicitClient.PostOptionalArrayParameter", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	req, err := client.PostOptionalArrayParameterPreparer(ctx, options)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostOptionalArrayParameter", nil, "Failure preparing request")
 		return
@@ -121,7 +142,7 @@ func (client ExplicitClient) PostOptionalArrayParameter(ctx context.Context, bod
 	}
 
 	result, err = client.PostOptionalArrayParameterResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostOptionalArrayParameter", resp, "Failure responding to request")
 	}
 
@@ -129,24 +150,28 @@ func (client ExplicitClient) PostOptionalArrayParameter(ctx context.Context, bod
 }
 
 // PostOptionalArrayParameterPreparer prepares the PostOptionalArrayParameter request.
-func (client ExplicitClient) PostOptionalArrayParameterPreparer(ctx context.Context, bodyParameter []string) (*http.Request, error) {
+func (client ExplicitClient) PostOptionalArrayParameterPreparer(ctx context.Context, options *ExplicitPostOptionalArrayParameterOptions) (*http.Request, error) {
+	if options == nil {
+		options = &ExplicitPostOptionalArrayParameterOptions{}
+	}
 	preparer := autorest.CreatePreparer(
 		autorest.AsContentType("application/json; charset=utf-8"),
 		autorest.AsPost(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/optional/array/parameter"))
-	if bodyParameter != nil && len(bodyParameter) > 0 {
+	if options.BodyParameter != nil && len(options.BodyParameter) > 0 {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithJSON(bodyParameter))
+			autorest.WithJSON(options.BodyParameter))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostOptionalArrayParameterSender sends the PostOptionalArrayParameter request. The method will close the
 // http.Response Body if it receives an error.
 func (client ExplicitClient) PostOptionalArrayParameterSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostOptionalArrayParameterResponder handles the response to the PostOptionalArrayParameter request. The method always
@@ -155,25 +180,30 @@ func (client ExplicitClient) PostOptionalArrayParameterResponder(resp *http.Resp
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
 }
 
 // PostOptionalArrayProperty test explicitly optional array. Please put a valid array-wrapper with 'value' = null.
-func (client ExplicitClient) PostOptionalArrayProperty(ctx context.Context, bodyParameter *ArrayOptionalWrapper) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ExplicitClient.PostOptionalArrayProperty")
+func (client ExplicitClient) PostOptionalArrayProperty(ctx context.Context, options *ExplicitPostOptionalArrayPropertyOptions) (result autorest.Response, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ExplicitClient.PostOptionalArrayProperty", "POST", "/reqopt/optional/array/property")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	req, err := client.PostOptionalArrayPropertyPreparer(ctx, bodyParameter)
+	if options == nil {
+		options = &ExplicitPostOptionalArrayPropertyOptions{}
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ExplicitClient.PostOptionalArrayProperty", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	req, err := client.PostOptionalArrayPropertyPreparer(ctx, options)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostOptionalArrayProperty", nil, "Failure preparing request")
 		return
@@ -187,7 +217,7 @@ func (client ExplicitClient) PostOptionalArrayProperty(ctx context.Context, body
 	}
 
 	result, err = client.PostOptionalArrayPropertyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostOptionalArrayProperty", resp, "Failure responding to request")
 	}
 
@@ -195,24 +225,28 @@ func (client ExplicitClient) PostOptionalArrayProperty(ctx context.Context, body
 }
 
 // PostOptionalArrayPropertyPreparer prepares the PostOptionalArrayProperty request.
-func (client ExplicitClient) PostOptionalArrayPropertyPreparer(ctx context.Context, bodyParameter *ArrayOptionalWrapper) (*http.Request, error) {
+func (client ExplicitClient) PostOptionalArrayPropertyPreparer(ctx context.Context, options *ExplicitPostOptionalArrayPropertyOptions) (*http.Request, error) {
+	if options == nil {
+		options = &ExplicitPostOptionalArrayPropertyOptions{}
+	}
 	preparer := autorest.CreatePreparer(
 		autorest.AsContentType("application/json; charset=utf-8"),
 		autorest.AsPost(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/optional/array/property"))
-	if bodyParameter != nil {
+	if options.BodyParameter != nil {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithJSON(bodyParameter))
+			autorest.WithJSON(options.BodyParameter))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostOptionalArrayPropertySender sends the PostOptionalArrayProperty request. The method will close the
 // http.Response Body if it receives an error.
 func (client ExplicitClient) PostOptionalArrayPropertySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostOptionalArrayPropertyResponder handles the response to the PostOptionalArrayProperty request. The method always
@@ -221,32 +255,38 @@ func (client ExplicitClient) PostOptionalArrayPropertyResponder(resp *http.Respo
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
 }
 
 // PostOptionalClassParameter test explicitly optional complex object. Please put null.
-func (client ExplicitClient) PostOptionalClassParameter(ctx context.Context, bodyParameter *Product) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ExplicitClient.PostOptionalClassParameter")
+func (client ExplicitClient) PostOptionalClassParameter(ctx context.Context, options *ExplicitPostOptionalClassParameterOptions) (result autorest.Response, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ExplicitClient.PostOptionalClassParameter", "POST", "/reqopt/optional/class/parameter")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if options == nil {
+		options = &ExplicitPostOptionalClassParameterOptions{}
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ExplicitClient.PostOptionalClassParameter", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: bodyParameter,
-			Constraints: []validation.Constraint{{Target: "bodyParameter", Name: validation.Null, Rule: false,
-				Chain: []validation.Constraint{{Target: "bodyParameter.ID", Name: validation.Null, Rule: true, Chain: nil}}}}}}); err != nil {
-		return result, validation.NewError("optionalgroup.ExplicitClient", "PostOptionalClassParameter", err.Error())
+	var errs ValidationErrors
+	if options.BodyParameter != nil {
+		errs = errs.addNested("bodyParameter", options.BodyParameter.Validate())
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("optionalgroup.ExplicitClient", "PostOptionalClassParameter", errs.Error()), Errors: errs}
 	}
 
-	req, err := client.PostOptionalClassParameterPreparer(ctx, bodyParameter)
+	req, err := client.PostOptionalClassParameterPreparer(ctx, options)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostOptionalClassParameter", nil, "Failure preparing request")
 		return
@@ -260,7 +300,7 @@ func (client ExplicitClient) PostOptionalClassParameter(ctx context.Context, bod
 	}
 
 	result, err = client.PostOptionalClassParameterResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostOptionalClassParameter", resp, "Failure responding to request")
 	}
 
@@ -268,24 +308,28 @@ func (client ExplicitClient) PostOptionalClassParameter(ctx context.Context, bod
 }
 
 // PostOptionalClassParameterPreparer prepares the PostOptionalClassParameter request.
-func (client ExplicitClient) PostOptionalClassParameterPreparer(ctx context.Context, bodyParameter *Product) (*http.Request, error) {
+func (client ExplicitClient) PostOptionalClassParameterPreparer(ctx context.Context, options *ExplicitPostOptionalClassParameterOptions) (*http.Request, error) {
+	if options == nil {
+		options = &ExplicitPostOptionalClassParameterOptions{}
+	}
 	preparer := autorest.CreatePreparer(
 		autorest.AsContentType("application/json; charset=utf-8"),
 		autorest.AsPost(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/optional/class/parameter"))
-	if bodyParameter != nil {
+	if options.BodyParameter != nil {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithJSON(bodyParameter))
+			autorest.WithJSON(options.BodyParameter))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostOptionalClassParameterSender sends the PostOptionalClassParameter request. The method will close the
 // http.Response Body if it receives an error.
 func (client ExplicitClient) PostOptionalClassParameterSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostOptionalClassParameterResponder handles the response to the PostOptionalClassParameter request. The method always
@@ -294,7 +338,7 @@ func (client ExplicitClient) PostOptionalClassParameterResponder(resp *http.Resp
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -302,27 +346,31 @@ func (client ExplicitClient) PostOptionalClassParameterResponder(resp *http.Resp
 
 // PostOptionalClassProperty test explicitly optional complex object. Please put a valid class-wrapper with 'value' =
 // null.
-func (client ExplicitClient) PostOptionalClassProperty(ctx context.Context, bodyParameter *ClassOptionalWrapper) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ExplicitClient.PostOptionalClassProperty")
+func (client ExplicitClient) PostOptionalClassProperty(ctx context.Context, options *ExplicitPostOptionalClassPropertyOptions) (result autorest.Response, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ExplicitClient.PostOptionalClassProperty", "POST", "/reqopt/optional/class/property")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if options == nil {
+		options = &ExplicitPostOptionalClassPropertyOptions{}
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ExplicitClient.PostOptionalClassProperty", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: bodyParameter,
-			Constraints: []validation.Constraint{{Target: "bodyParameter", Name: validation.Null, Rule: false,
-				Chain: []validation.Constraint{{Target: "bodyParameter.Value", Name: validation.Null, Rule: false,
-					Chain: []validation.Constraint{{Target: "bodyParameter.Value.ID", Name: validation.Null, Rule: true, Chain: nil}}},
-				}}}}}); err != nil {
-		return result, validation.NewError("optionalgroup.ExplicitClient", "PostOptionalClassProperty", err.Error())
+	var errs ValidationErrors
+	if options.BodyParameter != nil {
+		errs = errs.addNested("bodyParameter", options.BodyParameter.Validate())
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("optionalgroup.ExplicitClient", "PostOptionalClassProperty", errs.Error()), Errors: errs}
 	}
 
-	req, err := client.PostOptionalClassPropertyPreparer(ctx, bodyParameter)
+	req, err := client.PostOptionalClassPropertyPreparer(ctx, options)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostOptionalClassProperty", nil, "Failure preparing request")
 		return
@@ -336,7 +384,7 @@ func (client ExplicitClient) PostOptionalClassProperty(ctx context.Context, body
 	}
 
 	result, err = client.PostOptionalClassPropertyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostOptionalClassProperty", resp, "Failure responding to request")
 	}
 
@@ -344,24 +392,28 @@ func (client ExplicitClient) PostOptionalClassProperty(ctx context.Context, body
 }
 
 // PostOptionalClassPropertyPreparer prepares the PostOptionalClassProperty request.
-func (client ExplicitClient) PostOptionalClassPropertyPreparer(ctx context.Context, bodyParameter *ClassOptionalWrapper) (*http.Request, error) {
+func (client ExplicitClient) PostOptionalClassPropertyPreparer(ctx context.Context, options *ExplicitPostOptionalClassPropertyOptions) (*http.Request, error) {
+	if options == nil {
+		options = &ExplicitPostOptionalClassPropertyOptions{}
+	}
 	preparer := autorest.CreatePreparer(
 		autorest.AsContentType("application/json; charset=utf-8"),
 		autorest.AsPost(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/optional/class/property"))
-	if bodyParameter != nil {
+	if options.BodyParameter != nil {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithJSON(bodyParameter))
+			autorest.WithJSON(options.BodyParameter))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostOptionalClassPropertySender sends the PostOptionalClassProperty request. The method will close the
 // http.Response Body if it receives an error.
 func (client ExplicitClient) PostOptionalClassPropertySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostOptionalClassPropertyResponder handles the response to the PostOptionalClassProperty request. The method always
@@ -370,25 +422,32 @@ func (client ExplicitClient) PostOptionalClassPropertyResponder(resp *http.Respo
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
 }
 
 // PostOptionalIntegerHeader test explicitly optional integer. Please put a header 'headerParameter' => null.
-func (client ExplicitClient) PostOptionalIntegerHeader(ctx context.Context, headerParameter *int32) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ExplicitClient.PostOptionalIntegerHeader")
+func (client ExplicitClient) PostOptionalIntegerHeader(ctx context.Context, options *ExplicitPostOptionalIntegerHeaderOptions) (result autorest.Response, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ExplicitClient.PostOptionalIntegerHeader", "POST", "/reqopt/optional/integer/header")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	req, err := client.PostOptionalIntegerHeaderPreparer(ctx, headerParameter)
+	if options == nil {
+		options = &ExplicitPostOptionalIntegerHeaderOptions{}
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ExplicitClient.PostOptionalIntegerHeader", map[string]interface{}{
+			"headerParameter": options.HeaderParameter,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	req, err := client.PostOptionalIntegerHeaderPreparer(ctx, options)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostOptionalIntegerHeader", nil, "Failure preparing request")
 		return
@@ -402,7 +461,7 @@ func (client ExplicitClient) PostOptionalIntegerHeader(ctx context.Context, head
 	}
 
 	result, err = client.PostOptionalIntegerHeaderResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostOptionalIntegerHeader", resp, "Failure responding to request")
 	}
 
@@ -410,23 +469,27 @@ func (client ExplicitClient) PostOptionalIntegerHeader(ctx context.Context, head
 }
 
 // PostOptionalIntegerHeaderPreparer prepares the PostOptionalIntegerHeader request.
-func (client ExplicitClient) PostOptionalIntegerHeaderPreparer(ctx context.Context, headerParameter *int32) (*http.Request, error) {
+func (client ExplicitClient) PostOptionalIntegerHeaderPreparer(ctx context.Context, options *ExplicitPostOptionalIntegerHeaderOptions) (*http.Request, error) {
+	if options == nil {
+		options = &ExplicitPostOptionalIntegerHeaderOptions{}
+	}
 	preparer := autorest.CreatePreparer(
 		autorest.AsPost(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/optional/integer/header"))
-	if headerParameter != nil {
+	if options.HeaderParameter != nil {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithHeader("headerParameter", autorest.String(headerParameter)))
+			autorest.WithHeader("headerParameter", autorest.String(*options.HeaderParameter)))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostOptionalIntegerHeaderSender sends the PostOptionalIntegerHeader request. The method will close the
 // http.Response Body if it receives an error.
 func (client ExplicitClient) PostOptionalIntegerHeaderSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostOptionalIntegerHeaderResponder handles the response to the PostOptionalIntegerHeader request. The method always
@@ -435,25 +498,30 @@ func (client ExplicitClient) PostOptionalIntegerHeaderResponder(resp *http.Respo
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
 }
 
 // PostOptionalIntegerParameter test explicitly optional integer. Please put null.
-func (client ExplicitClient) PostOptionalIntegerParameter(ctx context.Context, bodyParameter *int32) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ExplicitClient.PostOptionalIntegerParameter")
+func (client ExplicitClient) PostOptionalIntegerParameter(ctx context.Context, options *ExplicitPostOptionalIntegerParameterOptions) (result autorest.Response, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ExplicitClient.PostOptionalIntegerParameter", "POST", "/reqopt/optional/integer/parameter")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if options == nil {
+		options = &ExplicitPostOptionalIntegerParameterOptions{}
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ExplicitClient.PostOptionalIntegerParameter", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	req, err := client.PostOptionalIntegerParameterPreparer(ctx, bodyParameter)
+	req, err := client.PostOptionalIntegerParameterPreparer(ctx, options)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostOptionalIntegerParameter", nil, "Failure preparing request")
 		return
@@ -467,7 +535,7 @@ func (client ExplicitClient) PostOptionalIntegerParameter(ctx context.Context, b
 	}
 
 	result, err = client.PostOptionalIntegerParameterResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostOptionalIntegerParameter", resp, "Failure responding to request")
 	}
 
@@ -475,24 +543,28 @@ func (client ExplicitClient) PostOptionalIntegerParameter(ctx context.Context, b
 }
 
 // PostOptionalIntegerParameterPreparer prepares the PostOptionalIntegerParameter request.
-func (client ExplicitClient) PostOptionalIntegerParameterPreparer(ctx context.Context, bodyParameter *int32) (*http.Request, error) {
+func (client ExplicitClient) PostOptionalIntegerParameterPreparer(ctx context.Context, options *ExplicitPostOptionalIntegerParameterOptions) (*http.Request, error) {
+	if options == nil {
+		options = &ExplicitPostOptionalIntegerParameterOptions{}
+	}
 	preparer := autorest.CreatePreparer(
 		autorest.AsContentType("application/json; charset=utf-8"),
 		autorest.AsPost(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/optional/integer/parameter"))
-	if bodyParameter != nil {
+	if options.BodyParameter != nil {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithJSON(bodyParameter))
+			autorest.WithJSON(options.BodyParameter))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostOptionalIntegerParameterSender sends the PostOptionalIntegerParameter request. The method will close the
 // http.Response Body if it receives an error.
 func (client ExplicitClient) PostOptionalIntegerParameterSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostOptionalIntegerParameterResponder handles the response to the PostOptionalIntegerParameter request. The method always
@@ -501,25 +573,30 @@ func (client ExplicitClient) PostOptionalIntegerParameterResponder(resp *http.Re
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
 }
 
 // PostOptionalIntegerProperty test explicitly optional integer. Please put a valid int-wrapper with 'value' = null.
-func (client ExplicitClient) PostOptionalIntegerProperty(ctx context.Context, bodyParameter *IntOptionalWrapper) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ExplicitClient.PostOptionalIntegerProperty")
+func (client ExplicitClient) PostOptionalIntegerProperty(ctx context.Context, options *ExplicitPostOptionalIntegerPropertyOptions) (result autorest.Response, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ExplicitClient.PostOptionalIntegerProperty", "POST", "/reqopt/optional/integer/property")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	req, err := client.PostOptionalIntegerPropertyPreparer(ctx, bodyParameter)
+	if options == nil {
+		options = &ExplicitPostOptionalIntegerPropertyOptions{}
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ExplicitClient.PostOptionalIntegerProperty", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	req, err := client.PostOptionalIntegerPropertyPreparer(ctx, options)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostOptionalIntegerProperty", nil, "Failure preparing request")
 		return
@@ -533,7 +610,7 @@ func (client ExplicitClient) PostOptionalIntegerProperty(ctx context.Context, bo
 	}
 
 	result, err = client.PostOptionalIntegerPropertyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostOptionalIntegerProperty", resp, "Failure responding to request")
 	}
 
@@ -541,24 +618,28 @@ func (client ExplicitClient) PostOptionalIntegerProperty(ctx context.Context, bo
 }
 
 // PostOptionalIntegerPropertyPreparer prepares the PostOptionalIntegerProperty request.
-func (client ExplicitClient) PostOptionalIntegerPropertyPreparer(ctx context.Context, bodyParameter *IntOptionalWrapper) (*http.Request, error) {
+func (client ExplicitClient) PostOptionalIntegerPropertyPreparer(ctx context.Context, options *ExplicitPostOptionalIntegerPropertyOptions) (*http.Request, error) {
+	if options == nil {
+		options = &ExplicitPostOptionalIntegerPropertyOptions{}
+	}
 	preparer := autorest.CreatePreparer(
 		autorest.AsContentType("application/json; charset=utf-8"),
 		autorest.AsPost(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/optional/integer/property"))
-	if bodyParameter != nil {
+	if options.BodyParameter != nil {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithJSON(bodyParameter))
+			autorest.WithJSON(options.BodyParameter))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostOptionalIntegerPropertySender sends the PostOptionalIntegerProperty request. The method will close the
 // http.Response Body if it receives an error.
 func (client ExplicitClient) PostOptionalIntegerPropertySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostOptionalIntegerPropertyResponder handles the response to the PostOptionalIntegerProperty request. The method always
@@ -567,25 +648,32 @@ func (client ExplicitClient) PostOptionalIntegerPropertyResponder(resp *http.Res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
 }
 
 // PostOptionalStringHeader test explicitly optional string. Please put a header 'headerParameter' => null.
-func (client ExplicitClient) PostOptionalStringHeader(ctx context.Context, bodyParameter string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ExplicitClient.PostOptionalStringHeader")
+func (client ExplicitClient) PostOptionalStringHeader(ctx context.Context, options *ExplicitPostOptionalStringHeaderOptions) (result autorest.Response, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ExplicitClient.PostOptionalStringHeader", "POST", "/reqopt/optional/string/header")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	req, err := client.PostOptionalStringHeaderPreparer(ctx, bodyParameter)
+	if options == nil {
+		options = &ExplicitPostOptionalStringHeaderOptions{}
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ExplicitClient.PostOptionalStringHeader", map[string]interface{}{
+			"bodyParameter": options.BodyParameter,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	req, err := client.PostOptionalStringHeaderPreparer(ctx, options)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostOptionalStringHeader", nil, "Failure preparing request")
 		return
@@ -599,7 +687,7 @@ func (client ExplicitClient) PostOptionalStringHeader(ctx context.Context, bodyP
 	}
 
 	result, err = client.PostOptionalStringHeaderResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostOptionalStringHeader", resp, "Failure responding to request")
 	}
 
@@ -607,23 +695,27 @@ func (client ExplicitClient) PostOptionalStringHeader(ctx context.Context, bodyP
 }
 
 // PostOptionalStringHeaderPreparer prepares the PostOptionalStringHeader request.
-func (client ExplicitClient) PostOptionalStringHeaderPreparer(ctx context.Context, bodyParameter string) (*http.Request, error) {
+func (client ExplicitClient) PostOptionalStringHeaderPreparer(ctx context.Context, options *ExplicitPostOptionalStringHeaderOptions) (*http.Request, error) {
+	if options == nil {
+		options = &ExplicitPostOptionalStringHeaderOptions{}
+	}
 	preparer := autorest.CreatePreparer(
 		autorest.AsPost(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/optional/string/header"))
-	if len(bodyParameter) > 0 {
+	if len(options.BodyParameter) > 0 {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithHeader("bodyParameter", autorest.String(bodyParameter)))
+			autorest.WithHeader("bodyParameter", autorest.String(options.BodyParameter)))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostOptionalStringHeaderSender sends the PostOptionalStringHeader request. The method will close the
 // http.Response Body if it receives an error.
 func (client ExplicitClient) PostOptionalStringHeaderSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostOptionalStringHeaderResponder handles the response to the PostOptionalStringHeader request. The method always
@@ -632,25 +724,30 @@ func (client ExplicitClient) PostOptionalStringHeaderResponder(resp *http.Respon
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
 }
 
 // PostOptionalStringParameter test explicitly optional string. Please put null.
-func (client ExplicitClient) PostOptionalStringParameter(ctx context.Context, bodyParameter string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ExplicitClient.PostOptionalStringParameter")
+func (client ExplicitClient) PostOptionalStringParameter(ctx context.Context, options *ExplicitPostOptionalStringParameterOptions) (result autorest.Response, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ExplicitClient.PostOptionalStringParameter", "POST", "/reqopt/optional/string/parameter")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	req, err := client.PostOptionalStringParameterPreparer(ctx, bodyParameter)
+	if options == nil {
+		options = &ExplicitPostOptionalStringParameterOptions{}
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ExplicitClient.PostOptionalStringParameter", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	req, err := client.PostOptionalStringParameterPreparer(ctx, options)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostOptionalStringParameter", nil, "Failure preparing request")
 		return
@@ -664,7 +761,7 @@ func (client ExplicitClient) PostOptionalStringParameter(ctx context.Context, bo
 	}
 
 	result, err = client.PostOptionalStringParameterResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostOptionalStringParameter", resp, "Failure responding to request")
 	}
 
@@ -672,24 +769,28 @@ func (client ExplicitClient) PostOptionalStringParameter(ctx context.Context, bo
 }
 
 // PostOptionalStringParameterPreparer prepares the PostOptionalStringParameter request.
-func (client ExplicitClient) PostOptionalStringParameterPreparer(ctx context.Context, bodyParameter string) (*http.Request, error) {
+func (client ExplicitClient) PostOptionalStringParameterPreparer(ctx context.Context, options *ExplicitPostOptionalStringParameterOptions) (*http.Request, error) {
+	if options == nil {
+		options = &ExplicitPostOptionalStringParameterOptions{}
+	}
 	preparer := autorest.CreatePreparer(
 		autorest.AsContentType("application/json; charset=utf-8"),
 		autorest.AsPost(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/optional/string/parameter"))
-	if len(bodyParameter) > 0 {
+	if len(options.BodyParameter) > 0 {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithJSON(bodyParameter))
+			autorest.WithJSON(options.BodyParameter))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostOptionalStringParameterSender sends the PostOptionalStringParameter request. The method will close the
 // http.Response Body if it receives an error.
 func (client ExplicitClient) PostOptionalStringParameterSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostOptionalStringParameterResponder handles the response to the PostOptionalStringParameter request. The method always
@@ -698,25 +799,30 @@ func (client ExplicitClient) PostOptionalStringParameterResponder(resp *http.Res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
 }
 
 // PostOptionalStringProperty test explicitly optional integer. Please put a valid string-wrapper with 'value' = null.
-func (client ExplicitClient) PostOptionalStringProperty(ctx context.Context, bodyParameter *StringOptionalWrapper) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ExplicitClient.PostOptionalStringProperty")
+func (client ExplicitClient) PostOptionalStringProperty(ctx context.Context, options *ExplicitPostOptionalStringPropertyOptions) (result autorest.Response, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ExplicitClient.PostOptionalStringProperty", "POST", "/reqopt/optional/string/property")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	req, err := client.PostOptionalStringPropertyPreparer(ctx, bodyParameter)
+	if options == nil {
+		options = &ExplicitPostOptionalStringPropertyOptions{}
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ExplicitClient.PostOptionalStringProperty", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	req, err := client.PostOptionalStringPropertyPreparer(ctx, options)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostOptionalStringProperty", nil, "Failure preparing request")
 		return
@@ -730,7 +836,7 @@ func (client ExplicitClient) PostOptionalStringProperty(ctx context.Context, bod
 	}
 
 	result, err = client.PostOptionalStringPropertyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostOptionalStringProperty", resp, "Failure responding to request")
 	}
 
@@ -738,24 +844,28 @@ func (client ExplicitClient) PostOptionalStringProperty(ctx context.Context, bod
 }
 
 // PostOptionalStringPropertyPreparer prepares the PostOptionalStringProperty request.
-func (client ExplicitClient) PostOptionalStringPropertyPreparer(ctx context.Context, bodyParameter *StringOptionalWrapper) (*http.Request, error) {
+func (client ExplicitClient) PostOptionalStringPropertyPreparer(ctx context.Context, options *ExplicitPostOptionalStringPropertyOptions) (*http.Request, error) {
+	if options == nil {
+		options = &ExplicitPostOptionalStringPropertyOptions{}
+	}
 	preparer := autorest.CreatePreparer(
 		autorest.AsContentType("application/json; charset=utf-8"),
 		autorest.AsPost(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/optional/string/property"))
-	if bodyParameter != nil {
+	if options.BodyParameter != nil {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithJSON(bodyParameter))
+			autorest.WithJSON(options.BodyParameter))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostOptionalStringPropertySender sends the PostOptionalStringProperty request. The method will close the
 // http.Response Body if it receives an error.
 func (client ExplicitClient) PostOptionalStringPropertySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostOptionalStringPropertyResponder handles the response to the PostOptionalStringProperty request. The method always
@@ -764,7 +874,7 @@ func (client ExplicitClient) PostOptionalStringPropertyResponder(resp *http.Resp
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -773,20 +883,26 @@ func (client ExplicitClient) PostOptionalStringPropertyResponder(resp *http.Resp
 // PostRequiredArrayHeader test explicitly required array. Please put a header 'headerParameter' => null and the client
 // library should throw before the request is sent.
 func (client ExplicitClient) PostRequiredArrayHeader(ctx context.Context, headerParameter []string) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ExplicitClient.PostRequiredArrayHeader")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ExplicitClient.PostRequiredArrayHeader", "POST", "/reqopt/requied/array/header")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ExplicitClient.PostRequiredArrayHeader", map[string]interface{}{
+			"headerParameter": headerParameter,
+		})
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: headerParameter,
-			Constraints: []validation.Constraint{{Target: "headerParameter", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("optionalgroup.ExplicitClient", "PostRequiredArrayHeader", err.Error())
+	var errs ValidationErrors
+	if headerParameter == nil {
+		errs = append(errs, ValidationError{Path: "headerParameter", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("optionalgroup.ExplicitClient", "PostRequiredArrayHeader", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PostRequiredArrayHeaderPreparer(ctx, headerParameter)
@@ -803,7 +919,7 @@ func (client ExplicitClient) PostRequiredArrayHeader(ctx context.Context, header
 	}
 
 	result, err = client.PostRequiredArrayHeaderResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostRequiredArrayHeader", resp, "Failure responding to request")
 	}
 
@@ -817,14 +933,15 @@ func (client ExplicitClient) PostRequiredArrayHeaderPreparer(ctx context.Context
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/requied/array/header"),
 		autorest.WithHeader("headerParameter", autorest.String(headerParameter)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostRequiredArrayHeaderSender sends the PostRequiredArrayHeader request. The method will close the
 // http.Response Body if it receives an error.
 func (client ExplicitClient) PostRequiredArrayHeaderSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostRequiredArrayHeaderResponder handles the response to the PostRequiredArrayHeader request. The method always
@@ -833,7 +950,7 @@ func (client ExplicitClient) PostRequiredArrayHeaderResponder(resp *http.Respons
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -843,20 +960,24 @@ func (client ExplicitClient) PostRequiredArrayHeaderResponder(resp *http.Respons
 // PostRequiredArrayParameter test explicitly required array. Please put null and the client library should throw
 // before the request is sent.
 func (client ExplicitClient) PostRequiredArrayParameter(ctx context.Context, bodyParameter []string) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ExplicitClient.PostRequiredArrayParameter")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ExplicitClient.PostRequiredArrayParameter", "POST", "/reqopt/requied/array/parameter")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: bodyParameter,
-			Constraints: []validation.Constraint{{Target: "bodyParameter", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("optionalgroup.ExplicitClient", "PostRequiredArrayParameter", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ExplicitClient.PostRequiredArrayParameter", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if bodyParameter == nil {
+		errs = append(errs, ValidationError{Path: "bodyParameter", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("optionalgroup.ExplicitClient", "PostRequiredArrayParameter", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PostRequiredArrayParameterPreparer(ctx, bodyParameter)
@@ -873,7 +994,7 @@ func (client ExplicitClient) PostRequiredArrayParameter(ctx context.Context, bod
 	}
 
 	result, err = client.PostRequiredArrayParameterResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostRequiredArrayParameter", resp, "Failure responding to request")
 	}
 
@@ -888,14 +1009,15 @@ func (client ExplicitClient) PostRequiredArrayParameterPreparer(ctx context.Cont
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/requied/array/parameter"),
 		autorest.WithJSON(bodyParameter))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostRequiredArrayParameterSender sends the PostRequiredArrayParameter request. The method will close the
 // http.Response Body if it receives an error.
 func (client ExplicitClient) PostRequiredArrayParameterSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostRequiredArrayParameterResponder handles the response to the PostRequiredArrayParameter request. The method always
@@ -904,7 +1026,7 @@ func (client ExplicitClient) PostRequiredArrayParameterResponder(resp *http.Resp
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -914,20 +1036,22 @@ func (client ExplicitClient) PostRequiredArrayParameterResponder(resp *http.Resp
 // PostRequiredArrayProperty test explicitly required array. Please put a valid array-wrapper with 'value' = null and
 // the client library should throw before the request is sent.
 func (client ExplicitClient) PostRequiredArrayProperty(ctx context.Context, bodyParameter ArrayWrapper) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ExplicitClient.PostRequiredArrayProperty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ExplicitClient.PostRequiredArrayProperty", "POST", "/reqopt/requied/array/property")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: bodyParameter,
-			Constraints: []validation.Constraint{{Target: "bodyParameter.Value", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("optionalgroup.ExplicitClient", "PostRequiredArrayProperty", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ExplicitClient.PostRequiredArrayProperty", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
+		}()
+	}
+	var errs ValidationErrors
+	errs = errs.addNested("bodyParameter", bodyParameter.Validate())
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("optionalgroup.ExplicitClient", "PostRequiredArrayProperty", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PostRequiredArrayPropertyPreparer(ctx, bodyParameter)
@@ -944,7 +1068,7 @@ func (client ExplicitClient) PostRequiredArrayProperty(ctx context.Context, body
 	}
 
 	result, err = client.PostRequiredArrayPropertyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostRequiredArrayProperty", resp, "Failure responding to request")
 	}
 
@@ -959,14 +1083,15 @@ func (client ExplicitClient) PostRequiredArrayPropertyPreparer(ctx context.Conte
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/requied/array/property"),
 		autorest.WithJSON(bodyParameter))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostRequiredArrayPropertySender sends the PostRequiredArrayProperty request. The method will close the
 // http.Response Body if it receives an error.
 func (client ExplicitClient) PostRequiredArrayPropertySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostRequiredArrayPropertyResponder handles the response to the PostRequiredArrayProperty request. The method always
@@ -975,7 +1100,7 @@ func (client ExplicitClient) PostRequiredArrayPropertyResponder(resp *http.Respo
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -985,20 +1110,22 @@ func (client ExplicitClient) PostRequiredArrayPropertyResponder(resp *http.Respo
 // PostRequiredClassParameter test explicitly required complex object. Please put null and the client library should
 // throw before the request is sent.
 func (client ExplicitClient) PostRequiredClassParameter(ctx context.Context, bodyParameter Product) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ExplicitClient.PostRequiredClassParameter")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ExplicitClient.PostRequiredClassParameter", "POST", "/reqopt/requied/class/parameter")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ExplicitClient.PostRequiredClassParameter", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: bodyParameter,
-			Constraints: []validation.Constraint{{Target: "bodyParameter.ID", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("optionalgroup.ExplicitClient", "PostRequiredClassParameter", err.Error())
+	var errs ValidationErrors
+	errs = errs.addNested("bodyParameter", bodyParameter.Validate())
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("optionalgroup.ExplicitClient", "PostRequiredClassParameter", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PostRequiredClassParameterPreparer(ctx, bodyParameter)
@@ -1015,7 +1142,7 @@ func (client ExplicitClient) PostRequiredClassParameter(ctx context.Context, bod
 	}
 
 	result, err = client.PostRequiredClassParameterResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostRequiredClassParameter", resp, "Failure responding to request")
 	}
 
@@ -1030,14 +1157,15 @@ func (client ExplicitClient) PostRequiredClassParameterPreparer(ctx context.Cont
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/requied/class/parameter"),
 		autorest.WithJSON(bodyParameter))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostRequiredClassParameterSender sends the PostRequiredClassParameter request. The method will close the
 // http.Response Body if it receives an error.
 func (client ExplicitClient) PostRequiredClassParameterSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostRequiredClassParameterResponder handles the response to the PostRequiredClassParameter request. The method always
@@ -1046,7 +1174,7 @@ func (client ExplicitClient) PostRequiredClassParameterResponder(resp *http.Resp
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1056,21 +1184,22 @@ func (client ExplicitClient) PostRequiredClassParameterResponder(resp *http.Resp
 // PostRequiredClassProperty test explicitly required complex object. Please put a valid class-wrapper with 'value' =
 // null and the client library should throw before the request is sent.
 func (client ExplicitClient) PostRequiredClassProperty(ctx context.Context, bodyParameter ClassWrapper) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ExplicitClient.PostRequiredClassProperty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ExplicitClient.PostRequiredClassProperty", "POST", "/reqopt/requied/class/property")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ExplicitClient.PostRequiredClassProperty", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: bodyParameter,
-			Constraints: []validation.Constraint{{Target: "bodyParameter.Value", Name: validation.Null, Rule: true,
-				Chain: []validation.Constraint{{Target: "bodyParameter.Value.ID", Name: validation.Null, Rule: true, Chain: nil}}}}}}); err != nil {
-		return result, validation.NewError("optionalgroup.ExplicitClient", "PostRequiredClassProperty", err.Error())
+	var errs ValidationErrors
+	errs = errs.addNested("bodyParameter", bodyParameter.Validate())
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("optionalgroup.ExplicitClient", "PostRequiredClassProperty", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PostRequiredClassPropertyPreparer(ctx, bodyParameter)
@@ -1087,7 +1216,7 @@ func (client ExplicitClient) PostRequiredClassProperty(ctx context.Context, body
 	}
 
 	result, err = client.PostRequiredClassPropertyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostRequiredClassProperty", resp, "Failure responding to request")
 	}
 
@@ -1102,14 +1231,15 @@ func (client ExplicitClient) PostRequiredClassPropertyPreparer(ctx context.Conte
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/requied/class/property"),
 		autorest.WithJSON(bodyParameter))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostRequiredClassPropertySender sends the PostRequiredClassProperty request. The method will close the
 // http.Response Body if it receives an error.
 func (client ExplicitClient) PostRequiredClassPropertySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostRequiredClassPropertyResponder handles the response to the PostRequiredClassProperty request. The method always
@@ -1118,7 +1248,7 @@ func (client ExplicitClient) PostRequiredClassPropertyResponder(resp *http.Respo
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1128,14 +1258,18 @@ func (client ExplicitClient) PostRequiredClassPropertyResponder(resp *http.Respo
 // PostRequiredIntegerHeader test explicitly required integer. Please put a header 'headerParameter' => null and the
 // client library should throw before the request is sent.
 func (client ExplicitClient) PostRequiredIntegerHeader(ctx context.Context, headerParameter int32) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ExplicitClient.PostRequiredIntegerHeader")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ExplicitClient.PostRequiredIntegerHeader", "POST", "/reqopt/requied/integer/header")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ExplicitClient.PostRequiredIntegerHeader", map[string]interface{}{
+			"headerParameter": headerParameter,
+		})
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.PostRequiredIntegerHeaderPreparer(ctx, headerParameter)
@@ -1152,7 +1286,7 @@ func (client ExplicitClient) PostRequiredIntegerHeader(ctx context.Context, head
 	}
 
 	result, err = client.PostRequiredIntegerHeaderResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostRequiredIntegerHeader", resp, "Failure responding to request")
 	}
 
@@ -1166,14 +1300,15 @@ func (client ExplicitClient) PostRequiredIntegerHeaderPreparer(ctx context.Conte
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/requied/integer/header"),
 		autorest.WithHeader("headerParameter", autorest.String(headerParameter)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostRequiredIntegerHeaderSender sends the PostRequiredIntegerHeader request. The method will close the
 // http.Response Body if it receives an error.
 func (client ExplicitClient) PostRequiredIntegerHeaderSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostRequiredIntegerHeaderResponder handles the response to the PostRequiredIntegerHeader request. The method always
@@ -1182,7 +1317,7 @@ func (client ExplicitClient) PostRequiredIntegerHeaderResponder(resp *http.Respo
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1192,14 +1327,16 @@ func (client ExplicitClient) PostRequiredIntegerHeaderResponder(resp *http.Respo
 // PostRequiredIntegerParameter test explicitly required integer. Please put null and the client library should throw
 // before the request is sent.
 func (client ExplicitClient) PostRequiredIntegerParameter(ctx context.Context, bodyParameter int32) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ExplicitClient.PostRequiredIntegerParameter")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ExplicitClient.PostRequiredIntegerParameter", "POST", "/reqopt/requied/integer/parameter")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ExplicitClient.PostRequiredIntegerParameter", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.PostRequiredIntegerParameterPreparer(ctx, bodyParameter)
@@ -1216,7 +1353,7 @@ func (client ExplicitClient) PostRequiredIntegerParameter(ctx context.Context, b
 	}
 
 	result, err = client.PostRequiredIntegerParameterResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostRequiredIntegerParameter", resp, "Failure responding to request")
 	}
 
@@ -1231,14 +1368,15 @@ func (client ExplicitClient) PostRequiredIntegerParameterPreparer(ctx context.Co
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/requied/integer/parameter"),
 		autorest.WithJSON(bodyParameter))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostRequiredIntegerParameterSender sends the PostRequiredIntegerParameter request. The method will close the
 // http.Response Body if it receives an error.
 func (client ExplicitClient) PostRequiredIntegerParameterSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostRequiredIntegerParameterResponder handles the response to the PostRequiredIntegerParameter request. The method always
@@ -1247,7 +1385,7 @@ func (client ExplicitClient) PostRequiredIntegerParameterResponder(resp *http.Re
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1257,20 +1395,22 @@ func (client ExplicitClient) PostRequiredIntegerParameterResponder(resp *http.Re
 // PostRequiredIntegerProperty test explicitly required integer. Please put a valid int-wrapper with 'value' = null and
 // the client library should throw before the request is sent.
 func (client ExplicitClient) PostRequiredIntegerProperty(ctx context.Context, bodyParameter IntWrapper) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ExplicitClient.PostRequiredIntegerProperty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ExplicitClient.PostRequiredIntegerProperty", "POST", "/reqopt/requied/integer/property")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: bodyParameter,
-			Constraints: []validation.Constraint{{Target: "bodyParameter.Value", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("optionalgroup.ExplicitClient", "PostRequiredIntegerProperty", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ExplicitClient.PostRequiredIntegerProperty", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
+		}()
+	}
+	var errs ValidationErrors
+	errs = errs.addNested("bodyParameter", bodyParameter.Validate())
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("optionalgroup.ExplicitClient", "PostRequiredIntegerProperty", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PostRequiredIntegerPropertyPreparer(ctx, bodyParameter)
@@ -1287,7 +1427,7 @@ func (client ExplicitClient) PostRequiredIntegerProperty(ctx context.Context, bo
 	}
 
 	result, err = client.PostRequiredIntegerPropertyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostRequiredIntegerProperty", resp, "Failure responding to request")
 	}
 
@@ -1302,14 +1442,15 @@ func (client ExplicitClient) PostRequiredIntegerPropertyPreparer(ctx context.Con
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/requied/integer/property"),
 		autorest.WithJSON(bodyParameter))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostRequiredIntegerPropertySender sends the PostRequiredIntegerProperty request. The method will close the
 // http.Response Body if it receives an error.
 func (client ExplicitClient) PostRequiredIntegerPropertySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostRequiredIntegerPropertyResponder handles the response to the PostRequiredIntegerProperty request. The method always
@@ -1318,7 +1459,7 @@ func (client ExplicitClient) PostRequiredIntegerPropertyResponder(resp *http.Res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1328,14 +1469,18 @@ func (client ExplicitClient) PostRequiredIntegerPropertyResponder(resp *http.Res
 // PostRequiredStringHeader test explicitly required string. Please put a header 'headerParameter' => null and the
 // client library should throw before the request is sent.
 func (client ExplicitClient) PostRequiredStringHeader(ctx context.Context, headerParameter string) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ExplicitClient.PostRequiredStringHeader")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ExplicitClient.PostRequiredStringHeader", "POST", "/reqopt/requied/string/header")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ExplicitClient.PostRequiredStringHeader", map[string]interface{}{
+			"headerParameter": headerParameter,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.PostRequiredStringHeaderPreparer(ctx, headerParameter)
@@ -1352,7 +1497,7 @@ func (client ExplicitClient) PostRequiredStringHeader(ctx context.Context, heade
 	}
 
 	result, err = client.PostRequiredStringHeaderResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostRequiredStringHeader", resp, "Failure responding to request")
 	}
 
@@ -1366,14 +1511,15 @@ func (client ExplicitClient) PostRequiredStringHeaderPreparer(ctx context.Contex
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/requied/string/header"),
 		autorest.WithHeader("headerParameter", autorest.String(headerParameter)))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostRequiredStringHeaderSender sends the PostRequiredStringHeader request. The method will close the
 // http.Response Body if it receives an error.
 func (client ExplicitClient) PostRequiredStringHeaderSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostRequiredStringHeaderResponder handles the response to the PostRequiredStringHeader request. The method always
@@ -1382,7 +1528,7 @@ func (client ExplicitClient) PostRequiredStringHeaderResponder(resp *http.Respon
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1392,14 +1538,16 @@ func (client ExplicitClient) PostRequiredStringHeaderResponder(resp *http.Respon
 // PostRequiredStringParameter test explicitly required string. Please put null and the client library should throw
 // before the request is sent.
 func (client ExplicitClient) PostRequiredStringParameter(ctx context.Context, bodyParameter string) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ExplicitClient.PostRequiredStringParameter")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ExplicitClient.PostRequiredStringParameter", "POST", "/reqopt/requied/string/parameter")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ExplicitClient.PostRequiredStringParameter", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.PostRequiredStringParameterPreparer(ctx, bodyParameter)
@@ -1416,7 +1564,7 @@ func (client ExplicitClient) PostRequiredStringParameter(ctx context.Context, bo
 	}
 
 	result, err = client.PostRequiredStringParameterResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostRequiredStringParameter", resp, "Failure responding to request")
 	}
 
@@ -1431,14 +1579,15 @@ func (client ExplicitClient) PostRequiredStringParameterPreparer(ctx context.Con
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/requied/string/parameter"),
 		autorest.WithJSON(bodyParameter))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostRequiredStringParameterSender sends the PostRequiredStringParameter request. The method will close the
 // http.Response Body if it receives an error.
 func (client ExplicitClient) PostRequiredStringParameterSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostRequiredStringParameterResponder handles the response to the PostRequiredStringParameter request. The method always
@@ -1447,7 +1596,7 @@ func (client ExplicitClient) PostRequiredStringParameterResponder(resp *http.Res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -1457,20 +1606,22 @@ func (client ExplicitClient) PostRequiredStringParameterResponder(resp *http.Res
 // PostRequiredStringProperty test explicitly required string. Please put a valid string-wrapper with 'value' = null
 // and the client library should throw before the request is sent.
 func (client ExplicitClient) PostRequiredStringProperty(ctx context.Context, bodyParameter StringWrapper) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ExplicitClient.PostRequiredStringProperty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ExplicitClient.PostRequiredStringProperty", "POST", "/reqopt/requied/string/property")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: bodyParameter,
-			Constraints: []validation.Constraint{{Target: "bodyParameter.Value", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("optionalgroup.ExplicitClient", "PostRequiredStringProperty", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ExplicitClient.PostRequiredStringProperty", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
+		}()
+	}
+	var errs ValidationErrors
+	errs = errs.addNested("bodyParameter", bodyParameter.Validate())
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("optionalgroup.ExplicitClient", "PostRequiredStringProperty", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PostRequiredStringPropertyPreparer(ctx, bodyParameter)
@@ -1487,7 +1638,7 @@ func (client ExplicitClient) PostRequiredStringProperty(ctx context.Context, bod
 	}
 
 	result, err = client.PostRequiredStringPropertyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ExplicitClient", "PostRequiredStringProperty", resp, "Failure responding to request")
 	}
 
@@ -1502,14 +1653,15 @@ func (client ExplicitClient) PostRequiredStringPropertyPreparer(ctx context.Cont
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/requied/string/property"),
 		autorest.WithJSON(bodyParameter))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostRequiredStringPropertySender sends the PostRequiredStringProperty request. The method will close the
 // http.Response Body if it receives an error.
 func (client ExplicitClient) PostRequiredStringPropertySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostRequiredStringPropertyResponder handles the response to the PostRequiredStringProperty request. The method always
@@ -1518,7 +1670,7 @@ func (client ExplicitClient) PostRequiredStringPropertyResponder(resp *http.Resp
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
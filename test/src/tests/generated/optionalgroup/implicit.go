@@ -9,8 +9,6 @@ package optionalgroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -29,16 +27,25 @@ func NewImplicitClientWithBaseURI(baseURI string, requiredGlobalPath string, req
 	return ImplicitClient{NewWithBaseURI(baseURI, requiredGlobalPath, requiredGlobalQuery, optionalGlobalQuery)}
 }
 
+// NewImplicitClientWithOptions creates an instance of the ImplicitClient client with the specified options.  It returns
+// an error if the options are invalid.
+func NewImplicitClientWithOptions(opts ClientOptions) (ImplicitClient, error) {
+	client, err := NewWithOptions(opts)
+	return ImplicitClient{client}, err
+}
+
 // GetOptionalGlobalQuery test implicitly optional query parameter
 func (client ImplicitClient) GetOptionalGlobalQuery(ctx context.Context) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ImplicitClient.GetOptionalGlobalQuery")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ImplicitClient.GetOptionalGlobalQuery", "GET", "/reqopt/global/optional/query")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ImplicitClient.GetOptionalGlobalQuery", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetOptionalGlobalQueryPreparer(ctx)
@@ -55,7 +62,7 @@ func (client ImplicitClient) GetOptionalGlobalQuery(ctx context.Context) (result
 	}
 
 	result, err = client.GetOptionalGlobalQueryResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ImplicitClient", "GetOptionalGlobalQuery", resp, "Failure responding to request")
 	}
 
@@ -74,14 +81,15 @@ func (client ImplicitClient) GetOptionalGlobalQueryPreparer(ctx context.Context)
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/global/optional/query"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetOptionalGlobalQuerySender sends the GetOptionalGlobalQuery request. The method will close the
 // http.Response Body if it receives an error.
 func (client ImplicitClient) GetOptionalGlobalQuerySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetOptionalGlobalQueryResponder handles the response to the GetOptionalGlobalQuery request. The method always
@@ -90,7 +98,7 @@ func (client ImplicitClient) GetOptionalGlobalQueryResponder(resp *http.Response
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -99,14 +107,16 @@ func (client ImplicitClient) GetOptionalGlobalQueryResponder(resp *http.Response
 
 // GetRequiredGlobalPath test implicitly required path parameter
 func (client ImplicitClient) GetRequiredGlobalPath(ctx context.Context) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ImplicitClient.GetRequiredGlobalPath")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ImplicitClient.GetRequiredGlobalPath", "GET", "/reqopt/global/required/path/{required-global-path}")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ImplicitClient.GetRequiredGlobalPath", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetRequiredGlobalPathPreparer(ctx)
@@ -123,7 +133,7 @@ func (client ImplicitClient) GetRequiredGlobalPath(ctx context.Context) (result
 	}
 
 	result, err = client.GetRequiredGlobalPathResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ImplicitClient", "GetRequiredGlobalPath", resp, "Failure responding to request")
 	}
 
@@ -140,14 +150,15 @@ func (client ImplicitClient) GetRequiredGlobalPathPreparer(ctx context.Context)
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/reqopt/global/required/path/{required-global-path}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetRequiredGlobalPathSender sends the GetRequiredGlobalPath request. The method will close the
 // http.Response Body if it receives an error.
 func (client ImplicitClient) GetRequiredGlobalPathSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetRequiredGlobalPathResponder handles the response to the GetRequiredGlobalPath request. The method always
@@ -156,7 +167,7 @@ func (client ImplicitClient) GetRequiredGlobalPathResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -165,14 +176,16 @@ func (client ImplicitClient) GetRequiredGlobalPathResponder(resp *http.Response)
 
 // GetRequiredGlobalQuery test implicitly required query parameter
 func (client ImplicitClient) GetRequiredGlobalQuery(ctx context.Context) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ImplicitClient.GetRequiredGlobalQuery")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ImplicitClient.GetRequiredGlobalQuery", "GET", "/reqopt/global/required/query")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ImplicitClient.GetRequiredGlobalQuery", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetRequiredGlobalQueryPreparer(ctx)
@@ -189,7 +202,7 @@ func (client ImplicitClient) GetRequiredGlobalQuery(ctx context.Context) (result
 	}
 
 	result, err = client.GetRequiredGlobalQueryResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ImplicitClient", "GetRequiredGlobalQuery", resp, "Failure responding to request")
 	}
 
@@ -207,14 +220,15 @@ func (client ImplicitClient) GetRequiredGlobalQueryPreparer(ctx context.Context)
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/global/required/query"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetRequiredGlobalQuerySender sends the GetRequiredGlobalQuery request. The method will close the
 // http.Response Body if it receives an error.
 func (client ImplicitClient) GetRequiredGlobalQuerySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetRequiredGlobalQueryResponder handles the response to the GetRequiredGlobalQuery request. The method always
@@ -223,7 +237,7 @@ func (client ImplicitClient) GetRequiredGlobalQueryResponder(resp *http.Response
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -232,14 +246,18 @@ func (client ImplicitClient) GetRequiredGlobalQueryResponder(resp *http.Response
 
 // GetRequiredPath test implicitly required path parameter
 func (client ImplicitClient) GetRequiredPath(ctx context.Context, pathParameter string) (result Error, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ImplicitClient.GetRequiredPath")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ImplicitClient.GetRequiredPath", "GET", "/reqopt/implicit/required/path/{pathParameter}")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ImplicitClient.GetRequiredPath", map[string]interface{}{
+			"pathParameter": pathParameter,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetRequiredPathPreparer(ctx, pathParameter)
@@ -256,7 +274,7 @@ func (client ImplicitClient) GetRequiredPath(ctx context.Context, pathParameter
 	}
 
 	result, err = client.GetRequiredPathResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ImplicitClient", "GetRequiredPath", resp, "Failure responding to request")
 	}
 
@@ -273,14 +291,15 @@ func (client ImplicitClient) GetRequiredPathPreparer(ctx context.Context, pathPa
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/reqopt/implicit/required/path/{pathParameter}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetRequiredPathSender sends the GetRequiredPath request. The method will close the
 // http.Response Body if it receives an error.
 func (client ImplicitClient) GetRequiredPathSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetRequiredPathResponder handles the response to the GetRequiredPath request. The method always
@@ -289,7 +308,7 @@ func (client ImplicitClient) GetRequiredPathResponder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -297,18 +316,23 @@ func (client ImplicitClient) GetRequiredPathResponder(resp *http.Response) (resu
 }
 
 // PutOptionalBody test implicitly optional body parameter
-func (client ImplicitClient) PutOptionalBody(ctx context.Context, bodyParameter string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ImplicitClient.PutOptionalBody")
+func (client ImplicitClient) PutOptionalBody(ctx context.Context, options *ImplicitPutOptionalBodyOptions) (result autorest.Response, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ImplicitClient.PutOptionalBody", "PUT", "/reqopt/implicit/optional/body")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	req, err := client.PutOptionalBodyPreparer(ctx, bodyParameter)
+	if options == nil {
+		options = &ImplicitPutOptionalBodyOptions{}
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ImplicitClient.PutOptionalBody", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	req, err := client.PutOptionalBodyPreparer(ctx, options)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ImplicitClient", "PutOptionalBody", nil, "Failure preparing request")
 		return
@@ -322,7 +346,7 @@ func (client ImplicitClient) PutOptionalBody(ctx context.Context, bodyParameter
 	}
 
 	result, err = client.PutOptionalBodyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ImplicitClient", "PutOptionalBody", resp, "Failure responding to request")
 	}
 
@@ -330,24 +354,28 @@ func (client ImplicitClient) PutOptionalBody(ctx context.Context, bodyParameter
 }
 
 // PutOptionalBodyPreparer prepares the PutOptionalBody request.
-func (client ImplicitClient) PutOptionalBodyPreparer(ctx context.Context, bodyParameter string) (*http.Request, error) {
+func (client ImplicitClient) PutOptionalBodyPreparer(ctx context.Context, options *ImplicitPutOptionalBodyOptions) (*http.Request, error) {
+	if options == nil {
+		options = &ImplicitPutOptionalBodyOptions{}
+	}
 	preparer := autorest.CreatePreparer(
 		autorest.AsContentType("application/json; charset=utf-8"),
 		autorest.AsPut(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/implicit/optional/body"))
-	if len(bodyParameter) > 0 {
+	if len(options.BodyParameter) > 0 {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithJSON(bodyParameter))
+			autorest.WithJSON(options.BodyParameter))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutOptionalBodySender sends the PutOptionalBody request. The method will close the
 // http.Response Body if it receives an error.
 func (client ImplicitClient) PutOptionalBodySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutOptionalBodyResponder handles the response to the PutOptionalBody request. The method always
@@ -356,25 +384,32 @@ func (client ImplicitClient) PutOptionalBodyResponder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
 }
 
 // PutOptionalHeader test implicitly optional header parameter
-func (client ImplicitClient) PutOptionalHeader(ctx context.Context, queryParameter string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ImplicitClient.PutOptionalHeader")
+func (client ImplicitClient) PutOptionalHeader(ctx context.Context, options *ImplicitPutOptionalHeaderOptions) (result autorest.Response, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ImplicitClient.PutOptionalHeader", "PUT", "/reqopt/implicit/optional/header")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if options == nil {
+		options = &ImplicitPutOptionalHeaderOptions{}
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ImplicitClient.PutOptionalHeader", map[string]interface{}{
+			"queryParameter": options.QueryParameter,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	req, err := client.PutOptionalHeaderPreparer(ctx, queryParameter)
+	req, err := client.PutOptionalHeaderPreparer(ctx, options)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ImplicitClient", "PutOptionalHeader", nil, "Failure preparing request")
 		return
@@ -388,7 +423,7 @@ func (client ImplicitClient) PutOptionalHeader(ctx context.Context, queryParamet
 	}
 
 	result, err = client.PutOptionalHeaderResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ImplicitClient", "PutOptionalHeader", resp, "Failure responding to request")
 	}
 
@@ -396,23 +431,27 @@ func (client ImplicitClient) PutOptionalHeader(ctx context.Context, queryParamet
 }
 
 // PutOptionalHeaderPreparer prepares the PutOptionalHeader request.
-func (client ImplicitClient) PutOptionalHeaderPreparer(ctx context.Context, queryParameter string) (*http.Request, error) {
+func (client ImplicitClient) PutOptionalHeaderPreparer(ctx context.Context, options *ImplicitPutOptionalHeaderOptions) (*http.Request, error) {
+	if options == nil {
+		options = &ImplicitPutOptionalHeaderOptions{}
+	}
 	preparer := autorest.CreatePreparer(
 		autorest.AsPut(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/implicit/optional/header"))
-	if len(queryParameter) > 0 {
+	if len(options.QueryParameter) > 0 {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithHeader("queryParameter", autorest.String(queryParameter)))
+			autorest.WithHeader("queryParameter", autorest.String(options.QueryParameter)))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutOptionalHeaderSender sends the PutOptionalHeader request. The method will close the
 // http.Response Body if it receives an error.
 func (client ImplicitClient) PutOptionalHeaderSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutOptionalHeaderResponder handles the response to the PutOptionalHeader request. The method always
@@ -421,25 +460,32 @@ func (client ImplicitClient) PutOptionalHeaderResponder(resp *http.Response) (re
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
 }
 
 // PutOptionalQuery test implicitly optional query parameter
-func (client ImplicitClient) PutOptionalQuery(ctx context.Context, queryParameter string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ImplicitClient.PutOptionalQuery")
+func (client ImplicitClient) PutOptionalQuery(ctx context.Context, options *ImplicitPutOptionalQueryOptions) (result autorest.Response, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/ImplicitClient.PutOptionalQuery", "PUT", "/reqopt/implicit/optional/query")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	req, err := client.PutOptionalQueryPreparer(ctx, queryParameter)
+	if options == nil {
+		options = &ImplicitPutOptionalQueryOptions{}
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "ImplicitClient.PutOptionalQuery", map[string]interface{}{
+			"queryParameter": options.QueryParameter,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	req, err := client.PutOptionalQueryPreparer(ctx, options)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ImplicitClient", "PutOptionalQuery", nil, "Failure preparing request")
 		return
@@ -453,7 +499,7 @@ func (client ImplicitClient) PutOptionalQuery(ctx context.Context, queryParamete
 	}
 
 	result, err = client.PutOptionalQueryResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "optionalgroup.ImplicitClient", "PutOptionalQuery", resp, "Failure responding to request")
 	}
 
@@ -461,10 +507,13 @@ func (client ImplicitClient) PutOptionalQuery(ctx context.Context, queryParamete
 }
 
 // PutOptionalQueryPreparer prepares the PutOptionalQuery request.
-func (client ImplicitClient) PutOptionalQueryPreparer(ctx context.Context, queryParameter string) (*http.Request, error) {
+func (client ImplicitClient) PutOptionalQueryPreparer(ctx context.Context, options *ImplicitPutOptionalQueryOptions) (*http.Request, error) {
+	if options == nil {
+		options = &ImplicitPutOptionalQueryOptions{}
+	}
 	queryParameters := map[string]interface{}{}
-	if len(queryParameter) > 0 {
-		queryParameters["queryParameter"] = autorest.Encode("query", queryParameter)
+	if len(options.QueryParameter) > 0 {
+		queryParameters["queryParameter"] = autorest.Encode("query", options.QueryParameter)
 	}
 
 	preparer := autorest.CreatePreparer(
@@ -472,14 +521,15 @@ func (client ImplicitClient) PutOptionalQueryPreparer(ctx context.Context, query
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/reqopt/implicit/optional/query"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutOptionalQuerySender sends the PutOptionalQuery request. The method will close the
 // http.Response Body if it receives an error.
 func (client ImplicitClient) PutOptionalQuerySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutOptionalQueryResponder handles the response to the PutOptionalQuery request. The method always
@@ -488,7 +538,7 @@ func (client ImplicitClient) PutOptionalQueryResponder(resp *http.Response) (res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -7,7 +7,23 @@ package optionalgroup
 // Changes may cause incorrect behavior and will be lost if the code is regenerated.
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
 	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/validation"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // The package's fully qualified name.
@@ -18,21 +34,254 @@ type ArrayOptionalWrapper struct {
 	Value *[]string `json:"value,omitempty"`
 }
 
+// DeepCopy returns a copy of the ArrayOptionalWrapper that doesn't share any pointers, slices or maps with it.
+func (aow ArrayOptionalWrapper) DeepCopy() ArrayOptionalWrapper {
+	result := aow
+	if aow.Value != nil {
+		var v []string
+		if *aow.Value != nil {
+			v = make([]string, len(*aow.Value))
+			copy(v, *aow.Value)
+		}
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ArrayOptionalWrapper and other are equal.  Times are equal if they represent
+// the same instant.
+func (aow ArrayOptionalWrapper) Equal(other ArrayOptionalWrapper) bool {
+	return aow.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (aow ArrayOptionalWrapper) EqualIgnoreReadOnly(other ArrayOptionalWrapper) bool {
+	return aow.equal(other, true)
+}
+
+// equal compares the fields of the ArrayOptionalWrapper and other, skipping the read-only ones if ignoreReadOnly is true.
+func (aow ArrayOptionalWrapper) equal(other ArrayOptionalWrapper, ignoreReadOnly bool) bool {
+	if (aow.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if aow.Value != nil {
+		if (*aow.Value == nil) != (*other.Value == nil) || len(*aow.Value) != len(*other.Value) {
+			return false
+		}
+		for i := range *aow.Value {
+			if (*aow.Value)[i] != (*other.Value)[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ArrayOptionalWrapper to copies of the fields set in patch.  Nested models and maps
+// are merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (aow *ArrayOptionalWrapper) MergeFrom(patch ArrayOptionalWrapper) {
+	if patch.Value != nil {
+		var v []string
+		if *patch.Value != nil {
+			v = make([]string, len(*patch.Value))
+			copy(v, *patch.Value)
+		}
+		aow.Value = &v
+	}
+}
+
 // ArrayWrapper ...
 type ArrayWrapper struct {
 	Value *[]string `json:"value,omitempty"`
 }
 
+// Validate checks that the ArrayWrapper satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (aw ArrayWrapper) Validate() error {
+	var errs ValidationErrors
+	if aw.Value == nil {
+		errs = append(errs, ValidationError{Path: "value", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// DeepCopy returns a copy of the ArrayWrapper that doesn't share any pointers, slices or maps with it.
+func (aw ArrayWrapper) DeepCopy() ArrayWrapper {
+	result := aw
+	if aw.Value != nil {
+		var v []string
+		if *aw.Value != nil {
+			v = make([]string, len(*aw.Value))
+			copy(v, *aw.Value)
+		}
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ArrayWrapper and other are equal.  Times are equal if they represent the same
+// instant.
+func (aw ArrayWrapper) Equal(other ArrayWrapper) bool {
+	return aw.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (aw ArrayWrapper) EqualIgnoreReadOnly(other ArrayWrapper) bool {
+	return aw.equal(other, true)
+}
+
+// equal compares the fields of the ArrayWrapper and other, skipping the read-only ones if ignoreReadOnly is true.
+func (aw ArrayWrapper) equal(other ArrayWrapper, ignoreReadOnly bool) bool {
+	if (aw.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	if aw.Value != nil {
+		if (*aw.Value == nil) != (*other.Value == nil) || len(*aw.Value) != len(*other.Value) {
+			return false
+		}
+		for i := range *aw.Value {
+			if (*aw.Value)[i] != (*other.Value)[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ArrayWrapper to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (aw *ArrayWrapper) MergeFrom(patch ArrayWrapper) {
+	if patch.Value != nil {
+		var v []string
+		if *patch.Value != nil {
+			v = make([]string, len(*patch.Value))
+			copy(v, *patch.Value)
+		}
+		aw.Value = &v
+	}
+}
+
 // ClassOptionalWrapper ...
 type ClassOptionalWrapper struct {
 	Value *Product `json:"value,omitempty"`
 }
 
+// Validate checks that the ClassOptionalWrapper satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (cow ClassOptionalWrapper) Validate() error {
+	var errs ValidationErrors
+	if cow.Value != nil {
+		errs = errs.addNested("value", cow.Value.Validate())
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// DeepCopy returns a copy of the ClassOptionalWrapper that doesn't share any pointers, slices or maps with it.
+func (cow ClassOptionalWrapper) DeepCopy() ClassOptionalWrapper {
+	result := cow
+	if cow.Value != nil {
+		v := cow.Value.DeepCopy()
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ClassOptionalWrapper and other are equal.  Times are equal if they represent
+// the same instant.
+func (cow ClassOptionalWrapper) Equal(other ClassOptionalWrapper) bool {
+	return cow.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (cow ClassOptionalWrapper) EqualIgnoreReadOnly(other ClassOptionalWrapper) bool {
+	return cow.equal(other, true)
+}
+
+// equal compares the fields of the ClassOptionalWrapper and other, skipping the read-only ones if ignoreReadOnly is true.
+func (cow ClassOptionalWrapper) equal(other ClassOptionalWrapper, ignoreReadOnly bool) bool {
+	if (cow.Value == nil) != (other.Value == nil) || (cow.Value != nil && !cow.Value.equal(*other.Value, ignoreReadOnly)) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ClassOptionalWrapper to copies of the fields set in patch.  Nested models and maps
+// are merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (cow *ClassOptionalWrapper) MergeFrom(patch ClassOptionalWrapper) {
+	if patch.Value != nil {
+		if cow.Value == nil {
+			cow.Value = &Product{}
+		}
+		cow.Value.MergeFrom(*patch.Value)
+	}
+}
+
 // ClassWrapper ...
 type ClassWrapper struct {
 	Value *Product `json:"value,omitempty"`
 }
 
+// Validate checks that the ClassWrapper satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (cw ClassWrapper) Validate() error {
+	var errs ValidationErrors
+	if cw.Value == nil {
+		errs = append(errs, ValidationError{Path: "value", Constraint: "Null", Details: "value can not be null; required parameter"})
+	} else {
+		errs = errs.addNested("value", cw.Value.Validate())
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// DeepCopy returns a copy of the ClassWrapper that doesn't share any pointers, slices or maps with it.
+func (cw ClassWrapper) DeepCopy() ClassWrapper {
+	result := cw
+	if cw.Value != nil {
+		v := cw.Value.DeepCopy()
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ClassWrapper and other are equal.  Times are equal if they represent the same
+// instant.
+func (cw ClassWrapper) Equal(other ClassWrapper) bool {
+	return cw.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (cw ClassWrapper) EqualIgnoreReadOnly(other ClassWrapper) bool {
+	return cw.equal(other, true)
+}
+
+// equal compares the fields of the ClassWrapper and other, skipping the read-only ones if ignoreReadOnly is true.
+func (cw ClassWrapper) equal(other ClassWrapper, ignoreReadOnly bool) bool {
+	if (cw.Value == nil) != (other.Value == nil) || (cw.Value != nil && !cw.Value.equal(*other.Value, ignoreReadOnly)) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ClassWrapper to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (cw *ClassWrapper) MergeFrom(patch ClassWrapper) {
+	if patch.Value != nil {
+		if cw.Value == nil {
+			cw.Value = &Product{}
+		}
+		cw.Value.MergeFrom(*patch.Value)
+	}
+}
+
 // Error ...
 type Error struct {
 	autorest.Response `json:"-"`
@@ -40,28 +289,879 @@ type Error struct {
 	Message           *string `json:"message,omitempty"`
 }
 
+// DeepCopy returns a copy of the Error that doesn't share any pointers, slices or maps with it.  Its autorest.Response
+// is copied as is.
+func (e Error) DeepCopy() Error {
+	result := e
+	if e.Status != nil {
+		v := *e.Status
+		result.Status = &v
+	}
+	if e.Message != nil {
+		v := *e.Message
+		result.Message = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Error and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (e Error) Equal(other Error) bool {
+	return e.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (e Error) EqualIgnoreReadOnly(other Error) bool {
+	return e.equal(other, true)
+}
+
+// equal compares the fields of the Error and other, skipping the read-only ones if ignoreReadOnly is true.
+func (e Error) equal(other Error, ignoreReadOnly bool) bool {
+	if (e.Status == nil) != (other.Status == nil) || (e.Status != nil && *e.Status != *other.Status) {
+		return false
+	}
+	if (e.Message == nil) != (other.Message == nil) || (e.Message != nil && *e.Message != *other.Message) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Error to copies of the fields set in patch.  Nested models and maps are merged, the
+// values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (e *Error) MergeFrom(patch Error) {
+	if patch.Status != nil {
+		v := *patch.Status
+		e.Status = &v
+	}
+	if patch.Message != nil {
+		v := *patch.Message
+		e.Message = &v
+	}
+}
+
+// ErrorResponse is returned when an operation fails with a response containing the Error model.  Unlike the other
+// errors of the operations it isn't wrapped in an autorest.DetailedError.
+type ErrorResponse struct {
+	// Model - the error model unmarshalled from the response body.
+	Model Error
+	// StatusCode - the HTTP status code of the response.
+	StatusCode int
+	// Body - the raw response body.
+	Body []byte
+	// Err - the error encountered reading or unmarshalling the response body, if any.
+	Err error
+}
+
+// Error implements the error interface for type ErrorResponse.
+func (er ErrorResponse) Error() string {
+	if er.Err != nil {
+		return fmt.Sprintf("optionalgroup: StatusCode=%d Body=%s Err=%v", er.StatusCode, string(er.Body), er.Err)
+	}
+	return fmt.Sprintf("optionalgroup: StatusCode=%d Body=%s", er.StatusCode, string(er.Body))
+}
+
+// Unwrap returns the error encountered reading or unmarshalling the response body, if any.
+func (er ErrorResponse) Unwrap() error {
+	return er.Err
+}
+
+// withErrorResponseUnlessStatusCode returns a RespondDecorator that returns an ErrorResponse if the
+// response's status code isn't one of the specified codes.  The response body is left available to the caller.
+// When the body can't be read or unmarshalled the returned ErrorResponse wraps the error in its Err field.
+func withErrorResponseUnlessStatusCode(codes ...int) autorest.RespondDecorator {
+	return func(r autorest.Responder) autorest.Responder {
+		return autorest.ResponderFunc(func(resp *http.Response) error {
+			err := r.Respond(resp)
+			if err != nil || autorest.ResponseHasStatusCode(resp, codes...) {
+				return err
+			}
+			defer resp.Body.Close()
+			er := ErrorResponse{StatusCode: resp.StatusCode}
+			er.Body, er.Err = ioutil.ReadAll(resp.Body)
+			resp.Body = ioutil.NopCloser(bytes.NewReader(er.Body))
+			if er.Err == nil && len(bytes.TrimSpace(er.Body)) > 0 {
+				er.Err = json.Unmarshal(er.Body, &er.Model)
+			}
+			return er
+		})
+	}
+}
+
+// ExplicitPostOptionalArrayHeaderOptions contains the optional parameters for the ExplicitClient.PostOptionalArrayHeader method.
+type ExplicitPostOptionalArrayHeaderOptions struct {
+	HeaderParameter []string
+}
+
+// ExplicitPostOptionalArrayParameterOptions contains the optional parameters for the ExplicitClient.PostOptionalArrayParameter method.
+type ExplicitPostOptionalArrayParameterOptions struct {
+	BodyParameter []string
+}
+
+// ExplicitPostOptionalArrayPropertyOptions contains the optional parameters for the ExplicitClient.PostOptionalArrayProperty method.
+type ExplicitPostOptionalArrayPropertyOptions struct {
+	BodyParameter *ArrayOptionalWrapper
+}
+
+// ExplicitPostOptionalClassParameterOptions contains the optional parameters for the ExplicitClient.PostOptionalClassParameter method.
+type ExplicitPostOptionalClassParameterOptions struct {
+	BodyParameter *Product
+}
+
+// ExplicitPostOptionalClassPropertyOptions contains the optional parameters for the ExplicitClient.PostOptionalClassProperty method.
+type ExplicitPostOptionalClassPropertyOptions struct {
+	BodyParameter *ClassOptionalWrapper
+}
+
+// ExplicitPostOptionalIntegerHeaderOptions contains the optional parameters for the ExplicitClient.PostOptionalIntegerHeader method.
+type ExplicitPostOptionalIntegerHeaderOptions struct {
+	HeaderParameter *int32
+}
+
+// ExplicitPostOptionalIntegerParameterOptions contains the optional parameters for the ExplicitClient.PostOptionalIntegerParameter method.
+type ExplicitPostOptionalIntegerParameterOptions struct {
+	BodyParameter *int32
+}
+
+// ExplicitPostOptionalIntegerPropertyOptions contains the optional parameters for the ExplicitClient.PostOptionalIntegerProperty method.
+type ExplicitPostOptionalIntegerPropertyOptions struct {
+	BodyParameter *IntOptionalWrapper
+}
+
+// ExplicitPostOptionalStringHeaderOptions contains the optional parameters for the ExplicitClient.PostOptionalStringHeader method.
+type ExplicitPostOptionalStringHeaderOptions struct {
+	BodyParameter string
+}
+
+// ExplicitPostOptionalStringParameterOptions contains the optional parameters for the ExplicitClient.PostOptionalStringParameter method.
+type ExplicitPostOptionalStringParameterOptions struct {
+	BodyParameter string
+}
+
+// ExplicitPostOptionalStringPropertyOptions contains the optional parameters for the ExplicitClient.PostOptionalStringProperty method.
+type ExplicitPostOptionalStringPropertyOptions struct {
+	BodyParameter *StringOptionalWrapper
+}
+
+// ExponentialRetry is a RetryPolicy that retries failed requests with exponentially increasing delays.  When the
+// RetryPolicy of a client is nil one is created from its RetryAttempts and RetryDuration.  Unlike
+// autorest.DoRetryForStatusCodes it only retries idempotent requests unless RetryNonIdempotent is true, so POST and
+// PATCH requests, including the initial requests of long-running operations, aren't retried unless the service marks
+// their operation x-ms-idempotent.  Responses with status code 429 count against MaxRetries.
+type ExponentialRetry struct {
+	// MaxRetries - the maximum number of times a request is retried.
+	MaxRetries int
+	// Delay - the delay before the first retry.  The delay is doubled for each following retry.
+	Delay time.Duration
+	// MaxDelay - the maximum delay between retries.  Zero means no limit.
+	MaxDelay time.Duration
+	// MaxElapsed - requests aren't retried once the delay would exceed this much time since the first attempt.  Zero means no limit.
+	MaxElapsed time.Duration
+	// Jitter - the fraction, from 0 to 1, of each delay that's randomized to spread out the retries of concurrent requests.
+	Jitter float64
+	// StatusCodes - the status codes of the responses that are retried.  Defaults to autorest.StatusCodesForRetry.
+	StatusCodes []int
+	// RetryNonIdempotent - if true, requests that aren't idempotent, e.g. POST requests of operations the service doesn't mark as idempotent, are retried too.
+	RetryNonIdempotent bool
+}
+
+// defaultRetryJitter is the Jitter of the ExponentialRetry used when the RetryPolicy of a client is nil.
+const defaultRetryJitter = 0.2
+
+// RetryPolicy decides if and when a failed attempt to send a request is retried.
+type RetryPolicy interface {
+	// ShouldRetry returns the delay before the request is sent again, or false if it isn't retried.
+	ShouldRetry(attempt RetryAttempt) (time.Duration, bool)
+}
+
+// ImplicitPutOptionalBodyOptions contains the optional parameters for the ImplicitClient.PutOptionalBody method.
+type ImplicitPutOptionalBodyOptions struct {
+	BodyParameter string
+}
+
+// ImplicitPutOptionalHeaderOptions contains the optional parameters for the ImplicitClient.PutOptionalHeader method.
+type ImplicitPutOptionalHeaderOptions struct {
+	QueryParameter string
+}
+
+// ImplicitPutOptionalQueryOptions contains the optional parameters for the ImplicitClient.PutOptionalQuery method.
+type ImplicitPutOptionalQueryOptions struct {
+	QueryParameter string
+}
+
+// LogEvent is an event logged by the operations of a client when its Logger isn't nil.  The URLs and headers of the
+// requests aren't included as they can contain secrets.
+type LogEvent struct {
+	// Kind - the kind of the event.
+	Kind LogEventKind
+	// Operation - the name of the operation, qualified by the name of its client.
+	Operation string
+	// Fields - the parameters of the operation keyed by their names in the request, for LogOperationStart events.  Body parameters aren't included and the values of secret parameters are replaced with REDACTED.
+	Fields map[string]interface{}
+	// Attempt - the number of the failed attempt, starting at one, for LogRetry events.
+	Attempt int
+	// Delay - the delay before the request is sent again, for LogRetry events.
+	Delay time.Duration
+	// State - the status of the long-running operation, for LogPoll events.
+	State string
+	// StatusCode - the status code of the response, or zero if none was received.
+	StatusCode int
+	// Elapsed - the time elapsed since the operation, the page fetch or the polling started.
+	Elapsed time.Duration
+	// Err - the error the operation, the attempt or the poll failed with, if any.
+	Err error
+}
+
+// redactedLogValue replaces the values of secret parameters in the Fields of a LogEvent.
+const redactedLogValue = "REDACTED"
+
+// LogEventKind is the kind of a LogEvent.
+type LogEventKind string
+
+const (
+	// LogOperationStart is logged when an operation starts, with its parameters.
+	LogOperationStart LogEventKind = "OperationStart"
+	// LogOperationFinish is logged when an operation finishes.
+	LogOperationFinish LogEventKind = "OperationFinish"
+	// LogRetry is logged when a failed attempt to send a request is retried.
+	LogRetry LogEventKind = "Retry"
+	// LogPageFetch is logged when the next page of the results of an operation has been fetched.
+	LogPageFetch LogEventKind = "PageFetch"
+	// LogPoll is logged each time PollUntilDone has polled the status of a long-running operation.
+	LogPoll LogEventKind = "Poll"
+)
+
+// Logger receives the events logged by the operations of a client.
+type Logger interface {
+	// Log is called synchronously for each event.
+	Log(event LogEvent)
+}
+
+// LoggerFunc is a function implementing the Logger interface.
+type LoggerFunc func(event LogEvent)
+
+// Log implements the Logger interface for LoggerFunc.
+func (lf LoggerFunc) Log(event LogEvent) {
+	lf(event)
+}
+
+// String formats the event as a line of key=value pairs, e.g. to pass to log.Print.
+func (le LogEvent) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "event=%s operation=%s", le.Kind, le.Operation)
+	names := make([]string, 0, len(le.Fields))
+	for name := range le.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&b, " %s=%v", name, le.Fields[name])
+	}
+	if le.Attempt > 0 {
+		fmt.Fprintf(&b, " attempt=%d", le.Attempt)
+	}
+	if le.State != "" {
+		fmt.Fprintf(&b, " state=%s", le.State)
+	}
+	if le.StatusCode > 0 {
+		fmt.Fprintf(&b, " status=%d", le.StatusCode)
+	}
+	if le.Delay > 0 {
+		fmt.Fprintf(&b, " delay=%v", le.Delay)
+	}
+	if le.Kind != LogOperationStart {
+		fmt.Fprintf(&b, " elapsed=%v", le.Elapsed)
+	}
+	if le.Err != nil {
+		fmt.Fprintf(&b, " error=%q", le.Err.Error())
+	}
+	return b.String()
+}
+
+// operationLog is the state used to log the events of an operation, carried by the contexts of its requests.
+type operationLog struct {
+	logger    Logger
+	operation string
+	start     time.Time
+}
+
+// operationLogKey is the key of the operationLog in a context.
+type operationLogKey struct{}
+
+// withOperationLog returns a context carrying the state used to log the events of operation to logger.
+func withOperationLog(ctx context.Context, logger Logger, operation string) context.Context {
+	return context.WithValue(ctx, operationLogKey{}, &operationLog{logger: logger, operation: operation, start: time.Now()})
+}
+
+// startOperationLog logs the start of operation with its parameters, then returns a context carrying the state used to
+// log its other events.
+func startOperationLog(ctx context.Context, logger Logger, operation string, fields map[string]interface{}) context.Context {
+	for name, value := range fields {
+		// log the values of optional parameters rather than their addresses
+		v := reflect.ValueOf(value)
+		for v.Kind() == reflect.Ptr && !v.IsNil() {
+			v = v.Elem()
+		}
+		if v.Kind() == reflect.Ptr {
+			fields[name] = nil
+		} else if v.IsValid() {
+			fields[name] = v.Interface()
+		}
+	}
+	ctx = withOperationLog(ctx, logger, operation)
+	logger.Log(LogEvent{Kind: LogOperationStart, Operation: operation, Fields: fields})
+	return ctx
+}
+
+// logResponse logs event for the operation whose state is carried by ctx, if any, along with the status code of resp
+// if it isn't nil.
+func logResponse(ctx context.Context, event LogEvent, resp *http.Response) {
+	ol, ok := ctx.Value(operationLogKey{}).(*operationLog)
+	if !ok {
+		return
+	}
+	event.Operation = ol.operation
+	event.Elapsed = time.Since(ol.start)
+	if resp != nil {
+		event.StatusCode = resp.StatusCode
+	}
+	ol.logger.Log(event)
+}
+
+// RetryAttempt describes a failed attempt to send a request.
+type RetryAttempt struct {
+	// Request - the request that was sent.
+	Request *http.Request
+	// Response - the response received, or nil if sending the request failed.
+	Response *http.Response
+	// Err - the error returned when sending the request, if any.
+	Err error
+	// Attempt - the number of the attempt, starting at one.
+	Attempt int
+	// Elapsed - the time elapsed since the first attempt was sent.
+	Elapsed time.Duration
+	// Idempotent - true if the request can safely be sent more than once, either because its HTTP method is
+	// idempotent or because the service marks the operation as idempotent.
+	Idempotent bool
+}
+
+// ShouldRetry implements the RetryPolicy interface for ExponentialRetry.  A delay requested by the Retry-After header
+// of the response takes precedence over the computed one.
+func (er ExponentialRetry) ShouldRetry(attempt RetryAttempt) (time.Duration, bool) {
+	if attempt.Attempt > er.MaxRetries || !(attempt.Idempotent || er.RetryNonIdempotent) {
+		return 0, false
+	}
+	if attempt.Err != nil {
+		if !autorest.IsTemporaryNetworkError(attempt.Err) || autorest.IsTokenRefreshError(attempt.Err) {
+			return 0, false
+		}
+	} else {
+		codes := er.StatusCodes
+		if codes == nil {
+			codes = autorest.StatusCodesForRetry
+		}
+		if !autorest.ResponseHasStatusCode(attempt.Response, codes...) {
+			return 0, false
+		}
+	}
+	delay, ok := retryAfter(attempt.Response)
+	if !ok {
+		delay = er.Delay
+		for i := 1; i < attempt.Attempt && delay < math.MaxInt64/2; i++ {
+			delay *= 2
+		}
+		if er.MaxDelay > 0 && delay > er.MaxDelay {
+			delay = er.MaxDelay
+		}
+		if er.Jitter > 0 {
+			delay -= time.Duration(math.Min(er.Jitter, 1) * rand.Float64() * float64(delay))
+		}
+	}
+	if er.MaxElapsed > 0 && attempt.Elapsed+delay > er.MaxElapsed {
+		return 0, false
+	}
+	return delay, true
+}
+
+// retryAfter returns the delay requested by the Retry-After header of resp, and false if it doesn't have one.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	ra := resp.Header.Get("Retry-After")
+	if ra == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(ra); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(ra); err == nil {
+		if delay := time.Until(t); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// isIdempotentMethod returns true if requests using the HTTP method can safely be sent more than once.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodTrace:
+		return true
+	}
+	return false
+}
+
+// withRetryPolicy returns a SendDecorator that sends a request again after each failed attempt policy decides to retry.
+// If policy is nil an ExponentialRetry created from the RetryAttempts and RetryDuration of c is used.  idempotent is
+// true for operations the service marks as idempotent.
+func withRetryPolicy(policy RetryPolicy, c autorest.Client, idempotent bool) autorest.SendDecorator {
+	if policy == nil {
+		policy = ExponentialRetry{MaxRetries: c.RetryAttempts, Delay: c.RetryDuration, Jitter: defaultRetryJitter}
+	}
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+			idempotent := idempotent || isIdempotentMethod(r.Method)
+			start := time.Now()
+			for attempt := 1; ; attempt++ {
+				if err := rr.Prepare(); err != nil {
+					return nil, err
+				}
+				resp, err := s.Do(rr.Request())
+				if r.Context().Err() != nil {
+					return resp, err
+				}
+				delay, retry := policy.ShouldRetry(RetryAttempt{
+					Request:    rr.Request(),
+					Response:   resp,
+					Err:        err,
+					Attempt:    attempt,
+					Elapsed:    time.Since(start),
+					Idempotent: idempotent,
+				})
+				if !retry {
+					return resp, err
+				}
+				logResponse(r.Context(), LogEvent{Kind: LogRetry, Attempt: attempt, Delay: delay, Err: err}, resp)
+				setSpanAttribute(r.Context(), AttributeRetryCount, attempt)
+				if resp != nil {
+					autorest.Respond(resp, autorest.ByDiscardingBody(), autorest.ByClosing())
+				}
+				timer := time.NewTimer(delay)
+				select {
+				case <-timer.C:
+				case <-r.Context().Done():
+					timer.Stop()
+					return nil, r.Context().Err()
+				}
+			}
+		})
+	}
+}
+
 // IntOptionalWrapper ...
 type IntOptionalWrapper struct {
 	Value *int32 `json:"value,omitempty"`
 }
 
+// DeepCopy returns a copy of the IntOptionalWrapper that doesn't share any pointers, slices or maps with it.
+func (iow IntOptionalWrapper) DeepCopy() IntOptionalWrapper {
+	result := iow
+	if iow.Value != nil {
+		v := *iow.Value
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the IntOptionalWrapper and other are equal.  Times are equal if they represent
+// the same instant.
+func (iow IntOptionalWrapper) Equal(other IntOptionalWrapper) bool {
+	return iow.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (iow IntOptionalWrapper) EqualIgnoreReadOnly(other IntOptionalWrapper) bool {
+	return iow.equal(other, true)
+}
+
+// equal compares the fields of the IntOptionalWrapper and other, skipping the read-only ones if ignoreReadOnly is true.
+func (iow IntOptionalWrapper) equal(other IntOptionalWrapper, ignoreReadOnly bool) bool {
+	if (iow.Value == nil) != (other.Value == nil) || (iow.Value != nil && *iow.Value != *other.Value) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the IntOptionalWrapper to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (iow *IntOptionalWrapper) MergeFrom(patch IntOptionalWrapper) {
+	if patch.Value != nil {
+		v := *patch.Value
+		iow.Value = &v
+	}
+}
+
 // IntWrapper ...
 type IntWrapper struct {
 	Value *int32 `json:"value,omitempty"`
 }
 
+// Validate checks that the IntWrapper satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (iw IntWrapper) Validate() error {
+	var errs ValidationErrors
+	if iw.Value == nil {
+		errs = append(errs, ValidationError{Path: "value", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// DeepCopy returns a copy of the IntWrapper that doesn't share any pointers, slices or maps with it.
+func (iw IntWrapper) DeepCopy() IntWrapper {
+	result := iw
+	if iw.Value != nil {
+		v := *iw.Value
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the IntWrapper and other are equal.  Times are equal if they represent the same
+// instant.
+func (iw IntWrapper) Equal(other IntWrapper) bool {
+	return iw.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (iw IntWrapper) EqualIgnoreReadOnly(other IntWrapper) bool {
+	return iw.equal(other, true)
+}
+
+// equal compares the fields of the IntWrapper and other, skipping the read-only ones if ignoreReadOnly is true.
+func (iw IntWrapper) equal(other IntWrapper, ignoreReadOnly bool) bool {
+	if (iw.Value == nil) != (other.Value == nil) || (iw.Value != nil && *iw.Value != *other.Value) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the IntWrapper to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields aren't changed.
+func (iw *IntWrapper) MergeFrom(patch IntWrapper) {
+	if patch.Value != nil {
+		v := *patch.Value
+		iw.Value = &v
+	}
+}
+
 // Product ...
 type Product struct {
 	ID   *int32  `json:"id,omitempty"`
 	Name *string `json:"name,omitempty"`
 }
 
+// Validate checks that the Product satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (p Product) Validate() error {
+	var errs ValidationErrors
+	if p.ID == nil {
+		errs = append(errs, ValidationError{Path: "id", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// DeepCopy returns a copy of the Product that doesn't share any pointers, slices or maps with it.
+func (p Product) DeepCopy() Product {
+	result := p
+	if p.ID != nil {
+		v := *p.ID
+		result.ID = &v
+	}
+	if p.Name != nil {
+		v := *p.Name
+		result.Name = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Product and other are equal.  Times are equal if they represent the same
+// instant.
+func (p Product) Equal(other Product) bool {
+	return p.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (p Product) EqualIgnoreReadOnly(other Product) bool {
+	return p.equal(other, true)
+}
+
+// equal compares the fields of the Product and other, skipping the read-only ones if ignoreReadOnly is true.
+func (p Product) equal(other Product, ignoreReadOnly bool) bool {
+	if (p.ID == nil) != (other.ID == nil) || (p.ID != nil && *p.ID != *other.ID) {
+		return false
+	}
+	if (p.Name == nil) != (other.Name == nil) || (p.Name != nil && *p.Name != *other.Name) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Product to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields aren't changed.
+func (p *Product) MergeFrom(patch Product) {
+	if patch.ID != nil {
+		v := *patch.ID
+		p.ID = &v
+	}
+	if patch.Name != nil {
+		v := *patch.Name
+		p.Name = &v
+	}
+}
+
+// RequestOptions contains the per-call options applied to the requests of operations whose context was created with WithRequestOptions.
+type RequestOptions struct {
+	// Header - additional headers to send.  They replace any values of the same headers set by the operation.
+	Header http.Header
+	// Query - additional query parameters to send.  They replace any values of the same parameters set by the operation.
+	Query url.Values
+	// Timeout - if greater than zero, the maximum amount of time allowed for sending the request, including retries, and reading its response.
+	Timeout time.Duration
+}
+
+type requestOptionsKey struct{}
+
+// WithRequestOptions returns a copy of ctx carrying opts.  Operations called with the returned
+// context apply opts to their requests; other calls aren't affected.
+func WithRequestOptions(ctx context.Context, opts RequestOptions) context.Context {
+	return context.WithValue(ctx, requestOptionsKey{}, opts)
+}
+
+// withRequestOptions returns a PrepareDecorator that merges the headers and query parameters
+// of the RequestOptions carried by the request's context into the request.
+func withRequestOptions() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+			opts, ok := r.Context().Value(requestOptionsKey{}).(RequestOptions)
+			if !ok {
+				return r, nil
+			}
+			if len(opts.Header) > 0 {
+				if r.Header == nil {
+					r.Header = make(http.Header)
+				}
+				for k, v := range opts.Header {
+					r.Header[http.CanonicalHeaderKey(k)] = v
+				}
+			}
+			if len(opts.Query) > 0 {
+				q := r.URL.Query()
+				for k, v := range opts.Query {
+					q[k] = v
+				}
+				r.URL.RawQuery = q.Encode()
+			}
+			return r, nil
+		})
+	}
+}
+
+// sendWithRequestOptions sends the request like autorest.SendWithSender.  If the request's context
+// carries a RequestOptions with a Timeout, the request is cancelled once the timeout elapses
+// or the response body is closed, whichever happens first.
+func sendWithRequestOptions(s autorest.Sender, r *http.Request, decorators ...autorest.SendDecorator) (*http.Response, error) {
+	opts, ok := r.Context().Value(requestOptionsKey{}).(RequestOptions)
+	if !ok || opts.Timeout <= 0 {
+		return autorest.SendWithSender(s, r, decorators...)
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), opts.Timeout)
+	resp, err := autorest.SendWithSender(s, r.WithContext(ctx), decorators...)
+	if err != nil || resp == nil || resp.Body == nil {
+		cancel()
+		return resp, err
+	}
+	resp.Body = requestOptionsBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// requestOptionsBody releases the timeout of a request once its response body is closed.
+type requestOptionsBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b requestOptionsBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
 // StringOptionalWrapper ...
 type StringOptionalWrapper struct {
 	Value *string `json:"value,omitempty"`
 }
 
+// DeepCopy returns a copy of the StringOptionalWrapper that doesn't share any pointers, slices or maps with it.
+func (sow StringOptionalWrapper) DeepCopy() StringOptionalWrapper {
+	result := sow
+	if sow.Value != nil {
+		v := *sow.Value
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the StringOptionalWrapper and other are equal.  Times are equal if they represent
+// the same instant.
+func (sow StringOptionalWrapper) Equal(other StringOptionalWrapper) bool {
+	return sow.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (sow StringOptionalWrapper) EqualIgnoreReadOnly(other StringOptionalWrapper) bool {
+	return sow.equal(other, true)
+}
+
+// equal compares the fields of the StringOptionalWrapper and other, skipping the read-only ones if ignoreReadOnly is true.
+func (sow StringOptionalWrapper) equal(other StringOptionalWrapper, ignoreReadOnly bool) bool {
+	if (sow.Value == nil) != (other.Value == nil) || (sow.Value != nil && *sow.Value != *other.Value) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the StringOptionalWrapper to copies of the fields set in patch.  Nested models and maps
+// are merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (sow *StringOptionalWrapper) MergeFrom(patch StringOptionalWrapper) {
+	if patch.Value != nil {
+		v := *patch.Value
+		sow.Value = &v
+	}
+}
+
 // StringWrapper ...
 type StringWrapper struct {
 	Value *string `json:"value,omitempty"`
 }
+
+// Validate checks that the StringWrapper satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (sw StringWrapper) Validate() error {
+	var errs ValidationErrors
+	if sw.Value == nil {
+		errs = append(errs, ValidationError{Path: "value", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// DeepCopy returns a copy of the StringWrapper that doesn't share any pointers, slices or maps with it.
+func (sw StringWrapper) DeepCopy() StringWrapper {
+	result := sw
+	if sw.Value != nil {
+		v := *sw.Value
+		result.Value = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the StringWrapper and other are equal.  Times are equal if they represent the
+// same instant.
+func (sw StringWrapper) Equal(other StringWrapper) bool {
+	return sw.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (sw StringWrapper) EqualIgnoreReadOnly(other StringWrapper) bool {
+	return sw.equal(other, true)
+}
+
+// equal compares the fields of the StringWrapper and other, skipping the read-only ones if ignoreReadOnly is true.
+func (sw StringWrapper) equal(other StringWrapper, ignoreReadOnly bool) bool {
+	if (sw.Value == nil) != (other.Value == nil) || (sw.Value != nil && *sw.Value != *other.Value) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the StringWrapper to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (sw *StringWrapper) MergeFrom(patch StringWrapper) {
+	if patch.Value != nil {
+		v := *patch.Value
+		sw.Value = &v
+	}
+}
+
+// ValidationError describes a value that violates one of the constraints defined by the service.
+type ValidationError struct {
+	// Path - the JSON path of the value, relative to the value being validated.
+	Path string
+	// Constraint - the name of the violated constraint, e.g. MaxLength.
+	Constraint string
+	// Details - describes the violation.
+	Details string
+}
+
+// Error implements the error interface for type ValidationError.
+func (ve ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s (%s)", ve.Path, ve.Details, ve.Constraint)
+}
+
+// ValidationErrors contains every constraint violation found when validating a value.
+type ValidationErrors []ValidationError
+
+// Error implements the error interface for type ValidationErrors.
+func (ve ValidationErrors) Error() string {
+	msgs := make([]string, len(ve))
+	for i := range ve {
+		msgs[i] = ve[i].Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// addNested appends the violations in err, as returned by the Validate method
+// of the value at path, to ve after prefixing their paths with path.
+func (ve ValidationErrors) addNested(path string, err error) ValidationErrors {
+	if nested, ok := err.(ValidationErrors); ok {
+		for _, e := range nested {
+			e.Path = path + "." + e.Path
+			ve = append(ve, e)
+		}
+	} else if err != nil {
+		ve = append(ve, ValidationError{Path: path, Details: err.Error()})
+	}
+	return ve
+}
+
+// ParameterValidationError is returned by an operation whose parameters violate the constraints defined
+// by the service.  Use errors.As to retrieve the validation.Error or the ValidationErrors it wraps.
+type ParameterValidationError struct {
+	// Err - identifies the operation, its message lists every violation.
+	Err validation.Error
+	// Errors - contains every violation.
+	Errors ValidationErrors
+}
+
+// Error implements the error interface for type ParameterValidationError.
+func (pve ParameterValidationError) Error() string {
+	return pve.Err.Error()
+}
+
+// Unwrap returns the validation.Error and the ValidationErrors wrapped by the ParameterValidationError.
+func (pve ParameterValidationError) Unwrap() []error {
+	return []error{pve.Err, pve.Errors}
+}
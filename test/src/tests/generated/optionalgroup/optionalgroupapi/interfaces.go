@@ -18,26 +18,26 @@ type ImplicitClientAPI interface {
 	GetRequiredGlobalPath(ctx context.Context) (result optionalgroup.Error, err error)
 	GetRequiredGlobalQuery(ctx context.Context) (result optionalgroup.Error, err error)
 	GetRequiredPath(ctx context.Context, pathParameter string) (result optionalgroup.Error, err error)
-	PutOptionalBody(ctx context.Context, bodyParameter string) (result autorest.Response, err error)
-	PutOptionalHeader(ctx context.Context, queryParameter string) (result autorest.Response, err error)
-	PutOptionalQuery(ctx context.Context, queryParameter string) (result autorest.Response, err error)
+	PutOptionalBody(ctx context.Context, options *optionalgroup.ImplicitPutOptionalBodyOptions) (result autorest.Response, err error)
+	PutOptionalHeader(ctx context.Context, options *optionalgroup.ImplicitPutOptionalHeaderOptions) (result autorest.Response, err error)
+	PutOptionalQuery(ctx context.Context, options *optionalgroup.ImplicitPutOptionalQueryOptions) (result autorest.Response, err error)
 }
 
 var _ ImplicitClientAPI = (*optionalgroup.ImplicitClient)(nil)
 
 // ExplicitClientAPI contains the set of methods on the ExplicitClient type.
 type ExplicitClientAPI interface {
-	PostOptionalArrayHeader(ctx context.Context, headerParameter []string) (result autorest.Response, err error)
-	PostOptionalArrayParameter(ctx context.Context, bodyParameter []string) (result autorest.Response, err error)
-	PostOptionalArrayProperty(ctx context.Context, bodyParameter *optionalgroup.ArrayOptionalWrapper) (result autorest.Response, err error)
-	PostOptionalClassParameter(ctx context.Context, bodyParameter *optionalgroup.Product) (result autorest.Response, err error)
-	PostOptionalClassProperty(ctx context.Context, bodyParameter *optionalgroup.ClassOptionalWrapper) (result autorest.Response, err error)
-	PostOptionalIntegerHeader(ctx context.Context, headerParameter *int32) (result autorest.Response, err error)
-	PostOptionalIntegerParameter(ctx context.Context, bodyParameter *int32) (result autorest.Response, err error)
-	PostOptionalIntegerProperty(ctx context.Context, bodyParameter *optionalgroup.IntOptionalWrapper) (result autorest.Response, err error)
-	PostOptionalStringHeader(ctx context.Context, bodyParameter string) (result autorest.Response, err error)
-	PostOptionalStringParameter(ctx context.Context, bodyParameter string) (result autorest.Response, err error)
-	PostOptionalStringProperty(ctx context.Context, bodyParameter *optionalgroup.StringOptionalWrapper) (result autorest.Response, err error)
+	PostOptionalArrayHeader(ctx context.Context, options *optionalgroup.ExplicitPostOptionalArrayHeaderOptions) (result autorest.Response, err error)
+	PostOptionalArrayParameter(ctx context.Context, options *optionalgroup.ExplicitPostOptionalArrayParameterOptions) (result autorest.Response, err error)
+	PostOptionalArrayProperty(ctx context.Context, options *optionalgroup.ExplicitPostOptionalArrayPropertyOptions) (result autorest.Response, err error)
+	PostOptionalClassParameter(ctx context.Context, options *optionalgroup.ExplicitPostOptionalClassParameterOptions) (result autorest.Response, err error)
+	PostOptionalClassProperty(ctx context.Context, options *optionalgroup.ExplicitPostOptionalClassPropertyOptions) (result autorest.Response, err error)
+	PostOptionalIntegerHeader(ctx context.Context, options *optionalgroup.ExplicitPostOptionalIntegerHeaderOptions) (result autorest.Response, err error)
+	PostOptionalIntegerParameter(ctx context.Context, options *optionalgroup.ExplicitPostOptionalIntegerParameterOptions) (result autorest.Response, err error)
+	PostOptionalIntegerProperty(ctx context.Context, options *optionalgroup.ExplicitPostOptionalIntegerPropertyOptions) (result autorest.Response, err error)
+	PostOptionalStringHeader(ctx context.Context, options *optionalgroup.ExplicitPostOptionalStringHeaderOptions) (result autorest.Response, err error)
+	PostOptionalStringParameter(ctx context.Context, options *optionalgroup.ExplicitPostOptionalStringParameterOptions) (result autorest.Response, err error)
+	PostOptionalStringProperty(ctx context.Context, options *optionalgroup.ExplicitPostOptionalStringPropertyOptions) (result autorest.Response, err error)
 	PostRequiredArrayHeader(ctx context.Context, headerParameter []string) (result optionalgroup.Error, err error)
 	PostRequiredArrayParameter(ctx context.Context, bodyParameter []string) (result optionalgroup.Error, err error)
 	PostRequiredArrayProperty(ctx context.Context, bodyParameter optionalgroup.ArrayWrapper) (result optionalgroup.Error, err error)
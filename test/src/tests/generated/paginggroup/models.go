@@ -8,16 +8,31 @@ package paginggroup
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/to"
-	"github.com/Azure/go-autorest/tracing"
+	"io"
+	"math"
+	"math/rand"
 	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // The package's fully qualified name.
 const fqdn = "tests/generated/paginggroup"
 
+// ErrStopIteration can be returned from the callbacks passed to ForEach methods to stop the enumeration without an error.
+var ErrStopIteration = errors.New("stop iteration")
+
 // Status enumerates the values for status.
 type Status string
 
@@ -51,6 +66,413 @@ func PossibleStatusValues() []Status {
 	return []Status{Accepted, Canceled, Created, Creating, Deleted, Deleting, Failed, OK, Succeeded, Updated, Updating}
 }
 
+// knownStatusValues is the set of the possible values for the Status const type.
+var knownStatusValues = map[Status]struct{}{Accepted: {}, Canceled: {}, Created: {}, Creating: {}, Deleted: {}, Deleting: {}, Failed: {}, OK: {}, Succeeded: {}, Updated: {}, Updating: {}}
+
+// ParseStatus returns the Status whose value is s, or an error if s isn't one of its possible values.
+func ParseStatus(s string) (Status, error) {
+	if _, ok := knownStatusValues[Status(s)]; ok {
+		return Status(s), nil
+	}
+	return "", fmt.Errorf("%q isn't a possible value for Status", s)
+}
+
+// ParseStatusIgnoreCase returns the Status whose value equals s without regard to case, or an error if s isn't one of
+// its possible values.  Exact matches are preferred.
+func ParseStatusIgnoreCase(s string) (Status, error) {
+	if value, err := ParseStatus(s); err == nil {
+		return value, nil
+	}
+	for _, value := range PossibleStatusValues() {
+		if strings.EqualFold(string(value), s) {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("%q isn't a possible value for Status", s)
+}
+
+// IsKnown returns true if s is one of the possible values for the Status const type.
+func (s Status) IsKnown() bool {
+	_, ok := knownStatusValues[s]
+	return ok
+}
+
+// String returns the value of s.
+func (s Status) String() string {
+	return string(s)
+}
+
+// ExponentialRetry is a RetryPolicy that retries failed requests with exponentially increasing delays.  When the
+// RetryPolicy of a client is nil one is created from its RetryAttempts and RetryDuration.  Unlike
+// autorest.DoRetryForStatusCodes it only retries idempotent requests unless RetryNonIdempotent is true, so POST and
+// PATCH requests, including the initial requests of long-running operations, aren't retried unless the service marks
+// their operation x-ms-idempotent.  Responses with status code 429 count against MaxRetries.
+type ExponentialRetry struct {
+	// MaxRetries - the maximum number of times a request is retried.
+	MaxRetries int
+	// Delay - the delay before the first retry.  The delay is doubled for each following retry.
+	Delay time.Duration
+	// MaxDelay - the maximum delay between retries.  Zero means no limit.
+	MaxDelay time.Duration
+	// MaxElapsed - requests aren't retried once the delay would exceed this much time since the first attempt.  Zero means no limit.
+	MaxElapsed time.Duration
+	// Jitter - the fraction, from 0 to 1, of each delay that's randomized to spread out the retries of concurrent requests.
+	Jitter float64
+	// StatusCodes - the status codes of the responses that are retried.  Defaults to autorest.StatusCodesForRetry.
+	StatusCodes []int
+	// RetryNonIdempotent - if true, requests that aren't idempotent, e.g. POST requests of operations the service doesn't mark as idempotent, are retried too.
+	RetryNonIdempotent bool
+}
+
+// defaultRetryJitter is the Jitter of the ExponentialRetry used when the RetryPolicy of a client is nil.
+const defaultRetryJitter = 0.2
+
+// RetryPolicy decides if and when a failed attempt to send a request is retried.
+type RetryPolicy interface {
+	// ShouldRetry returns the delay before the request is sent again, or false if it isn't retried.
+	ShouldRetry(attempt RetryAttempt) (time.Duration, bool)
+}
+
+// LogEvent is an event logged by the operations of a client when its Logger isn't nil.  The URLs and headers of the
+// requests aren't included as they can contain secrets.
+type LogEvent struct {
+	// Kind - the kind of the event.
+	Kind LogEventKind
+	// Operation - the name of the operation, qualified by the name of its client.
+	Operation string
+	// Fields - the parameters of the operation keyed by their names in the request, for LogOperationStart events.  Body parameters aren't included and the values of secret parameters are replaced with REDACTED.
+	Fields map[string]interface{}
+	// Attempt - the number of the failed attempt, starting at one, for LogRetry events.
+	Attempt int
+	// Delay - the delay before the request is sent again, for LogRetry events.
+	Delay time.Duration
+	// State - the status of the long-running operation, for LogPoll events.
+	State string
+	// StatusCode - the status code of the response, or zero if none was received.
+	StatusCode int
+	// Elapsed - the time elapsed since the operation, the page fetch or the polling started.
+	Elapsed time.Duration
+	// Err - the error the operation, the attempt or the poll failed with, if any.
+	Err error
+}
+
+// redactedLogValue replaces the values of secret parameters in the Fields of a LogEvent.
+const redactedLogValue = "REDACTED"
+
+// LogEventKind is the kind of a LogEvent.
+type LogEventKind string
+
+const (
+	// LogOperationStart is logged when an operation starts, with its parameters.
+	LogOperationStart LogEventKind = "OperationStart"
+	// LogOperationFinish is logged when an operation finishes.
+	LogOperationFinish LogEventKind = "OperationFinish"
+	// LogRetry is logged when a failed attempt to send a request is retried.
+	LogRetry LogEventKind = "Retry"
+	// LogPageFetch is logged when the next page of the results of an operation has been fetched.
+	LogPageFetch LogEventKind = "PageFetch"
+	// LogPoll is logged each time PollUntilDone has polled the status of a long-running operation.
+	LogPoll LogEventKind = "Poll"
+)
+
+// Logger receives the events logged by the operations of a client.
+type Logger interface {
+	// Log is called synchronously for each event.
+	Log(event LogEvent)
+}
+
+// LoggerFunc is a function implementing the Logger interface.
+type LoggerFunc func(event LogEvent)
+
+// Log implements the Logger interface for LoggerFunc.
+func (lf LoggerFunc) Log(event LogEvent) {
+	lf(event)
+}
+
+// String formats the event as a line of key=value pairs, e.g. to pass to log.Print.
+func (le LogEvent) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "event=%s operation=%s", le.Kind, le.Operation)
+	names := make([]string, 0, len(le.Fields))
+	for name := range le.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&b, " %s=%v", name, le.Fields[name])
+	}
+	if le.Attempt > 0 {
+		fmt.Fprintf(&b, " attempt=%d", le.Attempt)
+	}
+	if le.State != "" {
+		fmt.Fprintf(&b, " state=%s", le.State)
+	}
+	if le.StatusCode > 0 {
+		fmt.Fprintf(&b, " status=%d", le.StatusCode)
+	}
+	if le.Delay > 0 {
+		fmt.Fprintf(&b, " delay=%v", le.Delay)
+	}
+	if le.Kind != LogOperationStart {
+		fmt.Fprintf(&b, " elapsed=%v", le.Elapsed)
+	}
+	if le.Err != nil {
+		fmt.Fprintf(&b, " error=%q", le.Err.Error())
+	}
+	return b.String()
+}
+
+// operationLog is the state used to log the events of an operation, carried by the contexts of its requests.
+type operationLog struct {
+	logger    Logger
+	operation string
+	start     time.Time
+}
+
+// operationLogKey is the key of the operationLog in a context.
+type operationLogKey struct{}
+
+// withOperationLog returns a context carrying the state used to log the events of operation to logger.
+func withOperationLog(ctx context.Context, logger Logger, operation string) context.Context {
+	return context.WithValue(ctx, operationLogKey{}, &operationLog{logger: logger, operation: operation, start: time.Now()})
+}
+
+// startOperationLog logs the start of operation with its parameters, then returns a context carrying the state used to
+// log its other events.
+func startOperationLog(ctx context.Context, logger Logger, operation string, fields map[string]interface{}) context.Context {
+	for name, value := range fields {
+		// log the values of optional parameters rather than their addresses
+		v := reflect.ValueOf(value)
+		for v.Kind() == reflect.Ptr && !v.IsNil() {
+			v = v.Elem()
+		}
+		if v.Kind() == reflect.Ptr {
+			fields[name] = nil
+		} else if v.IsValid() {
+			fields[name] = v.Interface()
+		}
+	}
+	ctx = withOperationLog(ctx, logger, operation)
+	logger.Log(LogEvent{Kind: LogOperationStart, Operation: operation, Fields: fields})
+	return ctx
+}
+
+// logResponse logs event for the operation whose state is carried by ctx, if any, along with the status code of resp
+// if it isn't nil.
+func logResponse(ctx context.Context, event LogEvent, resp *http.Response) {
+	ol, ok := ctx.Value(operationLogKey{}).(*operationLog)
+	if !ok {
+		return
+	}
+	event.Operation = ol.operation
+	event.Elapsed = time.Since(ol.start)
+	if resp != nil {
+		event.StatusCode = resp.StatusCode
+	}
+	ol.logger.Log(event)
+}
+
+// PagingGetMultiplePagesLROOptions contains the optional parameters for the PagingClient.GetMultiplePagesLRO method.
+type PagingGetMultiplePagesLROOptions struct {
+	ClientRequestID string
+	// Maxresults - sets the maximum number of items to return in the response.
+	Maxresults *int32
+	// Timeout - sets the maximum time that the server can spend processing the request, in seconds. The default is 30 seconds.
+	Timeout *int32
+}
+
+// PagingGetMultiplePagesOptions contains the optional parameters for the PagingClient.GetMultiplePages method.
+type PagingGetMultiplePagesOptions struct {
+	ClientRequestID string
+	// Maxresults - sets the maximum number of items to return in the response.
+	Maxresults *int32
+	// Timeout - sets the maximum time that the server can spend processing the request, in seconds. The default is 30 seconds.
+	Timeout *int32
+}
+
+// PagingGetMultiplePagesWithOffsetOptions contains the optional parameters for the PagingClient.GetMultiplePagesWithOffset method.
+type PagingGetMultiplePagesWithOffsetOptions struct {
+	ClientRequestID string
+	// Maxresults - sets the maximum number of items to return in the response.
+	Maxresults *int32
+	// Timeout - sets the maximum time that the server can spend processing the request, in seconds. The default is 30 seconds.
+	Timeout *int32
+}
+
+// PagingGetOdataMultiplePagesOptions contains the optional parameters for the PagingClient.GetOdataMultiplePages method.
+type PagingGetOdataMultiplePagesOptions struct {
+	ClientRequestID string
+	// Maxresults - sets the maximum number of items to return in the response.
+	Maxresults *int32
+	// Timeout - sets the maximum time that the server can spend processing the request, in seconds. The default is 30 seconds.
+	Timeout *int32
+}
+
+// RetryAttempt describes a failed attempt to send a request.
+type RetryAttempt struct {
+	// Request - the request that was sent.
+	Request *http.Request
+	// Response - the response received, or nil if sending the request failed.
+	Response *http.Response
+	// Err - the error returned when sending the request, if any.
+	Err error
+	// Attempt - the number of the attempt, starting at one.
+	Attempt int
+	// Elapsed - the time elapsed since the first attempt was sent.
+	Elapsed time.Duration
+	// Idempotent - true if the request can safely be sent more than once, either because its HTTP method is
+	// idempotent or because the service marks the operation as idempotent.
+	Idempotent bool
+}
+
+// ShouldRetry implements the RetryPolicy interface for ExponentialRetry.  A delay requested by the Retry-After header
+// of the response takes precedence over the computed one.
+func (er ExponentialRetry) ShouldRetry(attempt RetryAttempt) (time.Duration, bool) {
+	if attempt.Attempt > er.MaxRetries || !(attempt.Idempotent || er.RetryNonIdempotent) {
+		return 0, false
+	}
+	if attempt.Err != nil {
+		if !autorest.IsTemporaryNetworkError(attempt.Err) || autorest.IsTokenRefreshError(attempt.Err) {
+			return 0, false
+		}
+	} else {
+		codes := er.StatusCodes
+		if codes == nil {
+			codes = autorest.StatusCodesForRetry
+		}
+		if !autorest.ResponseHasStatusCode(attempt.Response, codes...) {
+			return 0, false
+		}
+	}
+	delay, ok := retryAfter(attempt.Response)
+	if !ok {
+		delay = er.Delay
+		for i := 1; i < attempt.Attempt && delay < math.MaxInt64/2; i++ {
+			delay *= 2
+		}
+		if er.MaxDelay > 0 && delay > er.MaxDelay {
+			delay = er.MaxDelay
+		}
+		if er.Jitter > 0 {
+			delay -= time.Duration(math.Min(er.Jitter, 1) * rand.Float64() * float64(delay))
+		}
+	}
+	if er.MaxElapsed > 0 && attempt.Elapsed+delay > er.MaxElapsed {
+		return 0, false
+	}
+	return delay, true
+}
+
+// retryAfter returns the delay requested by the Retry-After header of resp, and false if it doesn't have one.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	ra := resp.Header.Get("Retry-After")
+	if ra == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(ra); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(ra); err == nil {
+		if delay := time.Until(t); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// isIdempotentMethod returns true if requests using the HTTP method can safely be sent more than once.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodTrace:
+		return true
+	}
+	return false
+}
+
+// withRetryPolicy returns a SendDecorator that sends a request again after each failed attempt policy decides to retry.
+// If policy is nil an ExponentialRetry created from the RetryAttempts and RetryDuration of c is used.  idempotent is
+// true for operations the service marks as idempotent.
+func withRetryPolicy(policy RetryPolicy, c autorest.Client, idempotent bool) autorest.SendDecorator {
+	if policy == nil {
+		policy = ExponentialRetry{MaxRetries: c.RetryAttempts, Delay: c.RetryDuration, Jitter: defaultRetryJitter}
+	}
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+			idempotent := idempotent || isIdempotentMethod(r.Method)
+			start := time.Now()
+			for attempt := 1; ; attempt++ {
+				if err := rr.Prepare(); err != nil {
+					return nil, err
+				}
+				resp, err := s.Do(rr.Request())
+				if r.Context().Err() != nil {
+					return resp, err
+				}
+				delay, retry := policy.ShouldRetry(RetryAttempt{
+					Request:    rr.Request(),
+					Response:   resp,
+					Err:        err,
+					Attempt:    attempt,
+					Elapsed:    time.Since(start),
+					Idempotent: idempotent,
+				})
+				if !retry {
+					return resp, err
+				}
+				logResponse(r.Context(), LogEvent{Kind: LogRetry, Attempt: attempt, Delay: delay, Err: err}, resp)
+				setSpanAttribute(r.Context(), AttributeRetryCount, attempt)
+				if resp != nil {
+					autorest.Respond(resp, autorest.ByDiscardingBody(), autorest.ByClosing())
+				}
+				timer := time.NewTimer(delay)
+				select {
+				case <-timer.C:
+				case <-r.Context().Done():
+					timer.Stop()
+					return nil, r.Context().Err()
+				}
+			}
+		})
+	}
+}
+
+// futureResumeToken contains the state of a future and the operation that created it.
+type futureResumeToken struct {
+	Operation string       `json:"operation"`
+	Future    azure.Future `json:"future"`
+}
+
+// newResumeToken returns an opaque token containing the state of future, which was created by the specified operation.
+func newResumeToken(operation string, future azure.Future) (string, error) {
+	b, err := json.Marshal(futureResumeToken{Operation: operation, Future: future})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// parseResumeToken returns the future contained in token.
+// It returns an error if token wasn't created by the specified operation.
+func parseResumeToken(operation, token string) (azure.Future, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return azure.Future{}, err
+	}
+	rt := futureResumeToken{}
+	if err = json.Unmarshal(b, &rt); err != nil {
+		return azure.Future{}, err
+	}
+	if rt.Operation != operation {
+		return azure.Future{}, fmt.Errorf("resume token was created by operation %s, not %s", rt.Operation, operation)
+	}
+	return rt.Future, nil
+}
+
 // OdataProductResult ...
 type OdataProductResult struct {
 	autorest.Response `json:"-"`
@@ -58,6 +480,78 @@ type OdataProductResult struct {
 	OdataNextLink     *string    `json:"odata.nextLink,omitempty"`
 }
 
+// DeepCopy returns a copy of the OdataProductResult that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (opr OdataProductResult) DeepCopy() OdataProductResult {
+	result := opr
+	if opr.Values != nil {
+		var v []Product
+		if *opr.Values != nil {
+			v = make([]Product, len(*opr.Values))
+			for i := range *opr.Values {
+				v[i] = (*opr.Values)[i].DeepCopy()
+			}
+		}
+		result.Values = &v
+	}
+	if opr.OdataNextLink != nil {
+		v := *opr.OdataNextLink
+		result.OdataNextLink = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the OdataProductResult and other are equal.  Times are equal if they represent
+// the same instant.  Their autorest.Response isn't compared.
+func (opr OdataProductResult) Equal(other OdataProductResult) bool {
+	return opr.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (opr OdataProductResult) EqualIgnoreReadOnly(other OdataProductResult) bool {
+	return opr.equal(other, true)
+}
+
+// equal compares the fields of the OdataProductResult and other, skipping the read-only ones if ignoreReadOnly is true.
+func (opr OdataProductResult) equal(other OdataProductResult, ignoreReadOnly bool) bool {
+	if (opr.Values == nil) != (other.Values == nil) {
+		return false
+	}
+	if opr.Values != nil {
+		if (*opr.Values == nil) != (*other.Values == nil) || len(*opr.Values) != len(*other.Values) {
+			return false
+		}
+		for i := range *opr.Values {
+			if !(*opr.Values)[i].equal((*other.Values)[i], ignoreReadOnly) {
+				return false
+			}
+		}
+	}
+	if (opr.OdataNextLink == nil) != (other.OdataNextLink == nil) || (opr.OdataNextLink != nil && *opr.OdataNextLink != *other.OdataNextLink) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the OdataProductResult to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (opr *OdataProductResult) MergeFrom(patch OdataProductResult) {
+	if patch.Values != nil {
+		var v []Product
+		if *patch.Values != nil {
+			v = make([]Product, len(*patch.Values))
+			for i := range *patch.Values {
+				v[i] = (*patch.Values)[i].DeepCopy()
+			}
+		}
+		opr.Values = &v
+	}
+	if patch.OdataNextLink != nil {
+		v := *patch.OdataNextLink
+		opr.OdataNextLink = &v
+	}
+}
+
 // OdataProductResultIterator provides access to a complete listing of Product values.
 type OdataProductResultIterator struct {
 	i    int
@@ -67,16 +561,6 @@ type OdataProductResultIterator struct {
 // NextWithContext advances to the next value.  If there was an error making
 // the request the iterator does not advance and the error is returned.
 func (iter *OdataProductResultIterator) NextWithContext(ctx context.Context) (err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/OdataProductResultIterator.NextWithContext")
-		defer func() {
-			sc := -1
-			if iter.Response().Response.Response != nil {
-				sc = iter.Response().Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
-		}()
-	}
 	iter.i++
 	if iter.i < len(iter.page.Values()) {
 		return nil
@@ -116,6 +600,49 @@ func (iter OdataProductResultIterator) Value() Product {
 	return iter.page.Values()[iter.i]
 }
 
+// ForEach calls fn for each remaining value, automatically crossing page boundaries as required.
+// Enumeration stops at the first error returned from fn or from retrieving a page, which is then returned.
+// If fn returns ErrStopIteration the enumeration stops and ForEach returns nil.
+func (iter *OdataProductResultIterator) ForEach(ctx context.Context, fn func(Product) error) error {
+	for iter.NotDone() {
+		if err := fn(iter.Value()); err != nil {
+			if errors.Is(err, ErrStopIteration) {
+				return nil
+			}
+			return err
+		}
+		if err := iter.NextWithContext(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Stream returns a channel that receives each remaining value, automatically crossing page boundaries as required.
+// When the enumeration completes or fails at most one error is sent on the errors channel, which is buffered, before
+// both channels are closed, so the error can be received once the values channel is closed.  Cancel ctx to stop the
+// enumeration early.  The iterator must not be used until the values channel is closed.
+func (iter *OdataProductResultIterator) Stream(ctx context.Context) (<-chan Product, <-chan error) {
+	values := make(chan Product)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(errs)
+		defer close(values)
+		err := iter.ForEach(ctx, func(v Product) error {
+			select {
+			case values <- v:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil {
+			errs <- err
+		}
+	}()
+	return values, errs
+}
+
 // Creates a new instance of the OdataProductResultIterator type.
 func NewOdataProductResultIterator(page OdataProductResultPage) OdataProductResultIterator {
 	return OdataProductResultIterator{page: page}
@@ -135,7 +662,8 @@ func (opr OdataProductResult) odataProductResultPreparer(ctx context.Context) (*
 	return autorest.Prepare((&http.Request{}).WithContext(ctx),
 		autorest.AsJSON(),
 		autorest.AsGet(),
-		autorest.WithBaseURL(to.String(opr.OdataNextLink)))
+		autorest.WithBaseURL(to.String(opr.OdataNextLink)),
+		withRequestOptions())
 }
 
 // OdataProductResultPage contains a page of Product values.
@@ -147,16 +675,6 @@ type OdataProductResultPage struct {
 // NextWithContext advances to the next page of values.  If there was an error making
 // the request the page does not advance and the error is returned.
 func (page *OdataProductResultPage) NextWithContext(ctx context.Context) (err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/OdataProductResultPage.NextWithContext")
-		defer func() {
-			sc := -1
-			if page.Response().Response.Response != nil {
-				sc = page.Response().Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
-		}()
-	}
 	next, err := page.fn(ctx, page.opr)
 	if err != nil {
 		return err
@@ -190,17 +708,155 @@ func (page OdataProductResultPage) Values() []Product {
 	return *page.opr.Values
 }
 
+// ContinuationToken returns a token that can be passed to the FromToken method of the operation that returned
+// this page to resume the enumeration at the following page.  It returns an empty string if this is the last page.
+func (page OdataProductResultPage) ContinuationToken() string {
+	return to.String(page.opr.OdataNextLink)
+}
+
+// ForEach calls fn for each value in the current and remaining pages, advancing the page as required.
+// Enumeration stops at the first error returned from fn or from retrieving a page, which is then returned.
+// If fn returns ErrStopIteration the enumeration stops and ForEach returns nil.
+func (page *OdataProductResultPage) ForEach(ctx context.Context, fn func(Product) error) error {
+	for page.NotDone() {
+		for _, v := range page.Values() {
+			if err := fn(v); err != nil {
+				if errors.Is(err, ErrStopIteration) {
+					return nil
+				}
+				return err
+			}
+		}
+		if err := page.NextWithContext(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Stream returns a channel that receives each remaining value, automatically crossing page boundaries as required.
+// When the enumeration completes or fails at most one error is sent on the errors channel, which is buffered, before
+// both channels are closed, so the error can be received once the values channel is closed.  Cancel ctx to stop the
+// enumeration early.  The page must not be used until the values channel is closed.
+func (page *OdataProductResultPage) Stream(ctx context.Context) (<-chan Product, <-chan error) {
+	values := make(chan Product)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(errs)
+		defer close(values)
+		err := page.ForEach(ctx, func(v Product) error {
+			select {
+			case values <- v:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil {
+			errs <- err
+		}
+	}()
+	return values, errs
+}
+
 // Creates a new instance of the OdataProductResultPage type.
 func NewOdataProductResultPage(getNextPage func(context.Context, OdataProductResult) (OdataProductResult, error)) OdataProductResultPage {
 	return OdataProductResultPage{fn: getNextPage}
 }
 
+// NewOdataProductResultPageWithPrefetch creates a new instance of the OdataProductResultPage type that retrieves up to depth
+// pages after page in a background goroutine.  Pages and errors are returned by NextWithContext in order.
+// Prefetching stops at the first error, when ctx is cancelled or when the returned function is called, which
+// must be done to release its resources if the enumeration is abandoned before it completes.
+func NewOdataProductResultPageWithPrefetch(ctx context.Context, page OdataProductResultPage, depth int) (OdataProductResultPage, context.CancelFunc) {
+	if depth < 1 {
+		depth = 1
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	type fetched struct {
+		opr OdataProductResult
+		err error
+	}
+	var failed error
+	pages := make(chan fetched, depth)
+	go func() {
+		defer close(pages)
+		for last := page.opr; ; {
+			next, err := page.fn(ctx, last)
+			select {
+			case pages <- fetched{opr: next, err: err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				failed = err
+				return
+			}
+			if next.IsEmpty() {
+				return
+			}
+			last = next
+		}
+	}()
+	getNextPage := func(c context.Context, _ OdataProductResult) (OdataProductResult, error) {
+		if err := ctx.Err(); err != nil {
+			return OdataProductResult{}, err
+		}
+		select {
+		case f, ok := <-pages:
+			if !ok {
+				if failed != nil {
+					return OdataProductResult{}, failed
+				}
+				return OdataProductResult{}, ctx.Err()
+			}
+			return f.opr, f.err
+		case <-c.Done():
+			return OdataProductResult{}, c.Err()
+		}
+	}
+	return OdataProductResultPage{fn: getNextPage, opr: page.opr}, cancel
+}
+
 // OperationResult ...
 type OperationResult struct {
 	// Status - The status of the request. Possible values include: 'Succeeded', 'Failed', 'Canceled', 'Accepted', 'Creating', 'Created', 'Updating', 'Updated', 'Deleting', 'Deleted', 'OK'
 	Status Status `json:"status,omitempty"`
 }
 
+// DeepCopy returns a copy of the OperationResult that doesn't share any pointers, slices or maps with it.
+func (or OperationResult) DeepCopy() OperationResult {
+	result := or
+	return result
+}
+
+// Equal returns true if the fields of the OperationResult and other are equal.  Times are equal if they represent the
+// same instant.
+func (or OperationResult) Equal(other OperationResult) bool {
+	return or.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (or OperationResult) EqualIgnoreReadOnly(other OperationResult) bool {
+	return or.equal(other, true)
+}
+
+// equal compares the fields of the OperationResult and other, skipping the read-only ones if ignoreReadOnly is true.
+func (or OperationResult) equal(other OperationResult, ignoreReadOnly bool) bool {
+	if or.Status != other.Status {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the OperationResult to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (or *OperationResult) MergeFrom(patch OperationResult) {
+	if patch.Status != "" {
+		or.Status = patch.Status
+	}
+}
+
 // PagingGetMultiplePagesLROAllFuture an abstraction for monitoring and retrieving the results of a
 // long-running operation.
 type PagingGetMultiplePagesLROAllFuture struct {
@@ -210,8 +866,36 @@ type PagingGetMultiplePagesLROAllFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *PagingGetMultiplePagesLROAllFuture) Result(client PagingClient) (prp ProductResultPage, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *PagingGetMultiplePagesLROAllFuture) PollUntilDone(ctx context.Context, client PagingClient, opts PollUntilDoneOptions) (prp ProductResultPage, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "PagingClient.GetMultiplePagesLROComplete")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingGetMultiplePagesLROAllFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "paginggroup.PagingGetMultiplePagesLROAllFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *PagingGetMultiplePagesLROAllFuture) ResultWithContext(ctx context.Context, client PagingClient) (prp ProductResultPage, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "paginggroup.PagingGetMultiplePagesLROAllFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -220,7 +904,6 @@ func (future *PagingGetMultiplePagesLROAllFuture) Result(client PagingClient) (p
 		err = azure.NewAsyncOpIncompleteError("paginggroup.PagingGetMultiplePagesLROAllFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if prp.pr.Response.Response, err = future.GetResult(sender); err == nil && prp.pr.Response.Response.StatusCode != http.StatusNoContent {
 		prp, err = client.GetMultiplePagesLROResponder(prp.pr.Response.Response)
 		if err != nil {
@@ -239,8 +922,36 @@ type PagingGetMultiplePagesLROFuture struct {
 // Result returns the result of the asynchronous operation.
 // If the operation has not completed it will return an error.
 func (future *PagingGetMultiplePagesLROFuture) Result(client PagingClient) (prp ProductResultPage, err error) {
+	return future.ResultWithContext(context.Background(), client)
+}
+
+// PollUntilDone polls the status of the asynchronous operation until it completes, then returns its result.
+// Polling stops with an error if ctx is cancelled or opts.MaxWait elapses before the operation completes.
+func (future *PagingGetMultiplePagesLROFuture) PollUntilDone(ctx context.Context, client PagingClient, opts PollUntilDoneOptions) (prp ProductResultPage, err error) {
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "PagingClient.GetMultiplePagesLRO")
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingGetMultiplePagesLROFuture.PollUntilDone", "", "")
+		defer func() {
+			endSpan(ctx, future.Response(), err)
+		}()
+	}
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	err = pollUntilDone(ctx, &future.Future, client.Client, sender, opts)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "paginggroup.PagingGetMultiplePagesLROFuture", "PollUntilDone", future.Response(), "Polling failure")
+		return
+	}
+	return future.ResultWithContext(ctx, client)
+}
+
+// ResultWithContext returns the result of the asynchronous operation.
+// If the operation has not completed it will return an error.
+func (future *PagingGetMultiplePagesLROFuture) ResultWithContext(ctx context.Context, client PagingClient) (prp ProductResultPage, err error) {
+	sender := autorest.DecorateSender(client.pipeline(), withRetryPolicy(client.RetryPolicy, client.Client, false), withRequestContext(ctx))
 	var done bool
-	done, err = future.DoneWithContext(context.Background(), client)
+	done, err = future.DoneWithContext(ctx, sender)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "paginggroup.PagingGetMultiplePagesLROFuture", "Result", future.Response(), "Polling failure")
 		return
@@ -249,7 +960,6 @@ func (future *PagingGetMultiplePagesLROFuture) Result(client PagingClient) (prp
 		err = azure.NewAsyncOpIncompleteError("paginggroup.PagingGetMultiplePagesLROFuture")
 		return
 	}
-	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
 	if prp.pr.Response.Response, err = future.GetResult(sender); err == nil && prp.pr.Response.Response.StatusCode != http.StatusNoContent {
 		prp, err = client.GetMultiplePagesLROResponder(prp.pr.Response.Response)
 		if err != nil {
@@ -259,17 +969,173 @@ func (future *PagingGetMultiplePagesLROFuture) Result(client PagingClient) (prp
 	return
 }
 
+// ResumeToken returns a token that can be passed to PagingClient.ResumeGetMultiplePagesLRO to rebuild
+// this future, e.g. after a process restart.
+func (future *PagingGetMultiplePagesLROFuture) ResumeToken() (string, error) {
+	return newResumeToken("paginggroup.PagingGetMultiplePagesLROFuture", future.Future)
+}
+
+// PollUntilDoneOptions contains the optional parameters for the PollUntilDone methods of the futures.
+type PollUntilDoneOptions struct {
+	// Frequency - the delay between status checks.  If zero, the delay requested by the service or the client's PollingDelay is used.
+	Frequency time.Duration
+	// MaxWait - the maximum amount of time to wait for the operation to complete.  If zero, polling continues until the operation completes or the context is cancelled.
+	MaxWait time.Duration
+	// Progress - if not nil, it's called with the operation's status after each status check.
+	Progress func(status string)
+}
+
+// pollUntilDone polls the specified future with sender until its operation completes, ctx is cancelled or opts.MaxWait
+// elapses.  Polling errors are returned at once as the RetryPolicy of sender already retried the transient ones.
+func pollUntilDone(ctx context.Context, future *azure.Future, client autorest.Client, sender autorest.Sender, opts PollUntilDoneOptions) error {
+	if opts.MaxWait > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.MaxWait)
+		defer cancel()
+	}
+	for {
+		pctx := startChildSpan(ctx, "poll")
+		done, err := future.DoneWithContext(pctx, sender)
+		endSpan(pctx, future.Response(), err)
+		logResponse(ctx, LogEvent{Kind: LogPoll, State: future.Status(), Err: err}, future.Response())
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		if opts.Progress != nil {
+			opts.Progress(future.Status())
+		}
+		if done {
+			return nil
+		}
+		delay := opts.Frequency
+		if delay == 0 {
+			var ok bool
+			if delay, ok = future.GetPollingDelay(); !ok {
+				delay = client.PollingDelay
+			}
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// withRequestContext returns a SendDecorator that associates ctx with outgoing requests.
+func withRequestContext(ctx context.Context) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			return s.Do(r.WithContext(ctx))
+		})
+	}
+}
+
 // Product ...
 type Product struct {
 	Properties *ProductProperties `json:"properties,omitempty"`
 }
 
+// DeepCopy returns a copy of the Product that doesn't share any pointers, slices or maps with it.
+func (p Product) DeepCopy() Product {
+	result := p
+	if p.Properties != nil {
+		v := p.Properties.DeepCopy()
+		result.Properties = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Product and other are equal.  Times are equal if they represent the same
+// instant.
+func (p Product) Equal(other Product) bool {
+	return p.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (p Product) EqualIgnoreReadOnly(other Product) bool {
+	return p.equal(other, true)
+}
+
+// equal compares the fields of the Product and other, skipping the read-only ones if ignoreReadOnly is true.
+func (p Product) equal(other Product, ignoreReadOnly bool) bool {
+	if (p.Properties == nil) != (other.Properties == nil) || (p.Properties != nil && !p.Properties.equal(*other.Properties, ignoreReadOnly)) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Product to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields aren't changed.
+func (p *Product) MergeFrom(patch Product) {
+	if patch.Properties != nil {
+		if p.Properties == nil {
+			p.Properties = &ProductProperties{}
+		}
+		p.Properties.MergeFrom(*patch.Properties)
+	}
+}
+
 // ProductProperties ...
 type ProductProperties struct {
 	ID   *int32  `json:"id,omitempty"`
 	Name *string `json:"name,omitempty"`
 }
 
+// DeepCopy returns a copy of the ProductProperties that doesn't share any pointers, slices or maps with it.
+func (pp ProductProperties) DeepCopy() ProductProperties {
+	result := pp
+	if pp.ID != nil {
+		v := *pp.ID
+		result.ID = &v
+	}
+	if pp.Name != nil {
+		v := *pp.Name
+		result.Name = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ProductProperties and other are equal.  Times are equal if they represent the
+// same instant.
+func (pp ProductProperties) Equal(other ProductProperties) bool {
+	return pp.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (pp ProductProperties) EqualIgnoreReadOnly(other ProductProperties) bool {
+	return pp.equal(other, true)
+}
+
+// equal compares the fields of the ProductProperties and other, skipping the read-only ones if ignoreReadOnly is true.
+func (pp ProductProperties) equal(other ProductProperties, ignoreReadOnly bool) bool {
+	if (pp.ID == nil) != (other.ID == nil) || (pp.ID != nil && *pp.ID != *other.ID) {
+		return false
+	}
+	if (pp.Name == nil) != (other.Name == nil) || (pp.Name != nil && *pp.Name != *other.Name) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ProductProperties to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (pp *ProductProperties) MergeFrom(patch ProductProperties) {
+	if patch.ID != nil {
+		v := *patch.ID
+		pp.ID = &v
+	}
+	if patch.Name != nil {
+		v := *patch.Name
+		pp.Name = &v
+	}
+}
+
 // ProductResult ...
 type ProductResult struct {
 	autorest.Response `json:"-"`
@@ -277,6 +1143,78 @@ type ProductResult struct {
 	NextLink          *string    `json:"nextLink,omitempty"`
 }
 
+// DeepCopy returns a copy of the ProductResult that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (pr ProductResult) DeepCopy() ProductResult {
+	result := pr
+	if pr.Values != nil {
+		var v []Product
+		if *pr.Values != nil {
+			v = make([]Product, len(*pr.Values))
+			for i := range *pr.Values {
+				v[i] = (*pr.Values)[i].DeepCopy()
+			}
+		}
+		result.Values = &v
+	}
+	if pr.NextLink != nil {
+		v := *pr.NextLink
+		result.NextLink = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ProductResult and other are equal.  Times are equal if they represent the
+// same instant.  Their autorest.Response isn't compared.
+func (pr ProductResult) Equal(other ProductResult) bool {
+	return pr.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (pr ProductResult) EqualIgnoreReadOnly(other ProductResult) bool {
+	return pr.equal(other, true)
+}
+
+// equal compares the fields of the ProductResult and other, skipping the read-only ones if ignoreReadOnly is true.
+func (pr ProductResult) equal(other ProductResult, ignoreReadOnly bool) bool {
+	if (pr.Values == nil) != (other.Values == nil) {
+		return false
+	}
+	if pr.Values != nil {
+		if (*pr.Values == nil) != (*other.Values == nil) || len(*pr.Values) != len(*other.Values) {
+			return false
+		}
+		for i := range *pr.Values {
+			if !(*pr.Values)[i].equal((*other.Values)[i], ignoreReadOnly) {
+				return false
+			}
+		}
+	}
+	if (pr.NextLink == nil) != (other.NextLink == nil) || (pr.NextLink != nil && *pr.NextLink != *other.NextLink) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ProductResult to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (pr *ProductResult) MergeFrom(patch ProductResult) {
+	if patch.Values != nil {
+		var v []Product
+		if *patch.Values != nil {
+			v = make([]Product, len(*patch.Values))
+			for i := range *patch.Values {
+				v[i] = (*patch.Values)[i].DeepCopy()
+			}
+		}
+		pr.Values = &v
+	}
+	if patch.NextLink != nil {
+		v := *patch.NextLink
+		pr.NextLink = &v
+	}
+}
+
 // ProductResultIterator provides access to a complete listing of Product values.
 type ProductResultIterator struct {
 	i    int
@@ -286,16 +1224,6 @@ type ProductResultIterator struct {
 // NextWithContext advances to the next value.  If there was an error making
 // the request the iterator does not advance and the error is returned.
 func (iter *ProductResultIterator) NextWithContext(ctx context.Context) (err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ProductResultIterator.NextWithContext")
-		defer func() {
-			sc := -1
-			if iter.Response().Response.Response != nil {
-				sc = iter.Response().Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
-		}()
-	}
 	iter.i++
 	if iter.i < len(iter.page.Values()) {
 		return nil
@@ -335,6 +1263,49 @@ func (iter ProductResultIterator) Value() Product {
 	return iter.page.Values()[iter.i]
 }
 
+// ForEach calls fn for each remaining value, automatically crossing page boundaries as required.
+// Enumeration stops at the first error returned from fn or from retrieving a page, which is then returned.
+// If fn returns ErrStopIteration the enumeration stops and ForEach returns nil.
+func (iter *ProductResultIterator) ForEach(ctx context.Context, fn func(Product) error) error {
+	for iter.NotDone() {
+		if err := fn(iter.Value()); err != nil {
+			if errors.Is(err, ErrStopIteration) {
+				return nil
+			}
+			return err
+		}
+		if err := iter.NextWithContext(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Stream returns a channel that receives each remaining value, automatically crossing page boundaries as required.
+// When the enumeration completes or fails at most one error is sent on the errors channel, which is buffered, before
+// both channels are closed, so the error can be received once the values channel is closed.  Cancel ctx to stop the
+// enumeration early.  The iterator must not be used until the values channel is closed.
+func (iter *ProductResultIterator) Stream(ctx context.Context) (<-chan Product, <-chan error) {
+	values := make(chan Product)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(errs)
+		defer close(values)
+		err := iter.ForEach(ctx, func(v Product) error {
+			select {
+			case values <- v:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil {
+			errs <- err
+		}
+	}()
+	return values, errs
+}
+
 // Creates a new instance of the ProductResultIterator type.
 func NewProductResultIterator(page ProductResultPage) ProductResultIterator {
 	return ProductResultIterator{page: page}
@@ -354,7 +1325,8 @@ func (pr ProductResult) productResultPreparer(ctx context.Context) (*http.Reques
 	return autorest.Prepare((&http.Request{}).WithContext(ctx),
 		autorest.AsJSON(),
 		autorest.AsGet(),
-		autorest.WithBaseURL(to.String(pr.NextLink)))
+		autorest.WithBaseURL(to.String(pr.NextLink)),
+		withRequestOptions())
 }
 
 // ProductResultPage contains a page of Product values.
@@ -366,16 +1338,6 @@ type ProductResultPage struct {
 // NextWithContext advances to the next page of values.  If there was an error making
 // the request the page does not advance and the error is returned.
 func (page *ProductResultPage) NextWithContext(ctx context.Context) (err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/ProductResultPage.NextWithContext")
-		defer func() {
-			sc := -1
-			if page.Response().Response.Response != nil {
-				sc = page.Response().Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
-		}()
-	}
 	next, err := page.fn(ctx, page.pr)
 	if err != nil {
 		return err
@@ -409,7 +1371,192 @@ func (page ProductResultPage) Values() []Product {
 	return *page.pr.Values
 }
 
+// ContinuationToken returns a token that can be passed to the FromToken method of the operation that returned
+// this page to resume the enumeration at the following page.  It returns an empty string if this is the last page.
+func (page ProductResultPage) ContinuationToken() string {
+	return to.String(page.pr.NextLink)
+}
+
+// ForEach calls fn for each value in the current and remaining pages, advancing the page as required.
+// Enumeration stops at the first error returned from fn or from retrieving a page, which is then returned.
+// If fn returns ErrStopIteration the enumeration stops and ForEach returns nil.
+func (page *ProductResultPage) ForEach(ctx context.Context, fn func(Product) error) error {
+	for page.NotDone() {
+		for _, v := range page.Values() {
+			if err := fn(v); err != nil {
+				if errors.Is(err, ErrStopIteration) {
+					return nil
+				}
+				return err
+			}
+		}
+		if err := page.NextWithContext(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Stream returns a channel that receives each remaining value, automatically crossing page boundaries as required.
+// When the enumeration completes or fails at most one error is sent on the errors channel, which is buffered, before
+// both channels are closed, so the error can be received once the values channel is closed.  Cancel ctx to stop the
+// enumeration early.  The page must not be used until the values channel is closed.
+func (page *ProductResultPage) Stream(ctx context.Context) (<-chan Product, <-chan error) {
+	values := make(chan Product)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(errs)
+		defer close(values)
+		err := page.ForEach(ctx, func(v Product) error {
+			select {
+			case values <- v:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil {
+			errs <- err
+		}
+	}()
+	return values, errs
+}
+
 // Creates a new instance of the ProductResultPage type.
 func NewProductResultPage(getNextPage func(context.Context, ProductResult) (ProductResult, error)) ProductResultPage {
 	return ProductResultPage{fn: getNextPage}
 }
+
+// NewProductResultPageWithPrefetch creates a new instance of the ProductResultPage type that retrieves up to depth
+// pages after page in a background goroutine.  Pages and errors are returned by NextWithContext in order.
+// Prefetching stops at the first error, when ctx is cancelled or when the returned function is called, which
+// must be done to release its resources if the enumeration is abandoned before it completes.
+func NewProductResultPageWithPrefetch(ctx context.Context, page ProductResultPage, depth int) (ProductResultPage, context.CancelFunc) {
+	if depth < 1 {
+		depth = 1
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	type fetched struct {
+		pr  ProductResult
+		err error
+	}
+	var failed error
+	pages := make(chan fetched, depth)
+	go func() {
+		defer close(pages)
+		for last := page.pr; ; {
+			next, err := page.fn(ctx, last)
+			select {
+			case pages <- fetched{pr: next, err: err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				failed = err
+				return
+			}
+			if next.IsEmpty() {
+				return
+			}
+			last = next
+		}
+	}()
+	getNextPage := func(c context.Context, _ ProductResult) (ProductResult, error) {
+		if err := ctx.Err(); err != nil {
+			return ProductResult{}, err
+		}
+		select {
+		case f, ok := <-pages:
+			if !ok {
+				if failed != nil {
+					return ProductResult{}, failed
+				}
+				return ProductResult{}, ctx.Err()
+			}
+			return f.pr, f.err
+		case <-c.Done():
+			return ProductResult{}, c.Err()
+		}
+	}
+	return ProductResultPage{fn: getNextPage, pr: page.pr}, cancel
+}
+
+// RequestOptions contains the per-call options applied to the requests of operations whose context was created with WithRequestOptions.
+type RequestOptions struct {
+	// Header - additional headers to send.  They replace any values of the same headers set by the operation.
+	Header http.Header
+	// Query - additional query parameters to send.  They replace any values of the same parameters set by the operation.
+	Query url.Values
+	// Timeout - if greater than zero, the maximum amount of time allowed for sending the request, including retries, and reading its response.
+	Timeout time.Duration
+}
+
+type requestOptionsKey struct{}
+
+// WithRequestOptions returns a copy of ctx carrying opts.  Operations called with the returned
+// context apply opts to their requests; other calls aren't affected.
+func WithRequestOptions(ctx context.Context, opts RequestOptions) context.Context {
+	return context.WithValue(ctx, requestOptionsKey{}, opts)
+}
+
+// withRequestOptions returns a PrepareDecorator that merges the headers and query parameters
+// of the RequestOptions carried by the request's context into the request.
+func withRequestOptions() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+			opts, ok := r.Context().Value(requestOptionsKey{}).(RequestOptions)
+			if !ok {
+				return r, nil
+			}
+			if len(opts.Header) > 0 {
+				if r.Header == nil {
+					r.Header = make(http.Header)
+				}
+				for k, v := range opts.Header {
+					r.Header[http.CanonicalHeaderKey(k)] = v
+				}
+			}
+			if len(opts.Query) > 0 {
+				q := r.URL.Query()
+				for k, v := range opts.Query {
+					q[k] = v
+				}
+				r.URL.RawQuery = q.Encode()
+			}
+			return r, nil
+		})
+	}
+}
+
+// sendWithRequestOptions sends the request like autorest.SendWithSender.  If the request's context
+// carries a RequestOptions with a Timeout, the request is cancelled once the timeout elapses
+// or the response body is closed, whichever happens first.
+func sendWithRequestOptions(s autorest.Sender, r *http.Request, decorators ...autorest.SendDecorator) (*http.Response, error) {
+	opts, ok := r.Context().Value(requestOptionsKey{}).(RequestOptions)
+	if !ok || opts.Timeout <= 0 {
+		return autorest.SendWithSender(s, r, decorators...)
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), opts.Timeout)
+	resp, err := autorest.SendWithSender(s, r.WithContext(ctx), decorators...)
+	if err != nil || resp == nil || resp.Body == nil {
+		cancel()
+		return resp, err
+	}
+	resp.Body = requestOptionsBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// requestOptionsBody releases the timeout of a request once its response body is closed.
+type requestOptionsBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b requestOptionsBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
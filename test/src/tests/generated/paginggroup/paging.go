@@ -11,7 +11,6 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/to"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -30,24 +29,38 @@ func NewPagingClientWithBaseURI(baseURI string) PagingClient {
 	return PagingClient{NewWithBaseURI(baseURI)}
 }
 
+// NewPagingClientWithOptions creates an instance of the PagingClient client with the specified options.  It returns an
+// error if the options are invalid.
+func NewPagingClientWithOptions(opts ClientOptions) (PagingClient, error) {
+	client, err := NewWithOptions(opts)
+	return PagingClient{client}, err
+}
+
 // GetMultiplePages a paging operation that includes a nextLink that has 10 pages
 // Parameters:
-// maxresults - sets the maximum number of items to return in the response.
-// timeout - sets the maximum time that the server can spend processing the request, in seconds. The default is
-// 30 seconds.
-func (client PagingClient) GetMultiplePages(ctx context.Context, clientRequestID string, maxresults *int32, timeout *int32) (result ProductResultPage, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetMultiplePages")
+// options - contains the optional parameters, can be nil.
+func (client PagingClient) GetMultiplePages(ctx context.Context, options *PagingGetMultiplePagesOptions) (result ProductResultPage, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePages", "GET", "/paging/multiple")
 		defer func() {
-			sc := -1
-			if result.pr.Response.Response != nil {
-				sc = result.pr.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.pr.Response.Response, err)
+		}()
+	}
+	if options == nil {
+		options = &PagingGetMultiplePagesOptions{}
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PagingClient.GetMultiplePages", map[string]interface{}{
+			"client-request-id": options.ClientRequestID,
+			"maxresults":        options.Maxresults,
+			"timeout":           options.Timeout,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.pr.Response.Response)
 		}()
 	}
 	result.fn = client.getMultiplePagesNextResults
-	req, err := client.GetMultiplePagesPreparer(ctx, clientRequestID, maxresults, timeout)
+	req, err := client.GetMultiplePagesPreparer(ctx, options)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "paginggroup.PagingClient", "GetMultiplePages", nil, "Failure preparing request")
 		return
@@ -69,34 +82,38 @@ func (client PagingClient) GetMultiplePages(ctx context.Context, clientRequestID
 }
 
 // GetMultiplePagesPreparer prepares the GetMultiplePages request.
-func (client PagingClient) GetMultiplePagesPreparer(ctx context.Context, clientRequestID string, maxresults *int32, timeout *int32) (*http.Request, error) {
+func (client PagingClient) GetMultiplePagesPreparer(ctx context.Context, options *PagingGetMultiplePagesOptions) (*http.Request, error) {
+	if options == nil {
+		options = &PagingGetMultiplePagesOptions{}
+	}
 	preparer := autorest.CreatePreparer(
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/paging/multiple"))
-	if len(clientRequestID) > 0 {
+	if len(options.ClientRequestID) > 0 {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithHeader("client-request-id", autorest.String(clientRequestID)))
+			autorest.WithHeader("client-request-id", autorest.String(options.ClientRequestID)))
 	}
-	if maxresults != nil {
+	if options.Maxresults != nil {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithHeader("maxresults", autorest.String(maxresults)))
+			autorest.WithHeader("maxresults", autorest.String(*options.Maxresults)))
 	}
-	if timeout != nil {
+	if options.Timeout != nil {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithHeader("timeout", autorest.String(timeout)))
+			autorest.WithHeader("timeout", autorest.String(*options.Timeout)))
 	} else {
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithHeader("timeout", autorest.String(30)))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetMultiplePagesSender sends the GetMultiplePages request. The method will close the
 // http.Response Body if it receives an error.
 func (client PagingClient) GetMultiplePagesSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetMultiplePagesResponder handles the response to the GetMultiplePages request. The method always
@@ -121,6 +138,20 @@ func (client PagingClient) getMultiplePagesNextResults(ctx context.Context, last
 	if req == nil {
 		return
 	}
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "PagingClient.GetMultiplePages")
+		req = req.WithContext(ctx)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogPageFetch, Err: err}, result.Response.Response)
+		}()
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePages/page", req.Method, "")
+		req = req.WithContext(ctx)
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
 	resp, err := client.GetMultiplePagesSender(req)
 	if err != nil {
 		result.Response = autorest.Response{Response: resp}
@@ -134,31 +165,43 @@ func (client PagingClient) getMultiplePagesNextResults(ctx context.Context, last
 }
 
 // GetMultiplePagesComplete enumerates all values, automatically crossing page boundaries as required.
-func (client PagingClient) GetMultiplePagesComplete(ctx context.Context, clientRequestID string, maxresults *int32, timeout *int32) (result ProductResultIterator, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetMultiplePages")
+func (client PagingClient) GetMultiplePagesComplete(ctx context.Context, options *PagingGetMultiplePagesOptions) (result ProductResultIterator, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePages", "", "")
 		defer func() {
-			sc := -1
-			if result.Response().Response.Response != nil {
-				sc = result.page.Response().Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response().Response.Response, err)
 		}()
 	}
-	result.page, err = client.GetMultiplePages(ctx, clientRequestID, maxresults, timeout)
+	result.page, err = client.GetMultiplePages(ctx, options)
+	return
+}
+
+// GetMultiplePagesFromToken resumes the GetMultiplePages enumeration at the page identified by token, which is
+// obtained from the ContinuationToken method of a page returned by a previous enumeration.
+func (client PagingClient) GetMultiplePagesFromToken(ctx context.Context, token string) (result ProductResultPage, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesFromToken", "", "")
+		defer func() {
+			endSpan(ctx, result.pr.Response.Response, err)
+		}()
+	}
+	result.fn = client.getMultiplePagesNextResults
+	result.pr, err = result.fn(ctx, ProductResult{NextLink: &token})
 	return
 }
 
 // GetMultiplePagesFailure a paging operation that receives a 400 on the second call
 func (client PagingClient) GetMultiplePagesFailure(ctx context.Context) (result ProductResultPage, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetMultiplePagesFailure")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesFailure", "GET", "/paging/multiple/failure")
 		defer func() {
-			sc := -1
-			if result.pr.Response.Response != nil {
-				sc = result.pr.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.pr.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PagingClient.GetMultiplePagesFailure", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.pr.Response.Response)
 		}()
 	}
 	result.fn = client.getMultiplePagesFailureNextResults
@@ -189,14 +232,15 @@ func (client PagingClient) GetMultiplePagesFailurePreparer(ctx context.Context)
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/paging/multiple/failure"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetMultiplePagesFailureSender sends the GetMultiplePagesFailure request. The method will close the
 // http.Response Body if it receives an error.
 func (client PagingClient) GetMultiplePagesFailureSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetMultiplePagesFailureResponder handles the response to the GetMultiplePagesFailure request. The method always
@@ -221,6 +265,20 @@ func (client PagingClient) getMultiplePagesFailureNextResults(ctx context.Contex
 	if req == nil {
 		return
 	}
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "PagingClient.GetMultiplePagesFailure")
+		req = req.WithContext(ctx)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogPageFetch, Err: err}, result.Response.Response)
+		}()
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesFailure/page", req.Method, "")
+		req = req.WithContext(ctx)
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
 	resp, err := client.GetMultiplePagesFailureSender(req)
 	if err != nil {
 		result.Response = autorest.Response{Response: resp}
@@ -235,30 +293,42 @@ func (client PagingClient) getMultiplePagesFailureNextResults(ctx context.Contex
 
 // GetMultiplePagesFailureComplete enumerates all values, automatically crossing page boundaries as required.
 func (client PagingClient) GetMultiplePagesFailureComplete(ctx context.Context) (result ProductResultIterator, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetMultiplePagesFailure")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesFailure", "", "")
 		defer func() {
-			sc := -1
-			if result.Response().Response.Response != nil {
-				sc = result.page.Response().Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response().Response.Response, err)
 		}()
 	}
 	result.page, err = client.GetMultiplePagesFailure(ctx)
 	return
 }
 
+// GetMultiplePagesFailureFromToken resumes the GetMultiplePagesFailure enumeration at the page identified by token, which is
+// obtained from the ContinuationToken method of a page returned by a previous enumeration.
+func (client PagingClient) GetMultiplePagesFailureFromToken(ctx context.Context, token string) (result ProductResultPage, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesFailureFromToken", "", "")
+		defer func() {
+			endSpan(ctx, result.pr.Response.Response, err)
+		}()
+	}
+	result.fn = client.getMultiplePagesFailureNextResults
+	result.pr, err = result.fn(ctx, ProductResult{NextLink: &token})
+	return
+}
+
 // GetMultiplePagesFailureURI a paging operation that receives an invalid nextLink
 func (client PagingClient) GetMultiplePagesFailureURI(ctx context.Context) (result ProductResultPage, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetMultiplePagesFailureURI")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesFailureURI", "GET", "/paging/multiple/failureuri")
+		defer func() {
+			endSpan(ctx, result.pr.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PagingClient.GetMultiplePagesFailureURI", nil)
 		defer func() {
-			sc := -1
-			if result.pr.Response.Response != nil {
-				sc = result.pr.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.pr.Response.Response)
 		}()
 	}
 	result.fn = client.getMultiplePagesFailureURINextResults
@@ -289,14 +359,15 @@ func (client PagingClient) GetMultiplePagesFailureURIPreparer(ctx context.Contex
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/paging/multiple/failureuri"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetMultiplePagesFailureURISender sends the GetMultiplePagesFailureURI request. The method will close the
 // http.Response Body if it receives an error.
 func (client PagingClient) GetMultiplePagesFailureURISender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetMultiplePagesFailureURIResponder handles the response to the GetMultiplePagesFailureURI request. The method always
@@ -321,6 +392,20 @@ func (client PagingClient) getMultiplePagesFailureURINextResults(ctx context.Con
 	if req == nil {
 		return
 	}
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "PagingClient.GetMultiplePagesFailureURI")
+		req = req.WithContext(ctx)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogPageFetch, Err: err}, result.Response.Response)
+		}()
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesFailureURI/page", req.Method, "")
+		req = req.WithContext(ctx)
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
 	resp, err := client.GetMultiplePagesFailureURISender(req)
 	if err != nil {
 		result.Response = autorest.Response{Response: resp}
@@ -335,33 +420,48 @@ func (client PagingClient) getMultiplePagesFailureURINextResults(ctx context.Con
 
 // GetMultiplePagesFailureURIComplete enumerates all values, automatically crossing page boundaries as required.
 func (client PagingClient) GetMultiplePagesFailureURIComplete(ctx context.Context) (result ProductResultIterator, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetMultiplePagesFailureURI")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesFailureURI", "", "")
 		defer func() {
-			sc := -1
-			if result.Response().Response.Response != nil {
-				sc = result.page.Response().Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response().Response.Response, err)
 		}()
 	}
 	result.page, err = client.GetMultiplePagesFailureURI(ctx)
 	return
 }
 
+// GetMultiplePagesFailureURIFromToken resumes the GetMultiplePagesFailureURI enumeration at the page identified by token, which is
+// obtained from the ContinuationToken method of a page returned by a previous enumeration.
+func (client PagingClient) GetMultiplePagesFailureURIFromToken(ctx context.Context, token string) (result ProductResultPage, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesFailureURIFromToken", "", "")
+		defer func() {
+			endSpan(ctx, result.pr.Response.Response, err)
+		}()
+	}
+	result.fn = client.getMultiplePagesFailureURINextResults
+	result.pr, err = result.fn(ctx, ProductResult{NextLink: &token})
+	return
+}
+
 // GetMultiplePagesFragmentNextLink a paging operation that doesn't return a full URL, just a fragment
 // Parameters:
 // APIVersion - sets the api version to use.
 // tenant - sets the tenant to use.
 func (client PagingClient) GetMultiplePagesFragmentNextLink(ctx context.Context, APIVersion string, tenant string) (result OdataProductResultPage, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetMultiplePagesFragmentNextLink")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesFragmentNextLink", "GET", "/paging/multiple/fragment/{tenant}")
 		defer func() {
-			sc := -1
-			if result.opr.Response.Response != nil {
-				sc = result.opr.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.opr.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PagingClient.GetMultiplePagesFragmentNextLink", map[string]interface{}{
+			"api_version": APIVersion,
+			"tenant":      tenant,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.opr.Response.Response)
 		}()
 	}
 	result.fn = func(ctx context.Context, lastResult OdataProductResult) (OdataProductResult, error) {
@@ -406,14 +506,15 @@ func (client PagingClient) GetMultiplePagesFragmentNextLinkPreparer(ctx context.
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paging/multiple/fragment/{tenant}", pathParameters),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetMultiplePagesFragmentNextLinkSender sends the GetMultiplePagesFragmentNextLink request. The method will close the
 // http.Response Body if it receives an error.
 func (client PagingClient) GetMultiplePagesFragmentNextLinkSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetMultiplePagesFragmentNextLinkResponder handles the response to the GetMultiplePagesFragmentNextLink request. The method always
@@ -431,34 +532,54 @@ func (client PagingClient) GetMultiplePagesFragmentNextLinkResponder(resp *http.
 
 // GetMultiplePagesFragmentNextLinkComplete enumerates all values, automatically crossing page boundaries as required.
 func (client PagingClient) GetMultiplePagesFragmentNextLinkComplete(ctx context.Context, APIVersion string, tenant string) (result OdataProductResultIterator, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetMultiplePagesFragmentNextLink")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesFragmentNextLink", "", "")
 		defer func() {
-			sc := -1
-			if result.Response().Response.Response != nil {
-				sc = result.page.Response().Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response().Response.Response, err)
 		}()
 	}
 	result.page, err = client.GetMultiplePagesFragmentNextLink(ctx, APIVersion, tenant)
 	return
 }
 
+// GetMultiplePagesFragmentNextLinkFromToken resumes the GetMultiplePagesFragmentNextLink enumeration at the page identified by token, which is
+// obtained from the ContinuationToken method of a page returned by a previous enumeration.
+func (client PagingClient) GetMultiplePagesFragmentNextLinkFromToken(ctx context.Context, APIVersion string, tenant string, token string) (result OdataProductResultPage, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesFragmentNextLinkFromToken", "", "")
+		defer func() {
+			endSpan(ctx, result.opr.Response.Response, err)
+		}()
+	}
+	result.fn = func(ctx context.Context, lastResult OdataProductResult) (OdataProductResult, error) {
+		if lastResult.OdataNextLink == nil || len(to.String(lastResult.OdataNextLink)) < 1 {
+			return OdataProductResult{}, nil
+		}
+		return client.NextFragment(ctx, APIVersion, tenant, *lastResult.OdataNextLink)
+	}
+	result.opr, err = result.fn(ctx, OdataProductResult{OdataNextLink: &token})
+	return
+}
+
 // GetMultiplePagesFragmentWithGroupingNextLink a paging operation that doesn't return a full URL, just a fragment with
 // parameters grouped
 // Parameters:
 // APIVersion - sets the api version to use.
 // tenant - sets the tenant to use.
 func (client PagingClient) GetMultiplePagesFragmentWithGroupingNextLink(ctx context.Context, APIVersion string, tenant string) (result OdataProductResultPage, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetMultiplePagesFragmentWithGroupingNextLink")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesFragmentWithGroupingNextLink", "GET", "/paging/multiple/fragmentwithgrouping/{tenant}")
 		defer func() {
-			sc := -1
-			if result.opr.Response.Response != nil {
-				sc = result.opr.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.opr.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PagingClient.GetMultiplePagesFragmentWithGroupingNextLink", map[string]interface{}{
+			"api_version": APIVersion,
+			"tenant":      tenant,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.opr.Response.Response)
 		}()
 	}
 	result.fn = func(ctx context.Context, lastResult OdataProductResult) (OdataProductResult, error) {
@@ -503,14 +624,15 @@ func (client PagingClient) GetMultiplePagesFragmentWithGroupingNextLinkPreparer(
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paging/multiple/fragmentwithgrouping/{tenant}", pathParameters),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetMultiplePagesFragmentWithGroupingNextLinkSender sends the GetMultiplePagesFragmentWithGroupingNextLink request. The method will close the
 // http.Response Body if it receives an error.
 func (client PagingClient) GetMultiplePagesFragmentWithGroupingNextLinkSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetMultiplePagesFragmentWithGroupingNextLinkResponder handles the response to the GetMultiplePagesFragmentWithGroupingNextLink request. The method always
@@ -528,37 +650,59 @@ func (client PagingClient) GetMultiplePagesFragmentWithGroupingNextLinkResponder
 
 // GetMultiplePagesFragmentWithGroupingNextLinkComplete enumerates all values, automatically crossing page boundaries as required.
 func (client PagingClient) GetMultiplePagesFragmentWithGroupingNextLinkComplete(ctx context.Context, APIVersion string, tenant string) (result OdataProductResultIterator, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetMultiplePagesFragmentWithGroupingNextLink")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesFragmentWithGroupingNextLink", "", "")
 		defer func() {
-			sc := -1
-			if result.Response().Response.Response != nil {
-				sc = result.page.Response().Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response().Response.Response, err)
 		}()
 	}
 	result.page, err = client.GetMultiplePagesFragmentWithGroupingNextLink(ctx, APIVersion, tenant)
 	return
 }
 
+// GetMultiplePagesFragmentWithGroupingNextLinkFromToken resumes the GetMultiplePagesFragmentWithGroupingNextLink enumeration at the page identified by token, which is
+// obtained from the ContinuationToken method of a page returned by a previous enumeration.
+func (client PagingClient) GetMultiplePagesFragmentWithGroupingNextLinkFromToken(ctx context.Context, APIVersion string, tenant string, token string) (result OdataProductResultPage, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesFragmentWithGroupingNextLinkFromToken", "", "")
+		defer func() {
+			endSpan(ctx, result.opr.Response.Response, err)
+		}()
+	}
+	result.fn = func(ctx context.Context, lastResult OdataProductResult) (OdataProductResult, error) {
+		if lastResult.OdataNextLink == nil || len(to.String(lastResult.OdataNextLink)) < 1 {
+			return OdataProductResult{}, nil
+		}
+		return client.NextFragmentWithGrouping(ctx, APIVersion, tenant, *lastResult.OdataNextLink)
+	}
+	result.opr, err = result.fn(ctx, OdataProductResult{OdataNextLink: &token})
+	return
+}
+
 // GetMultiplePagesLRO a long-running paging operation that includes a nextLink that has 10 pages
 // Parameters:
-// maxresults - sets the maximum number of items to return in the response.
-// timeout - sets the maximum time that the server can spend processing the request, in seconds. The default is
-// 30 seconds.
-func (client PagingClient) GetMultiplePagesLRO(ctx context.Context, clientRequestID string, maxresults *int32, timeout *int32) (result PagingGetMultiplePagesLROFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetMultiplePagesLRO")
+// options - contains the optional parameters, can be nil.
+func (client PagingClient) GetMultiplePagesLRO(ctx context.Context, options *PagingGetMultiplePagesLROOptions) (result PagingGetMultiplePagesLROFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesLRO", "POST", "/paging/multiple/lro")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
 		}()
 	}
-	req, err := client.GetMultiplePagesLROPreparer(ctx, clientRequestID, maxresults, timeout)
+	if options == nil {
+		options = &PagingGetMultiplePagesLROOptions{}
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PagingClient.GetMultiplePagesLRO", map[string]interface{}{
+			"client-request-id": options.ClientRequestID,
+			"maxresults":        options.Maxresults,
+			"timeout":           options.Timeout,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response())
+		}()
+	}
+	req, err := client.GetMultiplePagesLROPreparer(ctx, options)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "paginggroup.PagingClient", "GetMultiplePagesLRO", nil, "Failure preparing request")
 		return
@@ -574,35 +718,39 @@ func (client PagingClient) GetMultiplePagesLRO(ctx context.Context, clientReques
 }
 
 // GetMultiplePagesLROPreparer prepares the GetMultiplePagesLRO request.
-func (client PagingClient) GetMultiplePagesLROPreparer(ctx context.Context, clientRequestID string, maxresults *int32, timeout *int32) (*http.Request, error) {
+func (client PagingClient) GetMultiplePagesLROPreparer(ctx context.Context, options *PagingGetMultiplePagesLROOptions) (*http.Request, error) {
+	if options == nil {
+		options = &PagingGetMultiplePagesLROOptions{}
+	}
 	preparer := autorest.CreatePreparer(
 		autorest.AsPost(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/paging/multiple/lro"))
-	if len(clientRequestID) > 0 {
+	if len(options.ClientRequestID) > 0 {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithHeader("client-request-id", autorest.String(clientRequestID)))
+			autorest.WithHeader("client-request-id", autorest.String(options.ClientRequestID)))
 	}
-	if maxresults != nil {
+	if options.Maxresults != nil {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithHeader("maxresults", autorest.String(maxresults)))
+			autorest.WithHeader("maxresults", autorest.String(*options.Maxresults)))
 	}
-	if timeout != nil {
+	if options.Timeout != nil {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithHeader("timeout", autorest.String(timeout)))
+			autorest.WithHeader("timeout", autorest.String(*options.Timeout)))
 	} else {
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithHeader("timeout", autorest.String(30)))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetMultiplePagesLROSender sends the GetMultiplePagesLRO request. The method will close the
 // http.Response Body if it receives an error.
 func (client PagingClient) GetMultiplePagesLROSender(req *http.Request) (future PagingGetMultiplePagesLROFuture, err error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req, sd...)
+	resp, err = sendWithRequestOptions(client.pipeline(), req, sd...)
 	if err != nil {
 		return
 	}
@@ -610,6 +758,22 @@ func (client PagingClient) GetMultiplePagesLROSender(req *http.Request) (future
 	return
 }
 
+// ResumeGetMultiplePagesLRO rebuilds the future returned from GetMultiplePagesLRO using a token obtained from its
+// ResumeToken method.  It returns an error if the token was created by a different operation.
+func (client PagingClient) ResumeGetMultiplePagesLRO(ctx context.Context, token string) (result PagingGetMultiplePagesLROFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.ResumeGetMultiplePagesLRO", "", "")
+		defer func() {
+			endSpan(ctx, nil, err)
+		}()
+	}
+	result.Future, err = parseResumeToken("paginggroup.PagingGetMultiplePagesLROFuture", token)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "paginggroup.PagingClient", "ResumeGetMultiplePagesLRO", nil, "Failure parsing resume token")
+	}
+	return
+}
+
 // GetMultiplePagesLROResponder handles the response to the GetMultiplePagesLRO request. The method always
 // closes the http.Response Body.
 func (client PagingClient) GetMultiplePagesLROResponder(resp *http.Response) (result ProductResultPage, err error) {
@@ -638,9 +802,23 @@ func (client PagingClient) getMultiplePagesLRONextResults(ctx context.Context, l
 	if req == nil {
 		return
 	}
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "PagingClient.GetMultiplePagesLRO")
+		req = req.WithContext(ctx)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogPageFetch, Err: err}, result.Response.Response)
+		}()
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesLRO/page", req.Method, "")
+		req = req.WithContext(ctx)
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
 	var resp *http.Response
-	resp, err = autorest.SendWithSender(client, req,
-		autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	resp, err = sendWithRequestOptions(client.pipeline(), req,
+		withRetryPolicy(client.RetryPolicy, client.Client, false))
 	if err != nil {
 		return result, autorest.NewErrorWithError(err, "paginggroup.PagingClient", "getMultiplePagesLRONextResults", resp, "Failure sending next results request")
 	}
@@ -648,19 +826,15 @@ func (client PagingClient) getMultiplePagesLRONextResults(ctx context.Context, l
 }
 
 // GetMultiplePagesLROComplete enumerates all values, automatically crossing page boundaries as required.
-func (client PagingClient) GetMultiplePagesLROComplete(ctx context.Context, clientRequestID string, maxresults *int32, timeout *int32) (result PagingGetMultiplePagesLROAllFuture, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetMultiplePagesLRO")
+func (client PagingClient) GetMultiplePagesLROComplete(ctx context.Context, options *PagingGetMultiplePagesLROOptions) (result PagingGetMultiplePagesLROAllFuture, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesLRO", "", "")
 		defer func() {
-			sc := -1
-			if result.Response() != nil {
-				sc = result.Response().StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response(), err)
 		}()
 	}
 	var future PagingGetMultiplePagesLROFuture
-	future, err = client.GetMultiplePagesLRO(ctx, clientRequestID, maxresults, timeout)
+	future, err = client.GetMultiplePagesLRO(ctx, options)
 	result.Future = future.Future
 	return
 }
@@ -668,14 +842,16 @@ func (client PagingClient) GetMultiplePagesLROComplete(ctx context.Context, clie
 // GetMultiplePagesRetryFirst a paging operation that fails on the first call with 500 and then retries and then get a
 // response including a nextLink that has 10 pages
 func (client PagingClient) GetMultiplePagesRetryFirst(ctx context.Context) (result ProductResultPage, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetMultiplePagesRetryFirst")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesRetryFirst", "GET", "/paging/multiple/retryfirst")
+		defer func() {
+			endSpan(ctx, result.pr.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PagingClient.GetMultiplePagesRetryFirst", nil)
 		defer func() {
-			sc := -1
-			if result.pr.Response.Response != nil {
-				sc = result.pr.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.pr.Response.Response)
 		}()
 	}
 	result.fn = client.getMultiplePagesRetryFirstNextResults
@@ -706,14 +882,15 @@ func (client PagingClient) GetMultiplePagesRetryFirstPreparer(ctx context.Contex
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/paging/multiple/retryfirst"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetMultiplePagesRetryFirstSender sends the GetMultiplePagesRetryFirst request. The method will close the
 // http.Response Body if it receives an error.
 func (client PagingClient) GetMultiplePagesRetryFirstSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetMultiplePagesRetryFirstResponder handles the response to the GetMultiplePagesRetryFirst request. The method always
@@ -738,6 +915,20 @@ func (client PagingClient) getMultiplePagesRetryFirstNextResults(ctx context.Con
 	if req == nil {
 		return
 	}
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "PagingClient.GetMultiplePagesRetryFirst")
+		req = req.WithContext(ctx)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogPageFetch, Err: err}, result.Response.Response)
+		}()
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesRetryFirst/page", req.Method, "")
+		req = req.WithContext(ctx)
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
 	resp, err := client.GetMultiplePagesRetryFirstSender(req)
 	if err != nil {
 		result.Response = autorest.Response{Response: resp}
@@ -752,31 +943,43 @@ func (client PagingClient) getMultiplePagesRetryFirstNextResults(ctx context.Con
 
 // GetMultiplePagesRetryFirstComplete enumerates all values, automatically crossing page boundaries as required.
 func (client PagingClient) GetMultiplePagesRetryFirstComplete(ctx context.Context) (result ProductResultIterator, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetMultiplePagesRetryFirst")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesRetryFirst", "", "")
 		defer func() {
-			sc := -1
-			if result.Response().Response.Response != nil {
-				sc = result.page.Response().Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response().Response.Response, err)
 		}()
 	}
 	result.page, err = client.GetMultiplePagesRetryFirst(ctx)
 	return
 }
 
+// GetMultiplePagesRetryFirstFromToken resumes the GetMultiplePagesRetryFirst enumeration at the page identified by token, which is
+// obtained from the ContinuationToken method of a page returned by a previous enumeration.
+func (client PagingClient) GetMultiplePagesRetryFirstFromToken(ctx context.Context, token string) (result ProductResultPage, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesRetryFirstFromToken", "", "")
+		defer func() {
+			endSpan(ctx, result.pr.Response.Response, err)
+		}()
+	}
+	result.fn = client.getMultiplePagesRetryFirstNextResults
+	result.pr, err = result.fn(ctx, ProductResult{NextLink: &token})
+	return
+}
+
 // GetMultiplePagesRetrySecond a paging operation that includes a nextLink that has 10 pages, of which the 2nd call
 // fails first with 500. The client should retry and finish all 10 pages eventually.
 func (client PagingClient) GetMultiplePagesRetrySecond(ctx context.Context) (result ProductResultPage, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetMultiplePagesRetrySecond")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesRetrySecond", "GET", "/paging/multiple/retrysecond")
 		defer func() {
-			sc := -1
-			if result.pr.Response.Response != nil {
-				sc = result.pr.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.pr.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PagingClient.GetMultiplePagesRetrySecond", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.pr.Response.Response)
 		}()
 	}
 	result.fn = client.getMultiplePagesRetrySecondNextResults
@@ -807,14 +1010,15 @@ func (client PagingClient) GetMultiplePagesRetrySecondPreparer(ctx context.Conte
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/paging/multiple/retrysecond"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetMultiplePagesRetrySecondSender sends the GetMultiplePagesRetrySecond request. The method will close the
 // http.Response Body if it receives an error.
 func (client PagingClient) GetMultiplePagesRetrySecondSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetMultiplePagesRetrySecondResponder handles the response to the GetMultiplePagesRetrySecond request. The method always
@@ -839,6 +1043,20 @@ func (client PagingClient) getMultiplePagesRetrySecondNextResults(ctx context.Co
 	if req == nil {
 		return
 	}
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "PagingClient.GetMultiplePagesRetrySecond")
+		req = req.WithContext(ctx)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogPageFetch, Err: err}, result.Response.Response)
+		}()
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesRetrySecond/page", req.Method, "")
+		req = req.WithContext(ctx)
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
 	resp, err := client.GetMultiplePagesRetrySecondSender(req)
 	if err != nil {
 		result.Response = autorest.Response{Response: resp}
@@ -853,39 +1071,57 @@ func (client PagingClient) getMultiplePagesRetrySecondNextResults(ctx context.Co
 
 // GetMultiplePagesRetrySecondComplete enumerates all values, automatically crossing page boundaries as required.
 func (client PagingClient) GetMultiplePagesRetrySecondComplete(ctx context.Context) (result ProductResultIterator, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetMultiplePagesRetrySecond")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesRetrySecond", "", "")
 		defer func() {
-			sc := -1
-			if result.Response().Response.Response != nil {
-				sc = result.page.Response().Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response().Response.Response, err)
 		}()
 	}
 	result.page, err = client.GetMultiplePagesRetrySecond(ctx)
 	return
 }
 
+// GetMultiplePagesRetrySecondFromToken resumes the GetMultiplePagesRetrySecond enumeration at the page identified by token, which is
+// obtained from the ContinuationToken method of a page returned by a previous enumeration.
+func (client PagingClient) GetMultiplePagesRetrySecondFromToken(ctx context.Context, token string) (result ProductResultPage, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesRetrySecondFromToken", "", "")
+		defer func() {
+			endSpan(ctx, result.pr.Response.Response, err)
+		}()
+	}
+	result.fn = client.getMultiplePagesRetrySecondNextResults
+	result.pr, err = result.fn(ctx, ProductResult{NextLink: &token})
+	return
+}
+
 // GetMultiplePagesWithOffset a paging operation that includes a nextLink that has 10 pages
 // Parameters:
 // offset - offset of return value
-// maxresults - sets the maximum number of items to return in the response.
-// timeout - sets the maximum time that the server can spend processing the request, in seconds. The default is
-// 30 seconds.
-func (client PagingClient) GetMultiplePagesWithOffset(ctx context.Context, offset int32, clientRequestID string, maxresults *int32, timeout *int32) (result ProductResultPage, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetMultiplePagesWithOffset")
+// options - contains the optional parameters, can be nil.
+func (client PagingClient) GetMultiplePagesWithOffset(ctx context.Context, offset int32, options *PagingGetMultiplePagesWithOffsetOptions) (result ProductResultPage, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesWithOffset", "GET", "/paging/multiple/withpath/{offset}")
+		defer func() {
+			endSpan(ctx, result.pr.Response.Response, err)
+		}()
+	}
+	if options == nil {
+		options = &PagingGetMultiplePagesWithOffsetOptions{}
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PagingClient.GetMultiplePagesWithOffset", map[string]interface{}{
+			"offset":            offset,
+			"client-request-id": options.ClientRequestID,
+			"maxresults":        options.Maxresults,
+			"timeout":           options.Timeout,
+		})
 		defer func() {
-			sc := -1
-			if result.pr.Response.Response != nil {
-				sc = result.pr.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.pr.Response.Response)
 		}()
 	}
 	result.fn = client.getMultiplePagesWithOffsetNextResults
-	req, err := client.GetMultiplePagesWithOffsetPreparer(ctx, offset, clientRequestID, maxresults, timeout)
+	req, err := client.GetMultiplePagesWithOffsetPreparer(ctx, offset, options)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "paginggroup.PagingClient", "GetMultiplePagesWithOffset", nil, "Failure preparing request")
 		return
@@ -907,7 +1143,10 @@ func (client PagingClient) GetMultiplePagesWithOffset(ctx context.Context, offse
 }
 
 // GetMultiplePagesWithOffsetPreparer prepares the GetMultiplePagesWithOffset request.
-func (client PagingClient) GetMultiplePagesWithOffsetPreparer(ctx context.Context, offset int32, clientRequestID string, maxresults *int32, timeout *int32) (*http.Request, error) {
+func (client PagingClient) GetMultiplePagesWithOffsetPreparer(ctx context.Context, offset int32, options *PagingGetMultiplePagesWithOffsetOptions) (*http.Request, error) {
+	if options == nil {
+		options = &PagingGetMultiplePagesWithOffsetOptions{}
+	}
 	pathParameters := map[string]interface{}{
 		"offset": autorest.Encode("path", offset),
 	}
@@ -916,29 +1155,30 @@ func (client PagingClient) GetMultiplePagesWithOffsetPreparer(ctx context.Contex
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paging/multiple/withpath/{offset}", pathParameters))
-	if len(clientRequestID) > 0 {
+	if len(options.ClientRequestID) > 0 {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithHeader("client-request-id", autorest.String(clientRequestID)))
+			autorest.WithHeader("client-request-id", autorest.String(options.ClientRequestID)))
 	}
-	if maxresults != nil {
+	if options.Maxresults != nil {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithHeader("maxresults", autorest.String(maxresults)))
+			autorest.WithHeader("maxresults", autorest.String(*options.Maxresults)))
 	}
-	if timeout != nil {
+	if options.Timeout != nil {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithHeader("timeout", autorest.String(timeout)))
+			autorest.WithHeader("timeout", autorest.String(*options.Timeout)))
 	} else {
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithHeader("timeout", autorest.String(30)))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetMultiplePagesWithOffsetSender sends the GetMultiplePagesWithOffset request. The method will close the
 // http.Response Body if it receives an error.
 func (client PagingClient) GetMultiplePagesWithOffsetSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetMultiplePagesWithOffsetResponder handles the response to the GetMultiplePagesWithOffset request. The method always
@@ -963,6 +1203,20 @@ func (client PagingClient) getMultiplePagesWithOffsetNextResults(ctx context.Con
 	if req == nil {
 		return
 	}
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "PagingClient.GetMultiplePagesWithOffset")
+		req = req.WithContext(ctx)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogPageFetch, Err: err}, result.Response.Response)
+		}()
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesWithOffset/page", req.Method, "")
+		req = req.WithContext(ctx)
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
 	resp, err := client.GetMultiplePagesWithOffsetSender(req)
 	if err != nil {
 		result.Response = autorest.Response{Response: resp}
@@ -976,39 +1230,56 @@ func (client PagingClient) getMultiplePagesWithOffsetNextResults(ctx context.Con
 }
 
 // GetMultiplePagesWithOffsetComplete enumerates all values, automatically crossing page boundaries as required.
-func (client PagingClient) GetMultiplePagesWithOffsetComplete(ctx context.Context, offset int32, clientRequestID string, maxresults *int32, timeout *int32) (result ProductResultIterator, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetMultiplePagesWithOffset")
+func (client PagingClient) GetMultiplePagesWithOffsetComplete(ctx context.Context, offset int32, options *PagingGetMultiplePagesWithOffsetOptions) (result ProductResultIterator, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesWithOffset", "", "")
 		defer func() {
-			sc := -1
-			if result.Response().Response.Response != nil {
-				sc = result.page.Response().Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response().Response.Response, err)
 		}()
 	}
-	result.page, err = client.GetMultiplePagesWithOffset(ctx, offset, clientRequestID, maxresults, timeout)
+	result.page, err = client.GetMultiplePagesWithOffset(ctx, offset, options)
+	return
+}
+
+// GetMultiplePagesWithOffsetFromToken resumes the GetMultiplePagesWithOffset enumeration at the page identified by token, which is
+// obtained from the ContinuationToken method of a page returned by a previous enumeration.
+func (client PagingClient) GetMultiplePagesWithOffsetFromToken(ctx context.Context, token string) (result ProductResultPage, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetMultiplePagesWithOffsetFromToken", "", "")
+		defer func() {
+			endSpan(ctx, result.pr.Response.Response, err)
+		}()
+	}
+	result.fn = client.getMultiplePagesWithOffsetNextResults
+	result.pr, err = result.fn(ctx, ProductResult{NextLink: &token})
 	return
 }
 
 // GetOdataMultiplePages a paging operation that includes a nextLink in odata format that has 10 pages
 // Parameters:
-// maxresults - sets the maximum number of items to return in the response.
-// timeout - sets the maximum time that the server can spend processing the request, in seconds. The default is
-// 30 seconds.
-func (client PagingClient) GetOdataMultiplePages(ctx context.Context, clientRequestID string, maxresults *int32, timeout *int32) (result OdataProductResultPage, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetOdataMultiplePages")
+// options - contains the optional parameters, can be nil.
+func (client PagingClient) GetOdataMultiplePages(ctx context.Context, options *PagingGetOdataMultiplePagesOptions) (result OdataProductResultPage, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetOdataMultiplePages", "GET", "/paging/multiple/odata")
+		defer func() {
+			endSpan(ctx, result.opr.Response.Response, err)
+		}()
+	}
+	if options == nil {
+		options = &PagingGetOdataMultiplePagesOptions{}
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PagingClient.GetOdataMultiplePages", map[string]interface{}{
+			"client-request-id": options.ClientRequestID,
+			"maxresults":        options.Maxresults,
+			"timeout":           options.Timeout,
+		})
 		defer func() {
-			sc := -1
-			if result.opr.Response.Response != nil {
-				sc = result.opr.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.opr.Response.Response)
 		}()
 	}
 	result.fn = client.getOdataMultiplePagesNextResults
-	req, err := client.GetOdataMultiplePagesPreparer(ctx, clientRequestID, maxresults, timeout)
+	req, err := client.GetOdataMultiplePagesPreparer(ctx, options)
 	if err != nil {
 		err = autorest.NewErrorWithError(err, "paginggroup.PagingClient", "GetOdataMultiplePages", nil, "Failure preparing request")
 		return
@@ -1030,34 +1301,38 @@ func (client PagingClient) GetOdataMultiplePages(ctx context.Context, clientRequ
 }
 
 // GetOdataMultiplePagesPreparer prepares the GetOdataMultiplePages request.
-func (client PagingClient) GetOdataMultiplePagesPreparer(ctx context.Context, clientRequestID string, maxresults *int32, timeout *int32) (*http.Request, error) {
+func (client PagingClient) GetOdataMultiplePagesPreparer(ctx context.Context, options *PagingGetOdataMultiplePagesOptions) (*http.Request, error) {
+	if options == nil {
+		options = &PagingGetOdataMultiplePagesOptions{}
+	}
 	preparer := autorest.CreatePreparer(
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/paging/multiple/odata"))
-	if len(clientRequestID) > 0 {
+	if len(options.ClientRequestID) > 0 {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithHeader("client-request-id", autorest.String(clientRequestID)))
+			autorest.WithHeader("client-request-id", autorest.String(options.ClientRequestID)))
 	}
-	if maxresults != nil {
+	if options.Maxresults != nil {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithHeader("maxresults", autorest.String(maxresults)))
+			autorest.WithHeader("maxresults", autorest.String(*options.Maxresults)))
 	}
-	if timeout != nil {
+	if options.Timeout != nil {
 		preparer = autorest.DecoratePreparer(preparer,
-			autorest.WithHeader("timeout", autorest.String(timeout)))
+			autorest.WithHeader("timeout", autorest.String(*options.Timeout)))
 	} else {
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithHeader("timeout", autorest.String(30)))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetOdataMultiplePagesSender sends the GetOdataMultiplePages request. The method will close the
 // http.Response Body if it receives an error.
 func (client PagingClient) GetOdataMultiplePagesSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetOdataMultiplePagesResponder handles the response to the GetOdataMultiplePages request. The method always
@@ -1082,6 +1357,20 @@ func (client PagingClient) getOdataMultiplePagesNextResults(ctx context.Context,
 	if req == nil {
 		return
 	}
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "PagingClient.GetOdataMultiplePages")
+		req = req.WithContext(ctx)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogPageFetch, Err: err}, result.Response.Response)
+		}()
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetOdataMultiplePages/page", req.Method, "")
+		req = req.WithContext(ctx)
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
 	resp, err := client.GetOdataMultiplePagesSender(req)
 	if err != nil {
 		result.Response = autorest.Response{Response: resp}
@@ -1095,31 +1384,43 @@ func (client PagingClient) getOdataMultiplePagesNextResults(ctx context.Context,
 }
 
 // GetOdataMultiplePagesComplete enumerates all values, automatically crossing page boundaries as required.
-func (client PagingClient) GetOdataMultiplePagesComplete(ctx context.Context, clientRequestID string, maxresults *int32, timeout *int32) (result OdataProductResultIterator, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetOdataMultiplePages")
+func (client PagingClient) GetOdataMultiplePagesComplete(ctx context.Context, options *PagingGetOdataMultiplePagesOptions) (result OdataProductResultIterator, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetOdataMultiplePages", "", "")
 		defer func() {
-			sc := -1
-			if result.Response().Response.Response != nil {
-				sc = result.page.Response().Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response().Response.Response, err)
 		}()
 	}
-	result.page, err = client.GetOdataMultiplePages(ctx, clientRequestID, maxresults, timeout)
+	result.page, err = client.GetOdataMultiplePages(ctx, options)
+	return
+}
+
+// GetOdataMultiplePagesFromToken resumes the GetOdataMultiplePages enumeration at the page identified by token, which is
+// obtained from the ContinuationToken method of a page returned by a previous enumeration.
+func (client PagingClient) GetOdataMultiplePagesFromToken(ctx context.Context, token string) (result OdataProductResultPage, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetOdataMultiplePagesFromToken", "", "")
+		defer func() {
+			endSpan(ctx, result.opr.Response.Response, err)
+		}()
+	}
+	result.fn = client.getOdataMultiplePagesNextResults
+	result.opr, err = result.fn(ctx, OdataProductResult{OdataNextLink: &token})
 	return
 }
 
 // GetSinglePages a paging operation that finishes on the first call without a nextlink
 func (client PagingClient) GetSinglePages(ctx context.Context) (result ProductResultPage, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetSinglePages")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetSinglePages", "GET", "/paging/single")
 		defer func() {
-			sc := -1
-			if result.pr.Response.Response != nil {
-				sc = result.pr.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.pr.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PagingClient.GetSinglePages", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.pr.Response.Response)
 		}()
 	}
 	result.fn = client.getSinglePagesNextResults
@@ -1150,14 +1451,15 @@ func (client PagingClient) GetSinglePagesPreparer(ctx context.Context) (*http.Re
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/paging/single"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetSinglePagesSender sends the GetSinglePages request. The method will close the
 // http.Response Body if it receives an error.
 func (client PagingClient) GetSinglePagesSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetSinglePagesResponder handles the response to the GetSinglePages request. The method always
@@ -1182,6 +1484,20 @@ func (client PagingClient) getSinglePagesNextResults(ctx context.Context, lastRe
 	if req == nil {
 		return
 	}
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "PagingClient.GetSinglePages")
+		req = req.WithContext(ctx)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogPageFetch, Err: err}, result.Response.Response)
+		}()
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetSinglePages/page", req.Method, "")
+		req = req.WithContext(ctx)
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
 	resp, err := client.GetSinglePagesSender(req)
 	if err != nil {
 		result.Response = autorest.Response{Response: resp}
@@ -1196,30 +1512,42 @@ func (client PagingClient) getSinglePagesNextResults(ctx context.Context, lastRe
 
 // GetSinglePagesComplete enumerates all values, automatically crossing page boundaries as required.
 func (client PagingClient) GetSinglePagesComplete(ctx context.Context) (result ProductResultIterator, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetSinglePages")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetSinglePages", "", "")
 		defer func() {
-			sc := -1
-			if result.Response().Response.Response != nil {
-				sc = result.page.Response().Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response().Response.Response, err)
 		}()
 	}
 	result.page, err = client.GetSinglePages(ctx)
 	return
 }
 
+// GetSinglePagesFromToken resumes the GetSinglePages enumeration at the page identified by token, which is
+// obtained from the ContinuationToken method of a page returned by a previous enumeration.
+func (client PagingClient) GetSinglePagesFromToken(ctx context.Context, token string) (result ProductResultPage, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetSinglePagesFromToken", "", "")
+		defer func() {
+			endSpan(ctx, result.pr.Response.Response, err)
+		}()
+	}
+	result.fn = client.getSinglePagesNextResults
+	result.pr, err = result.fn(ctx, ProductResult{NextLink: &token})
+	return
+}
+
 // GetSinglePagesFailure a paging operation that receives a 400 on the first call
 func (client PagingClient) GetSinglePagesFailure(ctx context.Context) (result ProductResultPage, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetSinglePagesFailure")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetSinglePagesFailure", "GET", "/paging/single/failure")
 		defer func() {
-			sc := -1
-			if result.pr.Response.Response != nil {
-				sc = result.pr.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.pr.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PagingClient.GetSinglePagesFailure", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.pr.Response.Response)
 		}()
 	}
 	result.fn = client.getSinglePagesFailureNextResults
@@ -1250,14 +1578,15 @@ func (client PagingClient) GetSinglePagesFailurePreparer(ctx context.Context) (*
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/paging/single/failure"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetSinglePagesFailureSender sends the GetSinglePagesFailure request. The method will close the
 // http.Response Body if it receives an error.
 func (client PagingClient) GetSinglePagesFailureSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetSinglePagesFailureResponder handles the response to the GetSinglePagesFailure request. The method always
@@ -1282,6 +1611,20 @@ func (client PagingClient) getSinglePagesFailureNextResults(ctx context.Context,
 	if req == nil {
 		return
 	}
+	if client.Logger != nil {
+		ctx = withOperationLog(ctx, client.Logger, "PagingClient.GetSinglePagesFailure")
+		req = req.WithContext(ctx)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogPageFetch, Err: err}, result.Response.Response)
+		}()
+	}
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetSinglePagesFailure/page", req.Method, "")
+		req = req.WithContext(ctx)
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
 	resp, err := client.GetSinglePagesFailureSender(req)
 	if err != nil {
 		result.Response = autorest.Response{Response: resp}
@@ -1296,34 +1639,50 @@ func (client PagingClient) getSinglePagesFailureNextResults(ctx context.Context,
 
 // GetSinglePagesFailureComplete enumerates all values, automatically crossing page boundaries as required.
 func (client PagingClient) GetSinglePagesFailureComplete(ctx context.Context) (result ProductResultIterator, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.GetSinglePagesFailure")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetSinglePagesFailure", "", "")
 		defer func() {
-			sc := -1
-			if result.Response().Response.Response != nil {
-				sc = result.page.Response().Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response().Response.Response, err)
 		}()
 	}
 	result.page, err = client.GetSinglePagesFailure(ctx)
 	return
 }
 
+// GetSinglePagesFailureFromToken resumes the GetSinglePagesFailure enumeration at the page identified by token, which is
+// obtained from the ContinuationToken method of a page returned by a previous enumeration.
+func (client PagingClient) GetSinglePagesFailureFromToken(ctx context.Context, token string) (result ProductResultPage, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.GetSinglePagesFailureFromToken", "", "")
+		defer func() {
+			endSpan(ctx, result.pr.Response.Response, err)
+		}()
+	}
+	result.fn = client.getSinglePagesFailureNextResults
+	result.pr, err = result.fn(ctx, ProductResult{NextLink: &token})
+	return
+}
+
 // NextFragment a paging operation that doesn't return a full URL, just a fragment
 // Parameters:
 // APIVersion - sets the api version to use.
 // tenant - sets the tenant to use.
 // nextLink - next link for list operation.
 func (client PagingClient) NextFragment(ctx context.Context, APIVersion string, tenant string, nextLink string) (result OdataProductResult, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.NextFragment")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.NextFragment", "GET", "/paging/multiple/fragment/{tenant}/{nextLink}")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PagingClient.NextFragment", map[string]interface{}{
+			"api_version": APIVersion,
+			"tenant":      tenant,
+			"nextLink":    nextLink,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.NextFragmentPreparer(ctx, APIVersion, tenant, nextLink)
@@ -1363,14 +1722,15 @@ func (client PagingClient) NextFragmentPreparer(ctx context.Context, APIVersion
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paging/multiple/fragment/{tenant}/{nextLink}", pathParameters),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // NextFragmentSender sends the NextFragment request. The method will close the
 // http.Response Body if it receives an error.
 func (client PagingClient) NextFragmentSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // NextFragmentResponder handles the response to the NextFragment request. The method always
@@ -1392,14 +1752,20 @@ func (client PagingClient) NextFragmentResponder(resp *http.Response) (result Od
 // tenant - sets the tenant to use.
 // nextLink - next link for list operation.
 func (client PagingClient) NextFragmentWithGrouping(ctx context.Context, APIVersion string, tenant string, nextLink string) (result OdataProductResult, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PagingClient.NextFragmentWithGrouping")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PagingClient.NextFragmentWithGrouping", "GET", "/paging/multiple/fragmentwithgrouping/{tenant}/{nextLink}")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PagingClient.NextFragmentWithGrouping", map[string]interface{}{
+			"api_version": APIVersion,
+			"tenant":      tenant,
+			"nextLink":    nextLink,
+		})
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.NextFragmentWithGroupingPreparer(ctx, APIVersion, tenant, nextLink)
@@ -1439,14 +1805,15 @@ func (client PagingClient) NextFragmentWithGroupingPreparer(ctx context.Context,
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paging/multiple/fragmentwithgrouping/{tenant}/{nextLink}", pathParameters),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // NextFragmentWithGroupingSender sends the NextFragmentWithGrouping request. The method will close the
 // http.Response Body if it receives an error.
 func (client PagingClient) NextFragmentWithGroupingSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // NextFragmentWithGroupingResponder handles the response to the NextFragmentWithGrouping request. The method always
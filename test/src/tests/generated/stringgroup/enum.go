@@ -9,9 +9,7 @@ package stringgroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/validation"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -30,16 +28,25 @@ func NewEnumClientWithBaseURI(baseURI string) EnumClient {
 	return EnumClient{NewWithBaseURI(baseURI)}
 }
 
+// NewEnumClientWithOptions creates an instance of the EnumClient client with the specified options.  It returns an
+// error if the options are invalid.
+func NewEnumClientWithOptions(opts ClientOptions) (EnumClient, error) {
+	client, err := NewWithOptions(opts)
+	return EnumClient{client}, err
+}
+
 // GetNotExpandable get enum value 'red color' from enumeration of 'red color', 'green-color', 'blue_color'.
 func (client EnumClient) GetNotExpandable(ctx context.Context) (result StringModel, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/EnumClient.GetNotExpandable")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/EnumClient.GetNotExpandable", "GET", "/string/enum/notExpandable")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "EnumClient.GetNotExpandable", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetNotExpandablePreparer(ctx)
@@ -56,7 +63,7 @@ func (client EnumClient) GetNotExpandable(ctx context.Context) (result StringMod
 	}
 
 	result, err = client.GetNotExpandableResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "stringgroup.EnumClient", "GetNotExpandable", resp, "Failure responding to request")
 	}
 
@@ -69,14 +76,15 @@ func (client EnumClient) GetNotExpandablePreparer(ctx context.Context) (*http.Re
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/string/enum/notExpandable"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetNotExpandableSender sends the GetNotExpandable request. The method will close the
 // http.Response Body if it receives an error.
 func (client EnumClient) GetNotExpandableSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetNotExpandableResponder handles the response to the GetNotExpandable request. The method always
@@ -85,7 +93,7 @@ func (client EnumClient) GetNotExpandableResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -94,14 +102,16 @@ func (client EnumClient) GetNotExpandableResponder(resp *http.Response) (result
 
 // GetReferenced get enum value 'red color' from enumeration of 'red color', 'green-color', 'blue_color'.
 func (client EnumClient) GetReferenced(ctx context.Context) (result StringModel, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/EnumClient.GetReferenced")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/EnumClient.GetReferenced", "GET", "/string/enum/Referenced")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "EnumClient.GetReferenced", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetReferencedPreparer(ctx)
@@ -118,7 +128,7 @@ func (client EnumClient) GetReferenced(ctx context.Context) (result StringModel,
 	}
 
 	result, err = client.GetReferencedResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "stringgroup.EnumClient", "GetReferenced", resp, "Failure responding to request")
 	}
 
@@ -131,14 +141,15 @@ func (client EnumClient) GetReferencedPreparer(ctx context.Context) (*http.Reque
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/string/enum/Referenced"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetReferencedSender sends the GetReferenced request. The method will close the
 // http.Response Body if it receives an error.
 func (client EnumClient) GetReferencedSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetReferencedResponder handles the response to the GetReferenced request. The method always
@@ -147,7 +158,7 @@ func (client EnumClient) GetReferencedResponder(resp *http.Response) (result Str
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result.Value),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -156,14 +167,16 @@ func (client EnumClient) GetReferencedResponder(resp *http.Response) (result Str
 
 // GetReferencedConstant get value 'green-color' from the constant.
 func (client EnumClient) GetReferencedConstant(ctx context.Context) (result RefColorConstant, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/EnumClient.GetReferencedConstant")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/EnumClient.GetReferencedConstant", "GET", "/string/enum/ReferencedConstant")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "EnumClient.GetReferencedConstant", nil)
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
 	req, err := client.GetReferencedConstantPreparer(ctx)
@@ -180,7 +193,7 @@ func (client EnumClient) GetReferencedConstant(ctx context.Context) (result RefC
 	}
 
 	result, err = client.GetReferencedConstantResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "stringgroup.EnumClient", "GetReferencedConstant", resp, "Failure responding to request")
 	}
 
@@ -193,14 +206,15 @@ func (client EnumClient) GetReferencedConstantPreparer(ctx context.Context) (*ht
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/string/enum/ReferencedConstant"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetReferencedConstantSender sends the GetReferencedConstant request. The method will close the
 // http.Response Body if it receives an error.
 func (client EnumClient) GetReferencedConstantSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetReferencedConstantResponder handles the response to the GetReferencedConstant request. The method always
@@ -209,7 +223,7 @@ func (client EnumClient) GetReferencedConstantResponder(resp *http.Response) (re
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
@@ -218,14 +232,16 @@ func (client EnumClient) GetReferencedConstantResponder(resp *http.Response) (re
 
 // PutNotExpandable sends value 'red color' from enumeration of 'red color', 'green-color', 'blue_color'
 func (client EnumClient) PutNotExpandable(ctx context.Context, stringBody Colors) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/EnumClient.PutNotExpandable")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/EnumClient.PutNotExpandable", "PUT", "/string/enum/notExpandable")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "EnumClient.PutNotExpandable", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutNotExpandablePreparer(ctx, stringBody)
@@ -242,7 +258,7 @@ func (client EnumClient) PutNotExpandable(ctx context.Context, stringBody Colors
 	}
 
 	result, err = client.PutNotExpandableResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "stringgroup.EnumClient", "PutNotExpandable", resp, "Failure responding to request")
 	}
 
@@ -257,14 +273,15 @@ func (client EnumClient) PutNotExpandablePreparer(ctx context.Context, stringBod
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/string/enum/notExpandable"),
 		autorest.WithJSON(stringBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutNotExpandableSender sends the PutNotExpandable request. The method will close the
 // http.Response Body if it receives an error.
 func (client EnumClient) PutNotExpandableSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutNotExpandableResponder handles the response to the PutNotExpandable request. The method always
@@ -273,7 +290,7 @@ func (client EnumClient) PutNotExpandableResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -281,14 +298,16 @@ func (client EnumClient) PutNotExpandableResponder(resp *http.Response) (result
 
 // PutReferenced sends value 'red color' from enumeration of 'red color', 'green-color', 'blue_color'
 func (client EnumClient) PutReferenced(ctx context.Context, enumStringBody Colors) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/EnumClient.PutReferenced")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/EnumClient.PutReferenced", "PUT", "/string/enum/Referenced")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "EnumClient.PutReferenced", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.PutReferencedPreparer(ctx, enumStringBody)
@@ -305,7 +324,7 @@ func (client EnumClient) PutReferenced(ctx context.Context, enumStringBody Color
 	}
 
 	result, err = client.PutReferencedResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "stringgroup.EnumClient", "PutReferenced", resp, "Failure responding to request")
 	}
 
@@ -320,14 +339,15 @@ func (client EnumClient) PutReferencedPreparer(ctx context.Context, enumStringBo
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/string/enum/Referenced"),
 		autorest.WithJSON(enumStringBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutReferencedSender sends the PutReferenced request. The method will close the
 // http.Response Body if it receives an error.
 func (client EnumClient) PutReferencedSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutReferencedResponder handles the response to the PutReferenced request. The method always
@@ -336,7 +356,7 @@ func (client EnumClient) PutReferencedResponder(resp *http.Response) (result aut
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -344,20 +364,22 @@ func (client EnumClient) PutReferencedResponder(resp *http.Response) (result aut
 
 // PutReferencedConstant sends value 'green-color' from a constant
 func (client EnumClient) PutReferencedConstant(ctx context.Context, enumStringBody RefColorConstant) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/EnumClient.PutReferencedConstant")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/EnumClient.PutReferencedConstant", "PUT", "/string/enum/ReferencedConstant")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "EnumClient.PutReferencedConstant", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: enumStringBody,
-			Constraints: []validation.Constraint{{Target: "enumStringBody.ColorConstant", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("stringgroup.EnumClient", "PutReferencedConstant", err.Error())
+	var errs ValidationErrors
+	errs = errs.addNested("enumStringBody", enumStringBody.Validate())
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("stringgroup.EnumClient", "PutReferencedConstant", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PutReferencedConstantPreparer(ctx, enumStringBody)
@@ -374,7 +396,7 @@ func (client EnumClient) PutReferencedConstant(ctx context.Context, enumStringBo
 	}
 
 	result, err = client.PutReferencedConstantResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "stringgroup.EnumClient", "PutReferencedConstant", resp, "Failure responding to request")
 	}
 
@@ -389,14 +411,15 @@ func (client EnumClient) PutReferencedConstantPreparer(ctx context.Context, enum
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/string/enum/ReferencedConstant"),
 		autorest.WithJSON(enumStringBody))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PutReferencedConstantSender sends the PutReferencedConstant request. The method will close the
 // http.Response Body if it receives an error.
 func (client EnumClient) PutReferencedConstantSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PutReferencedConstantResponder handles the response to the PutReferencedConstant request. The method always
@@ -405,7 +428,7 @@ func (client EnumClient) PutReferencedConstantResponder(resp *http.Response) (re
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -9,8 +9,6 @@ package urlgroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -29,6 +27,13 @@ func NewPathItemsClientWithBaseURI(baseURI string, globalStringPath string, glob
 	return PathItemsClient{NewWithBaseURI(baseURI, globalStringPath, globalStringQuery)}
 }
 
+// NewPathItemsClientWithOptions creates an instance of the PathItemsClient client with the specified options.  It
+// returns an error if the options are invalid.
+func NewPathItemsClientWithOptions(opts ClientOptions) (PathItemsClient, error) {
+	client, err := NewWithOptions(opts)
+	return PathItemsClient{client}, err
+}
+
 // GetAllWithValues send globalStringPath='globalStringPath', pathItemStringPath='pathItemStringPath',
 // localStringPath='localStringPath', globalStringQuery='globalStringQuery', pathItemStringQuery='pathItemStringQuery',
 // localStringQuery='localStringQuery'
@@ -38,14 +43,21 @@ func NewPathItemsClientWithBaseURI(baseURI string, globalStringPath string, glob
 // localStringQuery - should contain value 'localStringQuery'
 // pathItemStringQuery - a string value 'pathItemStringQuery' that appears as a query parameter
 func (client PathItemsClient) GetAllWithValues(ctx context.Context, localStringPath string, pathItemStringPath string, localStringQuery string, pathItemStringQuery string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathItemsClient.GetAllWithValues")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathItemsClient.GetAllWithValues", "GET", "/pathitem/nullable/globalStringPath/{globalStringPath}/pathItemStringPath/{pathItemStringPath}/localStringPath/{localStringPath}/globalStringQuery/pathItemStringQuery/localStringQuery")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathItemsClient.GetAllWithValues", map[string]interface{}{
+			"localStringPath":     localStringPath,
+			"pathItemStringPath":  pathItemStringPath,
+			"localStringQuery":    localStringQuery,
+			"pathItemStringQuery": pathItemStringQuery,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetAllWithValuesPreparer(ctx, localStringPath, pathItemStringPath, localStringQuery, pathItemStringQuery)
@@ -62,7 +74,7 @@ func (client PathItemsClient) GetAllWithValues(ctx context.Context, localStringP
 	}
 
 	result, err = client.GetAllWithValuesResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathItemsClient", "GetAllWithValues", resp, "Failure responding to request")
 	}
 
@@ -93,14 +105,15 @@ func (client PathItemsClient) GetAllWithValuesPreparer(ctx context.Context, loca
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/pathitem/nullable/globalStringPath/{globalStringPath}/pathItemStringPath/{pathItemStringPath}/localStringPath/{localStringPath}/globalStringQuery/pathItemStringQuery/localStringQuery", pathParameters),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetAllWithValuesSender sends the GetAllWithValues request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathItemsClient) GetAllWithValuesSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetAllWithValuesResponder handles the response to the GetAllWithValues request. The method always
@@ -109,7 +122,7 @@ func (client PathItemsClient) GetAllWithValuesResponder(resp *http.Response) (re
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -124,14 +137,21 @@ func (client PathItemsClient) GetAllWithValuesResponder(resp *http.Response) (re
 // localStringQuery - should contain null value
 // pathItemStringQuery - a string value 'pathItemStringQuery' that appears as a query parameter
 func (client PathItemsClient) GetGlobalAndLocalQueryNull(ctx context.Context, localStringPath string, pathItemStringPath string, localStringQuery string, pathItemStringQuery string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathItemsClient.GetGlobalAndLocalQueryNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathItemsClient.GetGlobalAndLocalQueryNull", "GET", "/pathitem/nullable/globalStringPath/{globalStringPath}/pathItemStringPath/{pathItemStringPath}/localStringPath/{localStringPath}/null/pathItemStringQuery/null")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathItemsClient.GetGlobalAndLocalQueryNull", map[string]interface{}{
+			"localStringPath":     localStringPath,
+			"pathItemStringPath":  pathItemStringPath,
+			"localStringQuery":    localStringQuery,
+			"pathItemStringQuery": pathItemStringQuery,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetGlobalAndLocalQueryNullPreparer(ctx, localStringPath, pathItemStringPath, localStringQuery, pathItemStringQuery)
@@ -148,7 +168,7 @@ func (client PathItemsClient) GetGlobalAndLocalQueryNull(ctx context.Context, lo
 	}
 
 	result, err = client.GetGlobalAndLocalQueryNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathItemsClient", "GetGlobalAndLocalQueryNull", resp, "Failure responding to request")
 	}
 
@@ -179,14 +199,15 @@ func (client PathItemsClient) GetGlobalAndLocalQueryNullPreparer(ctx context.Con
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/pathitem/nullable/globalStringPath/{globalStringPath}/pathItemStringPath/{pathItemStringPath}/localStringPath/{localStringPath}/null/pathItemStringQuery/null", pathParameters),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetGlobalAndLocalQueryNullSender sends the GetGlobalAndLocalQueryNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathItemsClient) GetGlobalAndLocalQueryNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetGlobalAndLocalQueryNullResponder handles the response to the GetGlobalAndLocalQueryNull request. The method always
@@ -195,7 +216,7 @@ func (client PathItemsClient) GetGlobalAndLocalQueryNullResponder(resp *http.Res
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -210,14 +231,21 @@ func (client PathItemsClient) GetGlobalAndLocalQueryNullResponder(resp *http.Res
 // localStringQuery - should contain value 'localStringQuery'
 // pathItemStringQuery - a string value 'pathItemStringQuery' that appears as a query parameter
 func (client PathItemsClient) GetGlobalQueryNull(ctx context.Context, localStringPath string, pathItemStringPath string, localStringQuery string, pathItemStringQuery string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathItemsClient.GetGlobalQueryNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathItemsClient.GetGlobalQueryNull", "GET", "/pathitem/nullable/globalStringPath/{globalStringPath}/pathItemStringPath/{pathItemStringPath}/localStringPath/{localStringPath}/null/pathItemStringQuery/localStringQuery")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathItemsClient.GetGlobalQueryNull", map[string]interface{}{
+			"localStringPath":     localStringPath,
+			"pathItemStringPath":  pathItemStringPath,
+			"localStringQuery":    localStringQuery,
+			"pathItemStringQuery": pathItemStringQuery,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetGlobalQueryNullPreparer(ctx, localStringPath, pathItemStringPath, localStringQuery, pathItemStringQuery)
@@ -234,7 +262,7 @@ func (client PathItemsClient) GetGlobalQueryNull(ctx context.Context, localStrin
 	}
 
 	result, err = client.GetGlobalQueryNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathItemsClient", "GetGlobalQueryNull", resp, "Failure responding to request")
 	}
 
@@ -265,14 +293,15 @@ func (client PathItemsClient) GetGlobalQueryNullPreparer(ctx context.Context, lo
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/pathitem/nullable/globalStringPath/{globalStringPath}/pathItemStringPath/{pathItemStringPath}/localStringPath/{localStringPath}/null/pathItemStringQuery/localStringQuery", pathParameters),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetGlobalQueryNullSender sends the GetGlobalQueryNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathItemsClient) GetGlobalQueryNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetGlobalQueryNullResponder handles the response to the GetGlobalQueryNull request. The method always
@@ -281,7 +310,7 @@ func (client PathItemsClient) GetGlobalQueryNullResponder(resp *http.Response) (
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -296,14 +325,21 @@ func (client PathItemsClient) GetGlobalQueryNullResponder(resp *http.Response) (
 // localStringQuery - should contain value null
 // pathItemStringQuery - should contain value null
 func (client PathItemsClient) GetLocalPathItemQueryNull(ctx context.Context, localStringPath string, pathItemStringPath string, localStringQuery string, pathItemStringQuery string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathItemsClient.GetLocalPathItemQueryNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathItemsClient.GetLocalPathItemQueryNull", "GET", "/pathitem/nullable/globalStringPath/{globalStringPath}/pathItemStringPath/{pathItemStringPath}/localStringPath/{localStringPath}/globalStringQuery/null/null")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathItemsClient.GetLocalPathItemQueryNull", map[string]interface{}{
+			"localStringPath":     localStringPath,
+			"pathItemStringPath":  pathItemStringPath,
+			"localStringQuery":    localStringQuery,
+			"pathItemStringQuery": pathItemStringQuery,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetLocalPathItemQueryNullPreparer(ctx, localStringPath, pathItemStringPath, localStringQuery, pathItemStringQuery)
@@ -320,7 +356,7 @@ func (client PathItemsClient) GetLocalPathItemQueryNull(ctx context.Context, loc
 	}
 
 	result, err = client.GetLocalPathItemQueryNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathItemsClient", "GetLocalPathItemQueryNull", resp, "Failure responding to request")
 	}
 
@@ -351,14 +387,15 @@ func (client PathItemsClient) GetLocalPathItemQueryNullPreparer(ctx context.Cont
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/pathitem/nullable/globalStringPath/{globalStringPath}/pathItemStringPath/{pathItemStringPath}/localStringPath/{localStringPath}/globalStringQuery/null/null", pathParameters),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetLocalPathItemQueryNullSender sends the GetLocalPathItemQueryNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathItemsClient) GetLocalPathItemQueryNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetLocalPathItemQueryNullResponder handles the response to the GetLocalPathItemQueryNull request. The method always
@@ -367,7 +404,7 @@ func (client PathItemsClient) GetLocalPathItemQueryNullResponder(resp *http.Resp
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -9,10 +9,8 @@ package urlgroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/Azure/go-autorest/autorest/validation"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -31,26 +29,39 @@ func NewPathsClientWithBaseURI(baseURI string, globalStringPath string, globalSt
 	return PathsClient{NewWithBaseURI(baseURI, globalStringPath, globalStringQuery)}
 }
 
+// NewPathsClientWithOptions creates an instance of the PathsClient client with the specified options.  It returns an
+// error if the options are invalid.
+func NewPathsClientWithOptions(opts ClientOptions) (PathsClient, error) {
+	client, err := NewWithOptions(opts)
+	return PathsClient{client}, err
+}
+
 // ArrayCsvInPath get an array of string ['ArrayPath1', 'begin!*'();:@ &=+$,/?#[]end' , null, ''] using the csv-array
 // format
 // Parameters:
 // arrayPath - an array of string ['ArrayPath1', 'begin!*'();:@ &=+$,/?#[]end' , null, ''] using the csv-array
 // format
 func (client PathsClient) ArrayCsvInPath(ctx context.Context, arrayPath []string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.ArrayCsvInPath")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.ArrayCsvInPath", "GET", "/paths/array/ArrayPath1%2cbegin%21%2A%27%28%29%3B%3A%40%20%26%3D%2B%24%2C%2F%3F%23%5B%5Dend%2c%2c/{arrayPath}")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: arrayPath,
-			Constraints: []validation.Constraint{{Target: "arrayPath", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("urlgroup.PathsClient", "ArrayCsvInPath", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.ArrayCsvInPath", map[string]interface{}{
+			"arrayPath": arrayPath,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if arrayPath == nil {
+		errs = append(errs, ValidationError{Path: "arrayPath", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("urlgroup.PathsClient", "ArrayCsvInPath", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.ArrayCsvInPathPreparer(ctx, arrayPath)
@@ -67,7 +78,7 @@ func (client PathsClient) ArrayCsvInPath(ctx context.Context, arrayPath []string
 	}
 
 	result, err = client.ArrayCsvInPathResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "ArrayCsvInPath", resp, "Failure responding to request")
 	}
 
@@ -84,14 +95,15 @@ func (client PathsClient) ArrayCsvInPathPreparer(ctx context.Context, arrayPath
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/array/ArrayPath1%2cbegin%21%2A%27%28%29%3B%3A%40%20%26%3D%2B%24%2C%2F%3F%23%5B%5Dend%2c%2c/{arrayPath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ArrayCsvInPathSender sends the ArrayCsvInPath request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) ArrayCsvInPathSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ArrayCsvInPathResponder handles the response to the ArrayCsvInPath request. The method always
@@ -100,7 +112,7 @@ func (client PathsClient) ArrayCsvInPathResponder(resp *http.Response) (result a
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -110,14 +122,18 @@ func (client PathsClient) ArrayCsvInPathResponder(resp *http.Response) (result a
 // Parameters:
 // base64URLPath - base64url encoded value
 func (client PathsClient) Base64URL(ctx context.Context, base64URLPath string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.Base64URL")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.Base64URL", "GET", "/paths/string/bG9yZW0/{base64UrlPath}")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.Base64URL", map[string]interface{}{
+			"base64UrlPath": base64URLPath,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.Base64URLPreparer(ctx, base64URLPath)
@@ -134,7 +150,7 @@ func (client PathsClient) Base64URL(ctx context.Context, base64URLPath string) (
 	}
 
 	result, err = client.Base64URLResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "Base64URL", resp, "Failure responding to request")
 	}
 
@@ -151,14 +167,15 @@ func (client PathsClient) Base64URLPreparer(ctx context.Context, base64URLPath s
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/string/bG9yZW0/{base64UrlPath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // Base64URLSender sends the Base64URL request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) Base64URLSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // Base64URLResponder handles the response to the Base64URL request. The method always
@@ -167,7 +184,7 @@ func (client PathsClient) Base64URLResponder(resp *http.Response) (result autore
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -175,14 +192,16 @@ func (client PathsClient) Base64URLResponder(resp *http.Response) (result autore
 
 // ByteEmpty get '' as byte array
 func (client PathsClient) ByteEmpty(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.ByteEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.ByteEmpty", "GET", "/paths/byte/empty/{bytePath}")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.ByteEmpty", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.ByteEmptyPreparer(ctx)
@@ -199,7 +218,7 @@ func (client PathsClient) ByteEmpty(ctx context.Context) (result autorest.Respon
 	}
 
 	result, err = client.ByteEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "ByteEmpty", resp, "Failure responding to request")
 	}
 
@@ -216,14 +235,15 @@ func (client PathsClient) ByteEmptyPreparer(ctx context.Context) (*http.Request,
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/byte/empty/{bytePath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ByteEmptySender sends the ByteEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) ByteEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ByteEmptyResponder handles the response to the ByteEmpty request. The method always
@@ -232,7 +252,7 @@ func (client PathsClient) ByteEmptyResponder(resp *http.Response) (result autore
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -242,20 +262,26 @@ func (client PathsClient) ByteEmptyResponder(resp *http.Response) (result autore
 // Parameters:
 // bytePath - '啊齄丂狛狜隣郎隣兀﨩' multibyte value as utf-8 encoded byte array
 func (client PathsClient) ByteMultiByte(ctx context.Context, bytePath []byte) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.ByteMultiByte")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.ByteMultiByte", "GET", "/paths/byte/multibyte/{bytePath}")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.ByteMultiByte", map[string]interface{}{
+			"bytePath": bytePath,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: bytePath,
-			Constraints: []validation.Constraint{{Target: "bytePath", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("urlgroup.PathsClient", "ByteMultiByte", err.Error())
+	var errs ValidationErrors
+	if bytePath == nil {
+		errs = append(errs, ValidationError{Path: "bytePath", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("urlgroup.PathsClient", "ByteMultiByte", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.ByteMultiBytePreparer(ctx, bytePath)
@@ -272,7 +298,7 @@ func (client PathsClient) ByteMultiByte(ctx context.Context, bytePath []byte) (r
 	}
 
 	result, err = client.ByteMultiByteResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "ByteMultiByte", resp, "Failure responding to request")
 	}
 
@@ -289,14 +315,15 @@ func (client PathsClient) ByteMultiBytePreparer(ctx context.Context, bytePath []
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/byte/multibyte/{bytePath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ByteMultiByteSender sends the ByteMultiByte request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) ByteMultiByteSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ByteMultiByteResponder handles the response to the ByteMultiByte request. The method always
@@ -305,7 +332,7 @@ func (client PathsClient) ByteMultiByteResponder(resp *http.Response) (result au
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -315,20 +342,26 @@ func (client PathsClient) ByteMultiByteResponder(resp *http.Response) (result au
 // Parameters:
 // bytePath - null as byte array (should throw)
 func (client PathsClient) ByteNull(ctx context.Context, bytePath []byte) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.ByteNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.ByteNull", "GET", "/paths/byte/null/{bytePath}")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.ByteNull", map[string]interface{}{
+			"bytePath": bytePath,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: bytePath,
-			Constraints: []validation.Constraint{{Target: "bytePath", Name: validation.Null, Rule: true, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("urlgroup.PathsClient", "ByteNull", err.Error())
+	var errs ValidationErrors
+	if bytePath == nil {
+		errs = append(errs, ValidationError{Path: "bytePath", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("urlgroup.PathsClient", "ByteNull", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.ByteNullPreparer(ctx, bytePath)
@@ -345,7 +378,7 @@ func (client PathsClient) ByteNull(ctx context.Context, bytePath []byte) (result
 	}
 
 	result, err = client.ByteNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "ByteNull", resp, "Failure responding to request")
 	}
 
@@ -362,14 +395,15 @@ func (client PathsClient) ByteNullPreparer(ctx context.Context, bytePath []byte)
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/byte/null/{bytePath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ByteNullSender sends the ByteNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) ByteNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ByteNullResponder handles the response to the ByteNull request. The method always
@@ -378,7 +412,7 @@ func (client PathsClient) ByteNullResponder(resp *http.Response) (result autores
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusBadRequest),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusBadRequest),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -388,14 +422,18 @@ func (client PathsClient) ByteNullResponder(resp *http.Response) (result autores
 // Parameters:
 // datePath - null as date (should throw)
 func (client PathsClient) DateNull(ctx context.Context, datePath date.Date) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.DateNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.DateNull", "GET", "/paths/date/null/{datePath}")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.DateNull", map[string]interface{}{
+			"datePath": datePath,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.DateNullPreparer(ctx, datePath)
@@ -412,7 +450,7 @@ func (client PathsClient) DateNull(ctx context.Context, datePath date.Date) (res
 	}
 
 	result, err = client.DateNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "DateNull", resp, "Failure responding to request")
 	}
 
@@ -429,14 +467,15 @@ func (client PathsClient) DateNullPreparer(ctx context.Context, datePath date.Da
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/date/null/{datePath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DateNullSender sends the DateNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) DateNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // DateNullResponder handles the response to the DateNull request. The method always
@@ -445,7 +484,7 @@ func (client PathsClient) DateNullResponder(resp *http.Response) (result autores
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusBadRequest),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusBadRequest),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -455,14 +494,18 @@ func (client PathsClient) DateNullResponder(resp *http.Response) (result autores
 // Parameters:
 // dateTimePath - null as date-time
 func (client PathsClient) DateTimeNull(ctx context.Context, dateTimePath date.Time) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.DateTimeNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.DateTimeNull", "GET", "/paths/datetime/null/{dateTimePath}")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.DateTimeNull", map[string]interface{}{
+			"dateTimePath": dateTimePath,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.DateTimeNullPreparer(ctx, dateTimePath)
@@ -479,7 +522,7 @@ func (client PathsClient) DateTimeNull(ctx context.Context, dateTimePath date.Ti
 	}
 
 	result, err = client.DateTimeNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "DateTimeNull", resp, "Failure responding to request")
 	}
 
@@ -496,14 +539,15 @@ func (client PathsClient) DateTimeNullPreparer(ctx context.Context, dateTimePath
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/datetime/null/{dateTimePath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DateTimeNullSender sends the DateTimeNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) DateTimeNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // DateTimeNullResponder handles the response to the DateTimeNull request. The method always
@@ -512,7 +556,7 @@ func (client PathsClient) DateTimeNullResponder(resp *http.Response) (result aut
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusBadRequest),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusBadRequest),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -520,14 +564,16 @@ func (client PathsClient) DateTimeNullResponder(resp *http.Response) (result aut
 
 // DateTimeValid get '2012-01-01T01:01:01Z' as date-time
 func (client PathsClient) DateTimeValid(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.DateTimeValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.DateTimeValid", "GET", "/paths/datetime/2012-01-01T01%3A01%3A01Z/{dateTimePath}")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.DateTimeValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.DateTimeValidPreparer(ctx)
@@ -544,7 +590,7 @@ func (client PathsClient) DateTimeValid(ctx context.Context) (result autorest.Re
 	}
 
 	result, err = client.DateTimeValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "DateTimeValid", resp, "Failure responding to request")
 	}
 
@@ -561,14 +607,15 @@ func (client PathsClient) DateTimeValidPreparer(ctx context.Context) (*http.Requ
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/datetime/2012-01-01T01%3A01%3A01Z/{dateTimePath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DateTimeValidSender sends the DateTimeValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) DateTimeValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // DateTimeValidResponder handles the response to the DateTimeValid request. The method always
@@ -577,7 +624,7 @@ func (client PathsClient) DateTimeValidResponder(resp *http.Response) (result au
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -585,14 +632,16 @@ func (client PathsClient) DateTimeValidResponder(resp *http.Response) (result au
 
 // DateValid get '2012-01-01' as date
 func (client PathsClient) DateValid(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.DateValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.DateValid", "GET", "/paths/date/2012-01-01/{datePath}")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.DateValid", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.DateValidPreparer(ctx)
@@ -609,7 +658,7 @@ func (client PathsClient) DateValid(ctx context.Context) (result autorest.Respon
 	}
 
 	result, err = client.DateValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "DateValid", resp, "Failure responding to request")
 	}
 
@@ -626,14 +675,15 @@ func (client PathsClient) DateValidPreparer(ctx context.Context) (*http.Request,
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/date/2012-01-01/{datePath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DateValidSender sends the DateValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) DateValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // DateValidResponder handles the response to the DateValid request. The method always
@@ -642,7 +692,7 @@ func (client PathsClient) DateValidResponder(resp *http.Response) (result autore
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -650,14 +700,16 @@ func (client PathsClient) DateValidResponder(resp *http.Response) (result autore
 
 // DoubleDecimalNegative get '-9999999.999' numeric value
 func (client PathsClient) DoubleDecimalNegative(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.DoubleDecimalNegative")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.DoubleDecimalNegative", "GET", "/paths/double/-9999999.999/{doublePath}")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.DoubleDecimalNegative", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.DoubleDecimalNegativePreparer(ctx)
@@ -674,7 +726,7 @@ func (client PathsClient) DoubleDecimalNegative(ctx context.Context) (result aut
 	}
 
 	result, err = client.DoubleDecimalNegativeResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "DoubleDecimalNegative", resp, "Failure responding to request")
 	}
 
@@ -691,14 +743,15 @@ func (client PathsClient) DoubleDecimalNegativePreparer(ctx context.Context) (*h
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/double/-9999999.999/{doublePath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DoubleDecimalNegativeSender sends the DoubleDecimalNegative request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) DoubleDecimalNegativeSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // DoubleDecimalNegativeResponder handles the response to the DoubleDecimalNegative request. The method always
@@ -707,7 +760,7 @@ func (client PathsClient) DoubleDecimalNegativeResponder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -715,14 +768,16 @@ func (client PathsClient) DoubleDecimalNegativeResponder(resp *http.Response) (r
 
 // DoubleDecimalPositive get '9999999.999' numeric value
 func (client PathsClient) DoubleDecimalPositive(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.DoubleDecimalPositive")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.DoubleDecimalPositive", "GET", "/paths/double/9999999.999/{doublePath}")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.DoubleDecimalPositive", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.DoubleDecimalPositivePreparer(ctx)
@@ -739,7 +794,7 @@ func (client PathsClient) DoubleDecimalPositive(ctx context.Context) (result aut
 	}
 
 	result, err = client.DoubleDecimalPositiveResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "DoubleDecimalPositive", resp, "Failure responding to request")
 	}
 
@@ -756,14 +811,15 @@ func (client PathsClient) DoubleDecimalPositivePreparer(ctx context.Context) (*h
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/double/9999999.999/{doublePath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DoubleDecimalPositiveSender sends the DoubleDecimalPositive request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) DoubleDecimalPositiveSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // DoubleDecimalPositiveResponder handles the response to the DoubleDecimalPositive request. The method always
@@ -772,7 +828,7 @@ func (client PathsClient) DoubleDecimalPositiveResponder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -782,14 +838,18 @@ func (client PathsClient) DoubleDecimalPositiveResponder(resp *http.Response) (r
 // Parameters:
 // enumPath - send null should throw
 func (client PathsClient) EnumNull(ctx context.Context, enumPath URIColor) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.EnumNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.EnumNull", "GET", "/paths/string/null/{enumPath}")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.EnumNull", map[string]interface{}{
+			"enumPath": enumPath,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.EnumNullPreparer(ctx, enumPath)
@@ -806,7 +866,7 @@ func (client PathsClient) EnumNull(ctx context.Context, enumPath URIColor) (resu
 	}
 
 	result, err = client.EnumNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "EnumNull", resp, "Failure responding to request")
 	}
 
@@ -823,14 +883,15 @@ func (client PathsClient) EnumNullPreparer(ctx context.Context, enumPath URIColo
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/string/null/{enumPath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // EnumNullSender sends the EnumNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) EnumNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // EnumNullResponder handles the response to the EnumNull request. The method always
@@ -839,7 +900,7 @@ func (client PathsClient) EnumNullResponder(resp *http.Response) (result autores
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusBadRequest),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusBadRequest),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -849,14 +910,18 @@ func (client PathsClient) EnumNullResponder(resp *http.Response) (result autores
 // Parameters:
 // enumPath - send the value green
 func (client PathsClient) EnumValid(ctx context.Context, enumPath URIColor) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.EnumValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.EnumValid", "GET", "/paths/enum/green%20color/{enumPath}")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.EnumValid", map[string]interface{}{
+			"enumPath": enumPath,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.EnumValidPreparer(ctx, enumPath)
@@ -873,7 +938,7 @@ func (client PathsClient) EnumValid(ctx context.Context, enumPath URIColor) (res
 	}
 
 	result, err = client.EnumValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "EnumValid", resp, "Failure responding to request")
 	}
 
@@ -890,14 +955,15 @@ func (client PathsClient) EnumValidPreparer(ctx context.Context, enumPath URICol
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/enum/green%20color/{enumPath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // EnumValidSender sends the EnumValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) EnumValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // EnumValidResponder handles the response to the EnumValid request. The method always
@@ -906,7 +972,7 @@ func (client PathsClient) EnumValidResponder(resp *http.Response) (result autore
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -914,14 +980,16 @@ func (client PathsClient) EnumValidResponder(resp *http.Response) (result autore
 
 // FloatScientificNegative get '-1.034E-20' numeric value
 func (client PathsClient) FloatScientificNegative(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.FloatScientificNegative")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.FloatScientificNegative", "GET", "/paths/float/-1.034E-20/{floatPath}")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.FloatScientificNegative", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.FloatScientificNegativePreparer(ctx)
@@ -938,7 +1006,7 @@ func (client PathsClient) FloatScientificNegative(ctx context.Context) (result a
 	}
 
 	result, err = client.FloatScientificNegativeResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "FloatScientificNegative", resp, "Failure responding to request")
 	}
 
@@ -955,14 +1023,15 @@ func (client PathsClient) FloatScientificNegativePreparer(ctx context.Context) (
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/float/-1.034E-20/{floatPath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // FloatScientificNegativeSender sends the FloatScientificNegative request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) FloatScientificNegativeSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // FloatScientificNegativeResponder handles the response to the FloatScientificNegative request. The method always
@@ -971,7 +1040,7 @@ func (client PathsClient) FloatScientificNegativeResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -979,14 +1048,16 @@ func (client PathsClient) FloatScientificNegativeResponder(resp *http.Response)
 
 // FloatScientificPositive get '1.034E+20' numeric value
 func (client PathsClient) FloatScientificPositive(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.FloatScientificPositive")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.FloatScientificPositive", "GET", "/paths/float/1.034E+20/{floatPath}")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.FloatScientificPositive", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.FloatScientificPositivePreparer(ctx)
@@ -1003,7 +1074,7 @@ func (client PathsClient) FloatScientificPositive(ctx context.Context) (result a
 	}
 
 	result, err = client.FloatScientificPositiveResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "FloatScientificPositive", resp, "Failure responding to request")
 	}
 
@@ -1020,14 +1091,15 @@ func (client PathsClient) FloatScientificPositivePreparer(ctx context.Context) (
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/float/1.034E+20/{floatPath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // FloatScientificPositiveSender sends the FloatScientificPositive request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) FloatScientificPositiveSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // FloatScientificPositiveResponder handles the response to the FloatScientificPositive request. The method always
@@ -1036,7 +1108,7 @@ func (client PathsClient) FloatScientificPositiveResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1044,14 +1116,16 @@ func (client PathsClient) FloatScientificPositiveResponder(resp *http.Response)
 
 // GetBooleanFalse get false Boolean value on path
 func (client PathsClient) GetBooleanFalse(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.GetBooleanFalse")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.GetBooleanFalse", "GET", "/paths/bool/false/{boolPath}")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.GetBooleanFalse", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetBooleanFalsePreparer(ctx)
@@ -1068,7 +1142,7 @@ func (client PathsClient) GetBooleanFalse(ctx context.Context) (result autorest.
 	}
 
 	result, err = client.GetBooleanFalseResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "GetBooleanFalse", resp, "Failure responding to request")
 	}
 
@@ -1085,14 +1159,15 @@ func (client PathsClient) GetBooleanFalsePreparer(ctx context.Context) (*http.Re
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/bool/false/{boolPath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetBooleanFalseSender sends the GetBooleanFalse request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) GetBooleanFalseSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetBooleanFalseResponder handles the response to the GetBooleanFalse request. The method always
@@ -1101,7 +1176,7 @@ func (client PathsClient) GetBooleanFalseResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1109,14 +1184,16 @@ func (client PathsClient) GetBooleanFalseResponder(resp *http.Response) (result
 
 // GetBooleanTrue get true Boolean value on path
 func (client PathsClient) GetBooleanTrue(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.GetBooleanTrue")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.GetBooleanTrue", "GET", "/paths/bool/true/{boolPath}")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.GetBooleanTrue", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetBooleanTruePreparer(ctx)
@@ -1133,7 +1210,7 @@ func (client PathsClient) GetBooleanTrue(ctx context.Context) (result autorest.R
 	}
 
 	result, err = client.GetBooleanTrueResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "GetBooleanTrue", resp, "Failure responding to request")
 	}
 
@@ -1150,14 +1227,15 @@ func (client PathsClient) GetBooleanTruePreparer(ctx context.Context) (*http.Req
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/bool/true/{boolPath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetBooleanTrueSender sends the GetBooleanTrue request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) GetBooleanTrueSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetBooleanTrueResponder handles the response to the GetBooleanTrue request. The method always
@@ -1166,7 +1244,7 @@ func (client PathsClient) GetBooleanTrueResponder(resp *http.Response) (result a
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1174,14 +1252,16 @@ func (client PathsClient) GetBooleanTrueResponder(resp *http.Response) (result a
 
 // GetIntNegativeOneMillion get '-1000000' integer value
 func (client PathsClient) GetIntNegativeOneMillion(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.GetIntNegativeOneMillion")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.GetIntNegativeOneMillion", "GET", "/paths/int/-1000000/{intPath}")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.GetIntNegativeOneMillion", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetIntNegativeOneMillionPreparer(ctx)
@@ -1198,7 +1278,7 @@ func (client PathsClient) GetIntNegativeOneMillion(ctx context.Context) (result
 	}
 
 	result, err = client.GetIntNegativeOneMillionResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "GetIntNegativeOneMillion", resp, "Failure responding to request")
 	}
 
@@ -1215,14 +1295,15 @@ func (client PathsClient) GetIntNegativeOneMillionPreparer(ctx context.Context)
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/int/-1000000/{intPath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetIntNegativeOneMillionSender sends the GetIntNegativeOneMillion request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) GetIntNegativeOneMillionSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetIntNegativeOneMillionResponder handles the response to the GetIntNegativeOneMillion request. The method always
@@ -1231,7 +1312,7 @@ func (client PathsClient) GetIntNegativeOneMillionResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1239,14 +1320,16 @@ func (client PathsClient) GetIntNegativeOneMillionResponder(resp *http.Response)
 
 // GetIntOneMillion get '1000000' integer value
 func (client PathsClient) GetIntOneMillion(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.GetIntOneMillion")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.GetIntOneMillion", "GET", "/paths/int/1000000/{intPath}")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.GetIntOneMillion", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetIntOneMillionPreparer(ctx)
@@ -1263,7 +1346,7 @@ func (client PathsClient) GetIntOneMillion(ctx context.Context) (result autorest
 	}
 
 	result, err = client.GetIntOneMillionResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "GetIntOneMillion", resp, "Failure responding to request")
 	}
 
@@ -1280,14 +1363,15 @@ func (client PathsClient) GetIntOneMillionPreparer(ctx context.Context) (*http.R
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/int/1000000/{intPath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetIntOneMillionSender sends the GetIntOneMillion request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) GetIntOneMillionSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetIntOneMillionResponder handles the response to the GetIntOneMillion request. The method always
@@ -1296,7 +1380,7 @@ func (client PathsClient) GetIntOneMillionResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1304,14 +1388,16 @@ func (client PathsClient) GetIntOneMillionResponder(resp *http.Response) (result
 
 // GetNegativeTenBillion get '-10000000000' 64 bit integer value
 func (client PathsClient) GetNegativeTenBillion(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.GetNegativeTenBillion")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.GetNegativeTenBillion", "GET", "/paths/long/-10000000000/{longPath}")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.GetNegativeTenBillion", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetNegativeTenBillionPreparer(ctx)
@@ -1328,7 +1414,7 @@ func (client PathsClient) GetNegativeTenBillion(ctx context.Context) (result aut
 	}
 
 	result, err = client.GetNegativeTenBillionResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "GetNegativeTenBillion", resp, "Failure responding to request")
 	}
 
@@ -1345,14 +1431,15 @@ func (client PathsClient) GetNegativeTenBillionPreparer(ctx context.Context) (*h
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/long/-10000000000/{longPath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetNegativeTenBillionSender sends the GetNegativeTenBillion request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) GetNegativeTenBillionSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetNegativeTenBillionResponder handles the response to the GetNegativeTenBillion request. The method always
@@ -1361,7 +1448,7 @@ func (client PathsClient) GetNegativeTenBillionResponder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1369,14 +1456,16 @@ func (client PathsClient) GetNegativeTenBillionResponder(resp *http.Response) (r
 
 // GetTenBillion get '10000000000' 64 bit integer value
 func (client PathsClient) GetTenBillion(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.GetTenBillion")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.GetTenBillion", "GET", "/paths/long/10000000000/{longPath}")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.GetTenBillion", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetTenBillionPreparer(ctx)
@@ -1393,7 +1482,7 @@ func (client PathsClient) GetTenBillion(ctx context.Context) (result autorest.Re
 	}
 
 	result, err = client.GetTenBillionResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "GetTenBillion", resp, "Failure responding to request")
 	}
 
@@ -1410,14 +1499,15 @@ func (client PathsClient) GetTenBillionPreparer(ctx context.Context) (*http.Requ
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/long/10000000000/{longPath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetTenBillionSender sends the GetTenBillion request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) GetTenBillionSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetTenBillionResponder handles the response to the GetTenBillion request. The method always
@@ -1426,7 +1516,7 @@ func (client PathsClient) GetTenBillionResponder(resp *http.Response) (result au
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1434,14 +1524,16 @@ func (client PathsClient) GetTenBillionResponder(resp *http.Response) (result au
 
 // StringEmpty get ''
 func (client PathsClient) StringEmpty(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.StringEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.StringEmpty", "GET", "/paths/string/empty/{stringPath}")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.StringEmpty", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.StringEmptyPreparer(ctx)
@@ -1458,7 +1550,7 @@ func (client PathsClient) StringEmpty(ctx context.Context) (result autorest.Resp
 	}
 
 	result, err = client.StringEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "StringEmpty", resp, "Failure responding to request")
 	}
 
@@ -1475,14 +1567,15 @@ func (client PathsClient) StringEmptyPreparer(ctx context.Context) (*http.Reques
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/string/empty/{stringPath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // StringEmptySender sends the StringEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) StringEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // StringEmptyResponder handles the response to the StringEmpty request. The method always
@@ -1491,7 +1584,7 @@ func (client PathsClient) StringEmptyResponder(resp *http.Response) (result auto
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1501,14 +1594,18 @@ func (client PathsClient) StringEmptyResponder(resp *http.Response) (result auto
 // Parameters:
 // stringPath - null string value
 func (client PathsClient) StringNull(ctx context.Context, stringPath string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.StringNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.StringNull", "GET", "/paths/string/null/{stringPath}")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.StringNull", map[string]interface{}{
+			"stringPath": stringPath,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.StringNullPreparer(ctx, stringPath)
@@ -1525,7 +1622,7 @@ func (client PathsClient) StringNull(ctx context.Context, stringPath string) (re
 	}
 
 	result, err = client.StringNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "StringNull", resp, "Failure responding to request")
 	}
 
@@ -1542,14 +1639,15 @@ func (client PathsClient) StringNullPreparer(ctx context.Context, stringPath str
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/string/null/{stringPath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // StringNullSender sends the StringNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) StringNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // StringNullResponder handles the response to the StringNull request. The method always
@@ -1558,7 +1656,7 @@ func (client PathsClient) StringNullResponder(resp *http.Response) (result autor
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusBadRequest),
+		withErrorResponseUnlessStatusCode(http.StatusOK, http.StatusBadRequest),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1566,14 +1664,16 @@ func (client PathsClient) StringNullResponder(resp *http.Response) (result autor
 
 // StringUnicode get '啊齄丂狛狜隣郎隣兀﨩' multi-byte string value
 func (client PathsClient) StringUnicode(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.StringUnicode")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.StringUnicode", "GET", "/paths/string/unicode/{stringPath}")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.StringUnicode", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.StringUnicodePreparer(ctx)
@@ -1590,7 +1690,7 @@ func (client PathsClient) StringUnicode(ctx context.Context) (result autorest.Re
 	}
 
 	result, err = client.StringUnicodeResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "StringUnicode", resp, "Failure responding to request")
 	}
 
@@ -1607,14 +1707,15 @@ func (client PathsClient) StringUnicodePreparer(ctx context.Context) (*http.Requ
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/string/unicode/{stringPath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // StringUnicodeSender sends the StringUnicode request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) StringUnicodeSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // StringUnicodeResponder handles the response to the StringUnicode request. The method always
@@ -1623,7 +1724,7 @@ func (client PathsClient) StringUnicodeResponder(resp *http.Response) (result au
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1631,14 +1732,16 @@ func (client PathsClient) StringUnicodeResponder(resp *http.Response) (result au
 
 // StringURLEncoded get 'begin!*'();:@ &=+$,/?#[]end
 func (client PathsClient) StringURLEncoded(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.StringURLEncoded")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.StringURLEncoded", "GET", "/paths/string/begin%21%2A%27%28%29%3B%3A%40%20%26%3D%2B%24%2C%2F%3F%23%5B%5Dend/{stringPath}")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.StringURLEncoded", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.StringURLEncodedPreparer(ctx)
@@ -1655,7 +1758,7 @@ func (client PathsClient) StringURLEncoded(ctx context.Context) (result autorest
 	}
 
 	result, err = client.StringURLEncodedResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "StringURLEncoded", resp, "Failure responding to request")
 	}
 
@@ -1672,14 +1775,15 @@ func (client PathsClient) StringURLEncodedPreparer(ctx context.Context) (*http.R
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/string/begin%21%2A%27%28%29%3B%3A%40%20%26%3D%2B%24%2C%2F%3F%23%5B%5Dend/{stringPath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // StringURLEncodedSender sends the StringURLEncoded request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) StringURLEncodedSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // StringURLEncodedResponder handles the response to the StringURLEncoded request. The method always
@@ -1688,7 +1792,7 @@ func (client PathsClient) StringURLEncodedResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1698,14 +1802,18 @@ func (client PathsClient) StringURLEncodedResponder(resp *http.Response) (result
 // Parameters:
 // unixTimeURLPath - unix time encoded value
 func (client PathsClient) UnixTimeURL(ctx context.Context, unixTimeURLPath date.UnixTime) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/PathsClient.UnixTimeURL")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/PathsClient.UnixTimeURL", "GET", "/paths/int/1460505600/{unixTimeUrlPath}")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "PathsClient.UnixTimeURL", map[string]interface{}{
+			"unixTimeUrlPath": unixTimeURLPath,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.UnixTimeURLPreparer(ctx, unixTimeURLPath)
@@ -1722,7 +1830,7 @@ func (client PathsClient) UnixTimeURL(ctx context.Context, unixTimeURLPath date.
 	}
 
 	result, err = client.UnixTimeURLResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.PathsClient", "UnixTimeURL", resp, "Failure responding to request")
 	}
 
@@ -1739,14 +1847,15 @@ func (client PathsClient) UnixTimeURLPreparer(ctx context.Context, unixTimeURLPa
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/paths/int/1460505600/{unixTimeUrlPath}", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // UnixTimeURLSender sends the UnixTimeURL request. The method will close the
 // http.Response Body if it receives an error.
 func (client PathsClient) UnixTimeURLSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // UnixTimeURLResponder handles the response to the UnixTimeURL request. The method always
@@ -1755,7 +1864,7 @@ func (client PathsClient) UnixTimeURLResponder(resp *http.Response) (result auto
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -9,9 +9,7 @@ package urlgroup
 import (
 	"context"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/date"
-	"github.com/Azure/go-autorest/tracing"
 	"net/http"
 )
 
@@ -30,18 +28,29 @@ func NewQueriesClientWithBaseURI(baseURI string, globalStringPath string, global
 	return QueriesClient{NewWithBaseURI(baseURI, globalStringPath, globalStringQuery)}
 }
 
+// NewQueriesClientWithOptions creates an instance of the QueriesClient client with the specified options.  It returns
+// an error if the options are invalid.
+func NewQueriesClientWithOptions(opts ClientOptions) (QueriesClient, error) {
+	client, err := NewWithOptions(opts)
+	return QueriesClient{client}, err
+}
+
 // ArrayStringCsvEmpty get an empty array [] of string using the csv-array format
 // Parameters:
 // arrayQuery - an empty array [] of string using the csv-array format
 func (client QueriesClient) ArrayStringCsvEmpty(ctx context.Context, arrayQuery []string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.ArrayStringCsvEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.ArrayStringCsvEmpty", "GET", "/queries/array/csv/string/empty")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.ArrayStringCsvEmpty", map[string]interface{}{
+			"arrayQuery": arrayQuery,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.ArrayStringCsvEmptyPreparer(ctx, arrayQuery)
@@ -58,7 +67,7 @@ func (client QueriesClient) ArrayStringCsvEmpty(ctx context.Context, arrayQuery
 	}
 
 	result, err = client.ArrayStringCsvEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "ArrayStringCsvEmpty", resp, "Failure responding to request")
 	}
 
@@ -77,14 +86,15 @@ func (client QueriesClient) ArrayStringCsvEmptyPreparer(ctx context.Context, arr
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/array/csv/string/empty"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ArrayStringCsvEmptySender sends the ArrayStringCsvEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) ArrayStringCsvEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ArrayStringCsvEmptyResponder handles the response to the ArrayStringCsvEmpty request. The method always
@@ -93,7 +103,7 @@ func (client QueriesClient) ArrayStringCsvEmptyResponder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -103,14 +113,18 @@ func (client QueriesClient) ArrayStringCsvEmptyResponder(resp *http.Response) (r
 // Parameters:
 // arrayQuery - a null array of string using the csv-array format
 func (client QueriesClient) ArrayStringCsvNull(ctx context.Context, arrayQuery []string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.ArrayStringCsvNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.ArrayStringCsvNull", "GET", "/queries/array/csv/string/null")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.ArrayStringCsvNull", map[string]interface{}{
+			"arrayQuery": arrayQuery,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.ArrayStringCsvNullPreparer(ctx, arrayQuery)
@@ -127,7 +141,7 @@ func (client QueriesClient) ArrayStringCsvNull(ctx context.Context, arrayQuery [
 	}
 
 	result, err = client.ArrayStringCsvNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "ArrayStringCsvNull", resp, "Failure responding to request")
 	}
 
@@ -146,14 +160,15 @@ func (client QueriesClient) ArrayStringCsvNullPreparer(ctx context.Context, arra
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/array/csv/string/null"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ArrayStringCsvNullSender sends the ArrayStringCsvNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) ArrayStringCsvNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ArrayStringCsvNullResponder handles the response to the ArrayStringCsvNull request. The method always
@@ -162,7 +177,7 @@ func (client QueriesClient) ArrayStringCsvNullResponder(resp *http.Response) (re
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -174,14 +189,18 @@ func (client QueriesClient) ArrayStringCsvNullResponder(resp *http.Response) (re
 // arrayQuery - an array of string ['ArrayQuery1', 'begin!*'();:@ &=+$,/?#[]end' , null, ''] using the
 // csv-array format
 func (client QueriesClient) ArrayStringCsvValid(ctx context.Context, arrayQuery []string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.ArrayStringCsvValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.ArrayStringCsvValid", "GET", "/queries/array/csv/string/valid")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.ArrayStringCsvValid", map[string]interface{}{
+			"arrayQuery": arrayQuery,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.ArrayStringCsvValidPreparer(ctx, arrayQuery)
@@ -198,7 +217,7 @@ func (client QueriesClient) ArrayStringCsvValid(ctx context.Context, arrayQuery
 	}
 
 	result, err = client.ArrayStringCsvValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "ArrayStringCsvValid", resp, "Failure responding to request")
 	}
 
@@ -217,14 +236,15 @@ func (client QueriesClient) ArrayStringCsvValidPreparer(ctx context.Context, arr
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/array/csv/string/valid"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ArrayStringCsvValidSender sends the ArrayStringCsvValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) ArrayStringCsvValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ArrayStringCsvValidResponder handles the response to the ArrayStringCsvValid request. The method always
@@ -233,7 +253,7 @@ func (client QueriesClient) ArrayStringCsvValidResponder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -245,14 +265,18 @@ func (client QueriesClient) ArrayStringCsvValidResponder(resp *http.Response) (r
 // arrayQuery - an array of string ['ArrayQuery1', 'begin!*'();:@ &=+$,/?#[]end' , null, ''] using the
 // pipes-array format
 func (client QueriesClient) ArrayStringPipesValid(ctx context.Context, arrayQuery []string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.ArrayStringPipesValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.ArrayStringPipesValid", "GET", "/queries/array/pipes/string/valid")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.ArrayStringPipesValid", map[string]interface{}{
+			"arrayQuery": arrayQuery,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.ArrayStringPipesValidPreparer(ctx, arrayQuery)
@@ -269,7 +293,7 @@ func (client QueriesClient) ArrayStringPipesValid(ctx context.Context, arrayQuer
 	}
 
 	result, err = client.ArrayStringPipesValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "ArrayStringPipesValid", resp, "Failure responding to request")
 	}
 
@@ -288,14 +312,15 @@ func (client QueriesClient) ArrayStringPipesValidPreparer(ctx context.Context, a
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/array/pipes/string/valid"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ArrayStringPipesValidSender sends the ArrayStringPipesValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) ArrayStringPipesValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ArrayStringPipesValidResponder handles the response to the ArrayStringPipesValid request. The method always
@@ -304,7 +329,7 @@ func (client QueriesClient) ArrayStringPipesValidResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -316,14 +341,18 @@ func (client QueriesClient) ArrayStringPipesValidResponder(resp *http.Response)
 // arrayQuery - an array of string ['ArrayQuery1', 'begin!*'();:@ &=+$,/?#[]end' , null, ''] using the
 // ssv-array format
 func (client QueriesClient) ArrayStringSsvValid(ctx context.Context, arrayQuery []string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.ArrayStringSsvValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.ArrayStringSsvValid", "GET", "/queries/array/ssv/string/valid")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.ArrayStringSsvValid", map[string]interface{}{
+			"arrayQuery": arrayQuery,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.ArrayStringSsvValidPreparer(ctx, arrayQuery)
@@ -340,7 +369,7 @@ func (client QueriesClient) ArrayStringSsvValid(ctx context.Context, arrayQuery
 	}
 
 	result, err = client.ArrayStringSsvValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "ArrayStringSsvValid", resp, "Failure responding to request")
 	}
 
@@ -359,14 +388,15 @@ func (client QueriesClient) ArrayStringSsvValidPreparer(ctx context.Context, arr
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/array/ssv/string/valid"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ArrayStringSsvValidSender sends the ArrayStringSsvValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) ArrayStringSsvValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ArrayStringSsvValidResponder handles the response to the ArrayStringSsvValid request. The method always
@@ -375,7 +405,7 @@ func (client QueriesClient) ArrayStringSsvValidResponder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -387,14 +417,18 @@ func (client QueriesClient) ArrayStringSsvValidResponder(resp *http.Response) (r
 // arrayQuery - an array of string ['ArrayQuery1', 'begin!*'();:@ &=+$,/?#[]end' , null, ''] using the
 // tsv-array format
 func (client QueriesClient) ArrayStringTsvValid(ctx context.Context, arrayQuery []string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.ArrayStringTsvValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.ArrayStringTsvValid", "GET", "/queries/array/tsv/string/valid")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.ArrayStringTsvValid", map[string]interface{}{
+			"arrayQuery": arrayQuery,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.ArrayStringTsvValidPreparer(ctx, arrayQuery)
@@ -411,7 +445,7 @@ func (client QueriesClient) ArrayStringTsvValid(ctx context.Context, arrayQuery
 	}
 
 	result, err = client.ArrayStringTsvValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "ArrayStringTsvValid", resp, "Failure responding to request")
 	}
 
@@ -430,14 +464,15 @@ func (client QueriesClient) ArrayStringTsvValidPreparer(ctx context.Context, arr
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/array/tsv/string/valid"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ArrayStringTsvValidSender sends the ArrayStringTsvValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) ArrayStringTsvValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ArrayStringTsvValidResponder handles the response to the ArrayStringTsvValid request. The method always
@@ -446,7 +481,7 @@ func (client QueriesClient) ArrayStringTsvValidResponder(resp *http.Response) (r
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -454,14 +489,16 @@ func (client QueriesClient) ArrayStringTsvValidResponder(resp *http.Response) (r
 
 // ByteEmpty get '' as byte array
 func (client QueriesClient) ByteEmpty(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.ByteEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.ByteEmpty", "GET", "/queries/byte/empty")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.ByteEmpty", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.ByteEmptyPreparer(ctx)
@@ -478,7 +515,7 @@ func (client QueriesClient) ByteEmpty(ctx context.Context) (result autorest.Resp
 	}
 
 	result, err = client.ByteEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "ByteEmpty", resp, "Failure responding to request")
 	}
 
@@ -496,14 +533,15 @@ func (client QueriesClient) ByteEmptyPreparer(ctx context.Context) (*http.Reques
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/byte/empty"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ByteEmptySender sends the ByteEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) ByteEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ByteEmptyResponder handles the response to the ByteEmpty request. The method always
@@ -512,7 +550,7 @@ func (client QueriesClient) ByteEmptyResponder(resp *http.Response) (result auto
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -522,14 +560,18 @@ func (client QueriesClient) ByteEmptyResponder(resp *http.Response) (result auto
 // Parameters:
 // byteQuery - '啊齄丂狛狜隣郎隣兀﨩' multibyte value as utf-8 encoded byte array
 func (client QueriesClient) ByteMultiByte(ctx context.Context, byteQuery []byte) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.ByteMultiByte")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.ByteMultiByte", "GET", "/queries/byte/multibyte")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.ByteMultiByte", map[string]interface{}{
+			"byteQuery": byteQuery,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.ByteMultiBytePreparer(ctx, byteQuery)
@@ -546,7 +588,7 @@ func (client QueriesClient) ByteMultiByte(ctx context.Context, byteQuery []byte)
 	}
 
 	result, err = client.ByteMultiByteResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "ByteMultiByte", resp, "Failure responding to request")
 	}
 
@@ -565,14 +607,15 @@ func (client QueriesClient) ByteMultiBytePreparer(ctx context.Context, byteQuery
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/byte/multibyte"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ByteMultiByteSender sends the ByteMultiByte request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) ByteMultiByteSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ByteMultiByteResponder handles the response to the ByteMultiByte request. The method always
@@ -581,7 +624,7 @@ func (client QueriesClient) ByteMultiByteResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -591,14 +634,18 @@ func (client QueriesClient) ByteMultiByteResponder(resp *http.Response) (result
 // Parameters:
 // byteQuery - null as byte array (no query parameters in uri)
 func (client QueriesClient) ByteNull(ctx context.Context, byteQuery []byte) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.ByteNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.ByteNull", "GET", "/queries/byte/null")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.ByteNull", map[string]interface{}{
+			"byteQuery": byteQuery,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.ByteNullPreparer(ctx, byteQuery)
@@ -615,7 +662,7 @@ func (client QueriesClient) ByteNull(ctx context.Context, byteQuery []byte) (res
 	}
 
 	result, err = client.ByteNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "ByteNull", resp, "Failure responding to request")
 	}
 
@@ -634,14 +681,15 @@ func (client QueriesClient) ByteNullPreparer(ctx context.Context, byteQuery []by
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/byte/null"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ByteNullSender sends the ByteNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) ByteNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ByteNullResponder handles the response to the ByteNull request. The method always
@@ -650,7 +698,7 @@ func (client QueriesClient) ByteNullResponder(resp *http.Response) (result autor
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -660,14 +708,18 @@ func (client QueriesClient) ByteNullResponder(resp *http.Response) (result autor
 // Parameters:
 // dateQuery - null as date (no query parameters in uri)
 func (client QueriesClient) DateNull(ctx context.Context, dateQuery *date.Date) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.DateNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.DateNull", "GET", "/queries/date/null")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.DateNull", map[string]interface{}{
+			"dateQuery": dateQuery,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.DateNullPreparer(ctx, dateQuery)
@@ -684,7 +736,7 @@ func (client QueriesClient) DateNull(ctx context.Context, dateQuery *date.Date)
 	}
 
 	result, err = client.DateNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "DateNull", resp, "Failure responding to request")
 	}
 
@@ -703,14 +755,15 @@ func (client QueriesClient) DateNullPreparer(ctx context.Context, dateQuery *dat
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/date/null"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DateNullSender sends the DateNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) DateNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // DateNullResponder handles the response to the DateNull request. The method always
@@ -719,7 +772,7 @@ func (client QueriesClient) DateNullResponder(resp *http.Response) (result autor
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -729,14 +782,18 @@ func (client QueriesClient) DateNullResponder(resp *http.Response) (result autor
 // Parameters:
 // dateTimeQuery - null as date-time (no query parameters)
 func (client QueriesClient) DateTimeNull(ctx context.Context, dateTimeQuery *date.Time) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.DateTimeNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.DateTimeNull", "GET", "/queries/datetime/null")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.DateTimeNull", map[string]interface{}{
+			"dateTimeQuery": dateTimeQuery,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.DateTimeNullPreparer(ctx, dateTimeQuery)
@@ -753,7 +810,7 @@ func (client QueriesClient) DateTimeNull(ctx context.Context, dateTimeQuery *dat
 	}
 
 	result, err = client.DateTimeNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "DateTimeNull", resp, "Failure responding to request")
 	}
 
@@ -772,14 +829,15 @@ func (client QueriesClient) DateTimeNullPreparer(ctx context.Context, dateTimeQu
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/datetime/null"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DateTimeNullSender sends the DateTimeNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) DateTimeNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // DateTimeNullResponder handles the response to the DateTimeNull request. The method always
@@ -788,7 +846,7 @@ func (client QueriesClient) DateTimeNullResponder(resp *http.Response) (result a
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -796,14 +854,16 @@ func (client QueriesClient) DateTimeNullResponder(resp *http.Response) (result a
 
 // DateTimeValid get '2012-01-01T01:01:01Z' as date-time
 func (client QueriesClient) DateTimeValid(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.DateTimeValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.DateTimeValid", "GET", "/queries/datetime/2012-01-01T01%3A01%3A01Z")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.DateTimeValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.DateTimeValidPreparer(ctx)
@@ -820,7 +880,7 @@ func (client QueriesClient) DateTimeValid(ctx context.Context) (result autorest.
 	}
 
 	result, err = client.DateTimeValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "DateTimeValid", resp, "Failure responding to request")
 	}
 
@@ -838,14 +898,15 @@ func (client QueriesClient) DateTimeValidPreparer(ctx context.Context) (*http.Re
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/datetime/2012-01-01T01%3A01%3A01Z"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DateTimeValidSender sends the DateTimeValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) DateTimeValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // DateTimeValidResponder handles the response to the DateTimeValid request. The method always
@@ -854,7 +915,7 @@ func (client QueriesClient) DateTimeValidResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -862,14 +923,16 @@ func (client QueriesClient) DateTimeValidResponder(resp *http.Response) (result
 
 // DateValid get '2012-01-01' as date
 func (client QueriesClient) DateValid(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.DateValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.DateValid", "GET", "/queries/date/2012-01-01")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.DateValid", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.DateValidPreparer(ctx)
@@ -886,7 +949,7 @@ func (client QueriesClient) DateValid(ctx context.Context) (result autorest.Resp
 	}
 
 	result, err = client.DateValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "DateValid", resp, "Failure responding to request")
 	}
 
@@ -904,14 +967,15 @@ func (client QueriesClient) DateValidPreparer(ctx context.Context) (*http.Reques
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/date/2012-01-01"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DateValidSender sends the DateValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) DateValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // DateValidResponder handles the response to the DateValid request. The method always
@@ -920,7 +984,7 @@ func (client QueriesClient) DateValidResponder(resp *http.Response) (result auto
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -928,14 +992,16 @@ func (client QueriesClient) DateValidResponder(resp *http.Response) (result auto
 
 // DoubleDecimalNegative get '-9999999.999' numeric value
 func (client QueriesClient) DoubleDecimalNegative(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.DoubleDecimalNegative")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.DoubleDecimalNegative", "GET", "/queries/double/-9999999.999")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.DoubleDecimalNegative", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.DoubleDecimalNegativePreparer(ctx)
@@ -952,7 +1018,7 @@ func (client QueriesClient) DoubleDecimalNegative(ctx context.Context) (result a
 	}
 
 	result, err = client.DoubleDecimalNegativeResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "DoubleDecimalNegative", resp, "Failure responding to request")
 	}
 
@@ -970,14 +1036,15 @@ func (client QueriesClient) DoubleDecimalNegativePreparer(ctx context.Context) (
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/double/-9999999.999"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DoubleDecimalNegativeSender sends the DoubleDecimalNegative request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) DoubleDecimalNegativeSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // DoubleDecimalNegativeResponder handles the response to the DoubleDecimalNegative request. The method always
@@ -986,7 +1053,7 @@ func (client QueriesClient) DoubleDecimalNegativeResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -994,14 +1061,16 @@ func (client QueriesClient) DoubleDecimalNegativeResponder(resp *http.Response)
 
 // DoubleDecimalPositive get '9999999.999' numeric value
 func (client QueriesClient) DoubleDecimalPositive(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.DoubleDecimalPositive")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.DoubleDecimalPositive", "GET", "/queries/double/9999999.999")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.DoubleDecimalPositive", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.DoubleDecimalPositivePreparer(ctx)
@@ -1018,7 +1087,7 @@ func (client QueriesClient) DoubleDecimalPositive(ctx context.Context) (result a
 	}
 
 	result, err = client.DoubleDecimalPositiveResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "DoubleDecimalPositive", resp, "Failure responding to request")
 	}
 
@@ -1036,14 +1105,15 @@ func (client QueriesClient) DoubleDecimalPositivePreparer(ctx context.Context) (
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/double/9999999.999"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DoubleDecimalPositiveSender sends the DoubleDecimalPositive request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) DoubleDecimalPositiveSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // DoubleDecimalPositiveResponder handles the response to the DoubleDecimalPositive request. The method always
@@ -1052,7 +1122,7 @@ func (client QueriesClient) DoubleDecimalPositiveResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1062,14 +1132,18 @@ func (client QueriesClient) DoubleDecimalPositiveResponder(resp *http.Response)
 // Parameters:
 // doubleQuery - null numeric value
 func (client QueriesClient) DoubleNull(ctx context.Context, doubleQuery *float64) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.DoubleNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.DoubleNull", "GET", "/queries/double/null")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.DoubleNull", map[string]interface{}{
+			"doubleQuery": doubleQuery,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.DoubleNullPreparer(ctx, doubleQuery)
@@ -1086,7 +1160,7 @@ func (client QueriesClient) DoubleNull(ctx context.Context, doubleQuery *float64
 	}
 
 	result, err = client.DoubleNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "DoubleNull", resp, "Failure responding to request")
 	}
 
@@ -1105,14 +1179,15 @@ func (client QueriesClient) DoubleNullPreparer(ctx context.Context, doubleQuery
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/double/null"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // DoubleNullSender sends the DoubleNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) DoubleNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // DoubleNullResponder handles the response to the DoubleNull request. The method always
@@ -1121,7 +1196,7 @@ func (client QueriesClient) DoubleNullResponder(resp *http.Response) (result aut
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1131,14 +1206,18 @@ func (client QueriesClient) DoubleNullResponder(resp *http.Response) (result aut
 // Parameters:
 // enumQuery - null string value
 func (client QueriesClient) EnumNull(ctx context.Context, enumQuery URIColor) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.EnumNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.EnumNull", "GET", "/queries/enum/null")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.EnumNull", map[string]interface{}{
+			"enumQuery": enumQuery,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.EnumNullPreparer(ctx, enumQuery)
@@ -1155,7 +1234,7 @@ func (client QueriesClient) EnumNull(ctx context.Context, enumQuery URIColor) (r
 	}
 
 	result, err = client.EnumNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "EnumNull", resp, "Failure responding to request")
 	}
 
@@ -1174,14 +1253,15 @@ func (client QueriesClient) EnumNullPreparer(ctx context.Context, enumQuery URIC
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/enum/null"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // EnumNullSender sends the EnumNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) EnumNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // EnumNullResponder handles the response to the EnumNull request. The method always
@@ -1190,7 +1270,7 @@ func (client QueriesClient) EnumNullResponder(resp *http.Response) (result autor
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1200,14 +1280,18 @@ func (client QueriesClient) EnumNullResponder(resp *http.Response) (result autor
 // Parameters:
 // enumQuery - 'green color' enum value
 func (client QueriesClient) EnumValid(ctx context.Context, enumQuery URIColor) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.EnumValid")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.EnumValid", "GET", "/queries/enum/green%20color")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.EnumValid", map[string]interface{}{
+			"enumQuery": enumQuery,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.EnumValidPreparer(ctx, enumQuery)
@@ -1224,7 +1308,7 @@ func (client QueriesClient) EnumValid(ctx context.Context, enumQuery URIColor) (
 	}
 
 	result, err = client.EnumValidResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "EnumValid", resp, "Failure responding to request")
 	}
 
@@ -1243,14 +1327,15 @@ func (client QueriesClient) EnumValidPreparer(ctx context.Context, enumQuery URI
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/enum/green%20color"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // EnumValidSender sends the EnumValid request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) EnumValidSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // EnumValidResponder handles the response to the EnumValid request. The method always
@@ -1259,7 +1344,7 @@ func (client QueriesClient) EnumValidResponder(resp *http.Response) (result auto
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1269,14 +1354,18 @@ func (client QueriesClient) EnumValidResponder(resp *http.Response) (result auto
 // Parameters:
 // floatQuery - null numeric value
 func (client QueriesClient) FloatNull(ctx context.Context, floatQuery *float64) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.FloatNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.FloatNull", "GET", "/queries/float/null")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.FloatNull", map[string]interface{}{
+			"floatQuery": floatQuery,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.FloatNullPreparer(ctx, floatQuery)
@@ -1293,7 +1382,7 @@ func (client QueriesClient) FloatNull(ctx context.Context, floatQuery *float64)
 	}
 
 	result, err = client.FloatNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "FloatNull", resp, "Failure responding to request")
 	}
 
@@ -1312,14 +1401,15 @@ func (client QueriesClient) FloatNullPreparer(ctx context.Context, floatQuery *f
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/float/null"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // FloatNullSender sends the FloatNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) FloatNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // FloatNullResponder handles the response to the FloatNull request. The method always
@@ -1328,7 +1418,7 @@ func (client QueriesClient) FloatNullResponder(resp *http.Response) (result auto
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1336,14 +1426,16 @@ func (client QueriesClient) FloatNullResponder(resp *http.Response) (result auto
 
 // FloatScientificNegative get '-1.034E-20' numeric value
 func (client QueriesClient) FloatScientificNegative(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.FloatScientificNegative")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.FloatScientificNegative", "GET", "/queries/float/-1.034E-20")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.FloatScientificNegative", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.FloatScientificNegativePreparer(ctx)
@@ -1360,7 +1452,7 @@ func (client QueriesClient) FloatScientificNegative(ctx context.Context) (result
 	}
 
 	result, err = client.FloatScientificNegativeResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "FloatScientificNegative", resp, "Failure responding to request")
 	}
 
@@ -1378,14 +1470,15 @@ func (client QueriesClient) FloatScientificNegativePreparer(ctx context.Context)
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/float/-1.034E-20"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // FloatScientificNegativeSender sends the FloatScientificNegative request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) FloatScientificNegativeSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // FloatScientificNegativeResponder handles the response to the FloatScientificNegative request. The method always
@@ -1394,7 +1487,7 @@ func (client QueriesClient) FloatScientificNegativeResponder(resp *http.Response
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1402,14 +1495,16 @@ func (client QueriesClient) FloatScientificNegativeResponder(resp *http.Response
 
 // FloatScientificPositive get '1.034E+20' numeric value
 func (client QueriesClient) FloatScientificPositive(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.FloatScientificPositive")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.FloatScientificPositive", "GET", "/queries/float/1.034E+20")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.FloatScientificPositive", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.FloatScientificPositivePreparer(ctx)
@@ -1426,7 +1521,7 @@ func (client QueriesClient) FloatScientificPositive(ctx context.Context) (result
 	}
 
 	result, err = client.FloatScientificPositiveResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "FloatScientificPositive", resp, "Failure responding to request")
 	}
 
@@ -1444,14 +1539,15 @@ func (client QueriesClient) FloatScientificPositivePreparer(ctx context.Context)
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/float/1.034E+20"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // FloatScientificPositiveSender sends the FloatScientificPositive request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) FloatScientificPositiveSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // FloatScientificPositiveResponder handles the response to the FloatScientificPositive request. The method always
@@ -1460,7 +1556,7 @@ func (client QueriesClient) FloatScientificPositiveResponder(resp *http.Response
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1468,14 +1564,16 @@ func (client QueriesClient) FloatScientificPositiveResponder(resp *http.Response
 
 // GetBooleanFalse get false Boolean value on path
 func (client QueriesClient) GetBooleanFalse(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.GetBooleanFalse")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.GetBooleanFalse", "GET", "/queries/bool/false")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.GetBooleanFalse", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetBooleanFalsePreparer(ctx)
@@ -1492,7 +1590,7 @@ func (client QueriesClient) GetBooleanFalse(ctx context.Context) (result autores
 	}
 
 	result, err = client.GetBooleanFalseResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "GetBooleanFalse", resp, "Failure responding to request")
 	}
 
@@ -1510,14 +1608,15 @@ func (client QueriesClient) GetBooleanFalsePreparer(ctx context.Context) (*http.
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/bool/false"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetBooleanFalseSender sends the GetBooleanFalse request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) GetBooleanFalseSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetBooleanFalseResponder handles the response to the GetBooleanFalse request. The method always
@@ -1526,7 +1625,7 @@ func (client QueriesClient) GetBooleanFalseResponder(resp *http.Response) (resul
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1536,14 +1635,18 @@ func (client QueriesClient) GetBooleanFalseResponder(resp *http.Response) (resul
 // Parameters:
 // boolQuery - null boolean value
 func (client QueriesClient) GetBooleanNull(ctx context.Context, boolQuery *bool) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.GetBooleanNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.GetBooleanNull", "GET", "/queries/bool/null")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.GetBooleanNull", map[string]interface{}{
+			"boolQuery": boolQuery,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetBooleanNullPreparer(ctx, boolQuery)
@@ -1560,7 +1663,7 @@ func (client QueriesClient) GetBooleanNull(ctx context.Context, boolQuery *bool)
 	}
 
 	result, err = client.GetBooleanNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "GetBooleanNull", resp, "Failure responding to request")
 	}
 
@@ -1579,14 +1682,15 @@ func (client QueriesClient) GetBooleanNullPreparer(ctx context.Context, boolQuer
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/bool/null"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetBooleanNullSender sends the GetBooleanNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) GetBooleanNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetBooleanNullResponder handles the response to the GetBooleanNull request. The method always
@@ -1595,7 +1699,7 @@ func (client QueriesClient) GetBooleanNullResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1603,14 +1707,16 @@ func (client QueriesClient) GetBooleanNullResponder(resp *http.Response) (result
 
 // GetBooleanTrue get true Boolean value on path
 func (client QueriesClient) GetBooleanTrue(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.GetBooleanTrue")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.GetBooleanTrue", "GET", "/queries/bool/true")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.GetBooleanTrue", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetBooleanTruePreparer(ctx)
@@ -1627,7 +1733,7 @@ func (client QueriesClient) GetBooleanTrue(ctx context.Context) (result autorest
 	}
 
 	result, err = client.GetBooleanTrueResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "GetBooleanTrue", resp, "Failure responding to request")
 	}
 
@@ -1645,14 +1751,15 @@ func (client QueriesClient) GetBooleanTruePreparer(ctx context.Context) (*http.R
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/bool/true"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetBooleanTrueSender sends the GetBooleanTrue request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) GetBooleanTrueSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetBooleanTrueResponder handles the response to the GetBooleanTrue request. The method always
@@ -1661,7 +1768,7 @@ func (client QueriesClient) GetBooleanTrueResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1669,14 +1776,16 @@ func (client QueriesClient) GetBooleanTrueResponder(resp *http.Response) (result
 
 // GetIntNegativeOneMillion get '-1000000' integer value
 func (client QueriesClient) GetIntNegativeOneMillion(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.GetIntNegativeOneMillion")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.GetIntNegativeOneMillion", "GET", "/queries/int/-1000000")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.GetIntNegativeOneMillion", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetIntNegativeOneMillionPreparer(ctx)
@@ -1693,7 +1802,7 @@ func (client QueriesClient) GetIntNegativeOneMillion(ctx context.Context) (resul
 	}
 
 	result, err = client.GetIntNegativeOneMillionResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "GetIntNegativeOneMillion", resp, "Failure responding to request")
 	}
 
@@ -1711,14 +1820,15 @@ func (client QueriesClient) GetIntNegativeOneMillionPreparer(ctx context.Context
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/int/-1000000"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetIntNegativeOneMillionSender sends the GetIntNegativeOneMillion request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) GetIntNegativeOneMillionSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetIntNegativeOneMillionResponder handles the response to the GetIntNegativeOneMillion request. The method always
@@ -1727,7 +1837,7 @@ func (client QueriesClient) GetIntNegativeOneMillionResponder(resp *http.Respons
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1737,14 +1847,18 @@ func (client QueriesClient) GetIntNegativeOneMillionResponder(resp *http.Respons
 // Parameters:
 // intQuery - null integer value
 func (client QueriesClient) GetIntNull(ctx context.Context, intQuery *int32) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.GetIntNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.GetIntNull", "GET", "/queries/int/null")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.GetIntNull", map[string]interface{}{
+			"intQuery": intQuery,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetIntNullPreparer(ctx, intQuery)
@@ -1761,7 +1875,7 @@ func (client QueriesClient) GetIntNull(ctx context.Context, intQuery *int32) (re
 	}
 
 	result, err = client.GetIntNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "GetIntNull", resp, "Failure responding to request")
 	}
 
@@ -1780,14 +1894,15 @@ func (client QueriesClient) GetIntNullPreparer(ctx context.Context, intQuery *in
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/int/null"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetIntNullSender sends the GetIntNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) GetIntNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetIntNullResponder handles the response to the GetIntNull request. The method always
@@ -1796,7 +1911,7 @@ func (client QueriesClient) GetIntNullResponder(resp *http.Response) (result aut
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1804,14 +1919,16 @@ func (client QueriesClient) GetIntNullResponder(resp *http.Response) (result aut
 
 // GetIntOneMillion get '1000000' integer value
 func (client QueriesClient) GetIntOneMillion(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.GetIntOneMillion")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.GetIntOneMillion", "GET", "/queries/int/1000000")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.GetIntOneMillion", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetIntOneMillionPreparer(ctx)
@@ -1828,7 +1945,7 @@ func (client QueriesClient) GetIntOneMillion(ctx context.Context) (result autore
 	}
 
 	result, err = client.GetIntOneMillionResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "GetIntOneMillion", resp, "Failure responding to request")
 	}
 
@@ -1846,14 +1963,15 @@ func (client QueriesClient) GetIntOneMillionPreparer(ctx context.Context) (*http
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/int/1000000"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetIntOneMillionSender sends the GetIntOneMillion request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) GetIntOneMillionSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetIntOneMillionResponder handles the response to the GetIntOneMillion request. The method always
@@ -1862,7 +1980,7 @@ func (client QueriesClient) GetIntOneMillionResponder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1872,14 +1990,18 @@ func (client QueriesClient) GetIntOneMillionResponder(resp *http.Response) (resu
 // Parameters:
 // longQuery - null 64 bit integer value
 func (client QueriesClient) GetLongNull(ctx context.Context, longQuery *int64) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.GetLongNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.GetLongNull", "GET", "/queries/long/null")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.GetLongNull", map[string]interface{}{
+			"longQuery": longQuery,
+		})
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetLongNullPreparer(ctx, longQuery)
@@ -1896,7 +2018,7 @@ func (client QueriesClient) GetLongNull(ctx context.Context, longQuery *int64) (
 	}
 
 	result, err = client.GetLongNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "GetLongNull", resp, "Failure responding to request")
 	}
 
@@ -1915,14 +2037,15 @@ func (client QueriesClient) GetLongNullPreparer(ctx context.Context, longQuery *
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/long/null"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetLongNullSender sends the GetLongNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) GetLongNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetLongNullResponder handles the response to the GetLongNull request. The method always
@@ -1931,7 +2054,7 @@ func (client QueriesClient) GetLongNullResponder(resp *http.Response) (result au
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -1939,14 +2062,16 @@ func (client QueriesClient) GetLongNullResponder(resp *http.Response) (result au
 
 // GetNegativeTenBillion get '-10000000000' 64 bit integer value
 func (client QueriesClient) GetNegativeTenBillion(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.GetNegativeTenBillion")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.GetNegativeTenBillion", "GET", "/queries/long/-10000000000")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.GetNegativeTenBillion", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetNegativeTenBillionPreparer(ctx)
@@ -1963,7 +2088,7 @@ func (client QueriesClient) GetNegativeTenBillion(ctx context.Context) (result a
 	}
 
 	result, err = client.GetNegativeTenBillionResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "GetNegativeTenBillion", resp, "Failure responding to request")
 	}
 
@@ -1981,14 +2106,15 @@ func (client QueriesClient) GetNegativeTenBillionPreparer(ctx context.Context) (
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/long/-10000000000"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetNegativeTenBillionSender sends the GetNegativeTenBillion request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) GetNegativeTenBillionSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetNegativeTenBillionResponder handles the response to the GetNegativeTenBillion request. The method always
@@ -1997,7 +2123,7 @@ func (client QueriesClient) GetNegativeTenBillionResponder(resp *http.Response)
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -2005,14 +2131,16 @@ func (client QueriesClient) GetNegativeTenBillionResponder(resp *http.Response)
 
 // GetTenBillion get '10000000000' 64 bit integer value
 func (client QueriesClient) GetTenBillion(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.GetTenBillion")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.GetTenBillion", "GET", "/queries/long/10000000000")
+		defer func() {
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.GetTenBillion", nil)
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetTenBillionPreparer(ctx)
@@ -2029,7 +2157,7 @@ func (client QueriesClient) GetTenBillion(ctx context.Context) (result autorest.
 	}
 
 	result, err = client.GetTenBillionResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "GetTenBillion", resp, "Failure responding to request")
 	}
 
@@ -2047,14 +2175,15 @@ func (client QueriesClient) GetTenBillionPreparer(ctx context.Context) (*http.Re
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/long/10000000000"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetTenBillionSender sends the GetTenBillion request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) GetTenBillionSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetTenBillionResponder handles the response to the GetTenBillion request. The method always
@@ -2063,7 +2192,7 @@ func (client QueriesClient) GetTenBillionResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -2071,14 +2200,16 @@ func (client QueriesClient) GetTenBillionResponder(resp *http.Response) (result
 
 // StringEmpty get ''
 func (client QueriesClient) StringEmpty(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.StringEmpty")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.StringEmpty", "GET", "/queries/string/empty")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.StringEmpty", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.StringEmptyPreparer(ctx)
@@ -2095,7 +2226,7 @@ func (client QueriesClient) StringEmpty(ctx context.Context) (result autorest.Re
 	}
 
 	result, err = client.StringEmptyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "StringEmpty", resp, "Failure responding to request")
 	}
 
@@ -2113,14 +2244,15 @@ func (client QueriesClient) StringEmptyPreparer(ctx context.Context) (*http.Requ
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/string/empty"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // StringEmptySender sends the StringEmpty request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) StringEmptySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // StringEmptyResponder handles the response to the StringEmpty request. The method always
@@ -2129,7 +2261,7 @@ func (client QueriesClient) StringEmptyResponder(resp *http.Response) (result au
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -2139,14 +2271,18 @@ func (client QueriesClient) StringEmptyResponder(resp *http.Response) (result au
 // Parameters:
 // stringQuery - null string value
 func (client QueriesClient) StringNull(ctx context.Context, stringQuery string) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.StringNull")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.StringNull", "GET", "/queries/string/null")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.StringNull", map[string]interface{}{
+			"stringQuery": stringQuery,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.StringNullPreparer(ctx, stringQuery)
@@ -2163,7 +2299,7 @@ func (client QueriesClient) StringNull(ctx context.Context, stringQuery string)
 	}
 
 	result, err = client.StringNullResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "StringNull", resp, "Failure responding to request")
 	}
 
@@ -2182,14 +2318,15 @@ func (client QueriesClient) StringNullPreparer(ctx context.Context, stringQuery
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/string/null"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // StringNullSender sends the StringNull request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) StringNullSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // StringNullResponder handles the response to the StringNull request. The method always
@@ -2198,7 +2335,7 @@ func (client QueriesClient) StringNullResponder(resp *http.Response) (result aut
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -2206,14 +2343,16 @@ func (client QueriesClient) StringNullResponder(resp *http.Response) (result aut
 
 // StringUnicode get '啊齄丂狛狜隣郎隣兀﨩' multi-byte string value
 func (client QueriesClient) StringUnicode(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.StringUnicode")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.StringUnicode", "GET", "/queries/string/unicode/")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.StringUnicode", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.StringUnicodePreparer(ctx)
@@ -2230,7 +2369,7 @@ func (client QueriesClient) StringUnicode(ctx context.Context) (result autorest.
 	}
 
 	result, err = client.StringUnicodeResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "StringUnicode", resp, "Failure responding to request")
 	}
 
@@ -2248,14 +2387,15 @@ func (client QueriesClient) StringUnicodePreparer(ctx context.Context) (*http.Re
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/string/unicode/"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // StringUnicodeSender sends the StringUnicode request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) StringUnicodeSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // StringUnicodeResponder handles the response to the StringUnicode request. The method always
@@ -2264,7 +2404,7 @@ func (client QueriesClient) StringUnicodeResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -2272,14 +2412,16 @@ func (client QueriesClient) StringUnicodeResponder(resp *http.Response) (result
 
 // StringURLEncoded get 'begin!*'();:@ &=+$,/?#[]end
 func (client QueriesClient) StringURLEncoded(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/QueriesClient.StringURLEncoded")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/QueriesClient.StringURLEncoded", "GET", "/queries/string/begin%21%2A%27%28%29%3B%3A%40%20%26%3D%2B%24%2C%2F%3F%23%5B%5Dend")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "QueriesClient.StringURLEncoded", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.StringURLEncodedPreparer(ctx)
@@ -2296,7 +2438,7 @@ func (client QueriesClient) StringURLEncoded(ctx context.Context) (result autore
 	}
 
 	result, err = client.StringURLEncodedResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "urlgroup.QueriesClient", "StringURLEncoded", resp, "Failure responding to request")
 	}
 
@@ -2314,14 +2456,15 @@ func (client QueriesClient) StringURLEncodedPreparer(ctx context.Context) (*http
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPath("/queries/string/begin%21%2A%27%28%29%3B%3A%40%20%26%3D%2B%24%2C%2F%3F%23%5B%5Dend"),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // StringURLEncodedSender sends the StringURLEncoded request. The method will close the
 // http.Response Body if it receives an error.
 func (client QueriesClient) StringURLEncodedSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // StringURLEncodedResponder handles the response to the StringURLEncoded request. The method always
@@ -2330,7 +2473,7 @@ func (client QueriesClient) StringURLEncodedResponder(resp *http.Response) (resu
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByClosing())
 	result.Response = resp
 	return
@@ -11,11 +11,17 @@ package validationgroup
 
 import (
 	"context"
+	"crypto/rand"
+	"fmt"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/validation"
 	"github.com/Azure/go-autorest/tracing"
 	"net/http"
+	"net/url"
+	"regexp"
+	"sync"
+	"time"
 )
 
 const (
@@ -26,8 +32,13 @@ const (
 // BaseClient is the base client for Validationgroup.
 type BaseClient struct {
 	autorest.Client
-	BaseURI        string
-	SubscriptionID string
+	BaseURI            string
+	SubscriptionID     string
+	RetryPolicy        RetryPolicy
+	Pipeline           []PipelinePolicy
+	Logger             Logger
+	Tracer             Tracer
+	ResponseValidation ResponseValidation
 }
 
 // New creates an instance of the BaseClient client.
@@ -44,16 +55,293 @@ func NewWithBaseURI(baseURI string, subscriptionID string) BaseClient {
 	}
 }
 
+// ClientOptions contains the options used to create a BaseClient with NewWithOptions.
+type ClientOptions struct {
+	// BaseURI - the endpoint of the service.  Defaults to DefaultBaseURI.
+	BaseURI string
+	// Authorizer - authorizes the requests sent by the client.  Requests aren't authorized if it's nil.
+	Authorizer autorest.Authorizer
+	// RetryAttempts - the number of times a request is retried after a retriable failure.  Zero uses autorest.DefaultRetryAttempts
+	// and a negative value disables retries.
+	RetryAttempts int
+	// RetryDuration - the delay between retries.  Zero uses autorest.DefaultRetryDuration.
+	RetryDuration time.Duration
+	// RetryPolicy - decides if and when failed requests are retried.  If nil, an ExponentialRetry created from
+	// RetryAttempts and RetryDuration is used.
+	RetryPolicy RetryPolicy
+	// HTTPClient - sends the requests, e.g. an *http.Client.  Defaults to the sender created by autorest.
+	HTTPClient autorest.Sender
+	// Pipeline - the policies the requests go through, in order, before being sent.
+	Pipeline []PipelinePolicy
+	// Logger - receives the events logged by the operations.  Nothing is logged if it's nil.
+	Logger Logger
+	// Tracer - starts the spans tracing the operations.  If nil, the Tracer passed to RegisterTracer is used.  If
+	// that's nil too, the operations are traced by github.com/Azure/go-autorest/tracing when it's enabled.
+	Tracer Tracer
+	// UserAgentSuffix - appended to the User-Agent header of the requests.
+	UserAgentSuffix string
+	// SubscriptionID - the value of the subscriptionId parameter sent with the requests.  Required.
+	SubscriptionID string
+}
+
+// NewWithOptions creates an instance of the BaseClient client with the specified options.  It returns an error if the
+// options are invalid.
+func NewWithOptions(opts ClientOptions) (BaseClient, error) {
+	if opts.BaseURI == "" {
+		opts.BaseURI = DefaultBaseURI
+	} else if u, err := url.Parse(opts.BaseURI); err != nil || !u.IsAbs() || u.Host == "" {
+		return BaseClient{}, validation.NewError("validationgroup.BaseClient", "NewWithOptions", "BaseURI %q isn't an absolute URI", opts.BaseURI)
+	}
+	if opts.SubscriptionID == "" {
+		return BaseClient{}, validation.NewError("validationgroup.BaseClient", "NewWithOptions", "SubscriptionID must be set")
+	}
+	if opts.RetryDuration < 0 {
+		return BaseClient{}, validation.NewError("validationgroup.BaseClient", "NewWithOptions", "RetryDuration can't be negative")
+	}
+	client := BaseClient{
+		Client:         autorest.NewClientWithUserAgent(UserAgent()),
+		BaseURI:        opts.BaseURI,
+		SubscriptionID: opts.SubscriptionID,
+	}
+	if opts.Authorizer != nil {
+		client.Authorizer = opts.Authorizer
+	}
+	if opts.RetryAttempts > 0 {
+		client.RetryAttempts = opts.RetryAttempts
+	} else if opts.RetryAttempts < 0 {
+		client.RetryAttempts = 0
+	}
+	if opts.RetryDuration > 0 {
+		client.RetryDuration = opts.RetryDuration
+	}
+	client.RetryPolicy = opts.RetryPolicy
+	if opts.HTTPClient != nil {
+		client.Sender = opts.HTTPClient
+	}
+	client.Pipeline = opts.Pipeline
+	client.Logger = opts.Logger
+	client.Tracer = opts.Tracer
+	if opts.UserAgentSuffix != "" {
+		client.AddToUserAgent(opts.UserAgentSuffix)
+	}
+	return client, nil
+}
+
+// PipelinePolicy is a step of the pipeline the requests sent by a client go through.  It can modify the request, pass it
+// to next to send it, and inspect or replace the response.  The policies run for each attempt to send a request.
+type PipelinePolicy func(req *http.Request, next autorest.Sender) (*http.Response, error)
+
+// pipeline returns a Sender that runs requests through the Pipeline of the client, in order, before sending them.
+func (client BaseClient) pipeline() autorest.Sender {
+	var s autorest.Sender = client.Client
+	for i := len(client.Pipeline) - 1; i >= 0; i-- {
+		policy, next := client.Pipeline[i], s
+		s = autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			return policy(r, next)
+		})
+	}
+	return s
+}
+
+// NewTelemetryPolicy returns a PipelinePolicy that passes each request, its outcome and the time it took to record, e.g.
+// to update metrics.
+func NewTelemetryPolicy(record func(req *http.Request, resp *http.Response, err error, elapsed time.Duration)) PipelinePolicy {
+	return func(req *http.Request, next autorest.Sender) (*http.Response, error) {
+		start := time.Now()
+		resp, err := next.Do(req)
+		record(req, resp, err, time.Since(start))
+		return resp, err
+	}
+}
+
+// NewLoggingPolicy returns a PipelinePolicy that passes a line describing each request and its outcome to logf, e.g.
+// log.Printf.  The user information and the query of the URL aren't logged as they can contain secrets.
+func NewLoggingPolicy(logf func(format string, v ...interface{})) PipelinePolicy {
+	return NewTelemetryPolicy(func(req *http.Request, resp *http.Response, err error, elapsed time.Duration) {
+		u := *req.URL
+		u.User = nil
+		u.RawQuery = ""
+		if err != nil {
+			logf("%s %s failed after %v: %v", req.Method, u.String(), elapsed, err)
+		} else {
+			logf("%s %s returned %d after %v", req.Method, u.String(), resp.StatusCode, elapsed)
+		}
+	})
+}
+
+// NewRequestIDPolicy returns a PipelinePolicy that stamps each request with a unique ID in the specified header, e.g.
+// x-ms-client-request-id, unless the request already has one.  Retries of a request send the same ID.
+func NewRequestIDPolicy(header string) PipelinePolicy {
+	return func(req *http.Request, next autorest.Sender) (*http.Response, error) {
+		if req.Header.Get(header) == "" {
+			if req.Header == nil {
+				req.Header = http.Header{}
+			}
+			var id [16]byte
+			if _, err := rand.Read(id[:]); err != nil {
+				return nil, err
+			}
+			// format the ID as a version 4 UUID
+			id[6] = id[6]&0x0f | 0x40
+			id[8] = id[8]&0x3f | 0x80
+			req.Header.Set(header, fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:]))
+		}
+		return next.Do(req)
+	}
+}
+
+// Attributes set on the spans of operations.
+const (
+	// AttributeHTTPMethod is the HTTP method of the operation.
+	AttributeHTTPMethod = "http.method"
+	// AttributeURLTemplate is the template of the URL path of the operation.
+	AttributeURLTemplate = "http.url_template"
+	// AttributeStatusCode is the status code of the last response received.
+	AttributeStatusCode = "http.status_code"
+	// AttributeRetryCount is the number of times the request was retried.
+	AttributeRetryCount = "http.retry_count"
+)
+
+// Tracer starts the spans tracing the operations of clients, e.g. an adapter to OpenTelemetry.
+type Tracer interface {
+	// StartSpan starts a span named name as a child of the span in ctx, if any, and returns a context containing the
+	// new span.
+	StartSpan(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a span started by a Tracer.
+type Span interface {
+	// SetAttribute sets the value of an attribute of the span.
+	SetAttribute(key string, value interface{})
+	// End ends the span.  err is the error the traced call failed with, if any.
+	End(err error)
+}
+
+// registeredTracer is the Tracer used by the clients whose Tracer is nil, guarded by registeredTracerMu.
+var (
+	registeredTracerMu sync.RWMutex
+	registeredTracer   Tracer
+)
+
+// RegisterTracer sets the Tracer used by the clients whose Tracer is nil.  Pass nil to trace them with
+// github.com/Azure/go-autorest/tracing, which only traces them when it's enabled.  It's safe to call RegisterTracer
+// while operations are running: the operations started after it returns use tracer.
+func RegisterTracer(tracer Tracer) {
+	registeredTracerMu.Lock()
+	defer registeredTracerMu.Unlock()
+	registeredTracer = tracer
+}
+
+// tracer returns the Tracer of the client, or the registered one if it's nil.  If neither is set it returns an
+// adapter to github.com/Azure/go-autorest/tracing when it's enabled, and nil otherwise.
+func (client BaseClient) tracer() Tracer {
+	if client.Tracer != nil {
+		return client.Tracer
+	}
+	registeredTracerMu.RLock()
+	tracer := registeredTracer
+	registeredTracerMu.RUnlock()
+	if tracer != nil {
+		return tracer
+	}
+	if tracing.IsEnabled() {
+		return autorestTracer{}
+	}
+	return nil
+}
+
+// autorestTracer is a Tracer starting the OpenCensus spans of github.com/Azure/go-autorest/tracing.
+type autorestTracer struct{}
+
+// StartSpan implements the Tracer interface for autorestTracer.
+func (autorestTracer) StartSpan(ctx context.Context, name string) (context.Context, Span) {
+	ctx = tracing.StartSpan(ctx, name)
+	return ctx, &autorestSpan{ctx: ctx, statusCode: -1}
+}
+
+// autorestSpan is a span started by autorestTracer.  go-autorest/tracing only records the status code of a span, the
+// other attributes are ignored.
+type autorestSpan struct {
+	ctx        context.Context
+	statusCode int
+}
+
+// SetAttribute implements the Span interface for autorestSpan.
+func (as *autorestSpan) SetAttribute(key string, value interface{}) {
+	if sc, ok := value.(int); ok && key == AttributeStatusCode {
+		as.statusCode = sc
+	}
+}
+
+// End implements the Span interface for autorestSpan.
+func (as *autorestSpan) End(err error) {
+	tracing.EndSpan(as.ctx, as.statusCode, err)
+}
+
+// traceSpan is a span started by startSpan, carried by the contexts of the requests of the traced call.
+type traceSpan struct {
+	tracer Tracer
+	name   string
+	span   Span
+}
+
+// traceSpanKey is the key of the traceSpan in a context.
+type traceSpanKey struct{}
+
+// startSpan starts a span named name with tracer and returns a context carrying it.  The HTTP method and the URL
+// template of the operation are set on the span unless they're empty.
+func startSpan(ctx context.Context, tracer Tracer, name, method, urlTemplate string) context.Context {
+	ctx, span := tracer.StartSpan(ctx, name)
+	if method != "" {
+		span.SetAttribute(AttributeHTTPMethod, method)
+	}
+	if urlTemplate != "" {
+		span.SetAttribute(AttributeURLTemplate, urlTemplate)
+	}
+	return context.WithValue(ctx, traceSpanKey{}, &traceSpan{tracer: tracer, name: name, span: span})
+}
+
+// startChildSpan starts a child of the span carried by ctx, named after it with suffix appended, and returns a context
+// carrying it.  It returns ctx if it doesn't carry a span.
+func startChildSpan(ctx context.Context, suffix string) context.Context {
+	ts, ok := ctx.Value(traceSpanKey{}).(*traceSpan)
+	if !ok {
+		return ctx
+	}
+	return startSpan(ctx, ts.tracer, ts.name+"/"+suffix, "", "")
+}
+
+// setSpanAttribute sets an attribute of the span carried by ctx, if any.
+func setSpanAttribute(ctx context.Context, key string, value interface{}) {
+	if ts, ok := ctx.Value(traceSpanKey{}).(*traceSpan); ok {
+		ts.span.SetAttribute(key, value)
+	}
+}
+
+// endSpan ends the span carried by ctx, if any, setting the status code of resp on it if resp isn't nil.
+func endSpan(ctx context.Context, resp *http.Response, err error) {
+	ts, ok := ctx.Value(traceSpanKey{}).(*traceSpan)
+	if !ok {
+		return
+	}
+	if resp != nil {
+		ts.span.SetAttribute(AttributeStatusCode, resp.StatusCode)
+	}
+	ts.span.End(err)
+}
+
 // GetWithConstantInPath sends the get with constant in path request.
 func (client BaseClient) GetWithConstantInPath(ctx context.Context) (result autorest.Response, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.GetWithConstantInPath")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BaseClient.GetWithConstantInPath", "GET", "/validation/constantsInPath/{constantParam}/value")
 		defer func() {
-			sc := -1
-			if result.Response != nil {
-				sc = result.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BaseClient.GetWithConstantInPath", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response)
 		}()
 	}
 	req, err := client.GetWithConstantInPathPreparer(ctx)
@@ -87,14 +375,15 @@ func (client BaseClient) GetWithConstantInPathPreparer(ctx context.Context) (*ht
 		autorest.AsGet(),
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/validation/constantsInPath/{constantParam}/value", pathParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // GetWithConstantInPathSender sends the GetWithConstantInPath request. The method will close the
 // http.Response Body if it receives an error.
 func (client BaseClient) GetWithConstantInPathSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // GetWithConstantInPathResponder handles the response to the GetWithConstantInPath request. The method always
@@ -109,42 +398,94 @@ func (client BaseClient) GetWithConstantInPathResponder(resp *http.Response) (re
 	return
 }
 
+// GetWithReadOnlyProperty gets a product with a required read-only property, which the service may omit.
+func (client BaseClient) GetWithReadOnlyProperty(ctx context.Context) (result ReadOnlyProduct, err error) {
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BaseClient.GetWithReadOnlyProperty", "GET", "/validation/readOnlyProperty")
+		defer func() {
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BaseClient.GetWithReadOnlyProperty", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
+		}()
+	}
+	req, err := client.GetWithReadOnlyPropertyPreparer(ctx)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "validationgroup.BaseClient", "GetWithReadOnlyProperty", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetWithReadOnlyPropertySender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "validationgroup.BaseClient", "GetWithReadOnlyProperty", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.GetWithReadOnlyPropertyResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "validationgroup.BaseClient", "GetWithReadOnlyProperty", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// GetWithReadOnlyPropertyPreparer prepares the GetWithReadOnlyProperty request.
+func (client BaseClient) GetWithReadOnlyPropertyPreparer(ctx context.Context) (*http.Request, error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPath("/validation/readOnlyProperty"))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// GetWithReadOnlyPropertySender sends the GetWithReadOnlyProperty request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) GetWithReadOnlyPropertySender(req *http.Request) (*http.Response, error) {
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
+}
+
+// GetWithReadOnlyPropertyResponder handles the response to the GetWithReadOnlyProperty request. The method always
+// closes the http.Response Body.
+func (client BaseClient) GetWithReadOnlyPropertyResponder(resp *http.Response) (result ReadOnlyProduct, err error) {
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = client.ResponseValidation.check(fqdn+"/BaseClient.GetWithReadOnlyProperty", result.validateResponse())
+	}
+	return
+}
+
 // PostWithConstantInBody sends the post with constant in body request.
 func (client BaseClient) PostWithConstantInBody(ctx context.Context, body *Product) (result Product, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.PostWithConstantInBody")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BaseClient.PostWithConstantInBody", "POST", "/validation/constantsInPath/{constantParam}/value")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BaseClient.PostWithConstantInBody", nil)
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: body,
-			Constraints: []validation.Constraint{{Target: "body", Name: validation.Null, Rule: false,
-				Chain: []validation.Constraint{{Target: "body.DisplayNames", Name: validation.Null, Rule: false,
-					Chain: []validation.Constraint{{Target: "body.DisplayNames", Name: validation.MaxItems, Rule: 6, Chain: nil},
-						{Target: "body.DisplayNames", Name: validation.MinItems, Rule: 0, Chain: nil},
-						{Target: "body.DisplayNames", Name: validation.UniqueItems, Rule: true, Chain: nil},
-					}},
-					{Target: "body.Capacity", Name: validation.Null, Rule: false,
-						Chain: []validation.Constraint{{Target: "body.Capacity", Name: validation.ExclusiveMaximum, Rule: 100, Chain: nil},
-							{Target: "body.Capacity", Name: validation.ExclusiveMinimum, Rule: 0, Chain: nil},
-						}},
-					{Target: "body.Image", Name: validation.Null, Rule: false,
-						Chain: []validation.Constraint{{Target: "body.Image", Name: validation.Pattern, Rule: `http://\w+`, Chain: nil}}},
-					{Target: "body.Child", Name: validation.Null, Rule: true,
-						Chain: []validation.Constraint{{Target: "body.Child.ConstProperty", Name: validation.Null, Rule: true, Chain: nil}}},
-					{Target: "body.ConstChild", Name: validation.Null, Rule: true,
-						Chain: []validation.Constraint{{Target: "body.ConstChild.ConstProperty", Name: validation.Null, Rule: true, Chain: nil},
-							{Target: "body.ConstChild.ConstProperty2", Name: validation.Null, Rule: true, Chain: nil},
-						}},
-					{Target: "body.ConstInt", Name: validation.Null, Rule: true, Chain: nil},
-					{Target: "body.ConstString", Name: validation.Null, Rule: true, Chain: nil},
-				}}}}}); err != nil {
-		return result, validation.NewError("validationgroup.BaseClient", "PostWithConstantInBody", err.Error())
+	var errs ValidationErrors
+	if body != nil {
+		errs = errs.addNested("body", body.Validate())
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("validationgroup.BaseClient", "PostWithConstantInBody", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.PostWithConstantInBodyPreparer(ctx, body)
@@ -183,14 +524,15 @@ func (client BaseClient) PostWithConstantInBodyPreparer(ctx context.Context, bod
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(body))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // PostWithConstantInBodySender sends the PostWithConstantInBody request. The method will close the
 // http.Response Body if it receives an error.
 func (client BaseClient) PostWithConstantInBodySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // PostWithConstantInBodyResponder handles the response to the PostWithConstantInBody request. The method always
@@ -203,6 +545,9 @@ func (client BaseClient) PostWithConstantInBodyResponder(resp *http.Response) (r
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = client.ResponseValidation.check(fqdn+"/BaseClient.PostWithConstantInBody", result.validateResponse())
+	}
 	return
 }
 
@@ -211,48 +556,45 @@ func (client BaseClient) PostWithConstantInBodyResponder(resp *http.Response) (r
 // resourceGroupName - required string between 3 and 10 chars with pattern [a-zA-Z0-9]+.
 // ID - required int multiple of 10 from 100 to 1000.
 func (client BaseClient) ValidationOfBody(ctx context.Context, resourceGroupName string, ID int32, body *Product) (result Product, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.ValidationOfBody")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BaseClient.ValidationOfBody", "PUT", "/fakepath/{subscriptionId}/{resourceGroupName}/{id}")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: resourceGroupName,
-			Constraints: []validation.Constraint{{Target: "resourceGroupName", Name: validation.MaxLength, Rule: 10, Chain: nil},
-				{Target: "resourceGroupName", Name: validation.MinLength, Rule: 3, Chain: nil},
-				{Target: "resourceGroupName", Name: validation.Pattern, Rule: `[a-zA-Z0-9]+`, Chain: nil}}},
-		{TargetValue: ID,
-			Constraints: []validation.Constraint{{Target: "ID", Name: validation.InclusiveMaximum, Rule: int64(1000), Chain: nil},
-				{Target: "ID", Name: validation.InclusiveMinimum, Rule: 100, Chain: nil},
-				{Target: "ID", Name: validation.MultipleOf, Rule: 10, Chain: nil}}},
-		{TargetValue: body,
-			Constraints: []validation.Constraint{{Target: "body", Name: validation.Null, Rule: false,
-				Chain: []validation.Constraint{{Target: "body.DisplayNames", Name: validation.Null, Rule: false,
-					Chain: []validation.Constraint{{Target: "body.DisplayNames", Name: validation.MaxItems, Rule: 6, Chain: nil},
-						{Target: "body.DisplayNames", Name: validation.MinItems, Rule: 0, Chain: nil},
-						{Target: "body.DisplayNames", Name: validation.UniqueItems, Rule: true, Chain: nil},
-					}},
-					{Target: "body.Capacity", Name: validation.Null, Rule: false,
-						Chain: []validation.Constraint{{Target: "body.Capacity", Name: validation.ExclusiveMaximum, Rule: 100, Chain: nil},
-							{Target: "body.Capacity", Name: validation.ExclusiveMinimum, Rule: 0, Chain: nil},
-						}},
-					{Target: "body.Image", Name: validation.Null, Rule: false,
-						Chain: []validation.Constraint{{Target: "body.Image", Name: validation.Pattern, Rule: `http://\w+`, Chain: nil}}},
-					{Target: "body.Child", Name: validation.Null, Rule: true,
-						Chain: []validation.Constraint{{Target: "body.Child.ConstProperty", Name: validation.Null, Rule: true, Chain: nil}}},
-					{Target: "body.ConstChild", Name: validation.Null, Rule: true,
-						Chain: []validation.Constraint{{Target: "body.ConstChild.ConstProperty", Name: validation.Null, Rule: true, Chain: nil},
-							{Target: "body.ConstChild.ConstProperty2", Name: validation.Null, Rule: true, Chain: nil},
-						}},
-					{Target: "body.ConstInt", Name: validation.Null, Rule: true, Chain: nil},
-					{Target: "body.ConstString", Name: validation.Null, Rule: true, Chain: nil},
-				}}}}}); err != nil {
-		return result, validation.NewError("validationgroup.BaseClient", "ValidationOfBody", err.Error())
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BaseClient.ValidationOfBody", map[string]interface{}{
+			"resourceGroupName": resourceGroupName,
+			"id":                ID,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
+		}()
+	}
+	var errs ValidationErrors
+	if len(resourceGroupName) > 10 {
+		errs = append(errs, ValidationError{Path: "resourceGroupName", Constraint: "MaxLength", Details: "value length must be less than or equal to 10"})
+	}
+	if len(resourceGroupName) < 3 {
+		errs = append(errs, ValidationError{Path: "resourceGroupName", Constraint: "MinLength", Details: "value length must be greater than or equal to 3"})
+	}
+	if matched, err := regexp.MatchString(`[a-zA-Z0-9]+`, resourceGroupName); err != nil || !matched {
+		errs = append(errs, ValidationError{Path: "resourceGroupName", Constraint: "Pattern", Details: "value doesn't match pattern [a-zA-Z0-9]+"})
+	}
+	if ID > 1000 {
+		errs = append(errs, ValidationError{Path: "id", Constraint: "InclusiveMaximum", Details: "value must be less than or equal to 1000"})
+	}
+	if ID < 100 {
+		errs = append(errs, ValidationError{Path: "id", Constraint: "InclusiveMinimum", Details: "value must be greater than or equal to 100"})
+	}
+	if ID%10 != 0 {
+		errs = append(errs, ValidationError{Path: "id", Constraint: "MultipleOf", Details: "value must be a multiple of 10"})
+	}
+	if body != nil {
+		errs = errs.addNested("body", body.Validate())
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("validationgroup.BaseClient", "ValidationOfBody", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.ValidationOfBodyPreparer(ctx, resourceGroupName, ID, body)
@@ -269,7 +611,7 @@ func (client BaseClient) ValidationOfBody(ctx context.Context, resourceGroupName
 	}
 
 	result, err = client.ValidationOfBodyResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "validationgroup.BaseClient", "ValidationOfBody", resp, "Failure responding to request")
 	}
 
@@ -299,14 +641,15 @@ func (client BaseClient) ValidationOfBodyPreparer(ctx context.Context, resourceG
 		preparer = autorest.DecoratePreparer(preparer,
 			autorest.WithJSON(body))
 	}
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ValidationOfBodySender sends the ValidationOfBody request. The method will close the
 // http.Response Body if it receives an error.
 func (client BaseClient) ValidationOfBodySender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ValidationOfBodyResponder handles the response to the ValidationOfBody request. The method always
@@ -315,10 +658,13 @@ func (client BaseClient) ValidationOfBodyResponder(resp *http.Response) (result
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = client.ResponseValidation.check(fqdn+"/BaseClient.ValidationOfBody", result.validateResponse())
+	}
 	return
 }
 
@@ -327,26 +673,42 @@ func (client BaseClient) ValidationOfBodyResponder(resp *http.Response) (result
 // resourceGroupName - required string between 3 and 10 chars with pattern [a-zA-Z0-9]+.
 // ID - required int multiple of 10 from 100 to 1000.
 func (client BaseClient) ValidationOfMethodParameters(ctx context.Context, resourceGroupName string, ID int32) (result Product, err error) {
-	if tracing.IsEnabled() {
-		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.ValidationOfMethodParameters")
+	if tracer := client.tracer(); tracer != nil {
+		ctx = startSpan(ctx, tracer, fqdn+"/BaseClient.ValidationOfMethodParameters", "GET", "/fakepath/{subscriptionId}/{resourceGroupName}/{id}")
 		defer func() {
-			sc := -1
-			if result.Response.Response != nil {
-				sc = result.Response.Response.StatusCode
-			}
-			tracing.EndSpan(ctx, sc, err)
+			endSpan(ctx, result.Response.Response, err)
+		}()
+	}
+	if client.Logger != nil {
+		ctx = startOperationLog(ctx, client.Logger, "BaseClient.ValidationOfMethodParameters", map[string]interface{}{
+			"resourceGroupName": resourceGroupName,
+			"id":                ID,
+		})
+		defer func() {
+			logResponse(ctx, LogEvent{Kind: LogOperationFinish, Err: err}, result.Response.Response)
 		}()
 	}
-	if err := validation.Validate([]validation.Validation{
-		{TargetValue: resourceGroupName,
-			Constraints: []validation.Constraint{{Target: "resourceGroupName", Name: validation.MaxLength, Rule: 10, Chain: nil},
-				{Target: "resourceGroupName", Name: validation.MinLength, Rule: 3, Chain: nil},
-				{Target: "resourceGroupName", Name: validation.Pattern, Rule: `[a-zA-Z0-9]+`, Chain: nil}}},
-		{TargetValue: ID,
-			Constraints: []validation.Constraint{{Target: "ID", Name: validation.InclusiveMaximum, Rule: int64(1000), Chain: nil},
-				{Target: "ID", Name: validation.InclusiveMinimum, Rule: 100, Chain: nil},
-				{Target: "ID", Name: validation.MultipleOf, Rule: 10, Chain: nil}}}}); err != nil {
-		return result, validation.NewError("validationgroup.BaseClient", "ValidationOfMethodParameters", err.Error())
+	var errs ValidationErrors
+	if len(resourceGroupName) > 10 {
+		errs = append(errs, ValidationError{Path: "resourceGroupName", Constraint: "MaxLength", Details: "value length must be less than or equal to 10"})
+	}
+	if len(resourceGroupName) < 3 {
+		errs = append(errs, ValidationError{Path: "resourceGroupName", Constraint: "MinLength", Details: "value length must be greater than or equal to 3"})
+	}
+	if matched, err := regexp.MatchString(`[a-zA-Z0-9]+`, resourceGroupName); err != nil || !matched {
+		errs = append(errs, ValidationError{Path: "resourceGroupName", Constraint: "Pattern", Details: "value doesn't match pattern [a-zA-Z0-9]+"})
+	}
+	if ID > 1000 {
+		errs = append(errs, ValidationError{Path: "id", Constraint: "InclusiveMaximum", Details: "value must be less than or equal to 1000"})
+	}
+	if ID < 100 {
+		errs = append(errs, ValidationError{Path: "id", Constraint: "InclusiveMinimum", Details: "value must be greater than or equal to 100"})
+	}
+	if ID%10 != 0 {
+		errs = append(errs, ValidationError{Path: "id", Constraint: "MultipleOf", Details: "value must be a multiple of 10"})
+	}
+	if len(errs) > 0 {
+		return result, ParameterValidationError{Err: validation.NewError("validationgroup.BaseClient", "ValidationOfMethodParameters", errs.Error()), Errors: errs}
 	}
 
 	req, err := client.ValidationOfMethodParametersPreparer(ctx, resourceGroupName, ID)
@@ -363,7 +725,7 @@ func (client BaseClient) ValidationOfMethodParameters(ctx context.Context, resou
 	}
 
 	result, err = client.ValidationOfMethodParametersResponder(resp)
-	if err != nil {
+	if _, ok := err.(ErrorResponse); err != nil && !ok {
 		err = autorest.NewErrorWithError(err, "validationgroup.BaseClient", "ValidationOfMethodParameters", resp, "Failure responding to request")
 	}
 
@@ -388,14 +750,15 @@ func (client BaseClient) ValidationOfMethodParametersPreparer(ctx context.Contex
 		autorest.WithBaseURL(client.BaseURI),
 		autorest.WithPathParameters("/fakepath/{subscriptionId}/{resourceGroupName}/{id}", pathParameters),
 		autorest.WithQueryParameters(queryParameters))
+	preparer = autorest.DecoratePreparer(preparer, withRequestOptions())
 	return preparer.Prepare((&http.Request{}).WithContext(ctx))
 }
 
 // ValidationOfMethodParametersSender sends the ValidationOfMethodParameters request. The method will close the
 // http.Response Body if it receives an error.
 func (client BaseClient) ValidationOfMethodParametersSender(req *http.Request) (*http.Response, error) {
-	sd := autorest.GetSendDecorators(req.Context(), autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
-	return autorest.SendWithSender(client, req, sd...)
+	sd := autorest.GetSendDecorators(req.Context(), withRetryPolicy(client.RetryPolicy, client.Client, false))
+	return sendWithRequestOptions(client.pipeline(), req, sd...)
 }
 
 // ValidationOfMethodParametersResponder handles the response to the ValidationOfMethodParameters request. The method always
@@ -404,9 +767,12 @@ func (client BaseClient) ValidationOfMethodParametersResponder(resp *http.Respon
 	err = autorest.Respond(
 		resp,
 		client.ByInspecting(),
-		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		withErrorResponseUnlessStatusCode(http.StatusOK),
 		autorest.ByUnmarshallingJSON(&result),
 		autorest.ByClosing())
 	result.Response = autorest.Response{Response: resp}
+	if err == nil {
+		err = client.ResponseValidation.check(fqdn+"/BaseClient.ValidationOfMethodParameters", result.validateResponse())
+	}
 	return
 }
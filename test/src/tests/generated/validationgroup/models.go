@@ -7,7 +7,24 @@ package validationgroup
 // Changes may cause incorrect behavior and will be lost if the code is regenerated.
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
 	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/validation"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // The package's fully qualified name.
@@ -26,6 +43,42 @@ func PossibleEnumConstValues() []EnumConst {
 	return []EnumConst{ConstantStringAsEnum}
 }
 
+// knownEnumConstValues is the set of the possible values for the EnumConst const type.
+var knownEnumConstValues = map[EnumConst]struct{}{ConstantStringAsEnum: {}}
+
+// ParseEnumConst returns the EnumConst whose value is s, or an error if s isn't one of its possible values.
+func ParseEnumConst(s string) (EnumConst, error) {
+	if _, ok := knownEnumConstValues[EnumConst(s)]; ok {
+		return EnumConst(s), nil
+	}
+	return "", fmt.Errorf("%q isn't a possible value for EnumConst", s)
+}
+
+// ParseEnumConstIgnoreCase returns the EnumConst whose value equals s without regard to case, or an error if s isn't
+// one of its possible values.  Exact matches are preferred.
+func ParseEnumConstIgnoreCase(s string) (EnumConst, error) {
+	if value, err := ParseEnumConst(s); err == nil {
+		return value, nil
+	}
+	for _, value := range PossibleEnumConstValues() {
+		if strings.EqualFold(string(value), s) {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("%q isn't a possible value for EnumConst", s)
+}
+
+// IsKnown returns true if ec is one of the possible values for the EnumConst const type.
+func (ec EnumConst) IsKnown() bool {
+	_, ok := knownEnumConstValues[ec]
+	return ok
+}
+
+// String returns the value of ec.
+func (ec EnumConst) String() string {
+	return string(ec)
+}
+
 // ChildProduct the product documentation.
 type ChildProduct struct {
 	// ConstProperty - Constant string
@@ -34,6 +87,74 @@ type ChildProduct struct {
 	Count *int32 `json:"count,omitempty"`
 }
 
+// Validate checks that the ChildProduct satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (cp ChildProduct) Validate() error {
+	var errs ValidationErrors
+	if cp.ConstProperty == nil {
+		errs = append(errs, ValidationError{Path: "constProperty", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateResponse checks that the ChildProduct returned by the service satisfies the constraints
+// defined by the service.  It returns a ValidationErrors containing every violation, or nil if there are none.
+func (cp ChildProduct) validateResponse() error {
+	return cp.Validate()
+}
+
+// DeepCopy returns a copy of the ChildProduct that doesn't share any pointers, slices or maps with it.
+func (cp ChildProduct) DeepCopy() ChildProduct {
+	result := cp
+	if cp.ConstProperty != nil {
+		v := *cp.ConstProperty
+		result.ConstProperty = &v
+	}
+	if cp.Count != nil {
+		v := *cp.Count
+		result.Count = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ChildProduct and other are equal.  Times are equal if they represent the same
+// instant.
+func (cp ChildProduct) Equal(other ChildProduct) bool {
+	return cp.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (cp ChildProduct) EqualIgnoreReadOnly(other ChildProduct) bool {
+	return cp.equal(other, true)
+}
+
+// equal compares the fields of the ChildProduct and other, skipping the read-only ones if ignoreReadOnly is true.
+func (cp ChildProduct) equal(other ChildProduct, ignoreReadOnly bool) bool {
+	if (cp.ConstProperty == nil) != (other.ConstProperty == nil) || (cp.ConstProperty != nil && *cp.ConstProperty != *other.ConstProperty) {
+		return false
+	}
+	if (cp.Count == nil) != (other.Count == nil) || (cp.Count != nil && *cp.Count != *other.Count) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ChildProduct to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (cp *ChildProduct) MergeFrom(patch ChildProduct) {
+	if patch.ConstProperty != nil {
+		v := *patch.ConstProperty
+		cp.ConstProperty = &v
+	}
+	if patch.Count != nil {
+		v := *patch.Count
+		cp.Count = &v
+	}
+}
+
 // ConstantProduct the product documentation.
 type ConstantProduct struct {
 	// ConstProperty - Constant string
@@ -42,6 +163,77 @@ type ConstantProduct struct {
 	ConstProperty2 *string `json:"constProperty2,omitempty"`
 }
 
+// Validate checks that the ConstantProduct satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (cp ConstantProduct) Validate() error {
+	var errs ValidationErrors
+	if cp.ConstProperty == nil {
+		errs = append(errs, ValidationError{Path: "constProperty", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if cp.ConstProperty2 == nil {
+		errs = append(errs, ValidationError{Path: "constProperty2", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateResponse checks that the ConstantProduct returned by the service satisfies the constraints
+// defined by the service.  It returns a ValidationErrors containing every violation, or nil if there are none.
+func (cp ConstantProduct) validateResponse() error {
+	return cp.Validate()
+}
+
+// DeepCopy returns a copy of the ConstantProduct that doesn't share any pointers, slices or maps with it.
+func (cp ConstantProduct) DeepCopy() ConstantProduct {
+	result := cp
+	if cp.ConstProperty != nil {
+		v := *cp.ConstProperty
+		result.ConstProperty = &v
+	}
+	if cp.ConstProperty2 != nil {
+		v := *cp.ConstProperty2
+		result.ConstProperty2 = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ConstantProduct and other are equal.  Times are equal if they represent the
+// same instant.
+func (cp ConstantProduct) Equal(other ConstantProduct) bool {
+	return cp.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (cp ConstantProduct) EqualIgnoreReadOnly(other ConstantProduct) bool {
+	return cp.equal(other, true)
+}
+
+// equal compares the fields of the ConstantProduct and other, skipping the read-only ones if ignoreReadOnly is true.
+func (cp ConstantProduct) equal(other ConstantProduct, ignoreReadOnly bool) bool {
+	if (cp.ConstProperty == nil) != (other.ConstProperty == nil) || (cp.ConstProperty != nil && *cp.ConstProperty != *other.ConstProperty) {
+		return false
+	}
+	if (cp.ConstProperty2 == nil) != (other.ConstProperty2 == nil) || (cp.ConstProperty2 != nil && *cp.ConstProperty2 != *other.ConstProperty2) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ConstantProduct to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields aren't changed.
+func (cp *ConstantProduct) MergeFrom(patch ConstantProduct) {
+	if patch.ConstProperty != nil {
+		v := *patch.ConstProperty
+		cp.ConstProperty = &v
+	}
+	if patch.ConstProperty2 != nil {
+		v := *patch.ConstProperty2
+		cp.ConstProperty2 = &v
+	}
+}
+
 // Error ...
 type Error struct {
 	Code    *int32  `json:"code,omitempty"`
@@ -49,6 +241,510 @@ type Error struct {
 	Fields  *string `json:"fields,omitempty"`
 }
 
+// DeepCopy returns a copy of the Error that doesn't share any pointers, slices or maps with it.
+func (e Error) DeepCopy() Error {
+	result := e
+	if e.Code != nil {
+		v := *e.Code
+		result.Code = &v
+	}
+	if e.Message != nil {
+		v := *e.Message
+		result.Message = &v
+	}
+	if e.Fields != nil {
+		v := *e.Fields
+		result.Fields = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Error and other are equal.  Times are equal if they represent the same
+// instant.
+func (e Error) Equal(other Error) bool {
+	return e.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (e Error) EqualIgnoreReadOnly(other Error) bool {
+	return e.equal(other, true)
+}
+
+// equal compares the fields of the Error and other, skipping the read-only ones if ignoreReadOnly is true.
+func (e Error) equal(other Error, ignoreReadOnly bool) bool {
+	if (e.Code == nil) != (other.Code == nil) || (e.Code != nil && *e.Code != *other.Code) {
+		return false
+	}
+	if (e.Message == nil) != (other.Message == nil) || (e.Message != nil && *e.Message != *other.Message) {
+		return false
+	}
+	if (e.Fields == nil) != (other.Fields == nil) || (e.Fields != nil && *e.Fields != *other.Fields) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Error to copies of the fields set in patch.  Nested models and maps are merged, the
+// values of the other fields are replaced.  The read-only fields aren't changed.
+func (e *Error) MergeFrom(patch Error) {
+	if patch.Code != nil {
+		v := *patch.Code
+		e.Code = &v
+	}
+	if patch.Message != nil {
+		v := *patch.Message
+		e.Message = &v
+	}
+	if patch.Fields != nil {
+		v := *patch.Fields
+		e.Fields = &v
+	}
+}
+
+// ErrorResponse is returned when an operation fails with a response containing the Error model.  Unlike the other
+// errors of the operations it isn't wrapped in an autorest.DetailedError.
+type ErrorResponse struct {
+	// Model - the error model unmarshalled from the response body.
+	Model Error
+	// StatusCode - the HTTP status code of the response.
+	StatusCode int
+	// Body - the raw response body.
+	Body []byte
+	// Err - the error encountered reading or unmarshalling the response body, if any.
+	Err error
+}
+
+// Error implements the error interface for type ErrorResponse.
+func (er ErrorResponse) Error() string {
+	if er.Err != nil {
+		return fmt.Sprintf("validationgroup: StatusCode=%d Body=%s Err=%v", er.StatusCode, string(er.Body), er.Err)
+	}
+	return fmt.Sprintf("validationgroup: StatusCode=%d Body=%s", er.StatusCode, string(er.Body))
+}
+
+// Unwrap returns the error encountered reading or unmarshalling the response body, if any.
+func (er ErrorResponse) Unwrap() error {
+	return er.Err
+}
+
+// withErrorResponseUnlessStatusCode returns a RespondDecorator that returns an ErrorResponse if the
+// response's status code isn't one of the specified codes.  The response body is left available to the caller.
+// When the body can't be read or unmarshalled the returned ErrorResponse wraps the error in its Err field.
+func withErrorResponseUnlessStatusCode(codes ...int) autorest.RespondDecorator {
+	return func(r autorest.Responder) autorest.Responder {
+		return autorest.ResponderFunc(func(resp *http.Response) error {
+			err := r.Respond(resp)
+			if err != nil || autorest.ResponseHasStatusCode(resp, codes...) {
+				return err
+			}
+			defer resp.Body.Close()
+			er := ErrorResponse{StatusCode: resp.StatusCode}
+			er.Body, er.Err = ioutil.ReadAll(resp.Body)
+			resp.Body = ioutil.NopCloser(bytes.NewReader(er.Body))
+			if er.Err == nil && len(bytes.TrimSpace(er.Body)) > 0 {
+				er.Err = json.Unmarshal(er.Body, &er.Model)
+			}
+			return er
+		})
+	}
+}
+
+// ExponentialRetry is a RetryPolicy that retries failed requests with exponentially increasing delays.  When the
+// RetryPolicy of a client is nil one is created from its RetryAttempts and RetryDuration.  Unlike
+// autorest.DoRetryForStatusCodes it only retries idempotent requests unless RetryNonIdempotent is true, so POST and
+// PATCH requests, including the initial requests of long-running operations, aren't retried unless the service marks
+// their operation x-ms-idempotent.  Responses with status code 429 count against MaxRetries.
+type ExponentialRetry struct {
+	// MaxRetries - the maximum number of times a request is retried.
+	MaxRetries int
+	// Delay - the delay before the first retry.  The delay is doubled for each following retry.
+	Delay time.Duration
+	// MaxDelay - the maximum delay between retries.  Zero means no limit.
+	MaxDelay time.Duration
+	// MaxElapsed - requests aren't retried once the delay would exceed this much time since the first attempt.  Zero means no limit.
+	MaxElapsed time.Duration
+	// Jitter - the fraction, from 0 to 1, of each delay that's randomized to spread out the retries of concurrent requests.
+	Jitter float64
+	// StatusCodes - the status codes of the responses that are retried.  Defaults to autorest.StatusCodesForRetry.
+	StatusCodes []int
+	// RetryNonIdempotent - if true, requests that aren't idempotent, e.g. POST requests of operations the service doesn't mark as idempotent, are retried too.
+	RetryNonIdempotent bool
+}
+
+// defaultRetryJitter is the Jitter of the ExponentialRetry used when the RetryPolicy of a client is nil.
+const defaultRetryJitter = 0.2
+
+// RetryPolicy decides if and when a failed attempt to send a request is retried.
+type RetryPolicy interface {
+	// ShouldRetry returns the delay before the request is sent again, or false if it isn't retried.
+	ShouldRetry(attempt RetryAttempt) (time.Duration, bool)
+}
+
+// LogEvent is an event logged by the operations of a client when its Logger isn't nil.  The URLs and headers of the
+// requests aren't included as they can contain secrets.
+type LogEvent struct {
+	// Kind - the kind of the event.
+	Kind LogEventKind
+	// Operation - the name of the operation, qualified by the name of its client.
+	Operation string
+	// Fields - the parameters of the operation keyed by their names in the request, for LogOperationStart events.  Body parameters aren't included and the values of secret parameters are replaced with REDACTED.
+	Fields map[string]interface{}
+	// Attempt - the number of the failed attempt, starting at one, for LogRetry events.
+	Attempt int
+	// Delay - the delay before the request is sent again, for LogRetry events.
+	Delay time.Duration
+	// State - the status of the long-running operation, for LogPoll events.
+	State string
+	// StatusCode - the status code of the response, or zero if none was received.
+	StatusCode int
+	// Elapsed - the time elapsed since the operation, the page fetch or the polling started.
+	Elapsed time.Duration
+	// Err - the error the operation, the attempt or the poll failed with, if any.
+	Err error
+}
+
+// redactedLogValue replaces the values of secret parameters in the Fields of a LogEvent.
+const redactedLogValue = "REDACTED"
+
+// LogEventKind is the kind of a LogEvent.
+type LogEventKind string
+
+const (
+	// LogOperationStart is logged when an operation starts, with its parameters.
+	LogOperationStart LogEventKind = "OperationStart"
+	// LogOperationFinish is logged when an operation finishes.
+	LogOperationFinish LogEventKind = "OperationFinish"
+	// LogRetry is logged when a failed attempt to send a request is retried.
+	LogRetry LogEventKind = "Retry"
+	// LogPageFetch is logged when the next page of the results of an operation has been fetched.
+	LogPageFetch LogEventKind = "PageFetch"
+	// LogPoll is logged each time PollUntilDone has polled the status of a long-running operation.
+	LogPoll LogEventKind = "Poll"
+)
+
+// Logger receives the events logged by the operations of a client.
+type Logger interface {
+	// Log is called synchronously for each event.
+	Log(event LogEvent)
+}
+
+// LoggerFunc is a function implementing the Logger interface.
+type LoggerFunc func(event LogEvent)
+
+// Log implements the Logger interface for LoggerFunc.
+func (lf LoggerFunc) Log(event LogEvent) {
+	lf(event)
+}
+
+// String formats the event as a line of key=value pairs, e.g. to pass to log.Print.
+func (le LogEvent) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "event=%s operation=%s", le.Kind, le.Operation)
+	names := make([]string, 0, len(le.Fields))
+	for name := range le.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&b, " %s=%v", name, le.Fields[name])
+	}
+	if le.Attempt > 0 {
+		fmt.Fprintf(&b, " attempt=%d", le.Attempt)
+	}
+	if le.State != "" {
+		fmt.Fprintf(&b, " state=%s", le.State)
+	}
+	if le.StatusCode > 0 {
+		fmt.Fprintf(&b, " status=%d", le.StatusCode)
+	}
+	if le.Delay > 0 {
+		fmt.Fprintf(&b, " delay=%v", le.Delay)
+	}
+	if le.Kind != LogOperationStart {
+		fmt.Fprintf(&b, " elapsed=%v", le.Elapsed)
+	}
+	if le.Err != nil {
+		fmt.Fprintf(&b, " error=%q", le.Err.Error())
+	}
+	return b.String()
+}
+
+// operationLog is the state used to log the events of an operation, carried by the contexts of its requests.
+type operationLog struct {
+	logger    Logger
+	operation string
+	start     time.Time
+}
+
+// operationLogKey is the key of the operationLog in a context.
+type operationLogKey struct{}
+
+// withOperationLog returns a context carrying the state used to log the events of operation to logger.
+func withOperationLog(ctx context.Context, logger Logger, operation string) context.Context {
+	return context.WithValue(ctx, operationLogKey{}, &operationLog{logger: logger, operation: operation, start: time.Now()})
+}
+
+// startOperationLog logs the start of operation with its parameters, then returns a context carrying the state used to
+// log its other events.
+func startOperationLog(ctx context.Context, logger Logger, operation string, fields map[string]interface{}) context.Context {
+	for name, value := range fields {
+		// log the values of optional parameters rather than their addresses
+		v := reflect.ValueOf(value)
+		for v.Kind() == reflect.Ptr && !v.IsNil() {
+			v = v.Elem()
+		}
+		if v.Kind() == reflect.Ptr {
+			fields[name] = nil
+		} else if v.IsValid() {
+			fields[name] = v.Interface()
+		}
+	}
+	ctx = withOperationLog(ctx, logger, operation)
+	logger.Log(LogEvent{Kind: LogOperationStart, Operation: operation, Fields: fields})
+	return ctx
+}
+
+// logResponse logs event for the operation whose state is carried by ctx, if any, along with the status code of resp
+// if it isn't nil.
+func logResponse(ctx context.Context, event LogEvent, resp *http.Response) {
+	ol, ok := ctx.Value(operationLogKey{}).(*operationLog)
+	if !ok {
+		return
+	}
+	event.Operation = ol.operation
+	event.Elapsed = time.Since(ol.start)
+	if resp != nil {
+		event.StatusCode = resp.StatusCode
+	}
+	ol.logger.Log(event)
+}
+
+// ReadOnlyProduct a product with a read-only identifier.
+type ReadOnlyProduct struct {
+	autorest.Response `json:"-"`
+	// ID - READ-ONLY; The identifier of the product, set by the service.
+	ID *string `json:"id,omitempty"`
+	// Name - The name of the product.
+	Name *string `json:"name,omitempty"`
+}
+
+// Validate checks that the ReadOnlyProduct satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (rop ReadOnlyProduct) Validate() error {
+	var errs ValidationErrors
+	if rop.Name != nil {
+		if len(*rop.Name) > 10 {
+			errs = append(errs, ValidationError{Path: "name", Constraint: "MaxLength", Details: "value length must be less than or equal to 10"})
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateResponse checks that the ReadOnlyProduct returned by the service satisfies the constraints
+// defined by the service.  It returns a ValidationErrors containing every violation, or nil if there are none.
+func (rop ReadOnlyProduct) validateResponse() error {
+	errs, _ := rop.Validate().(ValidationErrors)
+	errs = append(errs, rop.responseViolations()...)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// responseViolations returns the violations of the constraints the Validate method doesn't check,
+// i.e. unknown enum values and missing read-only fields.  They're only checked on values returned by the service.
+func (rop ReadOnlyProduct) responseViolations() ValidationErrors {
+	var errs ValidationErrors
+	if rop.ID == nil {
+		errs = append(errs, ValidationError{Path: "id", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	return errs
+}
+
+// DeepCopy returns a copy of the ReadOnlyProduct that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (rop ReadOnlyProduct) DeepCopy() ReadOnlyProduct {
+	result := rop
+	if rop.ID != nil {
+		v := *rop.ID
+		result.ID = &v
+	}
+	if rop.Name != nil {
+		v := *rop.Name
+		result.Name = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the ReadOnlyProduct and other are equal.  Times are equal if they represent the
+// same instant.  Their autorest.Response isn't compared.
+func (rop ReadOnlyProduct) Equal(other ReadOnlyProduct) bool {
+	return rop.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (rop ReadOnlyProduct) EqualIgnoreReadOnly(other ReadOnlyProduct) bool {
+	return rop.equal(other, true)
+}
+
+// equal compares the fields of the ReadOnlyProduct and other, skipping the read-only ones if ignoreReadOnly is true.
+func (rop ReadOnlyProduct) equal(other ReadOnlyProduct, ignoreReadOnly bool) bool {
+	if !ignoreReadOnly {
+		if (rop.ID == nil) != (other.ID == nil) || (rop.ID != nil && *rop.ID != *other.ID) {
+			return false
+		}
+	}
+	if (rop.Name == nil) != (other.Name == nil) || (rop.Name != nil && *rop.Name != *other.Name) {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the ReadOnlyProduct to copies of the fields set in patch.  Nested models and maps are
+// merged, the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (rop *ReadOnlyProduct) MergeFrom(patch ReadOnlyProduct) {
+	if patch.Name != nil {
+		v := *patch.Name
+		rop.Name = &v
+	}
+}
+
+// RetryAttempt describes a failed attempt to send a request.
+type RetryAttempt struct {
+	// Request - the request that was sent.
+	Request *http.Request
+	// Response - the response received, or nil if sending the request failed.
+	Response *http.Response
+	// Err - the error returned when sending the request, if any.
+	Err error
+	// Attempt - the number of the attempt, starting at one.
+	Attempt int
+	// Elapsed - the time elapsed since the first attempt was sent.
+	Elapsed time.Duration
+	// Idempotent - true if the request can safely be sent more than once, either because its HTTP method is
+	// idempotent or because the service marks the operation as idempotent.
+	Idempotent bool
+}
+
+// ShouldRetry implements the RetryPolicy interface for ExponentialRetry.  A delay requested by the Retry-After header
+// of the response takes precedence over the computed one.
+func (er ExponentialRetry) ShouldRetry(attempt RetryAttempt) (time.Duration, bool) {
+	if attempt.Attempt > er.MaxRetries || !(attempt.Idempotent || er.RetryNonIdempotent) {
+		return 0, false
+	}
+	if attempt.Err != nil {
+		if !autorest.IsTemporaryNetworkError(attempt.Err) || autorest.IsTokenRefreshError(attempt.Err) {
+			return 0, false
+		}
+	} else {
+		codes := er.StatusCodes
+		if codes == nil {
+			codes = autorest.StatusCodesForRetry
+		}
+		if !autorest.ResponseHasStatusCode(attempt.Response, codes...) {
+			return 0, false
+		}
+	}
+	delay, ok := retryAfter(attempt.Response)
+	if !ok {
+		delay = er.Delay
+		for i := 1; i < attempt.Attempt && delay < math.MaxInt64/2; i++ {
+			delay *= 2
+		}
+		if er.MaxDelay > 0 && delay > er.MaxDelay {
+			delay = er.MaxDelay
+		}
+		if er.Jitter > 0 {
+			delay -= time.Duration(math.Min(er.Jitter, 1) * rand.Float64() * float64(delay))
+		}
+	}
+	if er.MaxElapsed > 0 && attempt.Elapsed+delay > er.MaxElapsed {
+		return 0, false
+	}
+	return delay, true
+}
+
+// retryAfter returns the delay requested by the Retry-After header of resp, and false if it doesn't have one.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	ra := resp.Header.Get("Retry-After")
+	if ra == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(ra); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(ra); err == nil {
+		if delay := time.Until(t); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// isIdempotentMethod returns true if requests using the HTTP method can safely be sent more than once.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodTrace:
+		return true
+	}
+	return false
+}
+
+// withRetryPolicy returns a SendDecorator that sends a request again after each failed attempt policy decides to retry.
+// If policy is nil an ExponentialRetry created from the RetryAttempts and RetryDuration of c is used.  idempotent is
+// true for operations the service marks as idempotent.
+func withRetryPolicy(policy RetryPolicy, c autorest.Client, idempotent bool) autorest.SendDecorator {
+	if policy == nil {
+		policy = ExponentialRetry{MaxRetries: c.RetryAttempts, Delay: c.RetryDuration, Jitter: defaultRetryJitter}
+	}
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+			idempotent := idempotent || isIdempotentMethod(r.Method)
+			start := time.Now()
+			for attempt := 1; ; attempt++ {
+				if err := rr.Prepare(); err != nil {
+					return nil, err
+				}
+				resp, err := s.Do(rr.Request())
+				if r.Context().Err() != nil {
+					return resp, err
+				}
+				delay, retry := policy.ShouldRetry(RetryAttempt{
+					Request:    rr.Request(),
+					Response:   resp,
+					Err:        err,
+					Attempt:    attempt,
+					Elapsed:    time.Since(start),
+					Idempotent: idempotent,
+				})
+				if !retry {
+					return resp, err
+				}
+				logResponse(r.Context(), LogEvent{Kind: LogRetry, Attempt: attempt, Delay: delay, Err: err}, resp)
+				setSpanAttribute(r.Context(), AttributeRetryCount, attempt)
+				if resp != nil {
+					autorest.Respond(resp, autorest.ByDiscardingBody(), autorest.ByClosing())
+				}
+				timer := time.NewTimer(delay)
+				select {
+				case <-timer.C:
+				case <-r.Context().Done():
+					timer.Stop()
+					return nil, r.Context().Err()
+				}
+			}
+		})
+	}
+}
+
 // Product the product documentation.
 type Product struct {
 	autorest.Response `json:"-"`
@@ -67,3 +763,382 @@ type Product struct {
 	// ConstStringAsEnum - Constant string as Enum. Possible values include: 'ConstantStringAsEnum'
 	ConstStringAsEnum EnumConst `json:"constStringAsEnum,omitempty"`
 }
+
+// Validate checks that the Product satisfies the constraints defined by the service.
+// It returns a ValidationErrors containing every violation, or nil if there are none.
+func (p Product) Validate() error {
+	var errs ValidationErrors
+	if p.DisplayNames != nil {
+		if len(*p.DisplayNames) > 6 {
+			errs = append(errs, ValidationError{Path: "display_names", Constraint: "MaxItems", Details: "maximum item limit is 6"})
+		}
+		if len(*p.DisplayNames) < 0 {
+			errs = append(errs, ValidationError{Path: "display_names", Constraint: "MinItems", Details: "minimum item limit is 0"})
+		}
+		if hasDuplicateItems(len(*p.DisplayNames), func(i, j int) bool { return (*p.DisplayNames)[i] == (*p.DisplayNames)[j] }) {
+			errs = append(errs, ValidationError{Path: "display_names", Constraint: "UniqueItems", Details: "all items must be unique"})
+		}
+	}
+	if p.Capacity != nil {
+		if *p.Capacity >= 100 {
+			errs = append(errs, ValidationError{Path: "capacity", Constraint: "ExclusiveMaximum", Details: "value must be less than 100"})
+		}
+		if *p.Capacity <= 0 {
+			errs = append(errs, ValidationError{Path: "capacity", Constraint: "ExclusiveMinimum", Details: "value must be greater than 0"})
+		}
+	}
+	if p.Image != nil {
+		if matched, err := regexp.MatchString(`http://\w+`, *p.Image); err != nil || !matched {
+			errs = append(errs, ValidationError{Path: "image", Constraint: "Pattern", Details: "value doesn't match pattern http://\\w+"})
+		}
+	}
+	if p.Child == nil {
+		errs = append(errs, ValidationError{Path: "child", Constraint: "Null", Details: "value can not be null; required parameter"})
+	} else {
+		errs = errs.addNested("child", p.Child.Validate())
+	}
+	if p.ConstChild == nil {
+		errs = append(errs, ValidationError{Path: "constChild", Constraint: "Null", Details: "value can not be null; required parameter"})
+	} else {
+		errs = errs.addNested("constChild", p.ConstChild.Validate())
+	}
+	if p.ConstInt == nil {
+		errs = append(errs, ValidationError{Path: "constInt", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if p.ConstString == nil {
+		errs = append(errs, ValidationError{Path: "constString", Constraint: "Null", Details: "value can not be null; required parameter"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateResponse checks that the Product returned by the service satisfies the constraints
+// defined by the service.  It returns a ValidationErrors containing every violation, or nil if there are none.
+func (p Product) validateResponse() error {
+	errs, _ := p.Validate().(ValidationErrors)
+	errs = append(errs, p.responseViolations()...)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// responseViolations returns the violations of the constraints the Validate method doesn't check,
+// i.e. unknown enum values and missing read-only fields.  They're only checked on values returned by the service.
+func (p Product) responseViolations() ValidationErrors {
+	var errs ValidationErrors
+	switch p.ConstStringAsEnum {
+	case "", ConstantStringAsEnum:
+	default:
+		errs = append(errs, ValidationError{Path: "constStringAsEnum", Constraint: "Enum", Details: "value must be one of 'constant_string_as_enum'"})
+	}
+	return errs
+}
+
+// DeepCopy returns a copy of the Product that doesn't share any pointers, slices or maps with it.  Its
+// autorest.Response is copied as is.
+func (p Product) DeepCopy() Product {
+	result := p
+	if p.DisplayNames != nil {
+		var v []string
+		if *p.DisplayNames != nil {
+			v = make([]string, len(*p.DisplayNames))
+			copy(v, *p.DisplayNames)
+		}
+		result.DisplayNames = &v
+	}
+	if p.Capacity != nil {
+		v := *p.Capacity
+		result.Capacity = &v
+	}
+	if p.Image != nil {
+		v := *p.Image
+		result.Image = &v
+	}
+	if p.Child != nil {
+		v := p.Child.DeepCopy()
+		result.Child = &v
+	}
+	if p.ConstChild != nil {
+		v := p.ConstChild.DeepCopy()
+		result.ConstChild = &v
+	}
+	if p.ConstInt != nil {
+		v := *p.ConstInt
+		result.ConstInt = &v
+	}
+	if p.ConstString != nil {
+		v := *p.ConstString
+		result.ConstString = &v
+	}
+	return result
+}
+
+// Equal returns true if the fields of the Product and other are equal.  Times are equal if they represent the same
+// instant.  Their autorest.Response isn't compared.
+func (p Product) Equal(other Product) bool {
+	return p.equal(other, false)
+}
+
+// EqualIgnoreReadOnly is like Equal but ignores the read-only fields, whose values are set by the service.
+func (p Product) EqualIgnoreReadOnly(other Product) bool {
+	return p.equal(other, true)
+}
+
+// equal compares the fields of the Product and other, skipping the read-only ones if ignoreReadOnly is true.
+func (p Product) equal(other Product, ignoreReadOnly bool) bool {
+	if (p.DisplayNames == nil) != (other.DisplayNames == nil) {
+		return false
+	}
+	if p.DisplayNames != nil {
+		if (*p.DisplayNames == nil) != (*other.DisplayNames == nil) || len(*p.DisplayNames) != len(*other.DisplayNames) {
+			return false
+		}
+		for i := range *p.DisplayNames {
+			if (*p.DisplayNames)[i] != (*other.DisplayNames)[i] {
+				return false
+			}
+		}
+	}
+	if (p.Capacity == nil) != (other.Capacity == nil) || (p.Capacity != nil && *p.Capacity != *other.Capacity) {
+		return false
+	}
+	if (p.Image == nil) != (other.Image == nil) || (p.Image != nil && *p.Image != *other.Image) {
+		return false
+	}
+	if (p.Child == nil) != (other.Child == nil) || (p.Child != nil && !p.Child.equal(*other.Child, ignoreReadOnly)) {
+		return false
+	}
+	if (p.ConstChild == nil) != (other.ConstChild == nil) || (p.ConstChild != nil && !p.ConstChild.equal(*other.ConstChild, ignoreReadOnly)) {
+		return false
+	}
+	if (p.ConstInt == nil) != (other.ConstInt == nil) || (p.ConstInt != nil && *p.ConstInt != *other.ConstInt) {
+		return false
+	}
+	if (p.ConstString == nil) != (other.ConstString == nil) || (p.ConstString != nil && *p.ConstString != *other.ConstString) {
+		return false
+	}
+	if p.ConstStringAsEnum != other.ConstStringAsEnum {
+		return false
+	}
+	return true
+}
+
+// MergeFrom sets the fields of the Product to copies of the fields set in patch.  Nested models and maps are merged,
+// the values of the other fields are replaced.  The read-only fields and the autorest.Response aren't changed.
+func (p *Product) MergeFrom(patch Product) {
+	if patch.DisplayNames != nil {
+		var v []string
+		if *patch.DisplayNames != nil {
+			v = make([]string, len(*patch.DisplayNames))
+			copy(v, *patch.DisplayNames)
+		}
+		p.DisplayNames = &v
+	}
+	if patch.Capacity != nil {
+		v := *patch.Capacity
+		p.Capacity = &v
+	}
+	if patch.Image != nil {
+		v := *patch.Image
+		p.Image = &v
+	}
+	if patch.Child != nil {
+		if p.Child == nil {
+			p.Child = &ChildProduct{}
+		}
+		p.Child.MergeFrom(*patch.Child)
+	}
+	if patch.ConstChild != nil {
+		if p.ConstChild == nil {
+			p.ConstChild = &ConstantProduct{}
+		}
+		p.ConstChild.MergeFrom(*patch.ConstChild)
+	}
+	if patch.ConstInt != nil {
+		v := *patch.ConstInt
+		p.ConstInt = &v
+	}
+	if patch.ConstString != nil {
+		v := *patch.ConstString
+		p.ConstString = &v
+	}
+	if patch.ConstStringAsEnum != "" {
+		p.ConstStringAsEnum = patch.ConstStringAsEnum
+	}
+}
+
+// RequestOptions contains the per-call options applied to the requests of operations whose context was created with WithRequestOptions.
+type RequestOptions struct {
+	// Header - additional headers to send.  They replace any values of the same headers set by the operation.
+	Header http.Header
+	// Query - additional query parameters to send.  They replace any values of the same parameters set by the operation.
+	Query url.Values
+	// Timeout - if greater than zero, the maximum amount of time allowed for sending the request, including retries, and reading its response.
+	Timeout time.Duration
+}
+
+type requestOptionsKey struct{}
+
+// WithRequestOptions returns a copy of ctx carrying opts.  Operations called with the returned
+// context apply opts to their requests; other calls aren't affected.
+func WithRequestOptions(ctx context.Context, opts RequestOptions) context.Context {
+	return context.WithValue(ctx, requestOptionsKey{}, opts)
+}
+
+// withRequestOptions returns a PrepareDecorator that merges the headers and query parameters
+// of the RequestOptions carried by the request's context into the request.
+func withRequestOptions() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+			opts, ok := r.Context().Value(requestOptionsKey{}).(RequestOptions)
+			if !ok {
+				return r, nil
+			}
+			if len(opts.Header) > 0 {
+				if r.Header == nil {
+					r.Header = make(http.Header)
+				}
+				for k, v := range opts.Header {
+					r.Header[http.CanonicalHeaderKey(k)] = v
+				}
+			}
+			if len(opts.Query) > 0 {
+				q := r.URL.Query()
+				for k, v := range opts.Query {
+					q[k] = v
+				}
+				r.URL.RawQuery = q.Encode()
+			}
+			return r, nil
+		})
+	}
+}
+
+// sendWithRequestOptions sends the request like autorest.SendWithSender.  If the request's context
+// carries a RequestOptions with a Timeout, the request is cancelled once the timeout elapses
+// or the response body is closed, whichever happens first.
+func sendWithRequestOptions(s autorest.Sender, r *http.Request, decorators ...autorest.SendDecorator) (*http.Response, error) {
+	opts, ok := r.Context().Value(requestOptionsKey{}).(RequestOptions)
+	if !ok || opts.Timeout <= 0 {
+		return autorest.SendWithSender(s, r, decorators...)
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), opts.Timeout)
+	resp, err := autorest.SendWithSender(s, r.WithContext(ctx), decorators...)
+	if err != nil || resp == nil || resp.Body == nil {
+		cancel()
+		return resp, err
+	}
+	resp.Body = requestOptionsBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// requestOptionsBody releases the timeout of a request once its response body is closed.
+type requestOptionsBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b requestOptionsBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+// ResponseValidation controls how the client reports responses that violate the constraints defined by the
+// service.  The zero value reports violations as errors.
+type ResponseValidation struct {
+	// Warn - if true, violations are passed to OnWarning instead of being returned as errors.
+	Warn bool
+	// OnWarning - called with the name of the operation and the violations found in its response when Warn is true.
+	OnWarning func(operation string, err error)
+}
+
+// check reports the violations in err, found in the response to the specified operation.  It returns
+// err if violations are reported as errors, otherwise it passes a non-nil err to OnWarning and returns nil.
+func (rv ResponseValidation) check(operation string, err error) error {
+	if err == nil || !rv.Warn {
+		return err
+	}
+	if rv.OnWarning != nil {
+		rv.OnWarning(operation, err)
+	}
+	return nil
+}
+
+// ValidationError describes a value that violates one of the constraints defined by the service.
+type ValidationError struct {
+	// Path - the JSON path of the value, relative to the value being validated.
+	Path string
+	// Constraint - the name of the violated constraint, e.g. MaxLength.
+	Constraint string
+	// Details - describes the violation.
+	Details string
+}
+
+// Error implements the error interface for type ValidationError.
+func (ve ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s (%s)", ve.Path, ve.Details, ve.Constraint)
+}
+
+// ValidationErrors contains every constraint violation found when validating a value.
+type ValidationErrors []ValidationError
+
+// Error implements the error interface for type ValidationErrors.
+func (ve ValidationErrors) Error() string {
+	msgs := make([]string, len(ve))
+	for i := range ve {
+		msgs[i] = ve[i].Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// addNested appends the violations in err, as returned by the Validate method
+// of the value at path, to ve after prefixing their paths with path.
+func (ve ValidationErrors) addNested(path string, err error) ValidationErrors {
+	if nested, ok := err.(ValidationErrors); ok {
+		for _, e := range nested {
+			e.Path = path + "." + e.Path
+			ve = append(ve, e)
+		}
+	} else if err != nil {
+		ve = append(ve, ValidationError{Path: path, Details: err.Error()})
+	}
+	return ve
+}
+
+// ParameterValidationError is returned by an operation whose parameters violate the constraints defined
+// by the service.  Use errors.As to retrieve the validation.Error or the ValidationErrors it wraps.
+type ParameterValidationError struct {
+	// Err - identifies the operation, its message lists every violation.
+	Err validation.Error
+	// Errors - contains every violation.
+	Errors ValidationErrors
+}
+
+// Error implements the error interface for type ParameterValidationError.
+func (pve ParameterValidationError) Error() string {
+	return pve.Err.Error()
+}
+
+// Unwrap returns the validation.Error and the ValidationErrors wrapped by the ParameterValidationError.
+func (pve ParameterValidationError) Unwrap() []error {
+	return []error{pve.Err, pve.Errors}
+}
+
+// hasDuplicateItems returns true if equal reports that any two of the first n items are equal.
+func hasDuplicateItems(n int, equal func(i, j int) bool) bool {
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if equal(i, j) {
+				return true
+			}
+		}
+	}
+	return false
+}
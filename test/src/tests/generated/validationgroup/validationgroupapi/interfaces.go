@@ -15,6 +15,7 @@ import (
 // BaseClientAPI contains the set of methods on the BaseClient type.
 type BaseClientAPI interface {
 	GetWithConstantInPath(ctx context.Context) (result autorest.Response, err error)
+	GetWithReadOnlyProperty(ctx context.Context) (result validationgroup.ReadOnlyProduct, err error)
 	PostWithConstantInBody(ctx context.Context, body *validationgroup.Product) (result validationgroup.Product, err error)
 	ValidationOfBody(ctx context.Context, resourceGroupName string, ID int32, body *validationgroup.Product) (result validationgroup.Product, err error)
 	ValidationOfMethodParameters(ctx context.Context, resourceGroupName string, ID int32) (result validationgroup.Product, err error)
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"strings"
 	"tests/acceptancetests/utils"
 	"tests/generated/azurereport"
 	"tests/generated/report"
@@ -14,11 +16,20 @@ import (
 const testServerPath = "../../../node_modules/@microsoft.azure/autorest.testserver"
 
 func main() {
+	allPass := true
+	if utils.RecordingMode() == "playback" {
+		// the suites reply to their requests from their cassettes
+		runTests(&bytes.Buffer{}, &allPass)
+		if !allPass {
+			fmt.Println("Not all tests passed")
+			os.Exit(1)
+		}
+		return
+	}
 	srvOut, err := startServer()
 	if err != nil {
 		panic(fmt.Sprintf("Error starting server: %v\n", err))
 	}
-	allPass := true
 	runTests(srvOut, &allPass)
 	getReport(context.Background())
 	getAzureReport(context.Background())
@@ -88,7 +99,19 @@ func runTests(srvOutput *bytes.Buffer, allPass *bool) {
 		"lrogroup",
 	}
 
+	// only some of the suites have cassettes, the others need the test server
+	var skipped []string
+	defer func() {
+		if len(skipped) > 0 {
+			fmt.Printf("Skipped %d of %d suites without cassettes: %s\n", len(skipped), len(testSuites), strings.Join(skipped, ", "))
+		}
+	}()
 	for _, suite := range testSuites {
+		if utils.RecordingMode() == "playback" && !hasCassettes(suite) {
+			fmt.Printf("Skip test (no cassettes recorded in ./acceptancetests/%vtest/testdata)\n", suite)
+			skipped = append(skipped, suite)
+			continue
+		}
 		fmt.Printf("Run test (go test ./acceptancetests/%vtest -v) ...\n", suite)
 		tests := exec.Command("go", "test", fmt.Sprintf("./acceptancetests/%vtest", suite), "-v")
 		var stdout, stderr bytes.Buffer
@@ -110,6 +133,12 @@ func runTests(srvOutput *bytes.Buffer, allPass *bool) {
 	}
 }
 
+// hasCassettes returns true if the cassettes the suite plays back in playback mode have been recorded.
+func hasCassettes(suite string) bool {
+	cassettes, _ := filepath.Glob(filepath.Join("acceptancetests", suite+"test", "testdata", "*.json"))
+	return len(cassettes) > 0
+}
+
 func getReport(ctx context.Context) {
 	var reportClient = report.NewWithBaseURI(utils.GetBaseURI())
 	res, err := reportClient.GetReport(ctx, "")